@@ -116,25 +116,67 @@ const calculateNextRulePriority = async function (listenerArn) {
   return nextRulePriority;
 };
 
+/**
+ * Reserves a consecutive block of listener rule priorities for a service.
+ * The additional rules are assigned the lowest priorities of the block so that
+ * they're evaluated before the service's main rule, which gets the last one.
+ *
+ * @param {string} listenerArn the ARN of the ALB listener.
+ * @param {number} additionalRuleCount the number of additional rules of the service.
+ *
+ * @returns {object} The response data with the reserved priorities.
+ */
+const reserveRulePriorities = async function (listenerArn, additionalRuleCount) {
+  const nextRulePriority = await calculateNextRulePriority(listenerArn);
+  let data = {};
+  for (let i = 0; i < additionalRuleCount; i++) {
+    data[`Priority${i}`] = nextRulePriority + i;
+  }
+  data.Priority = nextRulePriority + additionalRuleCount;
+  return data;
+};
+
+/**
+ * Returns the number of additional rules requested in the resource properties.
+ *
+ * @param {object} properties the resource properties of the custom resource.
+ *
+ * @returns {number} The number of additional rules, 0 if none are requested.
+ */
+const additionalRuleCount = function (properties) {
+  return parseInt((properties || {}).AdditionalRuleCount || "0");
+};
+
 /**
  * Next Available ALB Rule Priority handler, invoked by Lambda
  */
 exports.nextAvailableRulePriorityHandler = async function (event, context) {
   var responseData = {};
   var physicalResourceId;
-  var rulePriority;
 
   try {
     switch (event.RequestType) {
       case "Create":
-        rulePriority = await calculateNextRulePriority(
-          event.ResourceProperties.ListenerArn
+        responseData = await reserveRulePriorities(
+          event.ResourceProperties.ListenerArn,
+          additionalRuleCount(event.ResourceProperties)
         );
-        responseData.Priority = rulePriority;
         physicalResourceId = `alb-rule-priority-${event.LogicalResourceId}`;
         break;
-      // Do nothing on update and delete, since this isn't a "real" resource.
       case "Update":
+        physicalResourceId = event.PhysicalResourceId;
+        // Only reserve a new block of priorities if the number of additional rules changed.
+        if (
+          additionalRuleCount(event.ResourceProperties) !==
+          additionalRuleCount(event.OldResourceProperties)
+        ) {
+          responseData = await reserveRulePriorities(
+            event.ResourceProperties.ListenerArn,
+            additionalRuleCount(event.ResourceProperties)
+          );
+        }
+        break;
+      // Do nothing on delete, since this isn't a "real" resource.
       case "Delete":
         physicalResourceId = event.PhysicalResourceId;
         break;
@@ -0,0 +1,336 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const aws = require("aws-sdk");
+
+// These are used for test purposes only
+let defaultResponseURL;
+let waiter;
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+let report = function (
+  event,
+  context,
+  responseStatus,
+  physicalResourceId,
+  responseData,
+  reason
+) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    var responseBody = JSON.stringify({
+      Status: responseStatus,
+      Reason: reason,
+      PhysicalResourceId: physicalResourceId || context.logStreamName,
+      StackId: event.StackId,
+      RequestId: event.RequestId,
+      LogicalResourceId: event.LogicalResourceId,
+      Data: responseData,
+    });
+
+    const parsedUrl = new URL(event.ResponseURL || defaultResponseURL);
+    const options = {
+      hostname: parsedUrl.hostname,
+      port: 443,
+      path: parsedUrl.pathname + parsedUrl.search,
+      method: "PUT",
+      headers: {
+        "Content-Type": "",
+        "Content-Length": responseBody.length,
+      },
+    };
+
+    https
+      .request(options)
+      .on("error", reject)
+      .on("response", (res) => {
+        res.resume();
+        if (res.statusCode >= 400) {
+          reject(new Error(`Error ${res.statusCode}: ${res.statusMessage}`));
+        } else {
+          resolve();
+        }
+      })
+      .end(responseBody, "utf8");
+  });
+};
+
+
+/**
+ * Returns true if the name is the domain name or one of its subdomains.
+ *
+ * @param {string} name the name
+ * @param {string} domain the domain name
+ */
+const isInDomain = function (name, domain) {
+  name = name.replace(/\.$/, "").toLowerCase();
+  domain = domain.replace(/\.$/, "").toLowerCase();
+  return name === domain || name.endsWith(`.${domain}`);
+};
+
+/**
+ * Returns the Route53 client and the ID of the hosted zone that the record of the alias belongs to.
+ * Aliases under the environment's domain are in the environment's hosted zone, the others are in the
+ * application's hosted zone which is updated by assuming the root DNS role.
+ *
+ * @param {string} alias the custom domain name
+ * @param {object} props the resource properties of the custom domain
+ */
+const hostedZoneFor = async function (alias, props) {
+  if (isInDomain(alias, props.EnvDomainName)) {
+    return [route53Client(), props.EnvHostedZoneId];
+  }
+  const route53 = route53Client(props.RootDNSRole);
+  const { HostedZones } = await route53
+    .listHostedZonesByName({
+      DNSName: props.AppDomainName,
+      MaxItems: "1",
+    })
+    .promise();
+  if (
+    !HostedZones ||
+    HostedZones.length === 0 ||
+    HostedZones[0].Name.replace(/\.$/, "") !== props.AppDomainName
+  ) {
+    throw new Error(
+      `Couldn't find any hosted zone with DNS name ${props.AppDomainName}.`
+    );
+  }
+  // HostedZoneIDs are of the form /hostedzone/1234455, but the actual
+  // ID is after the last slash.
+  return [route53, HostedZones[0].Id.split("/").pop()];
+};
+
+/**
+ * Returns the A record of the alias in the hosted zone, or undefined if it doesn't exist.
+ */
+const findRecord = async function (route53, hostedZoneId, alias) {
+  const { ResourceRecordSets } = await route53
+    .listResourceRecordSets({
+      HostedZoneId: hostedZoneId,
+      MaxItems: "1",
+      StartRecordName: alias,
+      StartRecordType: "A",
+    })
+    .promise();
+  const record = (ResourceRecordSets || [])[0];
+  if (
+    !record ||
+    record.Type !== "A" ||
+    record.Name.replace(/\.$/, "").toLowerCase() !==
+      alias.replace(/\.$/, "").toLowerCase()
+  ) {
+    return undefined;
+  }
+  return record;
+};
+
+/**
+ * Returns true if the record is an alias of the load balancer.
+ *
+ * @param {object} record the A record
+ * @param {object} props the resource properties of the custom domain
+ */
+const pointsToLoadBalancer = function (record, props) {
+  const normalize = (name) =>
+    name.replace(/\.$/, "").replace(/^dualstack\./, "").toLowerCase();
+  const target = (record.AliasTarget || {}).DNSName || "";
+  return normalize(target) === normalize(props.LoadBalancerDNS);
+};
+
+/**
+ * Creates or updates the A records of the aliases to point to the load balancer.
+ * Fails if an alias already points somewhere else, so that a service can't take over the alias of another one.
+ *
+ * @param {string[]} aliases the custom domain names
+ * @param {object} props the resource properties of the custom domain
+ */
+const upsertAliases = async function (aliases, props) {
+  for (const alias of aliases) {
+    const [route53, hostedZoneId] = await hostedZoneFor(alias, props);
+    const existing = await findRecord(route53, hostedZoneId, alias);
+    if (existing && !pointsToLoadBalancer(existing, props)) {
+      throw new Error(
+        `Alias ${alias} is already in use by another record in hosted zone ${hostedZoneId}.`
+      );
+    }
+    console.log(`Upserting A record of ${alias} into zone ${hostedZoneId}`);
+    const changeBatch = await route53
+      .changeResourceRecordSets({
+        ChangeBatch: {
+          Comment: `Alias of the load balancer for ${alias}`,
+          Changes: [
+            {
+              Action: "UPSERT",
+              ResourceRecordSet: aliasRecord(alias, props),
+            },
+          ],
+        },
+        HostedZoneId: hostedZoneId,
+      })
+      .promise();
+    await waitForRecordChange(route53, changeBatch.ChangeInfo.Id);
+  }
+};
+
+/**
+ * Deletes the A records of the aliases. Records that were already deleted, or that don't point
+ * to the load balancer because they were never created by the service, are skipped.
+ *
+ * @param {string[]} aliases the custom domain names
+ * @param {object} props the resource properties of the custom domain
+ */
+const deleteAliases = async function (aliases, props) {
+  for (const alias of aliases) {
+    const [route53, hostedZoneId] = await hostedZoneFor(alias, props);
+    const existing = await findRecord(route53, hostedZoneId, alias);
+    if (!existing || !pointsToLoadBalancer(existing, props)) {
+      continue;
+    }
+    console.log(`Deleting A record of ${alias} from zone ${hostedZoneId}`);
+    const changeBatch = await route53
+      .changeResourceRecordSets({
+        ChangeBatch: {
+          Changes: [
+            {
+              Action: "DELETE",
+              ResourceRecordSet: existing,
+            },
+          ],
+        },
+        HostedZoneId: hostedZoneId,
+      })
+      .promise();
+    await waitForRecordChange(route53, changeBatch.ChangeInfo.Id);
+  }
+};
+
+const aliasRecord = function (alias, props) {
+  return {
+    Name: alias,
+    Type: "A",
+    AliasTarget: {
+      HostedZoneId: props.LoadBalancerHostedZone,
+      DNSName: props.LoadBalancerDNS,
+      EvaluateTargetHealth: true,
+    },
+  };
+};
+
+const waitForRecordChange = function (route53, changeId) {
+  return route53
+    .waitFor("resourceRecordSetsChanged", {
+      // Wait up to 5 minutes
+      $waiter: {
+        delay: 30,
+        maxAttempts: 10,
+      },
+      Id: changeId,
+    })
+    .promise();
+};
+
+const route53Client = function (roleArn) {
+  let route53 = new aws.Route53();
+  if (roleArn) {
+    route53 = new aws.Route53({
+      credentials: new aws.ChainableTemporaryCredentials({
+        params: { RoleArn: roleArn },
+        masterCredentials: new aws.EnvironmentCredentials("AWS"),
+      }),
+    });
+  }
+  if (waiter) {
+    // Used by the test suite, since waiters aren't mockable yet
+    route53.waitFor = waiter;
+  }
+  return route53;
+};
+
+/**
+ * Returns the aliases of the resource properties without duplicates.
+ */
+const aliasesOf = function (properties) {
+  const aliases = ((properties || {}).Aliases || []).map((alias) =>
+    alias.toLowerCase()
+  );
+  return [...new Set(aliases)];
+};
+
+/**
+ * Custom domain handler, invoked by Lambda. It points the aliases of a service to the environment's
+ * load balancer, and removes their records when the service is deleted.
+ */
+exports.handler = async function (event, context) {
+  var physicalResourceId;
+  try {
+    const props = event.ResourceProperties;
+    switch (event.RequestType) {
+      case "Create":
+        physicalResourceId = `custom-domain-${event.LogicalResourceId}`;
+        await upsertAliases(aliasesOf(props), props);
+        break;
+      case "Update": {
+        physicalResourceId = event.PhysicalResourceId;
+        await upsertAliases(aliasesOf(props), props);
+        const aliases = aliasesOf(props);
+        const removed = aliasesOf(event.OldResourceProperties).filter(
+          (alias) => !aliases.includes(alias)
+        );
+        await deleteAliases(removed, event.OldResourceProperties);
+        break;
+      }
+      case "Delete":
+        physicalResourceId = event.PhysicalResourceId;
+        await deleteAliases(aliasesOf(props), props);
+        break;
+      default:
+        throw new Error(`Unsupported request type ${event.RequestType}`);
+    }
+    await report(event, context, "SUCCESS", physicalResourceId);
+  } catch (err) {
+    console.log(`Caught error ${err}.`);
+    await report(
+      event,
+      context,
+      "FAILED",
+      physicalResourceId,
+      null,
+      err.message
+    );
+  }
+};
+
+/**
+ * @private
+ */
+exports.withDefaultResponseURL = function (url) {
+  defaultResponseURL = url;
+};
+
+/**
+ * @private
+ */
+exports.withWaiter = function (w) {
+  waiter = w;
+};
+
+/**
+ * @private
+ */
+exports.reset = function () {
+  waiter = undefined;
+};
@@ -82,9 +82,13 @@ let report = function (
  * `*.example.com`, the hosted zone ID must point to a Route 53 zone authoritative
  * for `example.com`.
  *
+ * If a root DNS role is provided, the validation records of the names outside of the environment's
+ * domain are created in the application's hosted zone by assuming the role instead.
+ *
  * @param {string} requestId the CloudFormation request ID
  * @param {string} domainName the Common Name (CN) field for the requested certificate
  * @param {string} hostedZoneId the Route53 Hosted Zone ID
+ * @param {object} [aliasZone] the EnvDomainName, AppDomainName and RootDNSRole to validate names outside of the hosted zone
  * @returns {string} Validated certificate ARN
  */
 const requestCertificate = async function (
@@ -92,7 +96,8 @@ const requestCertificate = async function (
   domainName,
   subjectAlternativeNames,
   hostedZoneId,
-  region
+  region,
+  aliasZone
 ) {
   const crypto = require("crypto");
   const [acm, route53] = clients(region);
@@ -109,17 +114,20 @@ const requestCertificate = async function (
     })
     .promise();
 
-  let record;
-  for (let attempt = 0; attempt < maxAttempts && !record; attempt++) {
+  let options;
+  for (let attempt = 0; attempt < maxAttempts && !options; attempt++) {
     const { Certificate } = await acm
       .describeCertificate({
         CertificateArn: reqCertResponse.CertificateArn,
       })
       .promise();
-    const options = Certificate.DomainValidationOptions || [];
+    const validationOptions = Certificate.DomainValidationOptions || [];
 
-    if (options.length > 0 && options[0].ResourceRecord) {
-      record = options[0].ResourceRecord;
+    if (
+      validationOptions.length > 0 &&
+      validationOptions.every((option) => option.ResourceRecord)
+    ) {
+      options = validationOptions;
     } else {
       // Exponential backoff with jitter based on 200ms base
       // component of backoff fixed to ensure minimum total wait time on
@@ -128,24 +136,38 @@ const requestCertificate = async function (
       await sleep(random() * base * 50 + base * 150);
     }
   }
-  if (!record) {
+  if (!options) {
     throw new Error(
       `DescribeCertificate did not contain DomainValidationOptions after ${maxAttempts} tries.`
     );
   }
 
-  console.log(
-    `Creating DNS record into zone ${hostedZoneId}: ${record.Name} ${record.Type} ${record.Value}`
-  );
-  const changeBatch = await updateRecords(
-    route53,
-    hostedZoneId,
-    "UPSERT",
-    record.Name,
-    record.Type,
-    record.Value
-  );
-  await waitForRecordChange(route53, changeBatch.ChangeInfo.Id);
+  // A domain name and its wildcard share the same validation record.
+  const created = new Set();
+  for (const option of options) {
+    const record = option.ResourceRecord;
+    if (created.has(record.Name)) {
+      continue;
+    }
+    created.add(record.Name);
+
+    let [zoneClient, zoneId] = [route53, hostedZoneId];
+    if (aliasZone && !isInDomain(option.DomainName, aliasZone.EnvDomainName)) {
+      [zoneClient, zoneId] = await appHostedZone(aliasZone);
+    }
+    console.log(
+      `Creating DNS record into zone ${zoneId}: ${record.Name} ${record.Type} ${record.Value}`
+    );
+    const changeBatch = await updateRecords(
+      zoneClient,
+      zoneId,
+      "UPSERT",
+      record.Name,
+      record.Type,
+      record.Value
+    );
+    await waitForRecordChange(zoneClient, changeBatch.ChangeInfo.Id);
+  }
 
   await acm
     .waitFor("certificateValidated", {
@@ -166,8 +188,14 @@ const requestCertificate = async function (
  * If the certificate does not exist, the function will return normally.
  *
  * @param {string} arn The certificate ARN
+ * @param {boolean} [keepRecord] true to keep the DNS validation record, since it can be shared with other certificates
  */
-const deleteCertificate = async function (arn, region, hostedZoneId) {
+const deleteCertificate = async function (
+  arn,
+  region,
+  hostedZoneId,
+  keepRecord
+) {
   const [acm, route53] = clients(region);
   try {
     console.log(`Waiting for certificate ${arn} to become unused`);
@@ -200,6 +228,7 @@ const deleteCertificate = async function (arn, region, hostedZoneId) {
 
     // Fetch the DNS Validation Record and delete it
     if (
+      !keepRecord &&
       dnsValidationRecord.length > 0 &&
       dnsValidationRecord[0].ResourceRecord
     ) {
@@ -229,6 +258,54 @@ const deleteCertificate = async function (arn, region, hostedZoneId) {
   }
 };
 
+/**
+ * Returns true if the name is the domain name or one of its subdomains.
+ *
+ * @param {string} name the name, which may be a wildcard
+ * @param {string} domain the domain name
+ */
+const isInDomain = function (name, domain) {
+  const bare = name.replace(/^\*\./, "").replace(/\.$/, "").toLowerCase();
+  domain = domain.replace(/\.$/, "").toLowerCase();
+  return bare === domain || bare.endsWith(`.${domain}`);
+};
+
+/**
+ * Returns a Route53 client that assumes the root DNS role, and the ID of the application's hosted zone.
+ *
+ * @param {object} aliasZone the AppDomainName and the RootDNSRole that can manage its hosted zone
+ */
+const appHostedZone = async function (aliasZone) {
+  const route53 = new aws.Route53({
+    credentials: new aws.ChainableTemporaryCredentials({
+      params: { RoleArn: aliasZone.RootDNSRole },
+      masterCredentials: new aws.EnvironmentCredentials("AWS"),
+    }),
+  });
+  if (waiter) {
+    // Used by the test suite, since waiters aren't mockable yet
+    route53.waitFor = waiter;
+  }
+  const { HostedZones } = await route53
+    .listHostedZonesByName({
+      DNSName: aliasZone.AppDomainName,
+      MaxItems: "1",
+    })
+    .promise();
+  if (
+    !HostedZones ||
+    HostedZones.length === 0 ||
+    HostedZones[0].Name.replace(/\.$/, "") !== aliasZone.AppDomainName
+  ) {
+    throw new Error(
+      `Couldn't find any hosted zone with DNS name ${aliasZone.AppDomainName}.`
+    );
+  }
+  // HostedZoneIDs are of the form /hostedzone/1234455, but the actual
+  // ID is after the last slash.
+  return [route53, HostedZones[0].Id.split("/").pop()];
+};
+
 const waitForRecordChange = function (route53, changeId) {
   return route53
     .waitFor("resourceRecordSetsChanged", {
@@ -286,6 +363,23 @@ const clients = function (region) {
   return [acm, route53];
 };
 
+/**
+ * Returns the properties to validate names outside of the hosted zone, or undefined if the
+ * certificate is only validated in the hosted zone.
+ *
+ * @param {object} props the resource properties of the certificate
+ */
+const aliasZone = function (props) {
+  if (!props.RootDNSRole) {
+    return undefined;
+  }
+  return {
+    EnvDomainName: props.EnvDomainName,
+    AppDomainName: props.AppDomainName,
+    RootDNSRole: props.RootDNSRole,
+  };
+};
+
 /**
  * Main certificate manager handler, invoked by Lambda
  */
@@ -303,7 +397,8 @@ exports.certificateRequestHandler = async function (event, context) {
           event.ResourceProperties.DomainName,
           event.ResourceProperties.SubjectAlternativeNames,
           event.ResourceProperties.HostedZoneId,
-          event.ResourceProperties.Region
+          event.ResourceProperties.Region,
+          aliasZone(event.ResourceProperties)
         );
         responseData.Arn = physicalResourceId = certificateArn;
         break;
@@ -311,11 +406,14 @@ exports.certificateRequestHandler = async function (event, context) {
         physicalResourceId = event.PhysicalResourceId;
         // If the resource didn't create correctly, the physical resource ID won't be the
         // certificate ARN, so don't try to delete it in that case.
+        // Certificates of custom domain names keep their validation records, because an update replaces
+        // the certificate with one for the same names that is validated by the same records.
         if (physicalResourceId.startsWith("arn:")) {
           await deleteCertificate(
             physicalResourceId,
             event.ResourceProperties.Region,
-            event.ResourceProperties.HostedZoneId
+            event.ResourceProperties.HostedZoneId,
+            !!aliasZone(event.ResourceProperties)
           );
         }
         break;
@@ -352,4 +352,106 @@ describe("ALB Rule Priority Generator", () => {
         expect(request.isDone()).toBe(true);
       });
   });
+
+  test("Create operation reserves priorities for additional rules before the main rule", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "5",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: false,
+          Actions: [
+            {
+              TargetGroupArn:
+                "arn:aws:elasticloadbalancing:us-west-2:000000000:targetgroup/tg",
+              Type: "forward",
+            },
+          ],
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Priority0 == 6 &&
+          body.Data.Priority1 == 7 &&
+          body.Data.Priority == 8
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          AdditionalRuleCount: "2",
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Update operation reserves new priorities if the number of additional rules changed", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "5",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: false,
+          Actions: [
+            {
+              TargetGroupArn:
+                "arn:aws:elasticloadbalancing:us-west-2:000000000:targetgroup/tg",
+              Type: "forward",
+            },
+          ],
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === "alb-rule-priority-HTTPRulePriorityAction" &&
+          body.Data.Priority0 == 6 &&
+          body.Data.Priority == 7
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        PhysicalResourceId: "alb-rule-priority-HTTPRulePriorityAction",
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          AdditionalRuleCount: "1",
+        },
+        OldResourceProperties: {
+          ListenerArn: testALBListenerArn,
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          describeRulesFake,
+          sinon.match({
+            ListenerArn: testALBListenerArn,
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
 });
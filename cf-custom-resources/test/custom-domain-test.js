@@ -0,0 +1,347 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("Custom Domain Handler", () => {
+  const AWS = require("aws-sdk-mock");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  const sinon = require("sinon");
+  const handler = require("../lib/custom-domain");
+  const nock = require("nock");
+  const ResponseURL = "https://cloudwatch-response-mock.example.com/";
+
+  let origLog = console.log;
+  const testRequestId = "f4ef1b10-c39a-44e3-99c0-fbf7e53c3943";
+  const testLBDNS = "my-app-publi-1a2b3c4d.us-west-2.elb.amazonaws.com";
+  const testProps = {
+    Aliases: ["api.test.my-app.example.com", "api.my-app.example.com"],
+    EnvDomainName: "test.my-app.example.com",
+    EnvHostedZoneId: "Z1ENVZONE",
+    AppDomainName: "my-app.example.com",
+    RootDNSRole: "arn:aws:iam::123456789012:role/my-app-DNSDelegationRole",
+    LoadBalancerDNS: testLBDNS,
+    LoadBalancerHostedZone: "Z1H1FL5HABSF5",
+  };
+  const lbRecord = function (name) {
+    return {
+      Name: `${name}.`,
+      Type: "A",
+      AliasTarget: {
+        HostedZoneId: "Z1H1FL5HABSF5",
+        DNSName: `dualstack.${testLBDNS}.`,
+        EvaluateTargetHealth: true,
+      },
+    };
+  };
+
+  beforeEach(() => {
+    handler.withDefaultResponseURL(ResponseURL);
+    handler.withWaiter(function () {
+      // Mock waiter is merely a self-fulfilling promise
+      return {
+        promise: () => {
+          return new Promise((resolve) => {
+            resolve();
+          });
+        },
+      };
+    });
+    console.log = function () {};
+  });
+  afterEach(() => {
+    handler.reset();
+    AWS.restore();
+    console.log = origLog;
+  });
+
+  test("Empty event payload fails", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason === "Unsupported request type undefined"
+        );
+      })
+      .reply(200);
+    return LambdaTester(handler.handler)
+      .event({})
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create operation points the aliases to the load balancer in their hosted zones", () => {
+    const listHostedZonesByNameFake = sinon.fake.resolves({
+      HostedZones: [{ Id: "/hostedzone/Z2APPZONE", Name: "my-app.example.com." }],
+    });
+    const listResourceRecordSetsFake = sinon.fake.resolves({
+      ResourceRecordSets: [],
+    });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: { Id: "bogus" },
+    });
+    AWS.mock("Route53", "listHostedZonesByName", listHostedZonesByNameFake);
+    AWS.mock("Route53", "listResourceRecordSets", listResourceRecordSetsFake);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === "custom-domain-CustomDomainAction"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(handler.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        LogicalResourceId: "CustomDomainAction",
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledTwice(changeResourceRecordSetsFake);
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: sinon.match({
+              Changes: [
+                {
+                  Action: "UPSERT",
+                  ResourceRecordSet: {
+                    Name: "api.test.my-app.example.com",
+                    Type: "A",
+                    AliasTarget: {
+                      HostedZoneId: "Z1H1FL5HABSF5",
+                      DNSName: testLBDNS,
+                      EvaluateTargetHealth: true,
+                    },
+                  },
+                },
+              ],
+            }),
+            HostedZoneId: "Z1ENVZONE",
+          })
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: sinon.match({
+              Changes: [
+                sinon.match({
+                  Action: "UPSERT",
+                  ResourceRecordSet: sinon.match({
+                    Name: "api.my-app.example.com",
+                  }),
+                }),
+              ],
+            }),
+            HostedZoneId: "Z2APPZONE",
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create operation fails if an alias already points somewhere else", () => {
+    const listResourceRecordSetsFake = sinon.fake.resolves({
+      ResourceRecordSets: [
+        {
+          Name: "api.test.my-app.example.com.",
+          Type: "A",
+          AliasTarget: {
+            HostedZoneId: "Z1H1FL5HABSF5",
+            DNSName: "other-lb.us-west-2.elb.amazonaws.com.",
+          },
+        },
+      ],
+    });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: { Id: "bogus" },
+    });
+    AWS.mock("Route53", "listResourceRecordSets", listResourceRecordSetsFake);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Alias api.test.my-app.example.com is already in use by another record in hosted zone Z1ENVZONE."
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(handler.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        LogicalResourceId: "CustomDomainAction",
+        ResourceProperties: {
+          ...testProps,
+          Aliases: ["api.test.my-app.example.com"],
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(changeResourceRecordSetsFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Update operation deletes the records of the removed aliases", () => {
+    const listResourceRecordSetsStub = sinon.stub();
+    listResourceRecordSetsStub
+      .withArgs(sinon.match({ StartRecordName: "api.test.my-app.example.com" }))
+      .resolves({
+        ResourceRecordSets: [lbRecord("api.test.my-app.example.com")],
+      });
+    listResourceRecordSetsStub
+      .withArgs(sinon.match({ StartRecordName: "v1.test.my-app.example.com" }))
+      .resolves({
+        ResourceRecordSets: [lbRecord("v1.test.my-app.example.com")],
+      });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: { Id: "bogus" },
+    });
+    AWS.mock("Route53", "listResourceRecordSets", listResourceRecordSetsStub);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === "custom-domain-CustomDomainAction"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(handler.handler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        PhysicalResourceId: "custom-domain-CustomDomainAction",
+        ResourceProperties: {
+          ...testProps,
+          Aliases: ["api.test.my-app.example.com"],
+        },
+        OldResourceProperties: {
+          ...testProps,
+          Aliases: ["api.test.my-app.example.com", "v1.test.my-app.example.com"],
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledTwice(changeResourceRecordSetsFake);
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: sinon.match({
+              Changes: [
+                sinon.match({
+                  Action: "UPSERT",
+                  ResourceRecordSet: sinon.match({
+                    Name: "api.test.my-app.example.com",
+                  }),
+                }),
+              ],
+            }),
+          })
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: {
+              Changes: [
+                {
+                  Action: "DELETE",
+                  ResourceRecordSet: lbRecord("v1.test.my-app.example.com"),
+                },
+              ],
+            },
+            HostedZoneId: "Z1ENVZONE",
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Delete operation only deletes the records that point to the load balancer", () => {
+    const listHostedZonesByNameFake = sinon.fake.resolves({
+      HostedZones: [{ Id: "/hostedzone/Z2APPZONE", Name: "my-app.example.com." }],
+    });
+    const listResourceRecordSetsStub = sinon.stub();
+    listResourceRecordSetsStub
+      .withArgs(sinon.match({ StartRecordName: "api.test.my-app.example.com" }))
+      .resolves({
+        ResourceRecordSets: [lbRecord("api.test.my-app.example.com")],
+      });
+    listResourceRecordSetsStub
+      .withArgs(sinon.match({ StartRecordName: "api.my-app.example.com" }))
+      .resolves({
+        ResourceRecordSets: [
+          {
+            Name: "api.my-app.example.com.",
+            Type: "A",
+            AliasTarget: {
+              HostedZoneId: "Z1H1FL5HABSF5",
+              DNSName: "other-lb.us-west-2.elb.amazonaws.com.",
+            },
+          },
+        ],
+      });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: { Id: "bogus" },
+    });
+    AWS.mock("Route53", "listHostedZonesByName", listHostedZonesByNameFake);
+    AWS.mock("Route53", "listResourceRecordSets", listResourceRecordSetsStub);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(handler.handler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        PhysicalResourceId: "custom-domain-CustomDomainAction",
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledOnce(changeResourceRecordSetsFake);
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: {
+              Changes: [
+                {
+                  Action: "DELETE",
+                  ResourceRecordSet: lbRecord("api.test.my-app.example.com"),
+                },
+              ],
+            },
+            HostedZoneId: "Z1ENVZONE",
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+});
@@ -172,6 +172,132 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Create operation validates custom domain names outside of the environment in the application's hosted zone", () => {
+    const testAppRRName = "_8c15d1f5e8b6aa8b0d2ce4ec8d4a2c37.api.my-app.example.com";
+    const testAppRRValue = "_x3.acm-validations.aws";
+    const requestCertificateFake = sinon.fake.resolves({
+      CertificateArn: testCertificateArn,
+    });
+    const describeCertificateFake = sinon.fake.resolves({
+      Certificate: {
+        CertificateArn: testCertificateArn,
+        DomainValidationOptions: [
+          {
+            DomainName: "api.test.my-app.example.com",
+            ValidationStatus: "PENDING_VALIDATION",
+            ResourceRecord: {
+              Name: testRRName,
+              Type: "CNAME",
+              Value: testRRValue,
+            },
+          },
+          {
+            DomainName: "api.my-app.example.com",
+            ValidationStatus: "PENDING_VALIDATION",
+            ResourceRecord: {
+              Name: testAppRRName,
+              Type: "CNAME",
+              Value: testAppRRValue,
+            },
+          },
+        ],
+      },
+    });
+    const listHostedZonesByNameFake = sinon.fake.resolves({
+      HostedZones: [
+        {
+          Id: "/hostedzone/Z2APPZONE",
+          Name: "my-app.example.com.",
+        },
+      ],
+    });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: "bogus",
+      },
+    });
+
+    AWS.mock("ACM", "requestCertificate", requestCertificateFake);
+    AWS.mock("ACM", "describeCertificate", describeCertificateFake);
+    AWS.mock("Route53", "listHostedZonesByName", listHostedZonesByNameFake);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(handler.certificateRequestHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          DomainName: "api.test.my-app.example.com",
+          SubjectAlternativeNames: ["api.my-app.example.com"],
+          HostedZoneId: testHostedZoneId,
+          Region: "us-east-1",
+          EnvDomainName: "test.my-app.example.com",
+          AppDomainName: "my-app.example.com",
+          RootDNSRole: "arn:aws:iam::123456789012:role/my-app-DNSDelegationRole",
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          requestCertificateFake,
+          sinon.match({
+            DomainName: "api.test.my-app.example.com",
+            SubjectAlternativeNames: ["api.my-app.example.com"],
+            ValidationMethod: "DNS",
+          })
+        );
+        sinon.assert.calledWith(
+          listHostedZonesByNameFake,
+          sinon.match({
+            DNSName: "my-app.example.com",
+          })
+        );
+        sinon.assert.calledTwice(changeResourceRecordSetsFake);
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: {
+              Changes: [
+                sinon.match({
+                  Action: "UPSERT",
+                  ResourceRecordSet: sinon.match({
+                    Name: testRRName,
+                  }),
+                }),
+              ],
+            },
+            HostedZoneId: testHostedZoneId,
+          })
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({
+            ChangeBatch: {
+              Changes: [
+                sinon.match({
+                  Action: "UPSERT",
+                  ResourceRecordSet: sinon.match({
+                    Name: testAppRRName,
+                  }),
+                }),
+              ],
+            },
+            HostedZoneId: "Z2APPZONE",
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Create operation fails after more than 60s if certificate has no DomainValidationOptions", () => {
     handler.withRandom(() => 0);
     const requestCertificateFake = sinon.fake.resolves({
@@ -539,6 +665,69 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Delete operation keeps the validation records of custom domain names", () => {
+    const describeCertificateFake = sinon.fake.resolves({
+      Certificate: {
+        CertificateArn: testCertificateArn,
+        InUseBy: [],
+        DomainValidationOptions: [
+          {
+            DomainName: "api.my-app.example.com",
+            ValidationStatus: "SUCCESS",
+            ResourceRecord: {
+              Name: testRRName,
+              Type: "CNAME",
+              Value: testRRValue,
+            },
+          },
+        ],
+      },
+    });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: "bogus",
+      },
+    });
+    const deleteCertificateFake = sinon.fake.resolves({});
+    AWS.mock("ACM", "describeCertificate", describeCertificateFake);
+    AWS.mock("ACM", "deleteCertificate", deleteCertificateFake);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(handler.certificateRequestHandler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        PhysicalResourceId: testCertificateArn,
+        ResourceProperties: {
+          HostedZoneId: testHostedZoneId,
+          Region: "us-east-1",
+          EnvDomainName: "test.my-app.example.com",
+          AppDomainName: "my-app.example.com",
+          RootDNSRole: "arn:aws:iam::123456789012:role/my-app-DNSDelegationRole",
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          deleteCertificateFake,
+          sinon.match({
+            CertificateArn: testCertificateArn,
+          })
+        );
+        sinon.assert.notCalled(changeResourceRecordSetsFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Delete operation fails within 360s and 10 attempts if certificate is in-use", () => {
     const usedByArn =
       "arn:aws:cloudfront::123456789012:distribution/d111111abcdef8";
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
@@ -22,10 +23,25 @@ func init() {
 
 func main() {
 	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	if err := cli.RegisterFlagCompletions(cmd); err != nil {
 		log.Errorln(err.Error())
 		os.Exit(1)
 	}
+	if err := cmd.Execute(); err != nil {
+		log.Errorln(err.Error())
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode returns the exit code attached to the error by the command, or 1 if there is none.
+func exitCode(err error) int {
+	var exitCoder interface {
+		ExitCode() int
+	}
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+	return 1
 }
 
 func buildRootCmd() *cobra.Command {
@@ -38,6 +54,7 @@ func buildRootCmd() *cobra.Command {
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			// If we don't set a Run() function the help menu doesn't show up.
 			// See https://github.com/spf13/cobra/issues/790
+			cli.ApplyGlobalFlags()
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -47,6 +64,7 @@ func buildRootCmd() *cobra.Command {
 	// version information.
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("copilot version: {{.Version}}\n")
+	cli.AddGlobalFlags(cmd)
 
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
@@ -26,7 +26,15 @@ var RootUsage = fmt.Sprintf("{{h1 \"Commands\"}}{{ $cmds := .Commands }}{{$group
 {{.LocalFlags.FlagUsages | trimTrailingWhitespaces}}{{end}}{{if .HasAvailableInheritedFlags}}
 
 {{h1 "Global Flags"}}
-{{.InheritedFlags.FlagUsages | trimTrailingWhitespaces}}{{end}}{{if .HasExample}}
+{{.InheritedFlags.FlagUsages | trimTrailingWhitespaces}}{{end}}
+
+{{h1 "Exit Codes"}}
+  0  The command succeeded.
+  1  The command failed.
+  2  The application, environment, service or job doesn't exist.
+  3  The request was throttled by AWS and can be retried.
+  4  The AWS credentials are missing, invalid or expired.
+  5  A flag or argument has an invalid value.{{if .HasExample}}
 
 {{h1 "Examples"}}{{code .Example}}{{end}}
 `
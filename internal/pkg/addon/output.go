@@ -5,12 +5,27 @@ package addon
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/awslabs/goformation/v4"
 	"github.com/awslabs/goformation/v4/cloudformation"
 	"github.com/awslabs/goformation/v4/cloudformation/iam"
 	"github.com/awslabs/goformation/v4/cloudformation/secretsmanager"
 	"github.com/awslabs/goformation/v4/intrinsics"
+	"gopkg.in/yaml.v3"
+)
+
+// Types that a resource can be annotated with under "Metadata.copilot.type" to classify the outputs referring to it.
+const (
+	secretOutputType        = "secret"
+	variableOutputType      = "variable"
+	securityGroupOutputType = "security-group"
+)
+
+// Resource types whose "Ref" can be injected as a secret.
+const (
+	secretsManagerSecretResourceType = "AWS::SecretsManager::Secret"
+	ssmParameterResourceType         = "AWS::SSM::Parameter"
 )
 
 // Output represents an output from a CloudFormation template.
@@ -21,9 +36,13 @@ type Output struct {
 	IsSecret bool
 	// IsManagedPolicy is true if the output value refers to an IAM ManagedPolicy ARN. Otherwise, false.
 	IsManagedPolicy bool
+	// IsSecurityGroup is true if the output value refers to a resource annotated as a security group. Otherwise, false.
+	IsSecurityGroup bool
 }
 
 // Outputs parses the Outputs section of a CloudFormation template to extract logical IDs and returns them.
+// An output is classified by the "Metadata.copilot.type" of the resource it refers to if there is one,
+// otherwise by the type of the resource.
 func Outputs(template string) ([]Output, error) {
 	// goformation needs to evaluate CFN intrinsic functions to render the template.
 	// However, by default "Ref" evaluates to nil and results in the deletion of the field.
@@ -46,17 +65,104 @@ func Outputs(template string) ([]Output, error) {
 		return nil, fmt.Errorf("parse CloudFormation template %s: %w", template, err)
 	}
 
+	annotated, err := annotatedOutputTypes(template)
+	if err != nil {
+		return nil, err
+	}
+
 	var outputs []Output
 	for logicalID, output := range tpl.Outputs {
-		outputs = append(outputs, Output{
+		out := Output{
 			Name:            logicalID,
 			IsSecret:        isSecret(output, tpl.GetAllSecretsManagerSecretResources()),
 			IsManagedPolicy: isManagedPolicy(output, tpl.GetAllIAMManagedPolicyResources()),
-		})
+		}
+		if outputType, ok := annotated[logicalID]; ok {
+			out.IsSecret = outputType == secretOutputType
+			out.IsManagedPolicy = false
+			out.IsSecurityGroup = outputType == securityGroupOutputType
+		}
+		outputs = append(outputs, out)
 	}
 	return outputs, nil
 }
 
+// annotatedOutputTypes returns the type of each output that refers to a resource annotated with "Metadata.copilot.type".
+// Outputs that refer to resources without a known annotation are left out.
+func annotatedOutputTypes(template string) (map[string]string, error) {
+	var tpl struct {
+		Resources map[string]struct {
+			Type     string    `yaml:"Type"`
+			Metadata yaml.Node `yaml:"Metadata"`
+		} `yaml:"Resources"`
+		Outputs map[string]struct {
+			Value yaml.Node `yaml:"Value"`
+		} `yaml:"Outputs"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal CloudFormation template: %w", err)
+	}
+	types := make(map[string]string)
+	for name, output := range tpl.Outputs {
+		logicalID, isRef := referredResource(&output.Value)
+		resource, ok := tpl.Resources[logicalID]
+		if !ok || resource.Metadata.Kind != yaml.MappingNode {
+			continue
+		}
+		var metadata struct {
+			Copilot struct {
+				Type string `yaml:"type"`
+			} `yaml:"copilot"`
+		}
+		if err := resource.Metadata.Decode(&metadata); err != nil {
+			continue // The metadata isn't meant for Copilot.
+		}
+		switch outputType := metadata.Copilot.Type; outputType {
+		case secretOutputType:
+			if !isRef || (resource.Type != secretsManagerSecretResourceType && resource.Type != ssmParameterResourceType) {
+				return nil, fmt.Errorf("output %s refers to resource %s that is annotated as a secret, but its value isn't the !Ref of an %s or %s",
+					name, logicalID, secretsManagerSecretResourceType, ssmParameterResourceType)
+			}
+			types[name] = outputType
+		case variableOutputType, securityGroupOutputType:
+			types[name] = outputType
+		}
+	}
+	return types, nil
+}
+
+// referredResource returns the logical ID of the resource that an output value refers to with "Ref" or "Fn::GetAtt",
+// and whether it's referred to with "Ref".
+func referredResource(value *yaml.Node) (logicalID string, isRef bool) {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		switch value.Tag {
+		case "!Ref":
+			return value.Value, true
+		case "!GetAtt":
+			return strings.SplitN(value.Value, ".", 2)[0], false
+		}
+	case yaml.SequenceNode:
+		if value.Tag == "!GetAtt" && len(value.Content) > 0 {
+			return value.Content[0].Value, false
+		}
+	case yaml.MappingNode:
+		if len(value.Content) != 2 {
+			return "", false
+		}
+		key, val := value.Content[0].Value, value.Content[1]
+		switch {
+		case key == "Ref" && val.Kind == yaml.ScalarNode:
+			return val.Value, true
+		case key == "Fn::GetAtt" && val.Kind == yaml.ScalarNode:
+			return strings.SplitN(val.Value, ".", 2)[0], false
+		case key == "Fn::GetAtt" && val.Kind == yaml.SequenceNode && len(val.Content) > 0:
+			return val.Content[0].Value, false
+		}
+	}
+	return "", false
+}
+
 func isSecret(output cloudformation.Output, secrets map[string]*secretsmanager.Secret) bool {
 	value, ok := output.Value.(string)
 	if !ok {
@@ -4,6 +4,7 @@
 package addon
 
 import (
+	"errors"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -41,6 +42,34 @@ func TestOutputs(t *testing.T) {
 				},
 			},
 		},
+		"classifies outputs by the metadata of the resources they refer to": {
+			testdataFileName: "annotated.yml",
+
+			wantedOut: []Output{
+				{
+					Name: "MyDBSecretArn",
+				},
+				{
+					Name:     "MyDBPasswordParameterName",
+					IsSecret: true,
+				},
+				{
+					Name:            "MyDBClientSecurityGroupId",
+					IsSecurityGroup: true,
+				},
+				{
+					Name: "MyDBEndpoint",
+				},
+				{
+					Name: "MyBucketName",
+				},
+			},
+		},
+		"returns error if an output annotated as a secret isn't a secret": {
+			testdataFileName: "invalid-secret.yml",
+
+			wantedErr: errors.New("output MyDBEndpoint refers to resource MyDBInstance that is annotated as a secret, but its value isn't the !Ref of an AWS::SecretsManager::Secret or AWS::SSM::Parameter"),
+		},
 	}
 
 	for name, tc := range testCases {
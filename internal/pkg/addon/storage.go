@@ -58,6 +58,10 @@ type DynamoDBProps struct {
 	SortKey      *string
 	PartitionKey *string
 	HasLSI       bool
+
+	TTLAttribute   *string                 // Name of the attribute holding the expiry time of the items. No TTL if nil.
+	Capacity       *DDBProvisionedCapacity // Throughput of a provisioned table. The table is on-demand if nil.
+	StreamViewType *string                 // One of "KEYS_ONLY", "NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES". No stream if nil.
 }
 
 // DDBAttribute holds the attribute definition of a DynamoDB attribute (keys, local secondary indices).
@@ -66,6 +70,12 @@ type DDBAttribute struct {
 	DataType *string // Must be one of "N", "S", "B"
 }
 
+// DDBProvisionedCapacity holds the read and write capacity units of a provisioned table.
+type DDBProvisionedCapacity struct {
+	ReadCapacityUnits  int
+	WriteCapacityUnits int
+}
+
 // DDBLocalSecondaryIndex holds a representation of an LSI.
 type DDBLocalSecondaryIndex struct {
 	PartitionKey *string
@@ -8,8 +8,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
@@ -62,6 +65,72 @@ func TestDynamoDB_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestDynamoDB_MarshalBinary_Template(t *testing.T) {
+	newProps := func() DynamoDBProps {
+		return DynamoDBProps{
+			StorageProps: &StorageProps{
+				Name: "my-table",
+			},
+			Attributes: []DDBAttribute{
+				{
+					Name:     aws.String("email"),
+					DataType: aws.String("S"),
+				},
+			},
+			PartitionKey: aws.String("email"),
+		}
+	}
+	testCases := map[string]struct {
+		inProps func(p *DynamoDBProps)
+
+		wantedTestData string
+	}{
+		"on-demand table": {
+			inProps:        func(p *DynamoDBProps) {},
+			wantedTestData: "ddb-on-demand.yml",
+		},
+		"table with TTL": {
+			inProps: func(p *DynamoDBProps) {
+				p.TTLAttribute = aws.String("expiresAt")
+			},
+			wantedTestData: "ddb-ttl.yml",
+		},
+		"provisioned table": {
+			inProps: func(p *DynamoDBProps) {
+				p.Capacity = &DDBProvisionedCapacity{
+					ReadCapacityUnits:  5,
+					WriteCapacityUnits: 10,
+				}
+			},
+			wantedTestData: "ddb-provisioned.yml",
+		},
+		"table with a stream": {
+			inProps: func(p *DynamoDBProps) {
+				p.StreamViewType = aws.String("NEW_AND_OLD_IMAGES")
+			},
+			wantedTestData: "ddb-stream.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			wanted, err := ioutil.ReadFile(filepath.Join("testdata", "storage", tc.wantedTestData))
+			require.NoError(t, err)
+			props := newProps()
+			tc.inProps(&props)
+			ddb := NewDynamoDB(&props)
+
+			// WHEN
+			b, err := ddb.MarshalBinary()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, string(wanted), string(b))
+		})
+	}
+}
+
 func TestS3_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		mockDependencies func(ctrl *gomock.Controller, s3 *S3)
@@ -6,6 +6,7 @@ package aas
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -17,10 +18,30 @@ const (
 	// ECS service resource ID format: service/${clusterName}/${serviceName}.
 	fmtECSResourceID    = "service/%s/%s"
 	ecsServiceNamespace = "ecs"
+	ecsServiceDimension = "ecs:service:DesiredCount"
 )
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+	RegisterScalableTarget(input *aas.RegisterScalableTargetInput) (*aas.RegisterScalableTargetOutput, error)
+	DescribeScalingActivities(input *aas.DescribeScalingActivitiesInput) (*aas.DescribeScalingActivitiesOutput, error)
+}
+
+// Capacity is the range of tasks that Application Auto Scaling keeps an ECS service in.
+type Capacity struct {
+	Min int64
+	Max int64
+}
+
+// ScalingActivity is a scale-out or scale-in activity of an ECS service.
+type ScalingActivity struct {
+	Description   string     `json:"description"`
+	Cause         string     `json:"cause"`
+	Status        string     `json:"status"` // Such as "InProgress", "Successful" or "Failed".
+	StatusMessage string     `json:"statusMessage,omitempty"`
+	StartTime     time.Time  `json:"startTime"`
+	EndTime       *time.Time `json:"endTime,omitempty"` // Nil if the activity isn't over.
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +83,64 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// ECSServiceCapacity returns the capacity of the scalable target of the ECS service,
+// or nil if the service doesn't autoscale.
+func (a *ApplicationAutoscaling) ECSServiceCapacity(cluster, service string) (*Capacity, error) {
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(fmtECSResourceID, cluster, service)}),
+		ScalableDimension: aws.String(ecsServiceDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable targets for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := resp.ScalableTargets[0]
+	return &Capacity{
+		Min: aws.Int64Value(target.MinCapacity),
+		Max: aws.Int64Value(target.MaxCapacity),
+	}, nil
+}
+
+// UpdateECSServiceCapacity sets the capacity of the scalable target of the ECS service.
+func (a *ApplicationAutoscaling) UpdateECSServiceCapacity(cluster, service string, capacity Capacity) error {
+	_, err := a.client.RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+		ResourceId:        aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension: aws.String(ecsServiceDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		MinCapacity:       aws.Int64(capacity.Min),
+		MaxCapacity:       aws.Int64(capacity.Max),
+	})
+	if err != nil {
+		return fmt.Errorf("update capacity of ECS service %s/%s: %w", cluster, service, err)
+	}
+	return nil
+}
+
+// ECSServiceScalingActivities returns up to limit of the most recent scaling activities of the ECS service, newest first.
+func (a *ApplicationAutoscaling) ECSServiceScalingActivities(cluster, service string, limit int) ([]ScalingActivity, error) {
+	resp, err := a.client.DescribeScalingActivities(&aas.DescribeScalingActivitiesInput{
+		ResourceId:        aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension: aws.String(ecsServiceDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		MaxResults:        aws.Int64(int64(limit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scaling activities for ECS service %s/%s: %w", cluster, service, err)
+	}
+	activities := make([]ScalingActivity, len(resp.ScalingActivities))
+	for i, activity := range resp.ScalingActivities {
+		activities[i] = ScalingActivity{
+			Description:   aws.StringValue(activity.Description),
+			Cause:         aws.StringValue(activity.Cause),
+			Status:        aws.StringValue(activity.StatusCode),
+			StatusMessage: aws.StringValue(activity.StatusMessage),
+			StartTime:     aws.TimeValue(activity.StartTime),
+			EndTime:       activity.EndTime,
+		}
+	}
+	return activities, nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -139,3 +140,202 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr      error
+		wantCapacity *Capacity
+	}{
+		"errors if failed to describe scalable targets": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("describe scalable targets for ECS service mockCluster/mockService: some error"),
+		},
+		"returns nil if the service doesn't autoscale": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+					ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+				}).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(2),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wantCapacity: &Capacity{Min: 2, Max: 10},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{client: mockClient})
+			aasSvc := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceCapacity("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantCapacity, got)
+			}
+		})
+	}
+}
+
+func TestApplicationAutoscaling_UpdateECSServiceCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr error
+	}{
+		"errors if failed to register the scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().RegisterScalableTarget(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("update capacity of ECS service mockCluster/mockService: some error"),
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+					ResourceId:        aws.String("service/mockCluster/mockService"),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+					MinCapacity:       aws.Int64(0),
+					MaxCapacity:       aws.Int64(0),
+				}).Return(&aas.RegisterScalableTargetOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{client: mockClient})
+			aasSvc := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := aasSvc.UpdateECSServiceCapacity("mockCluster", "mockService", Capacity{})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplicationAutoscaling_ECSServiceScalingActivities(t *testing.T) {
+	startTime := time.Date(2020, time.November, 23, 9, 0, 0, 0, time.UTC)
+	endTime := time.Date(2020, time.November, 23, 9, 2, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr        error
+		wantActivities []ScalingActivity
+	}{
+		"errors if failed to describe the scaling activities": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalingActivities(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("describe scaling activities for ECS service mockCluster/mockService: some error"),
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalingActivities(&aas.DescribeScalingActivitiesInput{
+					ResourceId:        aws.String("service/mockCluster/mockService"),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+					MaxResults:        aws.Int64(5),
+				}).Return(&aas.DescribeScalingActivitiesOutput{
+					ScalingActivities: []*aas.ScalingActivity{
+						{
+							Description: aws.String("Setting desired count to 3."),
+							Cause:       aws.String("monitor alarm mockAlarm in state ALARM triggered policy mockPolicy"),
+							StatusCode:  aws.String("InProgress"),
+							StartTime:   aws.Time(endTime),
+						},
+						{
+							Description:   aws.String("Setting desired count to 2."),
+							Cause:         aws.String("monitor alarm mockAlarm in state ALARM triggered policy mockPolicy"),
+							StatusCode:    aws.String("Successful"),
+							StatusMessage: aws.String("Successfully set desired count to 2."),
+							StartTime:     aws.Time(startTime),
+							EndTime:       aws.Time(endTime),
+						},
+					},
+				}, nil)
+			},
+			wantActivities: []ScalingActivity{
+				{
+					Description: "Setting desired count to 3.",
+					Cause:       "monitor alarm mockAlarm in state ALARM triggered policy mockPolicy",
+					Status:      "InProgress",
+					StartTime:   endTime,
+				},
+				{
+					Description:   "Setting desired count to 2.",
+					Cause:         "monitor alarm mockAlarm in state ALARM triggered policy mockPolicy",
+					Status:        "Successful",
+					StatusMessage: "Successfully set desired count to 2.",
+					StartTime:     startTime,
+					EndTime:       aws.Time(endTime),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{client: mockClient})
+			aasSvc := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceScalingActivities("mockCluster", "mockService", 5)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantActivities, got)
+			}
+		})
+	}
+}
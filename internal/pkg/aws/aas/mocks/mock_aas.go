@@ -47,3 +47,48 @@ func (mr *MockapiMockRecorder) DescribeScalingPolicies(input interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalingPolicies", reflect.TypeOf((*Mockapi)(nil).DescribeScalingPolicies), input)
 }
+
+// DescribeScalableTargets mocks base method
+func (m *Mockapi) DescribeScalableTargets(input *applicationautoscaling.DescribeScalableTargetsInput) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScalableTargets", input)
+	ret0, _ := ret[0].(*applicationautoscaling.DescribeScalableTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScalableTargets indicates an expected call of DescribeScalableTargets
+func (mr *MockapiMockRecorder) DescribeScalableTargets(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalableTargets", reflect.TypeOf((*Mockapi)(nil).DescribeScalableTargets), input)
+}
+
+// RegisterScalableTarget mocks base method
+func (m *Mockapi) RegisterScalableTarget(input *applicationautoscaling.RegisterScalableTargetInput) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterScalableTarget", input)
+	ret0, _ := ret[0].(*applicationautoscaling.RegisterScalableTargetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterScalableTarget indicates an expected call of RegisterScalableTarget
+func (mr *MockapiMockRecorder) RegisterScalableTarget(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterScalableTarget", reflect.TypeOf((*Mockapi)(nil).RegisterScalableTarget), input)
+}
+
+// DescribeScalingActivities mocks base method
+func (m *Mockapi) DescribeScalingActivities(input *applicationautoscaling.DescribeScalingActivitiesInput) (*applicationautoscaling.DescribeScalingActivitiesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScalingActivities", input)
+	ret0, _ := ret[0].(*applicationautoscaling.DescribeScalingActivitiesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScalingActivities indicates an expected call of DescribeScalingActivities
+func (mr *MockapiMockRecorder) DescribeScalingActivities(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalingActivities", reflect.TypeOf((*Mockapi)(nil).DescribeScalingActivities), input)
+}
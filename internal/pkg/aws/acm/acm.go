@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acm provides a client to make API requests to AWS Certificate Manager.
+package acm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+)
+
+type api interface {
+	DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+}
+
+// ACM wraps an AWS Certificate Manager client.
+type ACM struct {
+	client api
+}
+
+// New returns an ACM client configured against the input session.
+func New(s *session.Session) *ACM {
+	return &ACM{
+		client: acm.New(s),
+	}
+}
+
+// ValidateCertAliases returns an error if one of the aliases isn't covered by any of the certificates.
+// A certificate covers its domain name and its subject alternative names, and a wildcard name such as
+// "*.example.com" covers a single level of subdomains.
+func (a *ACM) ValidateCertAliases(aliases []string, certARNs []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+	var domains []string
+	seen := make(map[string]bool)
+	for _, certARN := range certARNs {
+		names, err := a.domainNames(certARN)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			domains = append(domains, name)
+		}
+	}
+	for _, alias := range aliases {
+		if !isCovered(alias, domains) {
+			return fmt.Errorf("alias %s is not covered by any of the imported certificates, which are valid for: %s",
+				alias, strings.Join(domains, ", "))
+		}
+	}
+	return nil
+}
+
+// domainNames returns the domain name and the subject alternative names of the certificate.
+func (a *ACM) domainNames(certARN string) ([]string, error) {
+	out, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe certificate %s: %w", certARN, err)
+	}
+	names := []string{aws.StringValue(out.Certificate.DomainName)}
+	for _, san := range aws.StringValueSlice(out.Certificate.SubjectAlternativeNames) {
+		// The subject alternative names include the domain name of the certificate.
+		if san != names[0] {
+			names = append(names, san)
+		}
+	}
+	return names, nil
+}
+
+// isCovered returns true if the alias matches one of the domain names of a certificate.
+func isCovered(alias string, domains []string) bool {
+	alias = strings.ToLower(strings.TrimSuffix(alias, "."))
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if domain == alias {
+			return true
+		}
+		if !strings.HasPrefix(domain, "*.") {
+			continue
+		}
+		// A wildcard only matches the leftmost label of the alias.
+		i := strings.Index(alias, ".")
+		if i != -1 && alias[i:] == domain[1:] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package acm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACM_ValidateCertAliases(t *testing.T) {
+	const (
+		mockCertARN      = "arn:aws:acm:us-west-2:123456789012:certificate/mockCert"
+		mockOtherCertARN = "arn:aws:acm:us-west-2:123456789012:certificate/mockOtherCert"
+	)
+	describeCerts := func(m *mocks.Mockapi) {
+		m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(mockCertARN),
+		}).Return(&acm.DescribeCertificateOutput{
+			Certificate: &acm.CertificateDetail{
+				DomainName:              aws.String("example.com"),
+				SubjectAlternativeNames: aws.StringSlice([]string{"example.com", "*.example.com"}),
+			},
+		}, nil)
+		m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(mockOtherCertARN),
+		}).Return(&acm.DescribeCertificateOutput{
+			Certificate: &acm.CertificateDetail{
+				DomainName: aws.String("api.example.org"),
+			},
+		}, nil)
+	}
+	testCases := map[string]struct {
+		aliases    []string
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"no-op if there are no aliases": {
+			mockClient: func(m *mocks.Mockapi) {},
+		},
+		"wraps the error from the client": {
+			aliases: []string{"example.com"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe certificate arn:aws:acm:us-west-2:123456789012:certificate/mockCert: some error"),
+		},
+		"aliases covered by domain names, alternative names and wildcards": {
+			aliases:    []string{"example.com", "Www.Example.com.", "api.example.org"},
+			mockClient: describeCerts,
+		},
+		"wildcards only cover one level of subdomains": {
+			aliases:    []string{"v1.api.example.com"},
+			mockClient: describeCerts,
+			wantedErr:  errors.New("alias v1.api.example.com is not covered by any of the imported certificates, which are valid for: example.com, *.example.com, api.example.org"),
+		},
+		"error if an alias isn't covered": {
+			aliases:    []string{"example.com", "example.org"},
+			mockClient: describeCerts,
+			wantedErr:  errors.New("alias example.org is not covered by any of the imported certificates, which are valid for: example.com, *.example.com, api.example.org"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ACM{
+				client: m,
+			}
+
+			// WHEN
+			err := client.ValidateCertAliases(tc.aliases, []string{mockCertARN, mockOtherCertARN})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package cloudformation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -86,8 +87,9 @@ func (cs *changeSet) String() string {
 }
 
 // create creates a ChangeSet, waits until it's created, and returns the ChangeSet ID on success.
-func (cs *changeSet) create(conf *stackConfig) error {
-	out, err := cs.client.CreateChangeSet(&cloudformation.CreateChangeSetInput{
+// If the context is canceled while waiting, the ChangeSet is deleted and an ErrStackDeployInterrupted is returned.
+func (cs *changeSet) create(ctx context.Context, conf *stackConfig) error {
+	in := &cloudformation.CreateChangeSetInput{
 		ChangeSetName: aws.String(cs.name),
 		StackName:     aws.String(cs.stackName),
 		ChangeSetType: aws.String(cs.csType.String()),
@@ -100,14 +102,23 @@ func (cs *changeSet) create(conf *stackConfig) error {
 			cloudformation.CapabilityCapabilityNamedIam,
 			cloudformation.CapabilityCapabilityAutoExpand,
 		}),
-	})
+	}
+	if conf.UsePreviousTemplate {
+		in.TemplateBody = nil
+		in.UsePreviousTemplate = aws.Bool(true)
+	}
+	out, err := cs.client.CreateChangeSet(in)
 	if err != nil {
 		return fmt.Errorf("create %s: %w", cs, err)
 	}
-	err = cs.client.WaitUntilChangeSetCreateCompleteWithContext(context.Background(), &cloudformation.DescribeChangeSetInput{
+	err = cs.client.WaitUntilChangeSetCreateCompleteWithContext(ctx, &cloudformation.DescribeChangeSetInput{
 		ChangeSetName: out.Id,
 	}, waiters...)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			cs.name = aws.StringValue(out.Id)
+			return cs.interrupted(ctxErr)
+		}
 		return fmt.Errorf("wait for creation of %s: %w", cs, err)
 	}
 
@@ -180,8 +191,13 @@ func (cs *changeSet) execute() error {
 
 // createAndExecute calls create and then execute.
 // If the change set is empty, returns a ErrChangeSetEmpty.
-func (cs *changeSet) createAndExecute(conf *stackConfig) error {
-	if err := cs.create(conf); err != nil {
+// If the context is canceled before the change set is executed, deletes it and returns an ErrStackDeployInterrupted.
+func (cs *changeSet) createAndExecute(ctx context.Context, conf *stackConfig) error {
+	if err := cs.create(ctx, conf); err != nil {
+		var interrupted *ErrStackDeployInterrupted
+		if errors.As(err, &interrupted) {
+			return err
+		}
 		// It's possible that there are no changes between the previous and proposed stack change sets.
 		// We make a call to describe the change set to see if that is indeed the case and handle it gracefully.
 		descr, descrErr := cs.describe()
@@ -200,9 +216,24 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return err
 	}
+	if err := ctx.Err(); err != nil {
+		return cs.interrupted(err)
+	}
 	return cs.execute()
 }
 
+// interrupted deletes the change set, which wasn't executed, so that it isn't left behind on the stack.
+func (cs *changeSet) interrupted(cause error) error {
+	err := &ErrStackDeployInterrupted{
+		Name: cs.stackName,
+		err:  cause,
+	}
+	if deleteErr := cs.delete(); deleteErr != nil {
+		return fmt.Errorf("%w: %v", err, deleteErr)
+	}
+	return err
+}
+
 // delete removes the change set.
 func (cs *changeSet) delete() error {
 	_, err := cs.client.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
@@ -34,18 +34,26 @@ var waiters = []request.WaiterOption{
 // CloudFormation represents a client to make requests to AWS CloudFormation.
 type CloudFormation struct {
 	client api
+	region string
 }
 
 // New creates a new CloudFormation client.
 func New(s *session.Session) *CloudFormation {
 	return &CloudFormation{
 		client: cloudformation.New(s),
+		region: aws.StringValue(s.Config.Region),
 	}
 }
 
 // Create deploys a new CloudFormation stack using Change Sets.
 // If the stack already exists in a failed state, deletes the stack and re-creates it.
 func (c *CloudFormation) Create(stack *Stack) error {
+	return c.CreateWithContext(context.Background(), stack)
+}
+
+// CreateWithContext is Create with a context. If the context is canceled before the change set is executed,
+// the change set is deleted and an ErrStackDeployInterrupted is returned.
+func (c *CloudFormation) CreateWithContext(ctx context.Context, stack *Stack) error {
 	descr, err := c.Describe(stack.Name)
 	if err != nil {
 		var stackNotFound *ErrStackNotFound
@@ -53,7 +61,7 @@ func (c *CloudFormation) Create(stack *Stack) error {
 			return err
 		}
 		// If the stack does not exist, create it.
-		return c.create(stack)
+		return c.create(ctx, stack)
 	}
 	status := StackStatus(aws.StringValue(descr.StackStatus))
 	if status.requiresCleanup() {
@@ -61,7 +69,7 @@ func (c *CloudFormation) Create(stack *Stack) error {
 		if err := c.Delete(stack.Name); err != nil {
 			return fmt.Errorf("cleanup previously failed stack %s: %w", stack.Name, err)
 		}
-		return c.create(stack)
+		return c.create(ctx, stack)
 	}
 	if status.InProgress() {
 		return &ErrStackUpdateInProgress{
@@ -76,18 +84,32 @@ func (c *CloudFormation) Create(stack *Stack) error {
 
 // CreateAndWait calls Create and then WaitForCreate.
 func (c *CloudFormation) CreateAndWait(stack *Stack) error {
-	if err := c.Create(stack); err != nil {
+	return c.CreateAndWaitWithContext(context.Background(), stack)
+}
+
+// CreateAndWaitWithContext calls CreateWithContext and then WaitForCreateWithContext.
+func (c *CloudFormation) CreateAndWaitWithContext(ctx context.Context, stack *Stack) error {
+	if err := c.CreateWithContext(ctx, stack); err != nil {
 		return err
 	}
-	return c.WaitForCreate(stack.Name)
+	return c.WaitForCreateWithContext(ctx, stack.Name)
 }
 
 // WaitForCreate blocks until the stack is created or until the max attempt window expires.
 func (c *CloudFormation) WaitForCreate(stackName string) error {
-	err := c.client.WaitUntilStackCreateCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+	return c.WaitForCreateWithContext(context.Background(), stackName)
+}
+
+// WaitForCreateWithContext is WaitForCreate with a context. If the context is canceled,
+// it stops waiting and returns an ErrStackDeployInterrupted while CloudFormation keeps creating the stack.
+func (c *CloudFormation) WaitForCreateWithContext(ctx context.Context, stackName string) error {
+	err := c.client.WaitUntilStackCreateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	}, waiters...)
 	if err != nil {
+		if ctx.Err() != nil {
+			return c.interruptedAfterExecution(ctx, stackName)
+		}
 		return fmt.Errorf("wait until stack %s create is complete: %w", stackName, err)
 	}
 	return nil
@@ -96,6 +118,12 @@ func (c *CloudFormation) WaitForCreate(stackName string) error {
 // Update updates an existing CloudFormation with the new configuration.
 // If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
 func (c *CloudFormation) Update(stack *Stack) error {
+	return c.UpdateWithContext(context.Background(), stack)
+}
+
+// UpdateWithContext is Update with a context. If the context is canceled before the change set is executed,
+// the change set is deleted and an ErrStackDeployInterrupted is returned.
+func (c *CloudFormation) UpdateWithContext(ctx context.Context, stack *Stack) error {
 	descr, err := c.Describe(stack.Name)
 	if err != nil {
 		return err
@@ -106,28 +134,51 @@ func (c *CloudFormation) Update(stack *Stack) error {
 			Name: stack.Name,
 		}
 	}
-	return c.update(stack)
+	return c.update(ctx, stack)
 }
 
 // UpdateAndWait calls Update and then blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
-	if err := c.Update(stack); err != nil {
+	return c.UpdateAndWaitWithContext(context.Background(), stack)
+}
+
+// UpdateAndWaitWithContext calls UpdateWithContext and then WaitForUpdateWithContext.
+func (c *CloudFormation) UpdateAndWaitWithContext(ctx context.Context, stack *Stack) error {
+	if err := c.UpdateWithContext(ctx, stack); err != nil {
 		return err
 	}
-	return c.WaitForUpdate(stack.Name)
+	return c.WaitForUpdateWithContext(ctx, stack.Name)
 }
 
 // WaitForUpdate blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) WaitForUpdate(stackName string) error {
-	err := c.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+	return c.WaitForUpdateWithContext(context.Background(), stackName)
+}
+
+// WaitForUpdateWithContext is WaitForUpdate with a context. If the context is canceled,
+// it stops waiting and returns an ErrStackDeployInterrupted while CloudFormation keeps updating the stack.
+func (c *CloudFormation) WaitForUpdateWithContext(ctx context.Context, stackName string) error {
+	err := c.client.WaitUntilStackUpdateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	}, waiters...)
 	if err != nil {
+		if ctx.Err() != nil {
+			return c.interruptedAfterExecution(ctx, stackName)
+		}
 		return fmt.Errorf("wait until stack %s update is complete: %w", stackName, err)
 	}
 	return nil
 }
 
+func (c *CloudFormation) interruptedAfterExecution(ctx context.Context, stackName string) error {
+	return &ErrStackDeployInterrupted{
+		Name:     stackName,
+		Region:   c.region,
+		Executed: true,
+		err:      ctx.Err(),
+	}
+}
+
 // Delete removes an existing CloudFormation stack.
 // If the stack doesn't exist then do nothing.
 func (c *CloudFormation) Delete(stackName string) error {
@@ -239,20 +290,38 @@ func (c *CloudFormation) ErrorEvents(stackName string) ([]StackEvent, error) {
 	})
 }
 
-func (c *CloudFormation) create(stack *Stack) error {
+func (c *CloudFormation) create(ctx context.Context, stack *Stack) error {
 	cs, err := newCreateChangeSet(c.client, stack.Name)
 	if err != nil {
 		return err
 	}
-	return cs.createAndExecute(stack.stackConfig)
+	err = c.withRegion(cs.createAndExecute(ctx, stack.stackConfig))
+	var interrupted *ErrStackDeployInterrupted
+	if !errors.As(err, &interrupted) {
+		return err
+	}
+	// The change set left the new stack in REVIEW_IN_PROGRESS, delete it so that the next deployment can create it.
+	if deleteErr := c.Delete(stack.Name); deleteErr != nil {
+		return fmt.Errorf("%w: %v", err, deleteErr)
+	}
+	return err
 }
 
-func (c *CloudFormation) update(stack *Stack) error {
+func (c *CloudFormation) update(ctx context.Context, stack *Stack) error {
 	cs, err := newUpdateChangeSet(c.client, stack.Name)
 	if err != nil {
 		return err
 	}
-	return cs.createAndExecute(stack.stackConfig)
+	return c.withRegion(cs.createAndExecute(ctx, stack.stackConfig))
+}
+
+// withRegion sets the region of the stack if the deployment was interrupted, since change sets don't know it.
+func (c *CloudFormation) withRegion(err error) error {
+	var interrupted *ErrStackDeployInterrupted
+	if errors.As(err, &interrupted) {
+		interrupted.Region = c.region
+	}
+	return err
 }
 
 func (c *CloudFormation) deleteAndWait(in *cloudformation.DeleteStackInput) error {
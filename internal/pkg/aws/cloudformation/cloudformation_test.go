@@ -5,12 +5,14 @@ package cloudformation
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/mocks"
 	"github.com/golang/mock/gomock"
@@ -168,6 +170,161 @@ func TestCloudFormation_CreateAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_CreateAndWaitWithContext(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller, cancel context.CancelFunc) api
+		wantedErr  error
+	}{
+		"deletes the change set if interrupted while it's created": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id:      aws.String(mockChangeSetID),
+					StackId: aws.String(mockStack.Name),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeChangeSetInput, _ ...request.WaiterOption) error {
+						cancel()
+						return awserr.New("RequestCanceled", "request context canceled", context.Canceled)
+					})
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().DeleteStack(&cloudformation.DeleteStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedErr: &ErrStackDeployInterrupted{
+				Name:   mockStack.Name,
+				Region: "us-west-2",
+				err:    context.Canceled,
+			},
+		},
+		"deletes the change set if interrupted before it's executed": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id:      aws.String(mockChangeSetID),
+					StackId: aws.String(mockStack.Name),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeChangeSetInput, _ ...request.WaiterOption) error {
+						cancel()
+						return nil
+					})
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().DeleteStack(&cloudformation.DeleteStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: &ErrStackDeployInterrupted{
+				Name:   mockStack.Name,
+				Region: "us-west-2",
+				err:    context.Canceled,
+			},
+		},
+		"wraps the error if the change set can't be deleted after the interruption": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id:      aws.String(mockChangeSetID),
+					StackId: aws.String(mockStack.Name),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeChangeSetInput, _ ...request.WaiterOption) error {
+						cancel()
+						return awserr.New("RequestCanceled", "request context canceled", context.Canceled)
+					})
+				m.EXPECT().DeleteChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DeleteStack(gomock.Any()).Return(nil, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("%w: %v", &ErrStackDeployInterrupted{
+				Name:   mockStack.Name,
+				Region: "us-west-2",
+				err:    context.Canceled,
+			}, fmt.Errorf("delete change set %s for stack %s: %w", mockChangeSetID, mockStack.Name, errors.New("some error"))),
+		},
+		"wraps the error if the stack in review can't be deleted after the interruption": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id:      aws.String(mockChangeSetID),
+					StackId: aws.String(mockStack.Name),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeChangeSetInput, _ ...request.WaiterOption) error {
+						cancel()
+						return awserr.New("RequestCanceled", "request context canceled", context.Canceled)
+					})
+				m.EXPECT().DeleteChangeSet(gomock.Any()).Return(nil, nil)
+				m.EXPECT().DeleteStack(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("%w: %v", &ErrStackDeployInterrupted{
+				Name:   mockStack.Name,
+				Region: "us-west-2",
+				err:    context.Canceled,
+			}, fmt.Errorf("delete stack %s: %w", mockStack.Name, errors.New("some error"))),
+		},
+		"stops waiting without deleting the change set if interrupted after it's executed": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				addCreateDeployCalls(m)
+				m.EXPECT().WaitUntilStackCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeStacksInput, _ ...request.WaiterOption) error {
+						cancel()
+						return awserr.New("RequestCanceled", "request context canceled", context.Canceled)
+					})
+				m.EXPECT().DeleteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: &ErrStackDeployInterrupted{
+				Name:     mockStack.Name,
+				Region:   "us-west-2",
+				Executed: true,
+				err:      context.Canceled,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			c := CloudFormation{
+				client: tc.createMock(ctrl, cancel),
+				region: "us-west-2",
+			}
+
+			// WHEN
+			err := c.CreateAndWaitWithContext(ctx, mockStack)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
 func TestCloudFormation_WaitForCreate(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) api
@@ -262,6 +419,52 @@ func TestCloudFormation_Update(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_Update_WithPreviousTemplate(t *testing.T) {
+	// GIVEN
+	seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+	uuid.SetRand(seed)
+	defer uuid.SetRand(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+		Stacks: []*cloudformation.Stack{
+			{
+				StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+			},
+		},
+	}, nil)
+	m.EXPECT().CreateChangeSet(&cloudformation.CreateChangeSetInput{
+		ChangeSetName:       aws.String(mockChangeSetName),
+		StackName:           aws.String("id"),
+		ChangeSetType:       aws.String(cloudformation.ChangeSetTypeUpdate),
+		UsePreviousTemplate: aws.Bool(true),
+		Tags: []*cloudformation.Tag{
+			{
+				Key:   aws.String("cost-center"),
+				Value: aws.String("1234"),
+			},
+		},
+		Capabilities: aws.StringSlice([]string{
+			cloudformation.CapabilityCapabilityIam,
+			cloudformation.CapabilityCapabilityNamedIam,
+			cloudformation.CapabilityCapabilityAutoExpand,
+		}),
+	}).Return(nil, errors.New("some error"))
+	c := CloudFormation{
+		client: m,
+	}
+
+	// WHEN
+	err := c.Update(NewStack("id", "", WithPreviousTemplate(), WithTags(map[string]string{
+		"cost-center": "1234",
+	})))
+
+	// THEN
+	require.EqualError(t, err, fmt.Sprintf("create change set %s for stack id: some error", mockChangeSetName))
+}
+
 func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) api
@@ -308,6 +511,95 @@ func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_UpdateAndWaitWithContext(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller, cancel context.CancelFunc) api
+		wantedErr  error
+	}{
+		"deletes the change set without deleting the stack if interrupted while it's created": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id:      aws.String(mockChangeSetID),
+					StackId: aws.String(mockStack.Name),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeChangeSetInput, _ ...request.WaiterOption) error {
+						cancel()
+						return awserr.New("RequestCanceled", "request context canceled", context.Canceled)
+					})
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().DeleteStack(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: &ErrStackDeployInterrupted{
+				Name:   mockStack.Name,
+				Region: "us-west-2",
+				err:    context.Canceled,
+			},
+		},
+		"stops waiting if interrupted after the change set is executed": {
+			createMock: func(ctrl *gomock.Controller, cancel context.CancelFunc) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+						},
+					},
+				}, nil)
+				addUpdateDeployCalls(m)
+				m.EXPECT().WaitUntilStackUpdateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ aws.Context, _ *cloudformation.DescribeStacksInput, _ ...request.WaiterOption) error {
+						cancel()
+						return awserr.New("RequestCanceled", "request context canceled", context.Canceled)
+					})
+				return m
+			},
+			wantedErr: &ErrStackDeployInterrupted{
+				Name:     mockStack.Name,
+				Region:   "us-west-2",
+				Executed: true,
+				err:      context.Canceled,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			c := CloudFormation{
+				client: tc.createMock(ctrl, cancel),
+				region: "us-west-2",
+			}
+
+			// WHEN
+			err := c.UpdateAndWaitWithContext(ctx, mockStack)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
 func TestCloudFormation_Delete(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) api
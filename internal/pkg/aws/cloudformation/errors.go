@@ -57,6 +57,32 @@ func (e *ErrStackUpdateInProgress) Error() string {
 	return fmt.Sprintf("stack %s is currently being updated and cannot be deployed to", e.Name)
 }
 
+// ErrStackDeployInterrupted occurs when the context is canceled while a stack is created or updated.
+type ErrStackDeployInterrupted struct {
+	Name     string // Name of the stack.
+	Region   string // Region of the stack.
+	Executed bool   // True if the change set was executed, in which case CloudFormation keeps applying the changes.
+
+	err error
+}
+
+func (e *ErrStackDeployInterrupted) Error() string {
+	if e.Executed {
+		return fmt.Sprintf("stop waiting for stack %s to be deployed: %v", e.Name, e.err)
+	}
+	return fmt.Sprintf("cancel the deployment of stack %s before its change set was executed: %v", e.Name, e.err)
+}
+
+// Unwrap returns the error of the context that was canceled.
+func (e *ErrStackDeployInterrupted) Unwrap() error {
+	return e.err
+}
+
+// ConsoleURL returns the URL of the stack in the AWS CloudFormation console.
+func (e *ErrStackDeployInterrupted) ConsoleURL() string {
+	return fmt.Sprintf("https://console.aws.amazon.com/cloudformation/home?region=%s#/stacks?filteringText=%s", e.Region, e.Name)
+}
+
 // stackDoesNotExist returns true if the underlying error is a stack doesn't exist.
 func stackDoesNotExist(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
@@ -15,10 +15,11 @@ type Stack struct {
 }
 
 type stackConfig struct {
-	Template   string
-	Parameters []*cloudformation.Parameter
-	Tags       []*cloudformation.Tag
-	RoleARN    *string
+	Template            string
+	UsePreviousTemplate bool // If true, the template of the existing stack is reused and Template is ignored.
+	Parameters          []*cloudformation.Parameter
+	Tags                []*cloudformation.Tag
+	RoleARN             *string
 }
 
 // StackOption allows you to initialize a Stack with additional properties.
@@ -73,6 +74,13 @@ func WithRoleARN(roleARN string) StackOption {
 	}
 }
 
+// WithPreviousTemplate updates a stack with its existing template instead of a new template body.
+func WithPreviousTemplate() StackOption {
+	return func(s *Stack) {
+		s.UsePreviousTemplate = true
+	}
+}
+
 // StackEvent represents a stack event for a resource.
 type StackEvent cloudformation.StackEvent
 
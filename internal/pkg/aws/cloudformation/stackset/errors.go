@@ -5,6 +5,8 @@ package stackset
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -21,6 +23,35 @@ func (e *ErrStackSetOutOfDate) Error() string {
 	return fmt.Sprintf("stack set %s update was out of date (feel free to try again): %v", e.stackSetName, e.parentErr)
 }
 
+// ErrOperationFailed occurs when a stack set operation fails, with the reasons of the failed stack instances.
+type ErrOperationFailed struct {
+	stackSetName string
+	operationID  string
+	results      []OperationResult
+}
+
+func (e *ErrOperationFailed) Error() string {
+	if len(e.results) == 0 {
+		return fmt.Sprintf("operation %s for stack set %s failed", e.operationID, e.stackSetName)
+	}
+	reasons := make([]string, len(e.results))
+	for i, result := range e.results {
+		reasons[i] = fmt.Sprintf("account %s in region %s: %s", result.Account, result.Region, result.Reason)
+	}
+	return fmt.Sprintf("operation %s for stack set %s failed: %s", e.operationID, e.stackSetName, strings.Join(reasons, "; "))
+}
+
+// ErrOperationTimeout occurs when a stack set operation doesn't complete in time.
+type ErrOperationTimeout struct {
+	stackSetName string
+	operationID  string
+	timeout      time.Duration
+}
+
+func (e *ErrOperationTimeout) Error() string {
+	return fmt.Sprintf("operation %s for stack set %s did not complete within %s", e.operationID, e.stackSetName, e.timeout)
+}
+
 // isAlreadyExistingStackSet returns true if the underlying error is a stack already exists error.
 func isAlreadyExistingStackSet(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
@@ -152,3 +152,18 @@ func (mr *MockapiMockRecorder) ListStackInstances(arg0 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStackInstances", reflect.TypeOf((*Mockapi)(nil).ListStackInstances), arg0)
 }
+
+// ListStackSetOperationResults mocks base method
+func (m *Mockapi) ListStackSetOperationResults(arg0 *cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStackSetOperationResults", arg0)
+	ret0, _ := ret[0].(*cloudformation.ListStackSetOperationResultsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStackSetOperationResults indicates an expected call of ListStackSetOperationResults
+func (mr *MockapiMockRecorder) ListStackSetOperationResults(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStackSetOperationResults", reflect.TypeOf((*Mockapi)(nil).ListStackSetOperationResults), arg0)
+}
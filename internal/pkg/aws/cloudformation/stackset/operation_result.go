@@ -0,0 +1,21 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stackset
+
+// Statuses of a stack set operation in an account and region.
+const (
+	OperationResultStatusPending   = "PENDING"
+	OperationResultStatusRunning   = "RUNNING"
+	OperationResultStatusSucceeded = "SUCCEEDED"
+	OperationResultStatusFailed    = "FAILED"
+	OperationResultStatusCancelled = "CANCELLED"
+)
+
+// OperationResult represents the status of a stack set operation on the stack instance of an account and region.
+type OperationResult struct {
+	Account string
+	Region  string
+	Status  string
+	Reason  string // Why the operation failed or was cancelled for the stack instance.
+}
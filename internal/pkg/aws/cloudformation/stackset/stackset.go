@@ -20,6 +20,7 @@ type api interface {
 	DeleteStackSet(*cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error)
 	DescribeStackSet(*cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error)
 	DescribeStackSetOperation(*cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error)
+	ListStackSetOperationResults(*cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error)
 
 	CreateStackInstances(*cloudformation.CreateStackInstancesInput) (*cloudformation.CreateStackInstancesOutput, error)
 	DeleteStackInstances(*cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error)
@@ -32,6 +33,9 @@ const (
 	opStatusFailed    = "FAILED"
 )
 
+// Interval between two requests for the status of a stack set operation, overridden in tests.
+var operationPollInterval = 3 * time.Second
+
 // StackSet represents an AWS CloudFormation client to interact with stack sets.
 type StackSet struct {
 	client api
@@ -163,12 +167,27 @@ func (ss *StackSet) CreateInstances(name string, accounts, regions []string) err
 }
 
 // CreateInstancesAndWait creates new stack instances in the regions of the specified AWS accounts, and waits until the operation completes.
-func (ss *StackSet) CreateInstancesAndWait(name string, accounts, regions []string) error {
+func (ss *StackSet) CreateInstancesAndWait(name string, accounts, regions []string, opts ...WaitOption) error {
 	id, err := ss.createInstances(name, accounts, regions)
 	if err != nil {
 		return err
 	}
-	return ss.waitForOperation(name, id)
+	return ss.waitForOperation(name, id, opts...)
+}
+
+// DeleteInstancesAndWait deletes the stack instances in the regions of the specified AWS accounts, and waits until the operation completes.
+func (ss *StackSet) DeleteInstancesAndWait(name string, accounts, regions []string) error {
+	resp, err := ss.client.DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+		StackSetName: aws.String(name),
+		Accounts:     aws.StringSlice(accounts),
+		Regions:      aws.StringSlice(regions),
+		RetainStacks: aws.Bool(false),
+	})
+	if err != nil {
+		return fmt.Errorf("delete stack instances in regions %v for accounts %v for stack set %s: %w",
+			regions, accounts, name, err)
+	}
+	return ss.waitForOperation(name, aws.StringValue(resp.OperationId))
 }
 
 // InstanceSummariesOption allows to filter instance summaries to retrieve for the stack set.
@@ -231,7 +250,15 @@ func (ss *StackSet) createInstances(name string, accounts, regions []string) (st
 	return aws.StringValue(resp.OperationId), nil
 }
 
-func (ss *StackSet) waitForOperation(name, operationID string) error {
+func (ss *StackSet) waitForOperation(name, operationID string, opts ...WaitOption) error {
+	var w waiter
+	for _, opt := range opts {
+		opt(&w)
+	}
+	var deadline time.Time
+	if w.timeout > 0 {
+		deadline = time.Now().Add(w.timeout)
+	}
 	for {
 		response, err := ss.client.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
 			StackSetName: aws.String(name),
@@ -240,16 +267,100 @@ func (ss *StackSet) waitForOperation(name, operationID string) error {
 		if err != nil {
 			return fmt.Errorf("describe operation %s for stack set %s: %w", operationID, name, err)
 		}
-		if aws.StringValue(response.StackSetOperation.Status) == opStatusSucceeded {
+		status := aws.StringValue(response.StackSetOperation.Status)
+		if w.progress != nil {
+			// The results are only used to display progress, so failing to list them doesn't stop waiting.
+			if results, err := ss.operationResults(name, operationID); err == nil {
+				w.progress(results)
+			}
+		}
+		if status == opStatusSucceeded {
 			return nil
 		}
-		if aws.StringValue(response.StackSetOperation.Status) == opStatusStopped {
+		if status == opStatusStopped {
 			return fmt.Errorf("operation %s for stack set %s was manually stopped", operationID, name)
 		}
-		if aws.StringValue(response.StackSetOperation.Status) == opStatusFailed {
-			return fmt.Errorf("operation %s for stack set %s failed", operationID, name)
+		if status == opStatusFailed {
+			return ss.operationFailure(name, operationID)
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return &ErrOperationTimeout{
+				stackSetName: name,
+				operationID:  operationID,
+				timeout:      w.timeout,
+			}
+		}
+		time.Sleep(operationPollInterval)
+	}
+}
+
+// operationFailure returns an error with the reasons of the failed stack instances of the operation.
+func (ss *StackSet) operationFailure(name, operationID string) error {
+	results, err := ss.operationResults(name, operationID)
+	if err != nil {
+		return fmt.Errorf("operation %s for stack set %s failed: %w", operationID, name, err)
+	}
+	var failed []OperationResult
+	for _, result := range results {
+		if result.Status == OperationResultStatusFailed {
+			failed = append(failed, result)
+		}
+	}
+	return &ErrOperationFailed{
+		stackSetName: name,
+		operationID:  operationID,
+		results:      failed,
+	}
+}
+
+// operationResults returns the status of the operation in each account and region.
+func (ss *StackSet) operationResults(name, operationID string) ([]OperationResult, error) {
+	var results []OperationResult
+	var nextToken *string
+	for {
+		resp, err := ss.client.ListStackSetOperationResults(&cloudformation.ListStackSetOperationResultsInput{
+			StackSetName: aws.String(name),
+			OperationId:  aws.String(operationID),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list results of operation %s for stack set %s: %w", operationID, name, err)
 		}
-		time.Sleep(3 * time.Second)
+		for _, summary := range resp.Summaries {
+			results = append(results, OperationResult{
+				Account: aws.StringValue(summary.Account),
+				Region:  aws.StringValue(summary.Region),
+				Status:  aws.StringValue(summary.Status),
+				Reason:  aws.StringValue(summary.StatusReason),
+			})
+		}
+		if resp.NextToken == nil {
+			return results, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
+// WaitOption allows to configure how to wait for a stack set operation to complete.
+type WaitOption func(w *waiter)
+
+type waiter struct {
+	timeout  time.Duration
+	progress func([]OperationResult)
+}
+
+// WithTimeout returns an error if the operation doesn't complete within the duration.
+// By default, the operation is waited for until it completes.
+func WithTimeout(timeout time.Duration) WaitOption {
+	return func(w *waiter) {
+		w.timeout = timeout
+	}
+}
+
+// WithProgress calls fn with the status of the operation in each account and region every time the operation is checked.
+func WithProgress(fn func([]OperationResult)) WaitOption {
+	return func(w *waiter) {
+		w.progress = fn
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -304,9 +305,38 @@ func TestStackSet_UpdateAndWait(t *testing.T) {
 						Status: aws.String(opStatusFailed),
 					},
 				}, nil)
+				m.EXPECT().ListStackSetOperationResults(&cloudformation.ListStackSetOperationResultsInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.ListStackSetOperationResultsOutput{
+					Summaries: []*cloudformation.StackSetOperationResultSummary{
+						{
+							Account: aws.String("1234"),
+							Region:  aws.String("us-west-2"),
+							Status:  aws.String(OperationResultStatusSucceeded),
+						},
+						{
+							Account:      aws.String("5678"),
+							Region:       aws.String("us-west-2"),
+							Status:       aws.String(OperationResultStatusFailed),
+							StatusReason: aws.String("Account 5678 should have 'AWSCloudFormationStackSetExecutionRole' role"),
+						},
+					},
+				}, nil)
 				return m
 			},
-			wantedError: fmt.Errorf("operation %s for stack set %s failed", "1", testName),
+			wantedError: &ErrOperationFailed{
+				stackSetName: testName,
+				operationID:  "1",
+				results: []OperationResult{
+					{
+						Account: "5678",
+						Region:  "us-west-2",
+						Status:  OperationResultStatusFailed,
+						Reason:  "Account 5678 should have 'AWSCloudFormationStackSetExecutionRole' role",
+					},
+				},
+			},
 		},
 	}
 
@@ -475,6 +505,185 @@ func TestStackSet_CreateInstances(t *testing.T) {
 	}
 }
 
+func TestStackSet_CreateInstancesAndWait(t *testing.T) {
+	defer func(interval time.Duration) { operationPollInterval = interval }(operationPollInterval)
+	operationPollInterval = 0
+	var (
+		testAccounts = []string{"1234"}
+		testRegions  = []string{"us-west-1"}
+	)
+	running := &cloudformation.DescribeStackSetOperationOutput{
+		StackSetOperation: &cloudformation.StackSetOperation{
+			Status: aws.String("RUNNING"),
+		},
+	}
+	testCases := map[string]struct {
+		inTimeout  time.Duration
+		mockClient func(ctrl *gomock.Controller) api
+
+		wantedProgress [][]OperationResult
+		wantedError    error
+	}{
+		"reports progress until the operation succeeds": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CreateStackInstances(gomock.Any()).Return(&cloudformation.CreateStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(running, nil),
+					m.EXPECT().ListStackSetOperationResults(gomock.Any()).Return(&cloudformation.ListStackSetOperationResultsOutput{
+						Summaries: []*cloudformation.StackSetOperationResultSummary{
+							{
+								Account: aws.String("1234"),
+								Region:  aws.String("us-west-1"),
+								Status:  aws.String(OperationResultStatusRunning),
+							},
+						},
+					}, nil),
+					m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+						StackSetOperation: &cloudformation.StackSetOperation{
+							Status: aws.String(opStatusSucceeded),
+						},
+					}, nil),
+					m.EXPECT().ListStackSetOperationResults(gomock.Any()).Return(nil, testError),
+				)
+				return m
+			},
+			wantedProgress: [][]OperationResult{
+				{
+					{
+						Account: "1234",
+						Region:  "us-west-1",
+						Status:  OperationResultStatusRunning,
+					},
+				},
+			},
+		},
+		"returns an error if the operation doesn't complete in time": {
+			inTimeout: time.Nanosecond,
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CreateStackInstances(gomock.Any()).Return(&cloudformation.CreateStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(running, nil)
+				m.EXPECT().ListStackSetOperationResults(gomock.Any()).Return(&cloudformation.ListStackSetOperationResultsOutput{}, nil)
+				return m
+			},
+			wantedProgress: [][]OperationResult{nil},
+			wantedError: &ErrOperationTimeout{
+				stackSetName: testName,
+				operationID:  "1",
+				timeout:      time.Nanosecond,
+			},
+		},
+		"wraps the error if the results of a failed operation can't be listed": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CreateStackInstances(gomock.Any()).Return(&cloudformation.CreateStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusFailed),
+					},
+				}, nil)
+				m.EXPECT().ListStackSetOperationResults(gomock.Any()).Return(nil, testError).Times(2)
+				return m
+			},
+			wantedError: fmt.Errorf("operation 1 for stack set %s failed: %w", testName,
+				fmt.Errorf("list results of operation 1 for stack set %s: %w", testName, testError)),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := StackSet{
+				client: tc.mockClient(ctrl),
+			}
+			var progress [][]OperationResult
+
+			// WHEN
+			err := client.CreateInstancesAndWait(testName, testAccounts, testRegions,
+				WithTimeout(tc.inTimeout),
+				WithProgress(func(results []OperationResult) {
+					progress = append(progress, results)
+				}))
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedProgress, progress)
+		})
+	}
+}
+
+func TestStackSet_DeleteInstancesAndWait(t *testing.T) {
+	var (
+		testAccounts = []string{"1234"}
+		testRegions  = []string{"us-west-1"}
+	)
+	testCases := map[string]struct {
+		mockClient  func(ctrl *gomock.Controller) api
+		wantedError error
+	}{
+		"successfully deletes stack instances and waits for the operation": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+					StackSetName: aws.String(testName),
+					Accounts:     aws.StringSlice(testAccounts),
+					Regions:      aws.StringSlice(testRegions),
+					RetainStacks: aws.Bool(false),
+				}).Return(&cloudformation.DeleteStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusSucceeded),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"wraps error on unexpected failure": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DeleteStackInstances(gomock.Any()).Return(nil, testError)
+				return m
+			},
+			wantedError: fmt.Errorf("delete stack instances in regions %v for accounts %v for stack set %s: %w",
+				testRegions,
+				testAccounts,
+				testName,
+				testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := StackSet{
+				client: tc.mockClient(ctrl),
+			}
+
+			// WHEN
+			err := client.DeleteInstancesAndWait(testName, testAccounts, testRegions)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
 func TestStackSet_InstanceSummaries(t *testing.T) {
 	const (
 		testAccountID = "1234"
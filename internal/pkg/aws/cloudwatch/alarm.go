@@ -55,6 +55,10 @@ func (a metricAlarm) condition() string {
 	thresholdType := a.alarmThresholdType()
 	metricName := aws.StringValue(a.MetricName)
 	period := aws.Int64Value(a.Period)
+	if a.MetricName == nil {
+		// The alarm watches the result of a metric math expression.
+		metricName, period = a.expressionMetric()
+	}
 	evaluationPeriod := aws.Int64Value(a.EvaluationPeriods)
 	datapointsToAlarm := aws.Int64Value(a.DatapointsToAlarm)
 	if datapointsToAlarm == 0 {
@@ -96,6 +100,24 @@ func (a metricAlarm) thresholdMetric() *cloudwatch.MetricDataQuery {
 	return nil
 }
 
+// expressionMetric returns the label and the period of the metrics of an alarm on a metric math expression.
+func (a metricAlarm) expressionMetric() (string, int64) {
+	var label string
+	var period int64
+	for _, m := range a.Metrics {
+		if aws.BoolValue(m.ReturnData) {
+			label = aws.StringValue(m.Label)
+			if label == "" {
+				label = aws.StringValue(m.Id)
+			}
+		}
+		if m.MetricStat != nil && period == 0 {
+			period = aws.Int64Value(m.MetricStat.Period)
+		}
+	}
+	return label, period
+}
+
 func humanizePeriod(evaluationPeriod, period int64) string {
 	durationPeriod := time.Duration(evaluationPeriod*period) * time.Second
 	return strings.TrimSpace(humanizeDuration(time.Now(), time.Now().Add(durationPeriod), "", ""))
@@ -81,14 +81,25 @@ func (cw *CloudWatch) AlarmStatus(alarms []string) ([]AlarmStatus, error) {
 	if len(alarms) == 0 {
 		return nil, nil
 	}
+	return cw.describeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice(alarms),
+	})
+}
+
+// AlarmsWithPrefix returns the status of the alarms whose name starts with the prefix.
+func (cw *CloudWatch) AlarmsWithPrefix(prefix string) ([]AlarmStatus, error) {
+	return cw.describeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: aws.String(prefix),
+	})
+}
+
+func (cw *CloudWatch) describeAlarms(in *cloudwatch.DescribeAlarmsInput) ([]AlarmStatus, error) {
 	var alarmStatus []AlarmStatus
 	var err error
 	alarmResp := &cloudwatch.DescribeAlarmsOutput{}
 	for {
-		alarmResp, err = cw.client.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
-			AlarmNames: aws.StringSlice(alarms),
-			NextToken:  alarmResp.NextToken,
-		})
+		in.NextToken = alarmResp.NextToken
+		alarmResp, err = cw.client.DescribeAlarms(in)
 		if err != nil {
 			return nil, fmt.Errorf("describe CloudWatch alarms: %w", err)
 		}
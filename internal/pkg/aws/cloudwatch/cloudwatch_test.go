@@ -353,3 +353,127 @@ func TestCloudWatch_AlarmsWithTags(t *testing.T) {
 
 	}
 }
+
+func TestCloudWatch_AlarmsWithPrefix(t *testing.T) {
+	const (
+		mockPrefix = "mockApp-mockEnv-mockSvc-CopilotAlarm-"
+		mockArn    = "arn:aws:cloudwatch:us-west-2:1234567890:alarm:mockApp-mockEnv-mockSvc-CopilotAlarm-"
+	)
+	mockTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantAlarmStatus []AlarmStatus
+		wantErr         error
+	}{
+		"errors if failed to describe CloudWatch alarms": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+					AlarmNamePrefix: aws.String(mockPrefix),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe CloudWatch alarms: some error"),
+		},
+		"success with pagination": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+						AlarmNamePrefix: aws.String(mockPrefix),
+					}).Return(&cloudwatch.DescribeAlarmsOutput{
+						NextToken: aws.String("mockNextToken"),
+						MetricAlarms: []*cloudwatch.MetricAlarm{
+							{
+								AlarmArn:              aws.String(mockArn + "CPUUtilization"),
+								AlarmName:             aws.String(mockPrefix + "CPUUtilization"),
+								ComparisonOperator:    aws.String(cloudwatch.ComparisonOperatorGreaterThanThreshold),
+								EvaluationPeriods:     aws.Int64(3),
+								Period:                aws.Int64(60),
+								Threshold:             aws.Float64(70),
+								MetricName:            aws.String("CPUUtilization"),
+								StateValue:            aws.String("ALARM"),
+								StateUpdatedTimestamp: &mockTime,
+							},
+						},
+					}, nil),
+					m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+						AlarmNamePrefix: aws.String(mockPrefix),
+						NextToken:       aws.String("mockNextToken"),
+					}).Return(&cloudwatch.DescribeAlarmsOutput{
+						MetricAlarms: []*cloudwatch.MetricAlarm{
+							{
+								AlarmArn:           aws.String(mockArn + "HTTP5xxPercentage"),
+								AlarmName:          aws.String(mockPrefix + "HTTP5xxPercentage"),
+								ComparisonOperator: aws.String(cloudwatch.ComparisonOperatorGreaterThanThreshold),
+								EvaluationPeriods:  aws.Int64(3),
+								Threshold:          aws.Float64(5),
+								Metrics: []*cloudwatch.MetricDataQuery{
+									{
+										Id: aws.String("errors"),
+										MetricStat: &cloudwatch.MetricStat{
+											Period: aws.Int64(60),
+											Metric: &cloudwatch.Metric{
+												MetricName: aws.String("HTTPCode_Target_5XX_Count"),
+											},
+										},
+										ReturnData: aws.Bool(false),
+									},
+									{
+										Id:         aws.String("percentage"),
+										Expression: aws.String("100 * FILL(errors, 0) / requests"),
+										Label:      aws.String("HTTP5xxPercentage"),
+										ReturnData: aws.Bool(true),
+									},
+								},
+								StateValue:            aws.String("OK"),
+								StateUpdatedTimestamp: &mockTime,
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantAlarmStatus: []AlarmStatus{
+				{
+					Arn:          mockArn + "CPUUtilization",
+					Name:         mockPrefix + "CPUUtilization",
+					Type:         "Metric",
+					Condition:    "CPUUtilization > 70.00 for 3 datapoints within 3 minutes",
+					Status:       "ALARM",
+					UpdatedTimes: mockTime,
+				},
+				{
+					Arn:          mockArn + "HTTP5xxPercentage",
+					Name:         mockPrefix + "HTTP5xxPercentage",
+					Type:         "Metric",
+					Condition:    "HTTP5xxPercentage > 5.00 for 3 datapoints within 3 minutes",
+					Status:       "OK",
+					UpdatedTimes: mockTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockcwClient)
+
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			gotAlarmStatus, gotErr := cwSvc.AlarmsWithPrefix(mockPrefix)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantAlarmStatus, gotAlarmStatus)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,11 @@ import (
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+
+	// Above this number of log streams, the events of the log streams are retrieved in parallel.
+	maxSequentialLogStreams = 5
+	// Maximum number of log streams whose events are retrieved at the same time.
+	maxConcurrentLogStreams = 5
 )
 
 var (
@@ -28,6 +34,7 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -51,6 +58,7 @@ type LogEventsOpts struct {
 	StartTime           *int64
 	EndTime             *int64
 	StreamLastEventTime map[string]int64
+	FilterPattern       string // If empty, retrieve all log events.
 }
 
 // New returns a CloudWatchLogs configured against the input session.
@@ -61,6 +69,7 @@ func New(s *session.Session) *CloudWatchLogs {
 }
 
 // logStreams returns all name of the log streams in a log group.
+// If the log group does not exist, returns ErrLogGroupNotFound. If it has no log streams, returns ErrNoLogStreams.
 func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]string, error) {
 	resp, err := c.client.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
 		LogGroupName: aws.String(logGroup),
@@ -68,10 +77,13 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]st
 		OrderBy:      aws.String(cloudwatchlogs.OrderByLastEventTime),
 	})
 	if err != nil {
+		if isResourceNotFound(err) {
+			return nil, &ErrLogGroupNotFound{logGroup: logGroup}
+		}
 		return nil, fmt.Errorf("describe log streams of log group %s: %w", logGroup, err)
 	}
 	if len(resp.LogStreams) == 0 {
-		return nil, fmt.Errorf("no log stream found in log group %s", logGroup)
+		return nil, &ErrNoLogStreams{logGroup: logGroup}
 	}
 	var logStreamNames []string
 	for _, logStream := range resp.LogStreams {
@@ -88,46 +100,51 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]st
 }
 
 // LogEvents returns an array of Cloudwatch Logs events.
+// The events of the log streams are retrieved in parallel if there are many log streams.
 func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
-	var events []*Event
-	in := initGetLogEventsInput(opts)
 	logStreams, err := c.logStreams(opts.LogGroup, opts.LogStreams...)
 	if err != nil {
 		return nil, err
 	}
-	streamLastEventTime := make(map[string]int64)
-	for k, v := range opts.StreamLastEventTime {
-		streamLastEventTime[k] = v
-	}
-	for _, logStream := range logStreams {
-		// Set override value
-		in.SetLogStreamName(logStream)
-		if streamLastEventTime[logStream] != 0 {
+	eventsOf := make([][]*Event, len(logStreams))
+	getStreamEvents := func(i int) error {
+		in := initGetLogEventsInput(opts)
+		in.SetLogStreamName(logStreams[i])
+		if lastEventTime := opts.StreamLastEventTime[logStreams[i]]; lastEventTime != 0 {
 			// If last event for this log stream exists, increment last log event timestamp
 			// by one to get logs after the last event.
-			in.SetStartTime(streamLastEventTime[logStream] + 1)
+			in.SetStartTime(lastEventTime + 1)
 		}
 		// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
-		resp, err := c.client.GetLogEvents(in)
+		streamEvents, err := c.streamEvents(in, opts.FilterPattern)
 		if err != nil {
-			return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
+			return fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStreams[i], err)
 		}
+		eventsOf[i] = streamEvents
+		return nil
+	}
+	if len(logStreams) > maxSequentialLogStreams {
+		err = inParallel(len(logStreams), maxConcurrentLogStreams, getStreamEvents)
+	} else {
+		err = inSequence(len(logStreams), getStreamEvents)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-		for _, event := range resp.Events {
-			log := &Event{
-				LogStreamName: logStream,
-				IngestionTime: aws.Int64Value(event.IngestionTime),
-				Message:       aws.StringValue(event.Message),
-				Timestamp:     aws.Int64Value(event.Timestamp),
-			}
-			events = append(events, log)
-		}
-		if len(resp.Events) != 0 {
-			streamLastEventTime[logStream] = *resp.Events[len(resp.Events)-1].Timestamp
+	var events []*Event
+	streamLastEventTime := make(map[string]int64)
+	for k, v := range opts.StreamLastEventTime {
+		streamLastEventTime[k] = v
+	}
+	for i, streamEvents := range eventsOf {
+		events = append(events, streamEvents...)
+		if len(streamEvents) != 0 {
+			streamLastEventTime[logStreams[i]] = streamEvents[len(streamEvents)-1].Timestamp
 		}
 	}
 	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
-	limit := int(aws.Int64Value(in.Limit))
+	limit := int(aws.Int64Value(opts.Limit))
 	if limit != 0 {
 		return &LogEventsOutput{
 			Events:              truncateEvents(limit, events),
@@ -140,6 +157,86 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// inSequence calls fn for each index from 0 to n-1 and stops at the first error.
+func inSequence(n int, fn func(i int) error) error {
+	for i := 0; i < n; i++ {
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inParallel calls fn for each index from 0 to n-1 with at most concurrency calls at a time,
+// and returns the error of the lowest index once all the calls are done.
+func inParallel(n, concurrency int, fn func(i int) error) error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamEvents returns the events of the log stream of the input.
+// If the filter pattern isn't empty, only the events matching the pattern are returned.
+func (c *CloudWatchLogs) streamEvents(in *cloudwatchlogs.GetLogEventsInput, filterPattern string) ([]*Event, error) {
+	logStream := aws.StringValue(in.LogStreamName)
+	container, _, _ := parseLogStreamName(logStream)
+	var events []*Event
+	if filterPattern == "" {
+		resp, err := c.client.GetLogEvents(in)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.Events {
+			events = append(events, &Event{
+				LogStreamName: logStream,
+				ContainerName: container,
+				IngestionTime: aws.Int64Value(event.IngestionTime),
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+			})
+		}
+		return events, nil
+	}
+	resp, err := c.client.FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:   in.LogGroupName,
+		LogStreamNames: aws.StringSlice([]string{logStream}),
+		FilterPattern:  aws.String(filterPattern),
+		StartTime:      in.StartTime,
+		EndTime:        in.EndTime,
+		Limit:          in.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range resp.Events {
+		events = append(events, &Event{
+			LogStreamName: logStream,
+			ContainerName: container,
+			IngestionTime: aws.Int64Value(event.IngestionTime),
+			Message:       aws.StringValue(event.Message),
+			Timestamp:     aws.Int64Value(event.Timestamp),
+			FilterPattern: filterPattern,
+		})
+	}
+	return events, nil
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
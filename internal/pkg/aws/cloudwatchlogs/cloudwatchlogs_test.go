@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs/mocks"
 	"github.com/golang/mock/gomock"
@@ -24,6 +25,7 @@ func TestLogEvents(t *testing.T) {
 		endTime                  *int64
 		limit                    *int64
 		lastEventTime            map[string]int64
+		filterPattern            string
 		mockcloudwatchlogsClient func(m *mocks.Mockapi)
 
 		wantLogEvents     []*Event
@@ -80,11 +82,13 @@ func TestLogEvents(t *testing.T) {
 			wantLogEvents: []*Event{
 				{
 					LogStreamName: "copilot/mockLogGroup/goodLogStream2",
+					ContainerName: "mockLogGroup",
 					Message:       "other log",
 					Timestamp:     0,
 				},
 				{
 					LogStreamName: "copilot/mockLogGroup/goodLogStream1",
+					ContainerName: "mockLogGroup",
 					Message:       "some log",
 					Timestamp:     1,
 				},
@@ -131,6 +135,7 @@ func TestLogEvents(t *testing.T) {
 			wantLogEvents: []*Event{
 				{
 					LogStreamName: "copilot/mockLogGroup/mockLogStream",
+					ContainerName: "mockLogGroup",
 					Message:       "some log",
 					Timestamp:     1234892,
 				},
@@ -177,6 +182,7 @@ func TestLogEvents(t *testing.T) {
 			wantLogEvents: []*Event{
 				{
 					LogStreamName: "copilot/mockLogGroup/mockLogStream",
+					ContainerName: "mockLogGroup",
 					Message:       "other log",
 					Timestamp:     1,
 				},
@@ -186,6 +192,49 @@ func TestLogEvents(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"should filter log events with the filter pattern": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "?ERROR ?FATAL",
+			lastEventTime: map[string]int64{
+				"mockLogStream": 5,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetLogEvents(gomock.Any()).Times(0)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice([]string{"mockLogStream"}),
+					FilterPattern:  aws.String("?ERROR ?FATAL"),
+					StartTime:      aws.Int64(6),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+							Message:       aws.String("ERROR some error"),
+							Timestamp:     aws.Int64(8),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "mockLogStream",
+					Message:       "ERROR some error",
+					Timestamp:     8,
+					FilterPattern: "?ERROR ?FATAL",
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"mockLogStream": 8,
+			},
+		},
 		"returns error if fail to describe log streams": {
 			logGroupName: "mockLogGroup",
 			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
@@ -212,7 +261,71 @@ func TestLogEvents(t *testing.T) {
 			},
 
 			wantLogEvents: nil,
-			wantErr:       fmt.Errorf("no log stream found in log group %s", "mockLogGroup"),
+			wantErr:       &ErrNoLogStreams{logGroup: "mockLogGroup"},
+		},
+		"returns error if log group does not exist": {
+			logGroupName: "mockLogGroup",
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					Descending:   aws.Bool(true),
+					OrderBy:      aws.String("LastEventTime"),
+				}).Return(nil, awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "The specified log group does not exist.", nil))
+			},
+
+			wantLogEvents: nil,
+			wantErr:       &ErrLogGroupNotFound{logGroup: "mockLogGroup"},
+		},
+		"should get the log events of many log streams in parallel": {
+			logGroupName: "mockLogGroup",
+			lastEventTime: map[string]int64{
+				"mockLogStream3": 10,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				var logStreams []*cloudwatchlogs.LogStream
+				for i := 0; i < 6; i++ {
+					logStreams = append(logStreams, &cloudwatchlogs.LogStream{
+						LogStreamName: aws.String(fmt.Sprintf("mockLogStream%d", i)),
+					})
+				}
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: logStreams,
+				}, nil)
+				for i := 0; i < 6; i++ {
+					in := &cloudwatchlogs.GetLogEventsInput{
+						LogGroupName:  aws.String("mockLogGroup"),
+						LogStreamName: aws.String(fmt.Sprintf("mockLogStream%d", i)),
+					}
+					if i == 3 {
+						in.StartTime = aws.Int64(11)
+					}
+					m.EXPECT().GetLogEvents(in).Return(&cloudwatchlogs.GetLogEventsOutput{
+						Events: []*cloudwatchlogs.OutputLogEvent{
+							{
+								Message:   aws.String(fmt.Sprintf("log %d", i)),
+								Timestamp: aws.Int64(int64(20 - i)),
+							},
+						},
+					}, nil)
+				}
+			},
+
+			wantLogEvents: []*Event{
+				{LogStreamName: "mockLogStream5", Message: "log 5", Timestamp: 15},
+				{LogStreamName: "mockLogStream4", Message: "log 4", Timestamp: 16},
+				{LogStreamName: "mockLogStream3", Message: "log 3", Timestamp: 17},
+				{LogStreamName: "mockLogStream2", Message: "log 2", Timestamp: 18},
+				{LogStreamName: "mockLogStream1", Message: "log 1", Timestamp: 19},
+				{LogStreamName: "mockLogStream0", Message: "log 0", Timestamp: 20},
+			},
+			wantLastEventTime: map[string]int64{
+				"mockLogStream0": 20,
+				"mockLogStream1": 19,
+				"mockLogStream2": 18,
+				"mockLogStream3": 17,
+				"mockLogStream4": 16,
+				"mockLogStream5": 15,
+			},
 		},
 		"returns error if fail to get log events": {
 			logGroupName: "mockLogGroup",
@@ -258,6 +371,7 @@ func TestLogEvents(t *testing.T) {
 				LogStreams:          tc.logStream,
 				StartTime:           tc.startTime,
 				StreamLastEventTime: tc.lastEventTime,
+				FilterPattern:       tc.filterPattern,
 			})
 
 			if gotErr != nil {
@@ -0,0 +1,38 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+// ErrLogGroupNotFound occurs when a log group does not exist.
+type ErrLogGroupNotFound struct {
+	logGroup string
+}
+
+func (e *ErrLogGroupNotFound) Error() string {
+	return fmt.Sprintf("log group %s cannot be found", e.logGroup)
+}
+
+// ErrNoLogStreams occurs when a log group does not contain any log stream.
+type ErrNoLogStreams struct {
+	logGroup string
+}
+
+func (e *ErrNoLogStreams) Error() string {
+	return fmt.Sprintf("no log stream found in log group %s", e.logGroup)
+}
+
+func isResourceNotFound(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException
+}
@@ -7,7 +7,9 @@ package cloudwatchlogs
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"regexp"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	c "github.com/fatih/color"
@@ -15,14 +17,22 @@ import (
 
 const (
 	shortLogStreamNameLength = 25
+	shortTaskIDLength        = 8
+	containerNameColumnWidth = 20
 )
 
+// containerColors are the colors of the container names, a container is always printed with the same color.
+// Red and yellow are left out since they highlight errors and warnings in the messages.
+var containerColors = []*c.Color{color.Cyan, color.Green, color.HiBlue, c.New(c.FgMagenta), color.HiCyan, c.New(c.FgHiMagenta)}
+
 // Event represents a log event.
 type Event struct {
 	LogStreamName string `json:"logStreamName"`
+	ContainerName string `json:"containerName,omitempty"` // Parsed from the log stream name, empty if it isn't named by the awslogs driver.
 	IngestionTime int64  `json:"ingestionTime"`
 	Message       string `json:"message"`
 	Timestamp     int64  `json:"timestamp"`
+	FilterPattern string `json:"filterPattern,omitempty"` // The pattern the event matched, if the events were filtered.
 }
 
 // JSONString returns the stringified LogEvent struct with json format.
@@ -42,7 +52,14 @@ func (l *Event) HumanString() string {
 	for _, code := range warningCodes {
 		l.Message = colorCodeMessage(l.Message, code, color.Yellow)
 	}
-	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.Message)
+	container, taskID, ok := parseLogStreamName(l.LogStreamName)
+	if !ok {
+		return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.Message)
+	}
+	if len(taskID) > shortTaskIDLength {
+		taskID = taskID[:shortTaskIDLength]
+	}
+	return fmt.Sprintf("%s %s %s\n", containerColor(container).Sprintf("%-*s", containerNameColumnWidth, container), color.Grey.Sprint(taskID), l.Message)
 }
 
 func (l *Event) shortLogStreamName() string {
@@ -52,6 +69,22 @@ func (l *Event) shortLogStreamName() string {
 	return l.LogStreamName[0:shortLogStreamNameLength]
 }
 
+// parseLogStreamName returns the container name and the task ID of a log stream
+// named by the awslogs driver, "{prefix}/{container name}/{task ID}".
+func parseLogStreamName(name string) (container, taskID string, ok bool) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+func containerColor(container string) *c.Color {
+	h := fnv.New32a()
+	h.Write([]byte(container))
+	return containerColors[h.Sum32()%uint32(len(containerColors))]
+}
+
 // colorCodeMessage returns the given message with color applied to every occurence of code
 func colorCodeMessage(message string, code string, colorToApply *c.Color) string {
 	if c.NoColor {
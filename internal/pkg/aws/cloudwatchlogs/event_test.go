@@ -59,3 +59,88 @@ func TestColorCodeMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_JSONString(t *testing.T) {
+	testCases := map[string]struct {
+		event *Event
+
+		wanted string
+	}{
+		"omits the filter pattern if events aren't filtered": {
+			event: &Event{
+				LogStreamName: "copilot/api/abc",
+				Message:       "some log",
+				Timestamp:     1,
+			},
+			wanted: `{"logStreamName":"copilot/api/abc","ingestionTime":0,"message":"some log","timestamp":1}` + "\n",
+		},
+		"includes the container name parsed from the log stream name": {
+			event: &Event{
+				LogStreamName: "copilot/envoy/abc",
+				ContainerName: "envoy",
+				Message:       "some log",
+				Timestamp:     1,
+			},
+			wanted: `{"logStreamName":"copilot/envoy/abc","containerName":"envoy","ingestionTime":0,"message":"some log","timestamp":1}` + "\n",
+		},
+		"includes the matched filter pattern": {
+			event: &Event{
+				LogStreamName: "copilot/api/abc",
+				Message:       "ERROR some error",
+				Timestamp:     1,
+				FilterPattern: "?ERROR ?FATAL ?panic",
+			},
+			wanted: `{"logStreamName":"copilot/api/abc","ingestionTime":0,"message":"ERROR some error","timestamp":1,"filterPattern":"?ERROR ?FATAL ?panic"}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.event.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEvent_HumanString(t *testing.T) {
+	noColor := c.NoColor
+	c.NoColor = true
+	defer func() {
+		c.NoColor = noColor
+	}()
+	testCases := map[string]struct {
+		event *Event
+
+		wanted string
+	}{
+		"prints the container name and the short task ID of an awslogs log stream": {
+			event: &Event{
+				LogStreamName: "copilot/frontend/fcfe4ab8043841c08162318e5ad805f1",
+				Message:       "some log",
+			},
+			wanted: "frontend             fcfe4ab8 some log\n",
+		},
+		"aligns the messages of sidecars": {
+			event: &Event{
+				LogStreamName: "copilot/firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+				Message:       "some log",
+			},
+			wanted: "firelens_log_router  fcfe4ab8 some log\n",
+		},
+		"falls back to the truncated log stream name if it isn't named by the awslogs driver": {
+			event: &Event{
+				LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+				Message:       "some log",
+			},
+			wanted: "firelens_log_router/fcfe4 some log\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.event.HumanString())
+		})
+	}
+}
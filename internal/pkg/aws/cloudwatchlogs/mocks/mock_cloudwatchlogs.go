@@ -62,3 +62,18 @@ func (mr *MockapiMockRecorder) GetLogEvents(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEvents", reflect.TypeOf((*Mockapi)(nil).GetLogEvents), input)
 }
+
+// FilterLogEvents mocks base method
+func (m *Mockapi) FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogEvents", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogEvents indicates an expected call of FilterLogEvents
+func (mr *MockapiMockRecorder) FilterLogEvents(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEvents", reflect.TypeOf((*Mockapi)(nil).FilterLogEvents), input)
+}
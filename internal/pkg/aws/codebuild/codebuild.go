@@ -0,0 +1,111 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+type api interface {
+	StartBuild(input *codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error)
+	BatchGetBuilds(input *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error)
+}
+
+// CodeBuild wraps an AWS CodeBuild client.
+type CodeBuild struct {
+	client api
+}
+
+// StartBuildInput holds the overrides of a project's configuration for a single build.
+type StartBuildInput struct {
+	Project   string
+	Buildspec string            // Buildspec that replaces the one of the project.
+	EnvVars   map[string]string // Plaintext environment variables of the build.
+}
+
+// Build holds the status of a build and where its logs are.
+type Build struct {
+	ID            string
+	Status        string // One of "IN_PROGRESS", "SUCCEEDED", "FAILED", "FAULT", "TIMED_OUT" or "STOPPED".
+	LogGroupName  string // Empty until the build starts writing logs.
+	LogStreamName string
+	LogsURL       string // Link to the logs in the CloudWatch console.
+}
+
+// IsComplete returns true if the build is no longer running.
+func (b *Build) IsComplete() bool {
+	return b.Status != codebuild.StatusTypeInProgress
+}
+
+// IsSuccess returns true if the build completed successfully.
+func (b *Build) IsSuccess() bool {
+	return b.Status == codebuild.StatusTypeSucceeded
+}
+
+// New returns a CodeBuild client configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client: codebuild.New(s),
+	}
+}
+
+// StartBuild starts a build of the project without source code and returns it.
+func (c *CodeBuild) StartBuild(in StartBuildInput) (*Build, error) {
+	var names []string
+	for name := range in.EnvVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var envVars []*codebuild.EnvironmentVariable
+	for _, name := range names {
+		envVars = append(envVars, &codebuild.EnvironmentVariable{
+			Name:  aws.String(name),
+			Value: aws.String(in.EnvVars[name]),
+			Type:  aws.String(codebuild.EnvironmentVariableTypePlaintext),
+		})
+	}
+	out, err := c.client.StartBuild(&codebuild.StartBuildInput{
+		ProjectName:                  aws.String(in.Project),
+		SourceTypeOverride:           aws.String(codebuild.SourceTypeNoSource),
+		BuildspecOverride:            aws.String(in.Buildspec),
+		EnvironmentVariablesOverride: envVars,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start build of project %s: %w", in.Project, err)
+	}
+	return toBuild(out.Build), nil
+}
+
+// Build returns the current status of the build.
+func (c *CodeBuild) Build(id string) (*Build, error) {
+	out, err := c.client.BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+		Ids: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get build %s: %w", id, err)
+	}
+	if len(out.Builds) == 0 {
+		return nil, fmt.Errorf("build %s not found", id)
+	}
+	return toBuild(out.Builds[0]), nil
+}
+
+func toBuild(b *codebuild.Build) *Build {
+	build := &Build{
+		ID:     aws.StringValue(b.Id),
+		Status: aws.StringValue(b.BuildStatus),
+	}
+	if b.Logs != nil {
+		build.LogGroupName = aws.StringValue(b.Logs.GroupName)
+		build.LogStreamName = aws.StringValue(b.Logs.StreamName)
+		build.LogsURL = aws.StringValue(b.Logs.DeepLink)
+	}
+	return build
+}
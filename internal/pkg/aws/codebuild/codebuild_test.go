@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_StartBuild(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedBuild *Build
+		wantedErr   error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("start build of project phonetool-image-build: some error"),
+		},
+		"starts a build without source with the buildspec and environment variables": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(&codebuild.StartBuildInput{
+					ProjectName:        aws.String("phonetool-image-build"),
+					SourceTypeOverride: aws.String("NO_SOURCE"),
+					BuildspecOverride:  aws.String("version: 0.2"),
+					EnvironmentVariablesOverride: []*codebuild.EnvironmentVariable{
+						{
+							Name:  aws.String("IMAGE_TAG"),
+							Value: aws.String("v1"),
+							Type:  aws.String("PLAINTEXT"),
+						},
+						{
+							Name:  aws.String("SOURCE_KEY"),
+							Value: aws.String("images/frontend.tar.gz"),
+							Type:  aws.String("PLAINTEXT"),
+						},
+					},
+				}).Return(&codebuild.StartBuildOutput{
+					Build: &codebuild.Build{
+						Id:          aws.String("phonetool-image-build:1234"),
+						BuildStatus: aws.String("IN_PROGRESS"),
+					},
+				}, nil)
+			},
+			wantedBuild: &Build{
+				ID:     "phonetool-image-build:1234",
+				Status: "IN_PROGRESS",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cb := CodeBuild{
+				client: m,
+			}
+
+			// WHEN
+			build, err := cb.StartBuild(StartBuildInput{
+				Project:   "phonetool-image-build",
+				Buildspec: "version: 0.2",
+				EnvVars: map[string]string{
+					"SOURCE_KEY": "images/frontend.tar.gz",
+					"IMAGE_TAG":  "v1",
+				},
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuild, build)
+		})
+	}
+}
+
+func TestCodeBuild_Build(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedBuild *Build
+		wantedErr   error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get build phonetool-image-build:1234: some error"),
+		},
+		"returns error if the build doesn't exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{
+					BuildsNotFound: aws.StringSlice([]string{"phonetool-image-build:1234"}),
+				}, nil)
+			},
+			wantedErr: errors.New("build phonetool-image-build:1234 not found"),
+		},
+		"returns the status and logs of the build": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+					Ids: aws.StringSlice([]string{"phonetool-image-build:1234"}),
+				}).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							Id:          aws.String("phonetool-image-build:1234"),
+							BuildStatus: aws.String("FAILED"),
+							Logs: &codebuild.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/phonetool-image-build"),
+								StreamName: aws.String("1234"),
+								DeepLink:   aws.String("https://console.aws.amazon.com/cloudwatch/home"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedBuild: &Build{
+				ID:            "phonetool-image-build:1234",
+				Status:        "FAILED",
+				LogGroupName:  "/aws/codebuild/phonetool-image-build",
+				LogStreamName: "1234",
+				LogsURL:       "https://console.aws.amazon.com/cloudwatch/home",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cb := CodeBuild{
+				client: m,
+			}
+
+			// WHEN
+			build, err := cb.Build("phonetool-image-build:1234")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuild, build)
+		})
+	}
+}
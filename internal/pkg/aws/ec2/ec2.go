@@ -6,6 +6,7 @@ package ec2
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -62,6 +63,7 @@ type api interface {
 	DescribeSecurityGroups(*ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error)
 	DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
 	DescribeVpcAttribute(input *ec2.DescribeVpcAttributeInput) (*ec2.DescribeVpcAttributeOutput, error)
+	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -166,6 +168,53 @@ func (c *EC2) HasDNSSupport(vpcID string) (bool, error) {
 	return aws.BoolValue(resp.EnableDnsSupport.Value), nil
 }
 
+// HasIPv6CIDR returns if an IPv6 CIDR block is associated with the VPC.
+func (c *EC2) HasIPv6CIDR(vpcID string) (bool, error) {
+	resp, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe VPC %s: %w", vpcID, err)
+	}
+	for _, vpc := range resp.Vpcs {
+		for _, assoc := range vpc.Ipv6CidrBlockAssociationSet {
+			if assoc.Ipv6CidrBlockState == nil {
+				continue
+			}
+			if aws.StringValue(assoc.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ListAZs returns the names of the availability zones of the region that are available, sorted by name.
+// Local Zones and Wavelength Zones are excluded.
+func (c *EC2) ListAZs() ([]string, error) {
+	resp, err := c.client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "state",
+				Values: []string{ec2.AvailabilityZoneStateAvailable},
+			},
+			{
+				Name:   "zone-type",
+				Values: []string{"availability-zone"},
+			},
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe availability zones: %w", err)
+	}
+	var azs []string
+	for _, az := range resp.AvailabilityZones {
+		azs = append(azs, aws.StringValue(az.ZoneName))
+	}
+	sort.Strings(azs)
+	return azs, nil
+}
+
 // ListVPCSubnets lists all subnets given a VPC ID.
 func (c *EC2) ListVPCSubnets(vpcID string, opts ...ListVPCSubnetsOpts) ([]string, error) {
 	respSubnets, err := c.subnets(Filter{
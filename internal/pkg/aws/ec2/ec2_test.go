@@ -482,3 +482,147 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_HasIPv6CIDR(t *testing.T) {
+	testCases := map[string]struct {
+		vpcID string
+
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIPv6  bool
+	}{
+		"fail to describe VPC": {
+			vpcID: "mockVPCID",
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe VPC mockVPCID: some error"),
+		},
+		"no IPv6 CIDR block associated": {
+			vpcID: "mockVPCID",
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					VpcIds: aws.StringSlice([]string{"mockVPCID"}),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("mockVPCID"),
+							Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+								{
+									Ipv6CidrBlock: aws.String("2600:1f14:abc:de00::/56"),
+									Ipv6CidrBlockState: &ec2.VpcCidrBlockState{
+										State: aws.String(ec2.VpcCidrBlockStateCodeDisassociated),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedIPv6: false,
+		},
+		"success": {
+			vpcID: "mockVPCID",
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					VpcIds: aws.StringSlice([]string{"mockVPCID"}),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("mockVPCID"),
+							Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+								{
+									Ipv6CidrBlock: aws.String("2600:1f14:abc:de00::/56"),
+									Ipv6CidrBlockState: &ec2.VpcCidrBlockState{
+										State: aws.String(ec2.VpcCidrBlockStateCodeAssociated),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedIPv6: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ipv6, err := ec2Client.HasIPv6CIDR(tc.vpcID)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIPv6, ipv6)
+			}
+		})
+	}
+}
+
+func TestEC2_ListAZs(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedAZs   []string
+	}{
+		"fail to describe availability zones": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZones(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe availability zones: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{"available"}),
+						},
+						{
+							Name:   aws.String("zone-type"),
+							Values: aws.StringSlice([]string{"availability-zone"}),
+						},
+					},
+				}).Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: []*ec2.AvailabilityZone{
+						{ZoneName: aws.String("us-east-1b")},
+						{ZoneName: aws.String("us-east-1a")},
+						{ZoneName: aws.String("us-east-1c")},
+					},
+				}, nil)
+			},
+			wantedAZs: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			azs, err := ec2Client.ListAZs()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAZs, azs)
+			}
+		})
+	}
+}
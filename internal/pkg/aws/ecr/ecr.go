@@ -6,9 +6,11 @@ package ecr
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -22,6 +24,12 @@ const (
 	urlFmtString      = "%s.dkr.ecr.%s.amazonaws.com/%s"
 	arnResourcePrefix = "repository/"
 	batchDeleteLimit  = 100
+
+	// DeployedImageTagPrefix is the prefix of the tags that mark the images currently deployed to an environment.
+	// Images with such a tag are never expired by the image retention policy.
+	DeployedImageTagPrefix = "copilot-deployed-"
+	// Keep up to this many deployed images, one per environment, so that the rule never expires any of them.
+	maxDeployedImages = 1000
 )
 
 type api interface {
@@ -29,6 +37,10 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	BatchGetImage(*ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error)
+	PutImage(*ecr.PutImageInput) (*ecr.PutImageOutput, error)
+	GetLifecyclePolicy(*ecr.GetLifecyclePolicyInput) (*ecr.GetLifecyclePolicyOutput, error)
+	PutLifecyclePolicy(*ecr.PutLifecyclePolicyInput) (*ecr.PutLifecyclePolicyOutput, error)
 }
 
 // ECR wraps an AWS ECR client.
@@ -67,6 +79,9 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 		RepositoryNames: aws.StringSlice([]string{name}),
 	})
 
+	if isRepoNotFoundErr(err) {
+		return "", &ErrRepositoryNotFound{name: name}
+	}
 	if err != nil {
 		return "", fmt.Errorf("ecr describe repository %s: %w", name, err)
 	}
@@ -85,14 +100,27 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 // Image houses metadata for ECR repository images.
 type Image struct {
 	Digest string
+	Tag    string
 }
 
 func (i Image) imageIdentifier() *ecr.ImageIdentifier {
+	if i.Digest == "" {
+		return &ecr.ImageIdentifier{
+			ImageTag: aws.String(i.Tag),
+		}
+	}
 	return &ecr.ImageIdentifier{
 		ImageDigest: aws.String(i.Digest),
 	}
 }
 
+func (i Image) String() string {
+	if i.Digest == "" {
+		return i.Tag
+	}
+	return i.Digest
+}
+
 // ListImages calls the ECR DescribeImages API and returns a list of
 // Image metadata for images in the input ECR repository name.
 func (c ECR) ListImages(repoName string) ([]Image, error) {
@@ -125,6 +153,73 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 	return images, nil
 }
 
+// Repository holds the name and URI of an ECR repository.
+type Repository struct {
+	Name string
+	URI  string
+}
+
+// ListRepositories calls the ECR DescribeRepositories API and returns the repositories of the account in the region.
+func (c ECR) ListRepositories() ([]Repository, error) {
+	var repos []Repository
+	var nextToken *string
+	for {
+		resp, err := c.client.DescribeRepositories(&ecr.DescribeRepositoriesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ecr describe repositories: %w", err)
+		}
+		for _, repo := range resp.Repositories {
+			repos = append(repos, Repository{
+				Name: aws.StringValue(repo.RepositoryName),
+				URI:  aws.StringValue(repo.RepositoryUri),
+			})
+		}
+		if resp.NextToken == nil {
+			return repos, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
+// TaggedImage is a tag of an image in an ECR repository along with the time the image was pushed.
+type TaggedImage struct {
+	Tag      string
+	PushedAt time.Time
+}
+
+// ListTaggedImages calls the ECR DescribeImages API and returns the tags of the images in the input ECR repository name.
+// An image with several tags is listed once per tag.
+func (c ECR) ListTaggedImages(repoName string) ([]TaggedImage, error) {
+	var images []TaggedImage
+	var nextToken *string
+	for {
+		resp, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+			RepositoryName: aws.String(repoName),
+			Filter: &ecr.DescribeImagesFilter{
+				TagStatus: aws.String(ecr.TagStatusTagged),
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
+		}
+		for _, image := range resp.ImageDetails {
+			for _, tag := range image.ImageTags {
+				images = append(images, TaggedImage{
+					Tag:      aws.StringValue(tag),
+					PushedAt: aws.TimeValue(image.ImagePushedAt),
+				})
+			}
+		}
+		if resp.NextToken == nil {
+			return images, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
@@ -173,6 +268,165 @@ func (c ECR) ClearRepository(repoName string) error {
 	return err
 }
 
+// DeployedImageTag returns the tag that marks the image run by a container of a service deployed to the environment.
+func DeployedImageTag(env, container string) string {
+	return fmt.Sprintf("%s%s-%s", DeployedImageTagPrefix, env, container)
+}
+
+// TagImage adds the tag to the image in the repository, the image is identified by either its digest or one of its tags.
+// If the tag already points to another image, the tag is moved to this image.
+func (c ECR) TagImage(repoName string, image Image, tag string) error {
+	resp, err := c.client.BatchGetImage(&ecr.BatchGetImageInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds:       []*ecr.ImageIdentifier{image.imageIdentifier()},
+	})
+	if err != nil {
+		return fmt.Errorf("ecr repo %s batch get image %s: %w", repoName, image, err)
+	}
+	if len(resp.Images) == 0 {
+		return fmt.Errorf("image %s not found in ecr repo %s", image, repoName)
+	}
+	found := resp.Images[0]
+	_, err = c.client.PutImage(&ecr.PutImageInput{
+		RepositoryName:         aws.String(repoName),
+		ImageManifest:          found.ImageManifest,
+		ImageManifestMediaType: found.ImageManifestMediaType,
+		ImageTag:               aws.String(tag),
+	})
+	if err != nil && !isAWSErrCode(err, ecr.ErrCodeImageAlreadyExistsException) {
+		return fmt.Errorf("ecr repo %s tag image %s with %s: %w", repoName, image, tag, err)
+	}
+	return nil
+}
+
+// ImageExists returns true if an image in the repository has the tag.
+func (c ECR) ImageExists(repoName, tag string) (bool, error) {
+	_, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds:       []*ecr.ImageIdentifier{Image{Tag: tag}.imageIdentifier()},
+	})
+	if err != nil {
+		if isAWSErrCode(err, ecr.ErrCodeImageNotFoundException) {
+			return false, nil
+		}
+		return false, fmt.Errorf("ecr repo %s describe image %s: %w", repoName, tag, err)
+	}
+	return true, nil
+}
+
+// ImageDigest returns the digest, such as "sha256:...", of the image in the repository that has the tag.
+func (c ECR) ImageDigest(repoName, tag string) (string, error) {
+	resp, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds:       []*ecr.ImageIdentifier{Image{Tag: tag}.imageIdentifier()},
+	})
+	if err != nil {
+		return "", fmt.Errorf("ecr repo %s describe image %s: %w", repoName, tag, err)
+	}
+	if len(resp.ImageDetails) == 0 {
+		return "", fmt.Errorf("image %s not found in ecr repo %s", tag, repoName)
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
+type lifecyclePolicy struct {
+	Rules []lifecycleRule `json:"rules"`
+}
+
+type lifecycleRule struct {
+	RulePriority int                `json:"rulePriority"`
+	Description  string             `json:"description"`
+	Selection    lifecycleSelection `json:"selection"`
+	Action       lifecycleAction    `json:"action"`
+}
+
+type lifecycleSelection struct {
+	TagStatus      string   `json:"tagStatus"`
+	TagPrefixList  []string `json:"tagPrefixList,omitempty"`
+	TagPatternList []string `json:"tagPatternList,omitempty"`
+	CountType      string   `json:"countType"`
+	CountNumber    int      `json:"countNumber"`
+}
+
+type lifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// Lifecycle policy values, see https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html
+const (
+	lifecycleTagStatusTagged = "tagged"
+	lifecycleActionExpire    = "expire"
+
+	deployedImagesRulePriority = 1
+	retentionRulePriority      = 2
+)
+
+// SetImageRetention puts a lifecycle policy on the repository that expires all but the count most recently pushed
+// tagged images. Images tagged with DeployedImageTagPrefix are always kept.
+func (c ECR) SetImageRetention(repoName string, count int) error {
+	policy := lifecyclePolicy{
+		Rules: []lifecycleRule{
+			{
+				RulePriority: deployedImagesRulePriority,
+				Description:  "Keep the images deployed to environments.",
+				Selection: lifecycleSelection{
+					TagStatus:     lifecycleTagStatusTagged,
+					TagPrefixList: []string{DeployedImageTagPrefix},
+					CountType:     ecr.ImageCountTypeImageCountMoreThan,
+					CountNumber:   maxDeployedImages,
+				},
+				Action: lifecycleAction{Type: lifecycleActionExpire},
+			},
+			{
+				RulePriority: retentionRulePriority,
+				Description:  fmt.Sprintf("Keep the %d most recent tagged images.", count),
+				Selection: lifecycleSelection{
+					TagStatus:      lifecycleTagStatusTagged,
+					TagPatternList: []string{"*"},
+					CountType:      ecr.ImageCountTypeImageCountMoreThan,
+					CountNumber:    count,
+				},
+				Action: lifecycleAction{Type: lifecycleActionExpire},
+			},
+		},
+	}
+	text, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("marshal lifecycle policy: %w", err)
+	}
+	if _, err := c.client.PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repoName),
+		LifecyclePolicyText: aws.String(string(text)),
+	}); err != nil {
+		return fmt.Errorf("ecr repo %s put lifecycle policy: %w", repoName, err)
+	}
+	return nil
+}
+
+// ImageRetention returns the number of tagged images kept by the lifecycle policy of the repository.
+// It returns 0 if the repository doesn't have an image retention policy.
+func (c ECR) ImageRetention(repoName string) (int, error) {
+	resp, err := c.client.GetLifecyclePolicy(&ecr.GetLifecyclePolicyInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		if isAWSErrCode(err, ecr.ErrCodeLifecyclePolicyNotFoundException) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("ecr repo %s get lifecycle policy: %w", repoName, err)
+	}
+	var policy lifecyclePolicy
+	if err := json.Unmarshal([]byte(aws.StringValue(resp.LifecyclePolicyText)), &policy); err != nil {
+		return 0, fmt.Errorf("unmarshal lifecycle policy of ecr repo %s: %w", repoName, err)
+	}
+	for _, rule := range policy.Rules {
+		if rule.RulePriority == retentionRulePriority && rule.Selection.CountType == ecr.ImageCountTypeImageCountMoreThan {
+			return rule.Selection.CountNumber, nil
+		}
+	}
+	return 0, nil
+}
+
 // URIFromARN converts an ECR Repo ARN to a Repository URI
 func URIFromARN(repositoryARN string) (string, error) {
 	repoARN, err := arn.Parse(repositoryARN)
@@ -188,6 +442,20 @@ func URIFromARN(repositoryARN string) (string, error) {
 		repoName), nil
 }
 
+// ErrRepositoryNotFound is returned when a repository doesn't exist in the registry.
+type ErrRepositoryNotFound struct {
+	name string
+}
+
+func (e *ErrRepositoryNotFound) Error() string {
+	return fmt.Sprintf("repository %s not found", e.name)
+}
+
+func isAWSErrCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
+
 func isRepoNotFoundErr(err error) bool {
 	aerr, ok := err.(awserr.Error)
 	if !ok {
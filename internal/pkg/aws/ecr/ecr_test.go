@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -94,6 +95,12 @@ func TestRepositoryURI(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("ecr describe repository %s: %w", mockRepoName, mockError),
 		},
+		"should return ErrRepositoryNotFound if the repository doesn't exist": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(gomock.Any()).Return(nil, awserr.New("RepositoryNotFoundException", "some error", nil))
+			},
+			wantErr: &ErrRepositoryNotFound{name: mockRepoName},
+		},
 		"should return error given no repositories returned in list": {
 			mockECRClient: func(m *mocks.Mockapi) {
 				m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{
@@ -260,6 +267,158 @@ func TestListImages(t *testing.T) {
 	}
 }
 
+func TestListRepositories(t *testing.T) {
+	mockNextToken := "next"
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantRepos []Repository
+		wantError error
+	}{
+		"should wrap error returned by ECR DescribeRepositories": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("ecr describe repositories: some error"),
+		},
+		"should return all repositories when paginated": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{}).Return(&ecr.DescribeRepositoriesOutput{
+					Repositories: []*ecr.Repository{
+						{
+							RepositoryName: aws.String("phonetool/frontend"),
+							RepositoryUri:  aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"),
+						},
+					},
+					NextToken: &mockNextToken,
+				}, nil)
+				m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{
+					NextToken: &mockNextToken,
+				}).Return(&ecr.DescribeRepositoriesOutput{
+					Repositories: []*ecr.Repository{
+						{
+							RepositoryName: aws.String("nginx"),
+							RepositoryUri:  aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/nginx"),
+						},
+					},
+				}, nil)
+			},
+			wantRepos: []Repository{
+				{Name: "phonetool/frontend", URI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"},
+				{Name: "nginx", URI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/nginx"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			got, err := client.ListRepositories()
+
+			// THEN
+			if tc.wantError != nil {
+				require.EqualError(t, err, tc.wantError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRepos, got)
+		})
+	}
+}
+
+func TestListTaggedImages(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockNextToken := "next"
+	pushedAt := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantImages []TaggedImage
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("ecr repo mockRepoName describe images: some error"),
+		},
+		"should return every tag of the images when paginated": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					Filter: &ecr.DescribeImagesFilter{
+						TagStatus: aws.String(ecr.TagStatusTagged),
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageTags:     aws.StringSlice([]string{"latest", "v1.1"}),
+							ImagePushedAt: aws.Time(pushedAt),
+						},
+					},
+					NextToken: &mockNextToken,
+				}, nil)
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					Filter: &ecr.DescribeImagesFilter{
+						TagStatus: aws.String(ecr.TagStatusTagged),
+					},
+					NextToken: &mockNextToken,
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageTags:     aws.StringSlice([]string{"v1.0"}),
+							ImagePushedAt: aws.Time(pushedAt.Add(-time.Hour)),
+						},
+					},
+				}, nil)
+			},
+			wantImages: []TaggedImage{
+				{Tag: "latest", PushedAt: pushedAt},
+				{Tag: "v1.1", PushedAt: pushedAt},
+				{Tag: "v1.0", PushedAt: pushedAt.Add(-time.Hour)},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			got, err := client.ListTaggedImages(mockRepoName)
+
+			// THEN
+			if tc.wantError != nil {
+				require.EqualError(t, err, tc.wantError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantImages, got)
+		})
+	}
+}
+
 func TestDeleteImages(t *testing.T) {
 	mockRepoName := "mockRepoName"
 	mockError := errors.New("mockError")
@@ -446,3 +605,346 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestTagImage(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"should wrap error returned by ECR BatchGetImage": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName batch get image v1: %w", mockError),
+		},
+		"should return error if the image is not found": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{}, nil)
+			},
+			wantError: errors.New("image v1 not found in ecr repo mockRepoName"),
+		},
+		"should wrap error returned by ECR PutImage": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifest: aws.String("manifest"),
+						},
+					},
+				}, nil)
+				m.EXPECT().PutImage(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName tag image v1 with copilot-deployed-test-api: %w", mockError),
+		},
+		"should ignore images that are already tagged": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifest: aws.String("manifest"),
+						},
+					},
+				}, nil)
+				m.EXPECT().PutImage(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeImageAlreadyExistsException, "already exists", nil))
+			},
+		},
+		"should put the manifest of the image with the new tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(&ecr.BatchGetImageInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String("v1"),
+						},
+					},
+				}).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifest:          aws.String("manifest"),
+							ImageManifestMediaType: aws.String("application/vnd.docker.distribution.manifest.v2+json"),
+						},
+					},
+				}, nil)
+				m.EXPECT().PutImage(&ecr.PutImageInput{
+					RepositoryName:         aws.String(mockRepoName),
+					ImageManifest:          aws.String("manifest"),
+					ImageManifestMediaType: aws.String("application/vnd.docker.distribution.manifest.v2+json"),
+					ImageTag:               aws.String("copilot-deployed-test-api"),
+				}).Return(&ecr.PutImageOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotError := client.TagImage(mockRepoName, Image{Tag: "v1"}, DeployedImageTag("test", "api"))
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotError, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestImageExists(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantExists bool
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName describe image v1: %w", mockError),
+		},
+		"should return false if the image is not found": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeImageNotFoundException, "not found", nil))
+			},
+		},
+		"should return true if the image has the tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String("v1"),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			wantExists: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			exists, gotError := client.ImageExists(mockRepoName, "v1")
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotError, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotError)
+				require.Equal(t, tc.wantExists, exists)
+			}
+		})
+	}
+}
+
+func TestImageDigest(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantDigest string
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName describe image v1: %w", mockError),
+		},
+		"should return an error if no image has the tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			wantError: errors.New("image v1 not found in ecr repo mockRepoName"),
+		},
+		"should return the digest of the image that has the tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String("v1"),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wantDigest: "sha256:abc",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			digest, gotError := client.ImageDigest(mockRepoName, "v1")
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotError, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotError)
+				require.Equal(t, tc.wantDigest, digest)
+			}
+		})
+	}
+}
+
+func TestSetImageRetention(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+	wantedPolicy := `{"rules":[` +
+		`{"rulePriority":1,"description":"Keep the images deployed to environments.","selection":{"tagStatus":"tagged","tagPrefixList":["copilot-deployed-"],"countType":"imageCountMoreThan","countNumber":1000},"action":{"type":"expire"}},` +
+		`{"rulePriority":2,"description":"Keep the 10 most recent tagged images.","selection":{"tagStatus":"tagged","tagPatternList":["*"],"countType":"imageCountMoreThan","countNumber":10},"action":{"type":"expire"}}]}`
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"should wrap error returned by ECR PutLifecyclePolicy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLifecyclePolicy(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName put lifecycle policy: %w", mockError),
+		},
+		"should put the lifecycle policy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+					RepositoryName:      aws.String(mockRepoName),
+					LifecyclePolicyText: aws.String(wantedPolicy),
+				}).Return(&ecr.PutLifecyclePolicyOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotError := client.SetImageRetention(mockRepoName, 10)
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotError, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestImageRetention(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantRetention int
+		wantError     error
+	}{
+		"should wrap error returned by ECR GetLifecyclePolicy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLifecyclePolicy(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName get lifecycle policy: %w", mockError),
+		},
+		"should return 0 if the repository has no lifecycle policy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLifecyclePolicy(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeLifecyclePolicyNotFoundException, "not found", nil))
+			},
+		},
+		"should return 0 if the lifecycle policy is not an image retention policy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLifecyclePolicy(gomock.Any()).Return(&ecr.GetLifecyclePolicyOutput{
+					LifecyclePolicyText: aws.String(`{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`),
+				}, nil)
+			},
+		},
+		"should return the number of images kept": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLifecyclePolicy(&ecr.GetLifecyclePolicyInput{
+					RepositoryName: aws.String(mockRepoName),
+				}).Return(&ecr.GetLifecyclePolicyOutput{
+					LifecyclePolicyText: aws.String(`{"rules":[{"rulePriority":1,"selection":{"tagStatus":"tagged","tagPrefixList":["copilot-deployed-"],"countType":"imageCountMoreThan","countNumber":1000},"action":{"type":"expire"}},{"rulePriority":2,"selection":{"tagStatus":"tagged","tagPatternList":["*"],"countType":"imageCountMoreThan","countNumber":10},"action":{"type":"expire"}}]}`),
+				}, nil)
+			},
+			wantRetention: 10,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotRetention, gotError := client.ImageRetention(mockRepoName)
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotError, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotError)
+				require.Equal(t, tc.wantRetention, gotRetention)
+			}
+		})
+	}
+}
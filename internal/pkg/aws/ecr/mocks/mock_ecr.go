@@ -92,3 +92,63 @@ func (mr *MockapiMockRecorder) BatchDeleteImage(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteImage", reflect.TypeOf((*Mockapi)(nil).BatchDeleteImage), arg0)
 }
+
+// BatchGetImage mocks base method
+func (m *Mockapi) BatchGetImage(arg0 *ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetImage", arg0)
+	ret0, _ := ret[0].(*ecr.BatchGetImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetImage indicates an expected call of BatchGetImage
+func (mr *MockapiMockRecorder) BatchGetImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetImage", reflect.TypeOf((*Mockapi)(nil).BatchGetImage), arg0)
+}
+
+// GetLifecyclePolicy mocks base method
+func (m *Mockapi) GetLifecyclePolicy(arg0 *ecr.GetLifecyclePolicyInput) (*ecr.GetLifecyclePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLifecyclePolicy", arg0)
+	ret0, _ := ret[0].(*ecr.GetLifecyclePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLifecyclePolicy indicates an expected call of GetLifecyclePolicy
+func (mr *MockapiMockRecorder) GetLifecyclePolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicy", reflect.TypeOf((*Mockapi)(nil).GetLifecyclePolicy), arg0)
+}
+
+// PutImage mocks base method
+func (m *Mockapi) PutImage(arg0 *ecr.PutImageInput) (*ecr.PutImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutImage", arg0)
+	ret0, _ := ret[0].(*ecr.PutImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImage indicates an expected call of PutImage
+func (mr *MockapiMockRecorder) PutImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImage", reflect.TypeOf((*Mockapi)(nil).PutImage), arg0)
+}
+
+// PutLifecyclePolicy mocks base method
+func (m *Mockapi) PutLifecyclePolicy(arg0 *ecr.PutLifecyclePolicyInput) (*ecr.PutLifecyclePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLifecyclePolicy", arg0)
+	ret0, _ := ret[0].(*ecr.PutLifecyclePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutLifecyclePolicy indicates an expected call of PutLifecyclePolicy
+func (mr *MockapiMockRecorder) PutLifecyclePolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecyclePolicy", reflect.TypeOf((*Mockapi)(nil).PutLifecyclePolicy), arg0)
+}
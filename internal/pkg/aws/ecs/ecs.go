@@ -7,6 +7,8 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -21,10 +23,16 @@ type api interface {
 	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+	ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
+	DeregisterTaskDefinition(input *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+	UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
+	TagResource(input *ecs.TagResourceInput) (*ecs.TagResourceOutput, error)
+	UntagResource(input *ecs.UntagResourceInput) (*ecs.UntagResourceOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
+	WaitUntilTasksStopped(input *ecs.DescribeTasksInput) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -42,6 +50,43 @@ type RunTaskInput struct {
 	StartedBy      string
 }
 
+// CLICommand returns the AWS CLI command that runs the tasks with the same input as RunTask.
+func (i RunTaskInput) CLICommand() string {
+	in := i.runTaskInput()
+	vpc := in.NetworkConfiguration.AwsvpcConfiguration
+	networkConfig := fmt.Sprintf("awsvpcConfiguration={subnets=[%s],securityGroups=[%s],assignPublicIp=%s}",
+		strings.Join(aws.StringValueSlice(vpc.Subnets), ","),
+		strings.Join(aws.StringValueSlice(vpc.SecurityGroups), ","),
+		aws.StringValue(vpc.AssignPublicIp))
+	lines := []string{
+		"aws ecs run-task",
+		fmt.Sprintf("--cluster %s", aws.StringValue(in.Cluster)),
+		fmt.Sprintf("--count %d", aws.Int64Value(in.Count)),
+		fmt.Sprintf("--launch-type %s", aws.StringValue(in.LaunchType)),
+		fmt.Sprintf("--started-by %s", aws.StringValue(in.StartedBy)),
+		fmt.Sprintf("--task-definition %s", aws.StringValue(in.TaskDefinition)),
+		fmt.Sprintf("--network-configuration '%s'", networkConfig),
+	}
+	return strings.Join(lines, " \\\n  ")
+}
+
+func (i RunTaskInput) runTaskInput() *ecs.RunTaskInput {
+	return &ecs.RunTaskInput{
+		Cluster:        aws.String(i.Cluster),
+		Count:          aws.Int64(int64(i.Count)),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		StartedBy:      aws.String(i.StartedBy),
+		TaskDefinition: aws.String(i.TaskFamilyName),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				Subnets:        aws.StringSlice(i.Subnets),
+				SecurityGroups: aws.StringSlice(i.SecurityGroups),
+			},
+		},
+	}
+}
+
 // New returns a Service configured against the input session.
 func New(s *session.Session) *ECS {
 	return &ECS{
@@ -61,6 +106,47 @@ func (e *ECS) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// ActiveTaskDefinitions returns the ARNs of the ACTIVE revisions of a task definition family, newest first.
+func (e *ECS) ActiveTaskDefinitions(family string) ([]string, error) {
+	var arns []string
+	in := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Status:       aws.String(ecs.TaskDefinitionStatusActive),
+		Sort:         aws.String(ecs.SortOrderDesc),
+	}
+	for {
+		resp, err := e.client.ListTaskDefinitions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list task definitions of family %s: %w", family, err)
+		}
+		for _, taskDefARN := range aws.StringValueSlice(resp.TaskDefinitionArns) {
+			// The prefix also matches the families whose name starts with the family's name.
+			revisionFamily, _, err := TaskDefinitionRevision(taskDefARN)
+			if err != nil {
+				return nil, err
+			}
+			if revisionFamily == family {
+				arns = append(arns, taskDefARN)
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return arns, nil
+}
+
+// DeregisterTaskDefinition marks a task definition revision as INACTIVE.
+func (e *ECS) DeregisterTaskDefinition(taskDefARN string) error {
+	if _, err := e.client.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefARN),
+	}); err != nil {
+		return fmt.Errorf("deregister task definition %s: %w", taskDefARN, err)
+	}
+	return nil
+}
+
 // Service calls ECS API and returns the specified service running in the cluster.
 func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	resp, err := e.client.DescribeServices(&ecs.DescribeServicesInput{
@@ -79,6 +165,53 @@ func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	return nil, fmt.Errorf("cannot find service %s", serviceName)
 }
 
+// UpdateServiceDesiredCount sets the number of tasks the service should keep running.
+func (e *ECS) UpdateServiceDesiredCount(clusterName, serviceName string, count int64) error {
+	_, err := e.client.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterName),
+		Service:      aws.String(serviceName),
+		DesiredCount: aws.Int64(count),
+	})
+	if err != nil {
+		return fmt.Errorf("update desired count of service %s to %d: %w", serviceName, count, err)
+	}
+	return nil
+}
+
+// TagService adds the tags to the service, overwriting the values of existing keys.
+func (e *ECS) TagService(serviceARN string, tags map[string]string) error {
+	var ecsTags []*ecs.Tag
+	for k, v := range tags {
+		ecsTags = append(ecsTags, &ecs.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	sort.SliceStable(ecsTags, func(i, j int) bool {
+		return aws.StringValue(ecsTags[i].Key) < aws.StringValue(ecsTags[j].Key)
+	})
+	_, err := e.client.TagResource(&ecs.TagResourceInput{
+		ResourceArn: aws.String(serviceARN),
+		Tags:        ecsTags,
+	})
+	if err != nil {
+		return fmt.Errorf("tag service %s: %w", serviceARN, err)
+	}
+	return nil
+}
+
+// UntagService removes the tags with the keys from the service.
+func (e *ECS) UntagService(serviceARN string, keys []string) error {
+	_, err := e.client.UntagResource(&ecs.UntagResourceInput{
+		ResourceArn: aws.String(serviceARN),
+		TagKeys:     aws.StringSlice(keys),
+	})
+	if err != nil {
+		return fmt.Errorf("untag service %s: %w", serviceARN, err)
+	}
+	return nil
+}
+
 // ServiceTasks calls ECS API and returns ECS tasks running by a service.
 func (e *ECS) ServiceTasks(cluster, service string) ([]*Task, error) {
 	return e.listTasks(cluster, withService(service))
@@ -90,6 +223,12 @@ func (e *ECS) RunningTasksInFamily(cluster, family string) ([]*Task, error) {
 	return e.listTasks(cluster, withFamily(family), withRunningTasks())
 }
 
+// StoppedTasksInFamily calls ECS API and returns the recently stopped ECS tasks
+// within the same task definition family.
+func (e *ECS) StoppedTasksInFamily(cluster, family string) ([]*Task, error) {
+	return e.listTasks(cluster, withFamily(family), withStoppedTasks())
+}
+
 type listTasksOpts func(*ecs.ListTasksInput)
 
 func withService(svcName string) listTasksOpts {
@@ -110,6 +249,12 @@ func withRunningTasks() listTasksOpts {
 	}
 }
 
+func withStoppedTasks() listTasksOpts {
+	return func(in *ecs.ListTasksInput) {
+		in.DesiredStatus = aws.String(ecs.DesiredStatusStopped)
+	}
+}
+
 func (e *ECS) listTasks(cluster string, opts ...listTasksOpts) ([]*Task, error) {
 	var tasks []*Task
 	in := &ecs.ListTasksInput{
@@ -207,20 +352,7 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
-	resp, err := e.client.RunTask(&ecs.RunTaskInput{
-		Cluster:        aws.String(input.Cluster),
-		Count:          aws.Int64(int64(input.Count)),
-		LaunchType:     aws.String(ecs.LaunchTypeFargate),
-		StartedBy:      aws.String(input.StartedBy),
-		TaskDefinition: aws.String(input.TaskFamilyName),
-		NetworkConfiguration: &ecs.NetworkConfiguration{
-			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
-				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
-				Subnets:        aws.StringSlice(input.Subnets),
-				SecurityGroups: aws.StringSlice(input.SecurityGroups),
-			},
-		},
-	})
+	resp, err := e.client.RunTask(input.runTaskInput())
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
 	}
@@ -251,6 +383,25 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 	return tasks, nil
 }
 
+// WaitUntilTasksStopped waits until the tasks with the taskARNs in the cluster are stopped, and returns their final state.
+// Tasks can run for longer than the SDK waiter's maximum wait time, so the waiter is retried until it succeeds or fails.
+func (e *ECS) WaitUntilTasksStopped(cluster string, taskARNs []string) ([]*Task, error) {
+	in := &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   aws.StringSlice(taskARNs),
+	}
+	for {
+		err := e.client.WaitUntilTasksStopped(in)
+		if err == nil {
+			break
+		}
+		if !isRequestTimeoutErr(err) {
+			return nil, fmt.Errorf("wait for tasks to be stopped: %w", err)
+		}
+	}
+	return e.DescribeTasks(cluster, taskARNs)
+}
+
 // DescribeTasks returns the tasks with the taskARNs in the cluster.
 func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error) {
 	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
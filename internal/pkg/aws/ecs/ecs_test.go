@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs/mocks"
 	"github.com/golang/mock/gomock"
@@ -102,6 +104,128 @@ func TestECS_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestECS_ActiveTaskDefinitions(t *testing.T) {
+	const (
+		family = "phonetool-test-api"
+		fmtArn = "arn:aws:ecs:us-west-2:123456789012:task-definition/%s:%d"
+	)
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantArns []string
+		wantErr  error
+	}{
+		"errors if failed to list task definitions": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list task definitions of family phonetool-test-api: some error"),
+		},
+		"returns the revisions of the family across pages": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+						FamilyPrefix: aws.String(family),
+						Status:       aws.String(ecs.TaskDefinitionStatusActive),
+						Sort:         aws.String(ecs.SortOrderDesc),
+					}).Return(&ecs.ListTaskDefinitionsOutput{
+						TaskDefinitionArns: aws.StringSlice([]string{
+							fmt.Sprintf(fmtArn, family, 3),
+							fmt.Sprintf(fmtArn, "phonetool-test-api-worker", 7),
+						}),
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+						FamilyPrefix: aws.String(family),
+						Status:       aws.String(ecs.TaskDefinitionStatusActive),
+						Sort:         aws.String(ecs.SortOrderDesc),
+						NextToken:    aws.String("next"),
+					}).Return(&ecs.ListTaskDefinitionsOutput{
+						TaskDefinitionArns: aws.StringSlice([]string{
+							fmt.Sprintf(fmtArn, family, 2),
+							fmt.Sprintf(fmtArn, family, 1),
+						}),
+					}, nil),
+				)
+			},
+			wantArns: []string{
+				fmt.Sprintf(fmtArn, family, 3),
+				fmt.Sprintf(fmtArn, family, 2),
+				fmt.Sprintf(fmtArn, family, 1),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotArns, gotErr := service.ActiveTaskDefinitions(family)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantArns, gotArns)
+			}
+		})
+	}
+}
+
+func TestECS_DeregisterTaskDefinition(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"errors if failed to deregister task definition": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterTaskDefinition(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("deregister task definition mockTaskDefARN: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+					TaskDefinition: aws.String("mockTaskDefARN"),
+				}).Return(&ecs.DeregisterTaskDefinitionOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotErr := service.DeregisterTaskDefinition("mockTaskDefARN")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestECS_Service(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -185,6 +309,154 @@ func TestECS_Service(t *testing.T) {
 	}
 }
 
+func TestECS_UpdateServiceDesiredCount(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"errors if failed to update service": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("update desired count of service mockService to 0: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(&ecs.UpdateServiceInput{
+					Cluster:      aws.String("mockCluster"),
+					Service:      aws.String("mockService"),
+					DesiredCount: aws.Int64(0),
+				}).Return(&ecs.UpdateServiceOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotErr := service.UpdateServiceDesiredCount("mockCluster", "mockService", 0)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestECS_TagService(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"errors if failed to tag service": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().TagResource(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("tag service mockServiceARN: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().TagResource(&ecs.TagResourceInput{
+					ResourceArn: aws.String("mockServiceARN"),
+					Tags: []*ecs.Tag{
+						{
+							Key:   aws.String("a"),
+							Value: aws.String("1"),
+						},
+						{
+							Key:   aws.String("b"),
+							Value: aws.String("2"),
+						},
+					},
+				}).Return(&ecs.TagResourceOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotErr := service.TagService("mockServiceARN", map[string]string{"b": "2", "a": "1"})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestECS_UntagService(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"errors if failed to untag service": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UntagResource(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("untag service mockServiceARN: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UntagResource(&ecs.UntagResourceInput{
+					ResourceArn: aws.String("mockServiceARN"),
+					TagKeys:     aws.StringSlice([]string{"a", "b"}),
+				}).Return(&ecs.UntagResourceOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotErr := service.UntagService("mockServiceARN", []string{"a", "b"})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestECS_Tasks(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -664,6 +936,30 @@ func TestECS_RunTask(t *testing.T) {
 	}
 }
 
+func TestRunTaskInput_CLICommand(t *testing.T) {
+	// GIVEN
+	input := RunTaskInput{
+		Cluster:        "my-cluster",
+		Count:          3,
+		Subnets:        []string{"subnet-1", "subnet-2"},
+		SecurityGroups: []string{"sg-1", "sg-2"},
+		TaskFamilyName: "my-task",
+		StartedBy:      "task",
+	}
+
+	// WHEN
+	cmd := input.CLICommand()
+
+	// THEN
+	require.Equal(t, `aws ecs run-task \
+  --cluster my-cluster \
+  --count 3 \
+  --launch-type FARGATE \
+  --started-by task \
+  --task-definition my-task \
+  --network-configuration 'awsvpcConfiguration={subnets=[subnet-1,subnet-2],securityGroups=[sg-1,sg-2],assignPublicIp=ENABLED}'`, cmd)
+}
+
 func TestECS_DescribeTasks(t *testing.T) {
 	inCluster := "my-cluster"
 	inTaskARNs := []string{"task-1", "task-2", "task-3"}
@@ -736,3 +1032,72 @@ func TestECS_DescribeTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestECS_WaitUntilTasksStopped(t *testing.T) {
+	inCluster := "my-cluster"
+	inTaskARNs := []string{"task-1"}
+	wantedInput := &ecs.DescribeTasksInput{
+		Cluster: aws.String(inCluster),
+		Tasks:   aws.StringSlice(inTaskARNs),
+	}
+	testCases := map[string]struct {
+		mockAPI     func(m *mocks.Mockapi)
+		wantedError error
+		wantedTasks []*Task
+	}{
+		"error waiting for tasks to stop": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().WaitUntilTasksStopped(wantedInput).Return(errors.New("some error"))
+				m.EXPECT().DescribeTasks(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("wait for tasks to be stopped: some error"),
+		},
+		"keep waiting if the waiter times out": {
+			mockAPI: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().WaitUntilTasksStopped(wantedInput).
+						Return(awserr.New(request.WaiterResourceNotReadyErrorCode, "max attempts exceeded", nil)).Times(2),
+					m.EXPECT().WaitUntilTasksStopped(wantedInput).Return(nil),
+				)
+				m.EXPECT().DescribeTasks(wantedInput).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:       aws.String("task-1"),
+							LastStatus:    aws.String("STOPPED"),
+							StoppedReason: aws.String("Essential container in task exited"),
+						},
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn:       aws.String("task-1"),
+					LastStatus:    aws.String("STOPPED"),
+					StoppedReason: aws.String("Essential container in task exited"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockAPI(mockAPI)
+
+			ecs := ECS{
+				client: mockAPI,
+			}
+
+			tasks, err := ecs.WaitUntilTasksStopped(inCluster, inTaskARNs)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
@@ -37,6 +37,77 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// ServiceEvent is an event of the service scheduler, such as "(service api) has reached a steady state.".
+type ServiceEvent struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Message   string    `json:"message"`
+}
+
+// ServiceDeployment contains the status of one of the deployments of a service.
+type ServiceDeployment struct {
+	Status         string    `json:"status"`
+	TaskDefinition string    `json:"taskDefinition"`
+	DesiredCount   int64     `json:"desiredCount"`
+	RunningCount   int64     `json:"runningCount"`
+	PendingCount   int64     `json:"pendingCount"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// ServiceDeploymentConfig contains the deployment configuration of a service and its current deployments.
+type ServiceDeploymentConfig struct {
+	MinimumHealthyPercent int64               `json:"minimumHealthyPercent"`
+	MaximumPercent        int64               `json:"maximumPercent"`
+	Deployments           []ServiceDeployment `json:"deployments"`
+}
+
+// LatestEvents returns at most limit of the most recent events of the service, newest first.
+func (s *Service) LatestEvents(limit int) []ServiceEvent {
+	var events []ServiceEvent
+	for _, event := range s.Events {
+		if len(events) == limit {
+			break
+		}
+		events = append(events, ServiceEvent{
+			CreatedAt: aws.TimeValue(event.CreatedAt),
+			Message:   aws.StringValue(event.Message),
+		})
+	}
+	return events
+}
+
+// DeploymentConfig returns the deployment configuration of the service along with its deployments.
+// The primary deployment is the one that is being rolled out, the active ones are being replaced.
+func (s *Service) DeploymentConfig() ServiceDeploymentConfig {
+	var config ServiceDeploymentConfig
+	if s.DeploymentConfiguration != nil {
+		config.MinimumHealthyPercent = aws.Int64Value(s.DeploymentConfiguration.MinimumHealthyPercent)
+		config.MaximumPercent = aws.Int64Value(s.DeploymentConfiguration.MaximumPercent)
+	}
+	for _, deployment := range s.Deployments {
+		config.Deployments = append(config.Deployments, ServiceDeployment{
+			Status:         aws.StringValue(deployment.Status),
+			TaskDefinition: aws.StringValue(deployment.TaskDefinition),
+			DesiredCount:   aws.Int64Value(deployment.DesiredCount),
+			RunningCount:   aws.Int64Value(deployment.RunningCount),
+			PendingCount:   aws.Int64Value(deployment.PendingCount),
+			UpdatedAt:      aws.TimeValue(deployment.UpdatedAt),
+		})
+	}
+	return config
+}
+
+// TargetGroups returns the ARNs of the target groups that the service registers its tasks with.
+func (s *Service) TargetGroups() []string {
+	var arns []string
+	for _, lb := range s.LoadBalancers {
+		if lb.TargetGroupArn == nil {
+			continue
+		}
+		arns = append(arns, aws.StringValue(lb.TargetGroupArn))
+	}
+	return arns
+}
+
 // ServiceArn is the arn of an ECS service.
 type ServiceArn string
 
@@ -6,6 +6,7 @@ package ecs
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +32,51 @@ type Image struct {
 	Digest string
 }
 
+// Tag returns the tag of the image, or an empty string if the image is referenced without a tag.
+// For example: 123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2 returns v1.2.
+func (i Image) Tag() string {
+	ref := strings.SplitN(i.ID, "@", 2)[0]
+	name := ref[strings.LastIndex(ref, "/")+1:]
+	if ind := strings.LastIndex(name, ":"); ind != -1 {
+		return name[ind+1:]
+	}
+	return ""
+}
+
+// ContainerStatus contains the status info of a container in a task.
+type ContainerStatus struct {
+	Name       string `json:"name"`
+	Image      Image  `json:"image"`
+	LastStatus string `json:"lastStatus"`
+	Health     string `json:"health"`
+	ExitCode   *int   `json:"exitCode,omitempty"` // Set only if the container has exited.
+}
+
+// HumanString returns the stringified ContainerStatus struct with human readable format.
+// Example output:
+//   web          v1.2          f884127d          RUNNING          HEALTHY          -
+func (c ContainerStatus) HumanString() string {
+	tag := "-"
+	if t := c.Image.Tag(); t != "" {
+		tag = t
+	}
+	digest := "-"
+	if len(c.Image.Digest) >= shortImageDigestLength {
+		digest = c.Image.Digest[:shortImageDigestLength]
+	}
+	exitCode := "-"
+	if c.ExitCode != nil {
+		exitCode = strconv.Itoa(*c.ExitCode)
+	}
+	return fmt.Sprintf("  %s\t%s\t%s\t%s\t%s\t%s\n", c.Name, tag, digest, c.LastStatus, taskHealthColor(c.Health), exitCode)
+}
+
+// ContainerExitCode is the exit code of a container in a stopped task.
+type ContainerExitCode struct {
+	Name     string `json:"name"`
+	ExitCode int    `json:"exitCode"`
+}
+
 // Task wraps up ECS Task struct.
 type Task ecs.Task
 
@@ -53,11 +99,29 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		stoppedReason = aws.StringValue(t.StoppedReason)
 	}
 	var images []Image
+	var exitCodes []ContainerExitCode
+	var containers []ContainerStatus
 	for _, container := range t.Containers {
-		images = append(images, Image{
+		image := Image{
 			ID:     aws.StringValue(container.Image),
 			Digest: imageDigestValue(aws.StringValue(container.ImageDigest)),
-		})
+		}
+		images = append(images, image)
+		status := ContainerStatus{
+			Name:       aws.StringValue(container.Name),
+			Image:      image,
+			LastStatus: aws.StringValue(container.LastStatus),
+			Health:     aws.StringValue(container.HealthStatus),
+		}
+		if container.ExitCode != nil {
+			exitCode := int(aws.Int64Value(container.ExitCode))
+			status.ExitCode = &exitCode
+			exitCodes = append(exitCodes, ContainerExitCode{
+				Name:     aws.StringValue(container.Name),
+				ExitCode: exitCode,
+			})
+		}
+		containers = append(containers, status)
 	}
 	return &TaskStatus{
 		Health:        aws.StringValue(t.HealthStatus),
@@ -67,6 +131,8 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		StartedAt:     startedAt,
 		StoppedAt:     stoppedAt,
 		StoppedReason: stoppedReason,
+		ExitCodes:     exitCodes,
+		Containers:    containers,
 	}, nil
 }
 
@@ -79,6 +145,10 @@ type TaskStatus struct {
 	StartedAt     time.Time `json:"startedAt"`
 	StoppedAt     time.Time `json:"stoppedAt"`
 	StoppedReason string    `json:"stoppedReason"`
+	// ExitCodes holds the exit codes of the containers that have exited.
+	ExitCodes []ContainerExitCode `json:"exitCodes,omitempty"`
+	// Containers holds the status of each container in the task.
+	Containers []ContainerStatus `json:"containers,omitempty"`
 }
 
 // HumanString returns the stringified TaskStatus struct with human readable format.
@@ -137,6 +207,26 @@ func TaskID(taskARN string) (string, error) {
 	return taskID, nil
 }
 
+// TaskDefinitionRevision parses the task definition ARN and returns its family and revision.
+// For example: arn:aws:ecs:us-west-2:123456789:task-definition/my-app-test-api:12
+// returns my-app-test-api and 12.
+func TaskDefinitionRevision(taskDefARN string) (family string, revision int, err error) {
+	parsedARN, err := arn.Parse(taskDefARN)
+	if err != nil {
+		return "", 0, fmt.Errorf("parse ECS task definition ARN: %w", err)
+	}
+	resource := strings.TrimPrefix(parsedARN.Resource, "task-definition/")
+	sep := strings.LastIndex(resource, ":")
+	if sep == -1 {
+		return "", 0, fmt.Errorf("task definition ARN %s has no revision", taskDefARN)
+	}
+	revision, err = strconv.Atoi(resource[sep+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("parse revision of task definition %s: %w", taskDefARN, err)
+	}
+	return resource[:sep], revision, nil
+}
+
 func taskHealthColor(status string) string {
 	switch status {
 	case "HEALTHY":
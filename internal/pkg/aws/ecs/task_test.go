@@ -56,6 +56,14 @@ func TestTask_TaskStatus(t *testing.T) {
 					},
 				},
 				LastStatus: "UNKNOWN",
+				Containers: []ContainerStatus{
+					{
+						Image: Image{
+							Digest: mockImageDigest,
+							ID:     "mockImageArn",
+						},
+					},
+				},
 			},
 		},
 		"success with a running task": {
@@ -81,14 +89,31 @@ func TestTask_TaskStatus(t *testing.T) {
 				},
 				LastStatus: "UNKNOWN",
 				StartedAt:  startTime,
+				Containers: []ContainerStatus{
+					{
+						Image: Image{
+							Digest: mockImageDigest,
+							ID:     "mockImageArn",
+						},
+					},
+				},
 			},
 		},
 		"success with a stopped task": {
 			taskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
 			containers: []*ecs.Container{
 				{
-					Image:       aws.String("mockImageArn"),
-					ImageDigest: aws.String("sha256:" + mockImageDigest),
+					Name:         aws.String("web"),
+					Image:        aws.String("mockImageArn"),
+					ImageDigest:  aws.String("sha256:" + mockImageDigest),
+					ExitCode:     aws.Int64(137),
+					LastStatus:   aws.String("STOPPED"),
+					HealthStatus: aws.String("UNHEALTHY"),
+				},
+				{
+					Name:       aws.String("firelens"),
+					Image:      aws.String("mockSidecarImageArn"),
+					LastStatus: aws.String("RUNNING"),
 				},
 			},
 			health:        aws.String("HEALTHY"),
@@ -105,11 +130,39 @@ func TestTask_TaskStatus(t *testing.T) {
 						Digest: mockImageDigest,
 						ID:     "mockImageArn",
 					},
+					{
+						ID: "mockSidecarImageArn",
+					},
 				},
 				LastStatus:    "UNKNOWN",
 				StartedAt:     startTime,
 				StoppedAt:     stopTime,
 				StoppedReason: "some reason",
+				ExitCodes: []ContainerExitCode{
+					{
+						Name:     "web",
+						ExitCode: 137,
+					},
+				},
+				Containers: []ContainerStatus{
+					{
+						Name: "web",
+						Image: Image{
+							Digest: mockImageDigest,
+							ID:     "mockImageArn",
+						},
+						LastStatus: "STOPPED",
+						Health:     "UNHEALTHY",
+						ExitCode:   aws.Int(137),
+					},
+					{
+						Name: "firelens",
+						Image: Image{
+							ID: "mockSidecarImageArn",
+						},
+						LastStatus: "RUNNING",
+					},
+				},
 			},
 		},
 	}
@@ -209,6 +262,80 @@ func TestTaskStatus_HumanString(t *testing.T) {
 
 	}
 }
+
+func TestContainerStatus_HumanString(t *testing.T) {
+	mockImageDigest := "18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	testCases := map[string]struct {
+		container ContainerStatus
+
+		wantContainerStatus string
+	}{
+		"all params": {
+			container: ContainerStatus{
+				Name: "web",
+				Image: Image{
+					ID:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2",
+					Digest: mockImageDigest,
+				},
+				LastStatus: "STOPPED",
+				Health:     "UNHEALTHY",
+				ExitCode:   aws.Int(137),
+			},
+
+			wantContainerStatus: "  web\tv1.2\t18f7eb6c\tSTOPPED\tUNHEALTHY\t137\n",
+		},
+		"missing params": {
+			container: ContainerStatus{
+				Name: "firelens",
+				Image: Image{
+					ID: "amazon/aws-for-fluent-bit",
+				},
+				LastStatus: "RUNNING",
+				Health:     "UNKNOWN",
+			},
+
+			wantContainerStatus: "  firelens\t-\t-\tRUNNING\tUNKNOWN\t-\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantContainerStatus, tc.container.HumanString())
+		})
+	}
+}
+
+func TestImage_Tag(t *testing.T) {
+	testCases := map[string]struct {
+		id string
+
+		wantTag string
+	}{
+		"image in a registry with a port and without a tag": {
+			id:      "localhost:5000/my-app/web",
+			wantTag: "",
+		},
+		"image with a tag": {
+			id:      "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2",
+			wantTag: "v1.2",
+		},
+		"image with a tag and a digest": {
+			id:      "nginx:1.19@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+			wantTag: "1.19",
+		},
+		"image without a tag": {
+			id:      "nginx",
+			wantTag: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantTag, Image{ID: tc.id}.Tag())
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
@@ -239,6 +366,46 @@ func Test_TaskID(t *testing.T) {
 	}
 }
 
+func TestTaskDefinitionRevision(t *testing.T) {
+	testCases := map[string]struct {
+		taskDefARN string
+
+		wantErr      error
+		wantFamily   string
+		wantRevision int
+	}{
+		"bad unparsable task definition ARN": {
+			taskDefARN: "mockBadTaskDefARN",
+			wantErr:    fmt.Errorf("parse ECS task definition ARN: arn: invalid prefix"),
+		},
+		"task definition ARN without a revision": {
+			taskDefARN: "arn:aws:ecs:us-west-2:123456789:task-definition/phonetool-test-api",
+			wantErr:    fmt.Errorf("task definition ARN arn:aws:ecs:us-west-2:123456789:task-definition/phonetool-test-api has no revision"),
+		},
+		"success": {
+			taskDefARN:   "arn:aws:ecs:us-west-2:123456789:task-definition/phonetool-test-api:12",
+			wantFamily:   "phonetool-test-api",
+			wantRevision: 12,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			gotFamily, gotRevision, gotErr := TaskDefinitionRevision(tc.taskDefARN)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantFamily, gotFamily)
+				require.Equal(t, tc.wantRevision, gotRevision)
+			}
+		})
+	}
+}
+
 func TestTaskDefinition_EnvVars(t *testing.T) {
 	testCases := map[string]struct {
 		inContainers []*ecs.ContainerDefinition
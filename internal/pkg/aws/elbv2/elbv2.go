@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package elbv2 provides a client to make API requests to Elastic Load Balancing.
+package elbv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+type api interface {
+	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+}
+
+// ELBV2 wraps an Elastic Load Balancing client.
+type ELBV2 struct {
+	client api
+}
+
+// TargetHealth holds the health of a target registered with a target group.
+type TargetHealth struct {
+	TargetID string
+	Port     int64
+	State    string // One of "initial", "healthy", "unhealthy", "unused", "draining" or "unavailable".
+	Reason   string // Empty if the target is healthy.
+}
+
+// New returns an ELBV2 client configured against the input session.
+func New(s *session.Session) *ELBV2 {
+	return &ELBV2{
+		client: elbv2.New(s),
+	}
+}
+
+// TargetsHealth returns the health of the targets registered with the target group.
+func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
+	out, err := e.client.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe health of targets in target group %s: %w", targetGroupARN, err)
+	}
+	targets := make([]*TargetHealth, 0, len(out.TargetHealthDescriptions))
+	for _, descr := range out.TargetHealthDescriptions {
+		target := &TargetHealth{}
+		if descr.Target != nil {
+			target.TargetID = aws.StringValue(descr.Target.Id)
+			target.Port = aws.Int64Value(descr.Target.Port)
+		}
+		if descr.TargetHealth != nil {
+			target.State = aws.StringValue(descr.TargetHealth.State)
+			target.Reason = aws.StringValue(descr.TargetHealth.Reason)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package elbv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestELBV2_TargetsHealth(t *testing.T) {
+	const mockTargetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/phonet-Targe-1234/5678"
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedTargets []*TargetHealth
+		wantedErr     error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe health of targets in target group arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/phonet-Targe-1234/5678: some error"),
+		},
+		"returns the health of each target": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(mockTargetGroupARN),
+				}).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target: &elbv2.TargetDescription{
+								Id:   aws.String("10.0.0.12"),
+								Port: aws.Int64(80),
+							},
+							TargetHealth: &elbv2.TargetHealth{
+								State: aws.String("healthy"),
+							},
+						},
+						{
+							Target: &elbv2.TargetDescription{
+								Id:   aws.String("10.0.1.34"),
+								Port: aws.Int64(80),
+							},
+							TargetHealth: &elbv2.TargetHealth{
+								State:  aws.String("unhealthy"),
+								Reason: aws.String("Target.FailedHealthChecks"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTargets: []*TargetHealth{
+				{
+					TargetID: "10.0.0.12",
+					Port:     80,
+					State:    "healthy",
+				},
+				{
+					TargetID: "10.0.1.34",
+					Port:     80,
+					State:    "unhealthy",
+					Reason:   "Target.FailedHealthChecks",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ELBV2{
+				client: m,
+			}
+
+			// WHEN
+			targets, err := client.TargetsHealth(mockTargetGroupARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTargets, targets)
+			}
+		})
+	}
+}
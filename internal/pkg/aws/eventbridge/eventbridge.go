@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package eventbridge provides a client to make API requests to Amazon EventBridge.
+package eventbridge
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+type api interface {
+	DescribeRule(input *eventbridge.DescribeRuleInput) (*eventbridge.DescribeRuleOutput, error)
+	EnableRule(input *eventbridge.EnableRuleInput) (*eventbridge.EnableRuleOutput, error)
+	DisableRule(input *eventbridge.DisableRuleInput) (*eventbridge.DisableRuleOutput, error)
+}
+
+// EventBridge wraps an Amazon EventBridge client.
+type EventBridge struct {
+	client api
+}
+
+// New returns an EventBridge client configured against the input session.
+func New(s *session.Session) *EventBridge {
+	return &EventBridge{
+		client: eventbridge.New(s),
+	}
+}
+
+// IsRuleEnabled returns true if the rule is enabled, and false if it's disabled.
+func (e *EventBridge) IsRuleEnabled(name string) (bool, error) {
+	out, err := e.client.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe rule %s: %w", name, err)
+	}
+	return aws.StringValue(out.State) == eventbridge.RuleStateEnabled, nil
+}
+
+// EnableRule enables the rule so that it triggers its targets again.
+func (e *EventBridge) EnableRule(name string) error {
+	if _, err := e.client.EnableRule(&eventbridge.EnableRuleInput{
+		Name: aws.String(name),
+	}); err != nil {
+		return fmt.Errorf("enable rule %s: %w", name, err)
+	}
+	return nil
+}
+
+// DisableRule disables the rule so that it stops triggering its targets, without deleting it.
+func (e *EventBridge) DisableRule(name string) error {
+	if _, err := e.client.DisableRule(&eventbridge.DisableRuleInput{
+		Name: aws.String(name),
+	}); err != nil {
+		return fmt.Errorf("disable rule %s: %w", name, err)
+	}
+	return nil
+}
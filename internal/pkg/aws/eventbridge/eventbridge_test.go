@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package eventbridge
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/copilot-cli/internal/pkg/aws/eventbridge/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockRuleName = "phonetool-test-report-Rule-1A2B3C"
+
+func TestEventBridge_IsRuleEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedEnabled bool
+		wantedErr     error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe rule phonetool-test-report-Rule-1A2B3C: some error"),
+		},
+		"enabled rule": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+					Name: aws.String(mockRuleName),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					State: aws.String(eventbridge.RuleStateEnabled),
+				}, nil)
+			},
+			wantedEnabled: true,
+		},
+		"disabled rule": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRule(gomock.Any()).Return(&eventbridge.DescribeRuleOutput{
+					State: aws.String(eventbridge.RuleStateDisabled),
+				}, nil)
+			},
+			wantedEnabled: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := EventBridge{
+				client: m,
+			}
+
+			// WHEN
+			enabled, err := client.IsRuleEnabled(mockRuleName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnabled, enabled)
+			}
+		})
+	}
+}
+
+func TestEventBridge_EnableRule(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().EnableRule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("enable rule phonetool-test-report-Rule-1A2B3C: some error"),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().EnableRule(&eventbridge.EnableRuleInput{
+					Name: aws.String(mockRuleName),
+				}).Return(&eventbridge.EnableRuleOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := EventBridge{
+				client: m,
+			}
+
+			// WHEN
+			err := client.EnableRule(mockRuleName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventBridge_DisableRule(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DisableRule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("disable rule phonetool-test-report-Rule-1A2B3C: some error"),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DisableRule(&eventbridge.DisableRuleInput{
+					Name: aws.String(mockRuleName),
+				}).Return(&eventbridge.DisableRuleOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := EventBridge{
+				client: m,
+			}
+
+			// WHEN
+			err := client.DisableRule(mockRuleName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
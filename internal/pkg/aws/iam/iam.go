@@ -5,6 +5,7 @@
 package iam
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -33,15 +34,13 @@ func New(s *session.Session) *IAM {
 	}
 }
 
-// DeleteRole deletes an IAM role based on its ARN.
+// DeleteRole deletes an IAM role based on its ARN or name.
 // If the role does not exist it returns nil.
-func (c *IAM) DeleteRole(roleARN string) error {
-	parsed, err := arn.Parse(roleARN)
+func (c *IAM) DeleteRole(roleNameOrARN string) error {
+	roleName, err := roleNameFrom(roleNameOrARN)
 	if err != nil {
-		return fmt.Errorf("parse role ARN %s: %w", roleARN, err)
+		return err
 	}
-
-	roleName := strings.TrimPrefix(parsed.Resource, "role/") // Sample ARN format: arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole
 	if err := c.deleteRolePolicies(roleName); err != nil {
 		return err
 	}
@@ -57,6 +56,21 @@ func (c *IAM) DeleteRole(roleARN string) error {
 	return nil
 }
 
+// roleNameFrom returns the name of the role from either its ARN or its name.
+func roleNameFrom(roleNameOrARN string) (string, error) {
+	if !arn.IsARN(roleNameOrARN) {
+		return roleNameOrARN, nil
+	}
+	parsed, err := arn.Parse(roleNameOrARN)
+	if err != nil {
+		return "", fmt.Errorf("parse role ARN %s: %w", roleNameOrARN, err)
+	}
+	// Sample ARN format: arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole
+	// Roles can also have a path: arn:aws:iam::1111:role/path/to/phonetool-test-CFNExecutionRole
+	resource := strings.TrimPrefix(parsed.Resource, "role/")
+	return resource[strings.LastIndex(resource, "/")+1:], nil
+}
+
 func (c *IAM) deleteRolePolicies(roleName string) error {
 	policyNames, err := c.listRolePolicyNames(roleName)
 	if err != nil {
@@ -67,6 +81,10 @@ func (c *IAM) deleteRolePolicies(roleName string) error {
 			PolicyName: policyName,
 			RoleName:   aws.String(roleName),
 		}); err != nil {
+			if isNotExistErr(err) {
+				// The role or policy was already deleted.
+				continue
+			}
 			return fmt.Errorf("delete policy named %s in role %s: %w", aws.StringValue(policyName), roleName, err)
 		}
 	}
@@ -96,8 +114,8 @@ func (c *IAM) listRolePolicyNames(roleName string) ([]*string, error) {
 }
 
 func isNotExistErr(err error) bool {
-	aerr, ok := err.(awserr.Error)
-	if !ok {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
 		return false
 	}
 	switch aerr.Code() {
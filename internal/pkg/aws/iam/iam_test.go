@@ -71,6 +71,50 @@ func TestIAM_DeleteRole(t *testing.T) {
 				return m
 			},
 		},
+		"returns nil if a policy was already deleted": {
+			inRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().
+					ListRolePolicies(gomock.Any()).
+					Return(&iam.ListRolePoliciesOutput{
+						PolicyNames: []*string{aws.String("policy1")},
+					}, nil)
+				m.EXPECT().DeleteRolePolicy(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "does not exist", nil))
+				m.EXPECT().DeleteRole(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "does not exist", nil))
+				return m
+			},
+		},
+		"accepts a role name instead of an ARN": {
+			inRoleARN: "phonetool-test-CFNExecutionRole",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().
+					ListRolePolicies(&iam.ListRolePoliciesInput{
+						RoleName: aws.String("phonetool-test-CFNExecutionRole"),
+					}).
+					Return(&iam.ListRolePoliciesOutput{}, nil)
+				m.EXPECT().DeleteRole(&iam.DeleteRoleInput{
+					RoleName: aws.String("phonetool-test-CFNExecutionRole"),
+				}).Return(nil, nil)
+				return m
+			},
+		},
+		"strips the path from the role ARN": {
+			inRoleARN: "arn:aws:iam::1111:role/copilot/phonetool-test-CFNExecutionRole",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().
+					ListRolePolicies(&iam.ListRolePoliciesInput{
+						RoleName: aws.String("phonetool-test-CFNExecutionRole"),
+					}).
+					Return(&iam.ListRolePoliciesOutput{}, nil)
+				m.EXPECT().DeleteRole(&iam.DeleteRoleInput{
+					RoleName: aws.String("phonetool-test-CFNExecutionRole"),
+				}).Return(nil, nil)
+				return m
+			},
+		},
 		"returns nil when the role policies and the role can be deleted successfully": {
 			inRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
 			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
@@ -7,6 +7,7 @@ package identity
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -30,6 +31,7 @@ func New(s *session.Session) STS {
 // Caller holds information about a calling entity.
 type Caller struct {
 	RootUserARN string
+	ARN         string // ARN of the calling entity, such as an assumed role session.
 	Account     string
 	UserID      string
 }
@@ -44,6 +46,7 @@ func (s STS) Get() (Caller, error) {
 
 	return Caller{
 		RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", *out.Account),
+		ARN:         aws.StringValue(out.Arn),
 		Account:     *out.Account,
 		UserID:      *out.UserId,
 	}, nil
@@ -43,6 +43,7 @@ func TestIdentity_Get(t *testing.T) {
 			wantIdentity: Caller{
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", mockAccount),
+				ARN:         mockARN,
 				UserID:      mockUserID,
 			},
 		},
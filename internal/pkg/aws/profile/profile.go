@@ -16,16 +16,24 @@ import (
 const (
 	awsCredentialsDir = ".aws"
 	awsConfigFileName = "config"
+
+	defaultProfileName = "default"
+
+	// Keys of a named profile section.
+	ssoStartURLKey = "sso_start_url"
+	ssoSessionKey  = "sso_session"
+	mfaSerialKey   = "mfa_serial"
 )
 
-type sectionsGetter interface {
+type iniReader interface {
 	Sections() []string
+	HasKey(section, key string) bool
 }
 
 // Config represents the local AWS config file.
 type Config struct {
 	// f is the ~/.aws/config INI file.
-	f sectionsGetter
+	f iniReader
 }
 
 // NewConfig returns a new parsed Config object from $HOME/.aws/config.
@@ -55,3 +63,23 @@ func (c *Config) Names() []string {
 	}
 	return profiles
 }
+
+// IsSSO returns true if the named profile retrieves its credentials with AWS SSO, false otherwise.
+func (c *Config) IsSSO(name string) bool {
+	section := sectionName(name)
+	return c.f.HasKey(section, ssoStartURLKey) || c.f.HasKey(section, ssoSessionKey)
+}
+
+// RequiresMFA returns true if the named profile assumes a role that requires an MFA token, false otherwise.
+func (c *Config) RequiresMFA(name string) bool {
+	return c.f.HasKey(sectionName(name), mfaSerialKey)
+}
+
+// sectionName returns the name of the section of a profile in the config file.
+// Named profiles are formatted as "[profile test]" except for the default profile.
+func sectionName(profile string) string {
+	if profile == defaultProfileName {
+		return profile
+	}
+	return fmt.Sprintf("profile %s", profile)
+}
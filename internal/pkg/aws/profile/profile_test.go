@@ -11,12 +11,22 @@ import (
 
 type mockINI struct {
 	sections []string
+	keys     map[string][]string
 }
 
 func (m *mockINI) Sections() []string {
 	return m.sections
 }
 
+func (m *mockINI) HasKey(section, key string) bool {
+	for _, k := range m.keys[section] {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
 func TestConfig_Names(t *testing.T) {
 	testCases := map[string]struct {
 		ini *mockINI
@@ -60,3 +70,65 @@ func TestConfig_Names(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_IsSSO(t *testing.T) {
+	testCases := map[string]struct {
+		ini       *mockINI
+		inProfile string
+
+		wanted bool
+	}{
+		"return false for profiles with access keys": {
+			ini: &mockINI{
+				keys: map[string][]string{
+					"profile test": {"aws_access_key_id", "aws_secret_access_key"},
+				},
+			},
+			inProfile: "test",
+		},
+		"return true for a profile with an SSO start URL": {
+			ini: &mockINI{
+				keys: map[string][]string{
+					"profile test": {"sso_start_url", "sso_account_id", "sso_role_name"},
+				},
+			},
+			inProfile: "test",
+			wanted:    true,
+		},
+		"return true for the default profile with an SSO session": {
+			ini: &mockINI{
+				keys: map[string][]string{
+					"default": {"sso_session", "sso_account_id", "sso_role_name"},
+				},
+			},
+			inProfile: "default",
+			wanted:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := &Config{
+				f: tc.ini,
+			}
+
+			// THEN
+			require.Equal(t, tc.wanted, conf.IsSSO(tc.inProfile))
+		})
+	}
+}
+
+func TestConfig_RequiresMFA(t *testing.T) {
+	conf := &Config{
+		f: &mockINI{
+			keys: map[string][]string{
+				"profile admin": {"role_arn", "source_profile", "mfa_serial"},
+				"profile test":  {"role_arn", "source_profile"},
+			},
+		},
+	}
+
+	require.True(t, conf.RequiresMFA("admin"))
+	require.False(t, conf.RequiresMFA("test"))
+}
@@ -6,13 +6,21 @@ package resourcegroups
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 )
 
+const (
+	// Throttled GetResources requests are retried up to maxGetResourcesAttempts times in total,
+	// waiting twice as long before each new attempt.
+	maxGetResourcesAttempts = 5
+	initialThrottleBackoff  = 500 * time.Millisecond
+)
+
 type api interface {
 	GetResources(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error)
 }
@@ -20,6 +28,7 @@ type api interface {
 // ResourceGroups wraps an AWS ResourceGroups client.
 type ResourceGroups struct {
 	client api
+	sleep  func(time.Duration)
 }
 
 // Resource contains the ARN and the tags of the resource.
@@ -32,12 +41,12 @@ type Resource struct {
 func New(s *session.Session) *ResourceGroups {
 	return &ResourceGroups{
 		client: resourcegroupstaggingapi.New(s),
+		sleep:  time.Sleep,
 	}
 }
 
 // GetResourcesByTags gets tag set and ARN for the resource with input resource type and tags.
 func (rg *ResourceGroups) GetResourcesByTags(resourceType string, tags map[string]string) ([]*Resource, error) {
-	var resources []*Resource
 	var tagFilter []*resourcegroupstaggingapi.TagFilter
 	for k, v := range tags {
 		tagFilter = append(tagFilter, &resourcegroupstaggingapi.TagFilter{
@@ -45,36 +54,62 @@ func (rg *ResourceGroups) GetResourcesByTags(resourceType string, tags map[strin
 			Values: aws.StringSlice([]string{v}),
 		})
 	}
-	resourceResp := &resourcegroupstaggingapi.GetResourcesOutput{}
-	for {
-		var err error
-		resourceResp, err = rg.client.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
-			PaginationToken:     resourceResp.PaginationToken,
-			ResourceTypeFilters: aws.StringSlice([]string{resourceType}),
-			TagFilters:          tagFilter,
+	resourceResp, err := rg.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{resourceType}),
+		TagFilters:          tagFilter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get resource: %w", err)
+	}
+	var resources []*Resource
+	for _, resourceTagMapping := range resourceResp.ResourceTagMappingList {
+		tags := make(map[string]string)
+		for _, tag := range resourceTagMapping.Tags {
+			if tag.Key == nil {
+				continue
+			}
+			tags[*tag.Key] = aws.StringValue(tag.Value)
+		}
+		resources = append(resources, &Resource{
+			ARN:  aws.StringValue(resourceTagMapping.ResourceARN),
+			Tags: tags,
 		})
+	}
+	return resources, nil
+}
+
+// GetResources returns the resources matching the filters of the input from all the pages of results.
+// Throttled requests are retried with an exponential backoff.
+func (rg *ResourceGroups) GetResources(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	input := *in
+	out := &resourcegroupstaggingapi.GetResourcesOutput{}
+	for {
+		resp, err := rg.getResourcesWithRetry(&input)
 		if err != nil {
-			return nil, fmt.Errorf("get resource: %w", err)
-		}
-		for _, resourceTagMapping := range resourceResp.ResourceTagMappingList {
-			tags := make(map[string]string)
-			for _, tag := range resourceTagMapping.Tags {
-				if tag.Key == nil {
-					continue
-				}
-				tags[*tag.Key] = aws.StringValue(tag.Value)
-			}
-			resources = append(resources, &Resource{
-				ARN:  aws.StringValue(resourceTagMapping.ResourceARN),
-				Tags: tags,
-			})
+			return nil, err
 		}
+		out.ResourceTagMappingList = append(out.ResourceTagMappingList, resp.ResourceTagMappingList...)
 		// usually pagination token is "" when it doesn't have any next page. However, since it
 		// is type *string, it is safer for us to check nil value for it as well.
-		if token := resourceResp.PaginationToken; aws.StringValue(token) == "" {
+		if aws.StringValue(resp.PaginationToken) == "" {
 			break
 		}
+		input.PaginationToken = resp.PaginationToken
 	}
+	return out, nil
+}
 
-	return resources, nil
+func (rg *ResourceGroups) getResourcesWithRetry(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	backoff := initialThrottleBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := rg.client.GetResources(in)
+		if err == nil {
+			return resp, nil
+		}
+		if !request.IsErrorThrottle(err) || attempt == maxGetResourcesAttempts {
+			return nil, err
+		}
+		rg.sleep(backoff)
+		backoff *= 2
+	}
 }
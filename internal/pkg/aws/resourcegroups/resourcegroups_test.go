@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups/mocks"
 	"github.com/golang/mock/gomock"
@@ -153,3 +155,126 @@ func TestResourceGroups_GetResourcesByTags(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceGroups_GetResources(t *testing.T) {
+	mockInput := &rgapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{"cloudformation"}),
+		TagFilters: []*rgapi.TagFilter{
+			{
+				Key:    aws.String("copilot-environment"),
+				Values: aws.StringSlice([]string{"test"}),
+			},
+		},
+	}
+	mockNextPageInput := &rgapi.GetResourcesInput{
+		PaginationToken:     aws.String("mockNextToken"),
+		ResourceTypeFilters: aws.StringSlice([]string{"cloudformation"}),
+		TagFilters: []*rgapi.TagFilter{
+			{
+				Key:    aws.String("copilot-environment"),
+				Values: aws.StringSlice([]string{"test"}),
+			},
+		},
+	}
+	mockThrottlingErr := awserr.New("ThrottlingException", "Rate exceeded", nil)
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedOut    *rgapi.GetResourcesOutput
+		wantedErr    error
+		wantedSleeps []time.Duration
+	}{
+		"returns the resources of every page": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetResources(mockInput).Return(&rgapi.GetResourcesOutput{
+						PaginationToken:        aws.String("mockNextToken"),
+						ResourceTagMappingList: []*rgapi.ResourceTagMapping{},
+					}, nil),
+					m.EXPECT().GetResources(mockNextPageInput).Return(&rgapi.GetResourcesOutput{
+						PaginationToken: aws.String(""),
+						ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+							{
+								ResourceARN: aws.String(mockArn1),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedOut: &rgapi.GetResourcesOutput{
+				ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+					{
+						ResourceARN: aws.String(mockArn1),
+					},
+				},
+			},
+		},
+		"retries throttled requests with an exponential backoff": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetResources(mockInput).Return(nil, mockThrottlingErr).Times(2),
+					m.EXPECT().GetResources(mockInput).Return(&rgapi.GetResourcesOutput{
+						ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+							{
+								ResourceARN: aws.String(mockArn1),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedOut: &rgapi.GetResourcesOutput{
+				ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+					{
+						ResourceARN: aws.String(mockArn1),
+					},
+				},
+			},
+			wantedSleeps: []time.Duration{500 * time.Millisecond, time.Second},
+		},
+		"returns the throttling error once out of attempts": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetResources(mockInput).Return(nil, mockThrottlingErr).Times(5)
+			},
+			wantedErr:    mockThrottlingErr,
+			wantedSleeps: []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		"doesn't retry other errors": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetResources(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			var sleeps []time.Duration
+			rg := &ResourceGroups{
+				client: mockClient,
+				sleep: func(d time.Duration) {
+					sleeps = append(sleeps, d)
+				},
+			}
+
+			// WHEN
+			out, err := rg.GetResources(mockInput)
+
+			// THEN
+			require.Equal(t, tc.wantedSleeps, sleeps)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOut, out)
+			require.Nil(t, mockInput.PaginationToken, "the input should not be modified")
+		})
+	}
+}
@@ -5,13 +5,17 @@
 package s3
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -19,6 +23,8 @@ import (
 
 const (
 	artifactDirName = "manual"
+
+	maxDeleteObjects = 1000 // Maximum number of objects that a single DeleteObjects request removes.
 )
 
 type s3ManagerApi interface {
@@ -27,7 +33,10 @@ type s3ManagerApi interface {
 
 type s3Api interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	GetObjectRequest(input *s3.GetObjectInput) (*request.Request, *s3.GetObjectOutput)
 }
 
 // S3 wraps an Amazon Simple Storage Service client.
@@ -47,6 +56,32 @@ func New(s *session.Session) *S3 {
 // PutArtifact uploads data to a S3 bucket under a random path that ends with
 // the file name and returns its url.
 func (s *S3) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+	resp, _, err := s.putArtifact(bucket, fileName, data)
+	if err != nil {
+		return "", err
+	}
+	return resp.Location, nil
+}
+
+// PutArtifactForDownload uploads data to a S3 bucket under a random path that ends with
+// the file name and returns a presigned url that allows to download it until it expires.
+func (s *S3) PutArtifactForDownload(bucket, fileName string, data io.Reader, expiry time.Duration) (string, error) {
+	_, key, err := s.putArtifact(bucket, fileName, data)
+	if err != nil {
+		return "", err
+	}
+	req, _ := s.s3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	url, err := req.Presign(expiry)
+	if err != nil {
+		return "", fmt.Errorf("presign download of %s from bucket %s: %w", key, bucket, err)
+	}
+	return url, nil
+}
+
+func (s *S3) putArtifact(bucket, fileName string, data io.Reader) (*s3manager.UploadOutput, string, error) {
 	id := time.Now().Unix()
 	key := path.Join(artifactDirName, strconv.FormatInt(id, 10), fileName)
 	resp, err := s.s3Manager.Upload(&s3manager.UploadInput{
@@ -55,13 +90,95 @@ func (s *S3) PutArtifact(bucket, fileName string, data io.Reader) (string, error
 		Key:    aws.String(key),
 	})
 	if err != nil {
-		return "", fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
+		return nil, "", fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
 	}
+	return resp, key, nil
+}
 
-	return resp.Location, nil
+// PutObject uploads data to a S3 bucket under the key.
+func (s *S3) PutObject(bucket, key string, data io.Reader) error {
+	_, err := s.s3Manager.Upload(&s3manager.UploadInput{
+		Body:   data,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
+	}
+	return nil
+}
+
+// GetObject returns the contents of the object stored under the key in a S3 bucket.
+func (s *S3) GetObject(bucket, key string) ([]byte, error) {
+	out, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get %s from bucket %s: %w", key, bucket, err)
+	}
+	defer out.Body.Close()
+	data, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read %s from bucket %s: %w", key, bucket, err)
+	}
+	return data, nil
+}
+
+// ListObjectKeys returns the keys of the objects in a S3 bucket that start with the prefix, in ascending order.
+// If the bucket doesn't exist, it returns an empty list.
+func (s *S3) ListObjectKeys(bucket, prefix string) ([]string, error) {
+	var keys []string
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	for {
+		out, err := s.s3Client.ListObjectsV2(in)
+		if err != nil {
+			if isNoSuchBucketErr(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("list objects with prefix %s in bucket %s: %w", prefix, bucket, err)
+		}
+		for _, object := range out.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return keys, nil
+		}
+		in.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// DeleteObjects deletes the objects stored under the keys in a S3 bucket.
+func (s *S3) DeleteObjects(bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += maxDeleteObjects {
+		end := start + maxDeleteObjects
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+		_, err := s.s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("delete objects from bucket %s: %w", bucket, err)
+		}
+	}
+	return nil
 }
 
 // EmptyBucket deletes all objects within the bucket.
+// If the bucket doesn't exist, there is nothing to empty and it returns nil.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
 	var err error
@@ -72,6 +189,9 @@ func (s *S3) EmptyBucket(bucket string) error {
 	for {
 		listResp, err = s.s3Client.ListObjectVersions(listParams)
 		if err != nil {
+			if isNoSuchBucketErr(err) {
+				return nil
+			}
 			return fmt.Errorf("list objects for bucket %s: %w", bucket, err)
 		}
 		var objectsToDelete []*s3.ObjectIdentifier
@@ -108,3 +228,11 @@ func (s *S3) EmptyBucket(bucket string) error {
 		listParams.VersionIdMarker = listResp.NextVersionIdMarker
 	}
 }
+
+func isNoSuchBucketErr(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == s3.ErrCodeNoSuchBucket
+}
@@ -8,10 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3/mocks"
@@ -89,6 +93,69 @@ func TestS3_PutArtifact(t *testing.T) {
 	}
 }
 
+func TestS3_PutArtifactForDownload(t *testing.T) {
+	timeNow := strconv.FormatInt(time.Now().Unix(), 10)
+	key := fmt.Sprintf("manual/%s/frontend.tar.gz", timeNow)
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	testCases := map[string]struct {
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerApi)
+		mockS3Client        func(m *mocks.Mocks3Api)
+
+		wantErr error
+	}{
+		"should return error if fail to upload": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			mockS3Client: func(m *mocks.Mocks3Api) {},
+
+			wantErr: fmt.Errorf("put %s to bucket mockbucket: some error", key),
+		},
+		"should return a presigned url to download the artifact": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any()).Return(&s3manager.UploadOutput{}, nil)
+			},
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetObjectRequest(&s3.GetObjectInput{
+					Bucket: aws.String("mockbucket"),
+					Key:    aws.String(key),
+				}).DoAndReturn(s3.New(sess).GetObjectRequest)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerApi(ctrl)
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+				s3Client:  mockS3Client,
+			}
+
+			gotURL, gotErr := service.PutArtifactForDownload("mockbucket", "frontend.tar.gz", &bytes.Buffer{}, 15*time.Minute)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.True(t, strings.Contains(gotURL, key), "url %s should point to the artifact", gotURL)
+			require.True(t, strings.Contains(gotURL, "X-Amz-Expires=900"), "url %s should expire in 15 minutes", gotURL)
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
@@ -214,6 +281,15 @@ func TestS3_EmptyBucket(t *testing.T) {
 
 			wantErr: fmt.Errorf("list objects for bucket mockBucket: some error"),
 		},
+		"should return nil if the bucket doesn't exist": {
+			inBucket: "mockBucket",
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().ListObjectVersions(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil))
+				m.EXPECT().DeleteObjects(gomock.Any()).Times(0)
+			},
+
+			wantErr: nil,
+		},
 		"should not invoke DeleteObjects if bucket is empty": {
 			inBucket: "mockBucket",
 			mockS3Client: func(m *mocks.Mocks3Api) {
@@ -267,3 +343,154 @@ func TestS3_EmptyBucket(t *testing.T) {
 
 	}
 }
+
+func TestS3_ListObjectKeys(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wantKeys []string
+		wantErr  error
+	}{
+		"should return the keys of all pages": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("deployments/"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("deployments/1.json")},
+					},
+					IsTruncated:           aws.Bool(true),
+					NextContinuationToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					Prefix:            aws.String("deployments/"),
+					ContinuationToken: aws.String("mockToken"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("deployments/2.json")},
+					},
+					IsTruncated: aws.Bool(false),
+				}, nil)
+			},
+
+			wantKeys: []string{"deployments/1.json", "deployments/2.json"},
+		},
+		"should return an empty list if the bucket doesn't exist": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil))
+			},
+		},
+		"should wrap up error if fail to list objects": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("list objects with prefix deployments/ in bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			gotKeys, gotErr := service.ListObjectKeys("mockBucket", "deployments/")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantKeys, gotKeys)
+		})
+	}
+}
+
+func TestS3_DeleteObjects(t *testing.T) {
+	keys := make([]string, 1001)
+	batchObjectID1 := make([]*s3.ObjectIdentifier, 1000)
+	for i := range keys {
+		keys[i] = "mockKey"
+	}
+	for i := range batchObjectID1 {
+		batchObjectID1[i] = &s3.ObjectIdentifier{
+			Key: aws.String("mockKey"),
+		}
+	}
+	testCases := map[string]struct {
+		inKeys       []string
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wantErr error
+	}{
+		"should batch delete the objects": {
+			inKeys: keys,
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().DeleteObjects(&s3.DeleteObjectsInput{
+					Bucket: aws.String("mockBucket"),
+					Delete: &s3.Delete{
+						Objects: batchObjectID1,
+					},
+				}).Return(&s3.DeleteObjectsOutput{}, nil)
+				m.EXPECT().DeleteObjects(&s3.DeleteObjectsInput{
+					Bucket: aws.String("mockBucket"),
+					Delete: &s3.Delete{
+						Objects: []*s3.ObjectIdentifier{
+							{Key: aws.String("mockKey")},
+						},
+					},
+				}).Return(&s3.DeleteObjectsOutput{}, nil)
+			},
+		},
+		"should not invoke DeleteObjects without keys": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Times(0)
+			},
+		},
+		"should wrap up error if fail to delete objects": {
+			inKeys: []string{"mockKey"},
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("delete objects from bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			gotErr := service.DeleteObjects("mockBucket", tc.inKeys)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -8,15 +8,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 )
@@ -26,12 +31,33 @@ const (
 
 	credsTimeout  = 10 * time.Second
 	clientTimeout = 30 * time.Second
+
+	fmtMFATokenPrompt  = "What's the MFA token code for profile %s?"
+	mfaTokenHelpPrompt = "The profile assumes a role that requires a one-time code from the MFA device in mfa_serial."
+
+	// Environment variables that replace the endpoints of the AWS services, such as with the URL of localstack.
+	// The endpoint of a single service is set with the upper-case name of the service as suffix, such as COPILOT_AWS_ENDPOINT_URL_SSM.
+	envEndpointURL              = "COPILOT_AWS_ENDPOINT_URL"
+	envServiceEndpointURLPrefix = envEndpointURL + "_"
 )
 
+type prompter interface {
+	Get(message, help string, validator prompt.ValidatorFunc, promptOpts ...prompt.Option) (string, error)
+}
+
+type profileConfig interface {
+	IsSSO(name string) bool
+	RequiresMFA(name string) bool
+}
+
 // Provider provides methods to create sessions.
 // Once a session is created, it's cached locally so that the same session is not re-created.
 type Provider struct {
 	defaultSess *session.Session
+	profileSess map[string]*session.Session // Sessions by profile name, so that MFA tokens are asked only once.
+
+	prompt   prompter
+	profiles profileConfig // Lazily loaded from the AWS config file, nil if the file can't be read.
 }
 
 var instance *Provider
@@ -40,7 +66,10 @@ var once sync.Once
 // NewProvider returns a session Provider singleton.
 func NewProvider() *Provider {
 	once.Do(func() {
-		instance = &Provider{}
+		instance = &Provider{
+			profileSess: make(map[string]*session.Session),
+			prompt:      prompt.New(),
+		}
 	})
 	return instance
 }
@@ -77,16 +106,33 @@ func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
 }
 
 // FromProfile returns a session configured against the input profile name.
+// If the profile assumes a role that requires MFA, the user is prompted for a token code.
+// An ErrSSOProfile is returned if the profile retrieves its credentials with AWS SSO.
 func (p *Provider) FromProfile(name string) (*session.Session, error) {
+	if sess, ok := p.profileSess[name]; ok {
+		return sess, nil
+	}
+	profiles := p.profileConfig()
+	if profiles != nil && profiles.IsSSO(name) {
+		return nil, &ErrSSOProfile{Profile: name}
+	}
 	sess, err := session.NewSessionWithOptions(session.Options{
-		Config:            *newConfig(),
-		SharedConfigState: session.SharedConfigEnable,
-		Profile:           name,
+		Config:                  *newConfig(),
+		SharedConfigState:       session.SharedConfigEnable,
+		Profile:                 name,
+		AssumeRoleTokenProvider: p.mfaTokenProvider(name),
 	})
 	if err != nil {
 		return nil, err
 	}
+	if profiles != nil && profiles.RequiresMFA(name) {
+		// Retrieve the credentials right away so that the token is asked before any progress is rendered.
+		if _, err := Creds(sess); err != nil {
+			return nil, err
+		}
+	}
 	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	p.profileSess[name] = sess
 	return sess, nil
 }
 
@@ -96,18 +142,7 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 	if err != nil {
 		return nil, fmt.Errorf("error creating default session: %w", err)
 	}
-
-	creds := stscreds.NewCredentials(defaultSession, roleARN)
-	sess, err := session.NewSession(
-		newConfig().
-			WithCredentials(creds).
-			WithRegion(region),
-	)
-	if err != nil {
-		return nil, err
-	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
+	return AssumeRole(defaultSession, roleARN, region)
 }
 
 // FromStaticCreds returns a session from static credentials.
@@ -115,7 +150,7 @@ func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken st
 	conf := newConfig()
 	conf.Credentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, sessionToken)
 	sess, err := session.NewSessionWithOptions(session.Options{
-		Config: *newConfig(),
+		Config: *conf,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create session from static credentials: %w", err)
@@ -124,6 +159,46 @@ func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken st
 	return sess, nil
 }
 
+// profileConfig returns the parsed AWS config file, or nil if it can't be read.
+func (p *Provider) profileConfig() profileConfig {
+	if p.profiles != nil {
+		return p.profiles
+	}
+	cfg, err := profile.NewConfig()
+	if err != nil {
+		return nil
+	}
+	p.profiles = cfg
+	return cfg
+}
+
+// mfaTokenProvider returns a function that prompts for the MFA token code of the profile.
+func (p *Provider) mfaTokenProvider(profile string) func() (string, error) {
+	return func() (string, error) {
+		token, err := p.prompt.Get(fmt.Sprintf(fmtMFATokenPrompt, profile), mfaTokenHelpPrompt, prompt.RequireNonEmpty)
+		if err != nil {
+			return "", fmt.Errorf("get MFA token code for profile %s: %w", profile, err)
+		}
+		return token, nil
+	}
+}
+
+// AssumeRole returns a session configured against the input role and region
+// that assumes the role with the credentials of the input session.
+func AssumeRole(sess *session.Session, roleARN string, region string) (*session.Session, error) {
+	creds := stscreds.NewCredentials(sess, roleARN)
+	roleSess, err := session.NewSession(
+		newConfig().
+			WithCredentials(creds).
+			WithRegion(region),
+	)
+	if err != nil {
+		return nil, err
+	}
+	roleSess.Handlers.Build.PushBackNamed(userAgentHandler())
+	return roleSess, nil
+}
+
 // AreCredsFromEnvVars returns true if the session's credentials provider is environment variables, false otherwise.
 // An error is returned if the credentials are invalid or the request times out.
 func AreCredsFromEnvVars(sess *session.Session) (bool, error) {
@@ -147,13 +222,68 @@ func Creds(sess *session.Session) (credentials.Value, error) {
 }
 
 // newConfig returns a config with an end-to-end request timeout and verbose credentials errors.
+// The endpoints of the AWS services are replaced by the ones set in the environment variables, if any.
 func newConfig() *aws.Config {
 	c := &http.Client{
 		Timeout: clientTimeout,
 	}
-	return aws.NewConfig().
+	conf := aws.NewConfig().
 		WithHTTPClient(c).
 		WithCredentialsChainVerboseErrors(true)
+	return withCustomEndpoints(conf, os.Environ())
+}
+
+// withCustomEndpoints sets a resolver for the endpoints set in the environment variables of environ, formatted as "key=value".
+// Since the custom endpoints usually can't resolve bucket subdomains, S3 buckets are addressed by path.
+func withCustomEndpoints(conf *aws.Config, environ []string) *aws.Config {
+	resolver := &endpointResolver{
+		serviceURLs: make(map[string]string),
+	}
+	for _, kv := range environ {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			continue
+		}
+		switch key := parts[0]; {
+		case key == envEndpointURL:
+			resolver.url = parts[1]
+		case strings.HasPrefix(key, envServiceEndpointURLPrefix):
+			resolver.serviceURLs[strings.TrimPrefix(key, envServiceEndpointURLPrefix)] = parts[1]
+		}
+	}
+	if resolver.url == "" && len(resolver.serviceURLs) == 0 {
+		return conf
+	}
+	return conf.WithEndpointResolver(resolver).WithS3ForcePathStyle(true)
+}
+
+// endpointResolver resolves the endpoints of the AWS services to custom URLs,
+// and falls back to the default endpoints of the services without a custom URL.
+type endpointResolver struct {
+	url         string            // Endpoint of every service without its own endpoint.
+	serviceURLs map[string]string // Endpoints by upper-case name of the service, such as "SSM" or "CLOUDFORMATION".
+}
+
+// EndpointFor implements the endpoints.Resolver interface.
+func (r *endpointResolver) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	url := r.url
+	if serviceURL, ok := r.serviceURLs[endpointServiceName(service)]; ok {
+		url = serviceURL
+	}
+	if url == "" {
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	}
+	return endpoints.ResolvedEndpoint{
+		URL:           url,
+		SigningRegion: region,
+	}, nil
+}
+
+// endpointServiceName returns the name of the service in the environment variable of its endpoint.
+// For example, the endpoints ID "api.ecr" is named "ECR".
+func endpointServiceName(endpointsID string) string {
+	name := strings.TrimPrefix(endpointsID, "api.")
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(name))
 }
 
 // userAgentHandler returns a http request handler that sets a custom user agent to all aws requests.
@@ -167,3 +297,12 @@ func userAgentHandler() request.NamedHandler {
 		},
 	}
 }
+
+// ErrSSOProfile occurs when a named profile retrieves its credentials with AWS SSO.
+type ErrSSOProfile struct {
+	Profile string
+}
+
+func (e *ErrSSOProfile) Error() string {
+	return fmt.Sprintf(`profile %s uses AWS SSO which isn't supported yet: sign in with "aws sso login --profile %s", then use the temporary credentials of the role from the AWS SSO user portal instead`, e.Profile, e.Profile)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions/mocks"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -140,3 +142,166 @@ func TestCreds(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_FromProfile(t *testing.T) {
+	cachedSess := &session.Session{}
+	testCases := map[string]struct {
+		inProfile  string
+		inCached   map[string]*session.Session
+		setupMocks func(m *mocks.MockprofileConfig)
+		wantedSess *session.Session
+		wantedErr  error
+	}{
+		"returns the cached session of the profile": {
+			inProfile:  "test",
+			inCached:   map[string]*session.Session{"test": cachedSess},
+			setupMocks: func(m *mocks.MockprofileConfig) {},
+			wantedSess: cachedSess,
+		},
+		"returns an error if the profile uses AWS SSO": {
+			inProfile: "sso",
+			inCached:  map[string]*session.Session{},
+			setupMocks: func(m *mocks.MockprofileConfig) {
+				m.EXPECT().IsSSO("sso").Return(true)
+			},
+			wantedErr: errors.New(`profile sso uses AWS SSO which isn't supported yet: sign in with "aws sso login --profile sso", then use the temporary credentials of the role from the AWS SSO user portal instead`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProfiles := mocks.NewMockprofileConfig(ctrl)
+			tc.setupMocks(mockProfiles)
+			p := &Provider{
+				profileSess: tc.inCached,
+				profiles:    mockProfiles,
+			}
+
+			// WHEN
+			sess, err := p.FromProfile(tc.inProfile)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSess, sess)
+			}
+		})
+	}
+}
+
+func TestProvider_mfaTokenProvider(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockprompter)
+
+		wantedToken string
+		wantedErr   error
+	}{
+		"prompts for the MFA token code of the profile": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get("What's the MFA token code for profile admin?", mfaTokenHelpPrompt, gomock.Any()).Return("123456", nil)
+			},
+			wantedToken: "123456",
+		},
+		"wraps prompt errors": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get MFA token code for profile admin: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompt)
+			p := &Provider{
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			token, err := p.mfaTokenProvider("admin")()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedToken, token)
+			}
+		})
+	}
+}
+
+func TestWithCustomEndpoints(t *testing.T) {
+	testCases := map[string]struct {
+		inEnviron []string
+
+		wantedResolver bool
+		wantedURLs     map[string]string // Endpoint URL by endpoints ID of the service.
+	}{
+		"doesn't set a resolver without custom endpoints": {
+			inEnviron: []string{"HOME=/home/user", "COPILOT_AWS_ENDPOINT_URL="},
+		},
+		"resolves every service to the custom endpoint": {
+			inEnviron:      []string{"COPILOT_AWS_ENDPOINT_URL=http://localhost:4566"},
+			wantedResolver: true,
+			wantedURLs: map[string]string{
+				"ssm":            "http://localhost:4566",
+				"cloudformation": "http://localhost:4566",
+				"api.ecr":        "http://localhost:4566",
+				"s3":             "http://localhost:4566",
+			},
+		},
+		"resolves a service to its own endpoint": {
+			inEnviron: []string{
+				"COPILOT_AWS_ENDPOINT_URL=http://localhost:4566",
+				"COPILOT_AWS_ENDPOINT_URL_ECR=http://localhost:5000",
+				"COPILOT_AWS_ENDPOINT_URL_SSM=http://localhost:4583",
+			},
+			wantedResolver: true,
+			wantedURLs: map[string]string{
+				"ssm":            "http://localhost:4583",
+				"api.ecr":        "http://localhost:5000",
+				"cloudformation": "http://localhost:4566",
+			},
+		},
+		"falls back to the default endpoints of the services without a custom endpoint": {
+			inEnviron:      []string{"COPILOT_AWS_ENDPOINT_URL_CLOUDFORMATION=http://localhost:4581"},
+			wantedResolver: true,
+			wantedURLs: map[string]string{
+				"cloudformation": "http://localhost:4581",
+				"ecs":            "https://ecs.us-west-2.amazonaws.com",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			conf := withCustomEndpoints(aws.NewConfig(), tc.inEnviron)
+
+			// THEN
+			if !tc.wantedResolver {
+				require.Nil(t, conf.EndpointResolver)
+				require.Nil(t, conf.S3ForcePathStyle)
+				return
+			}
+			require.NotNil(t, conf.EndpointResolver)
+			require.True(t, aws.BoolValue(conf.S3ForcePathStyle))
+			for service, url := range tc.wantedURLs {
+				endpoint, err := conf.EndpointResolver.EndpointFor(service, "us-west-2")
+				require.NoError(t, err)
+				require.Equal(t, url, endpoint.URL, "endpoint of %s", service)
+				require.Equal(t, "us-west-2", endpoint.SigningRegion, "signing region of %s", service)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sns provides a client to make API requests to Amazon Simple Notification Service.
+package sns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+type api interface {
+	Publish(input *sns.PublishInput) (*sns.PublishOutput, error)
+}
+
+// SNS wraps an Amazon Simple Notification Service client.
+type SNS struct {
+	client api
+}
+
+// New returns a SNS client configured against the input session.
+func New(s *session.Session) *SNS {
+	return &SNS{
+		client: sns.New(s),
+	}
+}
+
+// Publish sends the message to the topic and returns the ID of the published message.
+func (s *SNS) Publish(topicARN, message string) (string, error) {
+	out, err := s.client.Publish(&sns.PublishInput{
+		TopicArn: aws.String(topicARN),
+		Message:  aws.String(message),
+	})
+	if err != nil {
+		return "", fmt.Errorf("publish message to topic %s: %w", topicARN, err)
+	}
+	return aws.StringValue(out.MessageId), nil
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sns/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSNS_Publish(t *testing.T) {
+	const mockTopicARN = "arn:aws:sns:us-west-2:123456789012:deployments"
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"returns the ID of the published message": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().Publish(&sns.PublishInput{
+					TopicArn: aws.String(mockTopicARN),
+					Message:  aws.String(`{"app":"phonetool"}`),
+				}).Return(&sns.PublishOutput{
+					MessageId: aws.String("mockID"),
+				}, nil)
+			},
+			wantedID: "mockID",
+		},
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().Publish(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("publish message to topic arn:aws:sns:us-west-2:123456789012:deployments: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := SNS{
+				client: m,
+			}
+
+			// WHEN
+			id, err := client.Publish(mockTopicARN, `{"app":"phonetool"}`)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
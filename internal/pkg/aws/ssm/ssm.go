@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssm provides a client to make API requests to AWS Systems Manager Parameter Store.
+package ssm
+
+import (
+	"fmt"
+	"strings"
+
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	parameterResourceType = "ssm:parameter"
+	parameterARNPrefix    = "parameter/"
+
+	// Maximum number of parameters that can be deleted in a single request.
+	maxDeleteParameters = 10
+)
+
+type api interface {
+	DeleteParameters(input *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error)
+}
+
+type resourceGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*rg.Resource, error)
+}
+
+// SSM wraps an AWS Systems Manager client.
+type SSM struct {
+	client   api
+	rgClient resourceGetter
+}
+
+// New returns a SSM struct configured against the input session.
+func New(s *session.Session) *SSM {
+	return &SSM{
+		client:   ssm.New(s),
+		rgClient: rg.New(s),
+	}
+}
+
+// DeleteParametersWithTags deletes all the parameters that have the resource tags and returns their names.
+func (s *SSM) DeleteParametersWithTags(tags map[string]string) ([]string, error) {
+	resources, err := s.rgClient.GetResourcesByTags(parameterResourceType, tags)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, resource := range resources {
+		name, err := parameterName(resource.ARN)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	for start := 0; start < len(names); start += maxDeleteParameters {
+		end := start + maxDeleteParameters
+		if end > len(names) {
+			end = len(names)
+		}
+		// Parameters that were deleted in the meantime are returned as invalid parameters instead of failing the request.
+		if _, err := s.client.DeleteParameters(&ssm.DeleteParametersInput{
+			Names: aws.StringSlice(names[start:end]),
+		}); err != nil {
+			return nil, fmt.Errorf("delete parameters %s: %w", strings.Join(names[start:end], ", "), err)
+		}
+	}
+	return names, nil
+}
+
+// parameterName returns the name of a parameter from its ARN.
+// For example, "arn:aws:ssm:us-west-2:123456789012:parameter/my-app/db-password" returns "/my-app/db-password".
+func parameterName(parameterARN string) (string, error) {
+	parsed, err := arn.Parse(parameterARN)
+	if err != nil {
+		return "", fmt.Errorf("parse parameter ARN %s: %w", parameterARN, err)
+	}
+	if !strings.HasPrefix(parsed.Resource, parameterARNPrefix) {
+		return "", fmt.Errorf("unknown parameter ARN format %s", parameterARN)
+	}
+	name := strings.TrimPrefix(parsed.Resource, parameterARNPrefix)
+	if strings.Contains(name, "/") {
+		// The leading slash of hierarchical names is left out of their ARN.
+		name = "/" + name
+	}
+	return name, nil
+}
@@ -0,0 +1,121 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type ssmMocks struct {
+	ssm *mocks.Mockapi
+	rg  *mocks.MockresourceGetter
+}
+
+func TestSSM_DeleteParametersWithTags(t *testing.T) {
+	const mockARNPrefix = "arn:aws:ssm:us-west-2:123456789012:parameter/"
+	mockTags := map[string]string{
+		"copilot-application": "my-app",
+		"copilot-service":     "api",
+	}
+	testError := errors.New("some error")
+
+	testCases := map[string]struct {
+		setupMocks func(m ssmMocks)
+
+		wantedNames []string
+		wantedErr   error
+	}{
+		"errors if fail to get parameters by tags": {
+			setupMocks: func(m ssmMocks) {
+				m.rg.EXPECT().GetResourcesByTags(parameterResourceType, mockTags).Return(nil, testError)
+			},
+			wantedErr: testError,
+		},
+		"errors if a parameter ARN is invalid": {
+			setupMocks: func(m ssmMocks) {
+				m.rg.EXPECT().GetResourcesByTags(parameterResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: "arn:aws:ssm:us-west-2:123456789012:document/my-doc"},
+				}, nil)
+			},
+			wantedErr: fmt.Errorf("unknown parameter ARN format arn:aws:ssm:us-west-2:123456789012:document/my-doc"),
+		},
+		"errors if fail to delete the parameters": {
+			setupMocks: func(m ssmMocks) {
+				m.rg.EXPECT().GetResourcesByTags(parameterResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockARNPrefix + "my-app/api/db-password"},
+				}, nil)
+				m.ssm.EXPECT().DeleteParameters(gomock.Any()).Return(nil, testError)
+			},
+			wantedErr: fmt.Errorf("delete parameters /my-app/api/db-password: some error"),
+		},
+		"does nothing if there are no parameters with the tags": {
+			setupMocks: func(m ssmMocks) {
+				m.rg.EXPECT().GetResourcesByTags(parameterResourceType, mockTags).Return(nil, nil)
+				m.ssm.EXPECT().DeleteParameters(gomock.Any()).Times(0)
+			},
+		},
+		"deletes the parameters in batches": {
+			setupMocks: func(m ssmMocks) {
+				var resources []*rg.Resource
+				var names []string
+				for i := 0; i < 11; i++ {
+					resources = append(resources, &rg.Resource{ARN: fmt.Sprintf("%smy-app/api/param-%d", mockARNPrefix, i)})
+					names = append(names, fmt.Sprintf("/my-app/api/param-%d", i))
+				}
+				resources = append(resources, &rg.Resource{ARN: mockARNPrefix + "flat-param"})
+				m.rg.EXPECT().GetResourcesByTags(parameterResourceType, mockTags).Return(resources, nil)
+				gomock.InOrder(
+					m.ssm.EXPECT().DeleteParameters(&ssm.DeleteParametersInput{
+						Names: aws.StringSlice(names[:10]),
+					}).Return(&ssm.DeleteParametersOutput{}, nil),
+					m.ssm.EXPECT().DeleteParameters(&ssm.DeleteParametersInput{
+						Names: aws.StringSlice([]string{"/my-app/api/param-10", "flat-param"}),
+					}).Return(&ssm.DeleteParametersOutput{}, nil),
+				)
+			},
+			wantedNames: []string{
+				"/my-app/api/param-0", "/my-app/api/param-1", "/my-app/api/param-2", "/my-app/api/param-3",
+				"/my-app/api/param-4", "/my-app/api/param-5", "/my-app/api/param-6", "/my-app/api/param-7",
+				"/my-app/api/param-8", "/my-app/api/param-9", "/my-app/api/param-10", "flat-param",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := ssmMocks{
+				ssm: mocks.NewMockapi(ctrl),
+				rg:  mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			client := SSM{
+				client:   m.ssm,
+				rgClient: m.rg,
+			}
+
+			// WHEN
+			names, err := client.DeleteParametersWithTags(mockTags)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stepfunctions provides a client to make API requests to AWS Step Functions.
+package stepfunctions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+)
+
+const (
+	// maxListExecutionsResults is the maximum number of executions returned by a single ListExecutions call.
+	maxListExecutionsResults = 1000
+	// maxFailureEvents is the number of events, starting from the most recent one, searched for the failure of an execution.
+	maxFailureEvents = 10
+)
+
+type api interface {
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
+}
+
+// StepFunctions wraps an AWS Step Functions client.
+type StepFunctions struct {
+	client api
+}
+
+// Execution holds the status of an execution of a state machine.
+type Execution struct {
+	Name      string
+	ARN       string
+	Status    string // One of "RUNNING", "SUCCEEDED", "FAILED", "TIMED_OUT" or "ABORTED".
+	StartDate time.Time
+	StopDate  *time.Time // Nil if the execution is still running.
+}
+
+// ExecutionFailure holds the error that stopped an execution and its cause.
+type ExecutionFailure struct {
+	Error string
+	Cause string
+}
+
+// New returns a StepFunctions client configured against the input session.
+func New(s *session.Session) *StepFunctions {
+	return &StepFunctions{
+		client: sfn.New(s),
+	}
+}
+
+// Executions returns up to maxResults of the most recent executions of the state machine, newest first.
+// If status is not empty, only the executions with that status are returned.
+func (s *StepFunctions) Executions(stateMachineARN, status string, maxResults int) ([]*Execution, error) {
+	in := &sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+	if status != "" {
+		in.StatusFilter = aws.String(status)
+	}
+	var executions []*Execution
+	for len(executions) < maxResults {
+		pageSize := maxResults - len(executions)
+		if pageSize > maxListExecutionsResults {
+			pageSize = maxListExecutionsResults
+		}
+		in.MaxResults = aws.Int64(int64(pageSize))
+		out, err := s.client.ListExecutions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+		}
+		for _, item := range out.Executions {
+			executions = append(executions, &Execution{
+				Name:      aws.StringValue(item.Name),
+				ARN:       aws.StringValue(item.ExecutionArn),
+				Status:    aws.StringValue(item.Status),
+				StartDate: aws.TimeValue(item.StartDate),
+				StopDate:  item.StopDate,
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return executions, nil
+}
+
+// ExecutionFailure returns the error that made the execution fail, time out or abort.
+// It returns nil if no such error is found in the most recent events of the execution.
+func (s *StepFunctions) ExecutionFailure(executionARN string) (*ExecutionFailure, error) {
+	out, err := s.client.GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionARN),
+		MaxResults:   aws.Int64(maxFailureEvents),
+		ReverseOrder: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get history of execution %s: %w", executionARN, err)
+	}
+	for _, event := range out.Events {
+		switch {
+		case event.ExecutionFailedEventDetails != nil:
+			return &ExecutionFailure{
+				Error: aws.StringValue(event.ExecutionFailedEventDetails.Error),
+				Cause: aws.StringValue(event.ExecutionFailedEventDetails.Cause),
+			}, nil
+		case event.ExecutionTimedOutEventDetails != nil:
+			return &ExecutionFailure{
+				Error: aws.StringValue(event.ExecutionTimedOutEventDetails.Error),
+				Cause: aws.StringValue(event.ExecutionTimedOutEventDetails.Cause),
+			}, nil
+		case event.ExecutionAbortedEventDetails != nil:
+			return &ExecutionFailure{
+				Error: aws.StringValue(event.ExecutionAbortedEventDetails.Error),
+				Cause: aws.StringValue(event.ExecutionAbortedEventDetails.Cause),
+			}, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stepfunctions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepFunctions_Executions(t *testing.T) {
+	const mockStateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report"
+	startDate := time.Date(2020, time.November, 23, 0, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(2 * time.Minute)
+	testCases := map[string]struct {
+		inStatus     string
+		inMaxResults int
+		mockClient   func(m *mocks.Mockapi)
+
+		wantedExecutions []*Execution
+		wantedErr        error
+	}{
+		"wraps the error from the client": {
+			inMaxResults: 10,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list executions of state machine arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report: some error"),
+		},
+		"returns no executions if the state machine never ran": {
+			inMaxResults: 10,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(&sfn.ListExecutionsOutput{}, nil)
+			},
+		},
+		"filters the executions by status and paginates until the maximum number of results": {
+			inStatus:     "FAILED",
+			inMaxResults: 2,
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+						StateMachineArn: aws.String(mockStateMachineARN),
+						StatusFilter:    aws.String("FAILED"),
+						MaxResults:      aws.Int64(2),
+					}).Return(&sfn.ListExecutionsOutput{
+						Executions: []*sfn.ExecutionListItem{
+							{
+								Name:         aws.String("exec-2"),
+								ExecutionArn: aws.String("arn-2"),
+								Status:       aws.String("FAILED"),
+								StartDate:    aws.Time(startDate),
+								StopDate:     aws.Time(stopDate),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+						StateMachineArn: aws.String(mockStateMachineARN),
+						StatusFilter:    aws.String("FAILED"),
+						MaxResults:      aws.Int64(1),
+						NextToken:       aws.String("token"),
+					}).Return(&sfn.ListExecutionsOutput{
+						Executions: []*sfn.ExecutionListItem{
+							{
+								Name:         aws.String("exec-1"),
+								ExecutionArn: aws.String("arn-1"),
+								Status:       aws.String("FAILED"),
+								StartDate:    aws.Time(startDate),
+								StopDate:     aws.Time(stopDate),
+							},
+						},
+						NextToken: aws.String("token2"),
+					}, nil),
+				)
+			},
+			wantedExecutions: []*Execution{
+				{
+					Name:      "exec-2",
+					ARN:       "arn-2",
+					Status:    "FAILED",
+					StartDate: startDate,
+					StopDate:  aws.Time(stopDate),
+				},
+				{
+					Name:      "exec-1",
+					ARN:       "arn-1",
+					Status:    "FAILED",
+					StartDate: startDate,
+					StopDate:  aws.Time(stopDate),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := StepFunctions{
+				client: m,
+			}
+
+			// WHEN
+			executions, err := client.Executions(mockStateMachineARN, tc.inStatus, tc.inMaxResults)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExecutions, executions)
+		})
+	}
+}
+
+func TestStepFunctions_ExecutionFailure(t *testing.T) {
+	const mockExecutionARN = "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:exec-1"
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedFailure *ExecutionFailure
+		wantedErr     error
+	}{
+		"wraps the error from the client": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get history of execution arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:exec-1: some error"),
+		},
+		"returns the error of a failed execution": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String(mockExecutionARN),
+					MaxResults:   aws.Int64(10),
+					ReverseOrder: aws.Bool(true),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{
+								Error: aws.String("States.TaskFailed"),
+								Cause: aws.String(`{"StoppedReason":"Essential container in task exited"}`),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedFailure: &ExecutionFailure{
+				Error: "States.TaskFailed",
+				Cause: `{"StoppedReason":"Essential container in task exited"}`,
+			},
+		},
+		"returns the error of a timed out execution": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							ExecutionTimedOutEventDetails: &sfn.ExecutionTimedOutEventDetails{
+								Error: aws.String("States.Timeout"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedFailure: &ExecutionFailure{
+				Error: "States.Timeout",
+			},
+		},
+		"returns nil if the execution did not fail": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							ExecutionSucceededEventDetails: &sfn.ExecutionSucceededEventDetails{},
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := StepFunctions{
+				client: m,
+			}
+
+			// WHEN
+			failure, err := client.ExecutionFailure(mockExecutionARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFailure, failure)
+		})
+	}
+}
@@ -4,6 +4,25 @@
 // Package tags implements simple functions to manipulate AWS resource tags.
 package tags
 
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tag constraints from https://docs.aws.amazon.com/general/latest/gr/aws_tagging.html#tag-conventions.
+const (
+	maxKeyLength      = 128
+	maxValueLength    = 256
+	awsReservedPrefix = "aws:"
+	pairSeparator     = "="
+)
+
+// allowedChars matches the letters, numbers, spaces and symbols that tag keys and values can contain.
+var allowedChars = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
 // Merge creates and returns a new map by adding tags in the order provided.
 func Merge(tags ...map[string]string) map[string]string {
 	merged := make(map[string]string)
@@ -14,3 +33,54 @@ func Merge(tags ...map[string]string) map[string]string {
 	}
 	return merged
 }
+
+// Parse returns the tags of "key=value" pairs. A pair is split on its first "=", so values can contain "=".
+// It returns an error naming the offending pair if a key is one of the reserved keys or starts with "aws:",
+// if a key or value doesn't satisfy the AWS tag constraints, or if two keys only differ by case.
+func Parse(pairs []string, reservedKeys ...string) (map[string]string, error) {
+	tags := make(map[string]string, len(pairs))
+	seen := make(map[string]string, len(pairs)) // Pairs keyed by the lower case of their key.
+	for _, pair := range pairs {
+		kv := strings.SplitN(pair, pairSeparator, 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("tag %q must be formatted as key=value", pair)
+		}
+		key, value := kv[0], kv[1]
+		if err := validate(key, value, reservedKeys); err != nil {
+			return nil, fmt.Errorf("tag %q: %w", pair, err)
+		}
+		if other, ok := seen[strings.ToLower(key)]; ok {
+			return nil, fmt.Errorf("tag %q: key %s is already set by tag %q", pair, key, other)
+		}
+		seen[strings.ToLower(key)] = pair
+		tags[key] = value
+	}
+	return tags, nil
+}
+
+func validate(key, value string, reservedKeys []string) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+	for _, reserved := range reservedKeys {
+		if strings.EqualFold(key, reserved) {
+			return fmt.Errorf("key %s is reserved", key)
+		}
+	}
+	if strings.HasPrefix(strings.ToLower(key), awsReservedPrefix) {
+		return fmt.Errorf("key %s cannot start with %s", key, awsReservedPrefix)
+	}
+	if n := utf8.RuneCountInString(key); n > maxKeyLength {
+		return fmt.Errorf("key must be at most %d characters, got %d", maxKeyLength, n)
+	}
+	if n := utf8.RuneCountInString(value); n > maxValueLength {
+		return fmt.Errorf("value must be at most %d characters, got %d", maxValueLength, n)
+	}
+	if !allowedChars.MatchString(key) {
+		return fmt.Errorf("key %s can only contain letters, numbers, spaces and the symbols _ . : / = + - @", key)
+	}
+	if !allowedChars.MatchString(value) {
+		return fmt.Errorf("value %s can only contain letters, numbers, spaces and the symbols _ . : / = + - @", value)
+	}
+	return nil
+}
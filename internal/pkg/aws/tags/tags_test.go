@@ -4,6 +4,8 @@
 package tags
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -42,3 +44,116 @@ func TestMerge(t *testing.T) {
 		"stage": "test",
 	}, envTags)
 }
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		inPairs        []string
+		inReservedKeys []string
+
+		wanted    map[string]string
+		wantedErr string
+	}{
+		"returns empty tags without pairs": {
+			wanted: map[string]string{},
+		},
+		"parses key value pairs": {
+			inPairs: []string{"team=payments", "source/revision=bb133e7", "cost center=1234"},
+			wanted: map[string]string{
+				"team":            "payments",
+				"source/revision": "bb133e7",
+				"cost center":     "1234",
+			},
+		},
+		"splits a pair on the first separator only": {
+			inPairs: []string{"query=a=b", "expr==x"},
+			wanted: map[string]string{
+				"query": "a=b",
+				"expr":  "=x",
+			},
+		},
+		"allows empty values": {
+			inPairs: []string{"team="},
+			wanted: map[string]string{
+				"team": "",
+			},
+		},
+		"allows unicode letters and every allowed symbol": {
+			inPairs: []string{"équipe=paiements", "a_b.c:d/e+f-g@h=1_2.3:4/5=6+7-8@9"},
+			wanted: map[string]string{
+				"équipe":          "paiements",
+				"a_b.c:d/e+f-g@h": "1_2.3:4/5=6+7-8@9",
+			},
+		},
+		"allows keys and values at the maximum length": {
+			inPairs: []string{strings.Repeat("k", 128) + "=" + strings.Repeat("v", 256)},
+			wanted: map[string]string{
+				strings.Repeat("k", 128): strings.Repeat("v", 256),
+			},
+		},
+		"counts the length in characters instead of bytes": {
+			inPairs: []string{strings.Repeat("é", 128) + "=" + strings.Repeat("é", 256)},
+			wanted: map[string]string{
+				strings.Repeat("é", 128): strings.Repeat("é", 256),
+			},
+		},
+		"errors if a pair has no separator": {
+			inPairs:   []string{"team=payments", "prod"},
+			wantedErr: `tag "prod" must be formatted as key=value`,
+		},
+		"errors if a key is empty": {
+			inPairs:   []string{"=payments"},
+			wantedErr: `tag "=payments": key cannot be empty`,
+		},
+		"errors if a key is reserved": {
+			inPairs:        []string{"team=payments", "copilot-service=api"},
+			inReservedKeys: []string{"copilot-application", "copilot-service"},
+			wantedErr:      `tag "copilot-service=api": key copilot-service is reserved`,
+		},
+		"errors if a key is reserved with a different case": {
+			inPairs:        []string{"Copilot-Application=other"},
+			inReservedKeys: []string{"copilot-application"},
+			wantedErr:      `tag "Copilot-Application=other": key Copilot-Application is reserved`,
+		},
+		"errors if a key starts with the aws prefix": {
+			inPairs:   []string{"AWS:cloudformation:stack-name=other"},
+			wantedErr: `tag "AWS:cloudformation:stack-name=other": key AWS:cloudformation:stack-name cannot start with aws:`,
+		},
+		"errors if a key is too long": {
+			inPairs:   []string{strings.Repeat("k", 129) + "=v"},
+			wantedErr: fmt.Sprintf(`tag "%s=v": key must be at most 128 characters, got 129`, strings.Repeat("k", 129)),
+		},
+		"errors if a value is too long": {
+			inPairs:   []string{"k=" + strings.Repeat("v", 257)},
+			wantedErr: fmt.Sprintf(`tag "k=%s": value must be at most 256 characters, got 257`, strings.Repeat("v", 257)),
+		},
+		"errors if a key has a character that isn't allowed": {
+			inPairs:   []string{"team#1=payments"},
+			wantedErr: `tag "team#1=payments": key team#1 can only contain letters, numbers, spaces and the symbols _ . : / = + - @`,
+		},
+		"errors if a value has a character that isn't allowed": {
+			inPairs:   []string{"team=pay*ments"},
+			wantedErr: `tag "team=pay*ments": value pay*ments can only contain letters, numbers, spaces and the symbols _ . : / = + - @`,
+		},
+		"errors if a key is set twice": {
+			inPairs:   []string{"team=payments", "team=orders"},
+			wantedErr: `tag "team=orders": key team is already set by tag "team=payments"`,
+		},
+		"errors if two keys only differ by case": {
+			inPairs:   []string{"Env=prod", "team=payments", "env=test"},
+			wantedErr: `tag "env=test": key env is already set by tag "Env=prod"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tc.inPairs, tc.inReservedKeys...)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
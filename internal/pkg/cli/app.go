@@ -23,7 +23,9 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppInitCommand())
 	cmd.AddCommand(buildAppListCommand())
 	cmd.AddCommand(buildAppShowCmd())
+	cmd.AddCommand(buildAppUpdateTagsCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
+	cmd.AddCommand(buildAppConsistencyCheckCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
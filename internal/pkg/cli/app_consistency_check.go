@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appConsistencyCheckNamePrompt     = "Which application would you like to check?"
+	appConsistencyCheckNameHelpPrompt = "The application, environment and service records of the application will be cross-validated."
+
+	fmtAppConsistencyCheckFoundNone = "No inconsistencies found in application %s.\n"
+	fmtAppConsistencyCheckFound     = "Found %d inconsistencies in application %s:\n"
+	fmtAppConsistencyCheckFixed     = "Fixed %s.\n"
+	fmtAppConsistencyCheckUnfixable = "Can't fix %s automatically, please inspect the parameter in the SSM console.\n"
+)
+
+type appConsistencyCheckVars struct {
+	name      string
+	shouldFix bool
+}
+
+type appConsistencyCheckOpts struct {
+	appConsistencyCheckVars
+
+	checker appConsistencyChecker
+	sel     appSelector
+
+	inconsistencies []*config.Inconsistency
+}
+
+func newAppConsistencyCheckOpts(vars appConsistencyCheckVars) (*appConsistencyCheckOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &appConsistencyCheckOpts{
+		appConsistencyCheckVars: vars,
+		checker:                 store,
+		sel:                     selector.NewSelect(prompt.New(), store),
+	}, nil
+}
+
+// Validate is a no-op for this command.
+// The application record is allowed to be missing as it's one of the inconsistencies the command repairs.
+func (o *appConsistencyCheckOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for the application name if it's not provided.
+func (o *appConsistencyCheckOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appConsistencyCheckNamePrompt, appConsistencyCheckNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute reports the inconsistent records of the application, and rewrites them if requested.
+func (o *appConsistencyCheckOpts) Execute() error {
+	incs, err := o.checker.CheckConsistency(o.name)
+	if err != nil {
+		return fmt.Errorf("check consistency of application %s: %w", o.name, err)
+	}
+	o.inconsistencies = incs
+	if len(incs) == 0 {
+		log.Successf(fmtAppConsistencyCheckFoundNone, color.HighlightUserInput(o.name))
+		return nil
+	}
+	log.Warningf(fmtAppConsistencyCheckFound, len(incs), color.HighlightUserInput(o.name))
+	for _, inc := range incs {
+		log.Infof("  - %s\n", inc)
+	}
+	if !o.shouldFix {
+		return nil
+	}
+	for _, inc := range incs {
+		if !inc.Fixable() {
+			log.Warningf(fmtAppConsistencyCheckUnfixable, color.HighlightResource(inc.Parameter))
+			continue
+		}
+		if err := o.checker.FixInconsistency(inc); err != nil {
+			return fmt.Errorf("fix %s: %w", inc.Parameter, err)
+		}
+		log.Successf(fmtAppConsistencyCheckFixed, color.HighlightResource(inc.Parameter))
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *appConsistencyCheckOpts) RecommendedActions() []string {
+	if len(o.inconsistencies) == 0 || o.shouldFix {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Run %s to repair the inconsistent records.",
+			color.HighlightCode(fmt.Sprintf("copilot app consistency-check -n %s --%s", o.name, fixFlag))),
+	}
+}
+
+// buildAppConsistencyCheckCmd builds the command for cross-validating the records of an application.
+func buildAppConsistencyCheckCmd() *cobra.Command {
+	vars := appConsistencyCheckVars{}
+	cmd := &cobra.Command{
+		Use:   "consistency-check",
+		Short: "Checks that the records of an application are consistent.",
+		Long: `Checks that the records of an application are consistent.
+Cross-validates the application, environment, and workload parameters of an application
+and reports orphaned, mismatched, or unreadable records.`,
+		Example: `
+  Reports inconsistent records in the application "my-app".
+  /code $ copilot app consistency-check -n my-app
+  Rewrites the inconsistent records of "my-app".
+  /code $ copilot app consistency-check -n my-app --fix`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppConsistencyCheckOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			if actions := opts.RecommendedActions(); len(actions) > 0 {
+				log.Infoln()
+				log.Infoln("Recommended follow-up actions:")
+				for _, followup := range actions {
+					log.Infof("- %s\n", followup)
+				}
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldFix, fixFlag, false, fixFlagDescription)
+	return cmd
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppConsistencyCheckOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName      string
+		mockSel     func(m *mocks.MockappSelector)
+		wantedName  string
+		wantedError error
+	}{
+		"skips prompting if the name is provided": {
+			inName:     "my-app",
+			mockSel:    func(m *mocks.MockappSelector) {},
+			wantedName: "my-app",
+		},
+		"prompts for the application": {
+			mockSel: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appConsistencyCheckNamePrompt, appConsistencyCheckNameHelpPrompt).Return("my-app", nil)
+			},
+			wantedName: "my-app",
+		},
+		"wraps the selector error": {
+			mockSel: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockappSelector(ctrl)
+			tc.mockSel(mockSel)
+			opts := &appConsistencyCheckOpts{
+				appConsistencyCheckVars: appConsistencyCheckVars{
+					name: tc.inName,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestAppConsistencyCheckOpts_Execute(t *testing.T) {
+	fixable := &config.Inconsistency{Kind: config.MissingApplication, Parameter: "/copilot/applications/my-app"}
+	unfixable := &config.Inconsistency{Kind: config.UnreadableRecord, Parameter: "/copilot/applications/my-app/components/fe"}
+
+	testCases := map[string]struct {
+		inFix       bool
+		mockChecker func(m *mocks.MockappConsistencyChecker)
+
+		wantedActions int
+		wantedError   error
+	}{
+		"wraps the error from checking the application": {
+			mockChecker: func(m *mocks.MockappConsistencyChecker) {
+				m.EXPECT().CheckConsistency("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("check consistency of application my-app: some error"),
+		},
+		"reports inconsistencies without fixing them": {
+			mockChecker: func(m *mocks.MockappConsistencyChecker) {
+				m.EXPECT().CheckConsistency("my-app").Return([]*config.Inconsistency{fixable}, nil)
+				m.EXPECT().FixInconsistency(gomock.Any()).Times(0)
+			},
+			wantedActions: 1,
+		},
+		"does nothing if the application is consistent": {
+			inFix: true,
+			mockChecker: func(m *mocks.MockappConsistencyChecker) {
+				m.EXPECT().CheckConsistency("my-app").Return(nil, nil)
+				m.EXPECT().FixInconsistency(gomock.Any()).Times(0)
+			},
+		},
+		"fixes only the fixable inconsistencies": {
+			inFix: true,
+			mockChecker: func(m *mocks.MockappConsistencyChecker) {
+				m.EXPECT().CheckConsistency("my-app").Return([]*config.Inconsistency{unfixable, fixable}, nil)
+				m.EXPECT().FixInconsistency(fixable).Return(nil)
+			},
+		},
+		"wraps the error from fixing an inconsistency": {
+			inFix: true,
+			mockChecker: func(m *mocks.MockappConsistencyChecker) {
+				m.EXPECT().CheckConsistency("my-app").Return([]*config.Inconsistency{fixable}, nil)
+				m.EXPECT().FixInconsistency(fixable).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("fix /copilot/applications/my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockChecker := mocks.NewMockappConsistencyChecker(ctrl)
+			tc.mockChecker(mockChecker)
+			opts := &appConsistencyCheckOpts{
+				appConsistencyCheckVars: appConsistencyCheckVars{
+					name:      "my-app",
+					shouldFix: tc.inFix,
+				},
+				checker: mockChecker,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, opts.RecommendedActions(), tc.wantedActions)
+			}
+		})
+	}
+}
@@ -4,14 +4,24 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -35,6 +45,8 @@ const (
 
 	fmtDeleteAppWsStartMsg = "Deleting local %s file."
 	fmtDeleteAppWsStopMsg  = "Deleted local %s file.\n"
+
+	fmtDeleteAppItemMsg = "Deleting %s %s (%d/%d).\n"
 )
 
 var (
@@ -44,6 +56,8 @@ var (
 type deleteAppVars struct {
 	name             string
 	skipConfirmation bool
+	dryRun           bool
+	shouldOutputJSON bool
 }
 
 type deleteAppOpts struct {
@@ -54,12 +68,45 @@ type deleteAppOpts struct {
 	ws                   wsFileDeleter
 	sessProvider         sessionProvider
 	cfn                  deployer
+	pipelineLister       pipelineNamesLister
 	prompt               prompter
 	s3                   func(session *session.Session) bucketEmptier
+	ecr                  func(session *session.Session) imageLister
 	svcDeleteExecutor    func(svcName string) (executor, error)
 	jobDeleteExecutor    func(jobName string) (executor, error)
 	envDeleteExecutor    func(envName string) (executeAsker, error)
 	deletePipelineRunner func() (deletePipelineRunner, error)
+
+	w io.Writer
+}
+
+// deleteAppInventory lists the resources that are deleted along with an application.
+type deleteAppInventory struct {
+	Application       string                        `json:"application"`
+	Environments      []*deleteAppEnvironment       `json:"environments"`
+	Services          []string                      `json:"services"`
+	Jobs              []string                      `json:"jobs"`
+	Pipelines         []string                      `json:"pipelines"`
+	RegionalResources []*deleteAppRegionalResources `json:"regionalResources"`
+}
+
+type deleteAppEnvironment struct {
+	Name      string `json:"name"`
+	Region    string `json:"region"`
+	AccountID string `json:"accountID"`
+}
+
+// deleteAppRegionalResources holds the resources created by the application stack set in a region.
+type deleteAppRegionalResources struct {
+	Region       string                 `json:"region"`
+	S3Bucket     string                 `json:"bucket"`
+	Repositories []*deleteAppRepository `json:"repositories"`
+}
+
+type deleteAppRepository struct {
+	Name       string `json:"name"`
+	URI        string `json:"uri"`
+	ImageCount int    `json:"imageCount"`
 }
 
 func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
@@ -80,16 +127,20 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 	}
 
 	return &deleteAppOpts{
-		deleteAppVars: vars,
-		spinner:       termprogress.NewSpinner(),
-		store:         store,
-		ws:            ws,
-		sessProvider:  provider,
-		cfn:           cloudformation.New(defaultSession),
-		prompt:        prompt.New(),
+		deleteAppVars:  vars,
+		spinner:        termprogress.NewSpinner(),
+		store:          store,
+		ws:             ws,
+		sessProvider:   provider,
+		cfn:            cloudformation.New(defaultSession),
+		pipelineLister: codepipeline.New(defaultSession),
+		prompt:         prompt.New(),
 		s3: func(session *session.Session) bucketEmptier {
 			return s3.New(session)
 		},
+		ecr: func(session *session.Session) imageLister {
+			return ecr.New(session)
+		},
 		svcDeleteExecutor: func(svcName string) (executor, error) {
 			opts, err := newDeleteSvcOpts(deleteSvcVars{
 				skipConfirmation: true, // always skip sub-confirmations
@@ -134,6 +185,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 			}
 			return opts, nil
 		},
+		w: os.Stdout,
 	}, nil
 }
 
@@ -142,15 +194,24 @@ func (o *deleteAppOpts) Validate() error {
 	if o.name == "" {
 		return errNoAppInWorkspace
 	}
+	if o.shouldOutputJSON && !o.dryRun {
+		return fmt.Errorf("--%s can only be used with --%s", jsonFlag, dryRunFlag)
+	}
 	return nil
 }
 
-// Ask prompts the user for any required flags that they didn't provide.
+// Ask lists the resources that will be deleted and prompts the user to confirm the deletion.
 func (o *deleteAppOpts) Ask() error {
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
+	inventory, err := o.inventory()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, inventory.humanString())
+
 	manualConfirm, err := o.prompt.Confirm(
 		fmt.Sprintf(fmtDeleteAppConfirmPrompt, o.name),
 		deleteAppConfirmHelp,
@@ -167,7 +228,21 @@ func (o *deleteAppOpts) Ask() error {
 // Execute deletes the application.
 // It removes all the services from each environment, the environments, the pipeline S3 buckets,
 // the pipeline, the application, removes the variables from the config store, and deletes the local workspace.
+// With --dry-run, it only lists these resources.
+// Resources that were already deleted, for example by a previous failed run, are skipped.
 func (o *deleteAppOpts) Execute() error {
+	if o.dryRun {
+		return o.writeInventory()
+	}
+	if o.skipConfirmation {
+		// The inventory wasn't listed while asking for confirmation, list it before deleting anything.
+		inventory, err := o.inventory()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, inventory.humanString())
+	}
+
 	if err := o.deleteSvcs(); err != nil {
 		return err
 	}
@@ -213,7 +288,8 @@ func (o *deleteAppOpts) deleteSvcs() error {
 		return fmt.Errorf("list services for application %s: %w", o.name, err)
 	}
 
-	for _, svc := range svcs {
+	for i, svc := range svcs {
+		log.Infof(fmtDeleteAppItemMsg, "service", svc.Name, i+1, len(svcs))
 		cmd, err := o.svcDeleteExecutor(svc.Name)
 		if err != nil {
 			return err
@@ -231,7 +307,8 @@ func (o *deleteAppOpts) deleteJobs() error {
 		return fmt.Errorf("list jobs for application %s: %w", o.name, err)
 	}
 
-	for _, job := range jobs {
+	for i, job := range jobs {
+		log.Infof(fmtDeleteAppItemMsg, "job", job.Name, i+1, len(jobs))
 		cmd, err := o.jobDeleteExecutor(job.Name)
 		if err != nil {
 			return err
@@ -249,7 +326,8 @@ func (o *deleteAppOpts) deleteEnvs() error {
 		return fmt.Errorf("list environments for application %s: %w", o.name, err)
 	}
 
-	for _, env := range envs {
+	for i, env := range envs {
+		log.Infof(fmtDeleteAppItemMsg, "environment", env.Name, i+1, len(envs))
 		cmd, err := o.envDeleteExecutor(env.Name)
 		if err != nil {
 			return err
@@ -267,6 +345,11 @@ func (o *deleteAppOpts) deleteEnvs() error {
 func (o *deleteAppOpts) emptyS3Bucket() error {
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
+		var errNoSuchApp *config.ErrNoSuchApplication
+		if errors.As(err, &errNoSuchApp) {
+			// The application configuration was already deleted, so were its regional resources.
+			return nil
+		}
 		return fmt.Errorf("get application %s: %w", o.name, err)
 	}
 	appResources, err := o.cfn.GetRegionalAppResources(app)
@@ -321,7 +404,7 @@ func (o *deleteAppOpts) deleteAppConfigs() error {
 
 func (o *deleteAppOpts) deleteWs() error {
 	o.spinner.Start(fmt.Sprintf(fmtDeleteAppWsStartMsg, workspace.SummaryFileName))
-	if err := o.ws.DeleteWorkspaceFile(); err != nil {
+	if err := o.ws.DeleteWorkspaceFile(); err != nil && !errors.Is(err, os.ErrNotExist) {
 		o.spinner.Stop(log.Serrorf("Error deleting %s file.\n", workspace.SummaryFileName))
 		return fmt.Errorf("delete %s file: %w", workspace.SummaryFileName, err)
 	}
@@ -329,15 +412,184 @@ func (o *deleteAppOpts) deleteWs() error {
 	return nil
 }
 
+// inventory lists the environments, workloads, pipelines and regional resources of the application.
+func (o *deleteAppOpts) inventory() (*deleteAppInventory, error) {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments for application %s: %w", o.name, err)
+	}
+	svcs, err := o.store.ListServices(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list services for application %s: %w", o.name, err)
+	}
+	jobs, err := o.store.ListJobs(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs for application %s: %w", o.name, err)
+	}
+	pipelines, err := o.pipelineLister.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: o.name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines for application %s: %w", o.name, err)
+	}
+	regionalResources, err := o.regionalResources()
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &deleteAppInventory{
+		Application:       o.name,
+		Environments:      []*deleteAppEnvironment{},
+		Services:          []string{},
+		Jobs:              []string{},
+		Pipelines:         []string{},
+		RegionalResources: regionalResources,
+	}
+	for _, env := range envs {
+		inventory.Environments = append(inventory.Environments, &deleteAppEnvironment{
+			Name:      env.Name,
+			Region:    env.Region,
+			AccountID: env.AccountID,
+		})
+	}
+	for _, svc := range svcs {
+		inventory.Services = append(inventory.Services, svc.Name)
+	}
+	for _, job := range jobs {
+		inventory.Jobs = append(inventory.Jobs, job.Name)
+	}
+	inventory.Pipelines = append(inventory.Pipelines, pipelines...)
+	return inventory, nil
+}
+
+// regionalResources lists the S3 buckets and ECR repositories, with their number of images, created by the application stack set.
+func (o *deleteAppOpts) regionalResources() ([]*deleteAppRegionalResources, error) {
+	resources := []*deleteAppRegionalResources{}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		var errNoSuchApp *config.ErrNoSuchApplication
+		if errors.As(err, &errNoSuchApp) {
+			return resources, nil
+		}
+		return nil, fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	appResources, err := o.cfn.GetRegionalAppResources(app)
+	if err != nil {
+		return nil, fmt.Errorf("get regional application resources for %s: %w", app.Name, err)
+	}
+	for _, appResource := range appResources {
+		sess, err := o.sessProvider.DefaultWithRegion(appResource.Region)
+		if err != nil {
+			return nil, fmt.Errorf("default session with region %s: %w", appResource.Region, err)
+		}
+		ecrClient := o.ecr(sess)
+		resource := &deleteAppRegionalResources{
+			Region:       appResource.Region,
+			S3Bucket:     appResource.S3Bucket,
+			Repositories: []*deleteAppRepository{},
+		}
+		var workloads []string
+		for workload := range appResource.RepositoryURLs {
+			workloads = append(workloads, workload)
+		}
+		sort.Strings(workloads)
+		for _, workload := range workloads {
+			repoName := fmt.Sprintf("%s/%s", o.name, workload)
+			images, err := ecrClient.ListImages(repoName)
+			if err != nil {
+				return nil, fmt.Errorf("list images in repository %s: %w", repoName, err)
+			}
+			resource.Repositories = append(resource.Repositories, &deleteAppRepository{
+				Name:       repoName,
+				URI:        appResource.RepositoryURLs[workload],
+				ImageCount: len(images),
+			})
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func (o *deleteAppOpts) writeInventory() error {
+	inventory, err := o.inventory()
+	if err != nil {
+		return err
+	}
+	if !o.shouldOutputJSON {
+		fmt.Fprint(o.w, inventory.humanString())
+		return nil
+	}
+	data, err := inventory.jsonString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
+func (i *deleteAppInventory) humanString() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "The following resources of application %s will be deleted:\n\n", color.HighlightUserInput(i.Application))
+	writer := tabwriter.NewWriter(b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Environments\n\n"))
+	if len(i.Environments) == 0 {
+		fmt.Fprintln(writer, "  None")
+	} else {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Name", "Region", "Account ID")
+		for _, env := range i.Environments {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", env.Name, env.Region, env.AccountID)
+		}
+	}
+	writeNames := func(title string, names []string) {
+		fmt.Fprint(writer, color.Bold.Sprintf("\n%s\n\n", title))
+		if len(names) == 0 {
+			fmt.Fprintln(writer, "  None")
+		}
+		for _, name := range names {
+			fmt.Fprintf(writer, "  %s\n", name)
+		}
+	}
+	writeNames("Services", i.Services)
+	writeNames("Jobs", i.Jobs)
+	writeNames("Pipelines", i.Pipelines)
+	fmt.Fprint(writer, color.Bold.Sprint("\nRegional Resources\n\n"))
+	if len(i.RegionalResources) == 0 {
+		fmt.Fprintln(writer, "  None")
+	} else {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Region", "Type", "Name", "Images")
+		for _, resource := range i.RegionalResources {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", resource.Region, "S3 bucket", resource.S3Bucket, "-")
+			for _, repo := range resource.Repositories {
+				fmt.Fprintf(writer, "  %s\t%s\t%s\t%d\n", resource.Region, "ECR repository", repo.Name, repo.ImageCount)
+			}
+		}
+	}
+	writer.Flush()
+	fmt.Fprintln(b)
+	return b.String()
+}
+
+func (i *deleteAppInventory) jsonString() (string, error) {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return "", fmt.Errorf("marshal inventory of application %s: %w", i.Application, err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
 // buildAppDeleteCommand builds the `app delete` subcommand.
 func buildAppDeleteCommand() *cobra.Command {
 	vars := deleteAppVars{}
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete all resources associated with the application.",
+		Long: `Delete all resources associated with the application.
+Lists the environments, services, jobs, pipelines and regional resources that will be deleted before deleting them.`,
 		Example: `
   Force delete the application with environments "test" and "prod".
-  /code $ copilot app delete --yes`,
+  /code $ copilot app delete --yes
+  List the resources that would be deleted in JSON format without deleting them.
+  /code $ copilot app delete --dry-run --json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteAppOpts(vars)
 			if err != nil {
@@ -356,5 +608,7 @@ func buildAppDeleteCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, appDeleteDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	return cmd
 }
@@ -4,11 +4,14 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -23,7 +26,9 @@ import (
 func TestDeleteAppOpts_Validate(t *testing.T) {
 	const mockAppName = "phonetool"
 	tests := map[string]struct {
-		name string
+		name             string
+		dryRun           bool
+		shouldOutputJSON bool
 
 		want error
 	}{
@@ -35,44 +40,94 @@ func TestDeleteAppOpts_Validate(t *testing.T) {
 			name: mockAppName,
 			want: nil,
 		},
+		"should return error if --json is used without --dry-run": {
+			name:             mockAppName,
+			shouldOutputJSON: true,
+			want:             errors.New("--json can only be used with --dry-run"),
+		},
+		"should return nil if --json is used with --dry-run": {
+			name:             mockAppName,
+			dryRun:           true,
+			shouldOutputJSON: true,
+			want:             nil,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			opts := deleteAppOpts{
 				deleteAppVars: deleteAppVars{
-					name: test.name,
+					name:             test.name,
+					dryRun:           test.dryRun,
+					shouldOutputJSON: test.shouldOutputJSON,
 				},
 			}
 
 			got := opts.Validate()
 
-			require.Equal(t, test.want, got)
+			if test.want != nil {
+				require.EqualError(t, got, test.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
 		})
 	}
 }
 
+type deleteAppInventoryMocks struct {
+	store          *mocks.Mockstore
+	deployer       *mocks.Mockdeployer
+	pipelineLister *mocks.MockpipelineNamesLister
+	imageLister    *mocks.MockimageLister
+}
+
 func TestDeleteAppOpts_Ask(t *testing.T) {
 	const mockAppName = "phonetool"
-	var mockPrompter *mocks.Mockprompter
 	mockError := errors.New("some error")
+	mockApp := &config.Application{
+		Name: mockAppName,
+	}
+	expectInventory := func(m deleteAppInventoryMocks) {
+		m.store.EXPECT().ListEnvironments(mockAppName).Return([]*config.Environment{
+			{Name: "test", Region: "us-west-2", AccountID: "1111"},
+		}, nil)
+		m.store.EXPECT().ListServices(mockAppName).Return([]*config.Workload{{Name: "frontend"}}, nil)
+		m.store.EXPECT().ListJobs(mockAppName).Return(nil, nil)
+		m.pipelineLister.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return(nil, nil)
+		m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+		m.deployer.EXPECT().GetRegionalAppResources(mockApp).Return(nil, nil)
+	}
 	tests := map[string]struct {
 		skipConfirmation bool
+		dryRun           bool
 
-		setupMocks func(ctrl *gomock.Controller)
+		setupMocks func(m deleteAppInventoryMocks, p *mocks.Mockprompter)
 
-		want error
+		wantedOutput string
+		want         error
 	}{
 		"return nil if skipConfirmation is enabled": {
 			skipConfirmation: true,
-			setupMocks:       func(ctrl *gomock.Controller) {},
+			setupMocks:       func(m deleteAppInventoryMocks, p *mocks.Mockprompter) {},
 			want:             nil,
 		},
+		"return nil without listing the resources on a dry run": {
+			dryRun:     true,
+			setupMocks: func(m deleteAppInventoryMocks, p *mocks.Mockprompter) {},
+			want:       nil,
+		},
+		"return error if fail to list the resources": {
+			skipConfirmation: false,
+			setupMocks: func(m deleteAppInventoryMocks, p *mocks.Mockprompter) {
+				m.store.EXPECT().ListEnvironments(mockAppName).Return(nil, mockError)
+			},
+			want: fmt.Errorf("list environments for application phonetool: %w", mockError),
+		},
 		"wrap error returned from prompting": {
 			skipConfirmation: false,
-			setupMocks: func(ctrl *gomock.Controller) {
-				mockPrompter = mocks.NewMockprompter(ctrl)
-				mockPrompter.EXPECT().
+			setupMocks: func(m deleteAppInventoryMocks, p *mocks.Mockprompter) {
+				expectInventory(m)
+				p.EXPECT().
 					Confirm(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
 						deleteAppConfirmHelp,
 						gomock.Any()).
@@ -81,9 +136,9 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 			want: fmt.Errorf("confirm app deletion: %w", mockError),
 		},
 		"return error if user cancels operation": {skipConfirmation: false,
-			setupMocks: func(ctrl *gomock.Controller) {
-				mockPrompter = mocks.NewMockprompter(ctrl)
-				mockPrompter.EXPECT().
+			setupMocks: func(m deleteAppInventoryMocks, p *mocks.Mockprompter) {
+				expectInventory(m)
+				p.EXPECT().
 					Confirm(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
 						deleteAppConfirmHelp,
 						gomock.Any()).
@@ -93,15 +148,16 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 		},
 		"return nil if user confirms": {
 			skipConfirmation: false,
-			setupMocks: func(ctrl *gomock.Controller) {
-				mockPrompter = mocks.NewMockprompter(ctrl)
-				mockPrompter.EXPECT().
+			setupMocks: func(m deleteAppInventoryMocks, p *mocks.Mockprompter) {
+				expectInventory(m)
+				p.EXPECT().
 					Confirm(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
 						deleteAppConfirmHelp,
 						gomock.Any()).
 					Return(true, nil)
 			},
-			want: nil,
+			wantedOutput: "frontend",
+			want:         nil,
 		},
 	}
 
@@ -109,18 +165,146 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
-			test.setupMocks(ctrl)
+			m := deleteAppInventoryMocks{
+				store:          mocks.NewMockstore(ctrl),
+				deployer:       mocks.NewMockdeployer(ctrl),
+				pipelineLister: mocks.NewMockpipelineNamesLister(ctrl),
+			}
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			test.setupMocks(m, mockPrompter)
+			b := &bytes.Buffer{}
 			opts := deleteAppOpts{
 				deleteAppVars: deleteAppVars{
 					name:             mockAppName,
 					skipConfirmation: test.skipConfirmation,
+					dryRun:           test.dryRun,
 				},
-				prompt: mockPrompter,
+				store:          m.store,
+				cfn:            m.deployer,
+				pipelineLister: m.pipelineLister,
+				prompt:         mockPrompter,
+				w:              b,
 			}
 
 			got := opts.Ask()
 
 			require.Equal(t, test.want, got)
+			require.Contains(t, b.String(), test.wantedOutput)
+		})
+	}
+}
+
+func TestDeleteAppOpts_Execute_DryRun(t *testing.T) {
+	const mockAppName = "phonetool"
+	mockApp := &config.Application{
+		Name: mockAppName,
+	}
+	setupMocks := func(m deleteAppInventoryMocks) {
+		m.store.EXPECT().ListEnvironments(mockAppName).Return([]*config.Environment{
+			{Name: "test", Region: "us-west-2", AccountID: "1111"},
+			{Name: "prod", Region: "us-east-1", AccountID: "2222"},
+		}, nil)
+		m.store.EXPECT().ListServices(mockAppName).Return([]*config.Workload{{Name: "frontend"}, {Name: "backend"}}, nil)
+		m.store.EXPECT().ListJobs(mockAppName).Return([]*config.Workload{{Name: "mailer"}}, nil)
+		m.pipelineLister.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).
+			Return([]string{"pipeline-phonetool-repo"}, nil)
+		m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+		m.deployer.EXPECT().GetRegionalAppResources(mockApp).Return([]*stack.AppRegionalResources{
+			{
+				Region:   "us-west-2",
+				S3Bucket: "phonetool-bucket",
+				RepositoryURLs: map[string]string{
+					"frontend": "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+					"backend":  "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/backend",
+				},
+			},
+		}, nil)
+		gomock.InOrder(
+			m.imageLister.EXPECT().ListImages("phonetool/backend").Return(nil, nil),
+			m.imageLister.EXPECT().ListImages("phonetool/frontend").Return([]ecr.Image{{Digest: "sha256:1"}, {Digest: "sha256:2"}}, nil),
+		)
+	}
+	tests := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m deleteAppInventoryMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"writes the inventory in JSON without deleting anything": {
+			shouldOutputJSON: true,
+			setupMocks:       setupMocks,
+
+			wantedContent: `{"application":"phonetool","environments":[{"name":"test","region":"us-west-2","accountID":"1111"},{"name":"prod","region":"us-east-1","accountID":"2222"}],"services":["frontend","backend"],"jobs":["mailer"],"pipelines":["pipeline-phonetool-repo"],"regionalResources":[{"region":"us-west-2","bucket":"phonetool-bucket","repositories":[{"name":"phonetool/backend","uri":"1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/backend","imageCount":0},{"name":"phonetool/frontend","uri":"1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend","imageCount":2}]}]}` + "\n",
+		},
+		"lists the regional resources as empty if the application configuration was already deleted": {
+			shouldOutputJSON: true,
+			setupMocks: func(m deleteAppInventoryMocks) {
+				m.store.EXPECT().ListEnvironments(mockAppName).Return(nil, nil)
+				m.store.EXPECT().ListServices(mockAppName).Return(nil, nil)
+				m.store.EXPECT().ListJobs(mockAppName).Return(nil, nil)
+				m.pipelineLister.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil)
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, &config.ErrNoSuchApplication{ApplicationName: mockAppName})
+			},
+
+			wantedContent: `{"application":"phonetool","environments":[],"services":[],"jobs":[],"pipelines":[],"regionalResources":[]}` + "\n",
+		},
+		"wraps the error if fail to list images": {
+			setupMocks: func(m deleteAppInventoryMocks) {
+				m.store.EXPECT().ListEnvironments(mockAppName).Return(nil, nil)
+				m.store.EXPECT().ListServices(mockAppName).Return(nil, nil)
+				m.store.EXPECT().ListJobs(mockAppName).Return(nil, nil)
+				m.pipelineLister.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil)
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+				m.deployer.EXPECT().GetRegionalAppResources(mockApp).Return([]*stack.AppRegionalResources{
+					{
+						Region:         "us-west-2",
+						RepositoryURLs: map[string]string{"frontend": "uri"},
+					},
+				}, nil)
+				m.imageLister.EXPECT().ListImages("phonetool/frontend").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list images in repository phonetool/frontend: some error"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deleteAppInventoryMocks{
+				store:          mocks.NewMockstore(ctrl),
+				deployer:       mocks.NewMockdeployer(ctrl),
+				pipelineLister: mocks.NewMockpipelineNamesLister(ctrl),
+				imageLister:    mocks.NewMockimageLister(ctrl),
+			}
+			test.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := deleteAppOpts{
+				deleteAppVars: deleteAppVars{
+					name:             mockAppName,
+					dryRun:           true,
+					shouldOutputJSON: test.shouldOutputJSON,
+				},
+				store:          m.store,
+				cfn:            m.deployer,
+				pipelineLister: m.pipelineLister,
+				sessProvider:   sessions.NewProvider(),
+				ecr: func(session *session.Session) imageLister {
+					return m.imageLister
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.wantedContent, b.String())
 		})
 	}
 }
@@ -260,6 +444,43 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"skips the resources that were already deleted": {
+			appName: mockAppName,
+			setupMocks: func(mocks deleteAppMocks) {
+				gomock.InOrder(
+					// deleteSvcs
+					mocks.store.EXPECT().ListServices(mockAppName).Return(nil, nil),
+
+					// deleteJobs
+					mocks.store.EXPECT().ListJobs(mockAppName).Return(nil, nil),
+
+					// deleteEnvs
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(nil, nil),
+
+					// emptyS3bucket
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(nil, &config.ErrNoSuchApplication{ApplicationName: mockAppName}),
+
+					// delete pipeline
+					mocks.pipelineDeleter.EXPECT().Run().Return(workspace.ErrNoPipelineInWorkspace),
+
+					// deleteAppResources
+					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
+					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppResourcesStopMsg)),
+
+					// deleteAppConfigs
+					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
+					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppConfigStopMsg)),
+
+					// deleteWs
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtDeleteAppWsStartMsg, workspace.SummaryFileName)),
+					mocks.ws.EXPECT().DeleteWorkspaceFile().Return(&os.PathError{Op: "remove", Path: ".workspace", Err: os.ErrNotExist}),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(fmt.Sprintf(fmtDeleteAppWsStopMsg, workspace.SummaryFileName))),
+				)
+			},
+			wantedError: nil,
+		},
 	}
 
 	for name, test := range tests {
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
@@ -32,10 +33,13 @@ const (
 	appInitNameHelpPrompt = "Services and jobs in the same application share the same VPC and ECS Cluster and services are discoverable via service discovery."
 )
 
+const iamServiceName = "iam"
+
 type initAppVars struct {
-	name         string
-	domainName   string
-	resourceTags map[string]string
+	name                string
+	domainName          string
+	resourceTags        map[string]string
+	permissionsBoundary string
 }
 
 type initAppOpts struct {
@@ -45,6 +49,7 @@ type initAppOpts struct {
 	store    applicationStore
 	route53  domainValidator
 	ws       wsAppManager
+	git      gitStatusReader
 	cfn      appDeployer
 	prompt   prompter
 	prog     progress
@@ -70,6 +75,7 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 		store:       store,
 		route53:     route53.New(sess),
 		ws:          ws,
+		git:         newGitRepo(),
 		cfn:         cloudformation.New(sess),
 		prompt:      prompt.New(),
 		prog:        termprogress.NewSpinner(),
@@ -91,6 +97,11 @@ func (o *initAppOpts) Validate() error {
 			return err
 		}
 	}
+	if o.permissionsBoundary != "" {
+		if err := validateIAMPolicyARN(o.permissionsBoundary); err != nil {
+			return fmt.Errorf("permissions boundary %s is invalid: %w", o.permissionsBoundary, err)
+		}
+	}
 	return nil
 }
 
@@ -163,12 +174,14 @@ func (o *initAppOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("create new workspace with application name %s: %w", o.name, err)
 	}
+	o.addGitIgnorePatterns()
 	o.prog.Start(fmt.Sprintf(fmtAppInitStart, color.HighlightUserInput(o.name)))
 	err = o.cfn.DeployApp(&deploy.CreateAppInput{
-		Name:           o.name,
-		AccountID:      caller.Account,
-		DomainName:     o.domainName,
-		AdditionalTags: o.resourceTags,
+		Name:                o.name,
+		AccountID:           caller.Account,
+		DomainName:          o.domainName,
+		PermissionsBoundary: o.permissionsBoundary,
+		AdditionalTags:      o.resourceTags,
 	})
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.name)))
@@ -176,12 +189,53 @@ func (o *initAppOpts) Execute() error {
 	}
 	o.prog.Stop(log.Ssuccessf(fmtAppInitComplete, color.HighlightUserInput(o.name)))
 
-	return o.store.CreateApplication(&config.Application{
-		AccountID: caller.Account,
-		Name:      o.name,
-		Domain:    o.domainName,
-		Tags:      o.resourceTags,
-	})
+	if err := o.store.CreateApplication(&config.Application{
+		AccountID:           caller.Account,
+		Name:                o.name,
+		Domain:              o.domainName,
+		Tags:                o.resourceTags,
+		PermissionsBoundary: o.permissionsBoundary,
+	}); err != nil {
+		return err
+	}
+	return o.recordPermissionsBoundary()
+}
+
+// recordPermissionsBoundary stores the permissions boundary of an application that already existed without one,
+// so that its environments pick it up on upgrade and its services and jobs on their next deployment.
+func (o *initAppOpts) recordPermissionsBoundary() error {
+	if o.permissionsBoundary == "" {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if app.PermissionsBoundary == o.permissionsBoundary {
+		return nil
+	}
+	app.PermissionsBoundary = o.permissionsBoundary
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update permissions boundary of application %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// addGitIgnorePatterns adds the copilot files that shouldn't be committed to the .gitignore file of the git repository.
+// Failing to update the file doesn't fail the command.
+func (o *initAppOpts) addGitIgnorePatterns() {
+	if !o.git.IsRepository() {
+		return
+	}
+	added, err := o.ws.AddGitIgnorePatterns()
+	if err != nil {
+		log.Warningf("Couldn't add the copilot files that shouldn't be committed to .gitignore: %v\n", err)
+		return
+	}
+	if len(added) == 0 {
+		return
+	}
+	log.Successf("Added %s to .gitignore.\n", color.HighlightResource(strings.Join(added, ", ")))
 }
 
 func (o *initAppOpts) validateAppName(name string) error {
@@ -199,6 +253,10 @@ func (o *initAppOpts) validateAppName(name string) error {
 	if o.domainName != "" && app.Domain != o.domainName {
 		return fmt.Errorf("application named %s already exists with a different domain name %s", name, app.Domain)
 	}
+	// An application created without a permissions boundary can adopt one, but it can't be swapped for another.
+	if o.permissionsBoundary != "" && app.PermissionsBoundary != "" && app.PermissionsBoundary != o.permissionsBoundary {
+		return fmt.Errorf("application named %s already exists with a different permissions boundary %s", name, app.PermissionsBoundary)
+	}
 	return nil
 }
 
@@ -264,7 +322,9 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose roles are bounded by an IAM policy.
+  /code $ copilot app init --permissions-boundary arn:aws:iam::123456789012:policy/MyBoundary`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -293,6 +353,7 @@ An application is a collection of containerized services that operate together.`
 		}),
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
-	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.permissionsBoundary, permissionsBoundaryFlag, "", permissionsBoundaryFlagDescription)
 	return cmd
 }
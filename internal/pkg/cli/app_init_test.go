@@ -145,10 +145,11 @@ func TestInitAppOpts_Ask(t *testing.T) {
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName      string
-		inDomainName   string
-		mockRoute53Svc func(m *mocks.MockdomainValidator)
-		mockStore      func(m *mocks.Mockstore)
+		inAppName             string
+		inDomainName          string
+		inPermissionsBoundary string
+		mockRoute53Svc        func(m *mocks.MockdomainValidator)
+		mockStore             func(m *mocks.Mockstore)
 
 		wantedError string
 	}{
@@ -249,6 +250,41 @@ func TestInitAppOpts_Validate(t *testing.T) {
 			mockStore:   func(m *mocks.Mockstore) {},
 			wantedError: "",
 		},
+		"valid permissions boundary": {
+			inPermissionsBoundary: "arn:aws:iam::123456789012:policy/team/Boundary",
+			mockRoute53Svc:        func(m *mocks.MockdomainValidator) {},
+			mockStore:             func(m *mocks.Mockstore) {},
+		},
+		"permissions boundary is not the ARN of an IAM policy": {
+			inPermissionsBoundary: "arn:aws:iam::123456789012:role/Boundary",
+			mockRoute53Svc:        func(m *mocks.MockdomainValidator) {},
+			mockStore:             func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("permissions boundary %s is invalid: %w", "arn:aws:iam::123456789012:role/Boundary", errValueNotAnIAMPolicyARN).Error(),
+		},
+		"application created without a permissions boundary can adopt one": {
+			inAppName:             "metrics",
+			inPermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+			mockRoute53Svc:        func(m *mocks.MockdomainValidator) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name: "metrics",
+				}, nil)
+			},
+		},
+		"errors if application with different permissions boundary already exists": {
+			inAppName:             "metrics",
+			inPermissionsBoundary: "arn:aws:iam::123456789012:policy/NewBoundary",
+			mockRoute53Svc:        func(m *mocks.MockdomainValidator) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name:                "metrics",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+				}, nil)
+			},
+
+			wantedError: "application named metrics already exists with a different permissions boundary arn:aws:iam::123456789012:policy/Boundary",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -264,8 +300,9 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				route53: mockRoute53Svc,
 				store:   mockStore,
 				initAppVars: initAppVars{
-					name:       tc.inAppName,
-					domainName: tc.inDomainName,
+					name:                tc.inAppName,
+					domainName:          tc.inDomainName,
+					permissionsBoundary: tc.inPermissionsBoundary,
 				},
 			}
 
@@ -286,16 +323,19 @@ func TestInitAppOpts_Execute(t *testing.T) {
 	mockError := fmt.Errorf("error")
 
 	testCases := map[string]struct {
-		inDomainName string
+		inDomainName          string
+		inPermissionsBoundary string
 
 		expectedError error
 		mocking       func(t *testing.T,
 			mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
 			mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
 			mockProgress *mocks.Mockprogress)
+		mockGit func(m *mocks.MockgitStatusReader, ws *mocks.MockwsAppManager)
 	}{
 		"with a successful call to add app": {
-			inDomainName: "amazon.com",
+			inDomainName:          "amazon.com",
+			inPermissionsBoundary: "arn:aws:iam::12345:policy/Boundary",
 
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
 				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
@@ -315,16 +355,22 @@ func TestInitAppOpts_Execute(t *testing.T) {
 						Tags: map[string]string{
 							"owner": "boss",
 						},
+						PermissionsBoundary: "arn:aws:iam::12345:policy/Boundary",
 					})
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					Name:                "myapp",
+					PermissionsBoundary: "arn:aws:iam::12345:policy/Boundary",
+				}, nil)
 				mockWorkspace.
 					EXPECT().
 					Create(gomock.Eq("myapp")).Return(nil)
 				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
 				mockDeployer.EXPECT().
 					DeployApp(&deploy.CreateAppInput{
-						Name:       "myapp",
-						AccountID:  "12345",
-						DomainName: "amazon.com",
+						Name:                "myapp",
+						AccountID:           "12345",
+						DomainName:          "amazon.com",
+						PermissionsBoundary: "arn:aws:iam::12345:policy/Boundary",
 						AdditionalTags: map[string]string{
 							"owner": "boss",
 						},
@@ -332,6 +378,67 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
 			},
 		},
+		"records the permissions boundary of an existing application created without one": {
+			inPermissionsBoundary: "arn:aws:iam::12345:policy/Boundary",
+
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+				}, nil)
+				mockstore.EXPECT().UpdateApplication(&config.Application{
+					AccountID:           "12345",
+					Name:                "myapp",
+					PermissionsBoundary: "arn:aws:iam::12345:policy/Boundary",
+				}).Return(nil)
+			},
+		},
+		"adds the copilot files to .gitignore in a git repository": {
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+			},
+			mockGit: func(m *mocks.MockgitStatusReader, ws *mocks.MockwsAppManager) {
+				m.EXPECT().IsRepository().Return(true)
+				ws.EXPECT().AddGitIgnorePatterns().Return([]string{"copilot/.deployments/"}, nil)
+			},
+		},
+		"does not fail if .gitignore can't be updated": {
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+			},
+			mockGit: func(m *mocks.MockgitStatusReader, ws *mocks.MockwsAppManager) {
+				m.EXPECT().IsRepository().Return(true)
+				ws.EXPECT().AddGitIgnorePatterns().Return(nil, mockError)
+			},
+		},
 		"should return error from workspace.Create": {
 			expectedError: mockError,
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
@@ -404,11 +511,13 @@ func TestInitAppOpts_Execute(t *testing.T) {
 			mockIdentityService := mocks.NewMockidentityService(ctrl)
 			mockDeployer := mocks.NewMockappDeployer(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
+			mockGit := mocks.NewMockgitStatusReader(ctrl)
 
 			opts := &initAppOpts{
 				initAppVars: initAppVars{
-					name:       "myapp",
-					domainName: tc.inDomainName,
+					name:                "myapp",
+					domainName:          tc.inDomainName,
+					permissionsBoundary: tc.inPermissionsBoundary,
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
@@ -417,9 +526,15 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				identity: mockIdentityService,
 				cfn:      mockDeployer,
 				ws:       mockWorkspace,
+				git:      mockGit,
 				prog:     mockProgress,
 			}
 			tc.mocking(t, mockstore, mockWorkspace, mockIdentityService, mockDeployer, mockProgress)
+			if tc.mockGit != nil {
+				tc.mockGit(mockGit, mockWorkspace)
+			} else {
+				mockGit.EXPECT().IsRepository().Return(false).AnyTimes()
+			}
 
 			// WHEN
 			err := opts.Execute()
@@ -0,0 +1,263 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appUpdateTagsNamePrompt     = "Which application's tags would you like to update?"
+	appUpdateTagsNameHelpPrompt = "The tags of the application are applied to the stacks of its environments and workloads."
+
+	fmtAppUpdateTagsStackStart    = "Updating the tags of stack %s."
+	fmtAppUpdateTagsStackComplete = "Updated the tags of stack %s.\n"
+	fmtAppUpdateTagsStackFailed   = "Failed to update the tags of stack %s.\n\n"
+)
+
+type updateAppTagsVars struct {
+	name         string
+	resourceTags map[string]string
+	envName      string
+	dryRun       bool
+}
+
+type updateAppTagsOpts struct {
+	updateAppTagsVars
+
+	store store
+	sel   appSelector
+	prog  progress
+
+	// newStackTagger is overriden in tests to provide a mock.
+	newStackTagger func(env *config.Environment) (stackTagger, error)
+}
+
+func newUpdateAppTagsOpts(vars updateAppTagsVars) (*updateAppTagsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &updateAppTagsOpts{
+		updateAppTagsVars: vars,
+
+		store: store,
+		sel:   selector.NewSelect(prompt.New(), store),
+		prog:  termprogress.NewSpinner(),
+		newStackTagger: func(env *config.Environment) (stackTagger, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *updateAppTagsOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.name); err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.name, o.envName); err != nil {
+			return fmt.Errorf("get environment %s from application %s: %w", o.envName, o.name, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *updateAppTagsOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appUpdateTagsNamePrompt, appUpdateTagsNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute adds the resource tags to the application, then applies the tags of the application to the stacks
+// of its environments and deployed workloads.
+// A stack tag with the same key as an application tag is overwritten.
+// With --dry-run, it only lists the stacks whose tags would change.
+func (o *updateAppTagsOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if err := o.updateApp(app); err != nil {
+		return err
+	}
+	envs, err := o.envsToUpdate()
+	if err != nil {
+		return err
+	}
+	wklds, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return fmt.Errorf("list workloads in application %s: %w", o.name, err)
+	}
+	for _, env := range envs {
+		tagger, err := o.newStackTagger(env)
+		if err != nil {
+			return err
+		}
+		stackNames := []string{stack.NameForEnv(o.name, env.Name)}
+		for _, wkld := range wklds {
+			stackNames = append(stackNames, stack.NameForService(o.name, env.Name, wkld.Name))
+		}
+		for _, stackName := range stackNames {
+			if err := o.updateStack(tagger, stackName, app.Tags); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateApp adds the resource tags to the tags of the application and stores them.
+func (o *updateAppTagsOpts) updateApp(app *config.Application) error {
+	tags, changed := mergeTags(app.Tags, o.resourceTags)
+	if !changed {
+		return nil
+	}
+	app.Tags = tags
+	if o.dryRun {
+		log.Infof("Would update the tags of application %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update application %s: %w", o.name, err)
+	}
+	log.Successf("Updated the tags of application %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+func (o *updateAppTagsOpts) envsToUpdate() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.name, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s from application %s: %w", o.envName, o.name, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	return envs, nil
+}
+
+// updateStack applies the application tags to the stack if any of them is missing or has a different value.
+// Stacks that don't exist, like the ones of workloads that aren't deployed to the environment, are skipped.
+func (o *updateAppTagsOpts) updateStack(tagger stackTagger, stackName string, appTags map[string]string) (err error) {
+	stackTags, err := tagger.StackTags(stackName)
+	if err != nil {
+		var errStackNotFound *awscfn.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get tags of stack %s: %w", stackName, err)
+	}
+	tags, changed := mergeTags(stackTags, appTags)
+	if !changed {
+		return nil
+	}
+	var keys []string
+	for key := range appTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if prev, ok := stackTags[key]; ok && prev != appTags[key] {
+			log.Warningf("Overwriting tag %s of stack %s from %q to %q.\n", key, stackName, prev, appTags[key])
+		}
+	}
+	if o.dryRun {
+		log.Infof("Would update the tags of stack %s.\n", color.HighlightResource(stackName))
+		return nil
+	}
+	o.prog.Start(fmt.Sprintf(fmtAppUpdateTagsStackStart, color.HighlightResource(stackName)))
+	defer func() {
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtAppUpdateTagsStackFailed, color.HighlightResource(stackName)))
+			return
+		}
+		o.prog.Stop(log.Ssuccessf(fmtAppUpdateTagsStackComplete, color.HighlightResource(stackName)))
+	}()
+	if err := tagger.UpdateStackTags(stackName, tags); err != nil {
+		return fmt.Errorf("update tags of stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
+// mergeTags returns the tags with the overrides applied on top, and whether any of the overrides changed them.
+func mergeTags(tags, overrides map[string]string) (map[string]string, bool) {
+	merged := make(map[string]string, len(tags)+len(overrides))
+	for key, val := range tags {
+		merged[key] = val
+	}
+	changed := false
+	for key, val := range overrides {
+		if prev, ok := merged[key]; !ok || prev != val {
+			changed = true
+		}
+		merged[key] = val
+	}
+	return merged, changed
+}
+
+// buildAppUpdateTagsCmd builds the command for updating the tags of an application.
+func buildAppUpdateTagsCmd() *cobra.Command {
+	vars := updateAppTagsVars{}
+	cmd := &cobra.Command{
+		Use:   "update-tags",
+		Short: "Updates the tags of an application and applies them to its environments and workloads.",
+		Long: `Updates the tags of an application and applies them to its environments and workloads.
+The stacks of the environments and deployed workloads are updated with their existing templates and parameters.`,
+		Example: `
+  Add a "cost-center" tag to the application "my-app" and to all its stacks.
+  /code $ copilot app update-tags -n my-app --resource-tags cost-center=1234
+  List the stacks of the "test" environment whose tags would change.
+  /code $ copilot app update-tags -n my-app --env test --dry-run`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUpdateAppTagsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", appUpdateTagsEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, appUpdateTagsDryRunFlagDescription)
+	return cmd
+}
@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type updateAppTagsMocks struct {
+	store  *mocks.Mockstore
+	tagger *mocks.MockstackTagger
+	prog   *mocks.Mockprogress
+}
+
+func TestUpdateAppTagsOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inResourceTags map[string]string
+		inEnv          string
+		inDryRun       bool
+
+		setupMocks func(m updateAppTagsMocks)
+
+		wantedErr string
+	}{
+		"adds the tag to the application and to the stacks of deployed workloads": {
+			inResourceTags: map[string]string{"cost-center": "1234"},
+			setupMocks: func(m updateAppTagsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name: "phonetool",
+					Tags: map[string]string{"cost-center": "1234"},
+				}).Return(nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "frontend"}, {Name: "report"}}, nil)
+				m.tagger.EXPECT().StackTags("phonetool-test").Return(map[string]string{"copilot-application": "phonetool"}, nil)
+				m.tagger.EXPECT().UpdateStackTags("phonetool-test", map[string]string{
+					"copilot-application": "phonetool",
+					"cost-center":         "1234",
+				}).Return(nil)
+				m.tagger.EXPECT().StackTags("phonetool-test-frontend").Return(map[string]string{"cost-center": "0000"}, nil)
+				m.tagger.EXPECT().UpdateStackTags("phonetool-test-frontend", map[string]string{
+					"cost-center": "1234",
+				}).Return(nil)
+				m.tagger.EXPECT().StackTags("phonetool-test-report").Return(nil, &awscfn.ErrStackNotFound{})
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+		},
+		"skips the stacks that already have the tags": {
+			inEnv: "test",
+			setupMocks: func(m updateAppTagsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					Tags: map[string]string{"cost-center": "1234"},
+				}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return(nil, nil)
+				m.tagger.EXPECT().StackTags("phonetool-test").Return(map[string]string{"cost-center": "1234"}, nil)
+			},
+		},
+		"only lists the stacks that would change with dry run": {
+			inResourceTags: map[string]string{"cost-center": "1234"},
+			inDryRun:       true,
+			setupMocks: func(m updateAppTagsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().UpdateApplication(gomock.Any()).Times(0)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return(nil, nil)
+				m.tagger.EXPECT().StackTags("phonetool-test").Return(map[string]string{}, nil)
+				m.tagger.EXPECT().UpdateStackTags(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"returns error if fail to update the tags of a stack": {
+			inResourceTags: map[string]string{"cost-center": "1234"},
+			setupMocks: func(m updateAppTagsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().UpdateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return(nil, nil)
+				m.tagger.EXPECT().StackTags("phonetool-test").Return(map[string]string{}, nil)
+				m.tagger.EXPECT().UpdateStackTags("phonetool-test", gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Start(gomock.Any())
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: "update tags of stack phonetool-test: some error",
+		},
+		"returns error if fail to get the tags of a stack": {
+			setupMocks: func(m updateAppTagsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return(nil, nil)
+				m.tagger.EXPECT().StackTags("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get tags of stack phonetool-test: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := updateAppTagsMocks{
+				store:  mocks.NewMockstore(ctrl),
+				tagger: mocks.NewMockstackTagger(ctrl),
+				prog:   mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &updateAppTagsOpts{
+				updateAppTagsVars: updateAppTagsVars{
+					name:         "phonetool",
+					resourceTags: tc.inResourceTags,
+					envName:      tc.inEnv,
+					dryRun:       tc.inDryRun,
+				},
+				store: m.store,
+				prog:  m.prog,
+				newStackTagger: func(env *config.Environment) (stackTagger, error) {
+					return m.tagger, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -11,11 +11,152 @@ import (
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	sdkiam "github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	// Display settings for tables.
+	minCellWidth           = 20  // minimum number of characters in a table's cell.
+	tabWidth               = 4   // number of characters in between columns.
+	cellPaddingWidth       = 2   // number of padding characters added by default to a cell.
+	paddingChar            = ' ' // character in between columns.
+	noAdditionalFormatting = 0
+)
+
+// Exit codes returned by commands so that scripts can react to the type of failure.
+const (
+	exitCodeFailure      = 1 // Any error that doesn't fall into a more specific category.
+	exitCodeNotFound     = 2 // The application, environment, service or job doesn't exist.
+	exitCodeThrottled    = 3 // The request was throttled by AWS, it's safe to retry.
+	exitCodeCredentials  = 4 // The AWS credentials are missing, invalid or expired.
+	exitCodeInvalidInput = 5 // A flag or argument has an invalid value.
+
+	exitCodeInterrupted = 130 // The command was interrupted by SIGINT or SIGTERM.
+)
+
+// AWS error codes returned when the credentials can't be used to sign requests.
+var credentialsErrCodes = map[string]bool{
+	"NoCredentialProviders":       true,
+	"InvalidClientTokenId":        true,
+	"UnrecognizedClientException": true,
+	"SharedCredsLoad":             true,
+}
+
+// validationErrs are the errors returned when a flag or argument is invalid.
+var validationErrs = []error{
+	errValueEmpty,
+	errValueTooLong,
+	errValueBadFormat,
+	errValueNotAString,
+	errValueNotAStringSlice,
+	errValueNotAValidPath,
+	errValueNotAnIPNet,
+	errValueNotIPNetSlice,
+	errPortInvalid,
+	errS3ValueBadSize,
+	errS3ValueBadFormat,
+	errS3ValueTrailingDash,
+	errValueBadFormatWithPeriod,
+	errDDBValueBadSize,
+	errValueBadFormatWithPeriodUnderscore,
+	errDDBAttributeBadFormat,
+	errTooManyLSIKeys,
+	errDomainInvalid,
+	errDurationInvalid,
+	errDurationBadUnits,
+	errScheduleInvalid,
+}
+
+// errWithExitCode wraps an error returned by a command with the code the process should exit with.
+// The error message is left untouched.
+type errWithExitCode struct {
+	err  error
+	code int
+}
+
+func (e *errWithExitCode) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error returned by the command.
+func (e *errWithExitCode) Unwrap() error {
+	return e.err
+}
+
+// ExitCode returns the code the process should exit with.
+func (e *errWithExitCode) ExitCode() int {
+	return e.code
+}
+
+// exitCode returns the exit code that matches the family of the error.
+// If the command already chose the code to exit with, such as the exit code of a task's container, it is kept
+// unless the command was interrupted.
+func exitCode(err error) int {
+	var interrupted *errInterrupted
+	if errors.As(err, &interrupted) {
+		return exitCodeInterrupted
+	}
+	var withCode *errWithExitCode
+	if errors.As(err, &withCode) {
+		return withCode.code
+	}
+	var (
+		noSuchApp *config.ErrNoSuchApplication
+		noSuchEnv *config.ErrNoSuchEnvironment
+		noSuchSvc *config.ErrNoSuchService
+		noSuchJob *config.ErrNoSuchJob
+	)
+	if errors.As(err, &noSuchApp) || errors.As(err, &noSuchEnv) || errors.As(err, &noSuchSvc) || errors.As(err, &noSuchJob) {
+		return exitCodeNotFound
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		if request.IsErrorThrottle(aerr) {
+			return exitCodeThrottled
+		}
+		if request.IsErrorExpiredCreds(aerr) || credentialsErrCodes[aerr.Code()] {
+			return exitCodeCredentials
+		}
+	}
+	var reservedArg *errReservedArg
+	if errors.As(err, &reservedArg) {
+		return exitCodeInvalidInput
+	}
+	var appMismatch *workspace.ErrAppMismatch
+	if errors.As(err, &appMismatch) {
+		return exitCodeInvalidInput
+	}
+	for _, validationErr := range validationErrs {
+		if errors.Is(err, validationErr) {
+			return exitCodeInvalidInput
+		}
+	}
+	return exitCodeFailure
+}
+
+// globalVars holds the values of the flags accepted by every command.
+var globalVars struct {
+	quiet bool
+}
+
+// AddGlobalFlags adds the flags accepted by every command to the root command.
+func AddGlobalFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().BoolVarP(&globalVars.quiet, quietFlag, quietFlagShort, false, quietFlagDescription)
+}
+
+// ApplyGlobalFlags configures the terminal output with the flags accepted by every command.
+// It must be called after the flags are parsed and before the command runs.
+func ApplyGlobalFlags() {
+	log.SetQuiet(globalVars.quiet)
+}
+
 // tryReadingAppName retrieves the application's name from the workspace if it exists and returns it.
 // If there is an error while retrieving the workspace summary, returns the empty string.
 func tryReadingAppName() string {
@@ -31,6 +172,37 @@ func tryReadingAppName() string {
 	return summary.Application
 }
 
+// workspaceAppName returns the name of the application that the workspace is registered with,
+// or the empty string if it can't be read.
+func workspaceAppName(ws *workspace.Workspace) string {
+	summary, err := ws.Summary()
+	if err != nil {
+		return ""
+	}
+	return summary.Application
+}
+
+// validateWorkspaceApp returns a *workspace.ErrAppMismatch if the application of the command differs from
+// the one the workspace is registered with, unless overriding the workspace's application is allowed.
+func validateWorkspaceApp(wsAppName, appName string, allowOverride bool) error {
+	if wsAppName == "" || wsAppName == appName || allowOverride {
+		return nil
+	}
+	return &workspace.ErrAppMismatch{
+		WorkspaceApp: wsAppName,
+		App:          appName,
+	}
+}
+
+// workspaceAppSelectOptions returns the options of a workspace selector for a command that targets the application.
+func workspaceAppSelectOptions(appName string, allowOverride bool) []selector.SelectOption {
+	opts := []selector.SelectOption{selector.WithApp(appName)}
+	if allowOverride {
+		opts = append(opts, selector.WithAppOverride())
+	}
+	return opts
+}
+
 type errReservedArg struct {
 	val string
 }
@@ -52,13 +224,26 @@ func reservedArgs(cmd *cobra.Command, args []string) error {
 
 // runCmdE wraps one of the run error methods, PreRunE, RunE, of a cobra command so that if a user
 // types "help" in the arguments the usage string is printed instead of running the command.
+// Errors returned by the method are annotated with the code the process should exit with.
+// If the process receives SIGINT or SIGTERM while the method runs, cmdCtx is canceled so that it can clean up.
 func runCmdE(f func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		if len(args) == 1 && args[0] == "help" {
 			_ = cmd.Help() // Help always returns nil.
 			os.Exit(0)
 		}
-		return f(cmd, args)
+		signals, stop := notifyInterrupts()
+		defer stop()
+		err := runInterruptible(func() error {
+			return f(cmd, args)
+		}, signals)
+		if err != nil {
+			return &errWithExitCode{
+				err:  err,
+				code: exitCode(err),
+			}
+		}
+		return nil
 	}
 }
 
@@ -77,6 +262,15 @@ func isStackSetNotExistsErr(err error) bool {
 	return true
 }
 
+// returns true if the error is an IAM entity, such as a role, that does not exist.
+func isNoSuchEntityErr(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == sdkiam.ErrCodeNoSuchEntityException
+}
+
 // relPath returns the path relative to the current working directory.
 func relPath(fullPath string) (string, error) {
 	wkdir, err := os.Getwd()
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedCode int
+	}{
+		"generic error": {
+			inErr:      errors.New("some error"),
+			wantedCode: exitCodeFailure,
+		},
+		"exit code chosen by the command": {
+			inErr: &errWithExitCode{
+				err:  errors.New("task exited with code 137"),
+				code: 137,
+			},
+			wantedCode: 137,
+		},
+		"application not found": {
+			inErr: fmt.Errorf("get application: %w", &config.ErrNoSuchApplication{
+				ApplicationName: "phonetool",
+			}),
+			wantedCode: exitCodeNotFound,
+		},
+		"environment not found": {
+			inErr: fmt.Errorf("get environment: %w", &config.ErrNoSuchEnvironment{
+				ApplicationName: "phonetool",
+				EnvironmentName: "test",
+			}),
+			wantedCode: exitCodeNotFound,
+		},
+		"service not found": {
+			inErr:      &config.ErrNoSuchService{App: "phonetool", Name: "api"},
+			wantedCode: exitCodeNotFound,
+		},
+		"job not found": {
+			inErr:      &config.ErrNoSuchJob{App: "phonetool", Name: "mailer"},
+			wantedCode: exitCodeNotFound,
+		},
+		"throttled by AWS": {
+			inErr:      fmt.Errorf("describe stack: %w", awserr.New("Throttling", "Rate exceeded", nil)),
+			wantedCode: exitCodeThrottled,
+		},
+		"expired credentials": {
+			inErr:      fmt.Errorf("describe stack: %w", awserr.New("ExpiredToken", "The security token included in the request is expired", nil)),
+			wantedCode: exitCodeCredentials,
+		},
+		"missing credentials": {
+			inErr:      awserr.New("NoCredentialProviders", "no valid providers in chain", nil),
+			wantedCode: exitCodeCredentials,
+		},
+		"other AWS error": {
+			inErr:      awserr.New("ValidationError", "Stack does not exist", nil),
+			wantedCode: exitCodeFailure,
+		},
+		"invalid flag value": {
+			inErr:      fmt.Errorf("application name %s is invalid: %w", "Phonetool", errValueBadFormat),
+			wantedCode: exitCodeInvalidInput,
+		},
+		"reserved argument": {
+			inErr:      &errReservedArg{val: "local"},
+			wantedCode: exitCodeInvalidInput,
+		},
+		"application different from the workspace's": {
+			inErr:      fmt.Errorf("select service: %w", &workspace.ErrAppMismatch{WorkspaceApp: "demo", App: "phonetool"}),
+			wantedCode: exitCodeInvalidInput,
+		},
+		"interrupted command": {
+			inErr: &errInterrupted{
+				sig: os.Interrupt,
+				err: &errWithExitCode{
+					err:  errors.New("task exited with code 137"),
+					code: 137,
+				},
+			},
+			wantedCode: exitCodeInterrupted,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedCode, exitCode(tc.inErr))
+		})
+	}
+}
+
+func TestRunCmdE(t *testing.T) {
+	t.Run("keeps the error message and attaches the exit code", func(t *testing.T) {
+		wantedErr := fmt.Errorf("get environment: %w", &config.ErrNoSuchEnvironment{
+			ApplicationName: "phonetool",
+			EnvironmentName: "test",
+		})
+		run := runCmdE(func(cmd *cobra.Command, args []string) error {
+			return wantedErr
+		})
+
+		err := run(&cobra.Command{}, nil)
+
+		require.EqualError(t, err, wantedErr.Error())
+		require.True(t, errors.Is(err, wantedErr))
+		var exitErr *errWithExitCode
+		require.True(t, errors.As(err, &exitErr))
+		require.Equal(t, exitCodeNotFound, exitErr.ExitCode())
+	})
+	t.Run("returns nil on success", func(t *testing.T) {
+		run := runCmdE(func(cmd *cobra.Command, args []string) error {
+			return nil
+		})
+
+		require.NoError(t, run(&cobra.Command{}, nil))
+	})
+}
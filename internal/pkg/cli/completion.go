@@ -7,18 +7,43 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+// flagCompletionTimeout is how long completing a flag value waits for its candidates.
+// Completion must never hang the shell, so no candidates are returned past it.
+const flagCompletionTimeout = 2 * time.Second
+
 type shellCompleter interface {
 	GenBashCompletion(w io.Writer) error
 	GenZshCompletion(w io.Writer) error
 }
 
+type completionStore interface {
+	ListApplications() ([]*config.Application, error)
+	ListEnvironments(appName string) ([]*config.Environment, error)
+	ListServices(appName string) ([]*config.Workload, error)
+	ListJobs(appName string) ([]*config.Workload, error)
+}
+
+type completionWorkspace interface {
+	Summary() (*workspace.Summary, error)
+	WorkloadNames() ([]string, error)
+}
+
+type profileNamesLister interface {
+	Names() []string
+}
+
 type completionOpts struct {
 	Shell string // must be "bash" or "zsh"
 
@@ -90,3 +115,230 @@ The code must be evaluated to provide interactive completion of commands.`,
 	}
 	return cmd
 }
+
+// flagCompleter completes the values of the --app, --env, --name, --workload and --profile flags.
+// Its dependencies are only initialized once the shell asks for completions.
+type flagCompleter struct {
+	timeout      time.Duration
+	initStore    func() (completionStore, error)
+	initWs       func() (completionWorkspace, error)
+	initProfiles func() (profileNamesLister, error)
+}
+
+func newFlagCompleter() *flagCompleter {
+	return &flagCompleter{
+		timeout: flagCompletionTimeout,
+		initStore: func() (completionStore, error) {
+			return config.NewStore()
+		},
+		initWs: func() (completionWorkspace, error) {
+			return workspace.New()
+		},
+		initProfiles: func() (profileNamesLister, error) {
+			return profile.NewConfig()
+		},
+	}
+}
+
+// RegisterFlagCompletions registers the completion of the values of the application, environment,
+// workload and profile flags for the command and all of its subcommands.
+func RegisterFlagCompletions(cmd *cobra.Command) error {
+	return newFlagCompleter().register(cmd)
+}
+
+func (c *flagCompleter) register(cmd *cobra.Command) error {
+	for _, sub := range cmd.Commands() {
+		if err := c.register(sub); err != nil {
+			return err
+		}
+	}
+	completions := map[string]func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective){
+		appFlag:      c.apps,
+		envFlag:      c.envs,
+		workloadFlag: c.workloads,
+		profileFlag:  c.profiles,
+	}
+	if complete := c.nameCompletion(cmd); complete != nil {
+		completions[nameFlag] = complete
+	}
+	for flag, complete := range completions {
+		if cmd.Flags().Lookup(flag) == nil {
+			continue
+		}
+		if err := cmd.RegisterFlagCompletionFunc(flag, complete); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nameCompletion returns the completion of the --name flag of the command, or nil if the name
+// isn't one of an existing resource.
+func (c *flagCompleter) nameCompletion(cmd *cobra.Command) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	if cmd.Name() == "init" || !cmd.HasParent() {
+		return nil
+	}
+	if cmd.Name() == "deploy" && !cmd.Parent().HasParent() {
+		// "copilot deploy" deploys a workload of the workspace.
+		return c.workloads
+	}
+	switch cmd.Parent().Name() {
+	case "app":
+		return c.apps
+	case "env":
+		return c.envs
+	case "svc":
+		return c.services
+	case "job":
+		return c.jobs
+	}
+	return nil
+}
+
+func (c *flagCompleter) apps(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func() ([]string, error) {
+		store, err := c.initStore()
+		if err != nil {
+			return nil, err
+		}
+		apps, err := store.ListApplications()
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(apps))
+		for i, app := range apps {
+			names[i] = app.Name
+		}
+		return names, nil
+	})
+}
+
+func (c *flagCompleter) envs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func() ([]string, error) {
+		appName, err := c.appName(cmd)
+		if err != nil {
+			return nil, err
+		}
+		store, err := c.initStore()
+		if err != nil {
+			return nil, err
+		}
+		envs, err := store.ListEnvironments(appName)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(envs))
+		for i, env := range envs {
+			names[i] = env.Name
+		}
+		return names, nil
+	})
+}
+
+func (c *flagCompleter) services(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func() ([]string, error) {
+		appName, err := c.appName(cmd)
+		if err != nil {
+			return nil, err
+		}
+		store, err := c.initStore()
+		if err != nil {
+			return nil, err
+		}
+		svcs, err := store.ListServices(appName)
+		if err != nil {
+			return nil, err
+		}
+		return workloadNames(svcs), nil
+	})
+}
+
+func (c *flagCompleter) jobs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func() ([]string, error) {
+		appName, err := c.appName(cmd)
+		if err != nil {
+			return nil, err
+		}
+		store, err := c.initStore()
+		if err != nil {
+			return nil, err
+		}
+		jobs, err := store.ListJobs(appName)
+		if err != nil {
+			return nil, err
+		}
+		return workloadNames(jobs), nil
+	})
+}
+
+func (c *flagCompleter) workloads(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func() ([]string, error) {
+		ws, err := c.initWs()
+		if err != nil {
+			return nil, err
+		}
+		return ws.WorkloadNames()
+	})
+}
+
+func (c *flagCompleter) profiles(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func() ([]string, error) {
+		cfg, err := c.initProfiles()
+		if err != nil {
+			return nil, err
+		}
+		return cfg.Names(), nil
+	})
+}
+
+// appName returns the application typed with the --app flag, or the application of the workspace otherwise.
+func (c *flagCompleter) appName(cmd *cobra.Command) (string, error) {
+	if flag := cmd.Flags().Lookup(appFlag); flag != nil && flag.Value.String() != "" {
+		return flag.Value.String(), nil
+	}
+	ws, err := c.initWs()
+	if err != nil {
+		return "", err
+	}
+	summary, err := ws.Summary()
+	if err != nil {
+		return "", err
+	}
+	if summary.Application == "" {
+		return "", errNoAppInWorkspace
+	}
+	return summary.Application, nil
+}
+
+// complete returns the candidates listed by list that start with toComplete.
+// No candidates are returned if list fails or doesn't return before the timeout.
+func (c *flagCompleter) complete(toComplete string, list func() ([]string, error)) ([]string, cobra.ShellCompDirective) {
+	listed := make(chan []string, 1) // Buffered so that the goroutine exits even after the timeout.
+	go func() {
+		names, err := list()
+		if err != nil {
+			names = nil
+		}
+		listed <- names
+	}()
+	var names []string
+	select {
+	case names = <-listed:
+	case <-time.After(c.timeout):
+	}
+	var candidates []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			candidates = append(candidates, name)
+		}
+	}
+	return candidates, cobra.ShellCompDirectiveNoFileComp
+}
+
+func workloadNames(wkls []*config.Workload) []string {
+	names := make([]string, len(wkls))
+	for i, wkl := range wkls {
+		names[i] = wkl.Name
+	}
+	return names
+}
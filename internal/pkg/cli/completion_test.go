@@ -4,11 +4,16 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,3 +91,192 @@ func TestCompletionOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+type flagCompleterMocks struct {
+	store    *mocks.MockcompletionStore
+	ws       *mocks.MockcompletionWorkspace
+	profiles *mocks.MockprofileNamesLister
+}
+
+func newTestFlagCompleter(m flagCompleterMocks) *flagCompleter {
+	return &flagCompleter{
+		timeout: time.Second,
+		initStore: func() (completionStore, error) {
+			return m.store, nil
+		},
+		initWs: func() (completionWorkspace, error) {
+			return m.ws, nil
+		},
+		initProfiles: func() (profileNamesLister, error) {
+			return m.profiles, nil
+		},
+	}
+}
+
+func TestFlagCompleter_envs(t *testing.T) {
+	testCases := map[string]struct {
+		inAppFlag    string
+		inToComplete string
+		setupMocks   func(m flagCompleterMocks)
+
+		wanted []string
+	}{
+		"completes the environments of the application typed with --app": {
+			inAppFlag:    "phonetool",
+			inToComplete: "p",
+			setupMocks: func(m flagCompleterMocks) {
+				m.ws.EXPECT().Summary().Times(0)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+					{Name: "prod-iad"},
+				}, nil)
+			},
+			wanted: []string{"prod", "prod-iad"},
+		},
+		"completes the environments of the workspace application without --app": {
+			setupMocks: func(m flagCompleterMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "phonetool"}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+			},
+			wanted: []string{"test"},
+		},
+		"returns no completions without an application": {
+			setupMocks: func(m flagCompleterMocks) {
+				m.ws.EXPECT().Summary().Return(nil, errors.New("no workspace"))
+				m.store.EXPECT().ListEnvironments(gomock.Any()).Times(0)
+			},
+		},
+		"returns no completions if the environments can't be listed": {
+			inAppFlag: "phonetool",
+			setupMocks: func(m flagCompleterMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := flagCompleterMocks{
+				store: mocks.NewMockcompletionStore(ctrl),
+				ws:    mocks.NewMockcompletionWorkspace(ctrl),
+			}
+			tc.setupMocks(m)
+			cmd := &cobra.Command{}
+			cmd.Flags().String(appFlag, "", "")
+			require.NoError(t, cmd.Flags().Set(appFlag, tc.inAppFlag))
+
+			// WHEN
+			got, directive := newTestFlagCompleter(m).envs(cmd, nil, tc.inToComplete)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+		})
+	}
+}
+
+func TestFlagCompleter_complete(t *testing.T) {
+	t.Run("returns no completions past the timeout", func(t *testing.T) {
+		// GIVEN
+		unblock := make(chan struct{})
+		defer close(unblock)
+		c := &flagCompleter{
+			timeout: 10 * time.Millisecond,
+		}
+
+		// WHEN
+		got, directive := c.complete("", func() ([]string, error) {
+			<-unblock
+			return []string{"test"}, nil
+		})
+
+		// THEN
+		require.Nil(t, got)
+		require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+}
+
+func TestFlagCompleter_register(t *testing.T) {
+	testCases := map[string]struct {
+		inArgs     []string
+		setupMocks func(m flagCompleterMocks)
+
+		wanted string
+	}{
+		"completes the services of the application typed with --app": {
+			inArgs: []string{"svc", "show", "--app", "phonetool", "--name", "f"},
+			setupMocks: func(m flagCompleterMocks) {
+				m.store.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "frontend"},
+					{Name: "backend"},
+				}, nil)
+			},
+			wanted: "frontend\n:4\n",
+		},
+		"completes the jobs of a job command": {
+			inArgs: []string{"job", "show", "--app", "phonetool", "--name", ""},
+			setupMocks: func(m flagCompleterMocks) {
+				m.store.EXPECT().ListJobs("phonetool").Return([]*config.Workload{
+					{Name: "report"},
+				}, nil)
+			},
+			wanted: "report\n:4\n",
+		},
+		"completes the profiles": {
+			inArgs: []string{"env", "init", "--profile", ""},
+			setupMocks: func(m flagCompleterMocks) {
+				m.profiles.EXPECT().Names().Return([]string{"default", "prod-admin"})
+			},
+			wanted: "default\nprod-admin\n:4\n",
+		},
+		"doesn't complete the name of a new resource": {
+			inArgs:     []string{"env", "init", "--name", ""},
+			setupMocks: func(m flagCompleterMocks) {},
+			wanted:     ":0\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := flagCompleterMocks{
+				store:    mocks.NewMockcompletionStore(ctrl),
+				ws:       mocks.NewMockcompletionWorkspace(ctrl),
+				profiles: mocks.NewMockprofileNamesLister(ctrl),
+			}
+			tc.setupMocks(m)
+			root := &cobra.Command{Use: "copilot"}
+			for _, group := range []string{"env", "svc", "job"} {
+				groupCmd := &cobra.Command{Use: group}
+				for _, use := range []string{"init", "show"} {
+					cmd := &cobra.Command{Use: use, Run: func(*cobra.Command, []string) {}}
+					cmd.Flags().String(nameFlag, "", "")
+					cmd.Flags().String(appFlag, "", "")
+					cmd.Flags().String(profileFlag, "", "")
+					groupCmd.AddCommand(cmd)
+				}
+				root.AddCommand(groupCmd)
+			}
+			require.NoError(t, newTestFlagCompleter(m).register(root))
+			out := &bytes.Buffer{}
+			root.SetOut(out)
+			root.SetErr(&bytes.Buffer{})
+			root.SetArgs(append([]string{cobra.ShellCompRequestCmd}, tc.inArgs...))
+
+			// WHEN
+			err := root.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, out.String())
+		})
+	}
+}
@@ -6,13 +6,17 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -23,24 +27,38 @@ import (
 const (
 	svcWkldType = "svc"
 	jobWkldType = "job"
+
+	deployEnvPrompt = "Select an environment to deploy to"
+
+	// Maximum number of workloads whose images are built at the same time when several workloads are deployed.
+	maxConcurrentImageBuilds = 4
 )
 
-type deployOpts struct {
+type deployVars struct {
 	deployWkldVars
 
-	deployWkld     actionCommand
+	all           bool     // true means every service and job of the workspace is deployed.
+	workloadNames []string // Services and jobs of the workspace to deploy.
+}
+
+type deployOpts struct {
+	deployVars
+
+	deployWkld     wkldDeployer
 	setupDeployCmd func(*deployOpts, string)
 
-	sel    wsSelector
-	store  store
-	ws     wsWlDirReader
-	prompt prompter
+	sel         wsSelector
+	store       store
+	deployStore deployedEnvironmentLister
+	ws          wsWlDirReader
+	prompt      prompter
+	unmarshal   func([]byte) (interface{}, error)
 
 	// values for logging
 	wlType string
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	store, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
@@ -50,13 +68,24 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 	prompter := prompt.New()
+	vars.notifyTopicARN = defaultNotifyTopicARN(vars.notifyTopicARN, ws)
+	vars.buildTool = defaultBuildTool(vars.buildTool, ws)
+	vars.history = defaultDeploymentHistory(ws)
+	vars.wsAppName = workspaceAppName(ws)
+	selOpts := workspaceAppSelectOptions(vars.appName, vars.allowAppOverride)
 	return &deployOpts{
-		deployWkldVars: vars,
-		store:          store,
-		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
-		ws:             ws,
-		prompt:         prompter,
+		deployVars:  vars,
+		store:       store,
+		deployStore: deployStore,
+		sel:         selector.NewWorkspaceSelect(prompter, store, ws, selOpts...),
+		ws:          ws,
+		prompt:      prompter,
+		unmarshal:   workloadUnmarshaler(vars.strict),
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
 			switch {
@@ -66,32 +95,43 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 
 					store:        o.store,
 					ws:           o.ws,
-					unmarshal:    manifest.UnmarshalWorkload,
+					unmarshal:    workloadUnmarshaler(o.strict),
 					spinner:      termprogress.NewSpinner(),
-					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
+					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws, selOpts...),
 					prompt:       o.prompt,
 					cmd:          command.New(),
+					git:          newGitRepo(),
 					sessProvider: sessions.NewProvider(),
 				}
 			case contains(workloadType, manifest.ServiceTypes):
-				o.deployWkld = &deploySvcOpts{
+				opts := &deploySvcOpts{
 					deployWkldVars: o.deployWkldVars,
 
 					store:        o.store,
+					deployStore:  o.deployStore,
 					ws:           o.ws,
-					unmarshal:    manifest.UnmarshalWorkload,
+					unmarshal:    workloadUnmarshaler(o.strict),
 					spinner:      termprogress.NewSpinner(),
-					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
+					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws, selOpts...),
 					prompt:       o.prompt,
 					cmd:          command.New(),
+					git:          newGitRepo(),
 					sessProvider: sessions.NewProvider(),
 				}
+				opts.deployedImages = opts.serviceDeployedImages
+				o.deployWkld = opts
 			}
 		},
 	}, nil
 }
 
 func (o *deployOpts) Run() error {
+	if err := o.validateWorkloadFlags(); err != nil {
+		return err
+	}
+	if o.all || len(o.workloadNames) != 0 {
+		return o.deployWorkloads()
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -104,6 +144,230 @@ func (o *deployOpts) Run() error {
 	return nil
 }
 
+func (o *deployOpts) validateWorkloadFlags() error {
+	if o.all && len(o.workloadNames) != 0 {
+		return fmt.Errorf("--%s cannot be used with --%s", allFlag, workloadsFlag)
+	}
+	if !o.all && len(o.workloadNames) == 0 {
+		return nil
+	}
+	if o.name != "" {
+		return fmt.Errorf("--%s cannot be used with --%s or --%s", nameFlag, allFlag, workloadsFlag)
+	}
+	if o.noWait {
+		// Waiting for a workload to be deployed is how the workloads that depend on it are deployed after it.
+		return fmt.Errorf("--%s cannot be used with --%s or --%s", noWaitFlag, allFlag, workloadsFlag)
+	}
+	return nil
+}
+
+// deployWorkloads deploys several services and jobs of the workspace to an environment.
+// The images of the workloads are built and pushed concurrently first, then each workload is deployed
+// after the workloads it depends on. A workload is skipped if one of its dependencies fails to deploy.
+func (o *deployOpts) deployWorkloads() error {
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	names, deps, err := o.workloadsToDeploy()
+	if err != nil {
+		return err
+	}
+	order, err := deploymentOrder(names, deps)
+	if err != nil {
+		return err
+	}
+	deployers := make(map[string]wkldDeployer, len(order))
+	for _, name := range order {
+		o.name = name
+		if err := o.loadWkld(); err != nil {
+			return fmt.Errorf("prepare the deployment of %s: %w", name, err)
+		}
+		deployers[name] = o.deployWkld
+	}
+
+	log.Infof("Building and pushing the images of %s.\n", strings.Join(order, ", "))
+	buildErrs := buildAndPushImages(order, deployers, maxConcurrentImageBuilds)
+
+	statuses := make(map[string]string, len(order))
+	results := make([]deployResult, len(order))
+	var failed int
+	for i, name := range order {
+		status := deployStatusDeployed
+		switch {
+		case buildErrs[name] != nil:
+			status = deployStatusFailed
+			log.Errorf("Failed to build and push the images of %s: %v\n", name, buildErrs[name])
+		case dependencyNotDeployed(deps[name], statuses):
+			status = deployStatusSkipped
+			log.Warningf("Skipped deploying %s since a workload it depends on isn't deployed.\n", name)
+		default:
+			if err := deployers[name].Execute(); err != nil {
+				status = deployStatusFailed
+				log.Errorf("Failed to deploy %s: %v\n", name, err)
+			}
+		}
+		if status == deployStatusFailed {
+			failed++
+		}
+		statuses[name] = status
+		results[i] = deployResult{name: name, status: status}
+	}
+	log.Infoln()
+	log.Info(deploySummaryString("Workload", results))
+	if failed != 0 {
+		return fmt.Errorf("%d of %d workloads failed to deploy", failed, len(order))
+	}
+	return nil
+}
+
+func (o *deployOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment(deployEnvPrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// workloadsToDeploy returns the names of the workloads selected with --all or --workloads,
+// and the dependencies of each of them read from their manifests.
+func (o *deployOpts) workloadsToDeploy() ([]string, map[string][]string, error) {
+	svcs, err := o.ws.ServiceNames()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	jobs, err := o.ws.JobNames()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	readers := make(map[string]func(string) ([]byte, error))
+	for _, svc := range svcs {
+		readers[svc] = o.ws.ReadServiceManifest
+	}
+	for _, job := range jobs {
+		readers[job] = o.ws.ReadJobManifest
+	}
+
+	names := append(svcs, jobs...)
+	if !o.all {
+		names = nil
+		for _, name := range o.workloadNames {
+			if _, ok := readers[name]; !ok {
+				return nil, nil, fmt.Errorf("workload %s not found in the workspace", color.HighlightUserInput(name))
+			}
+			if !contains(name, names) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	deps := make(map[string][]string)
+	for _, name := range names {
+		raw, err := readers[name](name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read manifest of %s: %w", name, err)
+		}
+		mft, err := o.unmarshal(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+		}
+		for _, dep := range manifest.WorkloadDependencies(mft) {
+			if _, ok := readers[dep]; !ok {
+				return nil, nil, fmt.Errorf(`"depends_on" of %s: %s is not a service or job in the workspace`, name, dep)
+			}
+			deps[name] = append(deps[name], dep)
+		}
+	}
+	return names, deps, nil
+}
+
+// deploymentOrder returns the workloads sorted so that each one comes after the workloads it depends on,
+// and otherwise in their original order. The dependencies that aren't in names are expected to be deployed already.
+// It returns an error if the workloads depend on each other in a cycle.
+func deploymentOrder(names []string, deps map[string][]string) ([]string, error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+	state := make(map[string]int, len(names))
+	var order, path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			// The cycle goes from the earlier visit of the workload to this one.
+			for i := range path {
+				if path[i] == name {
+					return fmt.Errorf(`"depends_on" forms a cycle: %s`, strings.Join(append(path[i:], name), " -> "))
+				}
+			}
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if !selected[dep] {
+				continue
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		order = append(order, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// buildAndPushImages builds and pushes the images of the workloads, at most limit workloads at a time,
+// and returns the error of each workload that failed.
+func buildAndPushImages(names []string, deployers map[string]wkldDeployer, limit int) map[string]error {
+	errs := make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, limit)
+	for _, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := deployers[name].BuildAndPushImages(); err != nil {
+				mu.Lock()
+				errs[name] = err
+				mu.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+	return errs
+}
+
+// dependencyNotDeployed returns true if a dependency deployed before the workload failed or was skipped.
+func dependencyNotDeployed(deps []string, statuses map[string]string) bool {
+	for _, dep := range deps {
+		if status, ok := statuses[dep]; ok && status != deployStatusDeployed {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *deployOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -145,16 +409,22 @@ func (o *deployOpts) loadWkldCmd() error {
 
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deployVars{}
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
-		Long:  "Deploy a Copilot job or service.",
+		Long: `Deploy a Copilot job or service.
+With --all or --workloads, several services and jobs are deployed to the environment,
+each after the workloads listed in the "depends_on" field of its manifest.`,
 		Example: `
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys every service and job of the workspace to a new "test" environment.
+  /code $ copilot deploy --all --env test
+  Deploys the "api" and "frontend" services.
+  /code $ copilot deploy --workloads api,frontend --env test`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeployOpts(vars)
 			if err != nil {
@@ -170,7 +440,16 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
-	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowLatest, allowLatestFlag, false, allowLatestFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.noWait, noWaitFlag, false, noWaitFlagDescription)
+	cmd.Flags().StringVar(&vars.notifyTopicARN, notifyTopicARNFlag, "", notifyTopicARNFlagDescription)
+	cmd.Flags().StringVar(&vars.buildTool, buildToolFlag, "", buildToolFlagDescription)
+	cmd.Flags().BoolVar(&vars.strict, strictFlag, false, strictFlagDescription)
+	cmd.Flags().IntVar(&vars.maxContextSize, maxContextSizeFlag, 0, maxContextSizeFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAppOverride, allowAppOverrideFlag, false, allowAppOverrideFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloadNames, workloadsFlag, nil, deployWorkloadsFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,100 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+// deploymentRecorder adds the deployments of a workload to its history
+// in the application's S3 bucket of the environment's region.
+type deploymentRecorder struct {
+	keep      int // Number of records kept for the workload in the environment.
+	history   deploymentHistoryWriter
+	resources appResourcesGetter
+	images    imageDigestGetter
+	identity  identityService
+	git       gitCommitReader
+	now       func() time.Time
+}
+
+// workloadDeployment describes a successful deployment of a workload.
+type workloadDeployment struct {
+	app          *config.Application
+	env          *config.Environment
+	name         string
+	imageTag     string // Tag of the image pushed to the workload's repository, empty if it runs an existing image.
+	templateHash string
+}
+
+// newDeploymentRecorder returns a recorder that writes to the history with the default credentials in the environment's region.
+func newDeploymentRecorder(sessEnvRegion *session.Session, resources appResourcesGetter, keep int) *deploymentRecorder {
+	return &deploymentRecorder{
+		keep:      keep,
+		history:   deploy.NewDeploymentHistory(s3.New(sessEnvRegion)),
+		resources: resources,
+		images:    ecr.New(sessEnvRegion),
+		identity:  identity.New(sessEnvRegion),
+		git:       newGitRepo(),
+		now:       time.Now,
+	}
+}
+
+// record adds the deployment to the history of the workload.
+// Failing to record the deployment doesn't fail it, instead a warning is logged.
+func (r *deploymentRecorder) record(d workloadDeployment) {
+	if err := r.write(d); err != nil {
+		log.Warningf("Couldn't record the deployment of %s to %s in its history: %v\n", d.name, d.env.Name, err)
+	}
+}
+
+func (r *deploymentRecorder) write(d workloadDeployment) error {
+	caller, err := r.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	rec := &deploy.WorkloadDeploymentRecord{
+		App:          d.app.Name,
+		Env:          d.env.Name,
+		Workload:     d.name,
+		Timestamp:    r.now().UTC(),
+		ImageTag:     d.imageTag,
+		GitSHA:       r.git.HeadCommit(),
+		DeployerARN:  caller.ARN,
+		TemplateHash: d.templateHash,
+	}
+	if d.imageTag != "" {
+		repoName := fmt.Sprintf("%s/%s", d.app.Name, d.name)
+		digest, err := r.images.ImageDigest(repoName, d.imageTag)
+		if err != nil {
+			return fmt.Errorf("get digest of the deployed image: %w", err)
+		}
+		rec.ImageDigest = digest
+	}
+	resources, err := r.resources.GetAppResourcesByRegion(d.app, d.env.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", d.app.Name, d.env.Region, err)
+	}
+	return r.history.Record(resources.S3Bucket, rec, r.keep)
+}
+
+// defaultDeploymentHistory returns the number of deployment records to keep configured in the workspace summary,
+// or 0 to keep the default number of records.
+func defaultDeploymentHistory(ws *workspace.Workspace) int {
+	summary, err := ws.Summary()
+	if err != nil {
+		return 0
+	}
+	return summary.DeploymentHistory
+}
@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deploymentRecorderMocks struct {
+	history   *mocks.MockdeploymentHistoryWriter
+	resources *mocks.MockappResourcesGetter
+	images    *mocks.MockimageDigestGetter
+	identity  *mocks.MockidentityService
+	git       *mocks.MockgitCommitReader
+}
+
+func TestDeploymentRecorder_write(t *testing.T) {
+	mockNow := time.Date(2020, 11, 5, 19, 50, 30, 0, time.UTC)
+	mockApp := &config.Application{
+		Name: "phonetool",
+	}
+	mockEnv := &config.Environment{
+		Name:   "test",
+		Region: "us-west-2",
+	}
+	testCases := map[string]struct {
+		inImageTag string
+		setupMocks func(m deploymentRecorderMocks)
+
+		wantedErr error
+	}{
+		"records the deployment with the digest of the pushed image": {
+			inImageTag: "v1.2.0",
+			setupMocks: func(m deploymentRecorderMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/alice"}, nil)
+				m.git.EXPECT().HeadCommit().Return("bb133e7")
+				m.images.EXPECT().ImageDigest("phonetool/frontend", "v1.2.0").Return("sha256:18f7eb6cff6e", nil)
+				m.resources.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "bucket",
+				}, nil)
+				m.history.EXPECT().Record("bucket", &deploy.WorkloadDeploymentRecord{
+					App:          "phonetool",
+					Env:          "test",
+					Workload:     "frontend",
+					Timestamp:    mockNow,
+					ImageTag:     "v1.2.0",
+					ImageDigest:  "sha256:18f7eb6cff6e",
+					GitSHA:       "bb133e7",
+					DeployerARN:  "arn:aws:iam::123456789012:user/alice",
+					TemplateHash: "5e884898da28",
+				}, 5).Return(nil)
+			},
+		},
+		"records the deployment without an image": {
+			setupMocks: func(m deploymentRecorderMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/alice"}, nil)
+				m.git.EXPECT().HeadCommit().Return("")
+				m.images.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Times(0)
+				m.resources.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "bucket",
+				}, nil)
+				m.history.EXPECT().Record("bucket", &deploy.WorkloadDeploymentRecord{
+					App:          "phonetool",
+					Env:          "test",
+					Workload:     "frontend",
+					Timestamp:    mockNow,
+					DeployerARN:  "arn:aws:iam::123456789012:user/alice",
+					TemplateHash: "5e884898da28",
+				}, 5).Return(nil)
+			},
+		},
+		"wraps error if the caller identity can't be retrieved": {
+			setupMocks: func(m deploymentRecorderMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("get identity: some error"),
+		},
+		"wraps error if the image digest can't be retrieved": {
+			inImageTag: "v1.2.0",
+			setupMocks: func(m deploymentRecorderMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.git.EXPECT().HeadCommit().Return("")
+				m.images.EXPECT().ImageDigest("phonetool/frontend", "v1.2.0").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get digest of the deployed image: some error"),
+		},
+		"wraps error if the application's resources can't be retrieved": {
+			setupMocks: func(m deploymentRecorderMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.git.EXPECT().HeadCommit().Return("")
+				m.resources.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool resources from region us-west-2: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deploymentRecorderMocks{
+				history:   mocks.NewMockdeploymentHistoryWriter(ctrl),
+				resources: mocks.NewMockappResourcesGetter(ctrl),
+				images:    mocks.NewMockimageDigestGetter(ctrl),
+				identity:  mocks.NewMockidentityService(ctrl),
+				git:       mocks.NewMockgitCommitReader(ctrl),
+			}
+			tc.setupMocks(m)
+			r := &deploymentRecorder{
+				keep:      5,
+				history:   m.history,
+				resources: m.resources,
+				images:    m.images,
+				identity:  m.identity,
+				git:       m.git,
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+
+			// WHEN
+			err := r.write(workloadDeployment{
+				app:          mockApp,
+				env:          mockEnv,
+				name:         "frontend",
+				imageTag:     tc.inImageTag,
+				templateHash: "5e884898da28",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sns"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const snsServiceName = "sns"
+
+// deploymentNotifier publishes an event to an SNS topic after a workload is deployed.
+type deploymentNotifier struct {
+	topicARN string
+	sns      snsPublisher
+	identity identityService
+	stacks   workloadStackIDGetter
+	git      gitCommitReader
+	now      func() time.Time
+}
+
+// newDeploymentNotifier returns a notifier that publishes to the topic with the default credentials in the topic's region.
+func newDeploymentNotifier(sessProvider sessionProvider, topicARN string, stacks workloadStackIDGetter) (*deploymentNotifier, error) {
+	parsed, err := arn.Parse(topicARN)
+	if err != nil {
+		return nil, fmt.Errorf("parse topic ARN %s: %w", topicARN, err)
+	}
+	sess, err := sessProvider.DefaultWithRegion(parsed.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session with region %s: %w", parsed.Region, err)
+	}
+	return &deploymentNotifier{
+		topicARN: topicARN,
+		sns:      sns.New(sess),
+		identity: identity.New(sess),
+		stacks:   stacks,
+		git:      newGitRepo(),
+		now:      time.Now,
+	}, nil
+}
+
+// notify publishes an event for the workload that was just deployed.
+// Failing to publish the event doesn't fail the deployment, instead a warning is logged.
+func (n *deploymentNotifier) notify(vars deployWkldVars) {
+	if err := n.publish(vars); err != nil {
+		log.Warningf("Couldn't publish the deployment event to %s: %v\n", n.topicARN, err)
+		return
+	}
+	log.Successf("Published the deployment event to %s.\n", n.topicARN)
+}
+
+func (n *deploymentNotifier) publish(vars deployWkldVars) error {
+	caller, err := n.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	stackID, err := n.stacks.WorkloadStackID(stack.NameForService(vars.appName, vars.envName, vars.name))
+	if err != nil {
+		return err
+	}
+	status := deploy.WorkloadDeploymentSucceeded
+	if vars.noWait {
+		status = deploy.WorkloadDeploymentStarted
+	}
+	event := &deploy.WorkloadDeploymentEvent{
+		App:         vars.appName,
+		Env:         vars.envName,
+		Workload:    vars.name,
+		ImageTag:    vars.imageTag,
+		GitSHA:      n.git.HeadCommit(),
+		DeployerARN: caller.ARN,
+		StackID:     stackID,
+		Status:      status,
+		Timestamp:   n.now().UTC(),
+	}
+	msg, err := event.JSONString()
+	if err != nil {
+		return err
+	}
+	if _, err := n.sns.Publish(n.topicARN, msg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// defaultNotifyTopicARN returns the topic configured in the workspace summary if the flag isn't set.
+func defaultNotifyTopicARN(flagValue string, ws *workspace.Workspace) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	summary, err := ws.Summary()
+	if err != nil {
+		return ""
+	}
+	return summary.NotifyTopicARN
+}
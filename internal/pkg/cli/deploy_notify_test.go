@@ -0,0 +1,121 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deploymentNotifierMocks struct {
+	sns      *mocks.MocksnsPublisher
+	identity *mocks.MockidentityService
+	stacks   *mocks.MockworkloadStackIDGetter
+	git      *mocks.MockgitCommitReader
+}
+
+func TestDeploymentNotifier_publish(t *testing.T) {
+	const (
+		mockTopicARN = "arn:aws:sns:us-west-2:123456789012:deployments"
+		mockStackID  = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc"
+	)
+	mockNow := time.Date(2020, 11, 5, 19, 50, 30, 0, time.UTC)
+	mockVars := deployWkldVars{
+		appName:  "phonetool",
+		envName:  "test",
+		name:     "frontend",
+		imageTag: "v1.2.0",
+	}
+	testCases := map[string]struct {
+		noWait     bool
+		setupMocks func(m deploymentNotifierMocks)
+
+		wantedErr error
+	}{
+		"publishes a succeeded event": {
+			setupMocks: func(m deploymentNotifierMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:sts::123456789012:assumed-role/ci/session"}, nil)
+				m.stacks.EXPECT().WorkloadStackID("phonetool-test-frontend").Return(mockStackID, nil)
+				m.git.EXPECT().HeadCommit().Return("bb133e7")
+				m.sns.EXPECT().Publish(mockTopicARN, `{"app":"phonetool","env":"test","workload":"frontend","imageTag":"v1.2.0","gitSha":"bb133e7","deployerArn":"arn:aws:sts::123456789012:assumed-role/ci/session","stackId":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc","status":"succeeded","timestamp":"2020-11-05T19:50:30Z"}`).
+					Return("message-id", nil)
+			},
+		},
+		"publishes a started event if the deployment wasn't waited on": {
+			noWait: true,
+			setupMocks: func(m deploymentNotifierMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/alice"}, nil)
+				m.stacks.EXPECT().WorkloadStackID("phonetool-test-frontend").Return(mockStackID, nil)
+				m.git.EXPECT().HeadCommit().Return("")
+				m.sns.EXPECT().Publish(mockTopicARN, `{"app":"phonetool","env":"test","workload":"frontend","imageTag":"v1.2.0","deployerArn":"arn:aws:iam::123456789012:user/alice","stackId":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc","status":"started","timestamp":"2020-11-05T19:50:30Z"}`).
+					Return("message-id", nil)
+			},
+		},
+		"wraps error if the caller identity can't be retrieved": {
+			setupMocks: func(m deploymentNotifierMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("get identity: some error"),
+		},
+		"returns error if the stack can't be described": {
+			setupMocks: func(m deploymentNotifierMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.stacks.EXPECT().WorkloadStackID("phonetool-test-frontend").Return("", errors.New("describe stack phonetool-test-frontend: some error"))
+			},
+			wantedErr: errors.New("describe stack phonetool-test-frontend: some error"),
+		},
+		"returns error if the message can't be published": {
+			setupMocks: func(m deploymentNotifierMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.stacks.EXPECT().WorkloadStackID(gomock.Any()).Return(mockStackID, nil)
+				m.git.EXPECT().HeadCommit().Return("")
+				m.sns.EXPECT().Publish(mockTopicARN, gomock.Any()).Return("", errors.New("publish message to topic deployments: some error"))
+			},
+			wantedErr: errors.New("publish message to topic deployments: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deploymentNotifierMocks{
+				sns:      mocks.NewMocksnsPublisher(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+				stacks:   mocks.NewMockworkloadStackIDGetter(ctrl),
+				git:      mocks.NewMockgitCommitReader(ctrl),
+			}
+			tc.setupMocks(m)
+			n := &deploymentNotifier{
+				topicARN: mockTopicARN,
+				sns:      m.sns,
+				identity: m.identity,
+				stacks:   m.stacks,
+				git:      m.git,
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+			vars := mockVars
+			vars.noWait = tc.noWait
+
+			// WHEN
+			err := n.publish(vars)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
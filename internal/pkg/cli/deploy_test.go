@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +32,7 @@ func TestDeployOpts_Run(t *testing.T) {
 		wantedErr string
 
 		mockSel           func(m *mocks.MockwsSelector)
-		mockActionCommand func(m *mocks.MockactionCommand)
+		mockActionCommand func(m *mocks.MockwkldDeployer)
 		mockStore         func(m *mocks.Mockstore)
 	}{
 		"prompts for workload": {
@@ -39,7 +40,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			mockSel: func(m *mocks.MockwsSelector) {
 				m.EXPECT().Workload("Select a service or job in your workspace", "").Return("fe", nil)
 			},
-			mockActionCommand: func(m *mocks.MockactionCommand) {
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {
 				m.EXPECT().Ask()
 				m.EXPECT().Validate()
 				m.EXPECT().Execute()
@@ -54,7 +55,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			mockSel: func(m *mocks.MockwsSelector) {
 				m.EXPECT().Workload("Select a service or job in your workspace", "").Return("mailer", nil)
 			},
-			mockActionCommand: func(m *mocks.MockactionCommand) {
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {
 				m.EXPECT().Ask().Return(errors.New("some error"))
 			},
 			mockStore: func(m *mocks.Mockstore) {
@@ -66,7 +67,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			inName:    "fe",
 
 			mockSel: func(m *mocks.MockwsSelector) {},
-			mockActionCommand: func(m *mocks.MockactionCommand) {
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {
 				m.EXPECT().Ask()
 				m.EXPECT().Validate()
 				m.EXPECT().Execute()
@@ -81,7 +82,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			mockSel: func(m *mocks.MockwsSelector) {
 				m.EXPECT().Workload(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
-			mockActionCommand: func(m *mocks.MockactionCommand) {},
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {},
 			mockStore:         func(m *mocks.Mockstore) {},
 		},
 		"ask error": {
@@ -90,7 +91,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			wantedErr: "ask svc deploy: some error",
 
 			mockSel: func(m *mocks.MockwsSelector) {},
-			mockActionCommand: func(m *mocks.MockactionCommand) {
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {
 				m.EXPECT().Ask().Return(errors.New("some error"))
 			},
 			mockStore: func(m *mocks.Mockstore) {
@@ -103,7 +104,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			wantedErr: "validate svc deploy: some error",
 
 			mockSel: func(m *mocks.MockwsSelector) {},
-			mockActionCommand: func(m *mocks.MockactionCommand) {
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {
 				m.EXPECT().Ask()
 				m.EXPECT().Validate().Return(errors.New("some error"))
 			},
@@ -117,7 +118,7 @@ func TestDeployOpts_Run(t *testing.T) {
 			wantedErr: "execute svc deploy: some error",
 
 			mockSel: func(m *mocks.MockwsSelector) {},
-			mockActionCommand: func(m *mocks.MockactionCommand) {
+			mockActionCommand: func(m *mocks.MockwkldDeployer) {
 				m.EXPECT().Ask()
 				m.EXPECT().Validate()
 				m.EXPECT().Execute().Return(errors.New("some error"))
@@ -134,16 +135,18 @@ func TestDeployOpts_Run(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockSel := mocks.NewMockwsSelector(ctrl)
-			mockCmd := mocks.NewMockactionCommand(ctrl)
+			mockCmd := mocks.NewMockwkldDeployer(ctrl)
 			mockStore := mocks.NewMockstore(ctrl)
 			tc.mockStore(mockStore)
 			tc.mockSel(mockSel)
 			tc.mockActionCommand(mockCmd)
 			opts := &deployOpts{
-				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					envName: "test",
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						name:    tc.inName,
+						envName: "test",
+					},
 				},
 				deployWkld: mockCmd,
 				sel:        mockSel,
@@ -162,3 +165,256 @@ func TestDeployOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployOpts_RunWorkloads(t *testing.T) {
+	manifests := map[string]interface{}{
+		"db":     &manifest.BackendService{},
+		"api":    &manifest.BackendService{Workload: manifest.Workload{DependsOn: []string{"db"}}},
+		"fe":     &manifest.LoadBalancedWebService{Workload: manifest.Workload{DependsOn: []string{"api"}}},
+		"mailer": &manifest.ScheduledJob{},
+	}
+	testCases := map[string]struct {
+		inName          string
+		inEnvName       string
+		inAll           bool
+		inWorkloadNames []string
+		inNoWait        bool
+		manifests       map[string]interface{}
+
+		setupMocks func(sel *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, deployers map[string]*mocks.MockwkldDeployer)
+
+		wantedErr string
+	}{
+		"error if both --all and --workloads are set": {
+			inAll:           true,
+			inWorkloadNames: []string{"fe"},
+			setupMocks:      func(_ *mocks.MockwsSelector, _ *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {},
+			wantedErr:       "--all cannot be used with --workloads",
+		},
+		"error if --name is set with --all": {
+			inName:     "fe",
+			inAll:      true,
+			setupMocks: func(_ *mocks.MockwsSelector, _ *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {},
+			wantedErr:  "--name cannot be used with --all or --workloads",
+		},
+		"error if --no-wait is set with --workloads": {
+			inWorkloadNames: []string{"fe"},
+			inNoWait:        true,
+			setupMocks:      func(_ *mocks.MockwsSelector, _ *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {},
+			wantedErr:       "--no-wait cannot be used with --all or --workloads",
+		},
+		"wraps error if fail to select the environment": {
+			inAll: true,
+			setupMocks: func(sel *mocks.MockwsSelector, _ *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {
+				sel.EXPECT().Environment(deployEnvPrompt, "", "app").Return("", errors.New("some error"))
+			},
+			wantedErr: "select environment: some error",
+		},
+		"error if a workload isn't in the workspace": {
+			inEnvName:       "test",
+			inWorkloadNames: []string{"fe", "worker"},
+			setupMocks: func(_ *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {
+				ws.EXPECT().ServiceNames().Return([]string{"fe", "api", "db"}, nil)
+				ws.EXPECT().JobNames().Return([]string{"mailer"}, nil)
+			},
+			wantedErr: "workload worker not found in the workspace",
+		},
+		"error if a dependency isn't in the workspace": {
+			inEnvName:       "test",
+			inWorkloadNames: []string{"fe"},
+			setupMocks: func(_ *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {
+				ws.EXPECT().ServiceNames().Return([]string{"fe"}, nil)
+				ws.EXPECT().JobNames().Return(nil, nil)
+				ws.EXPECT().ReadServiceManifest("fe").Return([]byte("fe"), nil)
+			},
+			wantedErr: `"depends_on" of fe: api is not a service or job in the workspace`,
+		},
+		"error if the dependencies form a cycle": {
+			inEnvName: "test",
+			inAll:     true,
+			manifests: map[string]interface{}{
+				"fe":  &manifest.LoadBalancedWebService{Workload: manifest.Workload{DependsOn: []string{"api"}}},
+				"api": &manifest.BackendService{Workload: manifest.Workload{DependsOn: []string{"fe"}}},
+			},
+			setupMocks: func(_ *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, _ map[string]*mocks.MockwkldDeployer) {
+				ws.EXPECT().ServiceNames().Return([]string{"fe", "api"}, nil)
+				ws.EXPECT().JobNames().Return(nil, nil)
+				ws.EXPECT().ReadServiceManifest("fe").Return([]byte("fe"), nil)
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+			},
+			wantedErr: `"depends_on" forms a cycle: fe -> api -> fe`,
+		},
+		"deploys every workload after its dependencies": {
+			inEnvName: "test",
+			inAll:     true,
+			setupMocks: func(_ *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, deployers map[string]*mocks.MockwkldDeployer) {
+				ws.EXPECT().ServiceNames().Return([]string{"fe", "api", "db"}, nil)
+				ws.EXPECT().JobNames().Return([]string{"mailer"}, nil)
+				for _, name := range []string{"fe", "api", "db"} {
+					ws.EXPECT().ReadServiceManifest(name).Return([]byte(name), nil)
+				}
+				ws.EXPECT().ReadJobManifest("mailer").Return([]byte("mailer"), nil)
+				for _, d := range deployers {
+					d.EXPECT().Ask()
+					d.EXPECT().Validate()
+					d.EXPECT().BuildAndPushImages()
+				}
+				gomock.InOrder(
+					deployers["db"].EXPECT().Execute(),
+					deployers["api"].EXPECT().Execute(),
+					deployers["fe"].EXPECT().Execute(),
+					deployers["mailer"].EXPECT().Execute(),
+				)
+			},
+		},
+		"skips the workloads that depend on a failed deployment": {
+			inEnvName:       "test",
+			inWorkloadNames: []string{"fe", "api", "db", "mailer", "fe"},
+			setupMocks: func(_ *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, deployers map[string]*mocks.MockwkldDeployer) {
+				ws.EXPECT().ServiceNames().Return([]string{"fe", "api", "db"}, nil)
+				ws.EXPECT().JobNames().Return([]string{"mailer"}, nil)
+				for _, name := range []string{"fe", "api", "db"} {
+					ws.EXPECT().ReadServiceManifest(name).Return([]byte(name), nil)
+				}
+				ws.EXPECT().ReadJobManifest("mailer").Return([]byte("mailer"), nil)
+				for _, d := range deployers {
+					d.EXPECT().Ask()
+					d.EXPECT().Validate()
+				}
+				deployers["db"].EXPECT().BuildAndPushImages()
+				deployers["db"].EXPECT().Execute()
+				deployers["api"].EXPECT().BuildAndPushImages().Return(errors.New("some error"))
+				deployers["fe"].EXPECT().BuildAndPushImages()
+				deployers["mailer"].EXPECT().BuildAndPushImages()
+				deployers["mailer"].EXPECT().Execute()
+			},
+			wantedErr: "1 of 4 workloads failed to deploy",
+		},
+		"deploys the selected workloads and ignores their other dependencies": {
+			inEnvName:       "test",
+			inWorkloadNames: []string{"fe"},
+			setupMocks: func(_ *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, deployers map[string]*mocks.MockwkldDeployer) {
+				ws.EXPECT().ServiceNames().Return([]string{"fe", "api", "db"}, nil)
+				ws.EXPECT().JobNames().Return([]string{"mailer"}, nil)
+				ws.EXPECT().ReadServiceManifest("fe").Return([]byte("fe"), nil)
+				deployers["fe"].EXPECT().Ask()
+				deployers["fe"].EXPECT().Validate()
+				deployers["fe"].EXPECT().BuildAndPushImages()
+				deployers["fe"].EXPECT().Execute()
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockWs := mocks.NewMockwsWlDirReader(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetWorkload("app", gomock.Any()).DoAndReturn(func(app, name string) (*config.Workload, error) {
+				return &config.Workload{App: app, Name: name, Type: "Backend Service"}, nil
+			}).AnyTimes()
+			mfts := manifests
+			if tc.manifests != nil {
+				mfts = tc.manifests
+			}
+			deployers := make(map[string]*mocks.MockwkldDeployer)
+			for name := range mfts {
+				deployers[name] = mocks.NewMockwkldDeployer(ctrl)
+			}
+			tc.setupMocks(mockSel, mockWs, deployers)
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						name:    tc.inName,
+						envName: tc.inEnvName,
+						noWait:  tc.inNoWait,
+					},
+					all:           tc.inAll,
+					workloadNames: tc.inWorkloadNames,
+				},
+				sel:   mockSel,
+				store: mockStore,
+				ws:    mockWs,
+				unmarshal: func(in []byte) (interface{}, error) {
+					return mfts[string(in)], nil
+				},
+				setupDeployCmd: func(o *deployOpts, wlType string) {
+					o.deployWkld = deployers[o.name]
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeploymentOrder(t *testing.T) {
+	testCases := map[string]struct {
+		names []string
+		deps  map[string][]string
+
+		wanted    []string
+		wantedErr string
+	}{
+		"keeps the order of workloads without dependencies": {
+			names:  []string{"fe", "api", "mailer"},
+			wanted: []string{"fe", "api", "mailer"},
+		},
+		"deploys dependencies first": {
+			names: []string{"fe", "api", "db", "mailer"},
+			deps: map[string][]string{
+				"fe":     {"api"},
+				"api":    {"db"},
+				"mailer": {"db"},
+			},
+			wanted: []string{"db", "api", "fe", "mailer"},
+		},
+		"ignores dependencies that aren't deployed": {
+			names: []string{"fe", "mailer"},
+			deps: map[string][]string{
+				"fe": {"api"},
+			},
+			wanted: []string{"fe", "mailer"},
+		},
+		"error if the dependencies form a cycle": {
+			names: []string{"mailer", "fe", "api", "db"},
+			deps: map[string][]string{
+				"fe":  {"api"},
+				"api": {"db"},
+				"db":  {"fe"},
+			},
+			wantedErr: `"depends_on" forms a cycle: fe -> api -> db -> fe`,
+		},
+		"error if a workload depends on itself": {
+			names: []string{"fe"},
+			deps: map[string][]string{
+				"fe": {"fe"},
+			},
+			wantedErr: `"depends_on" forms a cycle: fe -> fe`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := deploymentOrder(tc.names, tc.deps)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
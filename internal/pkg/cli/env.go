@@ -24,6 +24,7 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvDeleteCmd())
 	cmd.AddCommand(buildEnvShowCmd())
 	cmd.AddCommand(buildEnvUpgradeCmd())
+	cmd.AddCommand(buildEnvUseCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
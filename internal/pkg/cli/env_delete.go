@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -32,20 +33,31 @@ const (
 	fmtDeleteEnvStart    = "Deleting environment %s from application %s."
 	fmtDeleteEnvFailed   = "Failed to delete environment %s from application %s.\n"
 	fmtDeleteEnvComplete = "Deleted environment %s from application %s.\n"
+
+	fmtCleanupAppStart    = "Removing unused resources of environment %s from application %s."
+	fmtCleanupAppFailed   = "Failed to remove unused resources of environment %s from application %s.\n"
+	fmtCleanupAppComplete = "Removed unused resources of environment %s from application %s.\n"
+
+	fmtRevokeDNSDelegationStart    = "Revoking DNS permissions of application %s from account %s."
+	fmtRevokeDNSDelegationFailed   = "Failed to revoke DNS permissions of application %s from account %s.\n"
+	fmtRevokeDNSDelegationComplete = "Revoked DNS permissions of application %s from account %s.\n"
 )
 
 var (
 	errEnvDeleteCancelled = errors.New("env delete cancelled - no changes made")
 )
 
+// resourceGetter returns the resources matching the filters of the input from all the pages of results.
 type resourceGetter interface {
 	GetResources(*resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error)
 }
 
 type deleteEnvVars struct {
-	appName          string
-	name             string
-	skipConfirmation bool
+	appName           string
+	name              string
+	skipConfirmation  bool
+	appsCleanup       bool
+	keepDNSDelegation bool
 }
 
 type deleteEnvOpts struct {
@@ -53,6 +65,9 @@ type deleteEnvOpts struct {
 
 	// Interfaces for dependencies.
 	store    environmentStore
+	appStore applicationGetter
+	appCFN   envRemoverFromApp
+	appDNS   dnsDelegationRevoker
 	rg       resourceGetter
 	deployer environmentDeployer
 	iam      roleDeleter
@@ -62,6 +77,8 @@ type deleteEnvOpts struct {
 
 	// cached data to avoid fetching the same information multiple times.
 	envConfig *config.Environment
+	appConfig *config.Application
+	appRegion string // Region of the default session, where the application is mastered.
 
 	// initRuntimeClients is overriden in tests.
 	initRuntimeClients func(*deleteEnvOpts) error
@@ -73,14 +90,23 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 		return nil, fmt.Errorf("connect to copilot config store: %w", err)
 	}
 
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
 	prompter := prompt.New()
 	return &deleteEnvOpts{
 		deleteEnvVars: vars,
 
-		store:  store,
-		prog:   termprogress.NewSpinner(),
-		sel:    selector.NewConfigSelect(prompter, store),
-		prompt: prompter,
+		store:     store,
+		appStore:  store,
+		appCFN:    cloudformation.New(defaultSession),
+		appDNS:    cloudformation.New(defaultSession),
+		appRegion: aws.StringValue(defaultSession.Config.Region),
+		prog:      termprogress.NewSpinner(),
+		sel:       selector.NewConfigSelect(prompter, store),
+		prompt:    prompter,
 
 		initRuntimeClients: func(o *deleteEnvOpts) error {
 			env, err := o.getEnvConfig()
@@ -91,7 +117,7 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 			if err != nil {
 				return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 			}
-			o.rg = resourcegroupstaggingapi.New(sess)
+			o.rg = resourcegroups.New(sess)
 			o.iam = iam.New(sess)
 			o.deployer = cloudformation.New(sess)
 			return nil
@@ -132,7 +158,11 @@ func (o *deleteEnvOpts) Ask() error {
 // 1. Deleting the cloudformation stack.
 // 2. Deleting the EnvManagerRole and CFNExecutionRole.
 // 3. Deleting the parameter from the SSM store.
+// 4. Revoking the application's DNS delegation from the environment's account if no other environment is deployed there,
+// unless --keep-dns-delegation is set.
+// 5. If --apps-cleanup is set, removing the environment's account and region from the application stack set.
 // The environment is removed from the store only if other delete operations succeed.
+// Each step succeeds if its resource was already deleted, so that the command can be re-run after a partial failure.
 // Execute assumes that Validate is invoked first.
 func (o *deleteEnvOpts) Execute() error {
 	if err := o.initRuntimeClients(o); err != nil {
@@ -161,7 +191,16 @@ func (o *deleteEnvOpts) Execute() error {
 		return err
 	}
 	o.prog.Stop(log.Ssuccessf(fmtDeleteEnvComplete, o.name, o.appName))
-	return nil
+
+	if !o.keepDNSDelegation {
+		if err := o.revokeDNSDelegation(); err != nil {
+			return err
+		}
+	}
+	if !o.appsCleanup {
+		return nil
+	}
+	return o.removeEnvFromApp()
 }
 
 // RecommendedActions is a no-op for this command.
@@ -275,39 +314,148 @@ func (o *deleteEnvOpts) ensureRolesAreRetained() error {
 	return nil
 }
 
-// deleteStack returns nil if the stack was deleted successfully. Otherwise, returns the error.
+// deleteStack returns nil if the stack was deleted successfully or if it doesn't exist. Otherwise, returns the error.
 func (o *deleteEnvOpts) deleteStack() error {
 	env, err := o.getEnvConfig()
 	if err != nil {
 		return err
 	}
 	if err := o.deployer.DeleteEnvironment(o.appName, o.name, env.ExecutionRoleARN); err != nil {
+		var stackDoesNotExist *awscfn.ErrStackNotFound
+		if errors.As(err, &stackDoesNotExist) {
+			return nil
+		}
 		return fmt.Errorf("delete environment %s stack: %w", o.name, err)
 	}
 	return nil
 }
 
+// deleteRoles deletes the CFNExecutionRole and then the EnvManagerRole. Roles that don't exist are skipped.
 func (o *deleteEnvOpts) deleteRoles() error {
 	env, err := o.getEnvConfig()
 	if err != nil {
 		return err
 	}
-	if err := o.iam.DeleteRole(env.ExecutionRoleARN); err != nil {
-		return fmt.Errorf("delete role %s: %w", env.ExecutionRoleARN, err)
-	}
-	if err := o.iam.DeleteRole(env.ManagerRoleARN); err != nil {
-		return fmt.Errorf("delete role %s: %w", env.ManagerRoleARN, err)
+	for _, role := range []string{env.ExecutionRoleARN, env.ManagerRoleARN} {
+		if err := o.iam.DeleteRole(role); err != nil {
+			if isNoSuchEntityErr(err) {
+				continue
+			}
+			return fmt.Errorf("delete role %s: %w", role, err)
+		}
 	}
 	return nil
 }
 
 func (o *deleteEnvOpts) deleteFromStore() error {
 	if err := o.store.DeleteEnvironment(o.appName, o.name); err != nil {
-		return fmt.Errorf("delete environment %s configuration from application %s", o.name, o.appName)
+		var errNoSuchEnv *config.ErrNoSuchEnvironment
+		if errors.As(err, &errNoSuchEnv) {
+			return nil
+		}
+		return fmt.Errorf("delete environment %s configuration from application %s: %w", o.name, o.appName, err)
+	}
+	return nil
+}
+
+// removeEnvFromApp removes the environment's account and region from the application stack set
+// if no other environment of the application is deployed there.
+func (o *deleteEnvOpts) removeEnvFromApp() error {
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	app, err := o.getAppConfig()
+	if err != nil {
+		return err
+	}
+	remainingEnvs, err := o.remainingEnvs()
+	if err != nil {
+		return err
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtCleanupAppStart, o.name, o.appName))
+	if err := o.appCFN.RemoveEnvFromApp(&deploy.RemoveEnvFromAppInput{
+		App:           app,
+		Env:           env,
+		RemainingEnvs: remainingEnvs,
+		AppRegion:     o.appRegion,
+	}); err != nil {
+		if !isStackSetNotExistsErr(err) {
+			o.prog.Stop(log.Serrorf(fmtCleanupAppFailed, o.name, o.appName))
+			return err
+		}
 	}
+	o.prog.Stop(log.Ssuccessf(fmtCleanupAppComplete, o.name, o.appName))
 	return nil
 }
 
+// revokeDNSDelegation revokes the application's DNS delegation from the environment's account
+// if the environment was the last one of the application deployed in that account.
+// The NS records of the environment's subdomain are removed by the environment stack on deletion.
+func (o *deleteEnvOpts) revokeDNSDelegation() error {
+	app, err := o.getAppConfig()
+	if err != nil {
+		return err
+	}
+	if !app.RequiresDNSDelegation() {
+		return nil
+	}
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	if env.AccountID == app.AccountID {
+		return nil
+	}
+	remainingEnvs, err := o.remainingEnvs()
+	if err != nil {
+		return err
+	}
+	for _, e := range remainingEnvs {
+		if e.AccountID == env.AccountID {
+			return nil
+		}
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtRevokeDNSDelegationStart, o.appName, env.AccountID))
+	if err := o.appDNS.RevokeDNSPermissions(app, env.AccountID); err != nil {
+		o.prog.Stop(log.Serrorf(fmtRevokeDNSDelegationFailed, o.appName, env.AccountID))
+		return fmt.Errorf("revoke DNS delegation of application %s from account %s: %w", o.appName, env.AccountID, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtRevokeDNSDelegationComplete, o.appName, env.AccountID))
+	return nil
+}
+
+// remainingEnvs returns the environments of the application other than the one being deleted.
+func (o *deleteEnvOpts) remainingEnvs() ([]*config.Environment, error) {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var remainingEnvs []*config.Environment
+	for _, e := range envs {
+		if e.Name == o.name {
+			continue
+		}
+		remainingEnvs = append(remainingEnvs, e)
+	}
+	return remainingEnvs, nil
+}
+
+func (o *deleteEnvOpts) getAppConfig() (*config.Application, error) {
+	if o.appConfig != nil {
+		// Already fetched once, return.
+		return o.appConfig, nil
+	}
+	app, err := o.appStore.GetApplication(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	o.appConfig = app
+	return app, nil
+}
+
 func (o *deleteEnvOpts) getEnvConfig() (*config.Environment, error) {
 	if o.envConfig != nil {
 		// Already fetched once, return.
@@ -332,7 +480,13 @@ func buildEnvDeleteCmd() *cobra.Command {
   /code $ copilot env delete --name test
 
   Delete the "test" environment without prompting.
-  /code $ copilot env delete --name test --yes`,
+  /code $ copilot env delete --name test --yes
+
+  Delete the "test" environment and the application resources in its account and region if no other environment uses them.
+  /code $ copilot env delete --name test --apps-cleanup
+
+  Delete the "test" environment but keep the application's DNS delegation to its account.
+  /code $ copilot env delete --name test --keep-dns-delegation`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteEnvOpts(vars)
 			if err != nil {
@@ -350,5 +504,7 @@ func buildEnvDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.appsCleanup, appsCleanupFlag, false, appsCleanupFlagDescription)
+	cmd.Flags().BoolVar(&vars.keepDNSDelegation, keepDNSDelegationFlag, false, keepDNSDelegationFlagDescription)
 	return cmd
 }
@@ -4,17 +4,22 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -350,6 +355,263 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 
 				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
 
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:        rg,
+					deployer:  deployer,
+					prog:      prog,
+					iam:       iam,
+					store:     store,
+					appConfig: &config.Application{Name: "phonetool"},
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"completes when the stack was already deleted": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any())
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(&awscfn.ErrStackNotFound{})
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole("execARN").Return(nil)
+				iam.EXPECT().DeleteRole("managerRoleARN").Return(nil)
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:        rg,
+					deployer:  deployer,
+					prog:      prog,
+					iam:       iam,
+					store:     store,
+					appConfig: &config.Application{Name: "phonetool"},
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"completes when the stack and roles were already deleted and only the configuration is left": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any())
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(&awscfn.ErrStackNotFound{})
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				notFound := awserr.New("NoSuchEntity", "The role cannot be found.", nil)
+				iam.EXPECT().DeleteRole("execARN").Return(fmt.Errorf("delete role named execRole: %w", notFound))
+				iam.EXPECT().DeleteRole("managerRoleARN").Return(fmt.Errorf("delete role named managerRole: %w", notFound))
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:        rg,
+					deployer:  deployer,
+					prog:      prog,
+					iam:       iam,
+					store:     store,
+					appConfig: &config.Application{Name: "phonetool"},
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"completes when the configuration was already deleted": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any())
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole("execARN").Return(nil)
+				iam.EXPECT().DeleteRole("managerRoleARN").Return(nil)
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(&config.ErrNoSuchEnvironment{
+					ApplicationName: "phonetool",
+					EnvironmentName: "test",
+				})
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:        rg,
+					deployer:  deployer,
+					prog:      prog,
+					iam:       iam,
+					store:     store,
+					appConfig: &config.Application{Name: "phonetool"},
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"removes the environment from the application with --apps-cleanup": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start("Deleting environment test from application phonetool.")
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole("execARN").Return(nil)
+				iam.EXPECT().DeleteRole("managerRoleARN").Return(nil)
+
+				testEnv := &config.Environment{
+					Name:             "test",
+					AccountID:        "2222",
+					Region:           "us-west-2",
+					ExecutionRoleARN: "execARN",
+					ManagerRoleARN:   "managerRoleARN",
+				}
+				prodEnv := &config.Environment{
+					Name:      "prod",
+					AccountID: "1111",
+					Region:    "us-east-1",
+				}
+				app := &config.Application{
+					Name:      "phonetool",
+					AccountID: "1111",
+				}
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{prodEnv}, nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				appStore := mocks.NewMockapplicationGetter(ctrl)
+				appStore.EXPECT().GetApplication("phonetool").Return(app, nil)
+
+				prog.EXPECT().Start("Removing unused resources of environment test from application phonetool.")
+				appCFN := mocks.NewMockenvRemoverFromApp(ctrl)
+				appCFN.EXPECT().RemoveEnvFromApp(&deploy.RemoveEnvFromAppInput{
+					App:           app,
+					Env:           testEnv,
+					RemainingEnvs: []*config.Environment{prodEnv},
+					AppRegion:     "us-east-1",
+				}).Return(nil)
+				prog.EXPECT().Stop(log.Ssuccess("Removed unused resources of environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName:     "phonetool",
+						name:        "test",
+						appsCleanup: true,
+					},
+					rg:                 rg,
+					deployer:           deployer,
+					prog:               prog,
+					iam:                iam,
+					store:              store,
+					appStore:           appStore,
+					appCFN:             appCFN,
+					appRegion:          "us-east-1",
+					envConfig:          testEnv,
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"revokes DNS delegation if the environment was the last one in its account": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start("Deleting environment test from application phonetool.")
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole(gomock.Any()).Return(nil).Times(2)
+
+				app := &config.Application{
+					Name:      "phonetool",
+					AccountID: "1111",
+					Domain:    "example.com",
+				}
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{
+						Name:      "prod",
+						AccountID: "1111",
+					},
+				}, nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				appStore := mocks.NewMockapplicationGetter(ctrl)
+				appStore.EXPECT().GetApplication("phonetool").Return(app, nil)
+
+				prog.EXPECT().Start("Revoking DNS permissions of application phonetool from account 2222.")
+				appDNS := mocks.NewMockdnsDelegationRevoker(ctrl)
+				appDNS.EXPECT().RevokeDNSPermissions(app, "2222").Return(nil)
+				prog.EXPECT().Stop(log.Ssuccess("Revoked DNS permissions of application phonetool from account 2222.\n"))
+
 				return &deleteEnvOpts{
 					deleteEnvVars: deleteEnvVars{
 						appName: "phonetool",
@@ -360,7 +622,11 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 					prog:     prog,
 					iam:      iam,
 					store:    store,
+					appStore: appStore,
+					appDNS:   appDNS,
 					envConfig: &config.Environment{
+						Name:             "test",
+						AccountID:        "2222",
 						ExecutionRoleARN: "execARN",
 						ManagerRoleARN:   "managerRoleARN",
 					},
@@ -368,6 +634,211 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"keeps DNS delegation if another environment is deployed in the same account": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start("Deleting environment test from application phonetool.")
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole(gomock.Any()).Return(nil).Times(2)
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{
+						Name:      "test",
+						AccountID: "2222",
+					},
+					{
+						Name:      "staging",
+						AccountID: "2222",
+					},
+				}, nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:       rg,
+					deployer: deployer,
+					prog:     prog,
+					iam:      iam,
+					store:    store,
+					appDNS:   mocks.NewMockdnsDelegationRevoker(ctrl),
+					appConfig: &config.Application{
+						Name:      "phonetool",
+						AccountID: "1111",
+						Domain:    "example.com",
+					},
+					envConfig: &config.Environment{
+						Name:             "test",
+						AccountID:        "2222",
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"keeps DNS delegation with --keep-dns-delegation": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start("Deleting environment test from application phonetool.")
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole(gomock.Any()).Return(nil).Times(2)
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName:           "phonetool",
+						name:              "test",
+						keepDNSDelegation: true,
+					},
+					rg:       rg,
+					deployer: deployer,
+					prog:     prog,
+					iam:      iam,
+					store:    store,
+					appStore: mocks.NewMockapplicationGetter(ctrl),
+					appDNS:   mocks.NewMockdnsDelegationRevoker(ctrl),
+					envConfig: &config.Environment{
+						Name:             "test",
+						AccountID:        "2222",
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"returns wrapped error when DNS delegation cannot be revoked": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any()).Times(2)
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole(gomock.Any()).Return(nil).Times(2)
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				appDNS := mocks.NewMockdnsDelegationRevoker(ctrl)
+				appDNS.EXPECT().RevokeDNSPermissions(gomock.Any(), "2222").Return(errors.New("some error"))
+				prog.EXPECT().Stop(log.Serror("Failed to revoke DNS permissions of application phonetool from account 2222.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:       rg,
+					deployer: deployer,
+					prog:     prog,
+					iam:      iam,
+					store:    store,
+					appDNS:   appDNS,
+					appConfig: &config.Application{
+						Name:      "phonetool",
+						AccountID: "1111",
+						Domain:    "example.com",
+					},
+					envConfig: &config.Environment{
+						Name:             "test",
+						AccountID:        "2222",
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+			wantedError: errors.New("revoke DNS delegation of application phonetool from account 2222: some error"),
+		},
+		"returns error when the environment cannot be removed from the application": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any()).Times(2)
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("", &awscfn.ErrStackNotFound{})
+				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+
+				iam := mocks.NewMockroleDeleter(ctrl)
+				iam.EXPECT().DeleteRole(gomock.Any()).Return(nil).Times(2)
+
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+
+				prog.EXPECT().Stop(log.Ssuccess("Deleted environment test from application phonetool.\n"))
+
+				appStore := mocks.NewMockapplicationGetter(ctrl)
+				appStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+
+				appCFN := mocks.NewMockenvRemoverFromApp(ctrl)
+				appCFN.EXPECT().RemoveEnvFromApp(gomock.Any()).Return(errors.New("some error"))
+				prog.EXPECT().Stop(log.Serror("Failed to remove unused resources of environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName:     "phonetool",
+						name:        "test",
+						appsCleanup: true,
+					},
+					rg:       rg,
+					deployer: deployer,
+					prog:     prog,
+					iam:      iam,
+					store:    store,
+					appStore: appStore,
+					appCFN:   appCFN,
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+						ManagerRoleARN:   "managerRoleARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+			wantedError: errors.New("some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -389,3 +860,65 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteEnvOpts_Execute_Quiet(t *testing.T) {
+	// GIVEN
+	cmd := &cobra.Command{}
+	AddGlobalFlags(cmd)
+	require.NoError(t, cmd.ParseFlags([]string{"--quiet"}))
+	ApplyGlobalFlags()
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	defaultOutputWriter, defaultDiagnosticWriter := log.OutputWriter, log.DiagnosticWriter
+	log.OutputWriter, log.DiagnosticWriter = stdout, stderr
+	defer func() {
+		globalVars.quiet = false
+		log.SetQuiet(false)
+		log.OutputWriter, log.DiagnosticWriter = defaultOutputWriter, defaultDiagnosticWriter
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rg := mocks.NewMockresourceGetter(ctrl)
+	rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+	deployer := mocks.NewMockenvironmentDeployer(ctrl)
+	deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return(`
+  CloudformationExecutionRole:
+    DeletionPolicy: Retain
+  EnvironmentManagerRole:
+    DeletionPolicy: Retain`, nil)
+	deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
+	iam := mocks.NewMockroleDeleter(ctrl)
+	iam.EXPECT().DeleteRole("execARN").Return(nil)
+	iam.EXPECT().DeleteRole("managerRoleARN").Return(nil)
+	store := mocks.NewMockenvironmentStore(ctrl)
+	store.EXPECT().DeleteEnvironment("phonetool", "test").Return(nil)
+
+	opts := &deleteEnvOpts{
+		deleteEnvVars: deleteEnvVars{
+			appName:          "phonetool",
+			name:             "test",
+			skipConfirmation: true,
+		},
+		rg:        rg,
+		deployer:  deployer,
+		prog:      termprogress.NewSpinner(),
+		iam:       iam,
+		store:     store,
+		appConfig: &config.Application{Name: "phonetool"},
+		envConfig: &config.Environment{
+			ExecutionRoleARN: "execARN",
+			ManagerRoleARN:   "managerRoleARN",
+		},
+		initRuntimeClients: noopInitRuntimeClients,
+	}
+
+	// WHEN
+	require.NoError(t, opts.Ask())
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	require.Empty(t, stdout.String())
+	require.Empty(t, stderr.String())
+}
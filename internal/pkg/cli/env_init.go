@@ -4,18 +4,24 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
+	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -25,8 +31,10 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/mod/semver"
 )
 
 const (
@@ -37,9 +45,13 @@ const (
     - A new ECS Cluster
     - New IAM Roles to manage services and jobs in your environment
 `
-	envInitVPCSelectPrompt            = "Which VPC would you like to use?"
-	envInitPublicSubnetsSelectPrompt  = "Which public subnets would you like to use?"
-	envInitPrivateSubnetsSelectPrompt = "Which private subnets would you like to use?"
+	envInitVPCSelectPrompt             = "Which VPC would you like to use?"
+	envInitPublicSubnetsSelectPrompt   = "Which public subnets would you like to use?"
+	envInitPrivateSubnetsSelectPrompt  = "Which private subnets would you like to use?"
+	envInitSecurityGroupsConfirmPrompt = "Would you like to attach existing security groups to all services?"
+	envInitSecurityGroupsConfirmHelp   = `The security groups are attached to the tasks of every service in the environment
+in addition to the security group that Copilot creates, for example to enforce mandatory egress rules.`
+	envInitSecurityGroupsSelectPrompt = "Which security groups would you like to attach?"
 
 	envInitVPCCIDRPrompt         = "What VPC CIDR would you like to use?"
 	envInitVPCCIDRPromptHelp     = "CIDR used for your VPC. For example: 10.1.0.0/16"
@@ -47,11 +59,22 @@ const (
 	envInitPublicCIDRPromptHelp  = "CIDRs used for your public subnets. For example: 10.1.0.0/24,10.1.1.0/24"
 	envInitPrivateCIDRPrompt     = "What CIDR would you like to use for your private subnets?"
 	envInitPrivateCIDRPromptHelp = "CIDRs used for your private subnets. For example: 10.1.2.0/24,10.1.3.0/24"
+	envInitAZsPrompt             = "Which availability zones would you like to use?"
+	envInitAZsPromptHelp         = `Availability zones to spread the subnets of the environment across, with one public and one private subnet in each zone.
+The load balancer of the environment requires at least two zones.`
+
+	envInitEnableIPv6Prompt     = "Would you like to enable IPv6 for your environment?"
+	envInitEnableIPv6PromptHelp = `Copilot will associate an IPv6 CIDR block with the VPC and subnets of the environment
+and create a dualstack public load balancer, so that your services can receive IPv6 traffic.`
+	envInitEnableAccessLogsPrompt     = "Would you like to store the access logs of your load balancer in S3?"
+	envInitEnableAccessLogsPromptHelp = `Copilot will create an S3 bucket with the environment and store the access logs of its public load balancer in it.
+The bucket is retained when the environment is deleted.`
 
 	fmtEnvInitCredsPrompt  = "Which credentials would you like to use to create %s?"
 	envInitCredsHelpPrompt = `The credentials are used to create your environment in an AWS account and region.
 To learn more:
 https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
+	envInitCredsFromEnvVars    = "Using the credentials in the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.\n"
 	envInitRegionPrompt        = "Which region?"
 	envInitDefaultRegionOption = "us-west-2"
 
@@ -67,11 +90,17 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n\n"
+	fmtStackInstanceProgress = "Application resources in region %s of account %s"
 )
 
+// minAZs is the minimum number of availability zones an environment can use, since its load balancer requires two.
+const minAZs = 2
+
+const acmServiceName = "acm"
+
 var (
 	envInitDefaultConfigSelectOption      = "Yes, use default."
-	envInitAdjustEnvResourcesSelectOption = "Yes, but I'd like configure the default resources (CIDR ranges)."
+	envInitAdjustEnvResourcesSelectOption = "Yes, but I'd like configure the default resources (CIDR ranges, AZs)."
 	envInitImportEnvResourcesSelectOption = "No, I'd like to import existing resources (VPC, subnets)."
 	envInitCustomizedEnvTypes             = []string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption, envInitImportEnvResourcesSelectOption}
 )
@@ -80,26 +109,66 @@ type importVPCVars struct {
 	ID               string
 	PublicSubnetIDs  []string
 	PrivateSubnetIDs []string
+	SecurityGroupIDs []string
 }
 
 func (v importVPCVars) isSet() bool {
 	if v.ID != "" {
 		return true
 	}
-	return len(v.PublicSubnetIDs) > 0 || len(v.PrivateSubnetIDs) > 0
+	return len(v.PublicSubnetIDs) > 0 || len(v.PrivateSubnetIDs) > 0 || len(v.SecurityGroupIDs) > 0
 }
 
 type adjustVPCVars struct {
 	CIDR               net.IPNet
 	PublicSubnetCIDRs  []string
 	PrivateSubnetCIDRs []string
+	AZs                []string
 }
 
 func (v adjustVPCVars) isSet() bool {
 	if v.CIDR.String() != emptyIPNet.String() {
 		return true
 	}
-	return len(v.PublicSubnetCIDRs) != 0 || len(v.PrivateSubnetCIDRs) != 0
+	return len(v.PublicSubnetCIDRs) != 0 || len(v.PrivateSubnetCIDRs) != 0 || len(v.AZs) != 0
+}
+
+// validateAZs returns an error if there are fewer than two availability zones,
+// or if the number of subnet CIDRs doesn't match the number of availability zones.
+func (v adjustVPCVars) validateAZs() error {
+	if len(v.AZs) == 0 {
+		return nil
+	}
+	if len(v.AZs) < minAZs {
+		return fmt.Errorf("at least %d availability zones are required for the load balancer, got %d", minAZs, len(v.AZs))
+	}
+	if v.PublicSubnetCIDRs != nil && len(v.PublicSubnetCIDRs) != len(v.AZs) {
+		return fmt.Errorf("the number of public subnet CIDRs (%d) must match the number of availability zones (%d)", len(v.PublicSubnetCIDRs), len(v.AZs))
+	}
+	if v.PrivateSubnetCIDRs != nil && len(v.PrivateSubnetCIDRs) != len(v.AZs) {
+		return fmt.Errorf("the number of private subnet CIDRs (%d) must match the number of availability zones (%d)", len(v.PrivateSubnetCIDRs), len(v.AZs))
+	}
+	return nil
+}
+
+type accessLogsVars struct {
+	BucketName string
+	Prefix     string
+}
+
+func (v accessLogsVars) isSet() bool {
+	return v.BucketName != "" || v.Prefix != ""
+}
+
+// validate returns an error if the prefix can't be used by the load balancer.
+func (v accessLogsVars) validate() error {
+	if strings.HasPrefix(v.Prefix, "/") || strings.HasSuffix(v.Prefix, "/") {
+		return fmt.Errorf("access logs prefix %s cannot start or end with a forward slash", v.Prefix)
+	}
+	if strings.Contains(v.Prefix, "AWSLogs") {
+		return fmt.Errorf("access logs prefix %s cannot contain AWSLogs", v.Prefix)
+	}
+	return nil
 }
 
 type tempCredsVars struct {
@@ -112,18 +181,63 @@ func (v tempCredsVars) isSet() bool {
 	return v.AccessKeyID != "" && v.SecretAccessKey != ""
 }
 
+// redact replaces the values of the credentials in msg.
+func (v tempCredsVars) redact(msg string) string {
+	for _, secret := range []string{v.SessionToken, v.SecretAccessKey, v.AccessKeyID} {
+		if secret == "" {
+			continue
+		}
+		msg = strings.ReplaceAll(msg, secret, "[redacted]")
+	}
+	return msg
+}
+
+// stsCredentials is the JSON document of temporary credentials output by "aws sts assume-role".
+type stsCredentials struct {
+	Credentials struct {
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string `json:"SecretAccessKey"`
+		SessionToken    string `json:"SessionToken"`
+	} `json:"Credentials"`
+}
+
+// errWithRedactedCreds is an error whose message doesn't contain the values of the temporary credentials.
+type errWithRedactedCreds struct {
+	err   error
+	creds tempCredsVars
+}
+
+func (e *errWithRedactedCreds) Error() string {
+	return e.creds.redact(e.err.Error())
+}
+
+func (e *errWithRedactedCreds) Unwrap() error {
+	return e.err
+}
+
 type initEnvVars struct {
 	appName       string
 	name          string // Name for the environment.
 	profile       string // The named profile to use for credential retrieval. Mutually exclusive with tempCreds.
 	isProduction  bool   // True means retain resources even after deletion.
 	defaultConfig bool   // True means using default environment configuration.
+	enableIPv6    bool   // True means the VPC, subnets and public load balancer support IPv6.
+
+	resourceTags map[string]string // Tags applied to the resources of the environment in addition to the application's tags.
+
+	enableAccessLogs bool           // True means the access logs of the public load balancer are stored in S3.
+	accessLogs       accessLogsVars // Existing bucket and prefix of the access logs. Setting either enables access logs.
+
+	importCertARNs []string // Existing ACM certificates for the HTTPS listener of the public load balancer.
 
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 
-	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
-	region    string        // The region to create the environment in.
+	tempCreds      tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
+	credsFromStdin bool          // True means the temporary credentials are read from stdin. Mutually exclusive with the profile and tempCreds.
+	region         string        // The region to create the environment in.
+
+	fromStack bool // True means the environment is registered from its existing stack instead of being deployed.
 }
 
 type initEnvOpts struct {
@@ -141,6 +255,8 @@ type initEnvOpts struct {
 	prompt       prompter
 	selVPC       ec2Selector
 	selCreds     credsSelector
+	stdin        io.Reader               // Source of the temporary credentials with --creds-from-stdin.
+	getenv       func(key string) string // Overridden in tests.
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
 }
@@ -169,6 +285,8 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		identity:     identity.New(defaultSession),
 		prog:         termprogress.NewSpinner(),
 		prompt:       prompter,
+		stdin:        os.Stdin,
+		getenv:       os.Getenv,
 		selCreds: &selector.CredsSelect{
 			Session: sessProvider,
 			Profile: cfg,
@@ -190,6 +308,17 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := o.validateFromStack(); err != nil {
+		return err
+	}
+	if err := o.accessLogs.validate(); err != nil {
+		return err
+	}
+	for _, certARN := range o.importCertARNs {
+		if err := validateACMCertARN(certARN); err != nil {
+			return fmt.Errorf("validate certificate %s: %w", certARN, err)
+		}
+	}
 	return o.validateCredentials()
 }
 
@@ -204,6 +333,10 @@ func (o *initEnvOpts) Ask() error {
 	if err := o.askEnvRegion(); err != nil {
 		return err
 	}
+	if o.fromStack {
+		// The configuration of the environment is read from its stack.
+		return nil
+	}
 	return o.askCustomizedResources()
 }
 
@@ -214,7 +347,7 @@ func (o *initEnvOpts) Execute() error {
 		o.envIdentity = identity.New(o.sess)
 	}
 	if o.envDeployer == nil {
-		o.envDeployer = deploycfn.New(o.sess)
+		o.envDeployer = deploycfn.New(o.sess).WithContext(cmdCtx)
 	}
 
 	app, err := o.store.GetApplication(o.appName)
@@ -224,10 +357,16 @@ func (o *initEnvOpts) Execute() error {
 	}
 
 	if app.RequiresDNSDelegation() {
+		if len(o.importCertARNs) != 0 {
+			return fmt.Errorf("cannot specify --%s for application %s with domain %s", importCertARNsFlag, app.Name, app.Domain)
+		}
 		if err := o.delegateDNSFromApp(app); err != nil {
 			return fmt.Errorf("granting DNS permissions: %w", err)
 		}
 	}
+	if o.fromStack {
+		return o.adoptEnv(app)
+	}
 	// 1. Start creating the CloudFormation stack for the environment.
 	if err := o.deployEnv(app); err != nil {
 		return err
@@ -239,7 +378,9 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig(), o.enableIPv6, o.accessLogsConfig(), o.importCertARNs)
+	env.CreatedByVersion = version.Version
+	env.LastUpdatedByVersion = version.Version
 
 	// 3. Add the stack set instance to the app stackset.
 	if err := o.addToStackset(app, env); err != nil {
@@ -267,6 +408,35 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	return o.adjustVPC.validateAZs()
+}
+
+// validateFromStack returns an error if flags that configure the environment's stack are set with --from-stack,
+// since the configuration is read from the existing stack.
+func (o *initEnvOpts) validateFromStack() error {
+	if !o.fromStack {
+		return nil
+	}
+	if o.importVPC.isSet() || o.adjustVPC.isSet() {
+		return fmt.Errorf("cannot import or configure vpc if --%s is set", fromStackFlag)
+	}
+	conflicts := []struct {
+		flag  string
+		isSet bool
+	}{
+		{flag: defaultConfigFlag, isSet: o.defaultConfig},
+		{flag: enableIPv6Flag, isSet: o.enableIPv6},
+		{flag: enableAccessLogsFlag, isSet: o.enableAccessLogs},
+		{flag: accessLogsBucketFlag, isSet: o.accessLogs.BucketName != ""},
+		{flag: accessLogsPrefixFlag, isSet: o.accessLogs.Prefix != ""},
+		{flag: importCertARNsFlag, isSet: len(o.importCertARNs) != 0},
+		{flag: resourceTagsFlag, isSet: len(o.resourceTags) != 0},
+	}
+	for _, c := range conflicts {
+		if c.isSet {
+			return fmt.Errorf("cannot specify both --%s and --%s", fromStackFlag, c.flag)
+		}
+	}
 	return nil
 }
 
@@ -292,6 +462,21 @@ func (o *initEnvOpts) askEnvSession() error {
 		o.sess = sess
 		return nil
 	}
+	if o.credsFromStdin {
+		creds, err := o.readStdinCreds()
+		if err != nil {
+			return err
+		}
+		o.tempCreds = creds
+	}
+	if !o.tempCreds.isSet() && o.getenv("AWS_ACCESS_KEY_ID") != "" && o.getenv("AWS_SECRET_ACCESS_KEY") != "" {
+		log.Info(envInitCredsFromEnvVars)
+		o.tempCreds = tempCredsVars{
+			AccessKeyID:     o.getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: o.getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    o.getenv("AWS_SESSION_TOKEN"),
+		}
+	}
 	if o.tempCreds.isSet() {
 		sess, err := o.sessProvider.FromStaticCreds(o.tempCreds.AccessKeyID, o.tempCreds.SecretAccessKey, o.tempCreds.SessionToken)
 		if err != nil {
@@ -308,6 +493,38 @@ func (o *initEnvOpts) askEnvSession() error {
 	return nil
 }
 
+// readStdinCreds reads the temporary credentials from the JSON document of "aws sts assume-role" in stdin.
+func (o *initEnvOpts) readStdinCreds() (tempCredsVars, error) {
+	data, err := ioutil.ReadAll(o.stdin)
+	if err != nil {
+		return tempCredsVars{}, fmt.Errorf("read credentials from stdin: %w", err)
+	}
+	var doc stsCredentials
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return tempCredsVars{}, fmt.Errorf("unmarshal credentials from stdin: %w", err)
+	}
+	creds := tempCredsVars{
+		AccessKeyID:     doc.Credentials.AccessKeyID,
+		SecretAccessKey: doc.Credentials.SecretAccessKey,
+		SessionToken:    doc.Credentials.SessionToken,
+	}
+	if !creds.isSet() {
+		return tempCredsVars{}, errors.New(`credentials from stdin must have "Credentials.AccessKeyId" and "Credentials.SecretAccessKey"`)
+	}
+	return creds, nil
+}
+
+// redactCreds returns err with the values of the temporary credentials removed from its message, or nil if err is nil.
+func (o *initEnvOpts) redactCreds(err error) error {
+	if err == nil || !o.tempCreds.isSet() {
+		return err
+	}
+	return &errWithRedactedCreds{
+		err:   err,
+		creds: o.tempCreds,
+	}
+}
+
 func (o *initEnvOpts) askEnvRegion() error {
 	region := aws.StringValue(o.sess.Config.Region)
 	if o.region != "" {
@@ -342,8 +559,20 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	}
 	switch adjustOrImport {
 	case envInitImportEnvResourcesSelectOption:
-		return o.askImportResources()
+		if err := o.askEnableIPv6(); err != nil {
+			return err
+		}
+		if err := o.askImportResources(); err != nil {
+			return err
+		}
+		return o.askImportSecurityGroups()
 	case envInitAdjustEnvResourcesSelectOption:
+		if err := o.askEnableIPv6(); err != nil {
+			return err
+		}
+		if err := o.askEnableAccessLogs(); err != nil {
+			return err
+		}
 		return o.askAdjustResources()
 	case envInitDefaultConfigSelectOption:
 		return nil
@@ -351,6 +580,30 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	return nil
 }
 
+func (o *initEnvOpts) askEnableIPv6() error {
+	if o.enableIPv6 {
+		return nil
+	}
+	enableIPv6, err := o.prompt.Confirm(envInitEnableIPv6Prompt, envInitEnableIPv6PromptHelp)
+	if err != nil {
+		return fmt.Errorf("confirm enabling IPv6: %w", err)
+	}
+	o.enableIPv6 = enableIPv6
+	return nil
+}
+
+func (o *initEnvOpts) askEnableAccessLogs() error {
+	if o.enableAccessLogs || o.accessLogs.isSet() {
+		return nil
+	}
+	enableAccessLogs, err := o.prompt.Confirm(envInitEnableAccessLogsPrompt, envInitEnableAccessLogsPromptHelp)
+	if err != nil {
+		return fmt.Errorf("confirm enabling access logs: %w", err)
+	}
+	o.enableAccessLogs = enableAccessLogs
+	return nil
+}
+
 func (o *initEnvOpts) askImportResources() error {
 	if o.selVPC == nil {
 		o.selVPC = selector.NewEC2Select(o.prompt, ec2.New(o.sess))
@@ -382,6 +635,19 @@ To learn more about the issue:
 https://aws.amazon.com/premiumsupport/knowledge-center/ecs-pull-container-api-error-ecr/`)
 		return fmt.Errorf("VPC %s has no DNS support enabled", o.importVPC.ID)
 	}
+	if o.enableIPv6 {
+		ipv6Support, err := o.ec2Client.HasIPv6CIDR(o.importVPC.ID)
+		if err != nil {
+			return fmt.Errorf("check if VPC %s has an IPv6 CIDR block: %w", o.importVPC.ID, err)
+		}
+		if !ipv6Support {
+			log.Errorf(`Looks like you're creating an IPv6 environment using a VPC without an IPv6 CIDR block. You can either:
+- Associate an IPv6 CIDR block with VPC %s and its subnets, then import it again.
+- Create the environment without --%s, or without importing a VPC.
+`, o.importVPC.ID, enableIPv6Flag)
+			return fmt.Errorf("VPC %s has no IPv6 CIDR block", o.importVPC.ID)
+		}
+	}
 	if o.importVPC.PublicSubnetIDs == nil {
 		publicSubnets, err := o.selVPC.PublicSubnets(envInitPublicSubnetsSelectPrompt, "", o.importVPC.ID)
 		if err != nil {
@@ -406,6 +672,60 @@ https://aws.amazon.com/premiumsupport/knowledge-center/ecs-pull-container-api-er
 		}
 		o.importVPC.PrivateSubnetIDs = privateSubnets
 	}
+	return o.validateImportedSecurityGroups()
+}
+
+// askImportSecurityGroups asks for existing security groups of the imported VPC to attach to all services.
+func (o *initEnvOpts) askImportSecurityGroups() error {
+	if o.importVPC.SecurityGroupIDs != nil {
+		return nil
+	}
+	attach, err := o.prompt.Confirm(envInitSecurityGroupsConfirmPrompt, envInitSecurityGroupsConfirmHelp)
+	if err != nil {
+		return fmt.Errorf("confirm attaching existing security groups: %w", err)
+	}
+	if !attach {
+		return nil
+	}
+	securityGroups, err := o.selVPC.SecurityGroups(envInitSecurityGroupsSelectPrompt, "", o.importVPC.ID)
+	if err != nil {
+		return fmt.Errorf("select security groups: %w", err)
+	}
+	o.importVPC.SecurityGroupIDs = securityGroups
+	return nil
+}
+
+// validateImportedSecurityGroups returns an error if any of the security groups passed by flags
+// doesn't exist in the imported VPC.
+func (o *initEnvOpts) validateImportedSecurityGroups() error {
+	if len(o.importVPC.SecurityGroupIDs) == 0 {
+		return nil
+	}
+	found, err := o.ec2Client.SecurityGroups(
+		ec2.Filter{
+			Name:   "vpc-id",
+			Values: []string{o.importVPC.ID},
+		},
+		ec2.Filter{
+			Name:   "group-id",
+			Values: o.importVPC.SecurityGroupIDs,
+		})
+	if err != nil {
+		return fmt.Errorf("get security groups of VPC %s: %w", o.importVPC.ID, err)
+	}
+	exists := make(map[string]bool)
+	for _, id := range found {
+		exists[id] = true
+	}
+	var missing []string
+	for _, id := range o.importVPC.SecurityGroupIDs {
+		if !exists[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("security groups %s do not exist in VPC %s", strings.Join(missing, ", "), o.importVPC.ID)
+	}
 	return nil
 }
 
@@ -422,6 +742,12 @@ func (o *initEnvOpts) askAdjustResources() error {
 		}
 		o.adjustVPC.CIDR = *vpcCIDR
 	}
+	if o.adjustVPC.AZs == nil && (o.adjustVPC.PublicSubnetCIDRs == nil || o.adjustVPC.PrivateSubnetCIDRs == nil) {
+		// Subnets whose CIDRs were all passed by flags keep being spread across the first zones of the region.
+		if err := o.askAZs(); err != nil {
+			return err
+		}
+	}
 	if o.adjustVPC.PublicSubnetCIDRs == nil {
 		publicCIDR, err := o.prompt.Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, validateCIDRSlice,
 			prompt.WithDefaultInput(stack.DefaultPublicSubnetCIDRs))
@@ -438,6 +764,26 @@ func (o *initEnvOpts) askAdjustResources() error {
 		}
 		o.adjustVPC.PrivateSubnetCIDRs = strings.Split(privateCIDR, ",")
 	}
+	return o.adjustVPC.validateAZs()
+}
+
+func (o *initEnvOpts) askAZs() error {
+	if o.ec2Client == nil {
+		o.ec2Client = ec2.New(o.sess)
+	}
+	azs, err := o.ec2Client.ListAZs()
+	if err != nil {
+		return fmt.Errorf("list availability zones: %w", err)
+	}
+	if len(azs) < minAZs {
+		return fmt.Errorf("at least %d availability zones are required for the load balancer, found %d in the region", minAZs, len(azs))
+	}
+	selected, err := o.prompt.MultiSelect(envInitAZsPrompt, envInitAZsPromptHelp, azs,
+		prompt.WithDefaultSelections(azs[:minAZs]...))
+	if err != nil {
+		return fmt.Errorf("select availability zones: %w", err)
+	}
+	o.adjustVPC.AZs = selected
 	return nil
 }
 
@@ -449,6 +795,7 @@ func (o *initEnvOpts) importVPCConfig() *config.ImportVPC {
 		ID:               o.importVPC.ID,
 		PrivateSubnetIDs: o.importVPC.PrivateSubnetIDs,
 		PublicSubnetIDs:  o.importVPC.PublicSubnetIDs,
+		SecurityGroupIDs: o.importVPC.SecurityGroupIDs,
 	}
 }
 
@@ -460,6 +807,17 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 		CIDR:               o.adjustVPC.CIDR.String(),
 		PrivateSubnetCIDRs: o.adjustVPC.PrivateSubnetCIDRs,
 		PublicSubnetCIDRs:  o.adjustVPC.PublicSubnetCIDRs,
+		AZs:                o.adjustVPC.AZs,
+	}
+}
+
+func (o *initEnvOpts) accessLogsConfig() *config.AccessLogs {
+	if !o.enableAccessLogs && !o.accessLogs.isSet() {
+		return nil
+	}
+	return &config.AccessLogs{
+		BucketName: o.accessLogs.BucketName,
+		Prefix:     o.accessLogs.Prefix,
 	}
 }
 
@@ -468,15 +826,24 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	additionalTags := app.Tags
+	if len(o.resourceTags) != 0 {
+		// The environment's tags override the application's tags with the same key.
+		additionalTags = tags.Merge(app.Tags, o.resourceTags)
+	}
 	deployEnvInput := &deploy.CreateEnvironmentInput{
 		Name:                     o.name,
 		AppName:                  o.appName,
 		Prod:                     o.isProduction,
 		ToolsAccountPrincipalARN: caller.RootUserARN,
 		AppDNSName:               app.Domain,
-		AdditionalTags:           app.Tags,
+		AdditionalTags:           additionalTags,
 		AdjustVPCConfig:          o.adjustVPCConfig(),
 		ImportVPCConfig:          o.importVPCConfig(),
+		EnableIPv6:               o.enableIPv6,
+		AccessLogsConfig:         o.accessLogsConfig(),
+		ImportCertARNs:           o.importCertARNs,
+		PermissionsBoundary:      app.PermissionsBoundary,
 		Version:                  deploy.LatestEnvTemplateVersion,
 	}
 
@@ -509,9 +876,47 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 	return nil
 }
 
+// adoptEnv registers the environment from its existing stack without deploying it,
+// for example after the stack was restored in an account but the environment's record was lost.
+func (o *initEnvOpts) adoptEnv(app *config.Application) error {
+	stackName := stack.NameForEnv(o.appName, o.name)
+	env, tplVersion, err := o.envDeployer.EnvironmentFromStack(o.appName, o.name)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return fmt.Errorf("environment stack %s does not exist in the account and region of the credentials: run the command without --%s to create the environment", stackName, fromStackFlag)
+		}
+		return fmt.Errorf("read environment stack %s: %w", stackName, err)
+	}
+	warnIfEnvTemplateOutdated(env.Name, tplVersion)
+	env.Prod = o.isProduction
+	// The version of the CLI that created the stack is unknown, so the versions are left empty.
+
+	if err := o.addToStackset(app, env); err != nil {
+		return err
+	}
+	if err := o.store.CreateEnvironment(env); err != nil {
+		return fmt.Errorf("store environment: %w", err)
+	}
+	log.Successf("Registered environment %s from stack %s in region %s under application %s.\n",
+		color.HighlightUserInput(env.Name), color.HighlightResource(stackName), color.Emphasize(env.Region), color.HighlightUserInput(env.App))
+	return nil
+}
+
+// warnIfEnvTemplateOutdated logs a warning if the template of the environment's stack isn't on the latest version.
+func warnIfEnvTemplateOutdated(env, tplVersion string) {
+	if semver.Compare(tplVersion, deploy.LatestEnvTemplateVersion) >= 0 {
+		return
+	}
+	log.Warningf("The template of environment %s is on version %s while the latest version is %s.\nRun %s to upgrade it.\n",
+		env, tplVersion, deploy.LatestEnvTemplateVersion, color.HighlightCode(fmt.Sprintf("copilot env upgrade -n %s", env)))
+}
+
 func (o *initEnvOpts) addToStackset(app *config.Application, env *config.Environment) error {
 	o.prog.Start(fmt.Sprintf(fmtAddEnvToAppStart, color.Emphasize(env.AccountID), color.Emphasize(env.Region), color.HighlightUserInput(o.appName)))
-	if err := o.appDeployer.AddEnvToApp(app, env); err != nil {
+	if err := o.appDeployer.AddEnvToApp(app, env, stackset.WithProgress(func(results []stackset.OperationResult) {
+		o.prog.Events(humanizeStackInstanceResults(results))
+	})); err != nil {
 		o.prog.Stop(log.Serrorf(fmtAddEnvToAppFailed, color.Emphasize(env.AccountID), color.Emphasize(env.Region), color.HighlightUserInput(o.appName)))
 		return fmt.Errorf("deploy env %s to application %s: %w", env.Name, app.Name, err)
 	}
@@ -520,6 +925,28 @@ func (o *initEnvOpts) addToStackset(app *config.Application, env *config.Environ
 	return nil
 }
 
+// humanizeStackInstanceResults returns a row for the creation of the application's resources
+// in each account and region, followed by the reason if it failed.
+func humanizeStackInstanceResults(results []stackset.OperationResult) []termprogress.TabRow {
+	var rows []termprogress.TabRow
+	for _, result := range results {
+		coloredStatus := color.Grey.Sprintf("[%s]", termprogress.StatusInProgress)
+		failed := result.Status == stackset.OperationResultStatusFailed || result.Status == stackset.OperationResultStatusCancelled
+		switch {
+		case result.Status == stackset.OperationResultStatusSucceeded:
+			coloredStatus = fmt.Sprintf("[%s]", termprogress.StatusComplete)
+		case failed:
+			coloredStatus = color.Red.Sprintf("[%s]", termprogress.StatusFailed)
+		}
+		text := fmt.Sprintf(fmtStackInstanceProgress, result.Region, result.Account)
+		rows = append(rows, termprogress.TabRow(fmt.Sprintf("%s\t%s", color.Grey.Sprint(text), coloredStatus)))
+		if failed && result.Reason != "" {
+			rows = append(rows, termprogress.TabRow(fmt.Sprintf("  %s\t", result.Reason)))
+		}
+	}
+	return rows
+}
+
 func (o *initEnvOpts) delegateDNSFromApp(app *config.Application) error {
 	envAccount, err := o.envIdentity.Get()
 	if err != nil {
@@ -585,6 +1012,15 @@ func (o *initEnvOpts) validateCredentials() error {
 	if o.profile != "" && o.tempCreds.SessionToken != "" {
 		return fmt.Errorf("cannot specify both --%s and --%s", profileFlag, sessionTokenFlag)
 	}
+	if !o.credsFromStdin {
+		return nil
+	}
+	if o.profile != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", profileFlag, credsFromStdinFlag)
+	}
+	if o.tempCreds.AccessKeyID != "" || o.tempCreds.SecretAccessKey != "" || o.tempCreds.SessionToken != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s, --%s or --%s", credsFromStdinFlag, accessKeyIDFlag, secretAccessKeyFlag, sessionTokenFlag)
+	}
 	return nil
 }
 
@@ -602,6 +1038,10 @@ func buildEnvInitCmd() *cobra.Command {
   Creates a prod-iad environment using your "prod-admin" AWS profile.
   /code $ copilot env init --name prod-iad --profile prod-admin --prod
 
+  Creates a prod environment with the temporary credentials of an assumed role.
+  /code $ aws sts assume-role --role-arn arn:aws:iam::123456789012:role/admin --role-session-name copilot \
+  /code | copilot env init --name prod --prod --default-config --creds-from-stdin
+
   Creates an environment with imported VPC resources.
   /code $ copilot env init --import-vpc-id vpc-099c32d2b98cdcf47 \
   /code --import-public-subnets subnet-013e8b691862966cf,subnet -014661ebb7ab8681a \
@@ -610,7 +1050,24 @@ func buildEnvInitCmd() *cobra.Command {
   Creates an environment with overrided CIDRs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates an environment with subnets spread across three availability zones.
+  /code $ copilot env init --az us-west-2a --az us-west-2b --az us-west-2c \
+  /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24,10.1.2.0/24 \
+  /code --override-private-cidrs 10.1.3.0/24,10.1.4.0/24,10.1.5.0/24
+
+  Creates an environment whose VPC and load balancer support IPv6.
+  /code $ copilot env init --name test --profile default --default-config --enable-ipv6
+  Creates a test environment with additional resource tags.
+  /code $ copilot env init --name test --profile default --default-config --resource-tags team=payments,cost-center=1234
+  Creates a prod environment that stores the access logs of its load balancer in an existing bucket.
+  /code $ copilot env init --name prod --profile prod-admin --prod --default-config --access-logs-bucket my-audit-logs --access-logs-prefix copilot/prod
+  Creates an environment that serves HTTPS traffic with an existing ACM certificate.
+  /code $ copilot env init --name test --profile default --default-config \
+  /code --import-cert-arns arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012
+  Registers a prod environment from its existing stack without deploying it.
+  /code $ copilot env init --name prod --profile prod-admin --prod --from-stack`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -620,9 +1077,9 @@ func buildEnvInitCmd() *cobra.Command {
 				return err
 			}
 			if err := opts.Ask(); err != nil {
-				return err
+				return opts.redactCreds(err)
 			}
-			return opts.Execute()
+			return opts.redactCreds(opts.Execute())
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
@@ -631,19 +1088,29 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.tempCreds.AccessKeyID, accessKeyIDFlag, "", accessKeyIDFlagDescription)
 	cmd.Flags().StringVar(&vars.tempCreds.SecretAccessKey, secretAccessKeyFlag, "", secretAccessKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.tempCreds.SessionToken, sessionTokenFlag, "", sessionTokenFlagDescription)
+	cmd.Flags().BoolVar(&vars.credsFromStdin, credsFromStdinFlag, false, credsFromStdinFlagDescription)
 	cmd.Flags().StringVar(&vars.region, regionFlag, "", envRegionTokenFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.isProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
 
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importVPC.SecurityGroupIDs, importSecurityGroupsFlag, nil, importSecurityGroupsFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.adjustVPC.AZs, azFlag, nil, azFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.enableIPv6, enableIPv6Flag, false, enableIPv6FlagDescription)
+	cmd.Flags().BoolVar(&vars.enableAccessLogs, enableAccessLogsFlag, false, enableAccessLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.accessLogs.BucketName, accessLogsBucketFlag, "", accessLogsBucketFlagDescription)
+	cmd.Flags().StringVar(&vars.accessLogs.Prefix, accessLogsPrefixFlag, "", accessLogsPrefixFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importCertARNs, importCertARNsFlag, nil, importCertARNsFlagDescription)
+	cmd.Flags().BoolVar(&vars.fromStack, fromStackFlag, false, fromStackFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -652,19 +1119,29 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(accessKeyIDFlag))
 	flags.AddFlag(cmd.Flags().Lookup(secretAccessKeyFlag))
 	flags.AddFlag(cmd.Flags().Lookup(sessionTokenFlag))
+	flags.AddFlag(cmd.Flags().Lookup(credsFromStdinFlag))
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(resourceTagsFlag))
+	flags.AddFlag(cmd.Flags().Lookup(enableIPv6Flag))
+	flags.AddFlag(cmd.Flags().Lookup(enableAccessLogsFlag))
+	flags.AddFlag(cmd.Flags().Lookup(accessLogsBucketFlag))
+	flags.AddFlag(cmd.Flags().Lookup(accessLogsPrefixFlag))
+	flags.AddFlag(cmd.Flags().Lookup(fromStackFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(importSecurityGroupsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(importCertARNsFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(publicSubnetCIDRsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(privateSubnetCIDRsFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(azFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
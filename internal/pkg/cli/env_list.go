@@ -9,9 +9,13 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -20,35 +24,70 @@ import (
 const (
 	envListAppNamePrompt = "Which application is the environment in?"
 	envListAppNameHelper = "An application is a collection of related services."
+
+	envListMaxConcurrentDescribes = 5         // Maximum number of environments described at the same time.
+	envListUnknownValue           = "unknown" // Displayed when an environment's details can't be retrieved.
 )
 
 type listEnvVars struct {
 	appName          string
 	shouldOutputJSON bool
+	shouldShowWide   bool
 }
 
 type listEnvOpts struct {
 	listEnvVars
-	store  store
-	prompt prompter
-	sel    configSelector
+	store       store
+	deployStore deployedEnvironmentLister
+	checker     appConsistencyChecker
+	prompt      prompter
+	sel         configSelector
+
+	// Constructor for a client that can be initialized only at runtime.
+	// This function is overriden in tests to provide mocks.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
 
 	w io.Writer
 }
 
+// envListEntry holds an environment's configuration along with the details retrieved from its stack.
+type envListEntry struct {
+	*config.Environment
+	Version          string `json:"version,omitempty"`
+	DeployedServices *int   `json:"deployedServices"`
+	Err              string `json:"error,omitempty"`
+}
+
 func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 	store, err := config.NewStore()
 	if err != nil {
 		return nil, err
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
 
 	prompter := prompt.New()
 	return &listEnvOpts{
 		listEnvVars: vars,
 		store:       store,
+		deployStore: deployStore,
+		checker:     store,
 		sel:         selector.NewConfigSelect(prompter, store),
 		prompt:      prompter,
-		w:           os.Stdout,
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+			}
+			return d, nil
+		},
+		w: os.Stdout,
 	}, nil
 }
 
@@ -78,20 +117,81 @@ func (o *listEnvOpts) Execute() error {
 	}
 
 	var out string
-	if o.shouldOutputJSON {
-		data, err := o.jsonOutput(envs)
+	switch {
+	case o.shouldOutputJSON:
+		data, err := o.jsonOutput(o.describeEnvs(envs))
 		if err != nil {
 			return err
 		}
 		out = data
-	} else {
+	case o.shouldShowWide:
+		out = o.wideOutput(o.describeEnvs(envs))
+	default:
 		out = o.humanOutput(envs)
 	}
 	fmt.Fprint(o.w, out)
 
+	o.warnInconsistencies()
 	return nil
 }
 
+// warnInconsistencies warns if the records of the application disagree with each other.
+// The check is best effort: errors are ignored so that they don't fail the listing.
+func (o *listEnvOpts) warnInconsistencies() {
+	incs, err := o.checker.CheckConsistency(o.appName)
+	if err != nil || len(incs) == 0 {
+		return
+	}
+	log.Warningf("Found %d inconsistent records in application %s. Run %s for details.\n",
+		len(incs), o.appName, color.HighlightCode(fmt.Sprintf("copilot app consistency-check -n %s", o.appName)))
+}
+
+// describeEnvs retrieves the template version and number of deployed services of each environment.
+// The environments are described concurrently, with at most envListMaxConcurrentDescribes at a time.
+// An environment that can't be described doesn't fail the listing, instead the error is recorded in its entry.
+func (o *listEnvOpts) describeEnvs(envs []*config.Environment) []*envListEntry {
+	entries := make([]*envListEntry, len(envs))
+	sem := make(chan struct{}, envListMaxConcurrentDescribes)
+	done := make(chan struct{}, len(envs))
+	defer close(done)
+	for i, env := range envs {
+		entries[i] = &envListEntry{
+			Environment: env,
+		}
+		go func(entry *envListEntry) {
+			sem <- struct{}{}
+			o.describeEnv(entry)
+			<-sem
+			done <- struct{}{}
+		}(entries[i])
+	}
+	for i := 0; i < len(envs); i++ {
+		<-done
+	}
+	return entries
+}
+
+func (o *listEnvOpts) describeEnv(entry *envListEntry) {
+	d, err := o.newEnvVersionGetter(o.appName, entry.Name)
+	if err != nil {
+		entry.Err = err.Error()
+		return
+	}
+	version, err := d.Version()
+	if err != nil {
+		entry.Err = fmt.Sprintf("get template version of environment %s: %v", entry.Name, err)
+		return
+	}
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, entry.Name)
+	if err != nil {
+		entry.Err = fmt.Sprintf("list deployed services in environment %s: %v", entry.Name, err)
+		return
+	}
+	numSvcs := len(svcs)
+	entry.Version = version
+	entry.DeployedServices = &numSvcs
+}
+
 func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
 	b := &strings.Builder{}
 	for _, env := range envs {
@@ -104,9 +204,26 @@ func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
 	return b.String()
 }
 
-func (o *listEnvOpts) jsonOutput(envs []*config.Environment) (string, error) {
+func (o *listEnvOpts) wideOutput(entries []*envListEntry) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", "Name", "Production", "Region", "Account ID", "Version", "Services")
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", "----", "----------", "------", "----------", "-------", "--------")
+	for _, entry := range entries {
+		version, numSvcs := envListUnknownValue, envListUnknownValue
+		if entry.Err == "" {
+			version = entry.Version
+			numSvcs = fmt.Sprintf("%d", *entry.DeployedServices)
+		}
+		fmt.Fprintf(writer, "%s\t%t\t%s\t%s\t%s\t%s\n", entry.Name, entry.Prod, entry.Region, entry.AccountID, version, numSvcs)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (o *listEnvOpts) jsonOutput(envs []*envListEntry) (string, error) {
 	type serializedEnvs struct {
-		Environments []*config.Environment `json:"environments"`
+		Environments []*envListEntry `json:"environments"`
 	}
 	b, err := json.Marshal(serializedEnvs{Environments: envs})
 	if err != nil {
@@ -121,9 +238,13 @@ func buildEnvListCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ls",
 		Short: "Lists all the environments in an application.",
+		Long: `Lists all the environments in an application.
+With --wide or --json, also shows the template version and number of deployed services of each environment.`,
 		Example: `
   Lists all the environments for the frontend application.
-  /code $ copilot env ls -a frontend`,
+  /code $ copilot env ls -a frontend
+  Lists the environments with their region, account, template version and number of deployed services.
+  /code $ copilot env ls -a frontend --wide`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListEnvOpts(vars)
 			if err != nil {
@@ -137,5 +258,6 @@ func buildEnvListCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowWide, wideFlag, false, envWideFlagDescription)
 	return cmd
 }
@@ -74,6 +74,9 @@ func TestEnvList_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockError := fmt.Errorf("error")
 	mockstore := mocks.NewMockstore(ctrl)
+	mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+	mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+	mockChecker := mocks.NewMockappConsistencyChecker(ctrl)
 	defer ctrl.Finish()
 
 	testCases := map[string]struct {
@@ -88,7 +91,12 @@ func TestEnvList_Execute(t *testing.T) {
 					shouldOutputJSON: true,
 					appName:          "coolapp",
 				},
-				store: mockstore,
+				store:       mockstore,
+				deployStore: mockDeployStore,
+				checker:     mockChecker,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					return mockVersionGetter, nil
+				},
 			},
 			mocking: func() {
 				mockstore.EXPECT().
@@ -101,15 +109,84 @@ func TestEnvList_Execute(t *testing.T) {
 						{Name: "test"},
 						{Name: "test2"},
 					}, nil)
+				mockVersionGetter.EXPECT().Version().Return("v1.1.0", nil).Times(2)
+				mockDeployStore.EXPECT().ListDeployedServices("coolapp", "test").Return([]string{"fe", "be"}, nil)
+				mockDeployStore.EXPECT().ListDeployedServices("coolapp", "test2").Return(nil, nil)
+				mockChecker.EXPECT().CheckConsistency("coolapp").Return(nil, nil)
+			},
+			expectedContent: "{\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"\",\"accountID\":\"\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"version\":\"v1.1.0\",\"deployedServices\":2},{\"app\":\"\",\"name\":\"test2\",\"region\":\"\",\"accountID\":\"\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"version\":\"v1.1.0\",\"deployedServices\":0}]}\n",
+		},
+		"with json envs that can't be described": {
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					shouldOutputJSON: true,
+					appName:          "coolapp",
+				},
+				store:       mockstore,
+				deployStore: mockDeployStore,
+				checker:     mockChecker,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					return nil, errors.New("assume role")
+				},
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{Name: "test"},
+					}, nil)
+				mockChecker.EXPECT().CheckConsistency("coolapp").Return(nil, nil)
+			},
+			expectedContent: "{\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"\",\"accountID\":\"\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"deployedServices\":null,\"error\":\"assume role\"}]}\n",
+		},
+		"with wide envs": {
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					shouldShowWide: true,
+					appName:        "coolapp",
+				},
+				store:       mockstore,
+				deployStore: mockDeployStore,
+				checker:     mockChecker,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					if env == "prod" {
+						return nil, mockError
+					}
+					return mockVersionGetter, nil
+				},
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{Name: "test", Region: "us-west-2", AccountID: "1234"},
+						{Name: "prod", Region: "us-east-1", AccountID: "5678", Prod: true},
+					}, nil)
+				mockVersionGetter.EXPECT().Version().Return("v1.1.0", nil)
+				mockDeployStore.EXPECT().ListDeployedServices("coolapp", "test").Return([]string{"fe"}, nil)
+				mockChecker.EXPECT().CheckConsistency("coolapp").Return(nil, nil)
 			},
-			expectedContent: "{\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"\",\"accountID\":\"\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"test2\",\"region\":\"\",\"accountID\":\"\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}]}\n",
+			expectedContent: `Name                Production          Region              Account ID          Version             Services
+----                ----------          ------              ----------          -------             --------
+test                false               us-west-2           1234                v1.1.0              1
+prod                true                us-east-1           5678                unknown             unknown
+`,
 		},
 		"with envs": {
 			listOpts: listEnvOpts{
 				listEnvVars: listEnvVars{
 					appName: "coolapp",
 				},
-				store: mockstore,
+				store:   mockstore,
+				checker: mockChecker,
 			},
 			mocking: func() {
 				mockstore.EXPECT().
@@ -122,6 +199,7 @@ func TestEnvList_Execute(t *testing.T) {
 						{Name: "test"},
 						{Name: "test2"},
 					}, nil)
+				mockChecker.EXPECT().CheckConsistency("coolapp").Return(nil, mockError)
 			},
 			expectedContent: "test\ntest2\n",
 		},
@@ -131,7 +209,8 @@ func TestEnvList_Execute(t *testing.T) {
 				listEnvVars: listEnvVars{
 					appName: "coolapp",
 				},
-				store: mockstore,
+				store:   mockstore,
+				checker: mockChecker,
 			},
 			mocking: func() {
 				mockstore.EXPECT().
@@ -150,7 +229,8 @@ func TestEnvList_Execute(t *testing.T) {
 				listEnvVars: listEnvVars{
 					appName: "coolapp",
 				},
-				store: mockstore,
+				store:   mockstore,
+				checker: mockChecker,
 			},
 			mocking: func() {
 				mockstore.EXPECT().
@@ -168,7 +248,8 @@ func TestEnvList_Execute(t *testing.T) {
 				listEnvVars: listEnvVars{
 					appName: "coolapp",
 				},
-				store: mockstore,
+				store:   mockstore,
+				checker: mockChecker,
 			},
 			mocking: func() {
 				mockstore.EXPECT().
@@ -181,6 +262,7 @@ func TestEnvList_Execute(t *testing.T) {
 						{Name: "test"},
 						{Name: "test2", Prod: true},
 					}, nil)
+				mockChecker.EXPECT().CheckConsistency("coolapp").Return([]*config.Inconsistency{{Kind: config.MismatchedRecord}}, nil)
 			},
 			expectedContent: "test\ntest2 (prod)\n",
 		},
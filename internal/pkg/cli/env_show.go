@@ -4,8 +4,11 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -22,6 +25,8 @@ const (
 	envShowAppNameHelpPrompt = "An application is a collection of related services."
 	envShowNamePrompt        = "Which environment of %s would you like to show?"
 	envShowHelpPrompt        = "The detail of an environment will be shown (e.g., region, account ID, services)."
+
+	defaultEnvShowServicesTimeout = 30 * time.Second
 )
 
 type showEnvVars struct {
@@ -29,6 +34,9 @@ type showEnvVars struct {
 	name                  string
 	shouldOutputJSON      bool
 	shouldOutputResources bool
+	shouldOutputServices  bool
+	timeout               time.Duration
+	compareTo             string
 }
 
 type showEnvOpts struct {
@@ -39,6 +47,9 @@ type showEnvOpts struct {
 	describer        envDescriber
 	sel              configSelector
 	initEnvDescriber func() error
+
+	compareDescriber        envDescriber // Describer of the environment in --compare-to.
+	initCompareEnvDescriber func() error
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
@@ -57,25 +68,52 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelect(prompt.New(), configStore),
 	}
-	opts.initEnvDescriber = func() error {
+	newDescriber := func(env string) (*describe.EnvDescriber, error) {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 			App:             opts.appName,
-			Env:             opts.name,
+			Env:             env,
 			ConfigStore:     configStore,
 			DeployStore:     deployStore,
 			EnableResources: opts.shouldOutputResources,
+
+			EnableServicesHealth:  opts.shouldOutputServices,
+			ServicesHealthTimeout: opts.timeout,
 		})
 		if err != nil {
-			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
+			return nil, fmt.Errorf("creating describer for environment %s in application %s: %w", env, opts.appName, err)
+		}
+		return d, nil
+	}
+	opts.initEnvDescriber = func() error {
+		d, err := newDescriber(opts.name)
+		if err != nil {
+			return err
 		}
 		opts.describer = d
 		return nil
 	}
+	opts.initCompareEnvDescriber = func() error {
+		d, err := newDescriber(opts.compareTo)
+		if err != nil {
+			return err
+		}
+		opts.compareDescriber = d
+		return nil
+	}
 	return opts, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showEnvOpts) Validate() error {
+	if o.timeout < 0 {
+		return errors.New("--timeout cannot be negative")
+	}
+	if o.compareTo != "" && o.shouldOutputServices {
+		return errors.New("cannot specify both --compare-to and --services")
+	}
+	if o.compareTo != "" && o.compareTo == o.name {
+		return errors.New("--compare-to must be a different environment than --name")
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -86,6 +124,11 @@ func (o *showEnvOpts) Validate() error {
 			return err
 		}
 	}
+	if o.compareTo != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.compareTo); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -100,6 +143,9 @@ func (o *showEnvOpts) Ask() error {
 
 // Execute shows the environments through the prompt.
 func (o *showEnvOpts) Execute() error {
+	if o.compareTo != "" {
+		return o.compare()
+	}
 	if err := o.initEnvDescriber(); err != nil {
 		return err
 	}
@@ -120,6 +166,77 @@ func (o *showEnvOpts) Execute() error {
 	return nil
 }
 
+// compare describes the environment and the one in --compare-to concurrently, and writes their differences.
+// If an environment can't be described, for example because its role can't be assumed, only its configuration is compared.
+func (o *showEnvOpts) compare() error {
+	base, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.name, err)
+	}
+	target, err := o.store.GetEnvironment(o.appName, o.compareTo)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.compareTo, err)
+	}
+	envs := []struct {
+		name      string
+		init      func() error
+		describer func() envDescriber
+		compared  describe.ComparedEnv
+		err       error
+	}{
+		{
+			name:      o.name,
+			init:      o.initEnvDescriber,
+			describer: func() envDescriber { return o.describer },
+			compared:  describe.ComparedEnv{Env: base},
+		},
+		{
+			name:      o.compareTo,
+			init:      o.initCompareEnvDescriber,
+			describer: func() envDescriber { return o.compareDescriber },
+			compared:  describe.ComparedEnv{Env: target},
+		},
+	}
+	// The describers are created before the concurrent lookups since they share the default session.
+	for i := range envs {
+		envs[i].err = envs[i].init()
+	}
+	var wg sync.WaitGroup
+	for i := range envs {
+		if envs[i].err != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			desc, err := envs[i].describer().Describe()
+			if err != nil {
+				envs[i].err = fmt.Errorf("describe environment %s: %w", envs[i].name, err)
+				return
+			}
+			envs[i].compared.Description = desc
+		}(i)
+	}
+	wg.Wait()
+	for _, env := range envs {
+		if env.err != nil {
+			log.Warningf("Comparing only the configuration of environment %s: %v\n", env.name, env.err)
+		}
+	}
+
+	cmp := describe.CompareEnvs(envs[0].compared, envs[1].compared)
+	if o.shouldOutputJSON {
+		data, err := cmp.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, cmp.HumanString())
+	}
+	return nil
+}
+
 func (o *showEnvOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -156,7 +273,11 @@ func buildEnvShowCmd() *cobra.Command {
 
 		Example: `
   Shows info about the environment "test".
-  /code $ copilot env show -n test`,
+  /code $ copilot env show -n test
+  Shows the health of each service deployed in the environment "prod" in JSON format.
+  /code $ copilot env show -n prod --services --json
+  Shows how the environment "staging" differs from "prod", including the types of their resources.
+  /code $ copilot env show -n staging --compare-to prod --resources`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -175,5 +296,8 @@ func buildEnvShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputServices, servicesFlag, false, envServicesFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, defaultEnvShowServicesTimeout, envShowTimeoutFlagDescription)
+	cmd.Flags().StringVar(&vars.compareTo, compareToFlag, "", envCompareToFlagDescription)
 	return cmd
 }
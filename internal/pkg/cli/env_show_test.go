@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -29,10 +30,55 @@ func TestEnvShow_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inputApp         string
 		inputEnvironment string
+		inputTimeout     time.Duration
+		inputServices    bool
+		inputCompareTo   string
 		setupMocks       func(mocks showEnvMocks)
 
 		wantedError error
 	}{
+		"invalid timeout": {
+			inputTimeout: -time.Second,
+
+			setupMocks: func(m showEnvMocks) {},
+
+			wantedError: fmt.Errorf("--timeout cannot be negative"),
+		},
+		"cannot compare the health of services": {
+			inputServices:  true,
+			inputCompareTo: "prod",
+
+			setupMocks: func(m showEnvMocks) {},
+
+			wantedError: fmt.Errorf("cannot specify both --compare-to and --services"),
+		},
+		"cannot compare an environment to itself": {
+			inputEnvironment: "my-env",
+			inputCompareTo:   "my-env",
+
+			setupMocks: func(m showEnvMocks) {},
+
+			wantedError: fmt.Errorf("--compare-to must be a different environment than --name"),
+		},
+		"invalid environment to compare to": {
+			inputApp:         "my-app",
+			inputEnvironment: "my-env",
+			inputCompareTo:   "prod",
+
+			setupMocks: func(m showEnvMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+						Name: "my-app",
+					}, nil),
+					m.storeSvc.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
 		"valid app name and environment name": {
 			inputApp:         "my-app",
 			inputEnvironment: "my-env",
@@ -92,8 +138,11 @@ func TestEnvShow_Validate(t *testing.T) {
 
 			showEnvs := &showEnvOpts{
 				showEnvVars: showEnvVars{
-					name:    tc.inputEnvironment,
-					appName: tc.inputApp,
+					name:                 tc.inputEnvironment,
+					appName:              tc.inputApp,
+					timeout:              tc.inputTimeout,
+					shouldOutputServices: tc.inputServices,
+					compareTo:            tc.inputCompareTo,
 				},
 				store: mockStoreReader,
 			}
@@ -285,7 +334,7 @@ func TestEnvShow_Execute(t *testing.T) {
 				)
 			},
 
-			wantedContent: "About\n\n  Name              testEnv\n  Production        false\n  Region            us-west-2\n  Account ID        123456789012\n\nServices\n\n  Name              Type\n  --------          -------------\n  testSvc1          load-balanced\n  testSvc2          load-balanced\n  testSvc3          load-balanced\n\nTags\n\n  Key                  Value\n  -------------------  -------\n  copilot-application  testApp\n  copilot-environment  testEnv\n  key1              value1\n  key2              value2\n\nResources\n\n  AWS::IAM::Role           testApp-testEnv-CFNExecutionRole\n  testApp-testEnv-Cluster  AWS::ECS::Cluster-jI63pYBWU6BZ\n",
+			wantedContent: "About\n\n  Name              testEnv\n  Production        false\n  Region            us-west-2\n  Account ID        123456789012\n  Access Logs       Disabled\n\nServices\n\n  Name              Type\n  --------          -------------\n  testSvc1          load-balanced\n  testSvc2          load-balanced\n  testSvc3          load-balanced\n\nTags\n\n  Key                  Value\n  -------------------  -------\n  copilot-application  testApp\n  copilot-environment  testEnv\n  key1              value1\n  key2              value2\n\nResources\n\n  AWS::IAM::Role           testApp-testEnv-CFNExecutionRole\n  testApp-testEnv-Cluster  AWS::ECS::Cluster-jI63pYBWU6BZ\n",
 		},
 		"success in JSON format": {
 			inputEnv:         "testEnv",
@@ -339,3 +388,135 @@ func TestEnvShow_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvShow_ExecuteCompare(t *testing.T) {
+	testEnv := &config.Environment{
+		App:       "phonetool",
+		Name:      "test",
+		Region:    "us-west-2",
+		AccountID: "123456789012",
+	}
+	prodEnv := &config.Environment{
+		App:       "phonetool",
+		Name:      "prod",
+		Region:    "us-east-1",
+		AccountID: "123456789012",
+		Prod:      true,
+	}
+	api := &config.Workload{
+		App:  "phonetool",
+		Name: "api",
+		Type: "Load Balanced Web Service",
+	}
+	worker := &config.Workload{
+		App:  "phonetool",
+		Name: "worker",
+		Type: "Backend Service",
+	}
+	testDescription := &describe.EnvDescription{
+		Environment: testEnv,
+		Services:    []*config.Workload{api},
+		Tags:        map[string]string{"owner": "platform"},
+	}
+	prodDescription := &describe.EnvDescription{
+		Environment: prodEnv,
+		Services:    []*config.Workload{api, worker},
+		Tags:        map[string]string{"owner": "platform"},
+	}
+	configOnlyJSON := "{\"base\":\"test\",\"target\":\"prod\",\"about\":[{\"name\":\"Production\",\"base\":\"false\",\"target\":\"true\"},{\"name\":\"Region\",\"base\":\"us-west-2\",\"target\":\"us-east-1\"}],\"services\":null,\"jobs\":null,\"tags\":null,\"resourceTypes\":null}\n"
+
+	testCases := map[string]struct {
+		shouldOutputJSON   bool
+		initCompareDescErr error
+
+		setupMocks func(base, target *mocks.MockenvDescriber)
+
+		wantedContent string
+	}{
+		"compares the descriptions of both environments": {
+			setupMocks: func(base, target *mocks.MockenvDescriber) {
+				base.EXPECT().Describe().Return(testDescription, nil)
+				target.EXPECT().Describe().Return(prodDescription, nil)
+			},
+
+			wantedContent: `Comparing environment test (-) to prod (+)
+
+About
+
+  - Production      false
+  + Production      true
+  - Region          us-west-2
+  + Region          us-east-1
+
+Services
+
+  + worker          Backend Service
+
+Jobs
+
+  No differences
+
+Tags
+
+  No differences
+`,
+		},
+		"compares only the configurations if an environment can't be described": {
+			shouldOutputJSON: true,
+			setupMocks: func(base, target *mocks.MockenvDescriber) {
+				base.EXPECT().Describe().Return(testDescription, nil)
+				target.EXPECT().Describe().Return(nil, errors.New("AccessDenied: not authorized to perform sts:AssumeRole"))
+			},
+
+			wantedContent: configOnlyJSON,
+		},
+		"compares only the configurations if the describer of an environment can't be created": {
+			shouldOutputJSON:   true,
+			initCompareDescErr: errors.New("some error"),
+			setupMocks: func(base, target *mocks.MockenvDescriber) {
+				base.EXPECT().Describe().Return(testDescription, nil)
+			},
+
+			wantedContent: configOnlyJSON,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+			mockBaseDescriber := mocks.NewMockenvDescriber(ctrl)
+			mockTargetDescriber := mocks.NewMockenvDescriber(ctrl)
+			tc.setupMocks(mockBaseDescriber, mockTargetDescriber)
+
+			opts := &showEnvOpts{
+				showEnvVars: showEnvVars{
+					appName:          "phonetool",
+					name:             "test",
+					compareTo:        "prod",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				store:            mockStore,
+				describer:        mockBaseDescriber,
+				compareDescriber: mockTargetDescriber,
+				initEnvDescriber: func() error { return nil },
+				initCompareEnvDescriber: func() error {
+					return tc.initCompareDescErr
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
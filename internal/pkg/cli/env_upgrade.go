@@ -6,6 +6,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -19,6 +23,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/cobra"
 	"golang.org/x/mod/semver"
 )
@@ -33,6 +38,16 @@ to support the latest Copilot features.`
 	fmtEnvUpgradeStart    = "Upgrading environment %s from version %s to version %s."
 	fmtEnvUpgradeFailed   = "Failed to upgrade environment %s's template to version %s.\n"
 	fmtEnvUpgradeComplete = "Upgraded environment %s's template to version %s.\n"
+
+	envUpgradeMaxConcurrentUpgrades = 3 // Maximum number of environments upgraded at the same time.
+)
+
+// Statuses of an environment after running env upgrade with --all or --dry-run.
+const (
+	envUpgradeStatusUpgraded      = "upgraded"
+	envUpgradeStatusUpToDate      = "up to date"
+	envUpgradeStatusWouldUpgrade  = "would upgrade"
+	envUpgradeStatusUpgradeFailed = "failed"
 )
 
 // envUpgradeVars holds flag values.
@@ -40,6 +55,7 @@ type envUpgradeVars struct {
 	appName string // Required. Name of the application.
 	name    string // Required. Name of the environment.
 	all     bool   // True means all environments should be upgraded.
+	dryRun  bool   // True means the environments are only compared to the latest version without being upgraded.
 }
 
 // envUpgradeOpts represents the env upgrade command and holds the necessary data
@@ -56,6 +72,17 @@ type envUpgradeOpts struct {
 	// These functions are overriden in tests to provide mocks.
 	newEnvVersionGetter func(app, env string) (versionGetter, error)
 	newTemplateUpgrader func(conf *config.Environment) (envTemplateUpgrader, error)
+
+	w io.Writer
+}
+
+// envUpgradeResult holds the outcome of upgrading an environment.
+type envUpgradeResult struct {
+	name        string
+	fromVersion string
+	toVersion   string
+	status      string
+	err         error
 }
 
 func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
@@ -91,6 +118,7 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 			}
 			return cloudformation.New(sess), nil
 		},
+		w: os.Stdout,
 	}, nil
 }
 
@@ -135,15 +163,28 @@ func (o *envUpgradeOpts) Ask() error {
 
 // Execute updates the cloudformation stack of an environment to the latest version.
 // If the environment stack is busy updating, it spins and waits until the stack can be updated.
+// With --all or --dry-run, the environments are compared to the latest version concurrently and a summary table
+// is printed once they're all processed. A failure to upgrade an environment doesn't stop the upgrade of the others.
 func (o *envUpgradeOpts) Execute() error {
+	if !o.all && !o.dryRun {
+		return o.upgrade(o.name)
+	}
 	envs, err := o.listEnvsToUpgrade()
 	if err != nil {
 		return err
 	}
-	for _, env := range envs {
-		if err := o.upgrade(env); err != nil {
-			return err
+	results := o.upgradeEnvs(envs)
+	fmt.Fprint(o.w, envUpgradeResultsTable(results))
+	var failed int
+	for _, res := range results {
+		if res.err == nil {
+			continue
 		}
+		failed++
+		log.Errorf("Failed to upgrade environment %s: %v\n", res.name, res.err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("upgrade %d out of %d environments", failed, len(results))
 	}
 	return nil
 }
@@ -190,18 +231,96 @@ func (o *envUpgradeOpts) upgrade(env string) (err error) {
 		}
 		o.prog.Stop(log.Ssuccessf(fmtEnvUpgradeComplete, color.HighlightUserInput(env), color.Emphasize(deploy.LatestEnvTemplateVersion)))
 	}()
+	return o.upgradeTemplate(env, version)
+}
+
+// upgradeEnvs upgrades the environments that are behind the latest version, with at most
+// envUpgradeMaxConcurrentUpgrades at a time. The results are in the same order as the environments.
+func (o *envUpgradeOpts) upgradeEnvs(envs []string) []*envUpgradeResult {
+	results := make([]*envUpgradeResult, len(envs))
+	sem := make(chan struct{}, envUpgradeMaxConcurrentUpgrades)
+	done := make(chan struct{}, len(envs))
+	defer close(done)
+	for i, env := range envs {
+		results[i] = &envUpgradeResult{
+			name: env,
+		}
+		go func(res *envUpgradeResult) {
+			sem <- struct{}{}
+			o.upgradeEnv(res)
+			<-sem
+			done <- struct{}{}
+		}(results[i])
+	}
+	for i := 0; i < len(envs); i++ {
+		<-done
+	}
+	return results
+}
+
+func (o *envUpgradeOpts) upgradeEnv(res *envUpgradeResult) {
+	version, err := o.envVersion(res.name)
+	if err != nil {
+		res.status, res.err = envUpgradeStatusUpgradeFailed, err
+		return
+	}
+	res.fromVersion, res.toVersion = version, version
+	yes, err := shouldUpgradeEnv(res.name, version)
+	if err != nil {
+		res.status, res.err = envUpgradeStatusUpgradeFailed, err
+		return
+	}
+	if !yes {
+		res.status = envUpgradeStatusUpToDate
+		return
+	}
+	res.toVersion = deploy.LatestEnvTemplateVersion
+	if o.dryRun {
+		res.status = envUpgradeStatusWouldUpgrade
+		return
+	}
+	log.Infof(fmtEnvUpgradeStart+"\n", color.HighlightUserInput(res.name), color.Emphasize(version), color.Emphasize(deploy.LatestEnvTemplateVersion))
+	if err := o.upgradeTemplate(res.name, version); err != nil {
+		res.status, res.err = envUpgradeStatusUpgradeFailed, err
+		return
+	}
+	log.Successf(fmtEnvUpgradeComplete, color.HighlightUserInput(res.name), color.Emphasize(deploy.LatestEnvTemplateVersion))
+	res.status = envUpgradeStatusUpgraded
+}
+
+// upgradeTemplate updates the stack of the environment from version to the latest version of the environment template.
+func (o *envUpgradeOpts) upgradeTemplate(env, version string) error {
 	conf, err := o.store.GetEnvironment(o.appName, env)
 	if err != nil {
 		return err
 	}
+	// The application is fetched for its permissions boundary, that the roles of the upgraded environment must keep.
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %v", o.appName, err)
+	}
 	upgrader, err := o.newTemplateUpgrader(conf)
 	if err != nil {
 		return err
 	}
 	if version == deploy.LegacyEnvTemplateVersion {
-		return o.upgradeLegacyEnvironment(upgrader, conf, version, deploy.LatestEnvTemplateVersion)
+		err = o.upgradeLegacyEnvironment(upgrader, app, conf, version, deploy.LatestEnvTemplateVersion)
+	} else {
+		err = o.upgradeEnvironment(upgrader, app, conf, version, deploy.LatestEnvTemplateVersion)
+	}
+	if err != nil {
+		return err
+	}
+	return o.recordCLIVersion(conf)
+}
+
+// recordCLIVersion stores the version of the CLI that upgraded the environment.
+func (o *envUpgradeOpts) recordCLIVersion(conf *config.Environment) error {
+	conf.LastUpdatedByVersion = version.Version
+	if err := o.store.UpdateEnvironment(conf); err != nil {
+		return fmt.Errorf("record the CLI version that upgraded environment %s: %v", conf.Name, err)
 	}
-	return o.upgradeEnvironment(upgrader, conf, version, deploy.LatestEnvTemplateVersion)
+	return nil
 }
 
 func (o *envUpgradeOpts) envVersion(name string) (string, error) {
@@ -216,6 +335,22 @@ func (o *envUpgradeOpts) envVersion(name string) (string, error) {
 	return version, err
 }
 
+func envUpgradeResultsTable(results []*envUpgradeResult) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", "Name", "From Version", "To Version", "Status")
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", "----", "------------", "----------", "------")
+	for _, res := range results {
+		fromVersion, toVersion := res.fromVersion, res.toVersion
+		if fromVersion == "" {
+			fromVersion, toVersion = "-", "-"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", res.name, fromVersion, toVersion, res.status)
+	}
+	writer.Flush()
+	return b.String()
+}
+
 func shouldUpgradeEnv(env, version string) (bool, error) {
 	diff := semver.Compare(version, deploy.LatestEnvTemplateVersion)
 	if diff < 0 {
@@ -235,28 +370,38 @@ Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplate
 	return false, nil
 }
 
-func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.Environment, fromVersion, toVersion string) error {
+func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, app *config.Application, conf *config.Environment, fromVersion, toVersion string) error {
 	var importedVPC *config.ImportVPC
 	var adjustedVPC *config.AdjustVPC
+	var enableIPv6 bool
+	var accessLogs *config.AccessLogs
+	var importCertARNs []string
 	if conf.CustomConfig != nil {
 		importedVPC = conf.CustomConfig.ImportVPC
 		adjustedVPC = conf.CustomConfig.VPCConfig
+		enableIPv6 = conf.CustomConfig.EnableIPv6
+		accessLogs = conf.CustomConfig.AccessLogs
+		importCertARNs = conf.CustomConfig.ImportCertARNs
 	}
 
 	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
-		Version:           toVersion,
-		AppName:           conf.App,
-		Name:              conf.Name,
-		ImportVPCConfig:   importedVPC,
-		AdjustVPCConfig:   adjustedVPC,
-		CFNServiceRoleARN: conf.ExecutionRoleARN,
+		Version:             toVersion,
+		AppName:             conf.App,
+		Name:                conf.Name,
+		ImportVPCConfig:     importedVPC,
+		AdjustVPCConfig:     adjustedVPC,
+		EnableIPv6:          enableIPv6,
+		AccessLogsConfig:    accessLogs,
+		ImportCertARNs:      importCertARNs,
+		PermissionsBoundary: app.PermissionsBoundary,
+		CFNServiceRoleARN:   conf.ExecutionRoleARN,
 	}); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 	}
 	return nil
 }
 
-func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, conf *config.Environment, fromVersion, toVersion string) error {
+func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, app *config.Application, conf *config.Environment, fromVersion, toVersion string) error {
 	isDefaultEnv, err := o.isDefaultLegacyTemplate(upgrader, conf.App, conf.Name)
 	if err != nil {
 		return err
@@ -267,16 +412,17 @@ func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, co
 	}
 	if isDefaultEnv {
 		if err := upgrader.UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
-			Version:           toVersion,
-			AppName:           conf.App,
-			Name:              conf.Name,
-			CFNServiceRoleARN: conf.ExecutionRoleARN,
+			Version:             toVersion,
+			AppName:             conf.App,
+			Name:                conf.Name,
+			PermissionsBoundary: app.PermissionsBoundary,
+			CFNServiceRoleARN:   conf.ExecutionRoleARN,
 		}, albWorkloads...); err != nil {
 			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 		}
 		return nil
 	}
-	return o.upgradeLegacyEnvironmentWithVPCOverrides(upgrader, conf, fromVersion, toVersion, albWorkloads)
+	return o.upgradeLegacyEnvironmentWithVPCOverrides(upgrader, app, conf, fromVersion, toVersion, albWorkloads)
 }
 
 func (o *envUpgradeOpts) isDefaultLegacyTemplate(cfn envTemplater, appName, envName string) (bool, error) {
@@ -306,16 +452,17 @@ func (o *envUpgradeOpts) listLBWebServices() ([]string, error) {
 	return lbWebServiceNames, nil
 }
 
-func (o *envUpgradeOpts) upgradeLegacyEnvironmentWithVPCOverrides(upgrader legacyEnvUpgrader, conf *config.Environment,
+func (o *envUpgradeOpts) upgradeLegacyEnvironmentWithVPCOverrides(upgrader legacyEnvUpgrader, app *config.Application, conf *config.Environment,
 	fromVersion, toVersion string, albWorkloads []string) error {
 	if conf.CustomConfig != nil {
 		if err := upgrader.UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
-			Version:           toVersion,
-			AppName:           conf.App,
-			Name:              conf.Name,
-			ImportVPCConfig:   conf.CustomConfig.ImportVPC,
-			AdjustVPCConfig:   conf.CustomConfig.VPCConfig,
-			CFNServiceRoleARN: conf.ExecutionRoleARN,
+			Version:             toVersion,
+			AppName:             conf.App,
+			Name:                conf.Name,
+			ImportVPCConfig:     conf.CustomConfig.ImportVPC,
+			AdjustVPCConfig:     conf.CustomConfig.VPCConfig,
+			PermissionsBoundary: app.PermissionsBoundary,
+			CFNServiceRoleARN:   conf.ExecutionRoleARN,
 		}, albWorkloads...); err != nil {
 			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 		}
@@ -339,6 +486,13 @@ func buildEnvUpgradeCmd() *cobra.Command {
 		Use:    "upgrade",
 		Short:  "Upgrades the template of an environment to the latest version.",
 		Hidden: true,
+		Example: `
+  Upgrades the environment "test" of the application "phonetool".
+  /code $ copilot env upgrade -a phonetool -n test
+  Upgrades all the environments of the application, three at a time.
+  /code $ copilot env upgrade -a phonetool --all
+  Shows which environments are behind the latest version without upgrading them.
+  /code $ copilot env upgrade -a phonetool --all --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newEnvUpgradeOpts(vars)
 			if err != nil {
@@ -356,5 +510,6 @@ func buildEnvUpgradeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.all, allFlag, false, upgradeAllEnvsDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, envUpgradeDryRunFlagDescription)
 	return cmd
 }
@@ -4,13 +4,16 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -197,8 +200,9 @@ to support the latest Copilot features.`,
 
 func TestEnvUpgradeOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
-		given     func(ctrl *gomock.Controller) *envUpgradeOpts
-		wantedErr error
+		given        func(ctrl *gomock.Controller) *envUpgradeOpts
+		wantedOutput string
+		wantedErr    error
 	}{
 		"should skip upgrading if the environment version is already at least latest": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
@@ -225,6 +229,116 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 					},
 				}
 			},
+			wantedOutput: fmt.Sprintf(`Name                From Version        To Version          Status
+----                ------------        ----------          ------
+test                %-20s%-20sup to date
+prod                %-20s%-20sup to date
+`, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion),
+		},
+		"should upgrade the environments that are behind and keep going if one of them fails": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "staging",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").
+					Return(&config.Environment{
+						App:              "phonetool",
+						Name:             "test",
+						ExecutionRoleARN: "execARN",
+					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
+
+				mockTestTpl := mocks.NewMockversionGetter(ctrl)
+				mockTestTpl.EXPECT().Version().Return("v0.1.0", nil)
+				mockStagingTpl := mocks.NewMockversionGetter(ctrl)
+				mockStagingTpl.EXPECT().Version().Return("", errors.New("some error"))
+				mockProdTpl := mocks.NewMockversionGetter(ctrl)
+				mockProdTpl.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				envTpls := map[string]versionGetter{
+					"test":    mockTestTpl,
+					"staging": mockStagingTpl,
+					"prod":    mockProdTpl,
+				}
+
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					Version:           deploy.LatestEnvTemplateVersion,
+					AppName:           "phonetool",
+					Name:              "test",
+					CFNServiceRoleARN: "execARN",
+				}).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						all:     true,
+					},
+					store: mockStore,
+					newEnvVersionGetter: func(_, env string) (versionGetter, error) {
+						return envTpls[env], nil
+					},
+					newTemplateUpgrader: func(conf *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+			wantedOutput: fmt.Sprintf(`Name                From Version        To Version          Status
+----                ------------        ----------          ------
+test                v0.1.0              %-20supgraded
+staging             -                   -                   failed
+prod                %-20s%-20sup to date
+`, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion),
+			wantedErr: errors.New("upgrade 1 out of 3 environments"),
+		},
+		"should only compare the environments to the latest version on dry run": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				mockStore.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+
+				mockTestTpl := mocks.NewMockversionGetter(ctrl)
+				mockTestTpl.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+				mockProdTpl := mocks.NewMockversionGetter(ctrl)
+				mockProdTpl.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				envTpls := map[string]versionGetter{
+					"test": mockTestTpl,
+					"prod": mockProdTpl,
+				}
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						all:     true,
+						dryRun:  true,
+					},
+					store: mockStore,
+					newEnvVersionGetter: func(_, env string) (versionGetter, error) {
+						return envTpls[env], nil
+					},
+				}
+			},
+			wantedOutput: fmt.Sprintf(`Name                From Version        To Version          Status
+----                ------------        ----------          ------
+test                %-20s%-20swould upgrade
+prod                %-20s%-20sup to date
+`, deploy.LegacyEnvTemplateVersion, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion, deploy.LatestEnvTemplateVersion),
 		},
 		"should upgrade non-legacy environments with UpgradeEnvironment call": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
@@ -247,6 +361,11 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 							},
 						},
 					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:                "phonetool",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+				}, nil)
+				mockStore.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
 
 				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
 				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
@@ -256,7 +375,8 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 					ImportVPCConfig: &config.ImportVPC{
 						ID: "abc",
 					},
-					CFNServiceRoleARN: "execARN",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+					CFNServiceRoleARN:   "execARN",
 				}).Return(nil)
 
 				return &envUpgradeOpts{
@@ -275,6 +395,81 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should return an error if the CLI version that upgraded the environment can't be recorded": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v0.1.0", nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").
+					Return(&config.Environment{
+						App:              "phonetool",
+						Name:             "test",
+						ExecutionRoleARN: "execARN",
+					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateEnvironment(&config.Environment{
+					App:                  "phonetool",
+					Name:                 "test",
+					ExecutionRoleARN:     "execARN",
+					LastUpdatedByVersion: version.Version,
+				}).Return(errors.New("some error"))
+
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: mockStore,
+					prog:  mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newTemplateUpgrader: func(conf *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+			wantedErr: errors.New("record the CLI version that upgraded environment test: some error"),
+		},
+		"should return an error if the application can't be retrieved": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v0.1.0", nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+				}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+				mockStore.EXPECT().UpdateEnvironment(gomock.Any()).Times(0)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: mockStore,
+					prog:  mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+				}
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
 		"should upgrade default legacy environments without any VPC configuration": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
 				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
@@ -291,6 +486,11 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						Name:             "test",
 						ExecutionRoleARN: "execARN",
 					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:                "phonetool",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+				}, nil)
+				mockStore.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
 				mockStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
 					{
 						App:  "phonetool",
@@ -310,10 +510,11 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
 				mockUpgrader.EXPECT().EnvironmentTemplate("phonetool", "test").Return("template", nil)
 				mockUpgrader.EXPECT().UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
-					Version:           deploy.LatestEnvTemplateVersion,
-					AppName:           "phonetool",
-					Name:              "test",
-					CFNServiceRoleARN: "execARN",
+					Version:             deploy.LatestEnvTemplateVersion,
+					AppName:             "phonetool",
+					Name:                "test",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+					CFNServiceRoleARN:   "execARN",
 				}, "frontend").Return(nil)
 
 				return &envUpgradeOpts{
@@ -354,6 +555,8 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 							},
 						},
 					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
 				mockStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{}, nil)
 
 				mockTemplater := mocks.NewMocktemplater(ctrl)
@@ -404,6 +607,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						Name:             "test",
 						ExecutionRoleARN: "execARN",
 					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
 				mockStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{}, nil)
 
 				mockTemplater := mocks.NewMocktemplater(ctrl)
@@ -438,6 +642,8 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			opts := tc.given(ctrl)
+			b := &bytes.Buffer{}
+			opts.w = b
 
 			err := opts.Execute()
 
@@ -446,6 +652,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedOutput, b.String())
 		})
 	}
 }
@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envUseNamePrompt     = "Which environment of %s would you like to use by default?"
+	envUseNameHelpPrompt = "Commands that take an environment will pre-select this environment when prompting."
+)
+
+type useEnvVars struct {
+	name string
+}
+
+type useEnvOpts struct {
+	useEnvVars
+	appName string
+
+	store environmentGetter
+	ws    wsEnvDefaulter
+	sel   appEnvSelector
+}
+
+func newUseEnvOpts(vars useEnvVars) (*useEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	opts := &useEnvOpts{
+		useEnvVars: vars,
+		store:      store,
+		ws:         ws,
+		sel:        selector.NewSelect(prompt.New(), store),
+	}
+	if summary, err := ws.Summary(); err == nil {
+		opts.appName = summary.Application
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *useEnvOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the environment to use if it's not provided.
+func (o *useEnvOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envUseNamePrompt, color.HighlightUserInput(o.appName)), envUseNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// Execute records the environment as the default of the workspace.
+func (o *useEnvOpts) Execute() error {
+	if err := o.ws.SetDefaultEnvironment(o.name); err != nil {
+		return fmt.Errorf("set default environment to %s: %w", o.name, err)
+	}
+	log.Successf("Using environment %s by default in this workspace.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// defaultEnv returns the environment to use when the env flag isn't set, along with the selector options
+// to pre-select the default environment of the workspace.
+// If there is no terminal to prompt with, the default environment is used directly.
+func defaultEnv(flagValue, appName string, lister environmentLister) (string, []selector.SelectOption) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return "", nil
+	}
+	env := defaultEnvName(appName, ws, lister)
+	if env == "" {
+		return "", nil
+	}
+	if !prompt.IsInteractive() {
+		return env, nil
+	}
+	return "", []selector.SelectOption{selector.WithDefaultEnv(env)}
+}
+
+// defaultEnvName returns the default environment of the workspace if it still exists in the application.
+func defaultEnvName(appName string, ws wsEnvDefaulter, lister environmentLister) string {
+	if appName == "" {
+		return ""
+	}
+	summary, err := ws.Summary()
+	if err != nil || summary.Application != appName || summary.DefaultEnvironment == "" {
+		return ""
+	}
+	envs, err := lister.ListEnvironments(appName)
+	if err != nil {
+		return ""
+	}
+	for _, env := range envs {
+		if env.Name == summary.DefaultEnvironment {
+			return env.Name
+		}
+	}
+	log.Warningf("Ignoring default environment %s since it no longer exists in application %s.\n",
+		color.HighlightUserInput(summary.DefaultEnvironment), color.HighlightUserInput(appName))
+	return ""
+}
+
+// buildEnvUseCmd builds the command for setting the default environment of the workspace.
+func buildEnvUseCmd() *cobra.Command {
+	vars := useEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "use [name]",
+		Short: "Sets the default environment of the workspace.",
+		Long: `Sets the default environment of the workspace.
+Commands that take an environment, such as svc deploy, svc logs, svc status, and task run, pre-select it when prompting.`,
+
+		Example: `
+  Use the environment "test" by default.
+  /code $ copilot env use test
+  Select the environment to use by default.
+  /code $ copilot env use`,
+		Args: reservedArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUseEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				opts.name = args[0]
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	return cmd
+}
@@ -0,0 +1,246 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		mockStore func(m *mocks.MockenvironmentGetter)
+
+		wantedErr error
+	}{
+		"error if not in a workspace": {
+			mockStore: func(m *mocks.MockenvironmentGetter) {},
+			wantedErr: errNoAppInWorkspace,
+		},
+		"error if the environment does not exist": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			mockStore: func(m *mocks.MockenvironmentGetter) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"valid environment": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			mockStore: func(m *mocks.MockenvironmentGetter) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockenvironmentGetter(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &useEnvOpts{
+				useEnvVars: useEnvVars{
+					name: tc.inEnvName,
+				},
+				appName: tc.inAppName,
+				store:   mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvName string
+
+		mockSel func(m *mocks.MockappEnvSelector)
+
+		wantedEnv string
+		wantedErr error
+	}{
+		"skips prompting if the name is provided": {
+			inEnvName: "test",
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedEnv: "test",
+		},
+		"prompts for the environment": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(fmt.Sprintf(envUseNamePrompt, "phonetool"), envUseNameHelpPrompt, "phonetool").Return("prod", nil)
+			},
+			wantedEnv: "prod",
+		},
+		"wraps selection errors": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockappEnvSelector(ctrl)
+			tc.mockSel(mockSel)
+
+			opts := &useEnvOpts{
+				useEnvVars: useEnvVars{
+					name: tc.inEnvName,
+				},
+				appName: "phonetool",
+				sel:     mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnv, opts.name)
+			}
+		})
+	}
+}
+
+func TestUseEnvOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockWs func(m *mocks.MockwsEnvDefaulter)
+
+		wantedErr error
+	}{
+		"wraps workspace errors": {
+			mockWs: func(m *mocks.MockwsEnvDefaulter) {
+				m.EXPECT().SetDefaultEnvironment("test").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("set default environment to test: some error"),
+		},
+		"sets the default environment": {
+			mockWs: func(m *mocks.MockwsEnvDefaulter) {
+				m.EXPECT().SetDefaultEnvironment("test").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsEnvDefaulter(ctrl)
+			tc.mockWs(mockWs)
+
+			opts := &useEnvOpts{
+				useEnvVars: useEnvVars{
+					name: "test",
+				},
+				appName: "phonetool",
+				ws:      mockWs,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDefaultEnvName(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+
+		mockWs     func(m *mocks.MockwsEnvDefaulter)
+		mockLister func(m *mocks.MockenvironmentLister)
+
+		wanted string
+	}{
+		"no default if the summary can't be read": {
+			inAppName: "phonetool",
+			mockWs: func(m *mocks.MockwsEnvDefaulter) {
+				m.EXPECT().Summary().Return(nil, errors.New("some error"))
+			},
+			mockLister: func(m *mocks.MockenvironmentLister) {},
+		},
+		"no default if the workspace belongs to a different application": {
+			inAppName: "phonetool",
+			mockWs: func(m *mocks.MockwsEnvDefaulter) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "other", DefaultEnvironment: "test"}, nil)
+			},
+			mockLister: func(m *mocks.MockenvironmentLister) {},
+		},
+		"ignores an environment that no longer exists": {
+			inAppName: "phonetool",
+			mockWs: func(m *mocks.MockwsEnvDefaulter) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "phonetool", DefaultEnvironment: "test"}, nil)
+			},
+			mockLister: func(m *mocks.MockenvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "prod"}}, nil)
+			},
+		},
+		"returns the default environment": {
+			inAppName: "phonetool",
+			mockWs: func(m *mocks.MockwsEnvDefaulter) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "phonetool", DefaultEnvironment: "test"}, nil)
+			},
+			mockLister: func(m *mocks.MockenvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			wanted: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsEnvDefaulter(ctrl)
+			mockLister := mocks.NewMockenvironmentLister(ctrl)
+			tc.mockWs(mockWs)
+			tc.mockLister(mockLister)
+
+			// WHEN
+			got := defaultEnvName(tc.inAppName, mockWs, mockLister)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -4,9 +4,14 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"sort"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -14,17 +19,21 @@ import (
 // Long flag names.
 const (
 	// Common flags.
-	nameFlag     = "name"
-	appFlag      = "app"
-	envFlag      = "env"
-	workloadFlag = "workload"
-	svcTypeFlag  = "svc-type"
-	jobTypeFlag  = "job-type"
-	typeFlag     = "type"
-	profileFlag  = "profile"
-	yesFlag      = "yes"
-	jsonFlag     = "json"
-	allFlag      = "all"
+	nameFlag      = "name"
+	appFlag       = "app"
+	envFlag       = "env"
+	workloadFlag  = "workload"
+	workloadsFlag = "workloads"
+	svcTypeFlag   = "svc-type"
+	jobTypeFlag   = "job-type"
+	typeFlag      = "type"
+	profileFlag   = "profile"
+	yesFlag       = "yes"
+	jsonFlag      = "json"
+	allFlag       = "all"
+	wideFlag      = "wide"
+	forceFlag     = "force"
+	quietFlag     = "quiet"
 
 	// Command specific flags.
 	dockerFileFlag        = "dockerfile"
@@ -37,9 +46,14 @@ const (
 	startTimeFlag         = "start-time"
 	endTimeFlag           = "end-time"
 	tasksFlag             = "tasks"
+	containerFlag         = "container"
+	filterPatternFlag     = "filter-pattern"
+	errorsFlag            = "errors"
+	warningsFlag          = "warnings"
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
+	servicesFlag          = "services"
 	githubURLFlag         = "github-url"
 	githubAccessTokenFlag = "github-access-token"
 	gitBranchFlag         = "git-branch"
@@ -48,13 +62,35 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	fixFlag               = "fix"
+	appsCleanupFlag       = "apps-cleanup"
+	keepDNSDelegationFlag = "keep-dns-delegation"
+	dryRunFlag            = "dry-run"
+	pruneTaskDefsFlag     = "prune-task-definitions"
+	keepFlag              = "keep"
+	eventsFlag            = "events"
+	noWaitFlag            = "no-wait"
+	notifyTopicARNFlag    = "notify-topic-arn"
+	statusFlag            = "status"
+	buildToolFlag         = "build-tool"
+	strictFlag            = "strict"
+	allowAppOverrideFlag  = "allow-app-override"
+	allowLatestFlag       = "allow-latest"
+	fromStackFlag         = "from-stack"
+	ignoreEnvVersionFlag  = "ignore-env-version"
+	maxContextSizeFlag    = "max-context-size"
 
-	storageTypeFlag         = "storage-type"
-	storagePartitionKeyFlag = "partition-key"
-	storageSortKeyFlag      = "sort-key"
-	storageNoSortFlag       = "no-sort"
-	storageLSIConfigFlag    = "lsi"
-	storageNoLSIFlag        = "no-lsi"
+	storageTypeFlag           = "storage-type"
+	storagePartitionKeyFlag   = "partition-key"
+	storageSortKeyFlag        = "sort-key"
+	storageNoSortFlag         = "no-sort"
+	storageLSIConfigFlag      = "lsi"
+	storageNoLSIFlag          = "no-lsi"
+	storageTTLAttributeFlag   = "ttl-attribute"
+	storageBillingModeFlag    = "billing-mode"
+	storageReadCapacityFlag   = "read-capacity"
+	storageWriteCapacityFlag  = "write-capacity"
+	storageStreamViewTypeFlag = "stream-view-type"
 
 	taskGroupNameFlag  = "task-group-name"
 	countFlag          = "count"
@@ -68,25 +104,42 @@ const (
 	envVarsFlag        = "env-vars"
 	commandFlag        = "command"
 	taskDefaultFlag    = "default"
+	generateCmdFlag    = "generate-cmd"
+	waitFlag           = "wait"
+	taskIDFlag         = "task-id"
 
-	vpcIDFlag          = "import-vpc-id"
-	publicSubnetsFlag  = "import-public-subnets"
-	privateSubnetsFlag = "import-private-subnets"
+	vpcIDFlag                = "import-vpc-id"
+	publicSubnetsFlag        = "import-public-subnets"
+	privateSubnetsFlag       = "import-private-subnets"
+	importSecurityGroupsFlag = "import-security-groups"
 
 	vpcCIDRFlag            = "override-vpc-cidr"
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
 	privateSubnetCIDRsFlag = "override-private-cidrs"
+	azFlag                 = "az"
 
 	defaultConfigFlag = "default-config"
+	enableIPv6Flag    = "enable-ipv6"
+
+	enableAccessLogsFlag = "enable-access-logs"
+	accessLogsBucketFlag = "access-logs-bucket"
+	accessLogsPrefixFlag = "access-logs-prefix"
+
+	importCertARNsFlag = "import-cert-arns"
+
+	permissionsBoundaryFlag = "permissions-boundary"
 
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
 	sessionTokenFlag    = "aws-session-token"
+	credsFromStdinFlag  = "creds-from-stdin"
 	regionFlag          = "region"
 
 	retriesFlag  = "retries"
 	timeoutFlag  = "timeout"
 	scheduleFlag = "schedule"
+
+	compareToFlag = "compare-to"
 )
 
 // Short flag names.
@@ -106,6 +159,8 @@ const (
 	envsFlagShort              = "e"
 
 	scheduleFlagShort = "s"
+
+	quietFlagShort = "q"
 )
 
 // Descriptions for flags.
@@ -118,6 +173,10 @@ Mutually exclusive with -%s, --%s`, dockerFileFlagShort, dockerFileFlag)
 Mutually exclusive with -%s, --%s`, imageFlagShort, imageFlag)
 	storageTypeFlagDescription = fmt.Sprintf(`Type of storage to add. Must be one of:
 %s`, strings.Join(template.QuoteSliceFunc(storageTypes), ", "))
+	storageBillingModeFlagDescription = fmt.Sprintf(`Optional. Billing mode of the DDB table. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(ddbBillingModes), ", "))
+	storageStreamViewTypeFlagDescription = fmt.Sprintf(`Optional. Enables a stream on the DDB table with the item information to write. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(ddbStreamViewTypes), ", "))
 	jobTypeFlagDescription = fmt.Sprintf(`Type of job to create. Must be one of:
 %s`, strings.Join(template.QuoteSliceFunc(manifest.JobTypes), ", "))
 	wkldTypeFlagDescription = fmt.Sprintf(`Type of job or svc to create. Must be one of:
@@ -145,23 +204,35 @@ const (
 	profileFlagDescription  = "Name of the profile."
 	yesFlagDescription      = "Skips confirmation prompt."
 	jsonFlagDescription     = "Optional. Outputs in JSON format."
+	quietFlagDescription    = `Optional. Only writes errors and the requested output, such as JSON documents.
+Suppresses progress spinners and informational messages.`
 
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated with commas.
 Allows you to categorize resources.`
-	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
+	stackOutputDirFlagDescription = "Optional. Writes the stack template, template configuration and addons template to a directory."
 	prodEnvFlagDescription        = "If the environment contains production services."
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
 	followFlagDescription = "Optional. Specifies if the logs should be streamed."
-	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
-Defaults to all logs. Only one of start-time / since may be used.`
-	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
+	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, 3h, 2d or 1w,
+or since "today" or "yesterday". Defaults to all logs. Only one of start-time / since may be used.`
+	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339) or relative time like 2d.
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
 Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription = "Optional. Only return logs from specific task IDs."
+	deployAllFlagDescription = `Optional. Deploy every service and job of the workspace,
+each after the workloads it depends on.`
+	deployWorkloadsFlagDescription = `Optional. Names of the services and jobs to deploy,
+each after the workloads it depends on.`
+	containerLogsFlagDescription = `Optional. Only return logs from a specific container of the tasks,
+such as the service's main container or a sidecar like "firelens_log_router".`
+	filterPatternFlagDescription = `Optional. Only return logs matching a CloudWatch Logs filter pattern.
+Cannot be used with --errors or --warnings.`
+	errorsFlagDescription   = `Optional. Only return error logs, shortcut for --filter-pattern "?ERROR ?FATAL ?panic".`
+	warningsFlagDescription = `Optional. Only return warning and error logs.`
 
 	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
 	githubURLFlagDescription         = "GitHub repository URL for your service."
@@ -170,12 +241,58 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
+	envServicesFlagDescription       = "Optional. Show the running tasks, healthy targets and latest deployment of each service in your environment."
+	envWideFlagDescription           = "Optional. Show the region, account, template version and deployed services of each environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
+	fixFlagDescription               = "Optional. Rewrite the inconsistent records."
+	appsCleanupFlagDescription       = `Optional. Remove the environment's account and region from the application
+if no other environment is deployed there.`
+	keepDNSDelegationFlagDescription = `Optional. Keep the application's DNS delegation to the environment's account
+even if no other environment is deployed there.`
+	appDeleteDryRunFlagDescription     = "Optional. List the resources that would be deleted without deleting them."
+	appUpdateTagsDryRunFlagDescription = "Optional. List the stacks whose tags would change without updating them."
+	appUpdateTagsEnvFlagDescription    = "Optional. Only update the stacks of this environment."
+	noWaitFlagDescription              = `Optional. Return as soon as the stack create or update has started
+instead of waiting for the deployment to complete.`
+	svcStatusEventsFlagDescription = `Optional. Show the deployment configuration, current deployments
+and the last 25 events of the ECS service.`
+	svcStatusTasksFlagDescription = `Optional. Show the image, status, health and exit code
+of each container of the service's running tasks.`
+	svcDeployEnvsFlagDescription = `Name of the environment. Can be specified multiple times or as a comma-separated list
+to deploy to each environment in order.`
+	svcPackageEnvsFlagDescription = `Name of the environment. Can be specified multiple times or as a comma-separated list
+together with --output-dir to package each environment.`
+	svcPauseForceFlagDescription  = "Optional. Pause a service with autoscaling by also setting its minimum and maximum capacity to 0."
+	notifyTopicARNFlagDescription = `Optional. ARN of an SNS topic to publish a deployment event to
+after deploying. Defaults to "notify_topic_arn" in copilot/.workspace.`
+	buildToolFlagDescription = `Optional. Tool that builds the images from Dockerfiles: "docker" builds them locally,
+"remote" builds them with the application's CodeBuild project. Defaults to "build_tool" in copilot/.workspace or "docker".`
+	strictFlagDescription = `Optional. Fail the deployment if the manifest has fields that have no effect
+for the type of the workload, instead of only warning about them.`
+	svcDeployForceFlagDescription = `Optional. Update the service stack even if the image and the template
+are the same as the deployed ones.`
+	pruneTaskDefsFlagDescription = `Optional. After deploying, deregister the task definition revisions of the service
+except for the newest N. The revision in use and the one before it are always kept.`
+	svcPruneKeepFlagDescription = `Optional. Number of newest task definition revisions to keep.
+The revision in use and the one before it are always kept.`
+	svcPruneDryRunFlagDescription = "Optional. List the task definition revisions that would be deregistered without deregistering them."
+	wkldInitDryRunFlagDescription = `Optional. Print the manifest to stdout without writing it
+or adding the workload to the application.`
+	allowAppOverrideFlagDescription = `Optional. Use the application of --app even if the workspace
+is registered with a different application.`
+	allowLatestFlagDescription = `Optional. Use the "latest" image tag if --tag isn't provided
+and the tag can't be derived from a git repository.`
+	fromStackFlagDescription = `Optional. Register the environment from its existing CloudFormation stack
+instead of deploying it. The configuration of the environment is read from the stack.`
+	ignoreEnvVersionFlagDescription = `Optional. Skip checking that the version of the environment supports
+the features of the manifest.`
+	maxContextSizeFlagDescription = `Optional. Fail the deployment if the build context of an image is larger
+than this many megabytes (MB), instead of only warning above 250 MB.`
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageWorkloadFlagDescription     = "Name of the service or job to associate with storage."
@@ -187,6 +304,9 @@ Must be of the format '<keyName>:<dataType>'.`
 	storageNoLSIFlagDescription     = `Optional. Don't ask about configuring alternate sort keys.`
 	storageLSIConfigFlagDescription = `Optional. Attribute to use as an alternate sort key. May be specified up to 5 times.
 Must be of the format '<keyName>:<dataType>'.`
+	storageTTLAttributeFlagDescription  = "Optional. Name of the attribute holding the time at which items expire."
+	storageReadCapacityFlagDescription  = "Optional. Read capacity units of a provisioned table."
+	storageWriteCapacityFlagDescription = "Optional. Write capacity units of a provisioned table."
 
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
@@ -198,31 +318,125 @@ Must be of the format '<keyName>:<dataType>'.`
 	taskGroupFlagDescription     = `Optional. The group name of the task. 
 Tasks with the same group name share the same set of resources. 
 (default directory name)`
+	taskLogsGroupFlagDescription = `Optional. The group name of the tasks.
+(default directory name)`
+	taskIDLogsFlagDescription   = "Optional. Only return logs from specific task IDs. Can be specified multiple times."
 	taskImageTagFlagDescription = `Optional. The container image tag in addition to "latest".`
+	generateCmdFlagDescription  = `Optional. Print the equivalent "aws ecs run-task" command instead of running the tasks.
+Cannot be specified with '` + followFlag + `' or '` + waitFlag + `'.`
+	taskWaitFlagDescription = `Optional. Wait for the tasks to stop and exit with the exit code of their essential container.
+Implied by '` + followFlag + `'.`
+	taskJSONFlagDescription = `Optional. Output the final status of the stopped tasks in JSON format.
+Must be specified with '` + waitFlag + `' and cannot be specified with '` + followFlag + `'.`
 
-	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
-	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
-	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
+	vpcIDFlagDescription                = "Optional. Use an existing VPC ID."
+	publicSubnetsFlagDescription        = "Optional. Use existing public subnet IDs."
+	privateSubnetsFlagDescription       = "Optional. Use existing private subnet IDs."
+	importSecurityGroupsFlagDescription = `Optional. Existing security group IDs in the imported VPC
+to attach to all services in addition to the environment's security group.`
 
 	vpcCIDRFlagDescription            = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
 	privateSubnetCIDRsFlagDescription = "Optional. CIDR to use for private subnets (default 10.0.2.0/24,10.0.3.0/24)."
+	azFlagDescription                 = `Optional. Availability zone to spread the subnets across.
+Repeat the flag once per zone, in the same order as the subnet CIDRs (default the first two zones of the region).`
 
 	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
+	enableIPv6FlagDescription    = "Optional. Enable IPv6 for the VPC, subnets and public load balancer of the environment."
+
+	enableAccessLogsFlagDescription = "Optional. Store the access logs of the environment's public load balancer in S3."
+	accessLogsBucketFlagDescription = `Optional. Name of an existing S3 bucket to store the access logs in.
+The bucket must allow the Elastic Load Balancing account of the region to write to it.
+Implies --enable-access-logs (default a new bucket created with the environment).`
+	accessLogsPrefixFlagDescription = `Optional. Prefix of the access log objects in the S3 bucket.
+Implies --enable-access-logs.`
+
+	importCertARNsFlagDescription = `Optional. ARN of an existing ACM certificate for the HTTPS listener of the public load balancer.
+Separate multiple certificates with commas, the first one is the default certificate.
+Cannot be used if the application has a domain.`
+
+	permissionsBoundaryFlagDescription = `Optional. ARN of an IAM managed policy to set as the permissions boundary
+of every IAM role created for the application, its environments, services and jobs.`
 
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
-	envRegionTokenFlagDescription  = "Optional. An AWS region where the environment will be created."
+	credsFromStdinFlagDescription  = `Optional. Read temporary credentials from standard input
+in the JSON format of the output of "aws sts assume-role".`
+	envRegionTokenFlagDescription = "Optional. An AWS region where the environment will be created."
 
 	retriesFlagDescription = "Optional. The number of times to try restarting the job on a failure."
 	timeoutFlagDescription = `Optional. The total execution time for the task, including retries.
 Accepts valid Go duration strings. For example: "2h", "1h30m", "900s".`
+	envShowTimeoutFlagDescription = `Optional. The maximum time spent retrieving the health of the services.
+Services whose health isn't retrieved in time are shown without it. Accepts valid Go duration strings. For example: "30s", "1m".`
+	envCompareToFlagDescription = `Optional. Name of another environment to compare the environment to.
+Shows the differences in region, account, services, jobs and tags, and with --resources, the resource types.`
 	scheduleFlagDescription = `The schedule on which to run this job. 
 Accepts cron expressions of the format (M H DoM M DoW) and schedule definition strings. 
 For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".
 AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021)"
 are also accepted.`
+	jobHistoryLimitFlagDescription  = "Optional. The maximum number of executions returned."
+	jobHistoryStatusFlagDescription = `Optional. Only show the executions with this status.
+Must be one of "running", "succeeded", "failed", "timed_out" or "aborted".`
+
+	svcDeploymentsLimitFlagDescription = "Optional. The maximum number of deployments returned."
+
+	upgradeAllEnvsDescription       = "Optional. Upgrade all environments."
+	envUpgradeDryRunFlagDescription = "Optional. Show the environments that are behind the latest version without upgrading them."
 
-	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
+	svcDeleteAllFlagDescription = `Optional. Delete the service from all environments and from the application.
+Cannot be specified with --env.`
 )
+
+// reservedTagKeys are the tag keys that Copilot sets on resources, which can't be set with --resource-tags.
+var reservedTagKeys = []string{deploy.AppTagKey, deploy.EnvTagKey, deploy.ServiceTagKey, deploy.TaskTagKey}
+
+// resourceTagsValue is the value of the --resource-tags flag.
+// The pairs of every occurrence of the flag are validated together, so that a key can't be set twice.
+type resourceTagsValue struct {
+	pairs []string
+	tags  *map[string]string
+}
+
+func newResourceTagsValue(tags *map[string]string) *resourceTagsValue {
+	return &resourceTagsValue{tags: tags}
+}
+
+// Set parses the comma-separated key=value pairs of an occurrence of the flag.
+func (v *resourceTagsValue) Set(val string) error {
+	pairs, err := csv.NewReader(strings.NewReader(val)).Read()
+	if err == io.EOF {
+		// Let the empty value be reported as a malformed pair.
+		pairs, err = []string{val}, nil
+	}
+	if err != nil {
+		return fmt.Errorf("split %s into key=value pairs: %w", val, err)
+	}
+	all := append(append([]string{}, v.pairs...), pairs...)
+	parsed, err := tags.Parse(all, reservedTagKeys...)
+	if err != nil {
+		return err
+	}
+	v.pairs = all
+	*v.tags = parsed
+	return nil
+}
+
+// Type returns the same type as the flags created with StringToStringVar.
+func (v *resourceTagsValue) Type() string {
+	return "stringToString"
+}
+
+// String returns the tags in the same format as the flags created with StringToStringVar.
+func (v *resourceTagsValue) String() string {
+	var pairs []string
+	if v.tags != nil {
+		for key, value := range *v.tags {
+			pairs = append(pairs, key+"="+value)
+		}
+	}
+	sort.Strings(pairs)
+	return "[" + strings.Join(pairs, ",") + "]"
+}
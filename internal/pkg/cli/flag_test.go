@@ -0,0 +1,76 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTagsValue(t *testing.T) {
+	testCases := map[string]struct {
+		inArgs []string
+
+		wanted       map[string]string
+		wantedString string
+		wantedErr    string
+	}{
+		"leaves the tags unset without the flag": {
+			wantedString: "[]",
+		},
+		"parses the pairs of every occurrence of the flag": {
+			inArgs: []string{"--resource-tags", "team=payments,Env=prod", "--resource-tags", "source/revision=bb133e7"},
+			wanted: map[string]string{
+				"team":            "payments",
+				"Env":             "prod",
+				"source/revision": "bb133e7",
+			},
+			wantedString: "[Env=prod,source/revision=bb133e7,team=payments]",
+		},
+		"keeps the separators in the values": {
+			inArgs: []string{"--resource-tags", "query=a=b,path=/a/b=="},
+			wanted: map[string]string{
+				"query": "a=b",
+				"path":  "/a/b==",
+			},
+			wantedString: "[path=/a/b==,query=a=b]",
+		},
+		"errors if the value is empty": {
+			inArgs:    []string{"--resource-tags", ""},
+			wantedErr: `invalid argument "" for "--resource-tags" flag: tag "" must be formatted as key=value`,
+		},
+		"errors if a key is reserved by copilot": {
+			inArgs:    []string{"--resource-tags", "team=payments,copilot-environment=prod"},
+			wantedErr: `invalid argument "team=payments,copilot-environment=prod" for "--resource-tags" flag: tag "copilot-environment=prod": key copilot-environment is reserved`,
+		},
+		"errors if a key is set again in another occurrence of the flag": {
+			inArgs:    []string{"--resource-tags", "env=prod", "--resource-tags", "Env=test"},
+			wantedErr: `invalid argument "Env=test" for "--resource-tags" flag: tag "Env=test": key Env is already set by tag "env=prod"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var resourceTags map[string]string
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			value := newResourceTagsValue(&resourceTags)
+			flags.Var(value, resourceTagsFlag, resourceTagsFlagDescription)
+
+			// WHEN
+			err := flags.Parse(tc.inArgs)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, resourceTags)
+			require.Equal(t, tc.wantedString, value.String())
+		})
+	}
+}
@@ -8,19 +8,93 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
 const (
 	inputImageTagPrompt = "Input an image tag value:"
+
+	gitPorcelainStatusWidth = 3 // Number of characters before the path in "git status --porcelain" output, e.g. "?? ".
 )
 
-func getVersionTag(runner runner) (string, error) {
+// gitRepo runs git commands against the repository of the current working directory.
+type gitRepo struct {
+	runner runner
+}
+
+func newGitRepo() *gitRepo {
+	return &gitRepo{
+		runner: command.New(),
+	}
+}
+
+// IsRepository returns true if the current working directory is inside a git work tree.
+func (g *gitRepo) IsRepository() bool {
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	if err := runner.Run("git", []string{"describe", "--always"}, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+	if err := g.runner.Run("git", []string{"rev-parse", "--is-inside-work-tree"}, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return false
+	}
+	return strings.TrimSpace(stdout.String()) == "true"
+}
+
+// HeadCommit returns the SHA of the commit checked out in the repository, or the empty string if there is none.
+func (g *gitRepo) HeadCommit() string {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := g.runner.Run("git", []string{"rev-parse", "HEAD"}, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(stdout.String())
+}
+
+// UncommittedFiles returns the files under path that are modified, staged or untracked.
+// The paths are relative to the root of the repository.
+func (g *gitRepo) UncommittedFiles(path string) ([]string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := g.runner.Run("git", []string{"status", "--porcelain", "--untracked-files=all", "--", path}, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return nil, fmt.Errorf("get git status of %s: %w", path, err)
+	}
+	var files []string
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if len(line) <= gitPorcelainStatusWidth {
+			continue
+		}
+		files = append(files, strings.TrimSpace(line[gitPorcelainStatusWidth:]))
+	}
+	return files, nil
+}
+
+// warnUncommittedManifests warns if the copilot directory has changes that aren't committed to the git repository,
+// so that the configuration that gets deployed can be found in version control.
+// The check is best effort: errors are ignored so that they don't fail the command.
+func warnUncommittedManifests(git gitStatusReader, ws copilotDirGetter) {
+	if !git.IsRepository() {
+		return
+	}
+	dir, err := ws.CopilotDirPath()
+	if err != nil {
+		return
+	}
+	files, err := git.UncommittedFiles(dir)
+	if err != nil || len(files) == 0 {
+		return
+	}
+	log.Warningf(`The %s directory has uncommitted changes:
+  %s
+Commit them so that the deployed configuration is tracked in version control.
+`, workspace.CopilotDirName, strings.Join(files, "\n  "))
+}
+
+func getVersionTag(runner runner, flags ...string) (string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := runner.Run("git", append([]string{"describe", "--always"}, flags...), command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
 		return "", err
 	}
 
@@ -28,6 +102,44 @@ func getVersionTag(runner runner) (string, error) {
 	return strings.TrimSpace(stdout.String()), nil
 }
 
+// getShortCommit returns the abbreviated SHA of the commit checked out in the repository, or the empty string if there is none.
+func getShortCommit(runner runner) string {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := runner.Run("git", []string{"rev-parse", "--short", "HEAD"}, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(stdout.String())
+}
+
+// deployImageTag returns the tag of the image to build and deploy.
+// If the tag isn't provided, it is derived from the git repository so that the deployed image can be traced back to its commit.
+// Outside of a git repository, an error is returned unless the "latest" tag is allowed.
+func deployImageTag(tag string, allowLatest bool, cmd runner) (string, error) {
+	if tag != "" {
+		return tag, nil
+	}
+	tag, err := getVersionTag(cmd, "--dirty")
+	if err == nil {
+		log.Infof("Using image tag %s derived from the git repository.\n", color.HighlightUserInput(tag))
+		return tag, nil
+	}
+	if !allowLatest {
+		return "", fmt.Errorf("couldn't derive an image tag from a git repository: provide one with --%s or pass --%s to use the %q tag", imageTagFlag, allowLatestFlag, imageTagLatest)
+	}
+	log.Warningf("Couldn't derive an image tag from a git repository, using image tag %s.\n", color.HighlightUserInput(imageTagLatest))
+	return imageTagLatest, nil
+}
+
+// additionalImageTags returns the tags to push along with the image tag:
+// the short SHA of the commit the image is built from, if it differs from the image tag.
+func additionalImageTags(imageTag, commitTag string) []string {
+	if commitTag == "" || commitTag == imageTag {
+		return nil
+	}
+	return []string{commitTag}
+}
+
 func askImageTag(tag string, prompter prompter, cmd runner) (string, error) {
 	if tag != "" {
 		return tag, nil
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// writeStdout returns a fake runner that writes out to the stdout of the command.
+func writeStdout(out string, err error) func(name string, args []string, options ...command.Option) error {
+	return func(name string, args []string, options ...command.Option) error {
+		cmd := &exec.Cmd{}
+		for _, opt := range options {
+			opt(cmd)
+		}
+		cmd.Stdout.Write([]byte(out))
+		return err
+	}
+}
+
+func TestGitRepo_IsRepository(t *testing.T) {
+	testCases := map[string]struct {
+		mockRunner func(m *mocks.Mockrunner)
+
+		wanted bool
+	}{
+		"inside a work tree": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--is-inside-work-tree"}, gomock.Any()).
+					DoAndReturn(writeStdout("true\n", nil))
+			},
+			wanted: true,
+		},
+		"not a git repository": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--is-inside-work-tree"}, gomock.Any()).
+					DoAndReturn(writeStdout("", errors.New("exit status 128")))
+			},
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.mockRunner(m)
+			git := &gitRepo{runner: m}
+
+			// WHEN
+			got := git.IsRepository()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestGitRepo_HeadCommit(t *testing.T) {
+	testCases := map[string]struct {
+		mockRunner func(m *mocks.Mockrunner)
+
+		wanted string
+	}{
+		"returns the sha of HEAD": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "HEAD"}, gomock.Any()).
+					DoAndReturn(writeStdout("bb133e7a6d1d\n", nil))
+			},
+			wanted: "bb133e7a6d1d",
+		},
+		"returns empty string if not a git repository": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "HEAD"}, gomock.Any()).
+					DoAndReturn(writeStdout("", errors.New("exit status 128")))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.mockRunner(m)
+			git := &gitRepo{runner: m}
+
+			// WHEN
+			got := git.HeadCommit()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestGitRepo_UncommittedFiles(t *testing.T) {
+	testCases := map[string]struct {
+		mockRunner func(m *mocks.Mockrunner)
+
+		wantedFiles []string
+		wantedErr   error
+	}{
+		"returns modified, staged and untracked files": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"status", "--porcelain", "--untracked-files=all", "--", "/my-repo/copilot"}, gomock.Any()).
+					DoAndReturn(writeStdout(" M copilot/api/manifest.yml\nA  copilot/pipeline.yml\n?? copilot/worker/manifest.yml\n", nil))
+			},
+			wantedFiles: []string{"copilot/api/manifest.yml", "copilot/pipeline.yml", "copilot/worker/manifest.yml"},
+		},
+		"returns nothing if the directory is committed": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).DoAndReturn(writeStdout("", nil))
+			},
+		},
+		"wraps git errors": {
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).DoAndReturn(writeStdout("", errors.New("some error")))
+			},
+			wantedErr: errors.New("get git status of /my-repo/copilot: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.mockRunner(m)
+			git := &gitRepo{runner: m}
+
+			// WHEN
+			files, err := git.UncommittedFiles("/my-repo/copilot")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFiles, files)
+		})
+	}
+}
@@ -9,10 +9,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
@@ -79,6 +81,8 @@ type initOpts struct {
 	initWkldVars *initWkldVars
 
 	prompt prompter
+	git    gitStatusReader
+	ws     copilotDirGetter
 
 	setupWorkloadInit func(*initOpts, string) error
 }
@@ -92,14 +96,19 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	deployStore, err := deploy.NewStore(ssm)
+	if err != nil {
+		return nil, err
+	}
 	sessProvider := sessions.NewProvider()
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
 	}
 	prompt := prompt.New()
-	sel := selector.NewWorkspaceSelect(prompt, ssm, ws)
+	sel := selector.NewWorkspaceSelect(prompt, ssm, ws, selector.WithImageLister(ecr.New(defaultSess)))
 	spin := termprogress.NewSpinner()
+	git := newGitRepo()
 	id := identity.New(defaultSess)
 	deployer := cloudformation.New(defaultSess)
 	if err != nil {
@@ -111,6 +120,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		},
 		store:    ssm,
 		ws:       ws,
+		git:      git,
 		prompt:   prompt,
 		identity: id,
 		cfn:      deployer,
@@ -139,14 +149,17 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		},
 
 		store:        ssm,
+		deployStore:  deployStore,
 		prompt:       prompt,
 		ws:           ws,
 		unmarshal:    manifest.UnmarshalWorkload,
 		sel:          sel,
 		spinner:      spin,
 		cmd:          command.New(),
+		git:          git,
 		sessProvider: sessProvider,
 	}
+	deploySvcCmd.deployedImages = deploySvcCmd.serviceDeployedImages
 	deployJobCmd := &deployJobOpts{
 		deployWkldVars: deployWkldVars{
 			envName:  defaultEnvironmentName,
@@ -160,6 +173,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		sel:          sel,
 		spinner:      spin,
 		cmd:          command.New(),
+		git:          git,
 		sessProvider: sessProvider,
 	}
 
@@ -175,6 +189,8 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		appName: &initAppCmd.name,
 
 		prompt: prompt,
+		git:    git,
+		ws:     ws,
 
 		setupWorkloadInit: func(o *initOpts, wkldType string) error {
 			wlInitializer := &initialize.WorkloadInitializer{Store: ssm, Ws: ws, Prog: spin, Deployer: deployer}
@@ -263,7 +279,14 @@ containerized services that operate together.`))
 	if err := o.deployEnv(); err != nil {
 		return err
 	}
-	return o.deploy()
+	if err := o.deploy(); err != nil {
+		return err
+	}
+	if !o.ShouldDeploy {
+		// Deployments warn about uncommitted manifests themselves.
+		warnUncommittedManifests(o.git, o.ws)
+	}
+	return nil
 }
 
 func (o *initOpts) logWorkloadTypeAck() {
@@ -136,6 +136,9 @@ func TestInitOpts_Run(t *testing.T) {
 
 				opts.prompt.(*climocks.Mockprompter).EXPECT().Confirm(initShouldDeployPrompt, initShouldDeployHelpPrompt, gomock.Any()).
 					Return(false, nil)
+				opts.git.(*climocks.MockgitStatusReader).EXPECT().IsRepository().Return(true)
+				opts.ws.(*climocks.MockcopilotDirGetter).EXPECT().CopilotDirPath().Return("/my-repo/copilot", nil)
+				opts.git.(*climocks.MockgitStatusReader).EXPECT().UncommittedFiles("/my-repo/copilot").Return([]string{"copilot/api/manifest.yml"}, nil)
 			},
 		},
 	}
@@ -160,6 +163,8 @@ func TestInitOpts_Run(t *testing.T) {
 				deploySvcCmd: climocks.NewMockactionCommand(ctrl),
 
 				prompt: climocks.NewMockprompter(ctrl),
+				git:    climocks.NewMockgitStatusReader(ctrl),
+				ws:     climocks.NewMockcopilotDirGetter(ctrl),
 
 				// These fields are used for logging, the values are not important for tests.
 				appName:           &mockAppName,
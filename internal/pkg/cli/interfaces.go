@@ -9,10 +9,15 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
@@ -42,6 +47,14 @@ type actionCommand interface {
 	RecommendedActions() []string
 }
 
+// wkldDeployer is the command that deploys a service or a job.
+type wkldDeployer interface {
+	actionCommand
+
+	// BuildAndPushImages builds and pushes the images of the workload, so that Execute only deploys it.
+	BuildAndPushImages() error
+}
+
 // SSM store interfaces.
 
 type serviceStore interface {
@@ -54,6 +67,7 @@ type serviceStore interface {
 type jobStore interface {
 	CreateJob(job *config.Workload) error
 	GetJob(appName, jobName string) (*config.Workload, error)
+	UpdateJob(job *config.Workload) error
 	ListJobs(appName string) ([]*config.Workload, error)
 	DeleteJob(appName, jobName string) error
 }
@@ -72,12 +86,17 @@ type applicationStore interface {
 	applicationGetter
 	applicationLister
 	applicationDeleter
+	applicationUpdater
 }
 
 type applicationCreator interface {
 	CreateApplication(app *config.Application) error
 }
 
+type applicationUpdater interface {
+	UpdateApplication(app *config.Application) error
+}
+
 type applicationGetter interface {
 	GetApplication(appName string) (*config.Application, error)
 }
@@ -92,6 +111,7 @@ type applicationDeleter interface {
 
 type environmentStore interface {
 	environmentCreator
+	environmentUpdater
 	environmentGetter
 	environmentLister
 	environmentDeleter
@@ -101,6 +121,10 @@ type environmentCreator interface {
 	CreateEnvironment(env *config.Environment) error
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentGetter interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 }
@@ -121,10 +145,22 @@ type store interface {
 	wlStore
 }
 
+type appConsistencyChecker interface {
+	CheckConsistency(appName string) ([]*config.Inconsistency, error)
+	FixInconsistency(inc *config.Inconsistency) error
+}
+
+type stackTagger interface {
+	StackTags(stackName string) (map[string]string, error)
+	UpdateStackTags(stackName string, tags map[string]string) error
+}
+
 type deployedEnvironmentLister interface {
 	ListEnvironmentsDeployedTo(appName, svcName string) ([]string, error)
 	ListDeployedServices(appName, envName string) ([]string, error)
 	IsServiceDeployed(appName, envName string, svcName string) (bool, error)
+	ListDeployedJobs(appName, envName string) ([]string, error)
+	IsJobDeployed(appName, envName string, jobName string) (bool, error)
 }
 
 // Secretsmanager interface.
@@ -146,6 +182,25 @@ type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments) error
 }
 
+type imageRegistry interface {
+	RepositoryURI(name string) (string, error)
+	Auth() (string, string, error)
+	ImageExists(repoName, tag string) (bool, error)
+}
+
+type imageRetainer interface {
+	TagImage(repoName string, image ecr.Image, tag string) error
+	SetImageRetention(repoName string, count int) error
+}
+
+type imageDigestGetter interface {
+	ImageDigest(repoName, tag string) (string, error)
+}
+
+type alarmStatusGetter interface {
+	AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error)
+}
+
 type repositoryURIGetter interface {
 	URI() string
 }
@@ -172,6 +227,34 @@ type runner interface {
 	Run(name string, args []string, options ...command.Option) error
 }
 
+type gitStatusReader interface {
+	IsRepository() bool
+	UncommittedFiles(path string) ([]string, error)
+}
+
+type gitCommitReader interface {
+	HeadCommit() string
+}
+
+type workloadStackIDGetter interface {
+	WorkloadStackID(stackName string) (string, error)
+}
+
+type serviceDeployer interface {
+	workloadStackIDGetter
+	DeployService(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) error
+	DeployServiceNoWait(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) error
+	IsServiceUpToDate(conf deploycfn.StackConfiguration) (bool, error)
+}
+
+type snsPublisher interface {
+	Publish(topicARN, message string) (string, error)
+}
+
+type deploymentHistoryWriter interface {
+	Record(bucket string, rec *deploy.WorkloadDeploymentRecord, keep int) error
+}
+
 type eventsWriter interface {
 	WriteEventsUntilStopped() error
 }
@@ -244,6 +327,7 @@ type wsSvcReader interface {
 
 type wsSvcDirReader interface {
 	wsSvcReader
+	wsJobReader
 	copilotDirGetter
 }
 
@@ -262,9 +346,15 @@ type wsWlReader interface {
 
 type wsJobDirReader interface {
 	wsJobReader
+	wsSvcReader
 	copilotDirGetter
 }
 
+type wsWlManifestReader interface {
+	wsSvcReader
+	wsJobReader
+}
+
 type wsWlDirReader interface {
 	wsJobReader
 	wsSvcReader
@@ -282,6 +372,12 @@ type wsPipelineReader interface {
 type wsAppManager interface {
 	Create(appName string) error
 	Summary() (*workspace.Summary, error)
+	AddGitIgnorePatterns() ([]string, error)
+}
+
+type wsEnvDefaulter interface {
+	Summary() (*workspace.Summary, error)
+	SetDefaultEnvironment(envName string) error
 }
 
 type wsAddonManager interface {
@@ -303,6 +399,7 @@ type environmentDeployer interface {
 	StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse)
 	DeleteEnvironment(appName, envName, cfnExecRoleARN string) error
 	GetEnvironment(appName, envName string) (*config.Environment, error)
+	EnvironmentFromStack(appName, envName string) (*config.Environment, string, error)
 	EnvironmentTemplate(appName, envName string) (string, error)
 	UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error
 }
@@ -315,6 +412,14 @@ type svcRemoverFromApp interface {
 	RemoveServiceFromApp(app *config.Application, svcName string) error
 }
 
+type envRemoverFromApp interface {
+	RemoveEnvFromApp(in *deploy.RemoveEnvFromAppInput) error
+}
+
+type dnsDelegationRevoker interface {
+	RevokeDNSPermissions(app *config.Application, accountID string) error
+}
+
 type jobRemoverFromApp interface {
 	RemoveJobFromApp(app *config.Application, jobName string) error
 }
@@ -323,6 +428,14 @@ type imageRemover interface {
 	ClearRepository(repoName string) error // implemented by ECR Service
 }
 
+type paramsDeleter interface {
+	DeleteParametersWithTags(tags map[string]string) ([]string, error)
+}
+
+type imageLister interface {
+	ListImages(repoName string) ([]ecr.Image, error)
+}
+
 type pipelineDeployer interface {
 	CreatePipeline(env *deploy.CreatePipelineInput) error
 	UpdatePipeline(env *deploy.CreatePipelineInput) error
@@ -337,7 +450,7 @@ type appDeployer interface {
 	DeployApp(in *deploy.CreateAppInput) error
 	AddServiceToApp(app *config.Application, svcName string) error
 	AddJobToApp(app *config.Application, jobName string) error
-	AddEnvToApp(app *config.Application, env *config.Environment) error
+	AddEnvToApp(app *config.Application, env *config.Environment, opts ...stackset.WaitOption) error
 	DelegateDNSPermissions(app *config.Application, accountID string) error
 	DeleteApp(name string) error
 }
@@ -353,6 +466,12 @@ type taskDeployer interface {
 
 type taskRunner interface {
 	Run() ([]*task.Task, error)
+	Input() (*ecs.RunTaskInput, error)
+}
+
+type stoppedTasksWaiter interface {
+	WaitUntilTasksStopped(cluster string, taskARNs []string) ([]*ecs.Task, error)
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
 }
 
 type defaultClusterGetter interface {
@@ -369,6 +488,14 @@ type domainValidator interface {
 	DomainExists(domainName string) (bool, error)
 }
 
+type builderPlatformsLister interface {
+	BuilderPlatforms() ([]string, error)
+}
+
+type buildContextSizer interface {
+	BuildContextSize(dir string) (*docker.ContextSize, error)
+}
+
 type dockerfileParser interface {
 	GetExposedPorts() ([]uint16, error)
 	GetHealthCheck() (*dockerfile.HealthCheck, error)
@@ -378,6 +505,18 @@ type statusDescriber interface {
 	Describe() (*describe.ServiceStatusDesc, error)
 }
 
+type jobHistoryDescriber interface {
+	History(limit int, status string) (*describe.JobHistory, error)
+}
+
+type jobRuleDescriber interface {
+	RuleName() (string, error)
+}
+
+type serviceDeploymentsDescriber interface {
+	Deployments(limit int) (*describe.ServiceDeployments, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
@@ -404,6 +543,10 @@ type envTemplateUpgrader interface {
 	legacyEnvUpgrader
 }
 
+type pipelineNamesLister interface {
+	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
+}
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
@@ -435,6 +578,26 @@ type appEnvSelector interface {
 type configSelector interface {
 	appEnvSelector
 	Service(prompt, help, app string) (string, error)
+	Job(prompt, help, app string) (string, error)
+}
+
+type servicePauser interface {
+	PauseService(app, env, svc string, suspendAutoscaling bool) error
+}
+
+type serviceResumer interface {
+	ResumeService(app, env, svc string) error
+}
+
+type ruleToggler interface {
+	IsRuleEnabled(name string) (bool, error)
+	EnableRule(name string) error
+	DisableRule(name string) error
+}
+
+type taskDefinitionPruner interface {
+	StaleTaskDefinitions(app, env, svc string, keep int) ([]string, error)
+	DeregisterTaskDefinition(taskDefARN string) error
 }
 
 type deploySelector interface {
@@ -442,8 +605,14 @@ type deploySelector interface {
 	DeployedService(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error)
 }
 
+type deployedJobSelector interface {
+	appSelector
+	DeployedJob(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedJob, error)
+}
+
 type wsSelector interface {
 	appEnvSelector
+	Environments(prompt, help, app string) ([]string, error)
 	Service(prompt, help string) (string, error)
 	Job(prompt, help string) (string, error)
 	Workload(msg, help string) (string, error)
@@ -451,17 +620,19 @@ type wsSelector interface {
 
 type initJobSelector interface {
 	dockerfileSelector
-	Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error)
+	Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator, eventPatternValidator prompt.ValidatorFunc) (*selector.JobTrigger, error)
 }
 
 type dockerfileSelector interface {
 	Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error)
+	Image(selPrompt, customPrompt, selHelp, customHelp string, pv prompt.ValidatorFunc) (string, error)
 }
 
 type ec2Selector interface {
 	VPC(prompt, help string) (string, error)
 	PublicSubnets(prompt, help, vpcID string) ([]string, error)
 	PrivateSubnets(prompt, help, vpcID string) ([]string, error)
+	SecurityGroups(prompt, help, vpcID string) ([]string, error)
 }
 
 type credsSelector interface {
@@ -470,14 +641,19 @@ type credsSelector interface {
 
 type ec2Client interface {
 	HasDNSSupport(vpcID string) (bool, error)
+	HasIPv6CIDR(vpcID string) (bool, error)
+	ListAZs() ([]string, error)
+	SecurityGroups(filters ...ec2.Filter) ([]string, error)
 }
 
 type jobInitializer interface {
 	Job(props *initialize.JobProps) (string, error)
+	JobManifest(props *initialize.JobProps) (encoding.BinaryMarshaler, error)
 }
 
 type svcInitializer interface {
 	Service(props *initialize.ServiceProps) (string, error)
+	ServiceManifest(props *initialize.ServiceProps) (encoding.BinaryMarshaler, error)
 }
 
 type roleDeleter interface {
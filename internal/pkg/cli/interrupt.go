@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// interruptGracePeriod is how long an interrupted command has to clean up before the process exits anyway.
+const interruptGracePeriod = 10 * time.Second
+
+// interruptSignals are the signals that interrupt a running command.
+var interruptSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// cmdCtx is canceled once the running command is interrupted.
+// Commands that wait on long-running operations, such as CloudFormation deployments, should pass it down
+// so that they stop waiting and clean up after themselves.
+var cmdCtx = context.Background()
+
+// errInterrupted wraps the error returned by a command that was interrupted by a signal.
+type errInterrupted struct {
+	sig os.Signal
+	err error // Error returned by the command, nil if it didn't return before the grace period expired.
+}
+
+func (e *errInterrupted) Error() string {
+	if e.err == nil {
+		return fmt.Sprintf("interrupted by signal %s", e.sig)
+	}
+	return e.err.Error()
+}
+
+// Unwrap returns the error returned by the command.
+func (e *errInterrupted) Unwrap() error {
+	return e.err
+}
+
+// runInterruptible runs the command until it returns or the process receives an interrupt signal.
+// On the first signal, cmdCtx is canceled and the command has interruptGracePeriod to return.
+// A second signal stops waiting for the command immediately.
+// If the command is interrupted, the running spinners are stopped and an errInterrupted is returned,
+// unless the command completed successfully before the grace period expired.
+func runInterruptible(run func() error, signals <-chan os.Signal) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmdCtx = ctx
+	defer func() {
+		cmdCtx = context.Background()
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- run()
+	}()
+	var sig os.Signal
+	select {
+	case err := <-done:
+		return err
+	case sig = <-signals:
+		cancel()
+	}
+
+	var err error
+	select {
+	case err = <-done:
+		if err == nil {
+			return nil
+		}
+	case <-signals:
+	case <-time.After(interruptGracePeriod):
+	}
+	termprogress.StopSpinners("")
+	logInterruptedDeployment(err)
+	return &errInterrupted{
+		sig: sig,
+		err: err,
+	}
+}
+
+// notifyInterrupts returns a channel that receives the interrupt signals of the process,
+// and a function to stop receiving them.
+func notifyInterrupts() (<-chan os.Signal, func()) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, interruptSignals...)
+	return signals, func() {
+		signal.Stop(signals)
+	}
+}
+
+// logInterruptedDeployment tells the user the state of the stack that was deployed when the command was interrupted.
+func logInterruptedDeployment(err error) {
+	var stackErr *cloudformation.ErrStackDeployInterrupted
+	if !errors.As(err, &stackErr) {
+		return
+	}
+	if stackErr.Executed {
+		log.Warningf("Stopped waiting for stack %s, but AWS CloudFormation may still be applying changes to it.\n", color.HighlightResource(stackErr.Name))
+	} else {
+		log.Infof("Deleted the change set of stack %s before it was executed, the stack wasn't changed.\n", color.HighlightResource(stackErr.Name))
+	}
+	log.Infof("You can follow the stack in the AWS CloudFormation console: %s\n", stackErr.ConsoleURL())
+	log.Infoln("Run the command again to resume once the stack is no longer in progress.")
+}
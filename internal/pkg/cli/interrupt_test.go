@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunInterruptible(t *testing.T) {
+	testCases := map[string]struct {
+		inRun     func(unblock <-chan struct{}) error
+		inSignals []os.Signal
+
+		wantedErr         error
+		wantedInterrupted bool
+	}{
+		"returns the error of the command if it isn't interrupted": {
+			inRun: func(_ <-chan struct{}) error {
+				return errors.New("some error")
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"cancels the context of the command on interrupt": {
+			inRun: func(_ <-chan struct{}) error {
+				<-cmdCtx.Done()
+				return fmt.Errorf("deploy service: %w", cmdCtx.Err())
+			},
+			inSignals: []os.Signal{os.Interrupt},
+
+			wantedErr:         fmt.Errorf("deploy service: %w", context.Canceled),
+			wantedInterrupted: true,
+		},
+		"returns nil if the command completes successfully after the interrupt": {
+			inRun: func(_ <-chan struct{}) error {
+				<-cmdCtx.Done()
+				return nil
+			},
+			inSignals: []os.Signal{os.Interrupt},
+		},
+		"stops waiting for the command on the second interrupt": {
+			inRun: func(unblock <-chan struct{}) error {
+				<-unblock
+				return nil
+			},
+			inSignals: []os.Signal{os.Interrupt, os.Interrupt},
+
+			wantedInterrupted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			unblock := make(chan struct{})
+			defer close(unblock)
+			signals := make(chan os.Signal, len(tc.inSignals))
+			for _, sig := range tc.inSignals {
+				signals <- sig
+			}
+
+			// WHEN
+			err := runInterruptible(func() error {
+				return tc.inRun(unblock)
+			}, signals)
+
+			// THEN
+			require.NoError(t, cmdCtx.Err(), "the context is reset once the command returns")
+			if tc.wantedInterrupted {
+				var interrupted *errInterrupted
+				require.True(t, errors.As(err, &interrupted))
+				require.Equal(t, exitCodeInterrupted, exitCode(err))
+				require.Equal(t, tc.wantedErr, interrupted.err)
+				return
+			}
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
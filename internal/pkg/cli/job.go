@@ -24,6 +24,9 @@ Jobs are tasks that are triggered by events.`,
 	cmd.AddCommand(buildJobPackageCmd())
 	cmd.AddCommand(buildJobDeployCmd())
 	cmd.AddCommand(buildJobDeleteCmd())
+	cmd.AddCommand(buildJobHistoryCmd())
+	cmd.AddCommand(buildJobDisableCmd())
+	cmd.AddCommand(buildJobEnableCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -46,6 +46,7 @@ const (
 	fmtJobTasksStopStart          = "Stopping running tasks of job %s from environment %s."
 	fmtJobTasksStopFailed         = "Failed to stop running tasks of job %s from environment %s: %v.\n"
 	fmtJobTasksStopComplete       = "Stopped running tasks of job %s from environment %s.\n"
+	fmtJobNotDeployedToEnv        = "Job %s is not deployed to environment %s, nothing to delete.\n"
 
 	fmtJobDeleteTaskDeleteReason = "Task stopped because job %s was deleted."
 )
@@ -66,6 +67,7 @@ type deleteJobOpts struct {
 
 	// Interfaces to dependencies.
 	store                        store
+	deployStore                  deployedEnvironmentLister
 	prompt                       prompter
 	sel                          wsSelector
 	sess                         sessionProvider
@@ -82,6 +84,10 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 
 	provider := sessions.NewProvider()
 	defaultSession, err := provider.Default()
@@ -96,12 +102,13 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 	return &deleteJobOpts{
 		deleteJobVars: vars,
 
-		store:   store,
-		spinner: termprogress.NewSpinner(),
-		prompt:  prompt.New(),
-		sel:     selector.NewWorkspaceSelect(prompter, store, ws),
-		sess:    provider,
-		appCFN:  cloudformation.New(defaultSession),
+		store:       store,
+		deployStore: deployStore,
+		spinner:     termprogress.NewSpinner(),
+		prompt:      prompt.New(),
+		sel:         selector.NewWorkspaceSelect(prompter, store, ws),
+		sess:        provider,
+		appCFN:      cloudformation.New(defaultSession),
 		newWlDeleter: func(session *session.Session) wlDeleter {
 			return cloudformation.New(session)
 		},
@@ -263,6 +270,14 @@ func (o *deleteJobOpts) appEnvironments() ([]*config.Environment, error) {
 
 func (o *deleteJobOpts) deleteJobs(envs []*config.Environment) error {
 	for _, env := range envs {
+		deployed, err := o.deployStore.IsJobDeployed(o.appName, env.Name, o.name)
+		if err != nil {
+			return fmt.Errorf("check if job %s is deployed to environment %s: %w", o.name, env.Name, err)
+		}
+		if !deployed {
+			log.Infof(fmtJobNotDeployedToEnv, o.name, env.Name)
+			continue
+		}
 		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
 		if err != nil {
 			return err
@@ -288,6 +288,7 @@ func TestDeleteJobOpts_Ask(t *testing.T) {
 
 type deleteJobMocks struct {
 	store          *mocks.Mockstore
+	deployStore    *mocks.MockdeployedEnvironmentLister
 	secretsmanager *mocks.MocksecretsManager
 	sessProvider   *sessions.Provider
 	appCFN         *mocks.MockjobRemoverFromApp
@@ -335,6 +336,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobStackDeleteComplete, mockJobName, mockEnvName)),
@@ -370,6 +372,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobStackDeleteComplete, mockJobName, mockEnvName)),
@@ -391,6 +394,35 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"skips the environments that the job isn't deployed to": {
+			inAppName: mockAppName,
+			inJobName: mockJobName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteJobMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(false, nil),
+					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Times(0),
+					mocks.tasksGetter.EXPECT().ListActiveWorkloadTasks(gomock.Any(), gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+		},
+		"errors when checking if the job is deployed": {
+			inAppName: mockAppName,
+			inJobName: mockJobName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteJobMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(false, testError),
+				)
+			},
+			wantedError: fmt.Errorf("check if job resizer is deployed to environment test: some error"),
+		},
 		"errors when deleting stack": {
 			inAppName: mockAppName,
 			inJobName: mockJobName,
@@ -400,6 +432,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(testError),
 					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtJobStackDeleteFailed, mockJobName, mockEnvName, fmt.Errorf("delete job stack: %w", testError))),
@@ -416,6 +449,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobStackDeleteComplete, mockJobName, mockEnvName)),
@@ -434,6 +468,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobStackDeleteComplete, mockJobName, mockEnvName)),
@@ -451,6 +486,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsJobDeployed(mockAppName, mockEnvName, mockJobName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobStackDeleteComplete, mockJobName, mockEnvName)),
@@ -472,6 +508,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 
 			// GIVEN
 			mockstore := mocks.NewMockstore(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
 			mockSecretsManager := mocks.NewMocksecretsManager(ctrl)
 			mockSession := sessions.NewProvider()
 			mockAppCFN := mocks.NewMockjobRemoverFromApp(ctrl)
@@ -495,6 +532,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 
 			mocks := deleteJobMocks{
 				store:          mockstore,
+				deployStore:    mockDeployStore,
 				secretsmanager: mockSecretsManager,
 				sessProvider:   mockSession,
 				appCFN:         mockAppCFN,
@@ -514,6 +552,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					envName: test.inEnvName,
 				},
 				store:                        mockstore,
+				deployStore:                  mockDeployStore,
 				sess:                         mockSession,
 				spinner:                      mockSpinner,
 				appCFN:                       mockAppCFN,
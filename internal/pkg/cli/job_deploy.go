@@ -12,7 +12,6 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
-	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -29,6 +28,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
@@ -40,13 +40,17 @@ type deployJobOpts struct {
 	ws                 wsJobDirReader
 	unmarshal          func(in []byte) (interface{}, error)
 	cmd                runner
+	git                gitStatusReader
 	addons             templater
 	appCFN             appResourcesGetter
 	jobCFN             cloudformation.CloudFormation
 	imageBuilderPusher imageBuilderPusher
+	contextSizer       buildContextSizer
 	sessProvider       sessionProvider
 	s3                 artifactUploader
 	envUpgradeCmd      actionCommand
+	notifier           *deploymentNotifier // Set only if a topic to notify is configured.
+	recorder           *deploymentRecorder
 
 	spinner progress
 	sel     wsSelector
@@ -56,6 +60,9 @@ type deployJobOpts struct {
 	targetEnvironment *config.Environment
 	targetJob         *config.Workload
 	buildRequired     bool
+	sidecarImageTags  map[string]string // Image tags of the sidecars built from a Dockerfile, keyed by sidecar name.
+	commitImageTag    string            // Short SHA of the git commit, pushed as an additional tag of the job's image.
+	imagesPushed      bool              // true means the images were built and pushed before Execute.
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -72,16 +79,21 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	vars.notifyTopicARN = defaultNotifyTopicARN(vars.notifyTopicARN, ws)
+	vars.buildTool = defaultBuildTool(vars.buildTool, ws)
+	vars.history = defaultDeploymentHistory(ws)
+	vars.wsAppName = workspaceAppName(ws)
 	return &deployJobOpts{
 		deployWkldVars: vars,
 
 		store:        store,
 		ws:           ws,
-		unmarshal:    manifest.UnmarshalWorkload,
+		unmarshal:    workloadUnmarshaler(vars.strict),
 		spinner:      termprogress.NewSpinner(),
-		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
+		sel:          selector.NewWorkspaceSelect(prompter, store, ws, workspaceAppSelectOptions(vars.appName, vars.allowAppOverride)...),
 		prompt:       prompter,
 		cmd:          command.New(),
+		git:          newGitRepo(),
 		sessProvider: sessions.NewProvider(),
 	}, nil
 }
@@ -91,6 +103,9 @@ func (o *deployJobOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if err := validateWorkspaceApp(o.wsAppName, o.appName, o.allowAppOverride); err != nil {
+		return err
+	}
 	if o.name != "" {
 		if err := o.validateJobName(); err != nil {
 			return err
@@ -101,6 +116,17 @@ func (o *deployJobOpts) Validate() error {
 			return err
 		}
 	}
+	if o.notifyTopicARN != "" {
+		if err := validateSNSTopicARN(o.notifyTopicARN); err != nil {
+			return fmt.Errorf("validate %s: %w", notifyTopicARNFlag, err)
+		}
+	}
+	if err := validateBuildTool(o.buildTool); err != nil {
+		return err
+	}
+	if o.maxContextSize < 0 {
+		return fmt.Errorf("--%s cannot be negative", maxContextSizeFlag)
+	}
 	return nil
 }
 
@@ -112,11 +138,12 @@ func (o *deployJobOpts) Ask() error {
 	if err := o.askEnvName(); err != nil {
 		return err
 	}
-	tag, err := askImageTag(o.imageTag, o.prompt, o.cmd)
+	tag, err := deployImageTag(o.imageTag, o.allowLatest, o.cmd)
 	if err != nil {
 		return err
 	}
 	o.imageTag = tag
+	o.commitImageTag = getShortCommit(o.cmd)
 	return nil
 }
 
@@ -127,6 +154,7 @@ func (o *deployJobOpts) Execute() error {
 		return err
 	}
 	o.targetEnvironment = env
+	warnIfEnvNewerThanCLI(env, version.Version)
 
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
@@ -148,8 +176,10 @@ func (o *deployJobOpts) Execute() error {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
-	if err := o.configureContainerImage(); err != nil {
-		return err
+	if !o.imagesPushed {
+		if err := o.configureContainerImage(); err != nil {
+			return err
+		}
 	}
 
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
@@ -157,7 +187,36 @@ func (o *deployJobOpts) Execute() error {
 		return err
 	}
 
-	return o.deployJob(addonsURL)
+	if err := o.deployJob(addonsURL); err != nil {
+		return err
+	}
+	if o.notifier != nil {
+		o.notifier.notify(o.deployWkldVars)
+	}
+	warnUncommittedManifests(o.git, o.ws)
+	return nil
+}
+
+// BuildAndPushImages builds and pushes the images of the job, so that Execute only deploys it.
+func (o *deployJobOpts) BuildAndPushImages() error {
+	env, err := targetEnv(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	o.targetEnvironment = env
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return err
+	}
+	o.targetApp = app
+	if err := o.configureClients(); err != nil {
+		return err
+	}
+	if err := o.configureContainerImage(); err != nil {
+		return err
+	}
+	o.imagesPushed = true
+	return nil
 }
 
 // pushAddonsTemplateToS3Bucket generates the addons template for the job and pushes it to S3.
@@ -197,32 +256,41 @@ func (o *deployJobOpts) configureClients() error {
 		return fmt.Errorf("assuming environment manager role: %w", err)
 	}
 
+	// client to retrieve an application's resources created with CloudFormation
+	defaultSess, err := o.sessProvider.Default()
+	if err != nil {
+		return fmt.Errorf("create default session: %w", err)
+	}
+	o.appCFN = cloudformation.New(defaultSess)
+
 	// ECR client against tools account profile AND target environment region
 	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
 	registry := ecr.New(defaultSessEnvRegion)
-	o.imageBuilderPusher, err = repository.New(repoName, registry)
+	o.imageBuilderPusher, err = newImageBuilderPusher(o.buildTool, repoName, registry, o.targetApp, o.targetEnvironment.Region, defaultSess, defaultSessEnvRegion)
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.contextSizer = docker.New()
 
 	o.s3 = s3.New(defaultSessEnvRegion)
+	o.recorder = newDeploymentRecorder(defaultSessEnvRegion, o.appCFN, o.history)
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
 
+	if o.notifyTopicARN != "" {
+		o.notifier, err = newDeploymentNotifier(o.sessProvider, o.notifyTopicARN, o.jobCFN)
+		if err != nil {
+			return fmt.Errorf("initiate deployment notifier: %w", err)
+		}
+	}
+
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
 		return fmt.Errorf("initiate addons service: %w", err)
 	}
 	o.addons = addonsSvc
 
-	// client to retrieve an application's resources created with CloudFormation
-	defaultSess, err := o.sessProvider.Default()
-	if err != nil {
-		return fmt.Errorf("create default session: %w", err)
-	}
-	o.appCFN = cloudformation.New(defaultSess)
-
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
 		appName: o.appName,
 		name:    o.targetEnvironment.Name,
@@ -243,18 +311,46 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if err != nil {
 		return err
 	}
-	if !required {
+	if required {
+		// If it is built from local Dockerfile, build and push to the ECR repo.
+		buildArg, err := o.dfBuildArgs(job)
+		if err != nil {
+			return err
+		}
+		if o.buildTool != buildToolRemote {
+			warnIfPlatformNotBuildable(docker.New(), buildArg.Platform)
+		}
+		if err := checkBuildContextSize(o.contextSizer, buildArg, o.maxContextSize); err != nil {
+			return err
+		}
+		if err := o.imageBuilderPusher.BuildAndPush(docker.New(), buildArg); err != nil {
+			return fmt.Errorf("build and push image: %w", err)
+		}
+		o.buildRequired = true
+	}
+	return o.configureSidecarImages(job)
+}
+
+// configureSidecarImages builds and pushes the images of the sidecars that are built from a local Dockerfile.
+// Sidecars that use an existing image are left untouched.
+func (o *deployJobOpts) configureSidecarImages(job interface{}) error {
+	if !manifest.SidecarDockerfileBuildRequired(job) {
 		return nil
 	}
-	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(job)
+	copilotDir, err := o.ws.CopilotDirPath()
 	if err != nil {
-		return err
+		return fmt.Errorf("get copilot directory: %w", err)
 	}
-	if err := o.imageBuilderPusher.BuildAndPush(docker.New(), buildArg); err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+	o.sidecarImageTags = make(map[string]string)
+	for _, arg := range sidecarBuildArgs(o.imageTag, copilotDir, job) {
+		if err := checkBuildContextSize(o.contextSizer, arg.buildArgs, o.maxContextSize); err != nil {
+			return fmt.Errorf("sidecar %s: %w", arg.name, err)
+		}
+		if err := o.imageBuilderPusher.BuildAndPush(docker.New(), arg.buildArgs); err != nil {
+			return fmt.Errorf("build and push image for sidecar %s: %w", arg.name, err)
+		}
+		o.sidecarImageTags[arg.name] = arg.buildArgs.ImageTag
 	}
-	o.buildRequired = true
 	return nil
 }
 
@@ -263,7 +359,12 @@ func (o *deployJobOpts) dfBuildArgs(job interface{}) (*docker.BuildArguments, er
 	if err != nil {
 		return nil, fmt.Errorf("get copilot directory: %w", err)
 	}
-	return buildArgs(o.name, o.imageTag, copilotDir, job)
+	args, err := buildArgs(o.name, o.imageTag, copilotDir, job)
+	if err != nil {
+		return nil, err
+	}
+	args.AdditionalTags = additionalImageTags(o.imageTag, o.commitImageTag)
+	return args, nil
 }
 
 func (o *deployJobOpts) deployJob(addonsURL string) error {
@@ -271,17 +372,42 @@ func (o *deployJobOpts) deployJob(addonsURL string) error {
 	if err != nil {
 		return err
 	}
+	hash, err := cloudformation.TemplateHash(conf)
+	if err != nil {
+		return fmt.Errorf("hash the template of job %s: %w", o.name, err)
+	}
+	deployFn, fmtMsg := o.jobCFN.DeployService, "Deploying %s to %s"
+	if o.noWait {
+		deployFn, fmtMsg = o.jobCFN.DeployServiceNoWait, "Starting the deployment of %s to %s"
+	}
 	o.spinner.Start(
-		fmt.Sprintf("Deploying %s to %s",
+		fmt.Sprintf(fmtMsg,
 			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.name), color.HighlightUserInput(o.imageTag)),
 			color.HighlightUserInput(o.targetEnvironment.Name),
 		),
 	)
-	if err := o.jobCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	if err := deployFn(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy job.\n\n"))
 		return fmt.Errorf("deploy job: %w", err)
 	}
 	o.spinner.Stop("\n\n")
+	if o.noWait {
+		log.Successf("Started deploying %s to %s, the stack is %s.\n", color.HighlightUserInput(o.name),
+			color.HighlightUserInput(o.targetEnvironment.Name), color.HighlightResource(stack.NameForService(o.appName, o.targetEnvironment.Name, o.name)))
+		return nil
+	}
+	if o.recorder != nil {
+		deployment := workloadDeployment{
+			app:          o.targetApp,
+			env:          o.targetEnvironment,
+			name:         o.name,
+			templateHash: hash,
+		}
+		if o.buildRequired {
+			deployment.imageTag = o.imageTag
+		}
+		o.recorder.record(deployment)
+	}
 	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
 	return nil
 }
@@ -291,6 +417,9 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
+	if err := validateUniqueTopics(o.ws, o.name, mft, o.unmarshal); err != nil {
+		return nil, err
+	}
 	rc, err := o.runtimeConfig(addonsURL)
 	if err != nil {
 		return nil, err
@@ -309,10 +438,17 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 }
 
 func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
-	if !o.buildRequired {
+	exports, err := o.jobCFN.EnvironmentOutputExports(o.targetApp.Name, o.targetEnvironment.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get exported outputs of environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	if !o.buildRequired && len(o.sidecarImageTags) == 0 {
 		return &stack.RuntimeConfig{
-			AddonsTemplateURL: addonsURL,
-			AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
+			AddonsTemplateURL:   addonsURL,
+			AdditionalTags:      tags.Merge(o.targetApp.Tags, o.resourceTags),
+			EnvOutputExports:    exports,
+			ScheduleDisabled:    o.targetJob.IsScheduleDisabled(o.targetEnvironment.Name),
+			PermissionsBoundary: o.targetApp.PermissionsBoundary,
 		}, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
@@ -327,14 +463,22 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 			appAccountID: o.targetApp.AccountID,
 		}
 	}
-	return &stack.RuntimeConfig{
-		Image: &stack.ECRImage{
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL:   addonsURL,
+		AdditionalTags:      tags.Merge(o.targetApp.Tags, o.resourceTags),
+		SidecarImages:       stack.SidecarImageLocations(repoURL, o.sidecarImageTags),
+		AccountID:           o.targetEnvironment.AccountID,
+		EnvOutputExports:    exports,
+		ScheduleDisabled:    o.targetJob.IsScheduleDisabled(o.targetEnvironment.Name),
+		PermissionsBoundary: o.targetApp.PermissionsBoundary,
+	}
+	if o.buildRequired {
+		rc.Image = &stack.ECRImage{
 			RepoURL:  repoURL,
 			ImageTag: o.imageTag,
-		},
-		AddonsTemplateURL: addonsURL,
-		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-	}, nil
+		}
+	}
+	return rc, nil
 }
 
 func (o *deployJobOpts) manifest() (interface{}, error) {
@@ -433,7 +577,14 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
-	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowLatest, allowLatestFlag, false, allowLatestFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.noWait, noWaitFlag, false, noWaitFlagDescription)
+	cmd.Flags().StringVar(&vars.notifyTopicARN, notifyTopicARNFlag, "", notifyTopicARNFlagDescription)
+	cmd.Flags().StringVar(&vars.buildTool, buildToolFlag, "", buildToolFlagDescription)
+	cmd.Flags().BoolVar(&vars.strict, strictFlag, false, strictFlagDescription)
+	cmd.Flags().IntVar(&vars.maxContextSize, maxContextSizeFlag, 0, maxContextSizeFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAppOverride, allowAppOverrideFlag, false, allowAppOverrideFlagDescription)
 
 	return cmd
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -24,9 +25,10 @@ type deployJobMocks struct {
 
 func TestJobDeployOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inJobName string
+		inAppName   string
+		inEnvName   string
+		inJobName   string
+		inWsAppName string
 
 		mockWs    func(m *mocks.MockwsJobDirReader)
 		mockStore func(m *mocks.Mockstore)
@@ -39,6 +41,17 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errNoAppInWorkspace,
 		},
+		"with an application different from the workspace's": {
+			inAppName:   "phonetool",
+			inWsAppName: "demo",
+			mockWs:      func(m *mocks.MockwsJobDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedError: &workspace.ErrAppMismatch{
+				WorkspaceApp: "demo",
+				App:          "phonetool",
+			},
+		},
 		"with workspace error": {
 			inAppName: "phonetool",
 			inJobName: "resizer",
@@ -96,9 +109,10 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inJobName,
-					envName: tc.inEnvName,
+					appName:   tc.inAppName,
+					name:      tc.inJobName,
+					envName:   tc.inEnvName,
+					wsAppName: tc.inWsAppName,
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -124,12 +138,17 @@ func TestJobDeployOpts_Ask(t *testing.T) {
 		inJobName  string
 		inImageTag string
 
+		inAllowLatest bool
+		mockRunner    func(m *mocks.Mockrunner) // Defaults to a working directory outside of a git repository.
+
 		wantedCalls func(m *mocks.MockwsSelector)
 
 		wantedJobName  string
 		wantedEnvName  string
 		wantedImageTag string
 		wantedError    error
+
+		wantedCommitTag string
 	}{
 		"prompts for environment name and job names": {
 			inAppName:  "phonetool",
@@ -153,6 +172,46 @@ func TestJobDeployOpts_Ask(t *testing.T) {
 				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			},
 
+			wantedJobName:  "resizer",
+			wantedEnvName:  "prod-iad",
+			wantedImageTag: "latest",
+		},
+		"derives the image tag from the git repository if it isn't provided": {
+			inAppName:   "phonetool",
+			inEnvName:   "prod-iad",
+			inJobName:   "resizer",
+			wantedCalls: func(m *mocks.MockwsSelector) {},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always", "--dirty"}, gomock.Any()).
+					DoAndReturn(writeStdout("bb133e7\n", nil))
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).
+					DoAndReturn(writeStdout("bb133e7\n", nil))
+			},
+
+			wantedJobName:   "resizer",
+			wantedEnvName:   "prod-iad",
+			wantedImageTag:  "bb133e7",
+			wantedCommitTag: "bb133e7",
+		},
+		"errors if the image tag isn't provided outside of a git repository": {
+			inAppName:   "phonetool",
+			inEnvName:   "prod-iad",
+			inJobName:   "resizer",
+			wantedCalls: func(m *mocks.MockwsSelector) {},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always", "--dirty"}, gomock.Any()).
+					DoAndReturn(writeStdout("", errors.New("exit status 128")))
+			},
+
+			wantedError: errors.New(`couldn't derive an image tag from a git repository: provide one with --tag or pass --allow-latest to use the "latest" tag`),
+		},
+		"uses the latest image tag outside of a git repository if allowed": {
+			inAppName:     "phonetool",
+			inEnvName:     "prod-iad",
+			inJobName:     "resizer",
+			inAllowLatest: true,
+			wantedCalls:   func(m *mocks.MockwsSelector) {},
+
 			wantedJobName:  "resizer",
 			wantedEnvName:  "prod-iad",
 			wantedImageTag: "latest",
@@ -165,16 +224,24 @@ func TestJobDeployOpts_Ask(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockRunner := mocks.NewMockrunner(ctrl)
 
 			tc.wantedCalls(mockSel)
+			if tc.mockRunner != nil {
+				tc.mockRunner(mockRunner)
+			} else {
+				mockRunner.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("exit status 128")).AnyTimes()
+			}
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					appName:  tc.inAppName,
-					name:     tc.inJobName,
-					envName:  tc.inEnvName,
-					imageTag: tc.inImageTag,
+					appName:     tc.inAppName,
+					name:        tc.inJobName,
+					envName:     tc.inEnvName,
+					imageTag:    tc.inImageTag,
+					allowLatest: tc.inAllowLatest,
 				},
 				sel: mockSel,
+				cmd: mockRunner,
 			}
 
 			// WHEN
@@ -186,6 +253,7 @@ func TestJobDeployOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.wantedJobName, opts.name)
 				require.Equal(t, tc.wantedEnvName, opts.envName)
 				require.Equal(t, tc.wantedImageTag, opts.imageTag)
+				require.Equal(t, tc.wantedCommitTag, opts.commitImageTag)
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
@@ -197,6 +265,8 @@ func TestJobDeployOpts_configureContainerImage(t *testing.T) {
 	mockError := errors.New("mockError")
 	mockManifest := []byte(`name: mailer
 type: 'Scheduled Job'
+on:
+  schedule: "@daily"
 image:
   build:
     dockerfile: path/to/Dockerfile
@@ -204,16 +274,22 @@ image:
 `)
 	mockMftNoBuild := []byte(`name: mailer
 type: 'Scheduled Job'
+on:
+  schedule: "@daily"
 image:
   location: foo/bar
 `)
 	mockMftBuildString := []byte(`name: mailer
 type: 'Scheduled Job'
+on:
+  schedule: "@daily"
 image:
   build: path/to/Dockerfile
 `)
 	mockMftNoContext := []byte(`name: mailer
 type: 'Scheduled Job'
+on:
+  schedule: "@daily"
 image:
   build:
     dockerfile: path/to/Dockerfile`)
@@ -222,6 +298,9 @@ image:
 		inputSvc   string
 		setupMocks func(mocks deployJobMocks)
 
+		inputTag    string
+		inCommitTag string
+
 		wantErr error
 	}{
 		"should return error if ws ReadFile returns error": {
@@ -277,6 +356,23 @@ image:
 				)
 			},
 		},
+		"success tagging the image with the commit": {
+			inputSvc:    "mailer",
+			inputTag:    "v1.2.0-3-gbb133e7",
+			inCommitTag: "bb133e7",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadJobManifest("mailer").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &docker.BuildArguments{
+						Dockerfile:     filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:        filepath.Join("/ws", "root", "path"),
+						ImageTag:       "v1.2.0-3-gbb133e7",
+						AdditionalTags: []string{"bb133e7"},
+					}).Return(nil),
+				)
+			},
+		},
 		"using simple buildstring (backwards compatible)": {
 			inputSvc: "mailer",
 			setupMocks: func(m deployJobMocks) {
@@ -312,6 +408,8 @@ image:
 
 			mockWorkspace := mocks.NewMockwsJobDirReader(ctrl)
 			mockimageBuilderPusher := mocks.NewMockimageBuilderPusher(ctrl)
+			mockContextSizer := mocks.NewMockbuildContextSizer(ctrl)
+			mockContextSizer.EXPECT().BuildContextSize(gomock.Any()).Return(&docker.ContextSize{Bytes: 1024}, nil).AnyTimes()
 			mocks := deployJobMocks{
 				mockWs:                 mockWorkspace,
 				mockimageBuilderPusher: mockimageBuilderPusher,
@@ -319,11 +417,14 @@ image:
 			test.setupMocks(mocks)
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputSvc,
+					name:     test.inputSvc,
+					imageTag: test.inputTag,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
+				contextSizer:       mockContextSizer,
 				ws:                 mockWorkspace,
+				commitImageTag:     test.inCommitTag,
 			}
 
 			gotErr := opts.configureContainerImage()
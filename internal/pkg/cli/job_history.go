@@ -0,0 +1,209 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobHistoryAppNamePrompt     = "Which application is the job in?"
+	jobHistoryAppNameHelpPrompt = "An application groups all of your services and jobs together."
+	jobHistoryNamePrompt        = "Which job's history would you like to show?"
+	jobHistoryNameHelpPrompt    = "Displays the most recent executions of the job with their status and duration."
+	jobHistoryEnvNamePrompt     = "Which environment is the job deployed to?"
+	jobHistoryEnvNameHelpPrompt = "The executions of the job in this environment are displayed."
+
+	defaultJobHistoryLimit = 10
+)
+
+// jobExecutionStatuses are the statuses that the executions of a job can be filtered by.
+var jobExecutionStatuses = []string{"running", "succeeded", "failed", "timed_out", "aborted"}
+
+type jobHistoryVars struct {
+	shouldOutputJSON bool
+	name             string
+	envName          string
+	appName          string
+	limit            int
+	status           string
+}
+
+type jobHistoryOpts struct {
+	jobHistoryVars
+
+	w                    io.Writer
+	store                store
+	sel                  configSelector
+	historyDescriber     jobHistoryDescriber
+	initHistoryDescriber func() error // Overriden in tests.
+}
+
+func newJobHistoryOpts(vars jobHistoryVars) (*jobHistoryOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	opts := &jobHistoryOpts{
+		jobHistoryVars: vars,
+		store:          configStore,
+		w:              log.OutputWriter,
+		sel:            selector.NewConfigSelect(prompt.New(), configStore),
+	}
+	opts.initHistoryDescriber = func() error {
+		d, err := describe.NewJobHistoryDescriber(describe.NewJobHistoryConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Job:         opts.name,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create history describer for job %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		opts.historyDescriber = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *jobHistoryOpts) Validate() error {
+	if o.limit <= 0 {
+		return errors.New("--limit must be greater than 0")
+	}
+	if o.status != "" && !contains(strings.ToLower(o.status), jobExecutionStatuses) {
+		return fmt.Errorf("invalid status %s: must be one of %s", o.status, strings.Join(jobExecutionStatuses, ", "))
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *jobHistoryOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askJobName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute displays the most recent executions of the job.
+func (o *jobHistoryOpts) Execute() error {
+	if err := o.initHistoryDescriber(); err != nil {
+		return err
+	}
+	history, err := o.historyDescriber.History(o.limit, strings.ToUpper(o.status))
+	if err != nil {
+		return fmt.Errorf("describe history of job %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := history.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, history.HumanString())
+	return nil
+}
+
+func (o *jobHistoryOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(jobHistoryAppNamePrompt, jobHistoryAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *jobHistoryOpts) askJobName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Job(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select job for application %s: %w", o.appName, err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *jobHistoryOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(jobHistoryEnvNamePrompt, jobHistoryEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildJobHistoryCmd builds the command for showing the recent executions of a deployed job.
+func buildJobHistoryCmd() *cobra.Command {
+	vars := jobHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows the recent executions of a deployed job.",
+		Long: `Shows the recent executions of a deployed job, newest first.
+Each execution is displayed with its status, start time and duration, and failed executions with their error.`,
+
+		Example: `
+  Shows the 10 most recent executions of the job "report" in the "prod" environment.
+  /code $ copilot job history -n report -e prod
+  Shows the 5 most recent failed executions.
+  /code $ copilot job history -n report -e prod --status failed --limit 5`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, defaultJobHistoryLimit, jobHistoryLimitFlagDescription)
+	cmd.Flags().StringVar(&vars.status, statusFlag, "", jobHistoryStatusFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,249 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHistory_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp    string
+		inJob    string
+		inEnv    string
+		inLimit  int
+		inStatus string
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid flags": {
+			inApp:    "my-app",
+			inJob:    "report",
+			inEnv:    "test",
+			inLimit:  10,
+			inStatus: "FAILED",
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.EXPECT().GetJob("my-app", "report").Return(nil, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, nil)
+			},
+		},
+		"invalid limit": {
+			inLimit: 0,
+
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--limit must be greater than 0"),
+		},
+		"invalid status": {
+			inLimit:  10,
+			inStatus: "stopped",
+
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("invalid status stopped: must be one of running, succeeded, failed, timed_out, aborted"),
+		},
+		"invalid job name": {
+			inApp:   "my-app",
+			inJob:   "report",
+			inLimit: 10,
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.EXPECT().GetJob("my-app", "report").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					appName: tc.inApp,
+					name:    tc.inJob,
+					envName: tc.inEnv,
+					limit:   tc.inLimit,
+					status:  tc.inStatus,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobHistory_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inJob string
+		inEnv string
+
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"prompts for all fields": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(jobHistoryAppNamePrompt, jobHistoryAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().Job(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, "my-app").Return("report", nil)
+				m.EXPECT().Environment(jobHistoryEnvNamePrompt, jobHistoryEnvNameHelpPrompt, "my-app").Return("test", nil)
+			},
+
+			wantedApp: "my-app",
+			wantedJob: "report",
+			wantedEnv: "test",
+		},
+		"skips prompting if flags are set": {
+			inApp: "my-app",
+			inJob: "report",
+			inEnv: "test",
+
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+
+			wantedApp: "my-app",
+			wantedJob: "report",
+			wantedEnv: "test",
+		},
+		"returns error if fail to select job": {
+			inApp: "my-app",
+
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Job(gomock.Any(), gomock.Any(), "my-app").Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select job for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					appName: tc.inApp,
+					name:    tc.inJob,
+					envName: tc.inEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedJob, opts.name)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestJobHistory_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inStatus         string
+		shouldOutputJSON bool
+
+		setupMocks func(m *mocks.MockjobHistoryDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"passes the status in upper case and writes json": {
+			inStatus:         "failed",
+			shouldOutputJSON: true,
+
+			setupMocks: func(m *mocks.MockjobHistoryDescriber) {
+				m.EXPECT().History(10, "FAILED").Return(&describe.JobHistory{
+					Executions: []*describe.JobExecution{},
+				}, nil)
+			},
+
+			wantedContent: "{\"executions\":[]}\n",
+		},
+		"returns error if fail to describe history": {
+			setupMocks: func(m *mocks.MockjobHistoryDescriber) {
+				m.EXPECT().History(10, "").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe history of job report: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockjobHistoryDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					name:             "report",
+					limit:            10,
+					status:           tc.inStatus,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:                    b,
+				historyDescriber:     mockDescriber,
+				initHistoryDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -6,7 +6,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -28,7 +31,8 @@ var (
 	jobInitScheduleHelp   = `How to determine this job's schedule. "Rate" lets you define the time between 
 executions and is good for jobs which need to run frequently. "Fixed Schedule"
 lets you use a predefined or custom cron schedule and is good for less-frequent 
-jobs or those which require specific execution schedules.`
+jobs or those which require specific execution schedules. "Event Pattern" runs
+the job whenever an EventBridge event matching the pattern occurs.`
 
 	fmtJobInitTypeHelp = "A %s is a task which is invoked on a set schedule, with optional retry logic."
 )
@@ -43,6 +47,8 @@ type initJobVars struct {
 	timeout  string
 	retries  int
 	schedule string
+
+	eventPattern manifest.EventPattern // Set if the job is triggered by events instead of a schedule.
 }
 
 type initJobOpts struct {
@@ -54,6 +60,7 @@ type initJobOpts struct {
 	init   jobInitializer
 	prompt prompter
 	sel    initJobSelector
+	w      io.Writer // Receives the manifest with --dry-run.
 
 	// Outputs stored on successful actions.
 	manifestPath string
@@ -84,7 +91,9 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 	}
 
 	prompter := prompt.New()
-	sel := selector.NewWorkspaceSelect(prompter, store, ws)
+	sel := selector.NewWorkspaceSelect(prompter, store, ws,
+		append(workspaceAppSelectOptions(vars.appName, vars.allowAppOverride), selector.WithImageLister(ecr.New(sess)))...)
+	vars.wsAppName = workspaceAppName(ws)
 
 	return &initJobOpts{
 		initJobVars: vars,
@@ -94,11 +103,15 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 		init:   jobInitter,
 		prompt: prompter,
 		sel:    sel,
+		w:      os.Stdout,
 	}, nil
 }
 
 // Validate returns an error if the flag values passed by the user are invalid.
 func (o *initJobOpts) Validate() error {
+	if err := validateWorkspaceApp(o.wsAppName, o.appName, o.allowAppOverride); err != nil {
+		return err
+	}
 	if o.wkldType != "" {
 		if err := validateJobType(o.wkldType); err != nil {
 			return err
@@ -157,8 +170,9 @@ func (o *initJobOpts) Ask() error {
 }
 
 // Execute writes the job's manifest file, creates an ECR repo, and stores the name in SSM.
+// With --dry-run, it only prints the manifest.
 func (o *initJobOpts) Execute() error {
-	manifestPath, err := o.init.Job(&initialize.JobProps{
+	props := &initialize.JobProps{
 		WorkloadProps: initialize.WorkloadProps{
 			App:            o.appName,
 			Name:           o.name,
@@ -167,10 +181,19 @@ func (o *initJobOpts) Execute() error {
 			Image:          o.image,
 		},
 
-		Schedule: o.schedule,
-		Timeout:  o.timeout,
-		Retries:  o.retries,
-	})
+		Schedule:     o.schedule,
+		EventPattern: o.eventPattern,
+		Timeout:      o.timeout,
+		Retries:      o.retries,
+	}
+	if o.dryRun {
+		mft, err := o.init.JobManifest(props)
+		if err != nil {
+			return fmt.Errorf("generate the manifest of job %s: %w", o.name, err)
+		}
+		return printManifest(o.w, mft)
+	}
+	manifestPath, err := o.init.Job(props)
 	if err != nil {
 		return err
 	}
@@ -209,10 +232,9 @@ func (o *initJobOpts) askImage() error {
 	if o.image != "" {
 		return nil
 	}
-	image, err := o.prompt.Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil,
-		prompt.WithFinalMessage("Image:"))
+	image, err := o.sel.Image(wkldInitImageRepoPrompt, wkldInitImagePrompt, wkldInitImageRepoPromptHelp, wkldInitImagePromptHelp, nil)
 	if err != nil {
-		return fmt.Errorf("get image location: %w", err)
+		return fmt.Errorf("select image: %w", err)
 	}
 	o.image = image
 	return nil
@@ -246,22 +268,33 @@ func (o *initJobOpts) askSchedule() error {
 	if o.schedule != "" {
 		return nil
 	}
-	schedule, err := o.sel.Schedule(
+	trigger, err := o.sel.Schedule(
 		jobInitSchedulePrompt,
 		jobInitScheduleHelp,
 		validateSchedule,
 		validateRate,
+		validateEventPattern,
 	)
 	if err != nil {
 		return fmt.Errorf("get schedule: %w", err)
 	}
-
-	o.schedule = schedule
+	if trigger.EventPattern == "" {
+		o.schedule = trigger.Schedule
+		return nil
+	}
+	pattern, err := manifest.ParseEventPattern(trigger.EventPattern)
+	if err != nil {
+		return fmt.Errorf("parse event pattern: %w", err)
+	}
+	o.eventPattern = pattern
 	return nil
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initJobOpts) RecommendedActions() []string {
+	if o.dryRun {
+		return nil
+	}
 	return []string{
 		fmt.Sprintf("Update your manifest %s to change the defaults.", color.HighlightResource(o.manifestPath)),
 		fmt.Sprintf("Run %s to deploy your job to a %s environment.",
@@ -281,7 +314,10 @@ func buildJobInitCmd() *cobra.Command {
   /code $ copilot job init --name reaper --dockerfile ./frontend/Dockerfile --schedule "every 2 hours"
 
   Create a "report-generator" scheduled task with retries.
-  /code $ copilot job init --name report-generator --schedule "@monthly" --retries 3 --timeout 900s`,
+  /code $ copilot job init --name report-generator --schedule "@monthly" --retries 3 --timeout 900s
+
+  Print the manifest of a "reaper" job without creating it.
+  /code $ copilot job init --name reaper --image reaper:latest --schedule "@daily" --dry-run > reaper.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitJobOpts(vars)
 			if err != nil {
@@ -296,9 +332,11 @@ func buildJobInitCmd() *cobra.Command {
 			if err := opts.Execute(); err != nil {
 				return err
 			}
-			log.Infoln("Recommended follow-up actions:")
-			for _, followup := range opts.RecommendedActions() {
-				log.Infof("- %s\n", followup)
+			if actions := opts.RecommendedActions(); len(actions) > 0 {
+				log.Infoln("Recommended follow-up actions:")
+				for _, followup := range actions {
+					log.Infof("- %s\n", followup)
+				}
 			}
 			return nil
 		}),
@@ -311,6 +349,8 @@ func buildJobInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.timeout, timeoutFlag, "", timeoutFlagDescription)
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, wkldInitDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAppOverride, allowAppOverrideFlag, false, allowAppOverrideFlagDescription)
 
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -158,8 +160,9 @@ func TestJobInitOpts_Ask(t *testing.T) {
 		mockPrompt     func(m *mocks.Mockprompter)
 		mockSel        func(m *mocks.MockinitJobSelector)
 
-		wantedErr      error
-		wantedSchedule string
+		wantedErr          error
+		wantedSchedule     string
+		wantedEventPattern manifest.EventPattern
 	}{
 		"prompt for job name": {
 			inJobType:        wantedJobType,
@@ -212,10 +215,7 @@ func TestJobInitOpts_Ask(t *testing.T) {
 			inJobName:        wantedJobName,
 			inDockerfilePath: "",
 
-			mockPrompt: func(m *mocks.Mockprompter) {
-				m.EXPECT().Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil, gomock.Any()).
-					Return("", mockError)
-			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
 			mockSel: func(m *mocks.MockinitJobSelector) {
 				m.EXPECT().Dockerfile(
 					gomock.Eq(fmt.Sprintf(fmtWkldInitDockerfilePrompt, wantedJobName)),
@@ -224,9 +224,11 @@ func TestJobInitOpts_Ask(t *testing.T) {
 					gomock.Eq(wkldInitDockerfilePathHelpPrompt),
 					gomock.Any(),
 				).Return("Use an existing image instead", nil)
+				m.EXPECT().Image(wkldInitImageRepoPrompt, wkldInitImagePrompt, wkldInitImageRepoPromptHelp, wkldInitImagePromptHelp, nil).
+					Return("", mockError)
 			},
 			mockFileSystem: func(mockFS afero.Fs) {},
-			wantedErr:      fmt.Errorf("get image location: mock error"),
+			wantedErr:      fmt.Errorf("select image: mock error"),
 		},
 		"using existing image": {
 			inJobType:        wantedJobType,
@@ -234,10 +236,7 @@ func TestJobInitOpts_Ask(t *testing.T) {
 			inJobSchedule:    wantedCronSchedule,
 			inDockerfilePath: "",
 
-			mockPrompt: func(m *mocks.Mockprompter) {
-				m.EXPECT().Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil, gomock.Any()).
-					Return("mockImage", nil)
-			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
 			mockSel: func(m *mocks.MockinitJobSelector) {
 				m.EXPECT().Dockerfile(
 					gomock.Eq(fmt.Sprintf(fmtWkldInitDockerfilePrompt, wantedJobName)),
@@ -246,6 +245,8 @@ func TestJobInitOpts_Ask(t *testing.T) {
 					gomock.Eq(wkldInitDockerfilePathHelpPrompt),
 					gomock.Any(),
 				).Return("Use an existing image instead", nil)
+				m.EXPECT().Image(wkldInitImageRepoPrompt, wkldInitImagePrompt, wkldInitImageRepoPromptHelp, wkldInitImagePromptHelp, nil).
+					Return("mockImage", nil)
 			},
 			mockFileSystem: func(mockFS afero.Fs) {},
 			wantedSchedule: wantedCronSchedule,
@@ -302,12 +303,34 @@ func TestJobInitOpts_Ask(t *testing.T) {
 					gomock.Eq(jobInitScheduleHelp),
 					gomock.Any(),
 					gomock.Any(),
-				).Return(wantedCronSchedule, nil)
+					gomock.Any(),
+				).Return(&selector.JobTrigger{Schedule: wantedCronSchedule}, nil)
 			},
 			mockPrompt:     func(m *mocks.Mockprompter) {},
 			wantedErr:      nil,
 			wantedSchedule: wantedCronSchedule,
 		},
+		"asks for event pattern": {
+			inJobType:        wantedJobType,
+			inJobName:        wantedJobName,
+			inDockerfilePath: wantedDockerfilePath,
+			inJobSchedule:    "",
+
+			mockFileSystem: func(mockFS afero.Fs) {},
+			mockSel: func(m *mocks.MockinitJobSelector) {
+				m.EXPECT().Schedule(
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Return(&selector.JobTrigger{EventPattern: `{"source": ["aws.s3"]}`}, nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedEventPattern: manifest.EventPattern{
+				"source": []interface{}{"aws.s3"},
+			},
+		},
 		"error getting schedule": {
 			inJobType:        wantedJobType,
 			inJobName:        wantedJobName,
@@ -322,7 +345,8 @@ func TestJobInitOpts_Ask(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 					gomock.Any(),
-				).Return("", fmt.Errorf("some error"))
+					gomock.Any(),
+				).Return(nil, fmt.Errorf("some error"))
 			},
 			wantedErr: fmt.Errorf("get schedule: some error"),
 		},
@@ -372,6 +396,7 @@ func TestJobInitOpts_Ask(t *testing.T) {
 				require.Equal(t, wantedImage, opts.image)
 			}
 			require.Equal(t, tc.wantedSchedule, opts.schedule)
+			require.Equal(t, tc.wantedEventPattern, opts.eventPattern)
 		})
 	}
 }
@@ -386,9 +411,11 @@ func TestJobInitOpts_Execute(t *testing.T) {
 		inDf   string
 
 		inSchedule string
+		inDryRun   bool
 
 		wantedErr          error
 		wantedManifestPath string
+		wantedManifest     string
 	}{
 		"success on typical job props": {
 			inApp:              "sample",
@@ -416,6 +443,40 @@ func TestJobInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("some error"),
 		},
+		"prints the manifest without creating the job on dry run": {
+			inApp:      "sample",
+			inName:     "mailer",
+			inType:     manifest.ScheduledJobType,
+			inDf:       "./Dockerfile",
+			inSchedule: "@hourly",
+			inDryRun:   true,
+
+			mockJobInit: func(m *mocks.MockjobInitializer) {
+				m.EXPECT().JobManifest(&initialize.JobProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:            "sample",
+						Name:           "mailer",
+						Type:           "Scheduled Job",
+						DockerfilePath: "./Dockerfile",
+					},
+					Schedule: "@hourly",
+				}).Return(mockBinaryMarshaler{content: "name: mailer\n"}, nil)
+				m.EXPECT().Job(gomock.Any()).Times(0)
+			},
+
+			wantedManifest: "name: mailer\n",
+		},
+		"fails to generate the manifest on dry run": {
+			inName:   "mailer",
+			inDryRun: true,
+
+			mockJobInit: func(m *mocks.MockjobInitializer) {
+				m.EXPECT().JobManifest(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().Job(gomock.Any()).Times(0)
+			},
+
+			wantedErr: errors.New("generate the manifest of job mailer: some error"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -424,6 +485,7 @@ func TestJobInitOpts_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockJobInitializer := mocks.NewMockjobInitializer(ctrl)
+			b := &bytes.Buffer{}
 
 			if tc.mockJobInit != nil {
 				tc.mockJobInit(mockJobInitializer)
@@ -436,10 +498,12 @@ func TestJobInitOpts_Execute(t *testing.T) {
 						name:           tc.inName,
 						wkldType:       tc.inType,
 						dockerfilePath: tc.inDf,
+						dryRun:         tc.inDryRun,
 					},
 					schedule: tc.inSchedule,
 				},
 				init: mockJobInitializer,
+				w:    b,
 			}
 
 			// WHEN
@@ -449,6 +513,7 @@ func TestJobInitOpts_Execute(t *testing.T) {
 			if tc.wantedErr == nil {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedManifestPath, opts.manifestPath)
+				require.Equal(t, tc.wantedManifest, b.String())
 			} else {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			}
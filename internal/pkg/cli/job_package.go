@@ -94,6 +94,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				outputDir: o.outputDir,
 			},
 			initAddonsClient: initPackageAddonsClient,
+			envOutputExports: packageEnvOutputExports,
 			ws:               ws,
 			store:            o.store,
 			appCFN:           cloudformation.New(sess),
@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/eventbridge"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobScheduleAppNamePrompt     = "Which application is the job in?"
+	jobScheduleAppNameHelpPrompt = "An application groups all of your services and jobs together."
+	jobDisableNamePrompt         = "Which job's schedule would you like to disable?"
+	jobDisableNameHelpPrompt     = "The job isn't triggered anymore until its schedule is enabled, its stack is kept."
+	jobEnableNamePrompt          = "Which job's schedule would you like to enable?"
+	jobEnableNameHelpPrompt      = "The job is triggered again by its schedule."
+
+	fmtJobDisableProdConfirmPrompt = "Are you sure you want to disable the schedule of job %s in the production environment %s?"
+	fmtJobEnableProdConfirmPrompt  = "Are you sure you want to enable the schedule of job %s in the production environment %s?"
+
+	fmtJobDisableStart      = "Disabling the schedule of job %s in environment %s."
+	fmtJobDisableFailed     = "Failed to disable the schedule of job %s in environment %s.\n"
+	fmtJobDisableComplete   = "Disabled the schedule of job %s in environment %s.\n"
+	fmtJobEnableStart       = "Enabling the schedule of job %s in environment %s."
+	fmtJobEnableFailed      = "Failed to enable the schedule of job %s in environment %s.\n"
+	fmtJobEnableComplete    = "Enabled the schedule of job %s in environment %s.\n"
+	fmtJobScheduleUnchanged = "The schedule of job %s in environment %s is already %s, nothing to do.\n"
+)
+
+var (
+	errJobScheduleCancelled = errors.New("job schedule update cancelled - no changes made")
+)
+
+type jobScheduleVars struct {
+	appName          string
+	envName          string
+	name             string
+	skipConfirmation bool
+}
+
+// jobScheduleOpts holds the fields of "job disable" and "job enable", which only differ by the state the rule of the job is set to.
+type jobScheduleOpts struct {
+	jobScheduleVars
+	enable bool // True to enable the schedule of the job, false to disable it.
+
+	store         store
+	sel           deployedJobSelector
+	prompt        prompter
+	prog          progress
+	ruleDescriber jobRuleDescriber
+	rules         ruleToggler
+	initClients   func(*jobScheduleOpts) error // Overridden in tests.
+}
+
+func newJobScheduleOpts(vars jobScheduleVars, enable bool) (*jobScheduleOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, configStore)
+	return &jobScheduleOpts{
+		jobScheduleVars: vars,
+		enable:          enable,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(prompt.New(), configStore, deployStore, selOpts...),
+		prompt:          prompt.New(),
+		prog:            termprogress.NewSpinner(),
+		initClients: func(o *jobScheduleOpts) error {
+			env, err := configStore.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			d, err := describe.NewJobScheduleDescriber(describe.NewJobScheduleConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Job:         o.name,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create schedule describer for job %s in application %s: %w", o.name, o.appName, err)
+			}
+			o.ruleDescriber = d
+			o.rules = eventbridge.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *jobScheduleOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in,
+// and for a confirmation if the job is deployed to a production environment.
+func (o *jobScheduleOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(jobScheduleAppNamePrompt, jobScheduleAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	namePrompt, nameHelpPrompt := jobDisableNamePrompt, jobDisableNameHelpPrompt
+	if o.enable {
+		namePrompt, nameHelpPrompt = jobEnableNamePrompt, jobEnableNameHelpPrompt
+	}
+	deployedJob, err := o.sel.DeployedJob(namePrompt, nameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithJob(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Job
+	o.envName = deployedJob.Env
+	return o.confirmProd()
+}
+
+// Execute sets the state of the rule that triggers the job, and records it in the configuration of the job.
+func (o *jobScheduleOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	rule, err := o.ruleDescriber.RuleName()
+	if err != nil {
+		return fmt.Errorf("get the rule of job %s: %w", o.name, err)
+	}
+	enabled, err := o.rules.IsRuleEnabled(rule)
+	if err != nil {
+		return fmt.Errorf("get the state of the schedule of job %s: %w", o.name, err)
+	}
+	if enabled == o.enable {
+		log.Infof(fmtJobScheduleUnchanged, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName), o.state())
+		return o.recordState()
+	}
+	if o.enable {
+		o.prog.Start(fmt.Sprintf(fmtJobEnableStart, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
+		if err := o.rules.EnableRule(rule); err != nil {
+			o.prog.Stop(log.Serrorf(fmtJobEnableFailed, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
+			return fmt.Errorf("enable the schedule of job %s: %w", o.name, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtJobEnableComplete, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
+		return o.recordState()
+	}
+	o.prog.Start(fmt.Sprintf(fmtJobDisableStart, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
+	if err := o.rules.DisableRule(rule); err != nil {
+		o.prog.Stop(log.Serrorf(fmtJobDisableFailed, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
+		return fmt.Errorf("disable the schedule of job %s: %w", o.name, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtJobDisableComplete, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
+	return o.recordState()
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *jobScheduleOpts) RecommendedActions() []string {
+	if o.enable {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Run %s to trigger the job with its schedule again.",
+			color.HighlightCode(fmt.Sprintf("copilot job enable -n %s -e %s", o.name, o.envName))),
+	}
+}
+
+func (o *jobScheduleOpts) confirmProd() error {
+	if o.skipConfirmation {
+		return nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	if !env.Prod {
+		return nil
+	}
+	confirmPrompt := fmt.Sprintf(fmtJobDisableProdConfirmPrompt, o.name, o.envName)
+	if o.enable {
+		confirmPrompt = fmt.Sprintf(fmtJobEnableProdConfirmPrompt, o.name, o.envName)
+	}
+	confirmed, err := o.prompt.Confirm(confirmPrompt, "")
+	if err != nil {
+		return fmt.Errorf("job schedule confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errJobScheduleCancelled
+	}
+	return nil
+}
+
+// recordState records the state of the schedule in the configuration of the job,
+// so that it's listed by "job ls" and kept by later deployments of the job.
+func (o *jobScheduleOpts) recordState() error {
+	job, err := o.store.GetJob(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get job configuration: %w", err)
+	}
+	if job.IsScheduleDisabled(o.envName) == !o.enable {
+		return nil
+	}
+	job.SetScheduleDisabled(o.envName, !o.enable)
+	if err := o.store.UpdateJob(job); err != nil {
+		return fmt.Errorf("record the state of the schedule of job %s: %w", o.name, err)
+	}
+	return nil
+}
+
+func (o *jobScheduleOpts) state() string {
+	if o.enable {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// buildJobDisableCmd builds the command for disabling the schedule of a deployed job.
+func buildJobDisableCmd() *cobra.Command {
+	vars := jobScheduleVars{}
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disables the schedule of a deployed job.",
+		Long: `Disables the rule that triggers a deployed job, without deleting its stack.
+The job isn't triggered until its schedule is enabled with "copilot job enable", and redeploying the job keeps it disabled.`,
+
+		Example: `
+  Stop the job "report" from running in the "prod" environment.
+  /code $ copilot job disable -n report -e prod
+  Disable the schedule without confirmation prompt.
+  /code $ copilot job disable -n report -e prod --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobScheduleOpts(vars, false)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
+
+// buildJobEnableCmd builds the command for enabling the schedule of a deployed job.
+func buildJobEnableCmd() *cobra.Command {
+	vars := jobScheduleVars{}
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Enables the schedule of a deployed job.",
+		Long:  `Enables the rule that triggers a deployed job, after it was disabled with "copilot job disable".`,
+
+		Example: `
+  Run the job "report" on its schedule again in the "prod" environment.
+  /code $ copilot job enable -n report -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobScheduleOpts(vars, true)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,256 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobScheduleMocks struct {
+	store         *mocks.Mockstore
+	sel           *mocks.MockdeployedJobSelector
+	prompt        *mocks.Mockprompter
+	prog          *mocks.Mockprogress
+	ruleDescriber *mocks.MockjobRuleDescriber
+	rules         *mocks.MockruleToggler
+}
+
+func TestJobSchedule_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	selectJob := func(m jobScheduleMocks) {
+		m.sel.EXPECT().DeployedJob(jobDisableNamePrompt, jobDisableNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+			Return(&selector.DeployedJob{
+				Env: "mockEnv",
+				Job: "mockJob",
+			}, nil)
+	}
+	testCases := map[string]struct {
+		inputApp              string
+		inputEnable           bool
+		inputSkipConfirmation bool
+		setupMocks            func(m jobScheduleMocks)
+
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			setupMocks: func(m jobScheduleMocks) {
+				m.sel.EXPECT().Application(jobScheduleAppNamePrompt, jobScheduleAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed job": {
+			inputApp:    "mockApp",
+			inputEnable: true,
+			setupMocks: func(m jobScheduleMocks) {
+				m.sel.EXPECT().DeployedJob(jobEnableNamePrompt, jobEnableNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed jobs for application mockApp: some error"),
+		},
+		"does not prompt for confirmation in a non-production environment": {
+			inputApp: "mockApp",
+			setupMocks: func(m jobScheduleMocks) {
+				selectJob(m)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+			},
+
+			wantedJob: "mockJob",
+			wantedEnv: "mockEnv",
+		},
+		"does not prompt for confirmation with --yes": {
+			inputApp:              "mockApp",
+			inputSkipConfirmation: true,
+			setupMocks:            selectJob,
+
+			wantedJob: "mockJob",
+			wantedEnv: "mockEnv",
+		},
+		"errors if the user cancels in a production environment": {
+			inputApp: "mockApp",
+			setupMocks: func(m jobScheduleMocks) {
+				selectJob(m)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Prod: true}, nil)
+				m.prompt.EXPECT().Confirm("Are you sure you want to disable the schedule of job mockJob in the production environment mockEnv?", "").Return(false, nil)
+			},
+
+			wantedError: errJobScheduleCancelled,
+		},
+		"confirmed in a production environment": {
+			inputApp: "mockApp",
+			setupMocks: func(m jobScheduleMocks) {
+				selectJob(m)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Prod: true}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "").Return(true, nil)
+			},
+
+			wantedJob: "mockJob",
+			wantedEnv: "mockEnv",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := jobScheduleMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockdeployedJobSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &jobScheduleOpts{
+				jobScheduleVars: jobScheduleVars{
+					appName:          tc.inputApp,
+					skipConfirmation: tc.inputSkipConfirmation,
+				},
+				enable: tc.inputEnable,
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedJob, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestJobSchedule_Execute(t *testing.T) {
+	const mockRule = "mockApp-mockEnv-mockJob-Rule-1A2B3C"
+	testCases := map[string]struct {
+		inputEnable bool
+		setupMocks  func(m jobScheduleMocks)
+
+		wantedError error
+	}{
+		"errors if failed to get the rule of the job": {
+			setupMocks: func(m jobScheduleMocks) {
+				m.ruleDescriber.EXPECT().RuleName().Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get the rule of job mockJob: some error"),
+		},
+		"disabling an already disabled schedule only records its state": {
+			setupMocks: func(m jobScheduleMocks) {
+				m.ruleDescriber.EXPECT().RuleName().Return(mockRule, nil)
+				m.rules.EXPECT().IsRuleEnabled(mockRule).Return(false, nil)
+				m.rules.EXPECT().DisableRule(gomock.Any()).Times(0)
+				m.store.EXPECT().GetJob("mockApp", "mockJob").Return(&config.Workload{
+					App:                  "mockApp",
+					Name:                 "mockJob",
+					DisabledScheduleEnvs: []string{"mockEnv"},
+				}, nil)
+			},
+		},
+		"errors if failed to disable the rule": {
+			setupMocks: func(m jobScheduleMocks) {
+				m.ruleDescriber.EXPECT().RuleName().Return(mockRule, nil)
+				m.rules.EXPECT().IsRuleEnabled(mockRule).Return(true, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.rules.EXPECT().DisableRule(mockRule).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("disable the schedule of job mockJob: some error"),
+		},
+		"disables the rule and records it": {
+			setupMocks: func(m jobScheduleMocks) {
+				m.ruleDescriber.EXPECT().RuleName().Return(mockRule, nil)
+				m.rules.EXPECT().IsRuleEnabled(mockRule).Return(true, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.rules.EXPECT().DisableRule(mockRule).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().GetJob("mockApp", "mockJob").Return(&config.Workload{
+					App:  "mockApp",
+					Name: "mockJob",
+				}, nil)
+				m.store.EXPECT().UpdateJob(&config.Workload{
+					App:                  "mockApp",
+					Name:                 "mockJob",
+					DisabledScheduleEnvs: []string{"mockEnv"},
+				}).Return(nil)
+			},
+		},
+		"errors if failed to record the state of the schedule": {
+			inputEnable: true,
+			setupMocks: func(m jobScheduleMocks) {
+				m.ruleDescriber.EXPECT().RuleName().Return(mockRule, nil)
+				m.rules.EXPECT().IsRuleEnabled(mockRule).Return(false, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.rules.EXPECT().EnableRule(mockRule).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().GetJob("mockApp", "mockJob").Return(&config.Workload{
+					App:                  "mockApp",
+					Name:                 "mockJob",
+					DisabledScheduleEnvs: []string{"mockEnv"},
+				}, nil)
+				m.store.EXPECT().UpdateJob(&config.Workload{
+					App:  "mockApp",
+					Name: "mockJob",
+				}).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("record the state of the schedule of job mockJob: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := jobScheduleMocks{
+				store:         mocks.NewMockstore(ctrl),
+				prog:          mocks.NewMockprogress(ctrl),
+				ruleDescriber: mocks.NewMockjobRuleDescriber(ctrl),
+				rules:         mocks.NewMockruleToggler(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &jobScheduleOpts{
+				jobScheduleVars: jobScheduleVars{
+					appName: "mockApp",
+					envName: "mockEnv",
+					name:    "mockJob",
+				},
+				enable:        tc.inputEnable,
+				store:         m.store,
+				prog:          m.prog,
+				ruleDescriber: m.ruleDescriber,
+				rules:         m.rules,
+				initClients:   func(*jobScheduleOpts) error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
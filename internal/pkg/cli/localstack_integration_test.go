@@ -0,0 +1,76 @@
+// +build localintegration
+
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAppInitEnvInit_LocalstackIntegration creates an application and an environment with the default configuration
+// against the endpoint in COPILOT_AWS_ENDPOINT_URL, such as localstack started with "docker run -p 4566:4566 localstack/localstack".
+func TestAppInitEnvInit_LocalstackIntegration(t *testing.T) {
+	if os.Getenv("COPILOT_AWS_ENDPOINT_URL") == "" {
+		t.Skip("COPILOT_AWS_ENDPOINT_URL isn't set")
+	}
+	appName := fmt.Sprintf("localstack-%d", time.Now().Unix())
+	const (
+		envName = "test"
+		region  = "us-east-1"
+	)
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "copilot-localstack")
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer func() {
+		os.Chdir(wd)
+		os.RemoveAll(dir)
+	}()
+
+	store, err := config.NewStore()
+	require.NoError(t, err)
+	defer func() {
+		store.DeleteEnvironment(appName, envName)
+		store.DeleteApplication(appName)
+	}()
+
+	t.Run("app init", func(t *testing.T) {
+		cmd := buildAppInitCommand()
+		cmd.SetArgs([]string{appName})
+
+		require.NoError(t, cmd.Execute())
+
+		app, err := store.GetApplication(appName)
+		require.NoError(t, err)
+		require.Equal(t, appName, app.Name)
+	})
+
+	t.Run("env init --default-config", func(t *testing.T) {
+		cmd := buildEnvInitCmd()
+		cmd.SetArgs([]string{
+			"--app", appName,
+			"--name", envName,
+			"--region", region,
+			"--aws-access-key-id", "test",
+			"--aws-secret-access-key", "test",
+			"--default-config",
+		})
+
+		require.NoError(t, cmd.Execute())
+
+		env, err := store.GetEnvironment(appName, envName)
+		require.NoError(t, err)
+		require.Equal(t, region, env.Region)
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// maxLogsLookback is how far back logs can be retrieved, log groups of workloads retain events for 30 days.
+const maxLogsLookback = 30 * 24 * time.Hour
+
+var (
+	errDurationNotPositive = errors.New("duration must be greater than 0")
+
+	// dayWeekDurationRegexp matches the day and week components of a duration, like "3d" or "1.5w".
+	dayWeekDurationRegexp = regexp.MustCompile(`(\d+(?:\.\d+)?)([dw])`)
+)
+
+// parseLogsTimeWindow validates the --since, --start-time, --end-time and --follow flags shared by the logs commands,
+// and returns the start and end time of the logs to display in milliseconds since epoch. A nil time leaves that end of the window open.
+func parseLogsTimeWindow(since, startTime, endTime string, follow bool, now time.Time) (start, end *int64, err error) {
+	if since != "" && startTime != "" {
+		return nil, nil, fmt.Errorf("only one of --%s or --%s may be used", sinceFlag, startTimeFlag)
+	}
+	if endTime != "" && follow {
+		return nil, nil, fmt.Errorf("only one of --%s or --%s may be used", followFlag, endTimeFlag)
+	}
+
+	if since != "" {
+		sinceTime, err := parseRelativeTime(since, now)
+		if err != nil {
+			if errors.Is(err, errDurationNotPositive) {
+				return nil, nil, fmt.Errorf("--%s must be greater than 0", sinceFlag)
+			}
+			return nil, nil, fmt.Errorf(`invalid argument %s for "--%s" flag: %w`, since, sinceFlag, err)
+		}
+		if err := validateLogsLookback(sinceTime, now); err != nil {
+			return nil, nil, fmt.Errorf(`invalid argument %s for "--%s" flag: %w`, since, sinceFlag, err)
+		}
+		start = aws.Int64(sinceTime.Unix() * 1000)
+	}
+	if startTime != "" {
+		startMillis, err := parseLogsStartTime(startTime, now)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`invalid argument %s for "--%s" flag: %w`, startTime, startTimeFlag, err)
+		}
+		start = aws.Int64(startMillis)
+	}
+	if endTime != "" {
+		endMillis, err := parseRFC3339(endTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`invalid argument %s for "--%s" flag: %w`, endTime, endTimeFlag, err)
+		}
+		end = aws.Int64(endMillis)
+	}
+	return start, end, nil
+}
+
+// parseLogsStartTime parses the start time either as a RFC3339 date or as a time relative to now, like "2d" or "yesterday".
+func parseLogsStartTime(timeStr string, now time.Time) (int64, error) {
+	startTime, err := parseRFC3339(timeStr)
+	if err == nil {
+		return startTime, nil
+	}
+	relStartTime, relErr := parseRelativeTime(timeStr, now)
+	if relErr != nil {
+		// Report the RFC3339 error since it's the main format of the flag.
+		return 0, err
+	}
+	if err := validateLogsLookback(relStartTime, now); err != nil {
+		return 0, err
+	}
+	return relStartTime.Unix() * 1000, nil
+}
+
+// parseRelativeTime returns the time that a value relative to now refers to.
+// The value is either "today", "yesterday" or a duration before now, like "3d" or "1w2d12h".
+// Besides the units supported by time.ParseDuration, durations can use "d" for days and "w" for weeks.
+func parseRelativeTime(value string, now time.Time) (time.Time, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "today":
+		year, month, day := now.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, now.Location()), nil
+	case "yesterday":
+		year, month, day := now.AddDate(0, 0, -1).Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, now.Location()), nil
+	}
+	duration, err := parseHumanDuration(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if duration <= 0 {
+		return time.Time{}, errDurationNotPositive
+	}
+	return now.Add(-duration.Round(time.Second)), nil
+}
+
+// parseHumanDuration parses a duration that can use "d" for days and "w" for weeks on top of the units of time.ParseDuration.
+func parseHumanDuration(value string) (time.Duration, error) {
+	var convErr error
+	inHours := dayWeekDurationRegexp.ReplaceAllStringFunc(value, func(component string) string {
+		matches := dayWeekDurationRegexp.FindStringSubmatch(component)
+		num, err := strconv.ParseFloat(matches[1], 64)
+		if err != nil {
+			convErr = err
+			return component
+		}
+		hours := num * 24
+		if matches[2] == "w" {
+			hours = num * 24 * 7
+		}
+		return strconv.FormatFloat(hours, 'f', -1, 64) + "h"
+	})
+	if convErr != nil {
+		return 0, fmt.Errorf("parse duration %s: %w", value, convErr)
+	}
+	duration, err := time.ParseDuration(inHours)
+	if err != nil {
+		return 0, fmt.Errorf("parse duration %s: value must be a duration like 30m, 12h, 3d or 1w", value)
+	}
+	return duration, nil
+}
+
+// validateLogsLookback returns an error if logs from the start time are no longer retained.
+func validateLogsLookback(startTime, now time.Time) error {
+	if now.Sub(startTime) > maxLogsLookback {
+		return fmt.Errorf("logs are only retained for the last %d days", int(maxLogsLookback.Hours()/24))
+	}
+	return nil
+}
+
+func parseRFC3339(timeStr string) (int64, error) {
+	startTimeTmp, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return 0, fmt.Errorf("reading time value %s: %w", timeStr, err)
+	}
+	return startTimeTmp.Unix() * 1000, nil
+}
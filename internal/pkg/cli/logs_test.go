@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogsTimeWindow(t *testing.T) {
+	now := time.Date(2020, time.November, 12, 15, 30, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inSince     string
+		inStartTime string
+		inEndTime   string
+		inFollow    bool
+
+		wantedStart *int64
+		wantedEnd   *int64
+		wantedError error
+	}{
+		"no time window": {},
+		"error if since and start-time are both set": {
+			inSince:     "1h",
+			inStartTime: "2020-11-12T00:00:00Z",
+
+			wantedError: errors.New("only one of --since or --start-time may be used"),
+		},
+		"error if follow and end-time are both set": {
+			inFollow:  true,
+			inEndTime: "2020-11-12T00:00:00Z",
+
+			wantedError: errors.New("only one of --follow or --end-time may be used"),
+		},
+		"error if since is not positive": {
+			inSince: "0s",
+
+			wantedError: errors.New("--since must be greater than 0"),
+		},
+		"error if since is not a duration": {
+			inSince: "2 weeks",
+
+			wantedError: errors.New(`invalid argument 2 weeks for "--since" flag: parse duration 2 weeks: value must be a duration like 30m, 12h, 3d or 1w`),
+		},
+		"error if since is older than the logs retention": {
+			inSince: "31d",
+
+			wantedError: errors.New(`invalid argument 31d for "--since" flag: logs are only retained for the last 30 days`),
+		},
+		"error if start-time is older than the logs retention": {
+			inStartTime: "5w",
+
+			wantedError: errors.New(`invalid argument 5w for "--start-time" flag: logs are only retained for the last 30 days`),
+		},
+		"error if end-time is not a RFC3339 date": {
+			inEndTime: "2d",
+
+			wantedError: errors.New(`invalid argument 2d for "--end-time" flag: reading time value 2d: parsing time "2d" as "2006-01-02T15:04:05Z07:00": cannot parse "2d" as "2006"`),
+		},
+		"since in weeks": {
+			inSince: "2w",
+
+			wantedStart: aws.Int64(time.Date(2020, time.October, 29, 15, 30, 0, 0, time.UTC).Unix() * 1000),
+		},
+		"start-time and end-time dates": {
+			inStartTime: "2020-11-10T00:00:00Z",
+			inEndTime:   "2020-11-11T00:00:00Z",
+
+			wantedStart: aws.Int64(time.Date(2020, time.November, 10, 0, 0, 0, 0, time.UTC).Unix() * 1000),
+			wantedEnd:   aws.Int64(time.Date(2020, time.November, 11, 0, 0, 0, 0, time.UTC).Unix() * 1000),
+		},
+		"relative start-time": {
+			inStartTime: "yesterday",
+
+			wantedStart: aws.Int64(time.Date(2020, time.November, 11, 0, 0, 0, 0, time.UTC).Unix() * 1000),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			start, end, err := parseLogsTimeWindow(tc.inSince, tc.inStartTime, tc.inEndTime, tc.inFollow, now)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStart, start)
+			require.Equal(t, tc.wantedEnd, end)
+		})
+	}
+}
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2020, time.November, 12, 15, 30, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inValue string
+
+		wantedTime  time.Time
+		wantedError error
+	}{
+		"seconds": {
+			inValue:    "30s",
+			wantedTime: time.Date(2020, time.November, 12, 15, 29, 30, 0, time.UTC),
+		},
+		"minutes": {
+			inValue:    "5m",
+			wantedTime: time.Date(2020, time.November, 12, 15, 25, 0, 0, time.UTC),
+		},
+		"hours": {
+			inValue:    "3h",
+			wantedTime: time.Date(2020, time.November, 12, 12, 30, 0, 0, time.UTC),
+		},
+		"days": {
+			inValue:    "3d",
+			wantedTime: time.Date(2020, time.November, 9, 15, 30, 0, 0, time.UTC),
+		},
+		"fractional days": {
+			inValue:    "1.5d",
+			wantedTime: time.Date(2020, time.November, 11, 3, 30, 0, 0, time.UTC),
+		},
+		"weeks": {
+			inValue:    "1w",
+			wantedTime: time.Date(2020, time.November, 5, 15, 30, 0, 0, time.UTC),
+		},
+		"combined units": {
+			inValue:    "1w2d12h",
+			wantedTime: time.Date(2020, time.November, 3, 3, 30, 0, 0, time.UTC),
+		},
+		"today": {
+			inValue:    "today",
+			wantedTime: time.Date(2020, time.November, 12, 0, 0, 0, 0, time.UTC),
+		},
+		"yesterday": {
+			inValue:    "Yesterday",
+			wantedTime: time.Date(2020, time.November, 11, 0, 0, 0, 0, time.UTC),
+		},
+		"zero duration": {
+			inValue:     "0d",
+			wantedError: errDurationNotPositive,
+		},
+		"negative duration": {
+			inValue:     "-2d",
+			wantedError: errDurationNotPositive,
+		},
+		"unknown unit": {
+			inValue:     "2y",
+			wantedError: errors.New("parse duration 2y: value must be a duration like 30m, 12h, 3d or 1w"),
+		},
+		"missing unit": {
+			inValue:     "2",
+			wantedError: errors.New("parse duration 2: value must be a duration like 30m, 12h, 3d or 1w"),
+		},
+		"empty value": {
+			inValue:     "",
+			wantedError: errors.New("parse duration : value must be a duration like 30m, 12h, 3d or 1w"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseRelativeTime(tc.inValue, now)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTime, got)
+		})
+	}
+}
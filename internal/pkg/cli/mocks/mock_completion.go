@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	config "github.com/aws/copilot-cli/internal/pkg/config"
+	workspace "github.com/aws/copilot-cli/internal/pkg/workspace"
 	gomock "github.com/golang/mock/gomock"
 	io "io"
 	reflect "reflect"
@@ -60,3 +62,176 @@ func (mr *MockshellCompleterMockRecorder) GenZshCompletion(w interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenZshCompletion", reflect.TypeOf((*MockshellCompleter)(nil).GenZshCompletion), w)
 }
+
+// MockcompletionStore is a mock of completionStore interface
+type MockcompletionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockcompletionStoreMockRecorder
+}
+
+// MockcompletionStoreMockRecorder is the mock recorder for MockcompletionStore
+type MockcompletionStoreMockRecorder struct {
+	mock *MockcompletionStore
+}
+
+// NewMockcompletionStore creates a new mock instance
+func NewMockcompletionStore(ctrl *gomock.Controller) *MockcompletionStore {
+	mock := &MockcompletionStore{ctrl: ctrl}
+	mock.recorder = &MockcompletionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcompletionStore) EXPECT() *MockcompletionStoreMockRecorder {
+	return m.recorder
+}
+
+// ListApplications mocks base method
+func (m *MockcompletionStore) ListApplications() ([]*config.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications")
+	ret0, _ := ret[0].([]*config.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplications indicates an expected call of ListApplications
+func (mr *MockcompletionStoreMockRecorder) ListApplications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockcompletionStore)(nil).ListApplications))
+}
+
+// ListEnvironments mocks base method
+func (m *MockcompletionStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments
+func (mr *MockcompletionStoreMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockcompletionStore)(nil).ListEnvironments), appName)
+}
+
+// ListJobs mocks base method
+func (m *MockcompletionStore) ListJobs(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockcompletionStoreMockRecorder) ListJobs(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockcompletionStore)(nil).ListJobs), appName)
+}
+
+// ListServices mocks base method
+func (m *MockcompletionStore) ListServices(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices
+func (mr *MockcompletionStoreMockRecorder) ListServices(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockcompletionStore)(nil).ListServices), appName)
+}
+
+// MockcompletionWorkspace is a mock of completionWorkspace interface
+type MockcompletionWorkspace struct {
+	ctrl     *gomock.Controller
+	recorder *MockcompletionWorkspaceMockRecorder
+}
+
+// MockcompletionWorkspaceMockRecorder is the mock recorder for MockcompletionWorkspace
+type MockcompletionWorkspaceMockRecorder struct {
+	mock *MockcompletionWorkspace
+}
+
+// NewMockcompletionWorkspace creates a new mock instance
+func NewMockcompletionWorkspace(ctrl *gomock.Controller) *MockcompletionWorkspace {
+	mock := &MockcompletionWorkspace{ctrl: ctrl}
+	mock.recorder = &MockcompletionWorkspaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcompletionWorkspace) EXPECT() *MockcompletionWorkspaceMockRecorder {
+	return m.recorder
+}
+
+// Summary mocks base method
+func (m *MockcompletionWorkspace) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary
+func (mr *MockcompletionWorkspaceMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockcompletionWorkspace)(nil).Summary))
+}
+
+// WorkloadNames mocks base method
+func (m *MockcompletionWorkspace) WorkloadNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadNames indicates an expected call of WorkloadNames
+func (mr *MockcompletionWorkspaceMockRecorder) WorkloadNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockcompletionWorkspace)(nil).WorkloadNames))
+}
+
+// MockprofileNamesLister is a mock of profileNamesLister interface
+type MockprofileNamesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockprofileNamesListerMockRecorder
+}
+
+// MockprofileNamesListerMockRecorder is the mock recorder for MockprofileNamesLister
+type MockprofileNamesListerMockRecorder struct {
+	mock *MockprofileNamesLister
+}
+
+// NewMockprofileNamesLister creates a new mock instance
+func NewMockprofileNamesLister(ctrl *gomock.Controller) *MockprofileNamesLister {
+	mock := &MockprofileNamesLister{ctrl: ctrl}
+	mock.recorder = &MockprofileNamesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockprofileNamesLister) EXPECT() *MockprofileNamesListerMockRecorder {
+	return m.recorder
+}
+
+// Names mocks base method
+func (m *MockprofileNamesLister) Names() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Names")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Names indicates an expected call of Names
+func (mr *MockprofileNamesListerMockRecorder) Names() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Names", reflect.TypeOf((*MockprofileNamesLister)(nil).Names))
+}
@@ -8,10 +8,15 @@ import (
 	encoding "encoding"
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	docker "github.com/aws/copilot-cli/internal/pkg/docker"
@@ -108,6 +113,99 @@ func (mr *MockactionCommandMockRecorder) RecommendedActions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecommendedActions", reflect.TypeOf((*MockactionCommand)(nil).RecommendedActions))
 }
 
+// MockwkldDeployer is a mock of wkldDeployer interface
+type MockwkldDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockwkldDeployerMockRecorder
+}
+
+// MockwkldDeployerMockRecorder is the mock recorder for MockwkldDeployer
+type MockwkldDeployerMockRecorder struct {
+	mock *MockwkldDeployer
+}
+
+// NewMockwkldDeployer creates a new mock instance
+func NewMockwkldDeployer(ctrl *gomock.Controller) *MockwkldDeployer {
+	mock := &MockwkldDeployer{ctrl: ctrl}
+	mock.recorder = &MockwkldDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwkldDeployer) EXPECT() *MockwkldDeployerMockRecorder {
+	return m.recorder
+}
+
+// Ask mocks base method
+func (m *MockwkldDeployer) Ask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ask indicates an expected call of Ask
+func (mr *MockwkldDeployerMockRecorder) Ask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockwkldDeployer)(nil).Ask))
+}
+
+// BuildAndPushImages mocks base method
+func (m *MockwkldDeployer) BuildAndPushImages() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildAndPushImages")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildAndPushImages indicates an expected call of BuildAndPushImages
+func (mr *MockwkldDeployerMockRecorder) BuildAndPushImages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPushImages", reflect.TypeOf((*MockwkldDeployer)(nil).BuildAndPushImages))
+}
+
+// Execute mocks base method
+func (m *MockwkldDeployer) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockwkldDeployerMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockwkldDeployer)(nil).Execute))
+}
+
+// RecommendedActions mocks base method
+func (m *MockwkldDeployer) RecommendedActions() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecommendedActions")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// RecommendedActions indicates an expected call of RecommendedActions
+func (mr *MockwkldDeployerMockRecorder) RecommendedActions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecommendedActions", reflect.TypeOf((*MockwkldDeployer)(nil).RecommendedActions))
+}
+
+// Validate mocks base method
+func (m *MockwkldDeployer) Validate() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate
+func (mr *MockwkldDeployerMockRecorder) Validate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockwkldDeployer)(nil).Validate))
+}
+
 // MockserviceStore is a mock of serviceStore interface
 type MockserviceStore struct {
 	ctrl     *gomock.Controller
@@ -270,6 +368,20 @@ func (mr *MockjobStoreMockRecorder) DeleteJob(appName, jobName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockjobStore)(nil).DeleteJob), appName, jobName)
 }
 
+// UpdateJob mocks base method
+func (m *MockjobStore) UpdateJob(job *config.Workload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob
+func (mr *MockjobStoreMockRecorder) UpdateJob(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockjobStore)(nil).UpdateJob), job)
+}
+
 // MockwlStore is a mock of wlStore interface
 type MockwlStore struct {
 	ctrl     *gomock.Controller
@@ -441,6 +553,20 @@ func (mr *MockapplicationStoreMockRecorder) DeleteApplication(name interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockapplicationStore)(nil).DeleteApplication), name)
 }
 
+// UpdateApplication mocks base method
+func (m *MockapplicationStore) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication
+func (mr *MockapplicationStoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockapplicationStore)(nil).UpdateApplication), app)
+}
+
 // MockapplicationCreator is a mock of applicationCreator interface
 type MockapplicationCreator struct {
 	ctrl     *gomock.Controller
@@ -478,6 +604,43 @@ func (mr *MockapplicationCreatorMockRecorder) CreateApplication(app interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockapplicationCreator)(nil).CreateApplication), app)
 }
 
+// MockapplicationUpdater is a mock of applicationUpdater interface
+type MockapplicationUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockapplicationUpdaterMockRecorder
+}
+
+// MockapplicationUpdaterMockRecorder is the mock recorder for MockapplicationUpdater
+type MockapplicationUpdaterMockRecorder struct {
+	mock *MockapplicationUpdater
+}
+
+// NewMockapplicationUpdater creates a new mock instance
+func NewMockapplicationUpdater(ctrl *gomock.Controller) *MockapplicationUpdater {
+	mock := &MockapplicationUpdater{ctrl: ctrl}
+	mock.recorder = &MockapplicationUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockapplicationUpdater) EXPECT() *MockapplicationUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateApplication mocks base method
+func (m *MockapplicationUpdater) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication
+func (mr *MockapplicationUpdaterMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockapplicationUpdater)(nil).UpdateApplication), app)
+}
+
 // MockapplicationGetter is a mock of applicationGetter interface
 type MockapplicationGetter struct {
 	ctrl     *gomock.Controller
@@ -672,6 +835,20 @@ func (mr *MockenvironmentStoreMockRecorder) DeleteEnvironment(appName, environme
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).DeleteEnvironment), appName, environmentName)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentCreator is a mock of environmentCreator interface
 type MockenvironmentCreator struct {
 	ctrl     *gomock.Controller
@@ -709,6 +886,43 @@ func (mr *MockenvironmentCreatorMockRecorder) CreateEnvironment(env interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockenvironmentCreator)(nil).CreateEnvironment), env)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentGetter is a mock of environmentGetter interface
 type MockenvironmentGetter struct {
 	ctrl     *gomock.Controller
@@ -1107,6 +1321,152 @@ func (mr *MockstoreMockRecorder) GetWorkload(appName, name interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkload", reflect.TypeOf((*Mockstore)(nil).GetWorkload), appName, name)
 }
 
+// UpdateApplication mocks base method
+func (m *Mockstore) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication
+func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
+}
+
+// UpdateEnvironment mocks base method
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
+// UpdateJob mocks base method
+func (m *Mockstore) UpdateJob(job *config.Workload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob
+func (mr *MockstoreMockRecorder) UpdateJob(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*Mockstore)(nil).UpdateJob), job)
+}
+
+// MockappConsistencyChecker is a mock of appConsistencyChecker interface
+type MockappConsistencyChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockappConsistencyCheckerMockRecorder
+}
+
+// MockappConsistencyCheckerMockRecorder is the mock recorder for MockappConsistencyChecker
+type MockappConsistencyCheckerMockRecorder struct {
+	mock *MockappConsistencyChecker
+}
+
+// NewMockappConsistencyChecker creates a new mock instance
+func NewMockappConsistencyChecker(ctrl *gomock.Controller) *MockappConsistencyChecker {
+	mock := &MockappConsistencyChecker{ctrl: ctrl}
+	mock.recorder = &MockappConsistencyCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappConsistencyChecker) EXPECT() *MockappConsistencyCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckConsistency mocks base method
+func (m *MockappConsistencyChecker) CheckConsistency(appName string) ([]*config.Inconsistency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckConsistency", appName)
+	ret0, _ := ret[0].([]*config.Inconsistency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckConsistency indicates an expected call of CheckConsistency
+func (mr *MockappConsistencyCheckerMockRecorder) CheckConsistency(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConsistency", reflect.TypeOf((*MockappConsistencyChecker)(nil).CheckConsistency), appName)
+}
+
+// FixInconsistency mocks base method
+func (m *MockappConsistencyChecker) FixInconsistency(inc *config.Inconsistency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FixInconsistency", inc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FixInconsistency indicates an expected call of FixInconsistency
+func (mr *MockappConsistencyCheckerMockRecorder) FixInconsistency(inc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FixInconsistency", reflect.TypeOf((*MockappConsistencyChecker)(nil).FixInconsistency), inc)
+}
+
+// MockstackTagger is a mock of stackTagger interface
+type MockstackTagger struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackTaggerMockRecorder
+}
+
+// MockstackTaggerMockRecorder is the mock recorder for MockstackTagger
+type MockstackTaggerMockRecorder struct {
+	mock *MockstackTagger
+}
+
+// NewMockstackTagger creates a new mock instance
+func NewMockstackTagger(ctrl *gomock.Controller) *MockstackTagger {
+	mock := &MockstackTagger{ctrl: ctrl}
+	mock.recorder = &MockstackTaggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstackTagger) EXPECT() *MockstackTaggerMockRecorder {
+	return m.recorder
+}
+
+// StackTags mocks base method
+func (m *MockstackTagger) StackTags(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackTags", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackTags indicates an expected call of StackTags
+func (mr *MockstackTaggerMockRecorder) StackTags(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackTags", reflect.TypeOf((*MockstackTagger)(nil).StackTags), stackName)
+}
+
+// UpdateStackTags mocks base method
+func (m *MockstackTagger) UpdateStackTags(stackName string, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStackTags", stackName, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStackTags indicates an expected call of UpdateStackTags
+func (mr *MockstackTaggerMockRecorder) UpdateStackTags(stackName, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStackTags", reflect.TypeOf((*MockstackTagger)(nil).UpdateStackTags), stackName, tags)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -1175,6 +1535,36 @@ func (mr *MockdeployedEnvironmentListerMockRecorder) IsServiceDeployed(appName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceDeployed", reflect.TypeOf((*MockdeployedEnvironmentLister)(nil).IsServiceDeployed), appName, envName, svcName)
 }
 
+// IsJobDeployed mocks base method
+func (m *MockdeployedEnvironmentLister) IsJobDeployed(appName, envName, jobName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsJobDeployed", appName, envName, jobName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsJobDeployed indicates an expected call of IsJobDeployed
+func (mr *MockdeployedEnvironmentListerMockRecorder) IsJobDeployed(appName, envName, jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsJobDeployed", reflect.TypeOf((*MockdeployedEnvironmentLister)(nil).IsJobDeployed), appName, envName, jobName)
+}
+
+// ListDeployedJobs mocks base method
+func (m *MockdeployedEnvironmentLister) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs
+func (mr *MockdeployedEnvironmentListerMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockdeployedEnvironmentLister)(nil).ListDeployedJobs), appName, envName)
+}
+
 // MocksecretsManager is a mock of secretsManager interface
 type MocksecretsManager struct {
 	ctrl     *gomock.Controller
@@ -1339,51 +1729,247 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
-// MockrepositoryURIGetter is a mock of repositoryURIGetter interface
-type MockrepositoryURIGetter struct {
+// MockimageRegistry is a mock of imageRegistry interface
+type MockimageRegistry struct {
 	ctrl     *gomock.Controller
-	recorder *MockrepositoryURIGetterMockRecorder
+	recorder *MockimageRegistryMockRecorder
 }
 
-// MockrepositoryURIGetterMockRecorder is the mock recorder for MockrepositoryURIGetter
-type MockrepositoryURIGetterMockRecorder struct {
-	mock *MockrepositoryURIGetter
+// MockimageRegistryMockRecorder is the mock recorder for MockimageRegistry
+type MockimageRegistryMockRecorder struct {
+	mock *MockimageRegistry
 }
 
-// NewMockrepositoryURIGetter creates a new mock instance
-func NewMockrepositoryURIGetter(ctrl *gomock.Controller) *MockrepositoryURIGetter {
-	mock := &MockrepositoryURIGetter{ctrl: ctrl}
-	mock.recorder = &MockrepositoryURIGetterMockRecorder{mock}
+// NewMockimageRegistry creates a new mock instance
+func NewMockimageRegistry(ctrl *gomock.Controller) *MockimageRegistry {
+	mock := &MockimageRegistry{ctrl: ctrl}
+	mock.recorder = &MockimageRegistryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockrepositoryURIGetter) EXPECT() *MockrepositoryURIGetterMockRecorder {
+func (m *MockimageRegistry) EXPECT() *MockimageRegistryMockRecorder {
 	return m.recorder
 }
 
-// URI mocks base method
-func (m *MockrepositoryURIGetter) URI() string {
+// Auth mocks base method
+func (m *MockimageRegistry) Auth() (string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "URI")
+	ret := m.ctrl.Call(m, "Auth")
 	ret0, _ := ret[0].(string)
-	return ret0
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// URI indicates an expected call of URI
-func (mr *MockrepositoryURIGetterMockRecorder) URI() *gomock.Call {
+// Auth indicates an expected call of Auth
+func (mr *MockimageRegistryMockRecorder) Auth() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockrepositoryURIGetter)(nil).URI))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockimageRegistry)(nil).Auth))
 }
 
-// MockrepositoryService is a mock of repositoryService interface
-type MockrepositoryService struct {
-	ctrl     *gomock.Controller
-	recorder *MockrepositoryServiceMockRecorder
+// ImageExists mocks base method
+func (m *MockimageRegistry) ImageExists(repoName, tag string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageExists", repoName, tag)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockrepositoryServiceMockRecorder is the mock recorder for MockrepositoryService
-type MockrepositoryServiceMockRecorder struct {
+// ImageExists indicates an expected call of ImageExists
+func (mr *MockimageRegistryMockRecorder) ImageExists(repoName, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageExists", reflect.TypeOf((*MockimageRegistry)(nil).ImageExists), repoName, tag)
+}
+
+// RepositoryURI mocks base method
+func (m *MockimageRegistry) RepositoryURI(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepositoryURI", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepositoryURI indicates an expected call of RepositoryURI
+func (mr *MockimageRegistryMockRecorder) RepositoryURI(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryURI", reflect.TypeOf((*MockimageRegistry)(nil).RepositoryURI), name)
+}
+
+// MockimageRetainer is a mock of imageRetainer interface
+type MockimageRetainer struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRetainerMockRecorder
+}
+
+// MockimageRetainerMockRecorder is the mock recorder for MockimageRetainer
+type MockimageRetainerMockRecorder struct {
+	mock *MockimageRetainer
+}
+
+// NewMockimageRetainer creates a new mock instance
+func NewMockimageRetainer(ctrl *gomock.Controller) *MockimageRetainer {
+	mock := &MockimageRetainer{ctrl: ctrl}
+	mock.recorder = &MockimageRetainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageRetainer) EXPECT() *MockimageRetainerMockRecorder {
+	return m.recorder
+}
+
+// SetImageRetention mocks base method
+func (m *MockimageRetainer) SetImageRetention(repoName string, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImageRetention", repoName, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImageRetention indicates an expected call of SetImageRetention
+func (mr *MockimageRetainerMockRecorder) SetImageRetention(repoName, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImageRetention", reflect.TypeOf((*MockimageRetainer)(nil).SetImageRetention), repoName, count)
+}
+
+// TagImage mocks base method
+func (m *MockimageRetainer) TagImage(repoName string, image ecr.Image, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagImage", repoName, image, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagImage indicates an expected call of TagImage
+func (mr *MockimageRetainerMockRecorder) TagImage(repoName, image, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagImage", reflect.TypeOf((*MockimageRetainer)(nil).TagImage), repoName, image, tag)
+}
+
+// MockimageDigestGetter is a mock of imageDigestGetter interface
+type MockimageDigestGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDigestGetterMockRecorder
+}
+
+// MockimageDigestGetterMockRecorder is the mock recorder for MockimageDigestGetter
+type MockimageDigestGetterMockRecorder struct {
+	mock *MockimageDigestGetter
+}
+
+// NewMockimageDigestGetter creates a new mock instance
+func NewMockimageDigestGetter(ctrl *gomock.Controller) *MockimageDigestGetter {
+	mock := &MockimageDigestGetter{ctrl: ctrl}
+	mock.recorder = &MockimageDigestGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageDigestGetter) EXPECT() *MockimageDigestGetterMockRecorder {
+	return m.recorder
+}
+
+// ImageDigest mocks base method
+func (m *MockimageDigestGetter) ImageDigest(repoName, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", repoName, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest
+func (mr *MockimageDigestGetterMockRecorder) ImageDigest(repoName, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageDigestGetter)(nil).ImageDigest), repoName, tag)
+}
+
+// MockalarmStatusGetter is a mock of alarmStatusGetter interface
+type MockalarmStatusGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockalarmStatusGetterMockRecorder
+}
+
+// MockalarmStatusGetterMockRecorder is the mock recorder for MockalarmStatusGetter
+type MockalarmStatusGetterMockRecorder struct {
+	mock *MockalarmStatusGetter
+}
+
+// NewMockalarmStatusGetter creates a new mock instance
+func NewMockalarmStatusGetter(ctrl *gomock.Controller) *MockalarmStatusGetter {
+	mock := &MockalarmStatusGetter{ctrl: ctrl}
+	mock.recorder = &MockalarmStatusGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockalarmStatusGetter) EXPECT() *MockalarmStatusGetterMockRecorder {
+	return m.recorder
+}
+
+// AlarmStatus mocks base method
+func (m *MockalarmStatusGetter) AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmStatus", alarms)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmStatus indicates an expected call of AlarmStatus
+func (mr *MockalarmStatusGetterMockRecorder) AlarmStatus(alarms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStatus", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmStatus), alarms)
+}
+
+// MockrepositoryURIGetter is a mock of repositoryURIGetter interface
+type MockrepositoryURIGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockrepositoryURIGetterMockRecorder
+}
+
+// MockrepositoryURIGetterMockRecorder is the mock recorder for MockrepositoryURIGetter
+type MockrepositoryURIGetterMockRecorder struct {
+	mock *MockrepositoryURIGetter
+}
+
+// NewMockrepositoryURIGetter creates a new mock instance
+func NewMockrepositoryURIGetter(ctrl *gomock.Controller) *MockrepositoryURIGetter {
+	mock := &MockrepositoryURIGetter{ctrl: ctrl}
+	mock.recorder = &MockrepositoryURIGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrepositoryURIGetter) EXPECT() *MockrepositoryURIGetterMockRecorder {
+	return m.recorder
+}
+
+// URI mocks base method
+func (m *MockrepositoryURIGetter) URI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URI indicates an expected call of URI
+func (mr *MockrepositoryURIGetterMockRecorder) URI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockrepositoryURIGetter)(nil).URI))
+}
+
+// MockrepositoryService is a mock of repositoryService interface
+type MockrepositoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrepositoryServiceMockRecorder
+}
+
+// MockrepositoryServiceMockRecorder is the mock recorder for MockrepositoryService
+type MockrepositoryServiceMockRecorder struct {
 	mock *MockrepositoryService
 }
 
@@ -1543,58 +2129,351 @@ func (mr *MockstackSerializerMockRecorder) Template() *gomock.Call {
 // SerializedParameters mocks base method
 func (m *MockstackSerializer) SerializedParameters() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SerializedParameters")
+	ret := m.ctrl.Call(m, "SerializedParameters")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializedParameters indicates an expected call of SerializedParameters
+func (mr *MockstackSerializerMockRecorder) SerializedParameters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackSerializer)(nil).SerializedParameters))
+}
+
+// Mockrunner is a mock of runner interface
+type Mockrunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunnerMockRecorder
+}
+
+// MockrunnerMockRecorder is the mock recorder for Mockrunner
+type MockrunnerMockRecorder struct {
+	mock *Mockrunner
+}
+
+// NewMockrunner creates a new mock instance
+func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
+	mock := &Mockrunner{ctrl: ctrl}
+	mock.recorder = &MockrunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method
+func (m *Mockrunner) Run(name string, args []string, options ...command.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run
+func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
+}
+
+// MockgitStatusReader is a mock of gitStatusReader interface
+type MockgitStatusReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockgitStatusReaderMockRecorder
+}
+
+// MockgitStatusReaderMockRecorder is the mock recorder for MockgitStatusReader
+type MockgitStatusReaderMockRecorder struct {
+	mock *MockgitStatusReader
+}
+
+// NewMockgitStatusReader creates a new mock instance
+func NewMockgitStatusReader(ctrl *gomock.Controller) *MockgitStatusReader {
+	mock := &MockgitStatusReader{ctrl: ctrl}
+	mock.recorder = &MockgitStatusReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockgitStatusReader) EXPECT() *MockgitStatusReaderMockRecorder {
+	return m.recorder
+}
+
+// IsRepository mocks base method
+func (m *MockgitStatusReader) IsRepository() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRepository")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsRepository indicates an expected call of IsRepository
+func (mr *MockgitStatusReaderMockRecorder) IsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRepository", reflect.TypeOf((*MockgitStatusReader)(nil).IsRepository))
+}
+
+// UncommittedFiles mocks base method
+func (m *MockgitStatusReader) UncommittedFiles(path string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UncommittedFiles", path)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UncommittedFiles indicates an expected call of UncommittedFiles
+func (mr *MockgitStatusReaderMockRecorder) UncommittedFiles(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncommittedFiles", reflect.TypeOf((*MockgitStatusReader)(nil).UncommittedFiles), path)
+}
+
+// MockgitCommitReader is a mock of gitCommitReader interface
+type MockgitCommitReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockgitCommitReaderMockRecorder
+}
+
+// MockgitCommitReaderMockRecorder is the mock recorder for MockgitCommitReader
+type MockgitCommitReaderMockRecorder struct {
+	mock *MockgitCommitReader
+}
+
+// NewMockgitCommitReader creates a new mock instance
+func NewMockgitCommitReader(ctrl *gomock.Controller) *MockgitCommitReader {
+	mock := &MockgitCommitReader{ctrl: ctrl}
+	mock.recorder = &MockgitCommitReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockgitCommitReader) EXPECT() *MockgitCommitReaderMockRecorder {
+	return m.recorder
+}
+
+// HeadCommit mocks base method
+func (m *MockgitCommitReader) HeadCommit() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeadCommit")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HeadCommit indicates an expected call of HeadCommit
+func (mr *MockgitCommitReaderMockRecorder) HeadCommit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeadCommit", reflect.TypeOf((*MockgitCommitReader)(nil).HeadCommit))
+}
+
+// MockworkloadStackIDGetter is a mock of workloadStackIDGetter interface
+type MockworkloadStackIDGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStackIDGetterMockRecorder
+}
+
+// MockworkloadStackIDGetterMockRecorder is the mock recorder for MockworkloadStackIDGetter
+type MockworkloadStackIDGetterMockRecorder struct {
+	mock *MockworkloadStackIDGetter
+}
+
+// NewMockworkloadStackIDGetter creates a new mock instance
+func NewMockworkloadStackIDGetter(ctrl *gomock.Controller) *MockworkloadStackIDGetter {
+	mock := &MockworkloadStackIDGetter{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackIDGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockworkloadStackIDGetter) EXPECT() *MockworkloadStackIDGetterMockRecorder {
+	return m.recorder
+}
+
+// WorkloadStackID mocks base method
+func (m *MockworkloadStackIDGetter) WorkloadStackID(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadStackID", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadStackID indicates an expected call of WorkloadStackID
+func (mr *MockworkloadStackIDGetterMockRecorder) WorkloadStackID(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadStackID", reflect.TypeOf((*MockworkloadStackIDGetter)(nil).WorkloadStackID), stackName)
+}
+
+// MockserviceDeployer is a mock of serviceDeployer interface
+type MockserviceDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDeployerMockRecorder
+}
+
+// MockserviceDeployerMockRecorder is the mock recorder for MockserviceDeployer
+type MockserviceDeployerMockRecorder struct {
+	mock *MockserviceDeployer
+}
+
+// NewMockserviceDeployer creates a new mock instance
+func NewMockserviceDeployer(ctrl *gomock.Controller) *MockserviceDeployer {
+	mock := &MockserviceDeployer{ctrl: ctrl}
+	mock.recorder = &MockserviceDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceDeployer) EXPECT() *MockserviceDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method
+func (m *MockserviceDeployer) DeployService(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService
+func (mr *MockserviceDeployerMockRecorder) DeployService(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
+}
+
+// DeployServiceNoWait mocks base method
+func (m *MockserviceDeployer) DeployServiceNoWait(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployServiceNoWait", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployServiceNoWait indicates an expected call of DeployServiceNoWait
+func (mr *MockserviceDeployerMockRecorder) DeployServiceNoWait(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployServiceNoWait", reflect.TypeOf((*MockserviceDeployer)(nil).DeployServiceNoWait), varargs...)
+}
+
+// IsServiceUpToDate mocks base method
+func (m *MockserviceDeployer) IsServiceUpToDate(conf cloudformation0.StackConfiguration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsServiceUpToDate", conf)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsServiceUpToDate indicates an expected call of IsServiceUpToDate
+func (mr *MockserviceDeployerMockRecorder) IsServiceUpToDate(conf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceUpToDate", reflect.TypeOf((*MockserviceDeployer)(nil).IsServiceUpToDate), conf)
+}
+
+// WorkloadStackID mocks base method
+func (m *MockserviceDeployer) WorkloadStackID(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadStackID", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadStackID indicates an expected call of WorkloadStackID
+func (mr *MockserviceDeployerMockRecorder) WorkloadStackID(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadStackID", reflect.TypeOf((*MockserviceDeployer)(nil).WorkloadStackID), stackName)
+}
+
+// MocksnsPublisher is a mock of snsPublisher interface
+type MocksnsPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MocksnsPublisherMockRecorder
+}
+
+// MocksnsPublisherMockRecorder is the mock recorder for MocksnsPublisher
+type MocksnsPublisherMockRecorder struct {
+	mock *MocksnsPublisher
+}
+
+// NewMocksnsPublisher creates a new mock instance
+func NewMocksnsPublisher(ctrl *gomock.Controller) *MocksnsPublisher {
+	mock := &MocksnsPublisher{ctrl: ctrl}
+	mock.recorder = &MocksnsPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksnsPublisher) EXPECT() *MocksnsPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method
+func (m *MocksnsPublisher) Publish(topicARN, message string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", topicARN, message)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SerializedParameters indicates an expected call of SerializedParameters
-func (mr *MockstackSerializerMockRecorder) SerializedParameters() *gomock.Call {
+// Publish indicates an expected call of Publish
+func (mr *MocksnsPublisherMockRecorder) Publish(topicARN, message interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackSerializer)(nil).SerializedParameters))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MocksnsPublisher)(nil).Publish), topicARN, message)
 }
 
-// Mockrunner is a mock of runner interface
-type Mockrunner struct {
+// MockdeploymentHistoryWriter is a mock of deploymentHistoryWriter interface
+type MockdeploymentHistoryWriter struct {
 	ctrl     *gomock.Controller
-	recorder *MockrunnerMockRecorder
+	recorder *MockdeploymentHistoryWriterMockRecorder
 }
 
-// MockrunnerMockRecorder is the mock recorder for Mockrunner
-type MockrunnerMockRecorder struct {
-	mock *Mockrunner
+// MockdeploymentHistoryWriterMockRecorder is the mock recorder for MockdeploymentHistoryWriter
+type MockdeploymentHistoryWriterMockRecorder struct {
+	mock *MockdeploymentHistoryWriter
 }
 
-// NewMockrunner creates a new mock instance
-func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
-	mock := &Mockrunner{ctrl: ctrl}
-	mock.recorder = &MockrunnerMockRecorder{mock}
+// NewMockdeploymentHistoryWriter creates a new mock instance
+func NewMockdeploymentHistoryWriter(ctrl *gomock.Controller) *MockdeploymentHistoryWriter {
+	mock := &MockdeploymentHistoryWriter{ctrl: ctrl}
+	mock.recorder = &MockdeploymentHistoryWriterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+func (m *MockdeploymentHistoryWriter) EXPECT() *MockdeploymentHistoryWriterMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method
-func (m *Mockrunner) Run(name string, args []string, options ...command.Option) error {
+// Record mocks base method
+func (m *MockdeploymentHistoryWriter) Record(bucket string, rec *deploy.WorkloadDeploymentRecord, keep int) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{name, args}
-	for _, a := range options {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret := m.ctrl.Call(m, "Record", bucket, rec, keep)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Run indicates an expected call of Run
-func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+// Record indicates an expected call of Record
+func (mr *MockdeploymentHistoryWriterMockRecorder) Record(bucket, rec, keep interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{name, args}, options...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockdeploymentHistoryWriter)(nil).Record), bucket, rec, keep)
 }
 
 // MockeventsWriter is a mock of eventsWriter interface
@@ -2361,6 +3240,36 @@ func (mr *MockwsSvcDirReaderMockRecorder) CopilotDirPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsSvcDirReader)(nil).CopilotDirPath))
 }
 
+// JobNames mocks base method
+func (m *MockwsSvcDirReader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames
+func (mr *MockwsSvcDirReaderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsSvcDirReader)(nil).JobNames))
+}
+
+// ReadJobManifest mocks base method
+func (m *MockwsSvcDirReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest
+func (mr *MockwsSvcDirReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsSvcDirReader)(nil).ReadJobManifest), jobName)
+}
+
 // MockwsJobLister is a mock of wsJobLister interface
 type MockwsJobLister struct {
 	ctrl     *gomock.Controller
@@ -2558,6 +3467,119 @@ func (mr *MockwsJobDirReaderMockRecorder) CopilotDirPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsJobDirReader)(nil).CopilotDirPath))
 }
 
+// ReadServiceManifest mocks base method
+func (m *MockwsJobDirReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsJobDirReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsJobDirReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceNames mocks base method
+func (m *MockwsJobDirReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsJobDirReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsJobDirReader)(nil).ServiceNames))
+}
+
+// MockwsWlManifestReader is a mock of wsWlManifestReader interface
+type MockwsWlManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWlManifestReaderMockRecorder
+}
+
+// MockwsWlManifestReaderMockRecorder is the mock recorder for MockwsWlManifestReader
+type MockwsWlManifestReaderMockRecorder struct {
+	mock *MockwsWlManifestReader
+}
+
+// NewMockwsWlManifestReader creates a new mock instance
+func NewMockwsWlManifestReader(ctrl *gomock.Controller) *MockwsWlManifestReader {
+	mock := &MockwsWlManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsWlManifestReader) EXPECT() *MockwsWlManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// JobNames mocks base method
+func (m *MockwsWlManifestReader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames
+func (mr *MockwsWlManifestReaderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).JobNames))
+}
+
+// ReadJobManifest mocks base method
+func (m *MockwsWlManifestReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest
+func (mr *MockwsWlManifestReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadJobManifest), jobName)
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockwsWlManifestReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsWlManifestReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceNames mocks base method
+func (m *MockwsWlManifestReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsWlManifestReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).ServiceNames))
+}
+
 // MockwsWlDirReader is a mock of wsWlDirReader interface
 type MockwsWlDirReader struct {
 	ctrl     *gomock.Controller
@@ -2806,6 +3828,73 @@ func (mr *MockwsAppManagerMockRecorder) Summary() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManager)(nil).Summary))
 }
 
+// AddGitIgnorePatterns mocks base method
+func (m *MockwsAppManager) AddGitIgnorePatterns() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGitIgnorePatterns")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGitIgnorePatterns indicates an expected call of AddGitIgnorePatterns
+func (mr *MockwsAppManagerMockRecorder) AddGitIgnorePatterns() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGitIgnorePatterns", reflect.TypeOf((*MockwsAppManager)(nil).AddGitIgnorePatterns))
+}
+
+// MockwsEnvDefaulter is a mock of wsEnvDefaulter interface
+type MockwsEnvDefaulter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvDefaulterMockRecorder
+}
+
+// MockwsEnvDefaulterMockRecorder is the mock recorder for MockwsEnvDefaulter
+type MockwsEnvDefaulterMockRecorder struct {
+	mock *MockwsEnvDefaulter
+}
+
+// NewMockwsEnvDefaulter creates a new mock instance
+func NewMockwsEnvDefaulter(ctrl *gomock.Controller) *MockwsEnvDefaulter {
+	mock := &MockwsEnvDefaulter{ctrl: ctrl}
+	mock.recorder = &MockwsEnvDefaulterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsEnvDefaulter) EXPECT() *MockwsEnvDefaulterMockRecorder {
+	return m.recorder
+}
+
+// SetDefaultEnvironment mocks base method
+func (m *MockwsEnvDefaulter) SetDefaultEnvironment(envName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultEnvironment", envName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultEnvironment indicates an expected call of SetDefaultEnvironment
+func (mr *MockwsEnvDefaulterMockRecorder) SetDefaultEnvironment(envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultEnvironment", reflect.TypeOf((*MockwsEnvDefaulter)(nil).SetDefaultEnvironment), envName)
+}
+
+// Summary mocks base method
+func (m *MockwsEnvDefaulter) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary
+func (mr *MockwsEnvDefaulterMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsEnvDefaulter)(nil).Summary))
+}
+
 // MockwsAddonManager is a mock of wsAddonManager interface
 type MockwsAddonManager struct {
 	ctrl     *gomock.Controller
@@ -3041,81 +4130,171 @@ func (m *MockenvironmentDeployer) UpdateEnvironmentTemplate(appName, envName, te
 // UpdateEnvironmentTemplate indicates an expected call of UpdateEnvironmentTemplate
 func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+}
+
+// EnvironmentFromStack mocks base method
+func (m *MockenvironmentDeployer) EnvironmentFromStack(appName, envName string) (*config.Environment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentFromStack", appName, envName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnvironmentFromStack indicates an expected call of EnvironmentFromStack
+func (mr *MockenvironmentDeployerMockRecorder) EnvironmentFromStack(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentFromStack", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentFromStack), appName, envName)
+}
+
+// MockwlDeleter is a mock of wlDeleter interface
+type MockwlDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwlDeleterMockRecorder
+}
+
+// MockwlDeleterMockRecorder is the mock recorder for MockwlDeleter
+type MockwlDeleterMockRecorder struct {
+	mock *MockwlDeleter
+}
+
+// NewMockwlDeleter creates a new mock instance
+func NewMockwlDeleter(ctrl *gomock.Controller) *MockwlDeleter {
+	mock := &MockwlDeleter{ctrl: ctrl}
+	mock.recorder = &MockwlDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwlDeleter) EXPECT() *MockwlDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteWorkload mocks base method
+func (m *MockwlDeleter) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkload", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkload indicates an expected call of DeleteWorkload
+func (mr *MockwlDeleterMockRecorder) DeleteWorkload(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkload", reflect.TypeOf((*MockwlDeleter)(nil).DeleteWorkload), in)
+}
+
+// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
+type MocksvcRemoverFromApp struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRemoverFromAppMockRecorder
+}
+
+// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
+type MocksvcRemoverFromAppMockRecorder struct {
+	mock *MocksvcRemoverFromApp
+}
+
+// NewMocksvcRemoverFromApp creates a new mock instance
+func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
+	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+	return m.recorder
+}
+
+// RemoveServiceFromApp mocks base method
+func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
+func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
 }
 
-// MockwlDeleter is a mock of wlDeleter interface
-type MockwlDeleter struct {
+// MockenvRemoverFromApp is a mock of envRemoverFromApp interface
+type MockenvRemoverFromApp struct {
 	ctrl     *gomock.Controller
-	recorder *MockwlDeleterMockRecorder
+	recorder *MockenvRemoverFromAppMockRecorder
 }
 
-// MockwlDeleterMockRecorder is the mock recorder for MockwlDeleter
-type MockwlDeleterMockRecorder struct {
-	mock *MockwlDeleter
+// MockenvRemoverFromAppMockRecorder is the mock recorder for MockenvRemoverFromApp
+type MockenvRemoverFromAppMockRecorder struct {
+	mock *MockenvRemoverFromApp
 }
 
-// NewMockwlDeleter creates a new mock instance
-func NewMockwlDeleter(ctrl *gomock.Controller) *MockwlDeleter {
-	mock := &MockwlDeleter{ctrl: ctrl}
-	mock.recorder = &MockwlDeleterMockRecorder{mock}
+// NewMockenvRemoverFromApp creates a new mock instance
+func NewMockenvRemoverFromApp(ctrl *gomock.Controller) *MockenvRemoverFromApp {
+	mock := &MockenvRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MockenvRemoverFromAppMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwlDeleter) EXPECT() *MockwlDeleterMockRecorder {
+func (m *MockenvRemoverFromApp) EXPECT() *MockenvRemoverFromAppMockRecorder {
 	return m.recorder
 }
 
-// DeleteWorkload mocks base method
-func (m *MockwlDeleter) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
+// RemoveEnvFromApp mocks base method
+func (m *MockenvRemoverFromApp) RemoveEnvFromApp(in *deploy.RemoveEnvFromAppInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWorkload", in)
+	ret := m.ctrl.Call(m, "RemoveEnvFromApp", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteWorkload indicates an expected call of DeleteWorkload
-func (mr *MockwlDeleterMockRecorder) DeleteWorkload(in interface{}) *gomock.Call {
+// RemoveEnvFromApp indicates an expected call of RemoveEnvFromApp
+func (mr *MockenvRemoverFromAppMockRecorder) RemoveEnvFromApp(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkload", reflect.TypeOf((*MockwlDeleter)(nil).DeleteWorkload), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEnvFromApp", reflect.TypeOf((*MockenvRemoverFromApp)(nil).RemoveEnvFromApp), in)
 }
 
-// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
-type MocksvcRemoverFromApp struct {
+// MockdnsDelegationRevoker is a mock of dnsDelegationRevoker interface
+type MockdnsDelegationRevoker struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcRemoverFromAppMockRecorder
+	recorder *MockdnsDelegationRevokerMockRecorder
 }
 
-// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
-type MocksvcRemoverFromAppMockRecorder struct {
-	mock *MocksvcRemoverFromApp
+// MockdnsDelegationRevokerMockRecorder is the mock recorder for MockdnsDelegationRevoker
+type MockdnsDelegationRevokerMockRecorder struct {
+	mock *MockdnsDelegationRevoker
 }
 
-// NewMocksvcRemoverFromApp creates a new mock instance
-func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
-	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
-	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+// NewMockdnsDelegationRevoker creates a new mock instance
+func NewMockdnsDelegationRevoker(ctrl *gomock.Controller) *MockdnsDelegationRevoker {
+	mock := &MockdnsDelegationRevoker{ctrl: ctrl}
+	mock.recorder = &MockdnsDelegationRevokerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+func (m *MockdnsDelegationRevoker) EXPECT() *MockdnsDelegationRevokerMockRecorder {
 	return m.recorder
 }
 
-// RemoveServiceFromApp mocks base method
-func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+// RevokeDNSPermissions mocks base method
+func (m *MockdnsDelegationRevoker) RevokeDNSPermissions(app *config.Application, accountID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
+	ret := m.ctrl.Call(m, "RevokeDNSPermissions", app, accountID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
-func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+// RevokeDNSPermissions indicates an expected call of RevokeDNSPermissions
+func (mr *MockdnsDelegationRevokerMockRecorder) RevokeDNSPermissions(app, accountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeDNSPermissions", reflect.TypeOf((*MockdnsDelegationRevoker)(nil).RevokeDNSPermissions), app, accountID)
 }
 
 // MockjobRemoverFromApp is a mock of jobRemoverFromApp interface
@@ -3192,6 +4371,82 @@ func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
 }
 
+// MockparamsDeleter is a mock of paramsDeleter interface
+type MockparamsDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockparamsDeleterMockRecorder
+}
+
+// MockparamsDeleterMockRecorder is the mock recorder for MockparamsDeleter
+type MockparamsDeleterMockRecorder struct {
+	mock *MockparamsDeleter
+}
+
+// NewMockparamsDeleter creates a new mock instance
+func NewMockparamsDeleter(ctrl *gomock.Controller) *MockparamsDeleter {
+	mock := &MockparamsDeleter{ctrl: ctrl}
+	mock.recorder = &MockparamsDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockparamsDeleter) EXPECT() *MockparamsDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteParametersWithTags mocks base method
+func (m *MockparamsDeleter) DeleteParametersWithTags(tags map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParametersWithTags", tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParametersWithTags indicates an expected call of DeleteParametersWithTags
+func (mr *MockparamsDeleterMockRecorder) DeleteParametersWithTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParametersWithTags", reflect.TypeOf((*MockparamsDeleter)(nil).DeleteParametersWithTags), tags)
+}
+
+// MockimageLister is a mock of imageLister interface
+type MockimageLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageListerMockRecorder
+}
+
+// MockimageListerMockRecorder is the mock recorder for MockimageLister
+type MockimageListerMockRecorder struct {
+	mock *MockimageLister
+}
+
+// NewMockimageLister creates a new mock instance
+func NewMockimageLister(ctrl *gomock.Controller) *MockimageLister {
+	mock := &MockimageLister{ctrl: ctrl}
+	mock.recorder = &MockimageListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageLister) EXPECT() *MockimageListerMockRecorder {
+	return m.recorder
+}
+
+// ListImages mocks base method
+func (m *MockimageLister) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages
+func (mr *MockimageListerMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockimageLister)(nil).ListImages), repoName)
+}
+
 // MockpipelineDeployer is a mock of pipelineDeployer interface
 type MockpipelineDeployer struct {
 	ctrl     *gomock.Controller
@@ -3382,17 +4637,22 @@ func (mr *MockappDeployerMockRecorder) AddJobToApp(app, jobName interface{}) *go
 }
 
 // AddEnvToApp mocks base method
-func (m *MockappDeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
+func (m *MockappDeployer) AddEnvToApp(app *config.Application, env *config.Environment, opts ...stackset.WaitOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
+	varargs := []interface{}{app, env}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddEnvToApp", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddEnvToApp indicates an expected call of AddEnvToApp
-func (mr *MockappDeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
+func (mr *MockappDeployerMockRecorder) AddEnvToApp(app, env interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), app, env)
+	varargs := append([]interface{}{app, env}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), varargs...)
 }
 
 // DelegateDNSPermissions mocks base method
@@ -3556,6 +4816,74 @@ func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
 }
 
+// Input mocks base method
+func (m *MocktaskRunner) Input() (*ecs.RunTaskInput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Input")
+	ret0, _ := ret[0].(*ecs.RunTaskInput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Input indicates an expected call of Input
+func (mr *MocktaskRunnerMockRecorder) Input() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Input", reflect.TypeOf((*MocktaskRunner)(nil).Input))
+}
+
+// MockstoppedTasksWaiter is a mock of stoppedTasksWaiter interface
+type MockstoppedTasksWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstoppedTasksWaiterMockRecorder
+}
+
+// MockstoppedTasksWaiterMockRecorder is the mock recorder for MockstoppedTasksWaiter
+type MockstoppedTasksWaiterMockRecorder struct {
+	mock *MockstoppedTasksWaiter
+}
+
+// NewMockstoppedTasksWaiter creates a new mock instance
+func NewMockstoppedTasksWaiter(ctrl *gomock.Controller) *MockstoppedTasksWaiter {
+	mock := &MockstoppedTasksWaiter{ctrl: ctrl}
+	mock.recorder = &MockstoppedTasksWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstoppedTasksWaiter) EXPECT() *MockstoppedTasksWaiterMockRecorder {
+	return m.recorder
+}
+
+// TaskDefinition mocks base method
+func (m *MockstoppedTasksWaiter) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MockstoppedTasksWaiterMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockstoppedTasksWaiter)(nil).TaskDefinition), taskDefName)
+}
+
+// WaitUntilTasksStopped mocks base method
+func (m *MockstoppedTasksWaiter) WaitUntilTasksStopped(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilTasksStopped", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitUntilTasksStopped indicates an expected call of WaitUntilTasksStopped
+func (mr *MockstoppedTasksWaiterMockRecorder) WaitUntilTasksStopped(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTasksStopped", reflect.TypeOf((*MockstoppedTasksWaiter)(nil).WaitUntilTasksStopped), cluster, taskARNs)
+}
+
 // MockdefaultClusterGetter is a mock of defaultClusterGetter interface
 type MockdefaultClusterGetter struct {
 	ctrl     *gomock.Controller
@@ -3747,17 +5075,22 @@ func (mr *MockdeployerMockRecorder) AddJobToApp(app, jobName interface{}) *gomoc
 }
 
 // AddEnvToApp mocks base method
-func (m *Mockdeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
+func (m *Mockdeployer) AddEnvToApp(app *config.Application, env *config.Environment, opts ...stackset.WaitOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
+	varargs := []interface{}{app, env}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddEnvToApp", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddEnvToApp indicates an expected call of AddEnvToApp
-func (mr *MockdeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) AddEnvToApp(app, env interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), app, env)
+	varargs := append([]interface{}{app, env}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), varargs...)
 }
 
 // DelegateDNSPermissions mocks base method
@@ -3889,6 +5222,22 @@ func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
 }
 
+// EnvironmentFromStack mocks base method
+func (m *Mockdeployer) EnvironmentFromStack(appName, envName string) (*config.Environment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentFromStack", appName, envName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnvironmentFromStack indicates an expected call of EnvironmentFromStack
+func (mr *MockdeployerMockRecorder) EnvironmentFromStack(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentFromStack", reflect.TypeOf((*Mockdeployer)(nil).EnvironmentFromStack), appName, envName)
+}
+
 // MockdomainValidator is a mock of domainValidator interface
 type MockdomainValidator struct {
 	ctrl     *gomock.Controller
@@ -3927,6 +5276,82 @@ func (mr *MockdomainValidatorMockRecorder) DomainExists(domainName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainExists", reflect.TypeOf((*MockdomainValidator)(nil).DomainExists), domainName)
 }
 
+// MockbuilderPlatformsLister is a mock of builderPlatformsLister interface
+type MockbuilderPlatformsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuilderPlatformsListerMockRecorder
+}
+
+// MockbuilderPlatformsListerMockRecorder is the mock recorder for MockbuilderPlatformsLister
+type MockbuilderPlatformsListerMockRecorder struct {
+	mock *MockbuilderPlatformsLister
+}
+
+// NewMockbuilderPlatformsLister creates a new mock instance
+func NewMockbuilderPlatformsLister(ctrl *gomock.Controller) *MockbuilderPlatformsLister {
+	mock := &MockbuilderPlatformsLister{ctrl: ctrl}
+	mock.recorder = &MockbuilderPlatformsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbuilderPlatformsLister) EXPECT() *MockbuilderPlatformsListerMockRecorder {
+	return m.recorder
+}
+
+// BuilderPlatforms mocks base method
+func (m *MockbuilderPlatformsLister) BuilderPlatforms() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuilderPlatforms")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuilderPlatforms indicates an expected call of BuilderPlatforms
+func (mr *MockbuilderPlatformsListerMockRecorder) BuilderPlatforms() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuilderPlatforms", reflect.TypeOf((*MockbuilderPlatformsLister)(nil).BuilderPlatforms))
+}
+
+// MockbuildContextSizer is a mock of buildContextSizer interface
+type MockbuildContextSizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuildContextSizerMockRecorder
+}
+
+// MockbuildContextSizerMockRecorder is the mock recorder for MockbuildContextSizer
+type MockbuildContextSizerMockRecorder struct {
+	mock *MockbuildContextSizer
+}
+
+// NewMockbuildContextSizer creates a new mock instance
+func NewMockbuildContextSizer(ctrl *gomock.Controller) *MockbuildContextSizer {
+	mock := &MockbuildContextSizer{ctrl: ctrl}
+	mock.recorder = &MockbuildContextSizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbuildContextSizer) EXPECT() *MockbuildContextSizerMockRecorder {
+	return m.recorder
+}
+
+// BuildContextSize mocks base method
+func (m *MockbuildContextSizer) BuildContextSize(dir string) (*docker.ContextSize, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildContextSize", dir)
+	ret0, _ := ret[0].(*docker.ContextSize)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildContextSize indicates an expected call of BuildContextSize
+func (mr *MockbuildContextSizerMockRecorder) BuildContextSize(dir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildContextSize", reflect.TypeOf((*MockbuildContextSizer)(nil).BuildContextSize), dir)
+}
+
 // MockdockerfileParser is a mock of dockerfileParser interface
 type MockdockerfileParser struct {
 	ctrl     *gomock.Controller
@@ -4018,6 +5443,120 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockjobHistoryDescriber is a mock of jobHistoryDescriber interface
+type MockjobHistoryDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobHistoryDescriberMockRecorder
+}
+
+// MockjobHistoryDescriberMockRecorder is the mock recorder for MockjobHistoryDescriber
+type MockjobHistoryDescriberMockRecorder struct {
+	mock *MockjobHistoryDescriber
+}
+
+// NewMockjobHistoryDescriber creates a new mock instance
+func NewMockjobHistoryDescriber(ctrl *gomock.Controller) *MockjobHistoryDescriber {
+	mock := &MockjobHistoryDescriber{ctrl: ctrl}
+	mock.recorder = &MockjobHistoryDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockjobHistoryDescriber) EXPECT() *MockjobHistoryDescriberMockRecorder {
+	return m.recorder
+}
+
+// History mocks base method
+func (m *MockjobHistoryDescriber) History(limit int, status string) (*describe.JobHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", limit, status)
+	ret0, _ := ret[0].(*describe.JobHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History
+func (mr *MockjobHistoryDescriberMockRecorder) History(limit, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockjobHistoryDescriber)(nil).History), limit, status)
+}
+
+// MockjobRuleDescriber is a mock of jobRuleDescriber interface
+type MockjobRuleDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobRuleDescriberMockRecorder
+}
+
+// MockjobRuleDescriberMockRecorder is the mock recorder for MockjobRuleDescriber
+type MockjobRuleDescriberMockRecorder struct {
+	mock *MockjobRuleDescriber
+}
+
+// NewMockjobRuleDescriber creates a new mock instance
+func NewMockjobRuleDescriber(ctrl *gomock.Controller) *MockjobRuleDescriber {
+	mock := &MockjobRuleDescriber{ctrl: ctrl}
+	mock.recorder = &MockjobRuleDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockjobRuleDescriber) EXPECT() *MockjobRuleDescriberMockRecorder {
+	return m.recorder
+}
+
+// RuleName mocks base method
+func (m *MockjobRuleDescriber) RuleName() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RuleName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RuleName indicates an expected call of RuleName
+func (mr *MockjobRuleDescriberMockRecorder) RuleName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RuleName", reflect.TypeOf((*MockjobRuleDescriber)(nil).RuleName))
+}
+
+// MockserviceDeploymentsDescriber is a mock of serviceDeploymentsDescriber interface
+type MockserviceDeploymentsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDeploymentsDescriberMockRecorder
+}
+
+// MockserviceDeploymentsDescriberMockRecorder is the mock recorder for MockserviceDeploymentsDescriber
+type MockserviceDeploymentsDescriberMockRecorder struct {
+	mock *MockserviceDeploymentsDescriber
+}
+
+// NewMockserviceDeploymentsDescriber creates a new mock instance
+func NewMockserviceDeploymentsDescriber(ctrl *gomock.Controller) *MockserviceDeploymentsDescriber {
+	mock := &MockserviceDeploymentsDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceDeploymentsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceDeploymentsDescriber) EXPECT() *MockserviceDeploymentsDescriberMockRecorder {
+	return m.recorder
+}
+
+// Deployments mocks base method
+func (m *MockserviceDeploymentsDescriber) Deployments(limit int) (*describe.ServiceDeployments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deployments", limit)
+	ret0, _ := ret[0].(*describe.ServiceDeployments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deployments indicates an expected call of Deployments
+func (mr *MockserviceDeploymentsDescriberMockRecorder) Deployments(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployments", reflect.TypeOf((*MockserviceDeploymentsDescriber)(nil).Deployments), limit)
+}
+
 // MockenvDescriber is a mock of envDescriber interface
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -4282,19 +5821,57 @@ func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeLegacyEnvironment(in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
 }
 
-// EnvironmentTemplate mocks base method
-func (m *MockenvTemplateUpgrader) EnvironmentTemplate(appName, envName string) (string, error) {
+// EnvironmentTemplate mocks base method
+func (m *MockenvTemplateUpgrader) EnvironmentTemplate(appName, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate
+func (mr *MockenvTemplateUpgraderMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).EnvironmentTemplate), appName, envName)
+}
+
+// MockpipelineNamesLister is a mock of pipelineNamesLister interface
+type MockpipelineNamesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineNamesListerMockRecorder
+}
+
+// MockpipelineNamesListerMockRecorder is the mock recorder for MockpipelineNamesLister
+type MockpipelineNamesListerMockRecorder struct {
+	mock *MockpipelineNamesLister
+}
+
+// NewMockpipelineNamesLister creates a new mock instance
+func NewMockpipelineNamesLister(ctrl *gomock.Controller) *MockpipelineNamesLister {
+	mock := &MockpipelineNamesLister{ctrl: ctrl}
+	mock.recorder = &MockpipelineNamesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineNamesLister) EXPECT() *MockpipelineNamesListerMockRecorder {
+	return m.recorder
+}
+
+// ListPipelineNamesByTags mocks base method
+func (m *MockpipelineNamesLister) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EnvironmentTemplate indicates an expected call of EnvironmentTemplate
-func (mr *MockenvTemplateUpgraderMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags
+func (mr *MockpipelineNamesListerMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).EnvironmentTemplate), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineNamesLister)(nil).ListPipelineNamesByTags), tags)
 }
 
 // MockpipelineGetter is a mock of pipelineGetter interface
@@ -4674,6 +6251,213 @@ func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
 }
 
+// Job mocks base method
+func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job
+func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+}
+
+// MockservicePauser is a mock of servicePauser interface
+type MockservicePauser struct {
+	ctrl     *gomock.Controller
+	recorder *MockservicePauserMockRecorder
+}
+
+// MockservicePauserMockRecorder is the mock recorder for MockservicePauser
+type MockservicePauserMockRecorder struct {
+	mock *MockservicePauser
+}
+
+// NewMockservicePauser creates a new mock instance
+func NewMockservicePauser(ctrl *gomock.Controller) *MockservicePauser {
+	mock := &MockservicePauser{ctrl: ctrl}
+	mock.recorder = &MockservicePauserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockservicePauser) EXPECT() *MockservicePauserMockRecorder {
+	return m.recorder
+}
+
+// PauseService mocks base method
+func (m *MockservicePauser) PauseService(app, env, svc string, suspendAutoscaling bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseService", app, env, svc, suspendAutoscaling)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseService indicates an expected call of PauseService
+func (mr *MockservicePauserMockRecorder) PauseService(app, env, svc, suspendAutoscaling interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), app, env, svc, suspendAutoscaling)
+}
+
+// MockserviceResumer is a mock of serviceResumer interface
+type MockserviceResumer struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceResumerMockRecorder
+}
+
+// MockserviceResumerMockRecorder is the mock recorder for MockserviceResumer
+type MockserviceResumerMockRecorder struct {
+	mock *MockserviceResumer
+}
+
+// NewMockserviceResumer creates a new mock instance
+func NewMockserviceResumer(ctrl *gomock.Controller) *MockserviceResumer {
+	mock := &MockserviceResumer{ctrl: ctrl}
+	mock.recorder = &MockserviceResumerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceResumer) EXPECT() *MockserviceResumerMockRecorder {
+	return m.recorder
+}
+
+// ResumeService mocks base method
+func (m *MockserviceResumer) ResumeService(app, env, svc string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeService", app, env, svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeService indicates an expected call of ResumeService
+func (mr *MockserviceResumerMockRecorder) ResumeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeService", reflect.TypeOf((*MockserviceResumer)(nil).ResumeService), app, env, svc)
+}
+
+// MockruleToggler is a mock of ruleToggler interface
+type MockruleToggler struct {
+	ctrl     *gomock.Controller
+	recorder *MockruleTogglerMockRecorder
+}
+
+// MockruleTogglerMockRecorder is the mock recorder for MockruleToggler
+type MockruleTogglerMockRecorder struct {
+	mock *MockruleToggler
+}
+
+// NewMockruleToggler creates a new mock instance
+func NewMockruleToggler(ctrl *gomock.Controller) *MockruleToggler {
+	mock := &MockruleToggler{ctrl: ctrl}
+	mock.recorder = &MockruleTogglerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockruleToggler) EXPECT() *MockruleTogglerMockRecorder {
+	return m.recorder
+}
+
+// DisableRule mocks base method
+func (m *MockruleToggler) DisableRule(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableRule", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableRule indicates an expected call of DisableRule
+func (mr *MockruleTogglerMockRecorder) DisableRule(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableRule", reflect.TypeOf((*MockruleToggler)(nil).DisableRule), name)
+}
+
+// EnableRule mocks base method
+func (m *MockruleToggler) EnableRule(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableRule", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableRule indicates an expected call of EnableRule
+func (mr *MockruleTogglerMockRecorder) EnableRule(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableRule", reflect.TypeOf((*MockruleToggler)(nil).EnableRule), name)
+}
+
+// IsRuleEnabled mocks base method
+func (m *MockruleToggler) IsRuleEnabled(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRuleEnabled", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRuleEnabled indicates an expected call of IsRuleEnabled
+func (mr *MockruleTogglerMockRecorder) IsRuleEnabled(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRuleEnabled", reflect.TypeOf((*MockruleToggler)(nil).IsRuleEnabled), name)
+}
+
+// MocktaskDefinitionPruner is a mock of taskDefinitionPruner interface
+type MocktaskDefinitionPruner struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionPrunerMockRecorder
+}
+
+// MocktaskDefinitionPrunerMockRecorder is the mock recorder for MocktaskDefinitionPruner
+type MocktaskDefinitionPrunerMockRecorder struct {
+	mock *MocktaskDefinitionPruner
+}
+
+// NewMocktaskDefinitionPruner creates a new mock instance
+func NewMocktaskDefinitionPruner(ctrl *gomock.Controller) *MocktaskDefinitionPruner {
+	mock := &MocktaskDefinitionPruner{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionPrunerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskDefinitionPruner) EXPECT() *MocktaskDefinitionPrunerMockRecorder {
+	return m.recorder
+}
+
+// DeregisterTaskDefinition mocks base method
+func (m *MocktaskDefinitionPruner) DeregisterTaskDefinition(taskDefARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinition", taskDefARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTaskDefinition indicates an expected call of DeregisterTaskDefinition
+func (mr *MocktaskDefinitionPrunerMockRecorder) DeregisterTaskDefinition(taskDefARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinition", reflect.TypeOf((*MocktaskDefinitionPruner)(nil).DeregisterTaskDefinition), taskDefARN)
+}
+
+// StaleTaskDefinitions mocks base method
+func (m *MocktaskDefinitionPruner) StaleTaskDefinitions(app, env, svc string, keep int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaleTaskDefinitions", app, env, svc, keep)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaleTaskDefinitions indicates an expected call of StaleTaskDefinitions
+func (mr *MocktaskDefinitionPrunerMockRecorder) StaleTaskDefinitions(app, env, svc, keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaleTaskDefinitions", reflect.TypeOf((*MocktaskDefinitionPruner)(nil).StaleTaskDefinitions), app, env, svc, keep)
+}
+
 // MockdeploySelector is a mock of deploySelector interface
 type MockdeploySelector struct {
 	ctrl     *gomock.Controller
@@ -4737,6 +6521,69 @@ func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
 }
 
+// MockdeployedJobSelector is a mock of deployedJobSelector interface
+type MockdeployedJobSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedJobSelectorMockRecorder
+}
+
+// MockdeployedJobSelectorMockRecorder is the mock recorder for MockdeployedJobSelector
+type MockdeployedJobSelectorMockRecorder struct {
+	mock *MockdeployedJobSelector
+}
+
+// NewMockdeployedJobSelector creates a new mock instance
+func NewMockdeployedJobSelector(ctrl *gomock.Controller) *MockdeployedJobSelector {
+	mock := &MockdeployedJobSelector{ctrl: ctrl}
+	mock.recorder = &MockdeployedJobSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeployedJobSelector) EXPECT() *MockdeployedJobSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method
+func (m *MockdeployedJobSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application
+func (mr *MockdeployedJobSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeployedJobSelector)(nil).Application), varargs...)
+}
+
+// DeployedJob mocks base method
+func (m *MockdeployedJobSelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedJob indicates an expected call of DeployedJob
+func (mr *MockdeployedJobSelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeployedJobSelector)(nil).DeployedJob), varargs...)
+}
+
 // MockwsSelector is a mock of wsSelector interface
 type MockwsSelector struct {
 	ctrl     *gomock.Controller
@@ -4845,6 +6692,21 @@ func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
 }
 
+// Environments mocks base method
+func (m *MockwsSelector) Environments(prompt, help, app string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environments", prompt, help, app)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environments indicates an expected call of Environments
+func (mr *MockwsSelectorMockRecorder) Environments(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockwsSelector)(nil).Environments), prompt, help, app)
+}
+
 // MockinitJobSelector is a mock of initJobSelector interface
 type MockinitJobSelector struct {
 	ctrl     *gomock.Controller
@@ -4884,18 +6746,33 @@ func (mr *MockinitJobSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt,
 }
 
 // Schedule mocks base method
-func (m *MockinitJobSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+func (m *MockinitJobSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator, eventPatternValidator prompt.ValidatorFunc) (*selector.JobTrigger, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator, eventPatternValidator)
+	ret0, _ := ret[0].(*selector.JobTrigger)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Schedule indicates an expected call of Schedule
-func (mr *MockinitJobSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+func (mr *MockinitJobSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator, eventPatternValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinitJobSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator, eventPatternValidator)
+}
+
+// Image mocks base method
+func (m *MockinitJobSelector) Image(selPrompt, customPrompt, selHelp, customHelp string, pv prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Image", selPrompt, customPrompt, selHelp, customHelp, pv)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Image indicates an expected call of Image
+func (mr *MockinitJobSelectorMockRecorder) Image(selPrompt, customPrompt, selHelp, customHelp, pv interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinitJobSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Image", reflect.TypeOf((*MockinitJobSelector)(nil).Image), selPrompt, customPrompt, selHelp, customHelp, pv)
 }
 
 // MockdockerfileSelector is a mock of dockerfileSelector interface
@@ -4936,6 +6813,21 @@ func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundProm
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
 }
 
+// Image mocks base method
+func (m *MockdockerfileSelector) Image(selPrompt, customPrompt, selHelp, customHelp string, pv prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Image", selPrompt, customPrompt, selHelp, customHelp, pv)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Image indicates an expected call of Image
+func (mr *MockdockerfileSelectorMockRecorder) Image(selPrompt, customPrompt, selHelp, customHelp, pv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Image", reflect.TypeOf((*MockdockerfileSelector)(nil).Image), selPrompt, customPrompt, selHelp, customHelp, pv)
+}
+
 // Mockec2Selector is a mock of ec2Selector interface
 type Mockec2Selector struct {
 	ctrl     *gomock.Controller
@@ -5004,6 +6896,21 @@ func (mr *Mockec2SelectorMockRecorder) PrivateSubnets(prompt, help, vpcID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PrivateSubnets), prompt, help, vpcID)
 }
 
+// SecurityGroups mocks base method
+func (m *Mockec2Selector) SecurityGroups(prompt, help, vpcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroups", prompt, help, vpcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups
+func (mr *Mockec2SelectorMockRecorder) SecurityGroups(prompt, help, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*Mockec2Selector)(nil).SecurityGroups), prompt, help, vpcID)
+}
+
 // MockcredsSelector is a mock of credsSelector interface
 type MockcredsSelector struct {
 	ctrl     *gomock.Controller
@@ -5080,6 +6987,55 @@ func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
 }
 
+// HasIPv6CIDR mocks base method
+func (m *Mockec2Client) HasIPv6CIDR(vpcID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasIPv6CIDR", vpcID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasIPv6CIDR indicates an expected call of HasIPv6CIDR
+func (mr *Mockec2ClientMockRecorder) HasIPv6CIDR(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasIPv6CIDR", reflect.TypeOf((*Mockec2Client)(nil).HasIPv6CIDR), vpcID)
+}
+
+// ListAZs mocks base method
+func (m *Mockec2Client) ListAZs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAZs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAZs indicates an expected call of ListAZs
+func (mr *Mockec2ClientMockRecorder) ListAZs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAZs", reflect.TypeOf((*Mockec2Client)(nil).ListAZs))
+}
+
+// SecurityGroups mocks base method
+func (m *Mockec2Client) SecurityGroups(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroups", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups
+func (mr *Mockec2ClientMockRecorder) SecurityGroups(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*Mockec2Client)(nil).SecurityGroups), filters...)
+}
+
 // MockjobInitializer is a mock of jobInitializer interface
 type MockjobInitializer struct {
 	ctrl     *gomock.Controller
@@ -5118,6 +7074,21 @@ func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
 }
 
+// JobManifest mocks base method
+func (m *MockjobInitializer) JobManifest(props *initialize.JobProps) (encoding.BinaryMarshaler, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobManifest", props)
+	ret0, _ := ret[0].(encoding.BinaryMarshaler)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobManifest indicates an expected call of JobManifest
+func (mr *MockjobInitializerMockRecorder) JobManifest(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobManifest", reflect.TypeOf((*MockjobInitializer)(nil).JobManifest), props)
+}
+
 // MocksvcInitializer is a mock of svcInitializer interface
 type MocksvcInitializer struct {
 	ctrl     *gomock.Controller
@@ -5156,6 +7127,21 @@ func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
 }
 
+// ServiceManifest mocks base method
+func (m *MocksvcInitializer) ServiceManifest(props *initialize.ServiceProps) (encoding.BinaryMarshaler, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceManifest", props)
+	ret0, _ := ret[0].(encoding.BinaryMarshaler)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceManifest indicates an expected call of ServiceManifest
+func (mr *MocksvcInitializerMockRecorder) ServiceManifest(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceManifest", reflect.TypeOf((*MocksvcInitializer)(nil).ServiceManifest), props)
+}
+
 // MockroleDeleter is a mock of roleDeleter interface
 type MockroleDeleter struct {
 	ctrl     *gomock.Controller
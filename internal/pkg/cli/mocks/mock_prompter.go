@@ -132,3 +132,23 @@ func (mr *MockprompterMockRecorder) Confirm(message, help interface{}, promptOpt
 	varargs := append([]interface{}{message, help}, promptOpts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*Mockprompter)(nil).Confirm), varargs...)
 }
+
+// SelectOption mocks base method
+func (m *Mockprompter) SelectOption(message, help string, options []prompt.SelectOption, promptOpts ...prompt.Option) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{message, help, options}
+	for _, a := range promptOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectOption", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOption indicates an expected call of SelectOption
+func (mr *MockprompterMockRecorder) SelectOption(message, help, options interface{}, promptOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{message, help, options}, promptOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOption", reflect.TypeOf((*Mockprompter)(nil).SelectOption), varargs...)
+}
@@ -9,6 +9,7 @@ type prompter interface {
 	Get(message, help string, validator prompt.ValidatorFunc, promptOpts ...prompt.Option) (string, error)
 	GetSecret(message, help string, promptOpts ...prompt.Option) (string, error)
 	SelectOne(message, help string, options []string, promptOpts ...prompt.Option) (string, error)
+	SelectOption(message, help string, options []prompt.SelectOption, promptOpts ...prompt.Option) (string, error)
 	MultiSelect(message, help string, options []string, promptOpts ...prompt.Option) ([]string, error)
 	Confirm(message, help string, promptOpts ...prompt.Option) (bool, error)
 }
@@ -6,7 +6,10 @@ package cli
 import (
 	"encoding"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -68,10 +71,27 @@ You must specify a sort key if you wish to add alternate sort keys later.`
 	storageInitDDBLSIHelp   = `Alternate sort keys create Local Secondary Indexes, which allow you to sort the table using the same 
 partition key but a different sort key. You may specify up to 5 alternate sort keys.`
 
-	storageInitDDBMoreLSIPrompt = "Would you like to add more alternate sort keys to this table?"
+	storageInitDDBLSIKeysPrompt = "What are the " + color.Emphasize("alternate sort keys") + " of this table?"
+	storageInitDDBLSIKeysHelp   = `Enter one alternate sort key per line in the format <keyName>:<dataType>, for example "Points:Number".
+The datatype is one of String, Number or Binary. You can use the characters [a-zA-Z0-9.-_] in the name.`
 
-	storageInitDDBLSINamePrompt = "What would you like to name this " + color.Emphasize("alternate sort key") + "?"
-	storageInitDDBLSINameHelp   = "You can use the characters [a-zA-Z0-9.-_]"
+	storageInitDDBAdvancedConfirm = "Would you like to configure advanced settings for this table?"
+	storageInitDDBAdvancedHelp    = "Advanced settings include the expiry of items, the billing mode, and a stream of item changes."
+
+	storageInitDDBTTLPrompt = "Which attribute holds the " + color.Emphasize("expiry time") + " of the items?"
+	storageInitDDBTTLHelp   = `Items are deleted after the time, in seconds since the epoch, held in this attribute.
+Leave it empty if items don't expire.`
+
+	storageInitDDBBillingModePrompt = "Which " + color.Emphasize("billing mode") + " would you like to use?"
+	storageInitDDBBillingModeHelp   = `On-demand tables are billed per request and scale with your traffic.
+Provisioned tables are billed for the read and write capacity you reserve.`
+
+	fmtStorageInitDDBCapacityPrompt = "How many %s capacity units would you like to provision?"
+	storageInitDDBCapacityHelp      = "A capacity unit is one strongly consistent read or one write per second of an item up to 4 KB for reads and 1 KB for writes."
+
+	storageInitDDBStreamPrompt = "What would you like to write to the table's " + color.Emphasize("stream") + "?"
+	storageInitDDBStreamHelp   = `A stream records the changes to the items of the table. Its ARN is injected into your workload.
+KEYS_ONLY writes the key attributes, NEW_IMAGE and OLD_IMAGE the item after or before the change, and NEW_AND_OLD_IMAGES both.`
 )
 
 const (
@@ -86,6 +106,25 @@ var attributeTypes = []string{
 	ddbBinaryType,
 }
 
+const (
+	ddbOnDemandBillingMode    = "on-demand"
+	ddbProvisionedBillingMode = "provisioned"
+
+	ddbNoStream = "None"
+)
+
+var ddbBillingModes = []string{
+	ddbOnDemandBillingMode,
+	ddbProvisionedBillingMode,
+}
+
+var ddbStreamViewTypes = []string{
+	"KEYS_ONLY",
+	"NEW_IMAGE",
+	"OLD_IMAGE",
+	"NEW_AND_OLD_IMAGES",
+}
+
 type initStorageVars struct {
 	storageType  string
 	storageName  string
@@ -97,6 +136,12 @@ type initStorageVars struct {
 	lsiSorts     []string // lsi sort keys collected as "name:T" where T is one of [SNB]
 	noLSI        bool
 	noSort       bool
+
+	ttlAttribute   string
+	billingMode    string
+	readCapacity   int
+	writeCapacity  int
+	streamViewType string
 }
 
 type initStorageOpts struct {
@@ -195,6 +240,33 @@ func (o *initStorageOpts) validateDDB() error {
 			return err
 		}
 	}
+	if o.ttlAttribute != "" {
+		if err := validateDDBAttributeName(o.ttlAttribute); err != nil {
+			return fmt.Errorf("validate TTL attribute: %w", err)
+		}
+	}
+	if o.billingMode != "" {
+		if err := validateDDBBillingMode(o.billingMode); err != nil {
+			return err
+		}
+	}
+	// --read-capacity and --write-capacity are only valid for provisioned tables.
+	hasCapacity := o.readCapacity != 0 || o.writeCapacity != 0
+	if hasCapacity && o.billingMode != ddbProvisionedBillingMode {
+		return fmt.Errorf("--%s and --%s can only be specified with --%s %s",
+			storageReadCapacityFlag, storageWriteCapacityFlag, storageBillingModeFlag, ddbProvisionedBillingMode)
+	}
+	if o.billingMode == ddbProvisionedBillingMode {
+		if o.readCapacity < 1 || o.writeCapacity < 1 {
+			return fmt.Errorf("--%s and --%s must be at least 1 with --%s %s",
+				storageReadCapacityFlag, storageWriteCapacityFlag, storageBillingModeFlag, ddbProvisionedBillingMode)
+		}
+	}
+	if o.streamViewType != "" {
+		if err := validateDDBStreamViewType(o.streamViewType); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -210,6 +282,8 @@ func (o *initStorageOpts) Ask() error {
 	}
 	switch o.storageType {
 	case dynamoDBStorageType:
+		// Advanced settings are only offered when the table isn't configured with flags.
+		askAdvanced := !o.hasDDBFlags()
 		if err := o.askDynamoPartitionKey(); err != nil {
 			return err
 		}
@@ -219,10 +293,21 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.askDynamoLSIConfig(); err != nil {
 			return err
 		}
+		if askAdvanced {
+			if err := o.askDynamoAdvancedSettings(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// hasDDBFlags returns true if any of the DynamoDB table settings was passed as a flag.
+func (o *initStorageOpts) hasDDBFlags() bool {
+	return o.partitionKey != "" || o.sortKey != "" || len(o.lsiSorts) != 0 || o.noLSI || o.noSort ||
+		o.ttlAttribute != "" || o.billingMode != "" || o.streamViewType != ""
+}
+
 func (o *initStorageOpts) askStorageType() error {
 	if o.storageType != "" {
 		return nil
@@ -372,52 +457,115 @@ func (o *initStorageOpts) askDynamoLSIConfig() error {
 		o.noLSI = true
 		return nil
 	}
-	lsiTypePrompt := fmt.Sprintf(fmtStorageInitDDBKeyTypePrompt, color.Emphasize("alternate sort key"))
-	lsiTypeHelp := fmt.Sprintf(fmtStorageInitDDBKeyTypeHelp, "alternate sort key")
-
 	moreLSI, err := o.prompt.Confirm(storageInitDDBLSIPrompt, storageInitDDBLSIHelp, prompt.WithFinalMessage("Additional sort keys?"))
 	if err != nil {
 		return fmt.Errorf("confirm add alternate sort key: %w", err)
 	}
-	for {
-		if len(o.lsiSorts) > 5 {
-			log.Infoln("You may not specify more than 5 alternate sort keys. Continuing...")
-			moreLSI = false
-		}
-		// This will execute last in the loop if moreLSI is set to false by any confirm prompts.
-		if !moreLSI {
-			o.noLSI = len(o.lsiSorts) == 0
-			return nil
-		}
+	if !moreLSI {
+		o.noLSI = true
+		return nil
+	}
+	keys, err := o.prompt.Get(storageInitDDBLSIKeysPrompt,
+		storageInitDDBLSIKeysHelp,
+		func(v interface{}) error {
+			s, ok := v.(string)
+			if !ok {
+				return errValueNotAString
+			}
+			return validateLSIs(lsiKeysFromLines(s))
+		},
+		prompt.WithEditor(),
+		prompt.WithFinalMessage("Alternate sort keys:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get DDB alternate sort keys: %w", err)
+	}
+	o.lsiSorts = lsiKeysFromLines(keys)
+	o.noLSI = len(o.lsiSorts) == 0
+	return nil
+}
 
-		lsiName, err := o.prompt.Get(storageInitDDBLSINamePrompt,
-			storageInitDDBLSINameHelp,
-			dynamoTableNameValidation,
-			prompt.WithFinalMessage("Alternate Sort Key:"),
-		)
-		if err != nil {
-			return fmt.Errorf("get DDB alternate sort key name: %w", err)
+func (o *initStorageOpts) askDynamoAdvancedSettings() error {
+	advanced, err := o.prompt.Confirm(storageInitDDBAdvancedConfirm, storageInitDDBAdvancedHelp, prompt.WithFinalMessage("Advanced settings?"))
+	if err != nil {
+		return fmt.Errorf("confirm DDB advanced settings: %w", err)
+	}
+	if !advanced {
+		return nil
+	}
+	ttlAttribute, err := o.prompt.Get(storageInitDDBTTLPrompt,
+		storageInitDDBTTLHelp,
+		func(v interface{}) error {
+			if v == "" {
+				return nil
+			}
+			return validateDDBAttributeName(v)
+		},
+		prompt.WithFinalMessage("TTL attribute:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get DDB TTL attribute: %w", err)
+	}
+	o.ttlAttribute = ttlAttribute
+
+	billingMode, err := o.prompt.SelectOne(storageInitDDBBillingModePrompt,
+		storageInitDDBBillingModeHelp,
+		ddbBillingModes,
+		prompt.WithFinalMessage("Billing mode:"),
+	)
+	if err != nil {
+		return fmt.Errorf("select DDB billing mode: %w", err)
+	}
+	o.billingMode = billingMode
+	if billingMode == ddbProvisionedBillingMode {
+		if o.readCapacity, err = o.askDynamoCapacity("read", "Read capacity units:"); err != nil {
+			return err
 		}
-		lsiType, err := o.prompt.SelectOne(lsiTypePrompt,
-			lsiTypeHelp,
-			attributeTypes,
-			prompt.WithFinalMessage("Attribute type:"),
-		)
-		if err != nil {
-			return fmt.Errorf("get DDB alternate sort key type: %w", err)
+		if o.writeCapacity, err = o.askDynamoCapacity("write", "Write capacity units:"); err != nil {
+			return err
 		}
+	}
 
-		o.lsiSorts = append(o.lsiSorts, lsiName+":"+lsiType)
+	streamViewType, err := o.prompt.SelectOne(storageInitDDBStreamPrompt,
+		storageInitDDBStreamHelp,
+		append([]string{ddbNoStream}, ddbStreamViewTypes...),
+		prompt.WithFinalMessage("Stream:"),
+	)
+	if err != nil {
+		return fmt.Errorf("select DDB stream view type: %w", err)
+	}
+	if streamViewType != ddbNoStream {
+		o.streamViewType = streamViewType
+	}
+	return nil
+}
 
-		moreLSI, err = o.prompt.Confirm(
-			storageInitDDBMoreLSIPrompt,
-			storageInitDDBLSIHelp,
-			prompt.WithFinalMessage("Additional sort keys?"),
-		)
-		if err != nil {
-			return fmt.Errorf("confirm add alternate sort key: %w", err)
+func (o *initStorageOpts) askDynamoCapacity(mode, finalMsg string) (int, error) {
+	units, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitDDBCapacityPrompt, color.Emphasize(mode)),
+		storageInitDDBCapacityHelp,
+		validateDDBCapacity,
+		prompt.WithDefaultInput("5"),
+		prompt.WithFinalMessage(finalMsg),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get DDB %s capacity: %w", mode, err)
+	}
+	capacity, err := strconv.Atoi(units)
+	if err != nil {
+		return 0, fmt.Errorf("convert DDB %s capacity %s to an integer: %w", mode, units, err)
+	}
+	return capacity, nil
+}
+
+// lsiKeysFromLines returns the alternate sort keys entered one per line, ignoring blank lines.
+func lsiKeysFromLines(s string) []string {
+	var keys []string
+	for _, line := range strings.Split(s, "\n") {
+		if key := strings.TrimSpace(line); key != "" {
+			keys = append(keys, key)
 		}
 	}
+	return keys
 }
 
 func (o *initStorageOpts) validateWorkloadName() error {
@@ -510,6 +658,19 @@ func (o *initStorageOpts) newDynamoDBAddon() (*addon.DynamoDB, error) {
 		}
 	}
 
+	if o.ttlAttribute != "" {
+		props.TTLAttribute = aws.String(o.ttlAttribute)
+	}
+	if o.billingMode == ddbProvisionedBillingMode {
+		props.Capacity = &addon.DDBProvisionedCapacity{
+			ReadCapacityUnits:  o.readCapacity,
+			WriteCapacityUnits: o.writeCapacity,
+		}
+	}
+	if o.streamViewType != "" {
+		props.StreamViewType = aws.String(o.streamViewType)
+	}
+
 	return addon.NewDynamoDB(&props), nil
 }
 
@@ -528,8 +689,14 @@ func (o *initStorageOpts) RecommendedActions() []string {
 
 	deployCmd := fmt.Sprintf("copilot deploy --name %s", o.workloadName)
 
+	leverageVars := fmt.Sprintf("Update %s's code to leverage the injected environment variable %s", color.HighlightUserInput(o.workloadName), color.HighlightCode(newVar))
+	if o.streamViewType != "" {
+		streamVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "StreamArn")
+		leverageVars = fmt.Sprintf("Update %s's code to leverage the injected environment variables %s and %s", color.HighlightUserInput(o.workloadName),
+			color.HighlightCode(newVar), color.HighlightCode(streamVar))
+	}
 	return []string{
-		fmt.Sprintf("Update %s's code to leverage the injected environment variable %s", color.HighlightUserInput(o.workloadName), color.HighlightCode(newVar)),
+		leverageVars,
 		fmt.Sprintf("Run %s to deploy your storage resources.", color.HighlightCode(deployCmd)),
 	}
 }
@@ -550,7 +717,9 @@ are injected into your containers as environment variables for easy access.`,
   Create a basic DynamoDB table named "my-table" attached to the "frontend" service with a sort key specified.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create a DynamoDB table with multiple alternate sort keys.
-  /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N`,
+  /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
+  Create a provisioned DynamoDB table whose items expire, with a stream of the item changes.
+  /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --no-sort --ttl-attribute ExpiresAt --billing-mode provisioned --read-capacity 5 --write-capacity 5 --stream-view-type NEW_AND_OLD_IMAGES`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -581,6 +750,11 @@ are injected into your containers as environment variables for easy access.`,
 	cmd.Flags().StringArrayVar(&vars.lsiSorts, storageLSIConfigFlag, []string{}, storageLSIConfigFlagDescription)
 	cmd.Flags().BoolVar(&vars.noLSI, storageNoLSIFlag, false, storageNoLSIFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSort, storageNoSortFlag, false, storageNoSortFlagDescription)
+	cmd.Flags().StringVar(&vars.ttlAttribute, storageTTLAttributeFlag, "", storageTTLAttributeFlagDescription)
+	cmd.Flags().StringVar(&vars.billingMode, storageBillingModeFlag, "", storageBillingModeFlagDescription)
+	cmd.Flags().IntVar(&vars.readCapacity, storageReadCapacityFlag, 0, storageReadCapacityFlagDescription)
+	cmd.Flags().IntVar(&vars.writeCapacity, storageWriteCapacityFlag, 0, storageWriteCapacityFlagDescription)
+	cmd.Flags().StringVar(&vars.streamViewType, storageStreamViewTypeFlag, "", storageStreamViewTypeFlagDescription)
 
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
@@ -593,6 +767,11 @@ are injected into your containers as environment variables for easy access.`,
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoSortFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageLSIConfigFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoLSIFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageTTLAttributeFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageBillingModeFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageReadCapacityFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageWriteCapacityFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageStreamViewTypeFlag))
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
 		"sections": `Required,DynamoDB`,
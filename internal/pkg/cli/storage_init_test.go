@@ -28,6 +28,12 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoSort      bool
 		inNoLSI       bool
 
+		inTTLAttribute   string
+		inBillingMode    string
+		inReadCapacity   int
+		inWriteCapacity  int
+		inStreamViewType string
+
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
 
@@ -152,6 +158,51 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			inNoLSI:       true,
 			wantedErr:     fmt.Errorf("validate LSI configuration: cannot specify --no-lsi and --lsi options at once"),
 		},
+		"fails when capacity is specified for an on-demand table": {
+			mockWs:         func(m *mocks.MockwsAddonManager) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+			inAppName:      "bowie",
+			inStorageType:  dynamoDBStorageType,
+			inBillingMode:  ddbOnDemandBillingMode,
+			inReadCapacity: 5,
+			wantedErr:      fmt.Errorf("--read-capacity and --write-capacity can only be specified with --billing-mode provisioned"),
+		},
+		"fails when a provisioned table has no write capacity": {
+			mockWs:         func(m *mocks.MockwsAddonManager) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+			inAppName:      "bowie",
+			inStorageType:  dynamoDBStorageType,
+			inBillingMode:  ddbProvisionedBillingMode,
+			inReadCapacity: 5,
+			wantedErr:      fmt.Errorf("--read-capacity and --write-capacity must be at least 1 with --billing-mode provisioned"),
+		},
+		"fails with an invalid billing mode": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inBillingMode: "PAY_PER_REQUEST",
+			wantedErr:     fmt.Errorf(`invalid billing mode PAY_PER_REQUEST: must be one of "on-demand", "provisioned"`),
+		},
+		"fails with an invalid stream view type": {
+			mockWs:           func(m *mocks.MockwsAddonManager) {},
+			mockStore:        func(m *mocks.Mockstore) {},
+			inAppName:        "bowie",
+			inStorageType:    dynamoDBStorageType,
+			inStreamViewType: "ALL",
+			wantedErr:        fmt.Errorf(`invalid stream view type ALL: must be one of "KEYS_ONLY", "NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES"`),
+		},
+		"succeeds with a provisioned table with a TTL and a stream": {
+			mockWs:           func(m *mocks.MockwsAddonManager) {},
+			mockStore:        func(m *mocks.Mockstore) {},
+			inAppName:        "bowie",
+			inStorageType:    dynamoDBStorageType,
+			inTTLAttribute:   "ExpiresAt",
+			inBillingMode:    ddbProvisionedBillingMode,
+			inReadCapacity:   5,
+			inWriteCapacity:  5,
+			inStreamViewType: "KEYS_ONLY",
+		},
 		"fails when --no-sort and --lsi are both provided": {
 			mockWs:        func(m *mocks.MockwsAddonManager) {},
 			mockStore:     func(m *mocks.Mockstore) {},
@@ -181,6 +232,12 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					lsiSorts:     tc.inLSISorts,
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
+
+					ttlAttribute:   tc.inTTLAttribute,
+					billingMode:    tc.inBillingMode,
+					readCapacity:   tc.inReadCapacity,
+					writeCapacity:  tc.inWriteCapacity,
+					streamViewType: tc.inStreamViewType,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -529,30 +586,18 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inSort:        wantedSortKey,
 
 			mockPrompt: func(m *mocks.Mockprompter) {
-				lsiTypePrompt := fmt.Sprintf(fmtStorageInitDDBKeyTypePrompt, color.Emphasize("alternate sort key"))
-				lsiTypeHelp := fmt.Sprintf(fmtStorageInitDDBKeyTypeHelp, "alternate sort key")
 				m.EXPECT().Confirm(
 					gomock.Eq(storageInitDDBLSIPrompt),
 					gomock.Eq(storageInitDDBLSIHelp),
 					gomock.Any(),
 				).Return(true, nil)
 				m.EXPECT().Get(
-					gomock.Eq(storageInitDDBLSINamePrompt),
-					gomock.Eq(storageInitDDBLSINameHelp),
+					gomock.Eq(storageInitDDBLSIKeysPrompt),
+					gomock.Eq(storageInitDDBLSIKeysHelp),
 					gomock.Any(),
 					gomock.Any(),
-				).Return("Email", nil)
-				m.EXPECT().SelectOne(
-					gomock.Eq(lsiTypePrompt),
-					gomock.Eq(lsiTypeHelp),
-					gomock.Eq(attributeTypes),
 					gomock.Any(),
-				).Return(ddbStringType, nil)
-				m.EXPECT().Confirm(
-					gomock.Eq(storageInitDDBMoreLSIPrompt),
-					gomock.Eq(storageInitDDBLSIHelp),
-					gomock.Any(),
-				).Return(false, nil)
+				).Return("Email:String\n\n  Points:Number  ", nil)
 			},
 			mockCfg: func(m *mocks.MockwsSelector) {},
 
@@ -564,7 +609,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				partitionKey: wantedPartitionKey,
 				sortKey:      wantedSortKey,
 				noLSI:        false,
-				lsiSorts:     []string{"Email:String"},
+				lsiSorts:     []string{"Email:String", "Points:Number"},
 			},
 		},
 		"noLSI is set correctly if no lsis specified": {
@@ -620,7 +665,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				noSort:       true,
 			},
 		},
-		"error if lsi name misspecified": {
+		"error if fail to get lsi": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
@@ -638,11 +683,12 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 					gomock.Any(),
+					gomock.Any(),
 				).Return("", errors.New("some error"))
 			},
 			mockCfg: func(m *mocks.MockwsSelector) {},
 
-			wantedErr: fmt.Errorf("get DDB alternate sort key name: some error"),
+			wantedErr: fmt.Errorf("get DDB alternate sort keys: some error"),
 		},
 		"errors if fail to confirm lsi": {
 			inAppName:     wantedAppName,
@@ -663,35 +709,79 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: fmt.Errorf("confirm add alternate sort key: some error"),
 		},
-		"error if lsi type misspecified": {
+		"asks for advanced settings if the table isn't configured with flags": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
 			inStorageName: wantedTableName,
-			inPartition:   wantedPartitionKey,
-			inSort:        wantedSortKey,
 
 			mockPrompt: func(m *mocks.Mockprompter) {
-				m.EXPECT().Confirm(
-					gomock.Any(),
-					gomock.Any(),
-					gomock.Any(),
-				).Return(true, nil)
-				m.EXPECT().Get(gomock.Any(),
-					gomock.Any(),
-					gomock.Any(),
-					gomock.Any(),
-				).Return("cool", nil)
-				m.EXPECT().SelectOne(gomock.Any(),
-					gomock.Any(),
-					gomock.Any(),
-					gomock.Any(),
-				).Return("", errors.New("some error"))
+				m.EXPECT().Get(gomock.Any(), gomock.Eq(storageInitDDBPartitionKeyHelp), gomock.Any(), gomock.Any()).Return("DogName", nil)
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Eq(attributeTypes), gomock.Any()).Return(ddbStringType, nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitDDBSortKeyConfirm), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitDDBAdvancedConfirm), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(gomock.Eq(storageInitDDBTTLPrompt), gomock.Any(), gomock.Any(), gomock.Any()).Return("ExpiresAt", nil)
+				m.EXPECT().SelectOne(gomock.Eq(storageInitDDBBillingModePrompt), gomock.Any(), gomock.Eq(ddbBillingModes), gomock.Any()).Return(ddbProvisionedBillingMode, nil)
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(fmtStorageInitDDBCapacityPrompt, color.Emphasize("read"))), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("5", nil)
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(fmtStorageInitDDBCapacityPrompt, color.Emphasize("write"))), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("10", nil)
+				m.EXPECT().SelectOne(gomock.Eq(storageInitDDBStreamPrompt), gomock.Any(), gomock.Any(), gomock.Any()).Return("NEW_IMAGE", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:    dynamoDBStorageType,
+				storageName:    wantedTableName,
+				workloadName:   wantedSvcName,
+				partitionKey:   "DogName:String",
+				noSort:         true,
+				noLSI:          true,
+				ttlAttribute:   "ExpiresAt",
+				billingMode:    ddbProvisionedBillingMode,
+				readCapacity:   5,
+				writeCapacity:  10,
+				streamViewType: "NEW_IMAGE",
+			},
+		},
+		"skips the advanced settings if declined": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: wantedTableName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Eq(storageInitDDBPartitionKeyHelp), gomock.Any(), gomock.Any()).Return("DogName", nil)
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Eq(attributeTypes), gomock.Any()).Return(ddbStringType, nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitDDBSortKeyConfirm), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitDDBAdvancedConfirm), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
 
+			wantedVars: &initStorageVars{
+				storageType:  dynamoDBStorageType,
+				storageName:  wantedTableName,
+				workloadName: wantedSvcName,
+				partitionKey: "DogName:String",
+				noSort:       true,
+				noLSI:        true,
+			},
+		},
+		"error if fail to select the billing mode": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: wantedTableName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Eq(storageInitDDBPartitionKeyHelp), gomock.Any(), gomock.Any()).Return("DogName", nil)
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Eq(attributeTypes), gomock.Any()).Return(ddbStringType, nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitDDBSortKeyConfirm), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitDDBAdvancedConfirm), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(gomock.Eq(storageInitDDBTTLPrompt), gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
+				m.EXPECT().SelectOne(gomock.Eq(storageInitDDBBillingModePrompt), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
 			mockCfg: func(m *mocks.MockwsSelector) {},
 
-			wantedErr: fmt.Errorf("get DDB alternate sort key type: some error"),
+			wantedErr: fmt.Errorf("select DDB billing mode: some error"),
 		},
 		"no error or asks when fully specified": {
 			inAppName:     wantedAppName,
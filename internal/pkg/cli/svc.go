@@ -31,6 +31,10 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
+	cmd.AddCommand(buildSvcPauseCmd())
+	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcPruneCmd())
+	cmd.AddCommand(buildSvcDeploymentsCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -11,10 +11,12 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 
 	awssession "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -30,7 +32,8 @@ const (
 	svcDeleteAppNamePrompt           = "Which application's service would you like to delete?"
 	fmtSvcDeleteConfirmPrompt        = "Are you sure you want to delete %s from application %s?"
 	fmtSvcDeleteFromEnvConfirmPrompt = "Are you sure you want to delete %s from environment %s?"
-	svcDeleteConfirmHelp             = "This will remove the service from all environments and delete it from your app."
+	fmtSvcDeleteConfirmHelp          = "This will remove the service from %s %s and delete it from your app."
+	svcDeleteNotDeployedConfirmHelp  = "The service is not deployed to any environment. This will delete it from your app."
 	svcDeleteFromEnvConfirmHelp      = "This will remove the service from just the %s environment."
 )
 
@@ -38,6 +41,8 @@ const (
 	fmtSvcDeleteStart             = "Deleting service %s from environment %s."
 	fmtSvcDeleteFailed            = "Failed to delete service %s from environment %s: %v.\n"
 	fmtSvcDeleteComplete          = "Deleted service %s from environment %s.\n"
+	fmtSvcNotDeployedToEnv        = "Service %s is not deployed to environment %s, nothing to delete.\n"
+	fmtSvcDeleteParamsComplete    = "Deleted %s %s of service %s from environment %s.\n"
 	fmtSvcDeleteResourcesStart    = "Deleting resources of service %s from application %s."
 	fmtSvcDeleteResourcesFailed   = "Failed to delete resources of service %s from application %s.\n"
 	fmtSvcDeleteResourcesComplete = "Deleted resources of service %s from application %s.\n"
@@ -52,20 +57,23 @@ type deleteSvcVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	all              bool
 }
 
 type deleteSvcOpts struct {
 	deleteSvcVars
 
 	// Interfaces to dependencies.
-	store     store
-	sess      sessionProvider
-	spinner   progress
-	prompt    prompter
-	sel       wsSelector
-	appCFN    svcRemoverFromApp
-	getSvcCFN func(session *awssession.Session) wlDeleter
-	getECR    func(session *awssession.Session) imageRemover
+	store       store
+	deployStore deployedEnvironmentLister
+	sess        sessionProvider
+	spinner     progress
+	prompt      prompter
+	sel         wsSelector
+	appCFN      svcRemoverFromApp
+	getSvcCFN   func(session *awssession.Session) wlDeleter
+	getECR      func(session *awssession.Session) imageRemover
+	getSSM      func(session *awssession.Session) paramsDeleter
 }
 
 func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
@@ -73,6 +81,10 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
 
 	provider := sessions.NewProvider()
 	defaultSession, err := provider.Default()
@@ -88,23 +100,30 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 	return &deleteSvcOpts{
 		deleteSvcVars: vars,
 
-		store:   store,
-		spinner: termprogress.NewSpinner(),
-		prompt:  prompter,
-		sess:    provider,
-		sel:     selector.NewWorkspaceSelect(prompter, store, ws),
-		appCFN:  cloudformation.New(defaultSession),
+		store:       store,
+		deployStore: deployStore,
+		spinner:     termprogress.NewSpinner(),
+		prompt:      prompter,
+		sess:        provider,
+		sel:         selector.NewWorkspaceSelect(prompter, store, ws),
+		appCFN:      cloudformation.New(defaultSession),
 		getSvcCFN: func(session *awssession.Session) wlDeleter {
 			return cloudformation.New(session)
 		},
 		getECR: func(session *awssession.Session) imageRemover {
 			return ecr.New(session)
 		},
+		getSSM: func(session *awssession.Session) paramsDeleter {
+			return ssm.New(session)
+		},
 	}, nil
 }
 
 // Validate returns an error if the user inputs are invalid.
 func (o *deleteSvcOpts) Validate() error {
+	if o.all && o.envName != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", allFlag, envFlag)
+	}
 	if o.name != "" {
 		if _, err := o.store.GetService(o.appName, o.name); err != nil {
 			return err
@@ -132,7 +151,10 @@ func (o *deleteSvcOpts) Ask() error {
 	// When there's no env name passed in, we'll completely
 	// remove the service from the application.
 	deletePrompt := fmt.Sprintf(fmtSvcDeleteConfirmPrompt, o.name, o.appName)
-	deleteConfirmHelp := svcDeleteConfirmHelp
+	deleteConfirmHelp, err := o.deleteFromAppConfirmHelp()
+	if err != nil {
+		return err
+	}
 	if o.envName != "" {
 		// When a customer provides a particular environment,
 		// we'll just delete the service from that environment -
@@ -154,9 +176,9 @@ func (o *deleteSvcOpts) Ask() error {
 	return nil
 }
 
-// Execute deletes the service's CloudFormation stack.
-// If the service is being removed from the application, Execute will
-// also delete the ECR repository and the SSM parameter.
+// Execute deletes the service's CloudFormation stack from the environments that it's deployed to.
+// If the service is being removed from the application, Execute will also delete the parameters
+// created for the service by its addons, the ECR repository, and the SSM parameter.
 func (o *deleteSvcOpts) Execute() error {
 	envs, err := o.appEnvironments()
 	if err != nil {
@@ -173,6 +195,9 @@ func (o *deleteSvcOpts) Execute() error {
 		return nil
 	}
 
+	if err := o.deleteAddonsParams(envs); err != nil {
+		return err
+	}
 	if err := o.emptyECRRepos(envs); err != nil {
 		return err
 	}
@@ -195,6 +220,20 @@ func (o *deleteSvcOpts) validateEnvName() error {
 	return nil
 }
 
+func (o *deleteSvcOpts) deleteFromAppConfirmHelp() (string, error) {
+	if o.envName != "" {
+		return "", nil
+	}
+	envs, err := o.deployStore.ListEnvironmentsDeployedTo(o.appName, o.name)
+	if err != nil {
+		return "", fmt.Errorf("list environments that service %s is deployed to: %w", o.name, err)
+	}
+	if len(envs) == 0 {
+		return svcDeleteNotDeployedConfirmHelp, nil
+	}
+	return fmt.Sprintf(fmtSvcDeleteConfirmHelp, english.PluralWord(len(envs), "environment", ""), english.WordSeries(envs, "and")), nil
+}
+
 func (o *deleteSvcOpts) needsAppCleanup() bool {
 	// Only remove from a service from the app if
 	// we're removing it from every environment.
@@ -257,6 +296,14 @@ func (o *deleteSvcOpts) appEnvironments() ([]*config.Environment, error) {
 
 func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 	for _, env := range envs {
+		deployed, err := o.deployStore.IsServiceDeployed(o.appName, env.Name, o.name)
+		if err != nil {
+			return fmt.Errorf("check if service %s is deployed to environment %s: %w", o.name, env.Name, err)
+		}
+		if !deployed {
+			log.Infof(fmtSvcNotDeployedToEnv, o.name, env.Name)
+			continue
+		}
 		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
 		if err != nil {
 			return err
@@ -277,6 +324,30 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 	return nil
 }
 
+// deleteAddonsParams deletes the SSM parameters in each environment that are tagged with the service,
+// such as parameters that the service's addons retain after their stack is deleted.
+func (o *deleteSvcOpts) deleteAddonsParams(envs []*config.Environment) error {
+	for _, env := range envs {
+		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		names, err := o.getSSM(sess).DeleteParametersWithTags(map[string]string{
+			deploy.AppTagKey:     o.appName,
+			deploy.EnvTagKey:     env.Name,
+			deploy.ServiceTagKey: o.name,
+		})
+		if err != nil {
+			return fmt.Errorf("delete parameters of service %s from environment %s: %w", o.name, env.Name, err)
+		}
+		if len(names) == 0 {
+			continue
+		}
+		log.Successf(fmtSvcDeleteParamsComplete, english.PluralWord(len(names), "parameter", ""), english.WordSeries(names, "and"), o.name, env.Name)
+	}
+	return nil
+}
+
 // This is to make mocking easier in unit tests
 func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
 	var uniqueRegions []string
@@ -347,6 +418,9 @@ func buildSvcDeleteCmd() *cobra.Command {
   Delete the "test" service from just the prod environment.
   /code $ copilot svc delete --name test --env prod
 
+  Delete the "test" service from all of its environments and from the application.
+  /code $ copilot svc delete --name test --all
+
   Delete the "test" service from the "my-app" application from outside of the workspace.
   /code $ copilot svc delete --name test --app my-app
 
@@ -378,6 +452,7 @@ func buildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, svcDeleteAllFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	return cmd
 }
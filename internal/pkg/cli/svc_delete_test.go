@@ -24,6 +24,7 @@ func TestDeleteSvcOpts_Validate(t *testing.T) {
 		inAppName  string
 		inEnvName  string
 		inName     string
+		inAll      bool
 		setupMocks func(m *mocks.Mockstore)
 
 		want error
@@ -73,6 +74,13 @@ func TestDeleteSvcOpts_Validate(t *testing.T) {
 			},
 			want: errors.New("get environment test from config store: unknown env"),
 		},
+		"with both all and env flags set": {
+			inAppName:  "phonetool",
+			inEnvName:  "test",
+			inAll:      true,
+			setupMocks: func(m *mocks.Mockstore) {},
+			want:       errors.New("cannot specify both --all and --env"),
+		},
 		"should return error if fail to get service name": {
 			inAppName: "phonetool",
 			inName:    "api",
@@ -96,6 +104,7 @@ func TestDeleteSvcOpts_Validate(t *testing.T) {
 					appName: test.inAppName,
 					name:    test.inName,
 					envName: test.inEnvName,
+					all:     test.inAll,
 				},
 				store: mockstore,
 			}
@@ -124,8 +133,9 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 		envName          string
 		appName          string
 
-		mockSel    func(m *mocks.MockwsSelector)
-		mockPrompt func(m *mocks.Mockprompter)
+		mockSel         func(m *mocks.MockwsSelector)
+		mockPrompt      func(m *mocks.Mockprompter)
+		mockDeployStore func(m *mocks.MockdeployedEnvironmentLister)
 
 		wantedName  string
 		wantedError error
@@ -196,9 +206,12 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 			mockPrompt: func(m *mocks.Mockprompter) {
 				m.EXPECT().Confirm(
 					fmt.Sprintf(fmtSvcDeleteConfirmPrompt, testSvcName, testAppName),
-					svcDeleteConfirmHelp,
+					svcDeleteNotDeployedConfirmHelp,
 				).Times(1).Return(true, mockError)
 			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListEnvironmentsDeployedTo(testAppName, testSvcName).Return(nil, nil)
+			},
 
 			wantedError: fmt.Errorf("svc delete confirmation prompt: %w", mockError),
 		},
@@ -212,9 +225,12 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 			mockPrompt: func(m *mocks.Mockprompter) {
 				m.EXPECT().Confirm(
 					fmt.Sprintf(fmtSvcDeleteConfirmPrompt, testSvcName, testAppName),
-					svcDeleteConfirmHelp,
+					"This will remove the service from environment test and delete it from your app.",
 				).Times(1).Return(false, nil)
 			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListEnvironmentsDeployedTo(testAppName, testSvcName).Return([]string{"test"}, nil)
+			},
 
 			wantedError: errSvcDeleteCancelled,
 		},
@@ -228,12 +244,31 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 			mockPrompt: func(m *mocks.Mockprompter) {
 				m.EXPECT().Confirm(
 					fmt.Sprintf(fmtSvcDeleteConfirmPrompt, testSvcName, testAppName),
-					svcDeleteConfirmHelp,
+					"This will remove the service from environments test, staging and prod and delete it from your app.",
 				).Times(1).Return(true, nil)
 			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListEnvironmentsDeployedTo(testAppName, testSvcName).Return([]string{"test", "staging", "prod"}, nil)
+			},
 
 			wantedName: testSvcName,
 		},
+		"should wrap error if fail to list the environments that the service is deployed to": {
+			appName:          testAppName,
+			inName:           testSvcName,
+			skipConfirmation: false,
+			mockSel: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListEnvironmentsDeployedTo(testAppName, testSvcName).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("list environments that service api is deployed to: %w", mockError),
+		},
 		"should return error nil if user confirms svc delete --env": {
 			appName:          testAppName,
 			inName:           testSvcName,
@@ -260,8 +295,12 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 
 			mockPrompter := mocks.NewMockprompter(ctrl)
 			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
 			test.mockPrompt(mockPrompter)
 			test.mockSel(mockSel)
+			if test.mockDeployStore != nil {
+				test.mockDeployStore(mockDeployStore)
+			}
 
 			opts := deleteSvcOpts{
 				deleteSvcVars: deleteSvcVars{
@@ -270,8 +309,9 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 					name:             test.inName,
 					envName:          test.envName,
 				},
-				prompt: mockPrompter,
-				sel:    mockSel,
+				prompt:      mockPrompter,
+				sel:         mockSel,
+				deployStore: mockDeployStore,
 			}
 
 			got := opts.Ask()
@@ -293,6 +333,8 @@ type deleteSvcMocks struct {
 	spinner        *mocks.Mockprogress
 	svcCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
+	ssm            *mocks.MockparamsDeleter
+	deployStore    *mocks.MockdeployedEnvironmentLister
 }
 
 func TestDeleteSvcOpts_Execute(t *testing.T) {
@@ -311,6 +353,11 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 	}
 
 	mockRepo := fmt.Sprintf("%s/%s", mockAppName, mockSvcName)
+	mockParamsTags := map[string]string{
+		"copilot-application": mockAppName,
+		"copilot-environment": mockEnvName,
+		"copilot-service":     mockSvcName,
+	}
 	testError := errors.New("some error")
 
 	tests := map[string]struct {
@@ -330,9 +377,12 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					// deleteAddonsParams
+					mocks.ssm.EXPECT().DeleteParametersWithTags(mockParamsTags).Return([]string{"/copilot/badgoose/test/backend/db-password"}, nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
@@ -360,10 +410,14 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
 
+					// It should **not** deleteAddonsParams
+					mocks.ssm.EXPECT().DeleteParametersWithTags(gomock.Any()).Times(0),
+
 					// It should **not** emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(gomock.Any()).Return(nil).Times(0),
 
@@ -376,6 +430,50 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"skips the environments that the service is not deployed to": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					// deleteStacks
+					mocks.deployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(false, nil),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Times(0),
+				)
+			},
+			wantedError: nil,
+		},
+		"errors when checking if the service is deployed": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					// deleteStacks
+					mocks.deployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(false, testError),
+				)
+			},
+			wantedError: fmt.Errorf("check if service backend is deployed to environment test: %w", testError),
+		},
+		"errors when deleting the addons parameters": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Times(1).Return(mockEnvs, nil),
+					// deleteStacks
+					mocks.deployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(false, nil),
+					// deleteAddonsParams
+					mocks.ssm.EXPECT().DeleteParametersWithTags(mockParamsTags).Return(nil, testError),
+				)
+			},
+			wantedError: fmt.Errorf("delete parameters of service backend from environment test: %w", testError),
+		},
 		"errors when deleting stack": {
 			inAppName: mockAppName,
 			inSvcName: mockSvcName,
@@ -385,6 +483,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.deployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(testError),
 					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcDeleteFailed, mockSvcName, mockEnvName, testError)),
@@ -407,6 +506,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			mockSvcCFN := mocks.NewMockwlDeleter(ctrl)
 			mockSpinner := mocks.NewMockprogress(ctrl)
 			mockImageRemover := mocks.NewMockimageRemover(ctrl)
+			mockParamsDeleter := mocks.NewMockparamsDeleter(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
 			mockGetSvcCFN := func(_ *session.Session) wlDeleter {
 				return mockSvcCFN
 			}
@@ -414,6 +515,9 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			mockGetImageRemover := func(_ *session.Session) imageRemover {
 				return mockImageRemover
 			}
+			mockGetParamsDeleter := func(_ *session.Session) paramsDeleter {
+				return mockParamsDeleter
+			}
 			mocks := deleteSvcMocks{
 				store:          mockstore,
 				secretsmanager: mockSecretsManager,
@@ -422,6 +526,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				spinner:        mockSpinner,
 				svcCFN:         mockSvcCFN,
 				ecr:            mockImageRemover,
+				ssm:            mockParamsDeleter,
+				deployStore:    mockDeployStore,
 			}
 
 			test.setupMocks(mocks)
@@ -432,12 +538,14 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					name:    test.inSvcName,
 					envName: test.inEnvName,
 				},
-				store:     mockstore,
-				sess:      mockSession,
-				spinner:   mockSpinner,
-				appCFN:    mockAppCFN,
-				getSvcCFN: mockGetSvcCFN,
-				getECR:    mockGetImageRemover,
+				store:       mockstore,
+				deployStore: mockDeployStore,
+				sess:        mockSession,
+				spinner:     mockSpinner,
+				appCFN:      mockAppCFN,
+				getSvcCFN:   mockGetSvcCFN,
+				getECR:      mockGetImageRemover,
+				getSSM:      mockGetParamsDeleter,
 			}
 
 			// WHEN
@@ -4,26 +4,35 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
-	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
-	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -32,42 +41,104 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/aws/copilot-cli/pkg/copilot"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
 )
 
+const (
+	svcDeployMultiEnvPrompt     = "Would you like to deploy to more than one environment?"
+	svcDeployMultiEnvHelpPrompt = `The image is built and pushed once, then the service is deployed
+to each selected environment in order, stopping at the first failure.`
+	svcDeployEnvsPrompt = "Select the environments to deploy to"
+
+	buildToolDocker = "docker"
+	buildToolRemote = "remote"
+
+	deployStatusDeployed = "deployed"
+	deployStatusStarted  = "started"
+	deployStatusFailed   = "failed"
+	deployStatusSkipped  = "skipped"
+	deployStatusNoChange = "unchanged"
+)
+
+// buildTools are the tools that the images of workloads can be built with.
+var buildTools = []string{buildToolDocker, buildToolRemote}
+
 type deployWkldVars struct {
-	appName      string
-	name         string
-	envName      string
-	imageTag     string
-	resourceTags map[string]string
+	appName        string
+	name           string
+	envName        string
+	envNames       []string // Environments to deploy to in order, only svc deploy accepts more than one.
+	imageTag       string
+	resourceTags   map[string]string
+	noWait         bool   // true means the command returns once the stack create or update has started.
+	notifyTopicARN string // SNS topic that a deployment event is published to after the deployment.
+	buildTool      string // Tool that builds the images from Dockerfiles, "docker" or "remote".
+	strict         bool   // true means manifest fields that have no effect for the workload type are errors instead of warnings.
+	history        int    // Number of records kept in the deployment history of the workload in each environment, 0 keeps the default number.
+	allowLatest    bool   // true means the "latest" image tag is used if the tag isn't provided and can't be derived from git.
+	maxContextSize int    // Size in MB above which a build context fails the deployment, 0 only warns about large contexts.
+
+	wsAppName        string // Application that the workspace is registered with, empty if it can't be read.
+	allowAppOverride bool   // true means the application can differ from the one the workspace is registered with.
+
+	shouldOutputJSON bool // Only svc deploy writes the outputs of the deployed service.
+	forceUpdate      bool // Only svc deploy skips deployments without changes, true means the stack is updated anyway.
+	pruneTaskDefs    int  // Only svc deploy prunes task definitions, number of newest revisions kept after deploying; 0 disables pruning.
 }
 
 type deploySvcOpts struct {
 	deployWkldVars
 
 	store              store
+	deployStore        deployedEnvironmentLister
 	ws                 wsSvcDirReader
 	imageBuilderPusher imageBuilderPusher
+	contextSizer       buildContextSizer
+	imageRetainer      imageRetainer
+	imageDigests       imageDigestGetter
+	alarms             alarmStatusGetter
+	taskDefPruner      taskDefinitionPruner
+	deployedImages     func(env string) ([]describe.DeployedImage, error) // Images run by the service's tasks in an environment.
+	svcOutputs         func(env string) (*describe.ServiceOutputs, error) // Outputs of the service stack in an environment.
+	deployService      func(context.Context, copilot.DeployServiceInput) (*copilot.DeployServiceOutput, error)
 	unmarshal          func([]byte) (interface{}, error)
 	s3                 artifactUploader
 	cmd                runner
+	git                gitStatusReader
 	addons             templater
 	appCFN             appResourcesGetter
-	svcCFN             cloudformation.CloudFormation
+	svcCFN             serviceDeployer
 	sessProvider       sessionProvider
 	envUpgradeCmd      actionCommand
+	notifier           *deploymentNotifier // Set only if a topic to notify is configured.
+	recorder           *deploymentRecorder
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
+	w       io.Writer
 
 	// cached variables
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
 	targetSvc         *config.Workload
 	buildRequired     bool
+	imageDigest       string            // Digest of the image built for the service, empty if none is built or the deployment is forced.
+	imageRetention    int               // Number of tagged images to keep in the service's repository, 0 keeps all of them.
+	sidecarImageTags  map[string]string // Image tags of the sidecars built from a Dockerfile, keyed by sidecar name.
+	pushedRegions     map[string]bool   // Regions whose ECR repository already has the images of this deployment.
+	commitImageTag    string            // Short SHA of the git commit, pushed as an additional tag of the service's image.
+}
+
+// deployResult is the outcome of deploying a service to one environment, or of deploying one workload of the workspace.
+type deployResult struct {
+	name   string // Name of the environment or of the workload.
+	status string
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
@@ -80,19 +151,62 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 	prompter := prompt.New()
-	return &deploySvcOpts{
+	vars.notifyTopicARN = defaultNotifyTopicARN(vars.notifyTopicARN, ws)
+	vars.buildTool = defaultBuildTool(vars.buildTool, ws)
+	vars.history = defaultDeploymentHistory(ws)
+	vars.wsAppName = workspaceAppName(ws)
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, store)
+	selOpts = append(selOpts, workspaceAppSelectOptions(vars.appName, vars.allowAppOverride)...)
+	opts := &deploySvcOpts{
 		deployWkldVars: vars,
 
-		store:        store,
-		ws:           ws,
-		unmarshal:    manifest.UnmarshalWorkload,
-		spinner:      termprogress.NewSpinner(),
-		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
-		prompt:       prompter,
-		cmd:          command.New(),
-		sessProvider: sessions.NewProvider(),
-	}, nil
+		store:         store,
+		deployStore:   deployStore,
+		ws:            ws,
+		unmarshal:     workloadUnmarshaler(vars.strict),
+		spinner:       termprogress.NewSpinner(),
+		sel:           selector.NewWorkspaceSelect(prompter, store, ws, selOpts...),
+		prompt:        prompter,
+		cmd:           command.New(),
+		git:           newGitRepo(),
+		sessProvider:  sessions.NewProvider(),
+		deployService: copilot.DeployService,
+		w:             log.OutputWriter,
+	}
+	opts.deployedImages = opts.serviceDeployedImages
+	opts.svcOutputs = func(env string) (*describe.ServiceOutputs, error) {
+		d, err := describe.NewServiceOutputsDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         env,
+			Svc:         opts.name,
+			ConfigStore: store,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return d.Outputs()
+	}
+	return opts, nil
+}
+
+// serviceDeployedImages returns the images run by the tasks of the service in an environment.
+func (o *deploySvcOpts) serviceDeployedImages(env string) ([]describe.DeployedImage, error) {
+	status, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+		App:         o.appName,
+		Env:         env,
+		Svc:         o.name,
+		ConfigStore: o.store,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status.DeployedImages()
 }
 
 // Validate returns an error if the user inputs are invalid.
@@ -100,6 +214,9 @@ func (o *deploySvcOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if err := validateWorkspaceApp(o.wsAppName, o.appName, o.allowAppOverride); err != nil {
+		return err
+	}
 	if o.name != "" {
 		if err := o.validateSvcName(); err != nil {
 			return err
@@ -110,6 +227,29 @@ func (o *deploySvcOpts) Validate() error {
 			return err
 		}
 	}
+	if err := o.validateEnvNames(); err != nil {
+		return err
+	}
+	if o.notifyTopicARN != "" {
+		if err := validateSNSTopicARN(o.notifyTopicARN); err != nil {
+			return fmt.Errorf("validate %s: %w", notifyTopicARNFlag, err)
+		}
+	}
+	if err := validateBuildTool(o.buildTool); err != nil {
+		return err
+	}
+	if o.shouldOutputJSON && o.noWait {
+		return fmt.Errorf("--%s cannot be used with --%s", jsonFlag, noWaitFlag)
+	}
+	if o.pruneTaskDefs < 0 {
+		return fmt.Errorf("--%s cannot be negative", pruneTaskDefsFlag)
+	}
+	if o.maxContextSize < 0 {
+		return fmt.Errorf("--%s cannot be negative", maxContextSizeFlag)
+	}
+	if o.pruneTaskDefs > 0 && o.noWait {
+		return fmt.Errorf("--%s cannot be used with --%s", pruneTaskDefsFlag, noWaitFlag)
+	}
 	return nil
 }
 
@@ -121,22 +261,18 @@ func (o *deploySvcOpts) Ask() error {
 	if err := o.askEnvName(); err != nil {
 		return err
 	}
-	tag, err := askImageTag(o.imageTag, o.prompt, o.cmd)
+	tag, err := deployImageTag(o.imageTag, o.allowLatest, o.cmd)
 	if err != nil {
 		return err
 	}
 	o.imageTag = tag
+	o.commitImageTag = getShortCommit(o.cmd)
 	return nil
 }
 
 // Execute builds and pushes the container image for the service,
+// then deploys the service to each target environment in order and stops at the first failure.
 func (o *deploySvcOpts) Execute() error {
-	env, err := targetEnv(o.store, o.appName, o.envName)
-	if err != nil {
-		return err
-	}
-	o.targetEnvironment = env
-
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
 		return err
@@ -149,28 +285,152 @@ func (o *deploySvcOpts) Execute() error {
 	}
 	o.targetSvc = svc
 
+	envNames := o.targetEnvNames()
+	results := make([]deployResult, len(envNames))
+	for i, envName := range envNames {
+		results[i] = deployResult{name: envName, status: deployStatusSkipped}
+	}
+	for i, envName := range envNames {
+		status, err := o.deployToEnv(envName)
+		if err != nil {
+			results[i].status = deployStatusFailed
+			o.showDeploySummary(results)
+			return err
+		}
+		results[i].status = status
+	}
+	o.showDeploySummary(results)
+	warnUncommittedManifests(o.git, o.ws)
+	return nil
+}
+
+// deployToEnv deploys the service to a single environment and returns the status of the deployment.
+// The images are built and pushed only the first time the service is deployed to an environment's region.
+func (o *deploySvcOpts) deployToEnv(envName string) (string, error) {
+	o.envName = envName
+	env, err := targetEnv(o.store, o.appName, o.envName)
+	if err != nil {
+		return "", err
+	}
+	o.targetEnvironment = env
+	warnIfEnvNewerThanCLI(env, version.Version)
+
 	if err := o.configureClients(); err != nil {
-		return err
+		return "", err
 	}
 
 	if err := o.envUpgradeCmd.Execute(); err != nil {
-		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
+		return "", fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
-	if err := o.configureContainerImage(); err != nil {
-		return err
+	if err := o.pushImages(env.Region); err != nil {
+		return "", err
 	}
 
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
+	if err != nil {
+		return "", err
+	}
+
+	deployed, err := o.deploySvc(addonsURL)
+	if err != nil {
+		return "", err
+	}
+	if !deployed {
+		return deployStatusNoChange, o.showSvcOutputs()
+	}
+	if err := o.retainImages(); err != nil {
+		return "", err
+	}
+	if o.pruneTaskDefs > 0 {
+		pruneTaskDefinitions(o.taskDefPruner, o.appName, o.targetEnvironment.Name, o.name, o.pruneTaskDefs)
+	}
+	if o.notifier != nil {
+		o.notifier.notify(o.deployWkldVars)
+	}
+
+	if o.noWait {
+		log.Successf("Started deploying %s to %s, the stack is %s.\n", color.HighlightUserInput(o.name),
+			color.HighlightUserInput(o.targetEnvironment.Name), color.HighlightResource(stack.NameForService(o.appName, o.targetEnvironment.Name, o.name)))
+		log.Infof("Run %s to check on the deployment.\n",
+			color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s --events", o.name, o.targetEnvironment.Name)))
+		return deployStatusStarted, nil
+	}
+	log.Successf("Deployed %s to %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.targetEnvironment.Name))
+	return deployStatusDeployed, o.showSvcOutputs()
+}
+
+// BuildAndPushImages builds and pushes the images of the service to the region of its first target environment,
+// so that Execute only deploys the service there.
+func (o *deploySvcOpts) BuildAndPushImages() error {
+	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
 		return err
 	}
+	o.targetApp = app
+	env, err := targetEnv(o.store, o.appName, o.targetEnvNames()[0])
+	if err != nil {
+		return err
+	}
+	o.targetEnvironment = env
+	if err := o.configureClients(); err != nil {
+		return err
+	}
+	return o.pushImages(env.Region)
+}
 
-	if err := o.deploySvc(addonsURL); err != nil {
+// pushImages builds and pushes the images of the service unless they were already pushed to the region.
+func (o *deploySvcOpts) pushImages(region string) error {
+	if o.pushedRegions[region] {
+		return nil
+	}
+	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
+	if o.pushedRegions == nil {
+		o.pushedRegions = make(map[string]bool)
+	}
+	o.pushedRegions[region] = true
+	return nil
+}
+
+// targetEnvNames returns the environments to deploy to in order.
+func (o *deploySvcOpts) targetEnvNames() []string {
+	if len(o.envNames) != 0 {
+		return o.envNames
+	}
+	return []string{o.envName}
+}
+
+// showDeploySummary writes the status of the deployment to each environment if there is more than one.
+func (o *deploySvcOpts) showDeploySummary(results []deployResult) {
+	if len(results) < 2 {
+		return
+	}
+	log.Infoln()
+	log.Info(deploySummaryString("Environment", results))
+}
 
-	return o.showSvcURI()
+// deploySummaryString returns a table of the deployment results, column is the header of their names.
+func deploySummaryString(column string, results []deployResult) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Deployments\n\n"))
+	fmt.Fprintf(writer, "  %s\t%s\n", column, "Status")
+	for _, res := range results {
+		status := res.status
+		switch res.status {
+		case deployStatusDeployed, deployStatusStarted:
+			status = color.Green.Sprint(status)
+		case deployStatusFailed:
+			status = color.Red.Sprint(status)
+		case deployStatusSkipped:
+			status = color.Faint.Sprint(status)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", res.name, status)
+	}
+	writer.Flush()
+	return b.String()
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
@@ -198,6 +458,20 @@ func (o *deploySvcOpts) validateEnvName() error {
 	return nil
 }
 
+func (o *deploySvcOpts) validateEnvNames() error {
+	seen := make(map[string]bool)
+	for _, name := range o.envNames {
+		if seen[name] {
+			return fmt.Errorf("environment %s is specified more than once", color.HighlightUserInput(name))
+		}
+		seen[name] = true
+		if _, err := targetEnv(o.store, o.appName, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func targetEnv(s store, appName, envName string) (*config.Environment, error) {
 	env, err := s.GetEnvironment(appName, envName)
 	if err != nil {
@@ -220,10 +494,29 @@ func (o *deploySvcOpts) askSvcName() error {
 }
 
 func (o *deploySvcOpts) askEnvName() error {
-	if o.envName != "" {
+	if o.envName != "" || len(o.envNames) != 0 {
 		return nil
 	}
 
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments for application %s: %w", o.appName, err)
+	}
+	if len(envs) > 1 {
+		multiEnv, err := o.prompt.Confirm(svcDeployMultiEnvPrompt, svcDeployMultiEnvHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("confirm deploying to multiple environments: %w", err)
+		}
+		if multiEnv {
+			names, err := o.sel.Environments(svcDeployEnvsPrompt, "", o.appName)
+			if err != nil {
+				return fmt.Errorf("select environments: %w", err)
+			}
+			o.envNames = names
+			return nil
+		}
+	}
+
 	name, err := o.sel.Environment("Select an environment", "", o.appName)
 	if err != nil {
 		return fmt.Errorf("select environment: %w", err)
@@ -243,18 +536,38 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("assuming environment manager role: %w", err)
 	}
 
+	// client to retrieve an application's resources created with CloudFormation
+	defaultSess, err := o.sessProvider.Default()
+	if err != nil {
+		return fmt.Errorf("create default session: %w", err)
+	}
+	o.appCFN = cloudformation.New(defaultSess)
+
 	// ECR client against tools account profile AND target environment region
 	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
 	registry := ecr.New(defaultSessEnvRegion)
-	o.imageBuilderPusher, err = repository.New(repoName, registry)
+	o.imageBuilderPusher, err = newImageBuilderPusher(o.buildTool, repoName, registry, o.targetApp, o.targetEnvironment.Region, defaultSess, defaultSessEnvRegion)
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.contextSizer = docker.New()
+	o.imageRetainer = registry
+	o.imageDigests = registry
 
 	o.s3 = s3.New(defaultSessEnvRegion)
+	o.recorder = newDeploymentRecorder(defaultSessEnvRegion, o.appCFN, o.history)
 
 	// CF client against env account profile AND target environment region
 	o.svcCFN = cloudformation.New(envSession)
+	o.alarms = cloudwatch.New(envSession)
+	o.taskDefPruner = ecs.New(envSession)
+
+	if o.notifyTopicARN != "" {
+		o.notifier, err = newDeploymentNotifier(o.sessProvider, o.notifyTopicARN, o.svcCFN)
+		if err != nil {
+			return fmt.Errorf("initiate deployment notifier: %w", err)
+		}
+	}
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -262,13 +575,6 @@ func (o *deploySvcOpts) configureClients() error {
 	}
 	o.addons = addonsSvc
 
-	// client to retrieve an application's resources created with CloudFormation
-	defaultSess, err := o.sessProvider.Default()
-	if err != nil {
-		return fmt.Errorf("create default session: %w", err)
-	}
-	o.appCFN = cloudformation.New(defaultSess)
-
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
 		appName: o.appName,
 		name:    o.targetEnvironment.Name,
@@ -281,26 +587,134 @@ func (o *deploySvcOpts) configureClients() error {
 }
 
 func (o *deploySvcOpts) configureContainerImage() error {
-	svc, err := o.manifest()
+	_, svc, err := o.manifest()
 	if err != nil {
 		return err
 	}
+	retention, err := manifest.ServiceImageRetention(svc)
+	if err != nil {
+		return err
+	}
+	o.imageRetention = retention
 	required, err := manifest.ServiceDockerfileBuildRequired(svc)
 	if err != nil {
 		return err
 	}
-	if !required {
+	if required {
+		// If it is built from local Dockerfile, build and push to the ECR repo.
+		buildArg, err := o.dfBuildArgs(svc)
+		if err != nil {
+			return err
+		}
+		if o.buildTool != buildToolRemote {
+			warnIfPlatformNotBuildable(docker.New(), buildArg.Platform)
+		}
+		if err := checkBuildContextSize(o.contextSizer, buildArg, o.maxContextSize); err != nil {
+			return err
+		}
+		if err := o.imageBuilderPusher.BuildAndPush(docker.New(), buildArg); err != nil {
+			return fmt.Errorf("build and push image: %w", err)
+		}
+		o.buildRequired = true
+		if !o.forceUpdate {
+			repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+			digest, err := o.imageDigests.ImageDigest(repoName, o.imageTag)
+			if err != nil {
+				return fmt.Errorf("get digest of the pushed image: %w", err)
+			}
+			o.imageDigest = digest
+		}
+	}
+	return o.configureSidecarImages(svc)
+}
+
+// retainImages installs the lifecycle policy that keeps only the most recent images of the service's repository
+// in the target environment's region if the manifest sets "image.retention".
+// The images deployed to the environments of the region are tagged first so that the policy never expires them.
+func (o *deploySvcOpts) retainImages() error {
+	if o.imageRetention == 0 || (!o.buildRequired && len(o.sidecarImageTags) == 0) {
 		return nil
 	}
-	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(svc)
+	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	deployedTags := make(map[string]string, len(o.sidecarImageTags)+1)
+	for name, tag := range o.sidecarImageTags {
+		deployedTags[name] = tag
+	}
+	if o.buildRequired {
+		deployedTags[o.name] = o.imageTag
+	}
+	for container, tag := range deployedTags {
+		if err := o.imageRetainer.TagImage(repoName, ecr.Image{Tag: tag}, ecr.DeployedImageTag(o.targetEnvironment.Name, container)); err != nil {
+			return fmt.Errorf("tag image deployed to environment %s: %w", o.targetEnvironment.Name, err)
+		}
+	}
+
+	envs, err := o.deployStore.ListEnvironmentsDeployedTo(o.appName, o.name)
 	if err != nil {
-		return err
+		return fmt.Errorf("list environments service %s is deployed to: %w", o.name, err)
+	}
+	for _, envName := range envs {
+		if envName == o.targetEnvironment.Name {
+			continue
+		}
+		env, err := o.store.GetEnvironment(o.appName, envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", envName, err)
+		}
+		if env.Region != o.targetEnvironment.Region {
+			continue
+		}
+		images, err := o.deployedImages(envName)
+		if err != nil {
+			return fmt.Errorf("get images deployed to environment %s: %w", envName, err)
+		}
+		for _, image := range images {
+			if !isImageInRepo(image.URI, repoName) {
+				continue
+			}
+			if err := o.imageRetainer.TagImage(repoName, ecr.Image{Digest: image.Digest}, ecr.DeployedImageTag(envName, image.Container)); err != nil {
+				return fmt.Errorf("tag image deployed to environment %s: %w", envName, err)
+			}
+		}
+	}
+
+	if err := o.imageRetainer.SetImageRetention(repoName, o.imageRetention); err != nil {
+		return fmt.Errorf("keep the %d most recent images of repository %s: %w", o.imageRetention, repoName, err)
+	}
+	return nil
+}
+
+// isImageInRepo returns true if the image URI, such as "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:tag",
+// points to the repository.
+func isImageInRepo(uri, repoName string) bool {
+	i := strings.Index(uri, "/")
+	if i == -1 {
+		return false
+	}
+	path := uri[i+1:]
+	return path == repoName || strings.HasPrefix(path, repoName+":") || strings.HasPrefix(path, repoName+"@")
+}
+
+// configureSidecarImages builds and pushes the images of the sidecars that are built from a local Dockerfile.
+// Sidecars that use an existing image are left untouched.
+func (o *deploySvcOpts) configureSidecarImages(svc interface{}) error {
+	if !manifest.SidecarDockerfileBuildRequired(svc) {
+		return nil
 	}
-	if err := o.imageBuilderPusher.BuildAndPush(docker.New(), buildArg); err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	o.sidecarImageTags = make(map[string]string)
+	for _, arg := range sidecarBuildArgs(o.imageTag, copilotDir, svc) {
+		if err := checkBuildContextSize(o.contextSizer, arg.buildArgs, o.maxContextSize); err != nil {
+			return fmt.Errorf("sidecar %s: %w", arg.name, err)
+		}
+		if err := o.imageBuilderPusher.BuildAndPush(docker.New(), arg.buildArgs); err != nil {
+			return fmt.Errorf("build and push image for sidecar %s: %w", arg.name, err)
+		}
+		o.sidecarImageTags[arg.name] = arg.buildArgs.ImageTag
 	}
-	o.buildRequired = true
 	return nil
 }
 
@@ -309,17 +723,29 @@ func (o *deploySvcOpts) dfBuildArgs(svc interface{}) (*docker.BuildArguments, er
 	if err != nil {
 		return nil, fmt.Errorf("get copilot directory: %w", err)
 	}
-	return buildArgs(o.name, o.imageTag, copilotDir, svc)
+	args, err := buildArgs(o.name, o.imageTag, copilotDir, svc)
+	if err != nil {
+		return nil, err
+	}
+	args.AdditionalTags = additionalImageTags(o.imageTag, o.commitImageTag)
+	return args, nil
 }
 
 func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{}) (*docker.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+		ImagePlatform() string
 	}
 	mf, ok := unmarshaledManifest.(dfArgs)
 	if !ok {
 		return nil, fmt.Errorf("%s does not have required method BuildArgs()", name)
 	}
+	platform := mf.ImagePlatform()
+	if platform != "" {
+		if err := manifest.ValidatePlatform(aws.String(platform)); err != nil {
+			return nil, fmt.Errorf("validate the platform of %s: %w", name, err)
+		}
+	}
 
 	wsRoot := filepath.Dir(copilotDir)
 	args := mf.BuildArgs(wsRoot)
@@ -330,9 +756,265 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 		ImageTag:   imageTag,
 		CacheFrom:  args.CacheFrom,
 		Target:     aws.StringValue(args.Target),
+		Platform:   platform,
 	}, nil
 }
 
+// validateUniqueTopics returns an error if a topic published by the workload is also published by another workload
+// of the workspace, since the subscribers import the topics of an application by name.
+func validateUniqueTopics(ws wsWlManifestReader, name string, mft interface{}, unmarshal func([]byte) (interface{}, error)) error {
+	topics := manifest.PublishedTopics(mft)
+	if len(topics) == 0 {
+		return nil
+	}
+	svcs, err := ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	jobs, err := ws.JobNames()
+	if err != nil {
+		return fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	readers := make(map[string]func(string) ([]byte, error))
+	for _, svc := range svcs {
+		readers[svc] = ws.ReadServiceManifest
+	}
+	for _, job := range jobs {
+		readers[job] = ws.ReadJobManifest
+	}
+	for _, other := range append(svcs, jobs...) {
+		if other == name {
+			continue
+		}
+		raw, err := readers[other](other)
+		if err != nil {
+			return err
+		}
+		otherMft, err := unmarshal(raw)
+		if err != nil {
+			return fmt.Errorf("unmarshal manifest of %s: %w", other, err)
+		}
+		for _, published := range manifest.PublishedTopics(otherMft) {
+			for _, topic := range topics {
+				if topic == published {
+					return fmt.Errorf("topic %q is also published by %s, topic names must be unique within the application", topic, other)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// warnIfEnvNewerThanCLI logs a warning if the environment was created or last upgraded by a newer version of the CLI,
+// since deploying with an older version can drop template features that the environment relies on.
+func warnIfEnvNewerThanCLI(env *config.Environment, cliVersion string) {
+	envVersion := env.CLIVersion()
+	if !semver.IsValid(envVersion) || !semver.IsValid(cliVersion) {
+		// Environments stored before the version was recorded and development builds aren't compared.
+		return
+	}
+	if semver.Compare(cliVersion, envVersion) >= 0 {
+		return
+	}
+	log.Warningf(`Environment %s was last updated by copilot %s, but you are running copilot %s.
+Deploying with an older version might remove features of the environment, please upgrade copilot first.
+`, color.HighlightUserInput(env.Name), envVersion, cliVersion)
+}
+
+// warnIfPlatformNotBuildable logs a warning if the image is built for a CPU architecture other than the host's,
+// like an ARM image on an x86 host, and the docker builder can't emulate it.
+func warnIfPlatformNotBuildable(builder builderPlatformsLister, platform string) {
+	if platform == "" || strings.HasSuffix(platform, "/"+runtime.GOARCH) {
+		return
+	}
+	if platforms, err := builder.BuilderPlatforms(); err == nil {
+		for _, p := range platforms {
+			if p == platform {
+				return
+			}
+		}
+	}
+	log.Warningf(`Building an image for %s on a %s host requires docker buildx with QEMU emulation, which doesn't seem to be available.
+Run "docker buildx inspect" to check the platforms that your builder supports.
+`, color.HighlightUserInput(platform), runtime.GOARCH)
+}
+
+const (
+	bytesPerMB            = 1000 * 1000      // Number of bytes in a megabyte, the unit of --max-context-size.
+	buildContextWarnBytes = 250 * bytesPerMB // Size of a build context above which deployments warn about it.
+)
+
+// checkBuildContextSize prints the size of the build context of an image, and warns about a large context along with
+// its largest directories. If maxMB is set, it returns an error instead when the context is larger than maxMB megabytes.
+func checkBuildContextSize(sizer buildContextSizer, args *docker.BuildArguments, maxMB int) error {
+	dir := args.ContextDir()
+	size, err := sizer.BuildContextSize(dir)
+	if err != nil {
+		return fmt.Errorf("get size of build context %s: %w", dir, err)
+	}
+	log.Infof("Build context %s is %s.\n", color.HighlightResource(dir), humanize.Bytes(uint64(size.Bytes)))
+	if maxMB > 0 && size.Bytes > int64(maxMB)*bytesPerMB {
+		return fmt.Errorf("build context %s is %s, which exceeds the maximum of %d MB: exclude files with a .dockerignore file in the context directory, the largest directories are %s",
+			dir, humanize.Bytes(uint64(size.Bytes)), maxMB, largestContextDirs(size.LargestDirs))
+	}
+	if size.Bytes <= buildContextWarnBytes {
+		return nil
+	}
+	log.Warningf(`Build context %s is larger than %s, which slows down building the image.
+Exclude the files that the image doesn't need with a .dockerignore file in the context directory.
+The largest directories are %s.
+`, color.HighlightResource(dir), humanize.Bytes(buildContextWarnBytes), largestContextDirs(size.LargestDirs))
+	return nil
+}
+
+// largestContextDirs returns the directories of a build context with their sizes, such as "node_modules (1.2 GB), static (80 MB)".
+func largestContextDirs(dirs []docker.DirSize) string {
+	var out []string
+	for _, dir := range dirs {
+		out = append(out, fmt.Sprintf("%s (%s)", dir.Path, humanize.Bytes(uint64(dir.Bytes))))
+	}
+	return strings.Join(out, ", ")
+}
+
+// defaultBuildTool returns the build tool configured in the workspace summary if the flag isn't set,
+// and falls back to building images with the local docker daemon.
+func defaultBuildTool(flagValue string, ws *workspace.Workspace) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	summary, err := ws.Summary()
+	if err != nil || summary.BuildTool == "" {
+		return buildToolDocker
+	}
+	return summary.BuildTool
+}
+
+// workloadUnmarshaler returns the function that reads the manifest of a workload to deploy.
+// In strict mode, fields that have no effect for the workload type fail the deployment instead of being ignored.
+func workloadUnmarshaler(strict bool) func([]byte) (interface{}, error) {
+	if strict {
+		return manifest.UnmarshalWorkloadStrict
+	}
+	return manifest.UnmarshalWorkload
+}
+
+func validateBuildTool(tool string) error {
+	if tool == "" || contains(tool, buildTools) {
+		return nil
+	}
+	return fmt.Errorf("invalid %s %s: must be one of %s", buildToolFlag, tool, strings.Join(buildTools, ", "))
+}
+
+// newImageBuilderPusher returns the repository that builds the images of a workload and pushes them to its
+// ECR repository in the environment's region.
+// Remote builds run in the application's CodeBuild project, which downloads the build context from the application's
+// S3 bucket in the environment's region.
+func newImageBuilderPusher(tool, repoName string, registry ecr.ECR, app *config.Application, region string,
+	defaultSess, defaultSessEnvRegion *session.Session) (imageBuilderPusher, error) {
+	repoRegistry := &propagatingRegistry{imageRegistry: registry, region: region}
+	if tool != buildToolRemote {
+		return repository.New(repoName, repoRegistry)
+	}
+	appCFN := cloudformation.New(defaultSess)
+	project, err := appCFN.ImageBuildProject(app)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := appCFN.GetAppResourcesByRegion(app, region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", app.Name, region, err)
+	}
+	return repository.NewRemote(repoName, repository.RemoteConfig{
+		Project:  project,
+		Bucket:   resources.S3Bucket,
+		Uploader: s3.New(defaultSessEnvRegion),
+		Builder:  codebuild.New(defaultSess),
+		Registry: repoRegistry,
+		Logs:     cloudwatchlogs.New(defaultSess),
+		Out:      log.DiagnosticWriter,
+	})
+}
+
+// Lookups of a workload's ECR repository before giving up, overridden in tests.
+var (
+	repoLookupAttempts = 6
+	repoLookupInterval = 5 * time.Second
+)
+
+// propagatingRegistry looks up a repository again for a short while if it doesn't exist yet.
+// The ECR repositories of the workloads are created with the application's resources in the environment's region,
+// which can still be in progress when deploying right after "env init".
+type propagatingRegistry struct {
+	imageRegistry
+	region string
+}
+
+// RepositoryURI returns the URI of the repository, retrying while it's not found.
+func (r *propagatingRegistry) RepositoryURI(name string) (string, error) {
+	for attempt := 1; ; attempt++ {
+		uri, err := r.imageRegistry.RepositoryURI(name)
+		var notFound *ecr.ErrRepositoryNotFound
+		if !errors.As(err, &notFound) {
+			return uri, err
+		}
+		if attempt == repoLookupAttempts {
+			return "", fmt.Errorf("%w: the application's resources in region %s may still be propagating after %s, try again in a few minutes",
+				err, r.region, color.HighlightCode("copilot env init"))
+		}
+		time.Sleep(repoLookupInterval)
+	}
+}
+
+type sidecarBuildArg struct {
+	name      string
+	buildArgs *docker.BuildArguments
+}
+
+// sidecarBuildArgs returns the docker build arguments of the sidecars in the manifest that are built from a Dockerfile,
+// sorted by sidecar name. Each sidecar image is tagged with the workload's image tag suffixed by the sidecar name.
+func sidecarBuildArgs(imageTag, copilotDir string, unmarshaledManifest interface{}) []sidecarBuildArg {
+	type sidecarDfArgs interface {
+		BuildConfigs(rootDirectory string) map[string]*manifest.DockerBuildArgs
+		ImagePlatform() string
+	}
+	mf, ok := unmarshaledManifest.(sidecarDfArgs)
+	if !ok {
+		return nil
+	}
+
+	wsRoot := filepath.Dir(copilotDir)
+	configs := mf.BuildConfigs(wsRoot)
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var args []sidecarBuildArg
+	for _, name := range names {
+		conf := configs[name]
+		args = append(args, sidecarBuildArg{
+			name: name,
+			buildArgs: &docker.BuildArguments{
+				Dockerfile: *conf.Dockerfile,
+				Context:    *conf.Context,
+				Args:       conf.Args,
+				ImageTag:   sidecarImageTag(imageTag, name),
+				CacheFrom:  conf.CacheFrom,
+				Target:     aws.StringValue(conf.Target),
+				Platform:   mf.ImagePlatform(),
+			},
+		})
+	}
+	return args
+}
+
+func sidecarImageTag(imageTag, sidecarName string) string {
+	if imageTag == "" {
+		return sidecarName
+	}
+	return fmt.Sprintf("%s-%s", imageTag, sidecarName)
+}
+
 // pushAddonsTemplateToS3Bucket generates the addons template for the service and pushes it to S3.
 // If the service doesn't have any addons, it returns the empty string and no errors.
 // If the service has addons, it returns the URL of the S3 object storing the addons template.
@@ -359,137 +1041,188 @@ func (o *deploySvcOpts) pushAddonsTemplateToS3Bucket() (string, error) {
 	return url, nil
 }
 
-func (o *deploySvcOpts) manifest() (interface{}, error) {
+// manifest returns the contents of the service's manifest file and the unmarshaled manifest.
+func (o *deploySvcOpts) manifest() ([]byte, interface{}, error) {
 	raw, err := o.ws.ReadServiceManifest(o.name)
 	if err != nil {
-		return nil, fmt.Errorf("read service %s manifest file: %w", o.name, err)
+		return nil, nil, fmt.Errorf("read service %s manifest file: %w", o.name, err)
 	}
 	mft, err := o.unmarshal(raw)
 	if err != nil {
-		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+		return nil, nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
 	}
-	return mft, nil
+	return raw, mft, nil
 }
 
-func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
-	if !o.buildRequired {
-		return &stack.RuntimeConfig{
-			AddonsTemplateURL: addonsURL,
-			AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-		}, nil
+// imageTagToDeploy returns the tag of the image that the service runs in the target environment
+// if it has the same digest as the pushed image, so that pushing the same image under a new tag doesn't change the stack.
+// Otherwise, it returns the tag of the pushed image.
+func (o *deploySvcOpts) imageTagToDeploy() (string, error) {
+	if o.imageDigest == "" {
+		return o.imageTag, nil
 	}
-	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	deployed, err := o.deployStore.IsServiceDeployed(o.appName, o.targetEnvironment.Name, o.name)
 	if err != nil {
-		return nil, fmt.Errorf("get application %s resources from region %s: %w", o.targetApp.Name, o.targetEnvironment.Region, err)
+		return "", fmt.Errorf("check if service %s is deployed to environment %s: %w", o.name, o.targetEnvironment.Name, err)
 	}
-	repoURL, ok := resources.RepositoryURLs[o.name]
-	if !ok {
-		return nil, &errRepoNotFound{
-			wlName:       o.name,
-			envRegion:    o.targetEnvironment.Region,
-			appAccountID: o.targetApp.AccountID,
+	if !deployed {
+		return o.imageTag, nil
+	}
+	images, err := o.deployedImages(o.targetEnvironment.Name)
+	if err != nil {
+		return "", fmt.Errorf("get images deployed to environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	for _, image := range images {
+		if image.Container != o.name || image.Digest != o.imageDigest || !isImageInRepo(image.URI, repoName) {
+			continue
+		}
+		if tag := imageURITag(image.URI); tag != "" {
+			return tag, nil
 		}
 	}
-	return &stack.RuntimeConfig{
-		AddonsTemplateURL: addonsURL,
-		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-		Image: &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.imageTag,
-		},
-	}, nil
+	return o.imageTag, nil
 }
 
-func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
-	mft, err := o.manifest()
-	if err != nil {
-		return nil, err
+// imageURITag returns the tag of an image URI such as "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:tag",
+// or the empty string if the URI doesn't have a tag.
+func imageURITag(uri string) string {
+	path := uri[strings.LastIndex(uri, "/")+1:]
+	if strings.Contains(path, "@") {
+		return ""
+	}
+	i := strings.LastIndex(path, ":")
+	if i == -1 {
+		return ""
 	}
-	rc, err := o.runtimeConfig(addonsURL)
+	return path[i+1:]
+}
+
+// deploySvc creates or updates the stack of the service in the target environment.
+// Unless the deployment is forced, it returns false without updating the stack if the stack wouldn't change.
+func (o *deploySvcOpts) deploySvc(addonsURL string) (deployed bool, err error) {
+	raw, mft, err := o.manifest()
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	var conf cloudformation.StackConfiguration
-	switch t := mft.(type) {
-	case *manifest.LoadBalancedWebService:
-		if o.targetApp.RequiresDNSDelegation() {
-			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
-		} else {
-			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
-		}
-	case *manifest.BackendService:
-		conf, err = stack.NewBackendService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
-	default:
-		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
+	if err := validateUniqueTopics(o.ws, o.name, mft, o.unmarshal); err != nil {
+		return false, err
 	}
+	o.warnIfRollbackAlarmsNotFound(mft)
+	sess, err := o.sessProvider.Default()
 	if err != nil {
-		return nil, fmt.Errorf("create stack configuration: %w", err)
+		return false, fmt.Errorf("create default session: %w", err)
 	}
-	return conf, nil
-}
-
-func (o *deploySvcOpts) deploySvc(addonsURL string) error {
-	conf, err := o.stackConfiguration(addonsURL)
-	if err != nil {
-		return err
+	in := copilot.DeployServiceInput{
+		App:               o.appName,
+		Env:               o.targetEnvironment.Name,
+		Name:              o.name,
+		Manifest:          raw,
+		SidecarImageTags:  o.sidecarImageTags,
+		AddonsTemplateURL: addonsURL,
+		ResourceTags:      o.resourceTags,
+		Force:             o.forceUpdate,
+		NoWait:            o.noWait,
+		Session:           sess,
+	}
+	if o.buildRequired {
+		if in.ImageTag, err = o.imageTagToDeploy(); err != nil {
+			return false, err
+		}
+	}
+	fmtMsg := "Deploying %s to %s."
+	if o.noWait {
+		fmtMsg = "Starting the deployment of %s to %s."
 	}
 	o.spinner.Start(
-		fmt.Sprintf("Deploying %s to %s.",
+		fmt.Sprintf(fmtMsg,
 			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.name), color.HighlightUserInput(o.imageTag)),
 			color.HighlightUserInput(o.targetEnvironment.Name)))
-
-	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	out, err := o.deployService(cmdCtx, in)
+	if err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n\n"))
-		return fmt.Errorf("deploy service: %w", err)
+		return false, err
+	}
+	if !out.Deployed {
+		o.spinner.Stop("")
+		log.Infof("No changes detected for %s in %s, run with %s to deploy it anyway.\n",
+			color.HighlightUserInput(o.name), color.HighlightUserInput(o.targetEnvironment.Name), color.HighlightCode("--"+forceFlag))
+		return false, nil
 	}
 	o.spinner.Stop("\n\n")
-	return nil
+	if o.recorder != nil && !o.noWait {
+		o.recorder.record(workloadDeployment{
+			app:          o.targetApp,
+			env:          o.targetEnvironment,
+			name:         o.name,
+			imageTag:     in.ImageTag,
+			templateHash: out.TemplateHash,
+		})
+	}
+	o.warnIfExecNeedsNewTasks(mft)
+	return true, nil
 }
 
-func (o *deploySvcOpts) showSvcURI() error {
-	type identifier interface {
-		URI(string) (string, error)
+// warnIfExecNeedsNewTasks logs a warning if the service lets commands run in its containers,
+// because ECS only enables exec in the tasks started after the setting changed.
+func (o *deploySvcOpts) warnIfExecNeedsNewTasks(mft interface{}) {
+	enabled, err := manifest.ServiceExecEnabled(mft, o.targetEnvironment.Name)
+	if err != nil || !enabled {
+		return
 	}
+	log.Warningf("Exec is enabled for %s in %s, but tasks started before it was enabled can't run commands until a new deployment replaces them.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.targetEnvironment.Name))
+}
 
-	var svcDescriber identifier
-	var err error
-	switch o.targetSvc.Type {
-	case manifest.LoadBalancedWebServiceType:
-		svcDescriber, err = describe.NewWebServiceDescriber(describe.NewWebServiceConfig{
-			NewServiceConfig: describe.NewServiceConfig{
-				App:         o.appName,
-				Svc:         o.name,
-				ConfigStore: o.store,
-			},
-		})
-	case manifest.BackendServiceType:
-		svcDescriber, err = describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
-			NewServiceConfig: describe.NewServiceConfig{
-				App:         o.appName,
-				Svc:         o.name,
-				ConfigStore: o.store,
-			},
-		})
-	default:
-		err = errors.New("unexpected service type")
+// warnIfRollbackAlarmsNotFound logs a warning for each rollback alarm of the service that doesn't exist
+// in the environment's account and region. The alarms are only looked up on a best-effort basis.
+func (o *deploySvcOpts) warnIfRollbackAlarmsNotFound(mft interface{}) {
+	alarms, err := manifest.ServiceRollbackAlarms(mft, o.targetEnvironment.Name)
+	if err != nil || len(alarms) == 0 {
+		return
 	}
+	statuses, err := o.alarms.AlarmStatus(alarms)
 	if err != nil {
-		return fmt.Errorf("create describer for service type %s: %w", o.targetSvc.Type, err)
+		log.Warningf("Couldn't verify that the rollback alarms of service %s exist: %v\n", o.name, err)
+		return
+	}
+	found := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		found[status.Name] = true
 	}
+	for _, alarm := range alarms {
+		if !found[alarm] {
+			log.Warningf("Rollback alarm %s doesn't exist in environment %s, so it can't roll back the deployment.\n",
+				color.HighlightUserInput(alarm), color.HighlightUserInput(o.targetEnvironment.Name))
+		}
+	}
+}
 
-	uri, err := svcDescriber.URI(o.targetEnvironment.Name)
+// showSvcOutputs writes the URL, the service discovery endpoint and the addons outputs of the deployed service.
+// If messages are suppressed, only the URL is written to stdout.
+func (o *deploySvcOpts) showSvcOutputs() error {
+	outputs, err := o.svcOutputs(o.targetEnvironment.Name)
 	if err != nil {
-		return fmt.Errorf("get uri for environment %s: %w", o.targetEnvironment.Name, err)
+		return fmt.Errorf("get outputs of service %s in environment %s: %w", o.name, o.targetEnvironment.Name, err)
 	}
-	switch o.targetSvc.Type {
-	case manifest.BackendServiceType:
-		msg := fmt.Sprintf("Deployed %s.\n", color.HighlightUserInput(o.name))
-		if uri != describe.BlankServiceDiscoveryURI {
-			msg = fmt.Sprintf("Deployed %s, its service discovery endpoint is %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(uri))
+	if o.shouldOutputJSON {
+		data, err := outputs.JSONString()
+		if err != nil {
+			return err
 		}
-		log.Success(msg)
-	default:
-		log.Successf("Deployed %s, you can access it at %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(uri))
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if log.IsQuiet() {
+		// Keep the URL so that scripts running with --quiet can still capture it.
+		if outputs.URL != "" {
+			fmt.Fprintln(o.w, outputs.URL)
+		}
+		return nil
+	}
+	if summary := outputs.HumanString(); summary != "" {
+		log.Infoln()
+		log.Info(summary)
 	}
 	return nil
 }
@@ -505,7 +1238,17 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys the same image of a service to "test", then to "prod".
+  /code $ copilot svc deploy --name frontend --env test --env prod
+  Starts the deployment of a service without waiting for it to complete.
+  /code $ copilot svc deploy --name frontend --env test --no-wait
+  Deploys a service and writes its URL, service discovery endpoint and addons outputs in JSON format.
+  /code $ copilot svc deploy --name frontend --env test --json
+  Updates the service stack even if the image and the template haven't changed.
+  /code $ copilot svc deploy --name frontend --env test --force
+  Deploys a service and deregisters all but the newest 10 revisions of its task definition.
+  /code $ copilot svc deploy --name frontend --env test --prune-task-definitions 10`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -525,9 +1268,19 @@ func buildSvcDeployCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
-	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, svcDeployEnvsFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
-	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowLatest, allowLatestFlag, false, allowLatestFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.noWait, noWaitFlag, false, noWaitFlagDescription)
+	cmd.Flags().StringVar(&vars.notifyTopicARN, notifyTopicARNFlag, "", notifyTopicARNFlagDescription)
+	cmd.Flags().StringVar(&vars.buildTool, buildToolFlag, "", buildToolFlagDescription)
+	cmd.Flags().BoolVar(&vars.strict, strictFlag, false, strictFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAppOverride, allowAppOverrideFlag, false, allowAppOverrideFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceUpdate, forceFlag, false, svcDeployForceFlagDescription)
+	cmd.Flags().IntVar(&vars.pruneTaskDefs, pruneTaskDefsFlag, 0, pruneTaskDefsFlagDescription)
+	cmd.Flags().IntVar(&vars.maxContextSize, maxContextSizeFlag, 0, maxContextSizeFlagDescription)
 
 	return cmd
 }
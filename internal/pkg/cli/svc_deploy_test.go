@@ -4,16 +4,26 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
+	"runtime"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/aws/copilot-cli/pkg/copilot"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -23,13 +33,24 @@ import (
 type deploySvcMocks struct {
 	mockWs                 *mocks.MockwsSvcDirReader
 	mockimageBuilderPusher *mocks.MockimageBuilderPusher
+	mockImageDigests       *mocks.MockimageDigestGetter
 }
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName   string
+		inEnvName   string
+		inSvcName   string
+		inEnvNames  []string
+		inTopic     string
+		inBuildTool string
+		inJSON      bool
+		inNoWait    bool
+		inPrune     int
+		inMaxCtx    int
+
+		inWsAppName        string
+		inAllowAppOverride bool
 
 		mockWs    func(m *mocks.MockwsSvcDirReader)
 		mockStore func(m *mocks.Mockstore)
@@ -42,6 +63,24 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errNoAppInWorkspace,
 		},
+		"with an application different from the workspace's": {
+			inAppName:   "phonetool",
+			inWsAppName: "demo",
+			mockWs:      func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedError: &workspace.ErrAppMismatch{
+				WorkspaceApp: "demo",
+				App:          "phonetool",
+			},
+		},
+		"with an application overriding the workspace's": {
+			inAppName:          "phonetool",
+			inWsAppName:        "demo",
+			inAllowAppOverride: true,
+			mockWs:             func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:          func(m *mocks.Mockstore) {},
+		},
 		"with workspace error": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -73,6 +112,78 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
+		"with an environment specified more than once": {
+			inAppName:  "phonetool",
+			inEnvNames: []string{"test", "prod", "test"},
+			mockWs:     func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			},
+
+			wantedError: errors.New("environment test is specified more than once"),
+		},
+		"with one unknown environment out of multiple": {
+			inAppName:  "phonetool",
+			inEnvNames: []string{"test", "prod"},
+			mockWs:     func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(nil, errors.New("unknown env"))
+			},
+
+			wantedError: errors.New("get environment prod configuration: unknown env"),
+		},
+		"with a topic that isn't an SNS topic ARN": {
+			inAppName: "phonetool",
+			inTopic:   "deployments",
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("validate notify-topic-arn: %w", errValueNotASNSTopicARN),
+		},
+		"with an unknown build tool": {
+			inAppName:   "phonetool",
+			inBuildTool: "podman",
+			mockWs:      func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("invalid build-tool podman: must be one of docker, remote"),
+		},
+		"with json output and no wait": {
+			inAppName: "phonetool",
+			inJSON:    true,
+			inNoWait:  true,
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--json cannot be used with --no-wait"),
+		},
+		"with a negative number of task definitions to keep": {
+			inAppName: "phonetool",
+			inPrune:   -1,
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--prune-task-definitions cannot be negative"),
+		},
+		"with a negative maximum build context size": {
+			inAppName: "phonetool",
+			inMaxCtx:  -1,
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--max-context-size cannot be negative"),
+		},
+		"with task definitions pruning and no wait": {
+			inAppName: "phonetool",
+			inPrune:   10,
+			inNoWait:  true,
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--prune-task-definitions cannot be used with --no-wait"),
+		},
 		"successful validation": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -99,9 +210,18 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
+					appName:          tc.inAppName,
+					name:             tc.inSvcName,
+					envName:          tc.inEnvName,
+					envNames:         tc.inEnvNames,
+					notifyTopicARN:   tc.inTopic,
+					buildTool:        tc.inBuildTool,
+					shouldOutputJSON: tc.inJSON,
+					noWait:           tc.inNoWait,
+					pruneTaskDefs:    tc.inPrune,
+					maxContextSize:   tc.inMaxCtx,
+					wsAppName:        tc.inWsAppName,
+					allowAppOverride: tc.inAllowAppOverride,
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -124,42 +244,168 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName  string
 		inEnvName  string
+		inEnvNames []string
 		inSvcName  string
 		inImageTag string
 
-		wantedCalls func(m *mocks.MockwsSelector)
+		inAllowLatest bool
+		mockRunner    func(m *mocks.Mockrunner) // Defaults to a working directory outside of a git repository.
+
+		wantedCalls func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter)
 
 		wantedSvcName  string
 		wantedEnvName  string
+		wantedEnvNames []string
 		wantedImageTag string
 		wantedError    error
+
+		wantedCommitTag string
 	}{
 		"prompts for environment name and service names": {
 			inAppName:  "phonetool",
 			inImageTag: "latest",
-			wantedCalls: func(m *mocks.MockwsSelector) {
-				m.EXPECT().Service("Select a service in your workspace", "").Return("frontend", nil)
-				m.EXPECT().Environment("Select an environment", "", "phonetool").Return("prod-iad", nil)
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				sel.EXPECT().Service("Select a service in your workspace", "").Return("frontend", nil)
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "prod-iad"}}, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				sel.EXPECT().Environment("Select an environment", "", "phonetool").Return("prod-iad", nil)
+			},
+
+			wantedSvcName:  "frontend",
+			wantedEnvName:  "prod-iad",
+			wantedImageTag: "latest",
+		},
+		"prompts for a single environment if the user doesn't opt into multiple environments": {
+			inAppName:  "phonetool",
+			inSvcName:  "frontend",
+			inImageTag: "latest",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod-iad"}}, nil)
+				prompt.EXPECT().Confirm(svcDeployMultiEnvPrompt, svcDeployMultiEnvHelpPrompt).Return(false, nil)
+				sel.EXPECT().Environment("Select an environment", "", "phonetool").Return("prod-iad", nil)
 			},
 
 			wantedSvcName:  "frontend",
 			wantedEnvName:  "prod-iad",
 			wantedImageTag: "latest",
 		},
+		"prompts for multiple environments": {
+			inAppName:  "phonetool",
+			inSvcName:  "frontend",
+			inImageTag: "latest",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod-iad"}}, nil)
+				prompt.EXPECT().Confirm(svcDeployMultiEnvPrompt, svcDeployMultiEnvHelpPrompt).Return(true, nil)
+				sel.EXPECT().Environments(svcDeployEnvsPrompt, "", "phonetool").Return([]string{"test", "prod-iad"}, nil)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedSvcName:  "frontend",
+			wantedEnvNames: []string{"test", "prod-iad"},
+			wantedImageTag: "latest",
+		},
+		"wraps error if environments can't be listed": {
+			inAppName:  "phonetool",
+			inSvcName:  "frontend",
+			inImageTag: "latest",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list environments for application phonetool: some error"),
+		},
 		"don't call selector if flags are provided": {
 			inAppName:  "phonetool",
 			inEnvName:  "prod-iad",
 			inSvcName:  "frontend",
 			inImageTag: "latest",
-			wantedCalls: func(m *mocks.MockwsSelector) {
-				m.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
-				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				sel.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedSvcName:  "frontend",
+			wantedEnvName:  "prod-iad",
+			wantedImageTag: "latest",
+		},
+		"don't call selector if multiple environments are provided": {
+			inAppName:  "phonetool",
+			inEnvNames: []string{"test", "prod-iad"},
+			inSvcName:  "frontend",
+			inImageTag: "latest",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				sel.EXPECT().Environments(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedSvcName:  "frontend",
+			wantedEnvNames: []string{"test", "prod-iad"},
+			wantedImageTag: "latest",
+		},
+		"derives the image tag from the git repository if it isn't provided": {
+			inAppName:   "phonetool",
+			inEnvName:   "prod-iad",
+			inSvcName:   "frontend",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always", "--dirty"}, gomock.Any()).
+					DoAndReturn(writeStdout("v1.2.0-3-gbb133e7-dirty\n", nil))
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).
+					DoAndReturn(writeStdout("bb133e7\n", nil))
+			},
+
+			wantedSvcName:   "frontend",
+			wantedEnvName:   "prod-iad",
+			wantedImageTag:  "v1.2.0-3-gbb133e7-dirty",
+			wantedCommitTag: "bb133e7",
+		},
+		"errors if the image tag isn't provided outside of a git repository": {
+			inAppName:   "phonetool",
+			inEnvName:   "prod-iad",
+			inSvcName:   "frontend",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always", "--dirty"}, gomock.Any()).
+					DoAndReturn(writeStdout("", errors.New("exit status 128")))
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).Times(0)
+			},
+
+			wantedError: errors.New(`couldn't derive an image tag from a git repository: provide one with --tag or pass --allow-latest to use the "latest" tag`),
+		},
+		"uses the latest image tag outside of a git repository if allowed": {
+			inAppName:     "phonetool",
+			inEnvName:     "prod-iad",
+			inSvcName:     "frontend",
+			inAllowLatest: true,
+			wantedCalls:   func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always", "--dirty"}, gomock.Any()).
+					DoAndReturn(writeStdout("", errors.New("exit status 128")))
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).
+					DoAndReturn(writeStdout("", errors.New("exit status 128")))
 			},
 
 			wantedSvcName:  "frontend",
 			wantedEnvName:  "prod-iad",
 			wantedImageTag: "latest",
 		},
+		"uses the provided image tag and the commit of the git repository": {
+			inAppName:   "phonetool",
+			inEnvName:   "prod-iad",
+			inSvcName:   "frontend",
+			inImageTag:  "v1.2.0",
+			wantedCalls: func(sel *mocks.MockwsSelector, store *mocks.Mockstore, prompt *mocks.Mockprompter) {},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always", "--dirty"}, gomock.Any()).Times(0)
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).
+					DoAndReturn(writeStdout("bb133e7\n", nil))
+			},
+
+			wantedSvcName:   "frontend",
+			wantedEnvName:   "prod-iad",
+			wantedImageTag:  "v1.2.0",
+			wantedCommitTag: "bb133e7",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -168,16 +414,29 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockRunner := mocks.NewMockrunner(ctrl)
 
-			tc.wantedCalls(mockSel)
+			tc.wantedCalls(mockSel, mockStore, mockPrompt)
+			if tc.mockRunner != nil {
+				tc.mockRunner(mockRunner)
+			} else {
+				mockRunner.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("exit status 128")).AnyTimes()
+			}
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName:  tc.inAppName,
-					name:     tc.inSvcName,
-					envName:  tc.inEnvName,
-					imageTag: tc.inImageTag,
+					appName:     tc.inAppName,
+					name:        tc.inSvcName,
+					envName:     tc.inEnvName,
+					envNames:    tc.inEnvNames,
+					imageTag:    tc.inImageTag,
+					allowLatest: tc.inAllowLatest,
 				},
-				sel: mockSel,
+				sel:    mockSel,
+				store:  mockStore,
+				prompt: mockPrompt,
+				cmd:    mockRunner,
 			}
 
 			// WHEN
@@ -188,7 +447,9 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedSvcName, opts.name)
 				require.Equal(t, tc.wantedEnvName, opts.envName)
+				require.Equal(t, tc.wantedEnvNames, opts.envNames)
 				require.Equal(t, tc.wantedImageTag, opts.imageTag)
+				require.Equal(t, tc.wantedCommitTag, opts.commitImageTag)
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
@@ -196,6 +457,27 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 	}
 }
 
+func TestDeploySummaryString(t *testing.T) {
+	// GIVEN
+	results := []deployResult{
+		{name: "test", status: deployStatusDeployed},
+		{name: "staging", status: deployStatusFailed},
+		{name: "prod", status: deployStatusSkipped},
+	}
+
+	// WHEN
+	got := deploySummaryString("Environment", results)
+
+	// THEN
+	require.Equal(t, `Deployments
+
+  Environment       Status
+  test              deployed
+  staging           failed
+  prod              skipped
+`, got)
+}
+
 func TestSvcDeployOpts_configureContainerImage(t *testing.T) {
 	mockError := errors.New("mockError")
 	mockManifest := []byte(`name: serviceA
@@ -214,18 +496,53 @@ image:
 type: 'Load Balanced Web Service'
 image:
   build: path/to/Dockerfile
+`)
+	mockMftInvalidRetention := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  retention: 0
 `)
 	mockMftNoContext := []byte(`name: serviceA
 type: 'Load Balanced Web Service'
 image:
   build:
     dockerfile: path/to/Dockerfile`)
+	mockMftPlatform := []byte(fmt.Sprintf(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+platform: linux/%s
+`, runtime.GOARCH))
+	mockMftInvalidPlatform := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+platform: linux/386
+`)
+	mockMftSidecarBuild := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  location: foo/bar
+sidecars:
+  xray:
+    image: 123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon
+  nginx:
+    image:
+      build: nginx/Dockerfile
+`)
 
 	tests := map[string]struct {
 		inputSvc   string
+		inputTag   string
+		inForce    bool
 		setupMocks func(mocks deploySvcMocks)
 
-		wantErr error
+		inCommitTag string
+
+		wantErr              error
+		wantSidecarImageTags map[string]string
+		wantImageDigest      string
 	}{
 		"should return error if ws ReadFile returns error": {
 			inputSvc: "serviceA",
@@ -246,6 +563,16 @@ image:
 			},
 			wantErr: fmt.Errorf("get copilot directory: %w", mockError),
 		},
+		"should return error if the image retention is invalid": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftInvalidRetention, nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: fmt.Errorf("image.retention 0 must keep at least one image"),
+		},
 		"success without building and pushing": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -277,8 +604,47 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path"),
 					}).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "").Return("sha256:abc", nil),
+				)
+			},
+			wantImageDigest: "sha256:abc",
+		},
+		"success tagging the image with the commit": {
+			inputSvc:    "serviceA",
+			inputTag:    "v1.2.0-3-gbb133e7",
+			inCommitTag: "bb133e7",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &docker.BuildArguments{
+						Dockerfile:     filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:        filepath.Join("/ws", "root", "path"),
+						ImageTag:       "v1.2.0-3-gbb133e7",
+						AdditionalTags: []string{"bb133e7"},
+					}).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "v1.2.0-3-gbb133e7").Return("sha256:abc", nil),
+				)
+			},
+			wantImageDigest: "sha256:abc",
+		},
+		"does not tag the image twice if the tag is the commit": {
+			inputSvc:    "serviceA",
+			inputTag:    "bb133e7",
+			inCommitTag: "bb133e7",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &docker.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+						ImageTag:   "bb133e7",
+					}).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "bb133e7").Return("sha256:abc", nil),
 				)
 			},
+			wantImageDigest: "sha256:abc",
 		},
 		"using simple buildstring (backwards compatible)": {
 			inputSvc: "serviceA",
@@ -290,6 +656,7 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
 					}).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "").Return("sha256:abc", nil),
 				)
 			},
 		},
@@ -303,8 +670,89 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
 					}).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "").Return("sha256:abc", nil),
+				)
+			},
+		},
+		"should return error if fail to get the digest of the pushed image": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "").Return("", mockError),
+				)
+			},
+			wantErr: fmt.Errorf("get digest of the pushed image: mockError"),
+		},
+		"skip getting the digest of the pushed image if the deployment is forced": {
+			inputSvc: "serviceA",
+			inForce:  true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+		},
+		"should return error if the platform is invalid": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftInvalidPlatform, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: fmt.Errorf("validate the platform of serviceA: platform linux/386 must be one of linux/amd64, linux/arm64, windows/amd64"),
+		},
+		"success building for the platform": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftPlatform, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &docker.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Platform:   "linux/" + runtime.GOARCH,
+					}).Return(nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "").Return("sha256:abc", nil),
+				)
+			},
+		},
+		"should return error if fail to build and push sidecar image": {
+			inputSvc: "serviceA",
+			inputTag: "v1",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftSidecarBuild, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(mockError),
+				)
+			},
+			wantErr: fmt.Errorf("build and push image for sidecar nginx: mockError"),
+		},
+		"success building and pushing only the sidecars with a build field": {
+			inputSvc: "serviceA",
+			inputTag: "v1",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftSidecarBuild, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &docker.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "nginx", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "nginx"),
+						ImageTag:   "v1-nginx",
+					}).Return(nil),
 				)
 			},
+			wantSidecarImageTags: map[string]string{
+				"nginx": "v1-nginx",
+			},
 		},
 	}
 
@@ -315,18 +763,28 @@ image:
 
 			mockWorkspace := mocks.NewMockwsSvcDirReader(ctrl)
 			mockimageBuilderPusher := mocks.NewMockimageBuilderPusher(ctrl)
+			mockImageDigests := mocks.NewMockimageDigestGetter(ctrl)
+			mockContextSizer := mocks.NewMockbuildContextSizer(ctrl)
+			mockContextSizer.EXPECT().BuildContextSize(gomock.Any()).Return(&docker.ContextSize{Bytes: 1024}, nil).AnyTimes()
 			mocks := deploySvcMocks{
 				mockWs:                 mockWorkspace,
 				mockimageBuilderPusher: mockimageBuilderPusher,
+				mockImageDigests:       mockImageDigests,
 			}
 			test.setupMocks(mocks)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputSvc,
+					appName:     "phonetool",
+					name:        test.inputSvc,
+					imageTag:    test.inputTag,
+					forceUpdate: test.inForce,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
+				contextSizer:       mockContextSizer,
+				imageDigests:       mockImageDigests,
 				ws:                 mockWorkspace,
+				commitImageTag:     test.inCommitTag,
 			}
 
 			gotErr := opts.configureContainerImage()
@@ -335,7 +793,843 @@ image:
 				require.EqualError(t, gotErr, test.wantErr.Error())
 			} else {
 				require.Nil(t, gotErr)
+				if test.wantSidecarImageTags != nil {
+					require.Equal(t, test.wantSidecarImageTags, opts.sidecarImageTags)
+				}
+				require.Equal(t, test.wantImageDigest, opts.imageDigest)
+			}
+		})
+	}
+}
+
+func TestWarnIfPlatformNotBuildable(t *testing.T) {
+	otherArch := "arm64"
+	if runtime.GOARCH == "arm64" {
+		otherArch = "amd64"
+	}
+	testCases := map[string]struct {
+		inPlatform string
+		setupMocks func(m *mocks.MockbuilderPlatformsLister)
+	}{
+		"does not inspect the builder without a platform": {
+			setupMocks: func(m *mocks.MockbuilderPlatformsLister) {
+				m.EXPECT().BuilderPlatforms().Times(0)
+			},
+		},
+		"does not inspect the builder if the platform has the host's architecture": {
+			inPlatform: "linux/" + runtime.GOARCH,
+			setupMocks: func(m *mocks.MockbuilderPlatformsLister) {
+				m.EXPECT().BuilderPlatforms().Times(0)
+			},
+		},
+		"inspects the builder if the platform has a different architecture": {
+			inPlatform: "linux/" + otherArch,
+			setupMocks: func(m *mocks.MockbuilderPlatformsLister) {
+				m.EXPECT().BuilderPlatforms().Return([]string{"linux/amd64", "linux/arm64"}, nil)
+			},
+		},
+		"tolerates builders that can't be inspected": {
+			inPlatform: "linux/" + otherArch,
+			setupMocks: func(m *mocks.MockbuilderPlatformsLister) {
+				m.EXPECT().BuilderPlatforms().Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockbuilderPlatformsLister(ctrl)
+			tc.setupMocks(m)
+
+			warnIfPlatformNotBuildable(m, tc.inPlatform)
+		})
+	}
+}
+
+func TestCheckBuildContextSize(t *testing.T) {
+	largestDirs := []docker.DirSize{
+		{Path: "node_modules", Bytes: 300 * bytesPerMB},
+		{Path: "static", Bytes: 15 * bytesPerMB},
+	}
+	testCases := map[string]struct {
+		inMaxMB    int
+		setupMocks func(m *mocks.MockbuildContextSizer)
+
+		wantedErr error
+	}{
+		"returns a wrapped error if the size can't be computed": {
+			setupMocks: func(m *mocks.MockbuildContextSizer) {
+				m.EXPECT().BuildContextSize("path").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get size of build context path: some error"),
+		},
+		"only warns about a large context without a maximum": {
+			setupMocks: func(m *mocks.MockbuildContextSizer) {
+				m.EXPECT().BuildContextSize("path").Return(&docker.ContextSize{
+					Bytes:       320 * bytesPerMB,
+					LargestDirs: largestDirs,
+				}, nil)
+			},
+		},
+		"succeeds if the context is within the maximum": {
+			inMaxMB: 500,
+			setupMocks: func(m *mocks.MockbuildContextSizer) {
+				m.EXPECT().BuildContextSize("path").Return(&docker.ContextSize{
+					Bytes:       320 * bytesPerMB,
+					LargestDirs: largestDirs,
+				}, nil)
+			},
+		},
+		"returns an error if the context exceeds the maximum": {
+			inMaxMB: 100,
+			setupMocks: func(m *mocks.MockbuildContextSizer) {
+				m.EXPECT().BuildContextSize("path").Return(&docker.ContextSize{
+					Bytes:       320 * bytesPerMB,
+					LargestDirs: largestDirs,
+				}, nil)
+			},
+			wantedErr: errors.New("build context path is 320 MB, which exceeds the maximum of 100 MB: exclude files with a .dockerignore file in the context directory, the largest directories are node_modules (300 MB), static (15 MB)"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockbuildContextSizer(ctrl)
+			tc.setupMocks(m)
+
+			err := checkBuildContextSize(m, &docker.BuildArguments{
+				Dockerfile: "path/to/Dockerfile",
+				Context:    "path",
+			}, tc.inMaxMB)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWarnIfEnvNewerThanCLI(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv        *config.Environment
+		inCLIVersion string
+
+		wantedWarning bool
+	}{
+		"environment stored without the version": {
+			inEnv:        &config.Environment{Name: "test"},
+			inCLIVersion: "v1.2.0",
+		},
+		"development build": {
+			inEnv:        &config.Environment{Name: "test", CreatedByVersion: "v1.2.0"},
+			inCLIVersion: "",
+		},
+		"environment created by the same version": {
+			inEnv:        &config.Environment{Name: "test", CreatedByVersion: "v1.2.0", LastUpdatedByVersion: "v1.2.0"},
+			inCLIVersion: "v1.2.0",
+		},
+		"environment created by an older version": {
+			inEnv:        &config.Environment{Name: "test", CreatedByVersion: "v1.0.0"},
+			inCLIVersion: "v1.2.0",
+		},
+		"environment upgraded by a newer version": {
+			inEnv:         &config.Environment{Name: "test", CreatedByVersion: "v1.0.0", LastUpdatedByVersion: "v1.3.0"},
+			inCLIVersion:  "v1.2.0",
+			wantedWarning: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			defaultWriter := log.DiagnosticWriter
+			defer func() { log.DiagnosticWriter = defaultWriter }()
+			log.DiagnosticWriter = b
+
+			warnIfEnvNewerThanCLI(tc.inEnv, tc.inCLIVersion)
+
+			if tc.wantedWarning {
+				require.Contains(t, b.String(), "Environment test was last updated by copilot v1.3.0, but you are running copilot v1.2.0.")
+			} else {
+				require.Empty(t, b.String())
+			}
+		})
+	}
+}
+
+func TestValidateUniqueTopics(t *testing.T) {
+	manifests := map[string]interface{}{
+		"orders": &manifest.BackendService{
+			BackendServiceConfig: manifest.BackendServiceConfig{
+				Messaging: manifest.Messaging{
+					Publish: []string{"orders"},
+				},
+			},
+		},
+		"reports": &manifest.ScheduledJob{
+			ScheduledJobConfig: manifest.ScheduledJobConfig{
+				Messaging: manifest.Messaging{
+					Publish: []string{"reports"},
+				},
+			},
+		},
+	}
+	unmarshal := func(in []byte) (interface{}, error) {
+		return manifests[string(in)], nil
+	}
+	testCases := map[string]struct {
+		inName     string
+		inManifest interface{}
+		mockWs     func(m *mocks.MockwsWlManifestReader)
+
+		wantedErr error
+	}{
+		"skips the workspace if the workload doesn't publish topics": {
+			inName:     "frontend",
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockWs:     func(m *mocks.MockwsWlManifestReader) {},
+		},
+		"wraps error if fail to list the services": {
+			inName:     "orders",
+			inManifest: manifests["orders"],
+			mockWs: func(m *mocks.MockwsWlManifestReader) {
+				m.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in the workspace: some error"),
+		},
+		"errors if another workload publishes the same topic": {
+			inName: "frontend",
+			inManifest: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					Messaging: manifest.Messaging{
+						Publish: []string{"reports"},
+					},
+				},
+			},
+			mockWs: func(m *mocks.MockwsWlManifestReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "orders"}, nil)
+				m.EXPECT().JobNames().Return([]string{"reports"}, nil)
+				m.EXPECT().ReadServiceManifest("orders").Return([]byte("orders"), nil)
+				m.EXPECT().ReadJobManifest("reports").Return([]byte("reports"), nil)
+			},
+			wantedErr: errors.New(`topic "reports" is also published by reports, topic names must be unique within the application`),
+		},
+		"succeeds if the topics are only published by the workload": {
+			inName:     "orders",
+			inManifest: manifests["orders"],
+			mockWs: func(m *mocks.MockwsWlManifestReader) {
+				m.EXPECT().ServiceNames().Return([]string{"orders"}, nil)
+				m.EXPECT().JobNames().Return([]string{"reports"}, nil)
+				m.EXPECT().ReadJobManifest("reports").Return([]byte("reports"), nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsWlManifestReader(ctrl)
+			tc.mockWs(m)
+
+			// WHEN
+			err := validateUniqueTopics(m, tc.inName, tc.inManifest, unmarshal)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_warnIfRollbackAlarmsNotFound(t *testing.T) {
+	mftWithAlarms := &manifest.BackendService{
+		BackendServiceConfig: manifest.BackendServiceConfig{
+			Deployment: manifest.DeploymentConfig{
+				RollbackAlarms: []string{"high-cpu", "high-memory"},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inManifest interface{}
+		setupMocks func(m *mocks.MockalarmStatusGetter)
+	}{
+		"does not look up alarms if the service has none": {
+			inManifest: &manifest.BackendService{},
+			setupMocks: func(m *mocks.MockalarmStatusGetter) {
+				m.EXPECT().AlarmStatus(gomock.Any()).Times(0)
+			},
+		},
+		"looks up the rollback alarms": {
+			inManifest: mftWithAlarms,
+			setupMocks: func(m *mocks.MockalarmStatusGetter) {
+				m.EXPECT().AlarmStatus([]string{"high-cpu", "high-memory"}).Return([]cloudwatch.AlarmStatus{{Name: "high-cpu"}}, nil)
+			},
+		},
+		"tolerates alarms that can't be looked up": {
+			inManifest: mftWithAlarms,
+			setupMocks: func(m *mocks.MockalarmStatusGetter) {
+				m.EXPECT().AlarmStatus(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockalarmStatusGetter(ctrl)
+			tc.setupMocks(m)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name: "frontend",
+				},
+				alarms:            m,
+				targetEnvironment: &config.Environment{Name: "test"},
+			}
+
+			opts.warnIfRollbackAlarmsNotFound(tc.inManifest)
+		})
+	}
+}
+
+func TestSvcDeployOpts_deploySvc(t *testing.T) {
+	const addonsURL = "https://mybucket.s3.us-west-2.amazonaws.com/addons.yml"
+	testCases := map[string]struct {
+		inForce         bool
+		inNoWait        bool
+		inBuildRequired bool
+
+		mockSpinner func(m *mocks.Mockprogress)
+		deployOut   *copilot.DeployServiceOutput
+		deployErr   error
+
+		wantedInput    copilot.DeployServiceInput
+		wantedDeployed bool
+		wantedErr      error
+	}{
+		"wraps error if fail to deploy the service": {
+			mockSpinner: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+			deployErr: errors.New("some error"),
+
+			wantedErr: errors.New("some error"),
+		},
+		"returns false if the stack wouldn't change": {
+			mockSpinner: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop("")
+			},
+			deployOut: &copilot.DeployServiceOutput{},
+
+			wantedDeployed: false,
+		},
+		"deploys the pushed image with the deployment options": {
+			inForce:         true,
+			inNoWait:        true,
+			inBuildRequired: true,
+			mockSpinner: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop("\n\n")
+			},
+			deployOut: &copilot.DeployServiceOutput{
+				Deployed: true,
+			},
+
+			wantedInput: copilot.DeployServiceInput{
+				ImageTag: "v1.0",
+				Force:    true,
+				NoWait:   true,
+			},
+			wantedDeployed: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockWs.EXPECT().ReadServiceManifest("frontend").Return([]byte("name: frontend"), nil)
+			mockSessProvider := mocks.NewMocksessionProvider(ctrl)
+			sess := &session.Session{}
+			mockSessProvider.EXPECT().Default().Return(sess, nil)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.mockSpinner(mockSpinner)
+
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:     "phonetool",
+					name:        "frontend",
+					imageTag:    "v1.0",
+					forceUpdate: tc.inForce,
+					noWait:      tc.inNoWait,
+				},
+				ws: mockWs,
+				unmarshal: func(in []byte) (interface{}, error) {
+					return &manifest.BackendService{}, nil
+				},
+				sessProvider: mockSessProvider,
+				spinner:      mockSpinner,
+				deployService: func(_ context.Context, in copilot.DeployServiceInput) (*copilot.DeployServiceOutput, error) {
+					require.Equal(t, "phonetool", in.App)
+					require.Equal(t, "test", in.Env)
+					require.Equal(t, "frontend", in.Name)
+					require.Equal(t, []byte("name: frontend"), in.Manifest)
+					require.Equal(t, addonsURL, in.AddonsTemplateURL)
+					require.Equal(t, sess, in.Session)
+					require.Equal(t, tc.wantedInput.ImageTag, in.ImageTag)
+					require.Equal(t, tc.wantedInput.Force, in.Force)
+					require.Equal(t, tc.wantedInput.NoWait, in.NoWait)
+					return tc.deployOut, tc.deployErr
+				},
+				targetEnvironment: &config.Environment{
+					Name: "test",
+				},
+				buildRequired: tc.inBuildRequired,
+			}
+
+			// WHEN
+			deployed, err := opts.deploySvc(addonsURL)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployed, deployed)
+		})
+	}
+}
+
+func TestSvcDeployOpts_retainImages(t *testing.T) {
+	const repoName = "phonetool/frontend"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inRetention     int
+		inBuildRequired bool
+		inSidecarTags   map[string]string
+
+		mockRetainer    func(m *mocks.MockimageRetainer)
+		mockDeployStore func(m *mocks.MockdeployedEnvironmentLister)
+		mockStore       func(m *mocks.Mockstore)
+		deployedImages  map[string][]describe.DeployedImage
+
+		wantedErr error
+	}{
+		"skips if the manifest does not set a retention": {
+			inBuildRequired: true,
+			mockRetainer:    func(m *mocks.MockimageRetainer) {},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+		},
+		"skips if no image is pushed to the repository": {
+			inRetention:     10,
+			mockRetainer:    func(m *mocks.MockimageRetainer) {},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+		},
+		"wraps errors tagging the deployed image": {
+			inRetention:     10,
+			inBuildRequired: true,
+			mockRetainer: func(m *mocks.MockimageRetainer) {
+				m.EXPECT().TagImage(repoName, ecr.Image{Tag: "v2"}, "copilot-deployed-test-frontend").Return(mockError)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+			wantedErr:       errors.New("tag image deployed to environment test: some error"),
+		},
+		"wraps errors putting the lifecycle policy": {
+			inRetention:     10,
+			inBuildRequired: true,
+			mockRetainer: func(m *mocks.MockimageRetainer) {
+				m.EXPECT().TagImage(repoName, ecr.Image{Tag: "v2"}, "copilot-deployed-test-frontend").Return(nil)
+				m.EXPECT().SetImageRetention(repoName, 10).Return(mockError)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListEnvironmentsDeployedTo("phonetool", "frontend").Return([]string{"test"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+			wantedErr: errors.New("keep the 10 most recent images of repository phonetool/frontend: some error"),
+		},
+		"protects the images deployed to the environments in the same region": {
+			inRetention:     10,
+			inBuildRequired: true,
+			inSidecarTags: map[string]string{
+				"nginx": "v2-nginx",
+			},
+			mockRetainer: func(m *mocks.MockimageRetainer) {
+				m.EXPECT().TagImage(repoName, ecr.Image{Tag: "v2"}, "copilot-deployed-test-frontend").Return(nil)
+				m.EXPECT().TagImage(repoName, ecr.Image{Tag: "v2-nginx"}, "copilot-deployed-test-nginx").Return(nil)
+				m.EXPECT().TagImage(repoName, ecr.Image{Digest: "sha256:1234"}, "copilot-deployed-prod-frontend").Return(nil)
+				m.EXPECT().SetImageRetention(repoName, 10).Return(nil)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListEnvironmentsDeployedTo("phonetool", "frontend").Return([]string{"test", "prod", "eu"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod", Region: "us-west-2"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "eu").Return(&config.Environment{Name: "eu", Region: "eu-west-1"}, nil)
+			},
+			deployedImages: map[string][]describe.DeployedImage{
+				"prod": {
+					{
+						Container: "frontend",
+						URI:       "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1",
+						Digest:    "sha256:1234",
+					},
+					{
+						Container: "xray",
+						URI:       "amazon/aws-xray-daemon",
+						Digest:    "sha256:5678",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRetainer := mocks.NewMockimageRetainer(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockRetainer(mockRetainer)
+			tc.mockDeployStore(mockDeployStore)
+			tc.mockStore(mockStore)
+
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:  "phonetool",
+					name:     "frontend",
+					imageTag: "v2",
+				},
+				store:         mockStore,
+				deployStore:   mockDeployStore,
+				imageRetainer: mockRetainer,
+				deployedImages: func(env string) ([]describe.DeployedImage, error) {
+					return tc.deployedImages[env], nil
+				},
+				targetEnvironment: &config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				},
+				buildRequired:    tc.inBuildRequired,
+				imageRetention:   tc.inRetention,
+				sidecarImageTags: tc.inSidecarTags,
+			}
+
+			// WHEN
+			err := opts.retainImages()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcDeployOpts_imageTagToDeploy(t *testing.T) {
+	const deployedURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1"
+	testCases := map[string]struct {
+		inImageDigest string
+
+		mockDeployStore func(m *mocks.MockdeployedEnvironmentLister)
+		deployedImages  []describe.DeployedImage
+		imagesErr       error
+
+		wantedTag string
+		wantedErr error
+	}{
+		"returns the pushed tag if the deployment is forced": {
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+
+			wantedTag: "v2",
+		},
+		"returns the pushed tag if the service isn't deployed to the environment": {
+			inImageDigest: "sha256:1234",
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(false, nil)
+			},
+
+			wantedTag: "v2",
+		},
+		"returns the deployed tag if the deployed image has the same digest": {
+			inImageDigest: "sha256:1234",
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+			},
+			deployedImages: []describe.DeployedImage{
+				{
+					Container: "xray",
+					URI:       "amazon/aws-xray-daemon",
+					Digest:    "sha256:5678",
+				},
+				{
+					Container: "frontend",
+					URI:       deployedURI,
+					Digest:    "sha256:1234",
+				},
+			},
+
+			wantedTag: "v1",
+		},
+		"returns the pushed tag if the deployed image has another digest": {
+			inImageDigest: "sha256:1234",
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+			},
+			deployedImages: []describe.DeployedImage{
+				{
+					Container: "frontend",
+					URI:       deployedURI,
+					Digest:    "sha256:abcd",
+				},
+			},
+
+			wantedTag: "v2",
+		},
+		"wraps the error checking if the service is deployed": {
+			inImageDigest: "sha256:1234",
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(false, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("check if service frontend is deployed to environment test: some error"),
+		},
+		"wraps the error getting the deployed images": {
+			inImageDigest: "sha256:1234",
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+			},
+			imagesErr: errors.New("some error"),
+
+			wantedErr: errors.New("get images deployed to environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			tc.mockDeployStore(mockDeployStore)
+
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:  "phonetool",
+					name:     "frontend",
+					imageTag: "v2",
+				},
+				deployStore: mockDeployStore,
+				deployedImages: func(env string) ([]describe.DeployedImage, error) {
+					require.Equal(t, "test", env)
+					return tc.deployedImages, tc.imagesErr
+				},
+				targetEnvironment: &config.Environment{
+					Name: "test",
+				},
+				imageDigest: tc.inImageDigest,
+			}
+
+			// WHEN
+			tag, err := opts.imageTagToDeploy()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTag, tag)
+		})
+	}
+}
+
+func TestImageURITag(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"ECR image with a tag": {
+			in:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1",
+			wanted: "v1",
+		},
+		"image in a registry with a port": {
+			in:     "localhost:5000/frontend",
+			wanted: "",
+		},
+		"image with a digest": {
+			in:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend@sha256:1234",
+			wanted: "",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, imageURITag(tc.in))
+		})
+	}
+}
+
+func TestPropagatingRegistry_RepositoryURI(t *testing.T) {
+	const mockRepo = "phonetool/frontend"
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockimageRegistry)
+
+		wantedURI string
+		wantedErr error
+	}{
+		"returns the URI once the repository is found": {
+			mockRegistry: func(m *mocks.MockimageRegistry) {
+				gomock.InOrder(
+					m.EXPECT().RepositoryURI(mockRepo).Return("", &ecr.ErrRepositoryNotFound{}),
+					m.EXPECT().RepositoryURI(mockRepo).Return("mockURI", nil),
+				)
+			},
+			wantedURI: "mockURI",
+		},
+		"doesn't retry other errors": {
+			mockRegistry: func(m *mocks.MockimageRegistry) {
+				m.EXPECT().RepositoryURI(mockRepo).Return("", errors.New("some error")).Times(1)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"points to env init if the repository is still not found": {
+			mockRegistry: func(m *mocks.MockimageRegistry) {
+				m.EXPECT().RepositoryURI(mockRepo).Return("", &ecr.ErrRepositoryNotFound{}).Times(3)
+			},
+			wantedErr: fmt.Errorf("%w: the application's resources in region us-west-2 may still be propagating after `copilot env init`, try again in a few minutes",
+				&ecr.ErrRepositoryNotFound{}),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockimageRegistry(ctrl)
+			tc.mockRegistry(m)
+
+			defaultAttempts, defaultInterval := repoLookupAttempts, repoLookupInterval
+			defer func() {
+				repoLookupAttempts, repoLookupInterval = defaultAttempts, defaultInterval
+			}()
+			repoLookupAttempts, repoLookupInterval = 3, 0
+
+			registry := &propagatingRegistry{imageRegistry: m, region: "us-west-2"}
+
+			// WHEN
+			uri, err := registry.RepositoryURI(mockRepo)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedURI, uri)
+		})
+	}
+}
+
+func TestSvcDeployOpts_showSvcOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		inJSON  bool
+		inQuiet bool
+
+		outputs    *describe.ServiceOutputs
+		outputsErr error
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wraps error if fail to get the outputs": {
+			outputsErr: errors.New("some error"),
+
+			wantedErr: errors.New("get outputs of service frontend in environment test: some error"),
+		},
+		"writes the outputs in json format": {
+			inJSON: true,
+			outputs: &describe.ServiceOutputs{
+				Environment: "test",
+				URL:         "https://frontend.test.phonetool.com",
+				Addons: map[string]string{
+					"MyBucketName": "phonetool-test-frontend-mybucket",
+				},
+			},
+
+			wantedOutput: "{\"environment\":\"test\",\"url\":\"https://frontend.test.phonetool.com\",\"addons\":{\"MyBucketName\":\"phonetool-test-frontend-mybucket\"}}\n",
+		},
+		"does not write to stdout without json format": {
+			outputs: &describe.ServiceOutputs{
+				Environment: "test",
+				URL:         "https://frontend.test.phonetool.com",
+			},
+		},
+		"writes only the url to stdout if messages are suppressed": {
+			inQuiet: true,
+			outputs: &describe.ServiceOutputs{
+				Environment:      "test",
+				URL:              "https://frontend.test.phonetool.com",
+				ServiceDiscovery: "frontend.test.phonetool.local:80",
+			},
+
+			wantedOutput: "https://frontend.test.phonetool.com\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			log.SetQuiet(tc.inQuiet)
+			defer log.SetQuiet(false)
+			b := &bytes.Buffer{}
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:          "phonetool",
+					name:             "frontend",
+					shouldOutputJSON: tc.inJSON,
+				},
+				svcOutputs: func(env string) (*describe.ServiceOutputs, error) {
+					require.Equal(t, "test", env)
+					return tc.outputs, tc.outputsErr
+				},
+				targetEnvironment: &config.Environment{
+					Name: "test",
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.showSvcOutputs()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
 		})
 	}
 }
@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDeploymentsAppNamePrompt     = "Which application is the service in?"
+	svcDeploymentsAppNameHelpPrompt = "An application groups all of your services and jobs together."
+	svcDeploymentsNamePrompt        = "Which service's deployments would you like to show?"
+	svcDeploymentsNameHelpPrompt    = "Displays the most recent deployments of the service with their image and who deployed them."
+	svcDeploymentsEnvNamePrompt     = "Which environment is the service deployed to?"
+	svcDeploymentsEnvNameHelpPrompt = "The deployments of the service to this environment are displayed."
+
+	defaultSvcDeploymentsLimit = 10
+)
+
+type svcDeploymentsVars struct {
+	shouldOutputJSON bool
+	name             string
+	envName          string
+	appName          string
+	limit            int
+}
+
+type svcDeploymentsOpts struct {
+	svcDeploymentsVars
+
+	w                        io.Writer
+	store                    store
+	sel                      configSelector
+	deploymentsDescriber     serviceDeploymentsDescriber
+	initDeploymentsDescriber func() error // Overriden in tests.
+}
+
+func newSvcDeploymentsOpts(vars svcDeploymentsVars) (*svcDeploymentsOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	opts := &svcDeploymentsOpts{
+		svcDeploymentsVars: vars,
+		store:              configStore,
+		w:                  log.OutputWriter,
+		sel:                selector.NewConfigSelect(prompt.New(), configStore),
+	}
+	opts.initDeploymentsDescriber = func() error {
+		d, err := describe.NewServiceDeploymentsDescriber(describe.NewServiceDeploymentsConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.name,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create deployments describer for service %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		opts.deploymentsDescriber = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcDeploymentsOpts) Validate() error {
+	if o.limit <= 0 {
+		return errors.New("--limit must be greater than 0")
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcDeploymentsOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute displays the most recent deployments of the service.
+func (o *svcDeploymentsOpts) Execute() error {
+	if err := o.initDeploymentsDescriber(); err != nil {
+		return err
+	}
+	deployments, err := o.deploymentsDescriber.Deployments(o.limit)
+	if err != nil {
+		return fmt.Errorf("describe deployments of service %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := deployments.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, deployments.HumanString())
+	return nil
+}
+
+func (o *svcDeploymentsOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcDeploymentsAppNamePrompt, svcDeploymentsAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDeploymentsOpts) askSvcName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Service(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select service for application %s: %w", o.appName, err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *svcDeploymentsOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(svcDeploymentsEnvNamePrompt, svcDeploymentsEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildSvcDeploymentsCmd builds the command for showing the deployment history of a service.
+func buildSvcDeploymentsCmd() *cobra.Command {
+	vars := svcDeploymentsVars{}
+	cmd := &cobra.Command{
+		Use:   "deployments",
+		Short: "Shows the recent deployments of a service.",
+		Long: `Shows the recent deployments of a service to an environment, newest first.
+Each deployment is displayed with its time, image, git commit, template hash and the identity that deployed it.`,
+
+		Example: `
+  Shows the 10 most recent deployments of the service "frontend" to the "prod" environment.
+  /code $ copilot svc deployments -n frontend -e prod
+  Shows the 50 most recent deployments in JSON.
+  /code $ copilot svc deployments -n frontend -e prod --limit 50 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDeploymentsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, defaultSvcDeploymentsLimit, svcDeploymentsLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDeployments_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp   string
+		inSvc   string
+		inEnv   string
+		inLimit int
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid flags": {
+			inApp:   "my-app",
+			inSvc:   "frontend",
+			inEnv:   "test",
+			inLimit: 10,
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.EXPECT().GetService("my-app", "frontend").Return(nil, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, nil)
+			},
+		},
+		"invalid limit": {
+			inLimit: -1,
+
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--limit must be greater than 0"),
+		},
+		"invalid service name": {
+			inApp:   "my-app",
+			inSvc:   "frontend",
+			inLimit: 10,
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.EXPECT().GetService("my-app", "frontend").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					appName: tc.inApp,
+					name:    tc.inSvc,
+					envName: tc.inEnv,
+					limit:   tc.inLimit,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcDeployments_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inSvc string
+		inEnv string
+
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"prompts for all fields": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(svcDeploymentsAppNamePrompt, svcDeploymentsAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().Service(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, "my-app").Return("frontend", nil)
+				m.EXPECT().Environment(svcDeploymentsEnvNamePrompt, svcDeploymentsEnvNameHelpPrompt, "my-app").Return("test", nil)
+			},
+
+			wantedApp: "my-app",
+			wantedSvc: "frontend",
+			wantedEnv: "test",
+		},
+		"returns error if fail to select service": {
+			inApp: "my-app",
+
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Service(gomock.Any(), gomock.Any(), "my-app").Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select service for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					appName: tc.inApp,
+					name:    tc.inSvc,
+					envName: tc.inEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcDeployments_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		setupMocks func(m *mocks.MockserviceDeploymentsDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"writes json": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m *mocks.MockserviceDeploymentsDescriber) {
+				m.EXPECT().Deployments(10).Return(&describe.ServiceDeployments{
+					Deployments: []*deploy.WorkloadDeploymentRecord{},
+				}, nil)
+			},
+
+			wantedContent: "{\"deployments\":[]}\n",
+		},
+		"returns error if fail to describe deployments": {
+			setupMocks: func(m *mocks.MockserviceDeploymentsDescriber) {
+				m.EXPECT().Deployments(10).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe deployments of service frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockserviceDeploymentsDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					name:             "frontend",
+					limit:            10,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:                        b,
+				deploymentsDescriber:     mockDescriber,
+				initDeploymentsDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
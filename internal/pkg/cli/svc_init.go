@@ -4,10 +4,14 @@
 package cli
 
 import (
+	"encoding"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -45,9 +49,18 @@ Deployed resources (such as your ECR repository, logs) will contain this %[1]s's
 	svcInitSvcPortPrompt     = "Which %s do you want customer traffic sent to?"
 	svcInitSvcPortHelpPrompt = `The port will be used by the load balancer to route incoming traffic to this service.
 You should set this to the port which your Dockerfile uses to communicate with the internet.`
+	svcInitBackendSvcPortHelpPrompt = `The port will be used by other services to reach this service through service discovery.
+You should set this to the port which your Dockerfile exposes.`
+
+	svcInitHealthCheckPrompt     = "What " + color.Emphasize("command") + " should the container run to check its health?"
+	svcInitHealthCheckHelpPrompt = `The command is run in the container with the shell, for example "curl -f http://localhost/ || exit 1".
+The container is deemed unhealthy if the command exits with a non-zero code. Leave empty to skip the healthcheck.`
 )
 
 const (
+	wkldInitImageRepoPrompt     = "Which ECR repository is the image in?"
+	wkldInitImageRepoPromptHelp = `The ECR repositories of your account in the region of the application.
+You can also enter the location of an image in any other registry, such as Docker Hub.`
 	wkldInitImagePrompt     = `What's the location of the image to use?`
 	wkldInitImagePromptHelp = `The name of an existing Docker image. Images in the Docker Hub registry are available by default.
 Other repositories are specified with either repository-url/image:tag or repository-url/image@digest`
@@ -64,12 +77,17 @@ type initWkldVars struct {
 	name           string
 	dockerfilePath string
 	image          string
+	dryRun         bool // True prints the manifest to stdout instead of creating the workload.
+
+	wsAppName        string // Application that the workspace is registered with, empty if it can't be read.
+	allowAppOverride bool   // true means the application can differ from the one the workspace is registered with.
 }
 
 type initSvcVars struct {
 	initWkldVars
 
-	port uint16
+	port           uint16
+	healthCheckCmd string // Shell command of the container healthcheck, only for backend services.
 }
 
 type initSvcOpts struct {
@@ -80,6 +98,7 @@ type initSvcOpts struct {
 	init   svcInitializer
 	prompt prompter
 	df     dockerfileParser
+	w      io.Writer // Receives the manifest with --dry-run.
 
 	sel dockerfileSelector
 
@@ -107,7 +126,9 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 		return nil, err
 	}
 	prompter := prompt.New()
-	sel := selector.NewWorkspaceSelect(prompter, store, ws)
+	sel := selector.NewWorkspaceSelect(prompter, store, ws,
+		append(workspaceAppSelectOptions(vars.appName, vars.allowAppOverride), selector.WithImageLister(ecr.New(sess)))...)
+	vars.wsAppName = workspaceAppName(ws)
 
 	initSvc := &initialize.WorkloadInitializer{
 		Store:    store,
@@ -121,6 +142,7 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 		fs:     &afero.Afero{Fs: afero.NewOsFs()},
 		init:   initSvc,
 		prompt: prompter,
+		w:      os.Stdout,
 		sel:    sel,
 
 		setupParser: func(o *initSvcOpts) {
@@ -134,6 +156,9 @@ func (o *initSvcOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if err := validateWorkspaceApp(o.wsAppName, o.appName, o.allowAppOverride); err != nil {
+		return err
+	}
 	if o.wkldType != "" {
 		if err := validateSvcType(o.wkldType); err != nil {
 			return err
@@ -182,11 +207,15 @@ func (o *initSvcOpts) Ask() error {
 	if err := o.askSvcPort(); err != nil {
 		return err
 	}
+	if err := o.askHealthCheck(); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 // Execute writes the service's manifest file and stores the service in SSM.
+// With --dry-run, it only prints the manifest.
 func (o *initSvcOpts) Execute() error {
 	// Check for a valid healthcheck and add it to the opts.
 	var hc *manifest.ContainerHealthCheck
@@ -195,7 +224,7 @@ func (o *initSvcOpts) Execute() error {
 		return err
 	}
 
-	manifestPath, err := o.init.Service(&initialize.ServiceProps{
+	props := &initialize.ServiceProps{
 		WorkloadProps: initialize.WorkloadProps{
 			App:            o.appName,
 			Name:           o.name,
@@ -205,7 +234,15 @@ func (o *initSvcOpts) Execute() error {
 		},
 		Port:        o.port,
 		HealthCheck: hc,
-	})
+	}
+	if o.dryRun {
+		mft, err := o.init.ServiceManifest(props)
+		if err != nil {
+			return fmt.Errorf("generate the manifest of service %s: %w", o.name, err)
+		}
+		return printManifest(o.w, mft)
+	}
+	manifestPath, err := o.init.Service(props)
 	if err != nil {
 		return err
 	}
@@ -252,10 +289,9 @@ func (o *initSvcOpts) askImage() error {
 	if o.image != "" {
 		return nil
 	}
-	image, err := o.prompt.Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil,
-		prompt.WithFinalMessage("Image:"))
+	image, err := o.sel.Image(wkldInitImageRepoPrompt, wkldInitImagePrompt, wkldInitImageRepoPromptHelp, wkldInitImagePromptHelp, nil)
 	if err != nil {
-		return fmt.Errorf("get image location: %w", err)
+		return fmt.Errorf("select image: %w", err)
 	}
 	o.image = image
 	return nil
@@ -289,11 +325,6 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 	// See if we can get a healthcheck from the dockerfile.
 	o.setupParser(o)
 
-	// If the port flag was set, use that and don't ask.
-	if o.port != 0 {
-		return nil
-	}
-
 	var ports []uint16
 	if o.dockerfilePath != "" && o.image == "" {
 		// Check for exposed ports.
@@ -304,28 +335,36 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 		}
 	}
 
-	defaultPort := defaultSvcPortString
-	if o.dockerfilePath != "" {
-		switch len(ports) {
-		case 0:
-			// There were no ports detected, keep the default port prompt.
-		case 1:
-			o.port = ports[0]
-			return nil
-		default:
-			defaultPort = strconv.Itoa(int(ports[0]))
+	// If the port flag was set, use that and don't ask.
+	if o.port != 0 {
+		if len(ports) != 0 && !containsPort(ports, o.port) {
+			log.Warningf("Port %d is not exposed by Dockerfile %s.\n", o.port, o.dockerfilePath)
 		}
+		return nil
 	}
-	// Skip asking if it is a backend service.
+
+	help := svcInitSvcPortHelpPrompt
 	if o.wkldType == manifest.BackendServiceType {
+		help = svcInitBackendSvcPortHelpPrompt
+	}
+	switch len(ports) {
+	case 0:
+		// There were no ports detected, backend services don't need a port.
+		if o.wkldType == manifest.BackendServiceType {
+			return nil
+		}
+	case 1:
+		o.port = ports[0]
 		return nil
+	default:
+		return o.selectSvcPort(ports, help)
 	}
 
 	port, err := o.prompt.Get(
 		fmt.Sprintf(svcInitSvcPortPrompt, color.Emphasize("port")),
-		svcInitSvcPortHelpPrompt,
+		help,
 		validateSvcPort,
-		prompt.WithDefaultInput(defaultPort),
+		prompt.WithDefaultInput(defaultSvcPortString),
 		prompt.WithFinalMessage("Port:"),
 	)
 	if err != nil {
@@ -342,8 +381,73 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 	return nil
 }
 
+// selectSvcPort asks the user to pick one of the ports exposed by the Dockerfile.
+func (o *initSvcOpts) selectSvcPort(ports []uint16, help string) error {
+	options := make([]string, len(ports))
+	for i, port := range ports {
+		options[i] = strconv.Itoa(int(port))
+	}
+	port, err := o.prompt.SelectOne(
+		fmt.Sprintf(svcInitSvcPortPrompt, color.Emphasize("port")),
+		help,
+		options,
+		prompt.WithFinalMessage("Port:"),
+	)
+	if err != nil {
+		return fmt.Errorf("select port: %w", err)
+	}
+	portUint, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return fmt.Errorf("parse port string: %w", err)
+	}
+	o.port = uint16(portUint)
+	return nil
+}
+
+func containsPort(ports []uint16, port uint16) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *initSvcOpts) askHealthCheck() error {
+	if o.wkldType != manifest.BackendServiceType || o.healthCheckCmd != "" {
+		return nil
+	}
+	if o.dockerfilePath != "" {
+		o.setupParser(o)
+		hc, err := o.df.GetHealthCheck()
+		if err != nil {
+			return fmt.Errorf("get healthcheck from Dockerfile: %s, %w", o.dockerfilePath, err)
+		}
+		if hc != nil {
+			// Use the HEALTHCHECK instruction of the Dockerfile.
+			return nil
+		}
+	}
+	cmd, err := o.prompt.Get(svcInitHealthCheckPrompt, svcInitHealthCheckHelpPrompt, nil,
+		prompt.WithFinalMessage("Healthcheck command:"))
+	if err != nil {
+		return fmt.Errorf("get healthcheck command: %w", err)
+	}
+	o.healthCheckCmd = strings.TrimSpace(cmd)
+	return nil
+}
+
 func (o *initSvcOpts) parseHealthCheck() (*manifest.ContainerHealthCheck, error) {
-	if o.dockerfilePath == "" || o.wkldType != manifest.BackendServiceType {
+	if o.wkldType != manifest.BackendServiceType {
+		return nil, nil
+	}
+	if o.healthCheckCmd != "" {
+		// Use the defaults of the healthcheck for the other fields.
+		return &manifest.ContainerHealthCheck{
+			Command: []string{"CMD-SHELL", o.healthCheckCmd},
+		}, nil
+	}
+	if o.dockerfilePath == "" {
 		return nil, nil
 	}
 	o.setupParser(o)
@@ -363,8 +467,23 @@ func (o *initSvcOpts) parseHealthCheck() (*manifest.ContainerHealthCheck, error)
 	}, nil
 }
 
+// printManifest writes the manifest of a workload to w.
+func printManifest(w io.Writer, mft encoding.BinaryMarshaler) error {
+	b, err := mft.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	if _, err := w.Write(b); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	return nil
+}
+
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initSvcOpts) RecommendedActions() []string {
+	if o.dryRun {
+		return nil
+	}
 	return []string{
 		fmt.Sprintf("Update your manifest %s to change the defaults.", color.HighlightResource(o.manifestPath)),
 		fmt.Sprintf("Run %s to deploy your service to a %s environment.",
@@ -386,7 +505,10 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Print the manifest of an "api" backend service without creating it.
+  /code $ copilot svc init --name api --svc-type "Backend Service" --image nginx --dry-run > api.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -402,9 +524,11 @@ This command is also run as part of "copilot init".`,
 			if err := opts.Execute(); err != nil {
 				return err
 			}
-			log.Infoln("Recommended follow-up actions:")
-			for _, followup := range opts.RecommendedActions() {
-				log.Infof("- %s\n", followup)
+			if actions := opts.RecommendedActions(); len(actions) > 0 {
+				log.Infoln("Recommended follow-up actions:")
+				for _, followup := range actions {
+					log.Infof("- %s\n", followup)
+				}
 			}
 			return nil
 		}),
@@ -416,6 +540,8 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, wkldInitDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAppOverride, allowAppOverrideFlag, false, allowAppOverrideFlagDescription)
 
 	// Bucket flags by service type.
 	requiredFlags := pflag.NewFlagSet("Required Flags", pflag.ContinueOnError)
@@ -423,6 +549,8 @@ This command is also run as part of "copilot init".`,
 	requiredFlags.AddFlag(cmd.Flags().Lookup(svcTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(dockerFileFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(imageFlag))
+	requiredFlags.AddFlag(cmd.Flags().Lookup(dryRunFlag))
+	requiredFlags.AddFlag(cmd.Flags().Lookup(allowAppOverrideFlag))
 
 	lbWebSvcFlags := pflag.NewFlagSet(manifest.LoadBalancedWebServiceType, pflag.ContinueOnError)
 	lbWebSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
@@ -4,18 +4,29 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
+type mockBinaryMarshaler struct {
+	content string
+}
+
+func (m mockBinaryMarshaler) MarshalBinary() ([]byte, error) {
+	return []byte(m.content), nil
+}
+
 func TestSvcInitOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inSvcType        string
@@ -24,6 +35,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inImage          string
 		inAppName        string
 		inSvcPort        uint16
+		inWsAppName      string
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -53,6 +65,14 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inAppName: "",
 			wantedErr: errNoAppInWorkspace,
 		},
+		"app name different from the workspace's": {
+			inAppName:   "phonetool",
+			inWsAppName: "demo",
+			wantedErr: &workspace.ErrAppMismatch{
+				WorkspaceApp: "demo",
+				App:          "phonetool",
+			},
+		},
 		"valid flags": {
 			inSvcName:        "frontend",
 			inSvcType:        "Load Balanced Web Service",
@@ -77,6 +97,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 						dockerfilePath: tc.inDockerfilePath,
 						image:          tc.inImage,
 						appName:        tc.inAppName,
+						wsAppName:      tc.inWsAppName,
 					},
 					port: tc.inSvcPort,
 				},
@@ -118,7 +139,9 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 		mockSel        func(m *mocks.MockdockerfileSelector)
 		mockDockerfile func(m *mocks.MockdockerfileParser)
 
-		wantedErr error
+		wantedSvcPort        uint16
+		wantedHealthCheckCmd string
+		wantedErr            error
 	}{
 		"prompt for service type": {
 			inSvcType:        "",
@@ -195,10 +218,7 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			inSvcPort:        wantedSvcPort,
 			inDockerfilePath: "",
 
-			mockPrompt: func(m *mocks.Mockprompter) {
-				m.EXPECT().Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil, gomock.Any()).
-					Return("", mockError)
-			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
 			mockSel: func(m *mocks.MockdockerfileSelector) {
 				m.EXPECT().Dockerfile(
 					gomock.Eq(fmt.Sprintf(fmtWkldInitDockerfilePrompt, wantedSvcName)),
@@ -207,9 +227,11 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 					gomock.Eq(wkldInitDockerfilePathHelpPrompt),
 					gomock.Any(),
 				).Return("Use an existing image instead", nil)
+				m.EXPECT().Image(wkldInitImageRepoPrompt, wkldInitImagePrompt, wkldInitImageRepoPromptHelp, wkldInitImagePromptHelp, nil).
+					Return("", mockError)
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
-			wantedErr:      fmt.Errorf("get image location: mock error"),
+			wantedErr:      fmt.Errorf("select image: mock error"),
 		},
 		"using existing image": {
 			inSvcType:        wantedSvcType,
@@ -217,8 +239,6 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			inDockerfilePath: "",
 
 			mockPrompt: func(m *mocks.Mockprompter) {
-				m.EXPECT().Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil, gomock.Any()).
-					Return("mockImage", nil)
 				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(defaultSvcPortString, nil)
 			},
@@ -230,6 +250,8 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 					gomock.Eq(wkldInitDockerfilePathHelpPrompt),
 					gomock.Any(),
 				).Return("Use an existing image instead", nil)
+				m.EXPECT().Image(wkldInitImageRepoPrompt, wkldInitImagePrompt, wkldInitImageRepoPromptHelp, wkldInitImagePromptHelp, nil).
+					Return("mockImage", nil)
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
 		},
@@ -267,18 +289,99 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
 			wantedErr:      fmt.Errorf("select Dockerfile: some error"),
 		},
-		"skip asking for port for backend service": {
+		"skip asking for port for backend service if dockerfile has no port": {
 			inSvcType:        "Backend Service",
 			inSvcName:        wantedSvcName,
 			inDockerfilePath: wantedDockerfilePath,
 
-			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitHealthCheckPrompt), gomock.Any(), nil, gomock.Any()).
+					Return("", nil)
+			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetExposedPorts().Return([]uint16{}, errors.New("no expose"))
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
 			},
 			mockSel:   func(m *mocks.MockdockerfileSelector) {},
 			wantedErr: nil,
 		},
+		"select port for backend service if dockerfile has multiple ports": {
+			inSvcType:        "Backend Service",
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Eq(svcInitBackendSvcPortHelpPrompt), []string{"80", "8080"}, gomock.Any()).
+					Return("8080", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{80, 8080}, nil)
+				m.EXPECT().GetHealthCheck().Return(&dockerfile.HealthCheck{Cmd: []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"}}, nil)
+			},
+			mockSel: func(m *mocks.MockdockerfileSelector) {},
+
+			wantedSvcPort: 8080,
+		},
+		"select port for load balanced web service if dockerfile has multiple ports": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Eq(svcInitSvcPortHelpPrompt), []string{"80", "443"}, gomock.Any()).
+					Return("80", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{80, 443}, nil)
+			},
+			mockSel: func(m *mocks.MockdockerfileSelector) {},
+
+			wantedSvcPort: 80,
+		},
+		"errors if fail to select port": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{80, 443}, nil)
+			},
+			mockSel: func(m *mocks.MockdockerfileSelector) {},
+
+			wantedErr: fmt.Errorf("select port: some error"),
+		},
+		"prompt for healthcheck command for backend service": {
+			inSvcType: "Backend Service",
+			inSvcName: wantedSvcName,
+			inImage:   wantedImage,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitHealthCheckPrompt), gomock.Any(), nil, gomock.Any()).
+					Return(" curl -f http://localhost/ || exit 1 ", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
+			mockSel:        func(m *mocks.MockdockerfileSelector) {},
+
+			wantedHealthCheckCmd: "curl -f http://localhost/ || exit 1",
+		},
+		"errors if fail to get healthcheck command": {
+			inSvcType: "Backend Service",
+			inSvcName: wantedSvcName,
+			inImage:   wantedImage,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitHealthCheckPrompt), gomock.Any(), nil, gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
+			mockSel:        func(m *mocks.MockdockerfileSelector) {},
+
+			wantedErr: fmt.Errorf("get healthcheck command: some error"),
+		},
 		"asks for port if not specified": {
 			inSvcType:        wantedSvcType,
 			inSvcName:        wantedSvcName,
@@ -333,8 +436,7 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			inDockerfilePath: wantedDockerfilePath,
 			inSvcPort:        0,
 
-			mockPrompt: func(m *mocks.Mockprompter) {
-			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetExposedPorts().Return([]uint16{80}, nil)
 			},
@@ -346,10 +448,13 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			inDockerfilePath: wantedDockerfilePath,
 			inSvcPort:        wantedSvcPort,
 
-			mockPrompt: func(m *mocks.Mockprompter) {
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{8080}, nil)
 			},
-			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
-			mockSel:        func(m *mocks.MockdockerfileSelector) {},
+			mockSel: func(m *mocks.MockdockerfileSelector) {},
+
+			wantedSvcPort: wantedSvcPort,
 		},
 	}
 
@@ -397,6 +502,10 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 				if opts.image != "" {
 					require.Equal(t, wantedImage, opts.image)
 				}
+				if tc.wantedSvcPort != 0 {
+					require.Equal(t, tc.wantedSvcPort, opts.port)
+				}
+				require.Equal(t, tc.wantedHealthCheckCmd, opts.healthCheckCmd)
 			}
 		})
 	}
@@ -412,9 +521,12 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 		inDockerfilePath string
 		inImage          string
 		inAppName        string
+		inHealthCheckCmd string
+		inDryRun         bool
 
 		wantedErr          error
 		wantedManifestPath string
+		wantedManifest     string
 	}{
 		"success on typical svc props": {
 			inAppName:        "sample",
@@ -460,6 +572,30 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			wantedManifestPath: "manifest/path",
 		},
+		"backend service with healthcheck command": {
+			inAppName:        "sample",
+			inSvcName:        "backend",
+			inDockerfilePath: "./Dockerfile",
+			inSvcType:        manifest.BackendServiceType,
+			inHealthCheckCmd: "curl -f http://localhost/ || exit 1",
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:            "sample",
+						Name:           "backend",
+						Type:           "Backend Service",
+						DockerfilePath: "./Dockerfile",
+					},
+					HealthCheck: &manifest.ContainerHealthCheck{
+						Command: []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+					},
+				}).Return("manifest/path", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {}, // The Dockerfile has no healthcheck if a command was asked for.
+
+			wantedManifestPath: "manifest/path",
+		},
 		"doesn't parse dockerfile if image specified (backend)": {
 			inAppName:        "sample",
 			inSvcName:        "backend",
@@ -508,6 +644,41 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("some error"),
 		},
+		"prints the manifest without creating the service on dry run": {
+			inAppName: "sample",
+			inSvcName: "backend",
+			inImage:   "nginx:latest",
+			inSvcType: manifest.BackendServiceType,
+			inDryRun:  true,
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().ServiceManifest(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:   "sample",
+						Name:  "backend",
+						Type:  "Backend Service",
+						Image: "nginx:latest",
+					},
+				}).Return(mockBinaryMarshaler{content: "name: backend\n"}, nil)
+				m.EXPECT().Service(gomock.Any()).Times(0)
+			},
+
+			wantedManifest: "name: backend\n",
+		},
+		"fails to generate the manifest on dry run": {
+			inAppName: "sample",
+			inSvcName: "frontend",
+			inImage:   "nginx:latest",
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			inDryRun:  true,
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().ServiceManifest(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().Service(gomock.Any()).Times(0)
+			},
+
+			wantedErr: errors.New("generate the manifest of service frontend: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -518,6 +689,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			mockSvcInitializer := mocks.NewMocksvcInitializer(ctrl)
 			mockDockerfile := mocks.NewMockdockerfileParser(ctrl)
+			b := &bytes.Buffer{}
 
 			if tc.mockSvcInit != nil {
 				tc.mockSvcInit(mockSvcInitializer)
@@ -534,12 +706,15 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 						wkldType:       tc.inSvcType,
 						dockerfilePath: tc.inDockerfilePath,
 						image:          tc.inImage,
+						dryRun:         tc.inDryRun,
 					},
-					port: tc.inSvcPort,
+					port:           tc.inSvcPort,
+					healthCheckCmd: tc.inHealthCheckCmd,
 				},
 				init:        mockSvcInitializer,
 				setupParser: func(*initSvcOpts) {},
 				df:          mockDockerfile,
+				w:           b,
 			}
 
 			// WHEN
@@ -549,6 +724,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			if tc.wantedErr == nil {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedManifestPath, opts.manifestPath)
+				require.Equal(t, tc.wantedManifest, b.String())
 			} else {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			}
@@ -90,9 +90,14 @@ func buildSvcListCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ls",
 		Short: "Lists all the services in an application.",
+		Long: `Lists all the services in an application.
+Services in the workspace and services deployed to the application are listed together,
+with a source column that tells whether a service is only in the workspace, only registered, or both.`,
 		Example: `
   Lists all the services for the "myapp" application.
-  /code $ copilot svc ls --app myapp`,
+  /code $ copilot svc ls --app myapp
+  Lists only the services in the workspace.
+  /code $ copilot svc ls --local`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListSvcOpts(vars)
 			if err != nil {
@@ -4,7 +4,6 @@
 package cli
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -13,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -28,6 +28,10 @@ const (
 
 	cwGetLogEventsLimitMin = 1
 	cwGetLogEventsLimitMax = 10000
+
+	// Filter patterns of the severity shortcut flags, terms prefixed with "?" match events that contain any of them.
+	errorLogsFilterPattern   = "?ERROR ?FATAL ?panic"
+	warningLogsFilterPattern = "?WARN ?WARNING ?ERROR ?FATAL ?panic"
 )
 
 type svcLogsVars struct {
@@ -40,7 +44,11 @@ type svcLogsVars struct {
 	humanStartTime   string
 	humanEndTime     string
 	taskIDs          []string
-	since            time.Duration
+	containerName    string
+	humanSince       string
+	filterPattern    string
+	errorsOnly       bool
+	warningsOnly     bool
 }
 
 type svcLogsOpts struct {
@@ -67,12 +75,14 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, configStore)
 	opts := &svcLogsOpts{
 		svcLogsVars: vars,
 		w:           log.OutputWriter,
 		configStore: configStore,
 		deployStore: deployStore,
-		sel:         selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		sel:         selector.NewDeploySelect(prompt.New(), configStore, deployStore, selOpts...),
 	}
 	opts.initLogsSvc = func() error {
 		configStore, err := config.NewStore()
@@ -87,7 +97,21 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 		if err != nil {
 			return err
 		}
-		opts.logsSvc = logging.NewServiceClient(sess, opts.appName, opts.envName, opts.svcName)
+		d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return err
+		}
+		// The log group name can be overridden in the manifest.
+		logGroup, err := d.LogGroupName()
+		if err != nil {
+			return fmt.Errorf("get log group of service %s: %w", opts.svcName, err)
+		}
+		opts.logsSvc = logging.NewServiceClient(sess, opts.appName, opts.envName, opts.svcName, logGroup)
 		return nil
 	}
 	return opts, nil
@@ -102,37 +126,15 @@ func (o *svcLogsOpts) Validate() error {
 		}
 	}
 
-	if o.since != 0 && o.humanStartTime != "" {
-		return errors.New("only one of --since or --start-time may be used")
-	}
-
-	if o.humanEndTime != "" && o.follow {
-		return errors.New("only one of --follow or --end-time may be used")
-	}
-
-	if o.since != 0 {
-		if o.since < 0 {
-			return fmt.Errorf("--since must be greater than 0")
-		}
-		// round up to the nearest second
-		o.startTime = o.parseSince()
-	}
-
-	if o.humanStartTime != "" {
-		startTime, err := o.parseRFC3339(o.humanStartTime)
-		if err != nil {
-			return fmt.Errorf(`invalid argument %s for "--start-time" flag: %w`, o.humanStartTime, err)
-		}
-		o.startTime = aws.Int64(startTime)
+	if o.filterPattern != "" && (o.errorsOnly || o.warningsOnly) {
+		return fmt.Errorf("--%s cannot be used with --%s or --%s", filterPatternFlag, errorsFlag, warningsFlag)
 	}
 
-	if o.humanEndTime != "" {
-		endTime, err := o.parseRFC3339(o.humanEndTime)
-		if err != nil {
-			return fmt.Errorf(`invalid argument %s for "--end-time" flag: %w`, o.humanEndTime, err)
-		}
-		o.endTime = aws.Int64(endTime)
+	startTime, endTime, err := parseLogsTimeWindow(o.humanSince, o.humanStartTime, o.humanEndTime, o.follow, time.Now())
+	if err != nil {
+		return err
 	}
+	o.startTime, o.endTime = startTime, endTime
 
 	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
@@ -163,12 +165,14 @@ func (o *svcLogsOpts) Execute() error {
 		limit = aws.Int64(int64(o.limit))
 	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
-		Follow:    o.follow,
-		Limit:     limit,
-		EndTime:   o.endTime,
-		StartTime: o.startTime,
-		TaskIDs:   o.taskIDs,
-		OnEvents:  eventsWriter,
+		Follow:        o.follow,
+		Limit:         limit,
+		EndTime:       o.endTime,
+		StartTime:     o.startTime,
+		TaskIDs:       o.taskIDs,
+		ContainerName: o.containerName,
+		FilterPattern: o.logsFilterPattern(),
+		OnEvents:      eventsWriter,
 	})
 	if err != nil {
 		return fmt.Errorf("write log events for service %s: %w", o.svcName, err)
@@ -176,6 +180,18 @@ func (o *svcLogsOpts) Execute() error {
 	return nil
 }
 
+// logsFilterPattern returns the filter pattern of the flags, the severity shortcuts expand to their default patterns.
+func (o *svcLogsOpts) logsFilterPattern() string {
+	switch {
+	case o.warningsOnly:
+		return warningLogsFilterPattern
+	case o.errorsOnly:
+		return errorLogsFilterPattern
+	default:
+		return o.filterPattern
+	}
+}
+
 func (o *svcLogsOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -198,20 +214,6 @@ func (o *svcLogsOpts) askSvcEnvName() error {
 	return nil
 }
 
-func (o *svcLogsOpts) parseSince() *int64 {
-	sinceSec := int64(o.since.Round(time.Second).Seconds())
-	timeNow := time.Now().Add(time.Duration(-sinceSec) * time.Second)
-	return aws.Int64(timeNow.Unix() * 1000)
-}
-
-func (o *svcLogsOpts) parseRFC3339(timeStr string) (int64, error) {
-	startTimeTmp, err := time.Parse(time.RFC3339, timeStr)
-	if err != nil {
-		return 0, fmt.Errorf("reading time value %s: %w", timeStr, err)
-	}
-	return startTimeTmp.Unix() * 1000, nil
-}
-
 // buildSvcLogsCmd builds the command for displaying service logs in an application.
 func buildSvcLogsCmd() *cobra.Command {
 	vars := svcLogsVars{}
@@ -224,12 +226,20 @@ func buildSvcLogsCmd() *cobra.Command {
   /code $ copilot svc logs -n my-svc -e test
   Displays logs in the last hour.
   /code $ copilot svc logs --since 1h
+  Displays logs since the beginning of yesterday.
+  /code $ copilot svc logs --since yesterday
   Displays logs from 2006-01-02T15:04:05 to 2006-01-02T15:05:05.
   /code $ copilot svc logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00
 	Displays logs from specific task IDs.
   /code $ copilot svc logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
+  Displays logs of the "envoy" sidecar.
+  /code $ copilot svc logs --container envoy
   Displays logs in real time.
-  /code $ copilot svc logs --follow`,
+  /code $ copilot svc logs --follow
+  Displays only the error logs in real time.
+  /code $ copilot svc logs --follow --errors
+  Displays logs matching a CloudWatch Logs filter pattern.
+  /code $ copilot svc logs --filter-pattern '"GET /api"'`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -251,8 +261,12 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
-	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
+	cmd.Flags().StringVar(&vars.humanSince, sinceFlag, "", sinceFlagDescription)
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.filterPattern, filterPatternFlag, "", filterPatternFlagDescription)
+	cmd.Flags().BoolVar(&vars.errorsOnly, errorsFlag, false, errorsFlagDescription)
+	cmd.Flags().BoolVar(&vars.warningsOnly, warningsFlag, false, warningsFlagDescription)
 	return cmd
 }
@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
@@ -24,7 +23,7 @@ type svcLogsMock struct {
 
 func TestSvcLogs_Validate(t *testing.T) {
 	const (
-		mockSince        = 1 * time.Minute
+		mockSince        = "1m"
 		mockStartTime    = "1970-01-01T01:01:01+00:00"
 		mockBadStartTime = "badStartTime"
 		mockEndTime      = "1971-01-01T01:01:01+00:00"
@@ -38,7 +37,9 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputEnvName   string
 		inputStartTime string
 		inputEndTime   string
-		inputSince     time.Duration
+		inputSince     string
+		inputFilter    string
+		inputErrors    bool
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -66,6 +67,14 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --since or --start-time may be used"),
 		},
+		"returns error if filter pattern and severity shortcut flags are set together": {
+			inputFilter: `"GET /api"`,
+			inputErrors: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--filter-pattern cannot be used with --errors or --warnings"),
+		},
 		"returns error if follow and endTime flags are set together": {
 			inputFollow:  true,
 			inputEndTime: mockEndTime,
@@ -89,12 +98,40 @@ func TestSvcLogs_Validate(t *testing.T) {
 			wantedError: fmt.Errorf("invalid argument badEndTime for \"--end-time\" flag: reading time value badEndTime: parsing time \"badEndTime\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"badEndTime\" as \"2006\""),
 		},
 		"returns error if invalid since flag value": {
-			inputSince: -mockSince,
+			inputSince: "-1m",
 
 			mockstore: func(m *mocks.Mockstore) {},
 
 			wantedError: fmt.Errorf("--since must be greater than 0"),
 		},
+		"valid since flag value in days": {
+			inputSince: "2d",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: nil,
+		},
+		"valid relative start time": {
+			inputStartTime: "1w",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: nil,
+		},
+		"returns error if since flag value is not a duration": {
+			inputSince: "3 days",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("invalid argument 3 days for \"--since\" flag: parse duration 3 days: value must be a duration like 30m, 12h, 3d or 1w"),
+		},
+		"returns error if since flag value exceeds the log retention": {
+			inputSince: "5w",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("invalid argument 5w for \"--since\" flag: logs are only retained for the last 30 days"),
+		},
 		"returns error if limit value is below limit": {
 			inputLimit: -1,
 
@@ -126,9 +163,11 @@ func TestSvcLogs_Validate(t *testing.T) {
 					envName:        tc.inputEnvName,
 					humanStartTime: tc.inputStartTime,
 					humanEndTime:   tc.inputEndTime,
-					since:          tc.inputSince,
+					humanSince:     tc.inputSince,
 					svcName:        tc.inputSvc,
 					appName:        tc.inputApp,
+					filterPattern:  tc.inputFilter,
+					errorsOnly:     tc.inputErrors,
 				},
 				configStore: mockstore,
 			}
@@ -262,6 +301,10 @@ func TestSvcLogs_Execute(t *testing.T) {
 		endTime   int64
 		startTime int64
 		taskIDs   []string
+		container string
+		filter    string
+		errors    bool
+		warnings  bool
 
 		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
 
@@ -312,6 +355,59 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"passes the container name": {
+			inputSvc:  "mockSvc",
+			container: "firelens_log_router",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, "firelens_log_router", param.ContainerName)
+				}).Return(nil)
+
+				return m
+			},
+		},
+		"passes the filter pattern": {
+			inputSvc: "mockSvc",
+			filter:   `"GET /api"`,
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, `"GET /api"`, param.FilterPattern)
+				}).Return(nil)
+
+				return m
+			},
+		},
+		"expands the errors shortcut": {
+			inputSvc: "mockSvc",
+			errors:   true,
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, "?ERROR ?FATAL ?panic", param.FilterPattern)
+				}).Return(nil)
+
+				return m
+			},
+		},
+		"expands the warnings shortcut to include errors": {
+			inputSvc: "mockSvc",
+			errors:   true,
+			warnings: true,
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, "?WARN ?WARNING ?ERROR ?FATAL ?panic", param.FilterPattern)
+				}).Return(nil)
+
+				return m
+			},
+		},
 		"returns error if fail to get event logs": {
 			inputSvc: "mockSvc",
 
@@ -334,10 +430,14 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			svcLogs := &svcLogsOpts{
 				svcLogsVars: svcLogsVars{
-					svcName: tc.inputSvc,
-					follow:  tc.follow,
-					limit:   tc.limit,
-					taskIDs: tc.taskIDs,
+					svcName:       tc.inputSvc,
+					follow:        tc.follow,
+					limit:         tc.limit,
+					taskIDs:       tc.taskIDs,
+					containerName: tc.container,
+					filterPattern: tc.filter,
+					errorsOnly:    tc.errors,
+					warningsOnly:  tc.warnings,
 				},
 				startTime:   &tc.startTime,
 				endTime:     &tc.endTime,
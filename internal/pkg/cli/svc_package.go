@@ -18,6 +18,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -30,6 +31,10 @@ import (
 const (
 	svcPackageSvcNamePrompt = "Which service would you like to generate a CloudFormation template for?"
 	svcPackageEnvNamePrompt = "Which environment would you like to package this stack for?"
+
+	// svcPackageImageTagPlaceholder is the image tag written to the output directory when --tag isn't provided,
+	// so that the checked-in artifacts don't change with every commit.
+	svcPackageImageTagPlaceholder = "${IMAGE_TAG}"
 )
 
 var initPackageAddonsClient = func(o *packageSvcOpts) error {
@@ -41,12 +46,24 @@ var initPackageAddonsClient = func(o *packageSvcOpts) error {
 	return nil
 }
 
+// packageEnvOutputExports returns the export names of the environment stack's exported outputs keyed by output name.
+var packageEnvOutputExports = func(env *config.Environment) (map[string]string, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, err
+	}
+	return cloudformation.New(sess).EnvironmentOutputExports(env.App, env.Name)
+}
+
 type packageSvcVars struct {
 	name      string
 	envName   string
+	envNames  []string // Environments to package, only svc package with --output-dir accepts more than one.
 	appName   string
 	tag       string
 	outputDir string
+
+	ignoreEnvVersion bool // true means the template is generated even if the environment doesn't support the features of the manifest.
 }
 
 type packageSvcOpts struct {
@@ -66,6 +83,9 @@ type packageSvcOpts struct {
 	sel              wsSelector
 	prompt           prompter
 	stackSerializer  func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
+	envOutputExports func(env *config.Environment) (map[string]string, error) // Overridden in tests.
+
+	newEnvVersionGetter func(app, env string) (versionGetter, error) // Overridden in tests.
 }
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
@@ -86,6 +106,7 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 	opts := &packageSvcOpts{
 		packageSvcVars:   vars,
 		initAddonsClient: initPackageAddonsClient,
+		envOutputExports: packageEnvOutputExports,
 		ws:               ws,
 		store:            store,
 		appCFN:           cloudformation.New(sess),
@@ -96,14 +117,29 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		paramsWriter:     ioutil.Discard,
 		addonsWriter:     ioutil.Discard,
 		fs:               &afero.Afero{Fs: afero.NewOsFs()},
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %w", env, app, err)
+			}
+			return d, nil
+		},
 	}
 
 	opts.stackSerializer = func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
 		var serializer stackSerializer
 		switch v := mft.(type) {
 		case *manifest.LoadBalancedWebService:
-			if app.RequiresDNSDelegation() {
-				serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, app.Name, rc)
+			if app.RequiresDNSDelegation() || len(env.ImportedCertARNs()) != 0 {
+				serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, deploy.AppInformation{
+					Name:      app.Name,
+					AccountID: app.AccountID,
+					DNSName:   app.Domain,
+				}, rc)
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 				}
@@ -140,8 +176,19 @@ func (o *packageSvcOpts) Validate() error {
 			return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
 		}
 	}
-	if o.envName != "" {
-		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+	if len(o.envNames) > 1 && o.outputDir == "" {
+		return fmt.Errorf("`--%s` must be specified to package more than one environment", stackOutputDirFlag)
+	}
+	seen := make(map[string]bool)
+	for _, name := range o.targetEnvNames() {
+		if name == "" {
+			continue
+		}
+		if seen[name] {
+			return fmt.Errorf("environment %s is specified more than once", name)
+		}
+		seen[name] = true
+		if _, err := o.store.GetEnvironment(o.appName, name); err != nil {
 			return err
 		}
 	}
@@ -156,6 +203,10 @@ func (o *packageSvcOpts) Ask() error {
 	if err := o.askEnvName(); err != nil {
 		return err
 	}
+	if o.outputDir != "" && o.tag == "" {
+		o.tag = svcPackageImageTagPlaceholder
+		return nil
+	}
 	tag, err := askImageTag(o.tag, o.prompt, o.runner)
 	if err != nil {
 		return err
@@ -164,19 +215,17 @@ func (o *packageSvcOpts) Ask() error {
 	return nil
 }
 
-// Execute prints the CloudFormation template of the application for the environment.
+// Execute prints the CloudFormation template of the service for the environment.
+// If an output directory is provided, it writes the templates and their parameters for each environment instead.
 func (o *packageSvcOpts) Execute() error {
-	env, err := o.store.GetEnvironment(o.appName, o.envName)
-	if err != nil {
-		return err
-	}
-
 	if o.outputDir != "" {
-		if err := o.setOutputFileWriters(); err != nil {
-			return err
-		}
+		return o.writeOutputDir()
 	}
 
+	env, err := o.store.GetEnvironment(o.appName, o.targetEnvNames()[0])
+	if err != nil {
+		return err
+	}
 	appTemplates, err := o.getSvcTemplates(env)
 	if err != nil {
 		return err
@@ -197,16 +246,58 @@ func (o *packageSvcOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("retrieve addons template: %w", err)
 	}
+	_, err = o.addonsWriter.Write([]byte(addonsTemplate))
+	return err
+}
 
-	// Addons template won't show up without setting --output-dir flag.
-	if o.outputDir != "" {
-		if err := o.setAddonsFileWriter(); err != nil {
+// targetEnvNames returns the environments to package in order.
+func (o *packageSvcOpts) targetEnvNames() []string {
+	if len(o.envNames) != 0 {
+		return o.envNames
+	}
+	return []string{o.envName}
+}
+
+// writeOutputDir writes the stack template and parameters of each environment, and the addons template if present,
+// to the output directory. Existing files are overwritten.
+func (o *packageSvcOpts) writeOutputDir() error {
+	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+	}
+	for _, envName := range o.targetEnvNames() {
+		env, err := o.store.GetEnvironment(o.appName, envName)
+		if err != nil {
+			return err
+		}
+		appTemplates, err := o.getSvcTemplates(env)
+		if err != nil {
+			return err
+		}
+		if err := o.writeOutputFile(fmt.Sprintf(deploy.WorkloadCfnTemplateNameFormat, o.name, envName), appTemplates.stack); err != nil {
+			return err
+		}
+		if err := o.writeOutputFile(fmt.Sprintf(deploy.WorkloadCfnTemplateConfigurationNameFormat, o.name, envName), appTemplates.configuration); err != nil {
 			return err
 		}
 	}
 
-	_, err = o.addonsWriter.Write([]byte(addonsTemplate))
-	return err
+	addonsTemplate, err := o.getAddonsTemplate()
+	var notExistErr *addon.ErrAddonsDirNotExist
+	if errors.As(err, &notExistErr) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("retrieve addons template: %w", err)
+	}
+	return o.writeOutputFile(fmt.Sprintf(deploy.AddonsCfnTemplateNameFormat, o.name), addonsTemplate)
+}
+
+func (o *packageSvcOpts) writeOutputFile(name, content string) error {
+	path := filepath.Join(o.outputDir, name)
+	if err := afero.WriteFile(o.fs, path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", path, err)
+	}
+	return nil
 }
 
 func (o *packageSvcOpts) askSvcName() error {
@@ -223,7 +314,7 @@ func (o *packageSvcOpts) askSvcName() error {
 }
 
 func (o *packageSvcOpts) askEnvName() error {
-	if o.envName != "" {
+	if o.envName != "" || len(o.envNames) != 0 {
 		return nil
 	}
 
@@ -257,6 +348,9 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
+	if err := o.validateEnvVersion(mft, env); err != nil {
+		return nil, err
+	}
 	imgNeedsBuild, err := manifest.ServiceDockerfileBuildRequired(mft)
 	if err != nil {
 		return nil, err
@@ -265,8 +359,17 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
+	exports, err := o.envOutputExports(env)
+	if err != nil {
+		return nil, fmt.Errorf("get exported outputs of environment %s: %w", env.Name, err)
+	}
 	rc := stack.RuntimeConfig{
-		AdditionalTags: app.Tags,
+		AdditionalTags:      app.Tags,
+		SecurityGroups:      env.ImportedSecurityGroupIDs(),
+		AccountID:           env.AccountID,
+		EnvOutputExports:    exports,
+		ImportedCertARNs:    env.ImportedCertARNs(),
+		PermissionsBoundary: app.PermissionsBoundary,
 	}
 	if imgNeedsBuild {
 		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
@@ -286,6 +389,13 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 			ImageTag: o.tag,
 		}
 	}
+	if _, ok := mft.(*manifest.ScheduledJob); ok {
+		job, err := o.store.GetJob(o.appName, o.name)
+		if err != nil {
+			return nil, fmt.Errorf("get job configuration: %w", err)
+		}
+		rc.ScheduleDisabled = job.IsScheduleDisabled(env.Name)
+	}
 	serializer, err := o.stackSerializer(mft, env, app, rc)
 	if err != nil {
 		return nil, err
@@ -301,41 +411,28 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
 }
 
-// setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
-func (o *packageSvcOpts) setOutputFileWriters() error {
-	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
-		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+// validateEnvVersion returns an error if the manifest uses features that the version of the environment doesn't support,
+// so that the template doesn't fail to deploy because of missing environment resources.
+func (o *packageSvcOpts) validateEnvVersion(mft interface{}, env *config.Environment) error {
+	if o.ignoreEnvVersion {
+		return nil
 	}
-
-	templatePath := filepath.Join(o.outputDir,
-		fmt.Sprintf(deploy.WorkloadCfnTemplateNameFormat, o.name, o.envName))
-	templateFile, err := o.fs.Create(templatePath)
+	features, err := deploy.EnvFeatures(mft, env.Name)
 	if err != nil {
-		return fmt.Errorf("create file %s: %w", templatePath, err)
+		return err
 	}
-	o.stackWriter = templateFile
-
-	paramsPath := filepath.Join(o.outputDir,
-		fmt.Sprintf(deploy.WorkloadCfnTemplateConfigurationNameFormat, o.name, o.envName))
-	paramsFile, err := o.fs.Create(paramsPath)
+	if len(features) == 0 {
+		return nil
+	}
+	getter, err := o.newEnvVersionGetter(o.appName, env.Name)
 	if err != nil {
-		return fmt.Errorf("create file %s: %w", paramsPath, err)
+		return err
 	}
-	o.paramsWriter = paramsFile
-
-	return nil
-}
-
-func (o *packageSvcOpts) setAddonsFileWriter() error {
-	addonsPath := filepath.Join(o.outputDir,
-		fmt.Sprintf(deploy.AddonsCfnTemplateNameFormat, o.name))
-	addonsFile, err := o.fs.Create(addonsPath)
+	version, err := getter.Version()
 	if err != nil {
-		return fmt.Errorf("create file %s: %w", addonsPath, err)
+		return fmt.Errorf("get template version of environment %s in app %s: %w", env.Name, o.appName, err)
 	}
-	o.addonsWriter = addonsFile
-
-	return nil
+	return deploy.ValidateEnvVersion(o.appName, env.Name, version, features)
 }
 
 // RecommendedActions is a no-op for this command.
@@ -386,7 +483,13 @@ func buildSvcPackageCmd() *cobra.Command {
   Write the CloudFormation stack and configuration to a "infrastructure/" sub-directory instead of printing.
   /code $ copilot svc package -n frontend -e test --output-dir ./infrastructure
   /code $ ls ./infrastructure
-  /code frontend-test.stack.yml      frontend-test.params.yml`,
+  /code frontend-test.stack.yml      frontend-test.params.json
+
+  Write the CloudFormation stacks, configurations and addons of the "test" and "prod" environments to a directory.
+  /code $ copilot svc package -n frontend -e test,prod --output-dir ./infrastructure --tag v1.0.0
+  /code $ ls ./infrastructure
+  /code frontend-prod.params.json   frontend-prod.stack.yml   frontend-test.params.json
+  /code frontend-test.stack.yml     frontend.addons.stack.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageSvcOpts(vars)
 			if err != nil {
@@ -403,9 +506,10 @@ func buildSvcPackageCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
-	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, svcPackageEnvsFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().BoolVar(&vars.ignoreEnvVersion, ignoreEnvVersionFlag, false, ignoreEnvVersionFlagDescription)
 	return cmd
 }
@@ -6,13 +6,16 @@ package cli
 import (
 	"bytes"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,9 +26,11 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 	)
 
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName   string
+		inEnvName   string
+		inEnvNames  []string
+		inSvcName   string
+		inOutputDir string
 
 		setupMocks func()
 
@@ -75,6 +80,40 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 				EnvironmentName: "test",
 			}).Error(),
 		},
+		"error when packaging multiple environments without an output directory": {
+			inAppName:  "phonetool",
+			inEnvNames: []string{"test", "prod"},
+
+			setupMocks: func() {
+				mockWorkspace.EXPECT().ServiceNames().Times(0)
+				mockStore.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedErrorS: "`--output-dir` must be specified to package more than one environment",
+		},
+		"error when an environment is specified more than once": {
+			inAppName:   "phonetool",
+			inEnvNames:  []string{"test", "test"},
+			inOutputDir: "infrastructure",
+
+			setupMocks: func() {
+				mockWorkspace.EXPECT().ServiceNames().Times(0)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+
+			wantedErrorS: "environment test is specified more than once",
+		},
+		"packaging multiple environments to an output directory": {
+			inAppName:   "phonetool",
+			inEnvNames:  []string{"test", "prod"},
+			inOutputDir: "infrastructure",
+
+			setupMocks: func() {
+				mockWorkspace.EXPECT().ServiceNames().Times(0)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -90,9 +129,11 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 
 			opts := &packageSvcOpts{
 				packageSvcVars: packageSvcVars{
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
-					appName: tc.inAppName,
+					name:      tc.inSvcName,
+					envName:   tc.inEnvName,
+					envNames:  tc.inEnvNames,
+					appName:   tc.inAppName,
+					outputDir: tc.inOutputDir,
 				},
 				ws:    mockWorkspace,
 				store: mockStore,
@@ -114,9 +155,10 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 func TestPackageSvcOpts_Ask(t *testing.T) {
 	const testAppName = "phonetool"
 	testCases := map[string]struct {
-		inSvcName string
-		inEnvName string
-		inTag     string
+		inSvcName   string
+		inEnvName   string
+		inTag       string
+		inOutputDir string
 
 		expectSelector func(m *mocks.MockwsSelector)
 		expectPrompt   func(m *mocks.Mockprompter)
@@ -195,6 +237,26 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 			wantedEnvName: "test",
 			wantedTag:     "v1.0.0",
 		},
+		"use a placeholder image tag when writing to an output directory": {
+			inSvcName:   "frontend",
+			inEnvName:   "test",
+			inOutputDir: "infrastructure",
+
+			expectSelector: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedSvcName: "frontend",
+			wantedEnvName: "test",
+			wantedTag:     "${IMAGE_TAG}",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -213,10 +275,11 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 
 			opts := &packageSvcOpts{
 				packageSvcVars: packageSvcVars{
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
-					tag:     tc.inTag,
-					appName: testAppName,
+					name:      tc.inSvcName,
+					envName:   tc.inEnvName,
+					tag:       tc.inTag,
+					appName:   testAppName,
+					outputDir: tc.inOutputDir,
 				},
 				sel:    mockSelector,
 				prompt: mockPrompt,
@@ -246,10 +309,12 @@ func TestPackageSvcOpts_Execute(t *testing.T) {
 
 		mockDependencies func(*gomock.Controller, *packageSvcOpts)
 
-		wantedStack  string
-		wantedParams string
-		wantedAddons string
-		wantedErr    error
+		wantedStack     string
+		wantedParams    string
+		wantedAddons    string
+		wantedFileNames []string
+		wantedFiles     map[string]string
+		wantedErr       error
 	}{
 		"writes service template without addons": {
 			inVars: packageSvcVars{
@@ -321,6 +386,181 @@ count: 1`), nil)
 				}
 			},
 
+			wantedStack:  "mystack",
+			wantedParams: "myparams",
+		},
+		"writes templates of each environment and addons to the output directory": {
+			inVars: packageSvcVars{
+				appName:   "ecs-kudos",
+				name:      "api",
+				envNames:  []string{"test", "prod"},
+				tag:       "${IMAGE_TAG}",
+				outputDir: "infrastructure",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "test").
+					Return(&config.Environment{
+						App:    "ecs-kudos",
+						Name:   "test",
+						Region: "us-west-2",
+					}, nil)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "prod").
+					Return(&config.Environment{
+						App:    "ecs-kudos",
+						Name:   "prod",
+						Region: "us-east-1",
+					}, nil)
+				mockApp := &config.Application{
+					Name: "ecs-kudos",
+				}
+				mockStore.EXPECT().
+					GetApplication("ecs-kudos").
+					Return(mockApp, nil).Times(2)
+
+				mockWs := mocks.NewMockwsSvcReader(ctrl)
+				mockWs.EXPECT().
+					ReadServiceManifest("api").
+					Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80`), nil).Times(2)
+
+				mockAddons := mocks.NewMocktemplater(ctrl)
+				mockAddons.EXPECT().Template().Return("myaddons", nil)
+
+				opts.store = mockStore
+				opts.ws = mockWs
+				opts.initAddonsClient = func(opts *packageSvcOpts) error {
+					opts.addonsClient = mockAddons
+					return nil
+				}
+				opts.stackSerializer = func(_ interface{}, env *config.Environment, _ *config.Application, _ stack.RuntimeConfig) (stackSerializer, error) {
+					mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+					mockStackSerializer.EXPECT().Template().Return(env.Name+"-stack", nil)
+					mockStackSerializer.EXPECT().SerializedParameters().Return(env.Name+"-params", nil)
+					return mockStackSerializer, nil
+				}
+			},
+
+			wantedFileNames: []string{
+				"api-prod.params.json",
+				"api-prod.stack.yml",
+				"api-test.params.json",
+				"api-test.stack.yml",
+				"api.addons.stack.yml",
+			},
+			wantedFiles: map[string]string{
+				"api-test.stack.yml":   "test-stack",
+				"api-test.params.json": "test-params",
+				"api-prod.stack.yml":   "prod-stack",
+				"api-prod.params.json": "prod-params",
+				"api.addons.stack.yml": "myaddons",
+			},
+		},
+		"fails before rendering if the environment doesn't support the features of the manifest": {
+			inVars: packageSvcVars{
+				appName: "ecs-kudos",
+				name:    "api",
+				envName: "test",
+				tag:     "1234",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "test").
+					Return(&config.Environment{
+						App:    "ecs-kudos",
+						Name:   "test",
+						Region: "us-west-2",
+					}, nil)
+
+				mockWs := mocks.NewMockwsSvcReader(ctrl)
+				mockWs.EXPECT().
+					ReadServiceManifest("api").
+					Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+network:
+  connect: true`), nil)
+
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return("v1.1.0", nil)
+
+				opts.store = mockStore
+				opts.ws = mockWs
+				opts.newEnvVersionGetter = func(app, env string) (versionGetter, error) {
+					return mockVersionGetter, nil
+				}
+			},
+
+			wantedErr: &deploy.ErrEnvVersionTooOld{
+				App:      "ecs-kudos",
+				Env:      "test",
+				Version:  "v1.1.0",
+				Features: []string{"network.connect"},
+			},
+		},
+		"skips the environment version check with --ignore-env-version": {
+			inVars: packageSvcVars{
+				appName:          "ecs-kudos",
+				name:             "api",
+				envName:          "test",
+				tag:              "1234",
+				ignoreEnvVersion: true,
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "test").
+					Return(&config.Environment{
+						App:    "ecs-kudos",
+						Name:   "test",
+						Region: "us-west-2",
+					}, nil)
+				mockStore.EXPECT().
+					GetApplication("ecs-kudos").
+					Return(&config.Application{
+						Name: "ecs-kudos",
+					}, nil)
+
+				mockWs := mocks.NewMockwsSvcReader(ctrl)
+				mockWs.EXPECT().
+					ReadServiceManifest("api").
+					Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+network:
+  connect: true`), nil)
+
+				mockAddons := mocks.NewMocktemplater(ctrl)
+				mockAddons.EXPECT().Template().
+					Return("", &addon.ErrAddonsDirNotExist{})
+
+				opts.store = mockStore
+				opts.ws = mockWs
+				opts.initAddonsClient = func(opts *packageSvcOpts) error {
+					opts.addonsClient = mockAddons
+					return nil
+				}
+				opts.newEnvVersionGetter = func(app, env string) (versionGetter, error) {
+					return nil, errors.New("should not be called")
+				}
+				opts.stackSerializer = func(_ interface{}, _ *config.Environment, _ *config.Application, _ stack.RuntimeConfig) (stackSerializer, error) {
+					mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+					mockStackSerializer.EXPECT().Template().Return("mystack", nil)
+					mockStackSerializer.EXPECT().SerializedParameters().Return("myparams", nil)
+					return mockStackSerializer, nil
+				}
+			},
+
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
@@ -341,6 +581,10 @@ count: 1`), nil)
 				stackWriter:  stackBuf,
 				paramsWriter: paramsBuf,
 				addonsWriter: addonsBuf,
+				fs:           &afero.Afero{Fs: afero.NewMemMapFs()},
+				envOutputExports: func(*config.Environment) (map[string]string, error) {
+					return nil, nil
+				},
 			}
 			tc.mockDependencies(ctrl, opts)
 
@@ -352,6 +596,18 @@ count: 1`), nil)
 			require.Equal(t, tc.wantedStack, stackBuf.String())
 			require.Equal(t, tc.wantedParams, paramsBuf.String())
 			require.Equal(t, tc.wantedAddons, addonsBuf.String())
+			if tc.wantedFiles != nil {
+				entries, err := afero.ReadDir(opts.fs, tc.inVars.outputDir)
+				require.NoError(t, err)
+				var names []string
+				for _, entry := range entries {
+					names = append(names, entry.Name())
+					content, err := afero.ReadFile(opts.fs, filepath.Join(tc.inVars.outputDir, entry.Name()))
+					require.NoError(t, err)
+					require.Equal(t, tc.wantedFiles[entry.Name()], string(content))
+				}
+				require.Equal(t, tc.wantedFileNames, names)
+			}
 		})
 	}
 }
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPauseAppNamePrompt     = "Which application is the service in?"
+	svcPauseAppNameHelpPrompt = "An application groups all of your services together."
+	svcPauseNamePrompt        = "Which service would you like to pause?"
+	svcPauseNameHelpPrompt    = "The service's tasks are stopped until it's resumed."
+
+	fmtSvcPauseStart    = "Pausing service %s in environment %s."
+	fmtSvcPauseFailed   = "Failed to pause service %s in environment %s.\n"
+	fmtSvcPauseComplete = "Paused service %s in environment %s.\n"
+)
+
+type svcPauseVars struct {
+	appName string
+	envName string
+	svcName string
+	force   bool // true means the autoscaling of the service is suspended as well.
+}
+
+type svcPauseOpts struct {
+	svcPauseVars
+
+	store      store
+	sel        deploySelector
+	prog       progress
+	pauser     servicePauser
+	initPauser func(*svcPauseOpts) error // Overridden in tests.
+}
+
+func newSvcPauseOpts(vars svcPauseVars) (*svcPauseOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, configStore)
+	return &svcPauseOpts{
+		svcPauseVars: vars,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore, selOpts...),
+		prog:         termprogress.NewSpinner(),
+		initPauser: func(o *svcPauseOpts) error {
+			env, err := configStore.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			o.pauser = ecs.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPauseOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPauseOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcPauseAppNamePrompt, svcPauseAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute scales the service down to zero tasks.
+func (o *svcPauseOpts) Execute() error {
+	if err := o.initPauser(o); err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcPauseStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	if err := o.pauser.PauseService(o.appName, o.envName, o.svcName, o.force); err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcPauseFailed, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+		var errAutoscaling *ecs.ErrAutoscalingEnabled
+		if errors.As(err, &errAutoscaling) {
+			return fmt.Errorf("%w: specify --%s to also set its minimum and maximum capacity to 0 until it's resumed", err, forceFlag)
+		}
+		return fmt.Errorf("pause service %s: %w", o.svcName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcPauseComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcPauseOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to start the service's tasks again.",
+			color.HighlightCode(fmt.Sprintf("copilot svc resume -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+// buildSvcPauseCmd builds the command for pausing a deployed service.
+func buildSvcPauseCmd() *cobra.Command {
+	vars := svcPauseVars{}
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Pauses a deployed service.",
+		Long: `Pauses a deployed service by setting its desired count to 0, without deleting its stack.
+The desired count is restored with "copilot svc resume". Redeploying the service also restores it.`,
+
+		Example: `
+  Pause the service "my-svc" in the "test" environment.
+  /code $ copilot svc pause -n my-svc -e test
+  Pause the service "my-svc" and suspend its autoscaling.
+  /code $ copilot svc pause -n my-svc -e test --force`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPauseOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, svcPauseForceFlagDescription)
+	return cmd
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPause_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp         string
+		inputSvc         string
+		inputEnvironment string
+		mockSelector     func(m *mocks.MockdeploySelector)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcPauseAppNamePrompt, svcPauseAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "mockApp",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application mockApp: some error"),
+		},
+		"success": {
+			inputApp: "mockApp",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "mockEnv",
+						Svc: "mockSvc",
+					}, nil)
+			},
+
+			wantedSvc: "mockSvc",
+			wantedEnv: "mockEnv",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			svcPause := &svcPauseOpts{
+				svcPauseVars: svcPauseVars{
+					svcName: tc.inputSvc,
+					envName: tc.inputEnvironment,
+					appName: tc.inputApp,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := svcPause.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSvc, svcPause.svcName)
+				require.Equal(t, tc.wantedEnv, svcPause.envName)
+			}
+		})
+	}
+}
+
+func TestSvcPause_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inputForce bool
+		mockPauser func(m *mocks.MockservicePauser)
+
+		wantedError error
+	}{
+		"errors with a hint to use --force if the service autoscales": {
+			mockPauser: func(m *mocks.MockservicePauser) {
+				m.EXPECT().PauseService("mockApp", "mockEnv", "mockSvc", false).Return(&ecs.ErrAutoscalingEnabled{Svc: "mockSvc"})
+			},
+			wantedError: errors.New("service mockSvc has autoscaling enabled: specify --force to also set its minimum and maximum capacity to 0 until it's resumed"),
+		},
+		"errors if failed to pause the service": {
+			mockPauser: func(m *mocks.MockservicePauser) {
+				m.EXPECT().PauseService("mockApp", "mockEnv", "mockSvc", false).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("pause service mockSvc: some error"),
+		},
+		"success with autoscaling suspended": {
+			inputForce: true,
+			mockPauser: func(m *mocks.MockservicePauser) {
+				m.EXPECT().PauseService("mockApp", "mockEnv", "mockSvc", true).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPauser := mocks.NewMockservicePauser(ctrl)
+			tc.mockPauser(mockPauser)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			mockProgress.EXPECT().Start(gomock.Any())
+			mockProgress.EXPECT().Stop(gomock.Any())
+
+			svcPause := &svcPauseOpts{
+				svcPauseVars: svcPauseVars{
+					appName: "mockApp",
+					envName: "mockEnv",
+					svcName: "mockSvc",
+					force:   tc.inputForce,
+				},
+				prog:       mockProgress,
+				pauser:     mockPauser,
+				initPauser: func(*svcPauseOpts) error { return nil },
+			}
+
+			// WHEN
+			err := svcPause.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
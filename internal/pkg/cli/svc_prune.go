@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPruneAppNamePrompt     = "Which application is the service in?"
+	svcPruneAppNameHelpPrompt = "An application groups all of your services together."
+	svcPruneNamePrompt        = "Which service's task definitions would you like to prune?"
+	svcPruneNameHelpPrompt    = "The old task definition revisions of the service are deregistered."
+)
+
+type svcPruneVars struct {
+	appName string
+	envName string
+	svcName string
+	keep    int  // Number of newest revisions kept in addition to the one in use and the one before it.
+	dryRun  bool // true means the revisions are listed without being deregistered.
+}
+
+type svcPruneOpts struct {
+	svcPruneVars
+
+	store      store
+	sel        deploySelector
+	pruner     taskDefinitionPruner
+	initPruner func(*svcPruneOpts) error // Overridden in tests.
+}
+
+func newSvcPruneOpts(vars svcPruneVars) (*svcPruneOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, configStore)
+	return &svcPruneOpts{
+		svcPruneVars: vars,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore, selOpts...),
+		initPruner: func(o *svcPruneOpts) error {
+			env, err := configStore.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			o.pruner = ecs.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPruneOpts) Validate() error {
+	if o.keep < 0 {
+		return fmt.Errorf("--%s cannot be negative", keepFlag)
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPruneOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcPruneAppNamePrompt, svcPruneAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcPruneNamePrompt, svcPruneNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute deregisters the old task definition revisions of the service, or lists them on a dry run.
+func (o *svcPruneOpts) Execute() error {
+	if err := o.initPruner(o); err != nil {
+		return err
+	}
+	stale, err := o.pruner.StaleTaskDefinitions(o.appName, o.envName, o.svcName, o.keep)
+	if err != nil {
+		return fmt.Errorf("find task definitions of service %s to deregister: %w", o.svcName, err)
+	}
+	if len(stale) == 0 {
+		log.Infof("No task definitions of %s in %s to deregister.\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	if o.dryRun {
+		log.Infof("The following task definitions of %s in %s would be deregistered:\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+		for _, taskDefARN := range stale {
+			log.Infof("- %s\n", color.HighlightResource(taskDefARN))
+		}
+		return nil
+	}
+	deregistered := deregisterTaskDefinitions(o.pruner, stale)
+	log.Successf("Deregistered %d of %d old task definitions of %s in %s.\n", deregistered, len(stale),
+		color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+	return nil
+}
+
+// pruneTaskDefinitions deregisters the task definition revisions of a service except for the newest keep ones,
+// the one in use and the one before it. Failures are logged as warnings since the service is already deployed.
+func pruneTaskDefinitions(pruner taskDefinitionPruner, app, env, svc string, keep int) {
+	stale, err := pruner.StaleTaskDefinitions(app, env, svc, keep)
+	if err != nil {
+		log.Warningf("Couldn't find the old task definitions of %s to deregister: %v\n", svc, err)
+		return
+	}
+	if len(stale) == 0 {
+		return
+	}
+	deregistered := deregisterTaskDefinitions(pruner, stale)
+	log.Infof("Deregistered %d of %d old task definitions of %s.\n", deregistered, len(stale), color.HighlightUserInput(svc))
+}
+
+// deregisterTaskDefinitions deregisters each revision and returns how many were deregistered.
+// A revision that can't be deregistered is logged as a warning and doesn't stop the others.
+func deregisterTaskDefinitions(pruner taskDefinitionPruner, taskDefARNs []string) int {
+	var deregistered int
+	for _, taskDefARN := range taskDefARNs {
+		if err := pruner.DeregisterTaskDefinition(taskDefARN); err != nil {
+			log.Warningf("Couldn't deregister an old task definition: %v\n", err)
+			continue
+		}
+		deregistered++
+	}
+	return deregistered
+}
+
+// buildSvcPruneCmd builds the command for deregistering the old task definitions of a deployed service.
+func buildSvcPruneCmd() *cobra.Command {
+	vars := svcPruneVars{}
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Deregisters the old task definitions of a deployed service.",
+		Long: `Deregisters the old task definition revisions of a deployed service.
+The revision used by the service and the one before it are always kept so that the service can be rolled back.`,
+
+		Example: `
+  Deregister all but the newest 10 task definitions of the service "my-svc" in the "test" environment.
+  /code $ copilot svc prune -n my-svc -e test --keep 10
+  List the task definitions that would be deregistered without deregistering them.
+  /code $ copilot svc prune -n my-svc -e test --keep 10 --dry-run`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPruneOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.keep, keepFlag, 0, svcPruneKeepFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, svcPruneDryRunFlagDescription)
+	return cmd
+}
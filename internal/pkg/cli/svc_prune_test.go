@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPrune_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputKeep  int
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if the number of revisions to keep is negative": {
+			inputKeep:   -1,
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New("--keep cannot be negative"),
+		},
+		"errors if the application doesn't exist": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("mockApp").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			svcPrune := &svcPruneOpts{
+				svcPruneVars: svcPruneVars{
+					appName: "mockApp",
+					keep:    tc.inputKeep,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := svcPrune.Validate()
+
+			// THEN
+			require.EqualError(t, err, tc.wantedError.Error())
+		})
+	}
+}
+
+func TestSvcPrune_Execute(t *testing.T) {
+	const (
+		mockRevision1 = "arn:aws:ecs:us-west-2:123456789012:task-definition/mockApp-mockEnv-mockSvc:1"
+		mockRevision2 = "arn:aws:ecs:us-west-2:123456789012:task-definition/mockApp-mockEnv-mockSvc:2"
+	)
+	testCases := map[string]struct {
+		inputDryRun bool
+		mockPruner  func(m *mocks.MocktaskDefinitionPruner)
+
+		wantedError error
+	}{
+		"errors if failed to find the task definitions to deregister": {
+			mockPruner: func(m *mocks.MocktaskDefinitionPruner) {
+				m.EXPECT().StaleTaskDefinitions("mockApp", "mockEnv", "mockSvc", 10).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("find task definitions of service mockSvc to deregister: some error"),
+		},
+		"doesn't deregister anything on a dry run": {
+			inputDryRun: true,
+			mockPruner: func(m *mocks.MocktaskDefinitionPruner) {
+				m.EXPECT().StaleTaskDefinitions("mockApp", "mockEnv", "mockSvc", 10).Return([]string{mockRevision2, mockRevision1}, nil)
+				m.EXPECT().DeregisterTaskDefinition(gomock.Any()).Times(0)
+			},
+		},
+		"keeps deregistering the other revisions if one fails": {
+			mockPruner: func(m *mocks.MocktaskDefinitionPruner) {
+				gomock.InOrder(
+					m.EXPECT().StaleTaskDefinitions("mockApp", "mockEnv", "mockSvc", 10).Return([]string{mockRevision2, mockRevision1}, nil),
+					m.EXPECT().DeregisterTaskDefinition(mockRevision2).Return(errors.New("some error")),
+					m.EXPECT().DeregisterTaskDefinition(mockRevision1).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPruner := mocks.NewMocktaskDefinitionPruner(ctrl)
+			tc.mockPruner(mockPruner)
+
+			svcPrune := &svcPruneOpts{
+				svcPruneVars: svcPruneVars{
+					appName: "mockApp",
+					envName: "mockEnv",
+					svcName: "mockSvc",
+					keep:    10,
+					dryRun:  tc.inputDryRun,
+				},
+				pruner:     mockPruner,
+				initPruner: func(*svcPruneOpts) error { return nil },
+			}
+
+			// WHEN
+			err := svcPrune.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcResumeAppNamePrompt     = "Which application is the service in?"
+	svcResumeAppNameHelpPrompt = "An application groups all of your services together."
+	svcResumeNamePrompt        = "Which service would you like to resume?"
+	svcResumeNameHelpPrompt    = "The service's desired count, and its autoscaling if it was suspended, are restored."
+
+	fmtSvcResumeStart    = "Resuming service %s in environment %s."
+	fmtSvcResumeFailed   = "Failed to resume service %s in environment %s.\n"
+	fmtSvcResumeComplete = "Resumed service %s in environment %s.\n"
+)
+
+type svcResumeVars struct {
+	appName string
+	envName string
+	svcName string
+}
+
+type svcResumeOpts struct {
+	svcResumeVars
+
+	store       store
+	sel         deploySelector
+	prog        progress
+	resumer     serviceResumer
+	initResumer func(*svcResumeOpts) error // Overridden in tests.
+}
+
+func newSvcResumeOpts(vars svcResumeVars) (*svcResumeOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, configStore)
+	return &svcResumeOpts{
+		svcResumeVars: vars,
+		store:         configStore,
+		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore, selOpts...),
+		prog:          termprogress.NewSpinner(),
+		initResumer: func(o *svcResumeOpts) error {
+			env, err := configStore.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			o.resumer = ecs.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcResumeOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcResumeOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcResumeAppNamePrompt, svcResumeAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcResumeNamePrompt, svcResumeNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute restores the desired count of a paused service.
+func (o *svcResumeOpts) Execute() error {
+	if err := o.initResumer(o); err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcResumeStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	if err := o.resumer.ResumeService(o.appName, o.envName, o.svcName); err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcResumeFailed, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+		return fmt.Errorf("resume service %s: %w", o.svcName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcResumeComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	return nil
+}
+
+// buildSvcResumeCmd builds the command for resuming a paused service.
+func buildSvcResumeCmd() *cobra.Command {
+	vars := svcResumeVars{}
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resumes a paused service.",
+		Long:  `Resumes a service paused with "copilot svc pause" by restoring its desired count, and its autoscaling if it was suspended.`,
+
+		Example: `
+  Resume the paused service "my-svc" in the "test" environment.
+  /code $ copilot svc resume -n my-svc -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcResumeOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,95 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcResume_Ask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSelector := mocks.NewMockdeploySelector(ctrl)
+	mockSelector.EXPECT().Application(gomock.Any(), gomock.Any()).Times(0)
+	mockSelector.EXPECT().DeployedService(svcResumeNamePrompt, svcResumeNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+		Return(&selector.DeployedService{
+			Env: "mockEnv",
+			Svc: "mockSvc",
+		}, nil)
+	svcResume := &svcResumeOpts{
+		svcResumeVars: svcResumeVars{
+			appName: "mockApp",
+		},
+		sel: mockSelector,
+	}
+
+	// WHEN
+	err := svcResume.Ask()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "mockSvc", svcResume.svcName)
+	require.Equal(t, "mockEnv", svcResume.envName)
+}
+
+func TestSvcResume_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockResumer func(m *mocks.MockserviceResumer)
+
+		wantedError error
+	}{
+		"errors if failed to resume the service": {
+			mockResumer: func(m *mocks.MockserviceResumer) {
+				m.EXPECT().ResumeService("mockApp", "mockEnv", "mockSvc").Return(errors.New("service mockSvc is not paused"))
+			},
+			wantedError: errors.New("resume service mockSvc: service mockSvc is not paused"),
+		},
+		"success": {
+			mockResumer: func(m *mocks.MockserviceResumer) {
+				m.EXPECT().ResumeService("mockApp", "mockEnv", "mockSvc").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockResumer := mocks.NewMockserviceResumer(ctrl)
+			tc.mockResumer(mockResumer)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			mockProgress.EXPECT().Start(gomock.Any())
+			mockProgress.EXPECT().Stop(gomock.Any())
+
+			svcResume := &svcResumeOpts{
+				svcResumeVars: svcResumeVars{
+					appName: "mockApp",
+					envName: "mockEnv",
+					svcName: "mockSvc",
+				},
+				prog:        mockProgress,
+				resumer:     mockResumer,
+				initResumer: func(*svcResumeOpts) error { return nil },
+			}
+
+			// WHEN
+			err := svcResume.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -64,6 +65,13 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		if err != nil {
 			return err
 		}
+		// The workspace is optional, its manifest is only used to describe a service that's not deployed yet.
+		var manifestReader describe.WorkspaceManifestReader
+		if ws, err := workspace.New(); err == nil {
+			if summary, err := ws.Summary(); err == nil && summary.Application == opts.appName {
+				manifestReader = ws
+			}
+		}
 		switch svc.Type {
 		case manifest.LoadBalancedWebServiceType:
 			d, err = describe.NewWebServiceDescriber(describe.NewWebServiceConfig{
@@ -74,6 +82,7 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 				},
 				DeployStore:     deployStore,
 				EnableResources: opts.shouldOutputResources,
+				Workspace:       manifestReader,
 			})
 		case manifest.BackendServiceType:
 			d, err = describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
@@ -84,6 +93,7 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 				},
 				DeployStore:     deployStore,
 				EnableResources: opts.shouldOutputResources,
+				Workspace:       manifestReader,
 			})
 		default:
 			return fmt.Errorf("invalid service type %s", svc.Type)
@@ -25,6 +25,8 @@ const (
 
 type svcStatusVars struct {
 	shouldOutputJSON bool
+	shouldShowEvents bool
+	shouldShowTasks  bool
 	svcName          string
 	envName          string
 	appName          string
@@ -49,17 +51,21 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
+	var selOpts []selector.SelectOption
+	vars.envName, selOpts = defaultEnv(vars.envName, vars.appName, configStore)
 	return &svcStatusOpts{
 		svcStatusVars: vars,
 		store:         configStore,
 		w:             log.OutputWriter,
-		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore, selOpts...),
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			d, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
 				App:         o.appName,
 				Env:         o.envName,
 				Svc:         o.svcName,
 				ConfigStore: configStore,
+				WithEvents:  o.shouldShowEvents,
+				WithTasks:   o.shouldShowTasks,
 			})
 			if err != nil {
 				return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.appName, err)
@@ -149,11 +155,18 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Shows status of a deployed service.",
-		Long:  "Shows status of a deployed service's task status, most recent deployment and alarm statuses.",
+		Long: `Shows status of a deployed service's task status, most recent deployment and alarm statuses.
+With --events, also shows the service's deployments and recent events, where repeated events are collapsed
+and task placement failures are highlighted.
+With --tasks, also shows the image, status, health and exit code of each container of the running tasks.`,
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Shows the deployments and recent events of the service "my-svc" in the "test" environment
+  /code $ copilot svc status -n my-svc -e test --events
+  Shows the status of each container of the tasks of the service "my-svc", such as an unhealthy sidecar
+  /code $ copilot svc status -n my-svc --tasks`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -172,5 +185,7 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowEvents, eventsFlag, false, svcStatusEventsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowTasks, tasksFlag, false, svcStatusTasksFlagDescription)
 	return cmd
 }
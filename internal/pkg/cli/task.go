@@ -18,6 +18,7 @@ One-off Amazon ECS tasks that terminate once their work is done.`,
 	}
 
 	cmd.AddCommand(BuildTaskRunCmd())
+	cmd.AddCommand(buildTaskLogsCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
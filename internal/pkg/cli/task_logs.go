@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type taskLogsVars struct {
+	groupName        string
+	appName          string
+	env              string
+	taskIDs          []string
+	follow           bool
+	shouldOutputJSON bool
+	humanSince       string
+	humanStartTime   string
+	humanEndTime     string
+}
+
+type taskLogsOpts struct {
+	taskLogsVars
+
+	// internal states
+	startTime *int64
+	endTime   *int64
+
+	store       store
+	logsSvc     logEventsWriter
+	initLogsSvc func() error // Overriden in tests.
+}
+
+func newTaskLogsOpts(vars taskLogsVars) (*taskLogsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &taskLogsOpts{
+		taskLogsVars: vars,
+		store:        store,
+	}
+	opts.initLogsSvc = func() error {
+		sess, err := opts.session()
+		if err != nil {
+			return err
+		}
+		opts.logsSvc = logging.NewTaskGroupClient(sess, opts.groupName)
+		return nil
+	}
+	return opts, nil
+}
+
+// session returns the session of the environment the tasks ran in, or the default session if no environment is specified.
+func (o *taskLogsOpts) session() (*session.Session, error) {
+	provider := sessions.NewProvider()
+	if o.env == "" {
+		sess, err := provider.Default()
+		if err != nil {
+			return nil, fmt.Errorf("get default session: %w", err)
+		}
+		return sess, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s config: %w", o.env, err)
+	}
+	sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return sess, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *taskLogsOpts) Validate() error {
+	if o.groupName != "" {
+		if err := basicNameValidation(o.groupName); err != nil {
+			return err
+		}
+	}
+
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application: %w", err)
+		}
+	}
+
+	if o.env != "" && o.appName == "" {
+		return errNoAppInWorkspace
+	}
+
+	startTime, endTime, err := parseLogsTimeWindow(o.humanSince, o.humanStartTime, o.humanEndTime, o.follow, time.Now())
+	if err != nil {
+		return err
+	}
+	o.startTime, o.endTime = startTime, endTime
+
+	return nil
+}
+
+// Ask defaults the task group name to the name of the working directory, like "task run" does.
+func (o *taskLogsOpts) Ask() error {
+	if o.groupName != "" {
+		return nil
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("get working directory, please use --%s to specify a task group name: %w", taskGroupNameFlag, err)
+	}
+	o.groupName = filepath.Base(dir)
+	return nil
+}
+
+// Execute outputs logs of the tasks in the task group.
+func (o *taskLogsOpts) Execute() error {
+	if err := o.initLogsSvc(); err != nil {
+		return err
+	}
+	eventsWriter := logging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = logging.WriteJSONLogs
+	}
+	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
+		Follow:    o.follow,
+		EndTime:   o.endTime,
+		StartTime: o.startTime,
+		TaskIDs:   o.taskIDs,
+		OnEvents:  eventsWriter,
+	})
+	var errLogGroupNotFound *cloudwatchlogs.ErrLogGroupNotFound
+	var errNoLogStreams *cloudwatchlogs.ErrNoLogStreams
+	if errors.As(err, &errLogGroupNotFound) || errors.As(err, &errNoLogStreams) {
+		log.Infof("No logs found for task group %s, they are expected in the log group %s.\n",
+			color.HighlightUserInput(o.groupName), color.HighlightResource(logging.TaskLogGroupName(o.groupName)))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("write log events for task group %s: %w", o.groupName, err)
+	}
+	return nil
+}
+
+// buildTaskLogsCmd builds the command for displaying the logs of one-off tasks.
+func buildTaskLogsCmd() *cobra.Command {
+	vars := taskLogsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of one-off tasks.",
+
+		Example: `
+  Displays logs of the most recent tasks in the task group "db-migrate".
+  /code $ copilot task logs -n db-migrate
+  Displays logs of a task that ran in the "test" environment.
+  /code $ copilot task logs -n db-migrate --app my-app --env test --task-id 4f8243e83f8a4bdaa7587fa1eaff2ea3
+  Displays logs in the last hour.
+  /code $ copilot task logs -n db-migrate --since 1h
+  Displays logs in real time.
+  /code $ copilot task logs -n db-migrate --follow`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newTaskLogsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.groupName, taskGroupNameFlag, nameFlagShort, "", taskLogsGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.env, envFlag, "", envFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.taskIDs, taskIDFlag, nil, taskIDLogsFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().StringVar(&vars.humanSince, sinceFlag, "", sinceFlagDescription)
+	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
+	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLogs_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputGroup     string
+		inputApp       string
+		inputEnv       string
+		inputFollow    bool
+		inputSince     string
+		inputStartTime string
+		inputEndTime   string
+
+		mockStore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"with no flag set": {
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"invalid task group name": {
+			inputGroup: "1-db-migrate",
+
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errValueBadFormat,
+		},
+		"invalid application name": {
+			inputApp: "my-app",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get application: some error"),
+		},
+		"environment without an application": {
+			inputEnv: "test",
+
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"returns error if both since and start-time flags are set": {
+			inputSince:     "1m",
+			inputStartTime: "1970-01-01T01:01:01+00:00",
+
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("only one of --since or --start-time may be used"),
+		},
+		"returns error if both follow and end-time flags are set": {
+			inputFollow:  true,
+			inputEndTime: "1971-01-01T01:01:01+00:00",
+
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("only one of --follow or --end-time may be used"),
+		},
+		"returns error if invalid end time flag value": {
+			inputEndTime: "badEndTime",
+
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf(`invalid argument badEndTime for "--end-time" flag: reading time value badEndTime: parsing time "badEndTime" as "2006-01-02T15:04:05Z07:00": cannot parse "badEndTime" as "2006"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &taskLogsOpts{
+				taskLogsVars: taskLogsVars{
+					groupName:      tc.inputGroup,
+					appName:        tc.inputApp,
+					env:            tc.inputEnv,
+					follow:         tc.inputFollow,
+					humanSince:     tc.inputSince,
+					humanStartTime: tc.inputStartTime,
+					humanEndTime:   tc.inputEndTime,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskLogs_Execute(t *testing.T) {
+	mockStartTime := int64(123456789)
+	mockEndTime := int64(987654321)
+	testCases := map[string]struct {
+		follow  bool
+		taskIDs []string
+
+		mockLogsSvc func(ctrl *gomock.Controller) logEventsWriter
+
+		wantedError error
+	}{
+		"success": {
+			follow:  true,
+			taskIDs: []string{"mockTaskID"},
+
+			mockLogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, []string{"mockTaskID"}, param.TaskIDs)
+					require.Equal(t, &mockEndTime, param.EndTime)
+					require.Equal(t, &mockStartTime, param.StartTime)
+					require.True(t, param.Follow)
+				}).Return(nil)
+				return m
+			},
+		},
+		"does not return an error if the log group does not exist": {
+			mockLogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(fmt.Errorf("get task log events for log group /copilot/db-migrate: %w", &cloudwatchlogs.ErrLogGroupNotFound{}))
+				return m
+			},
+		},
+		"does not return an error if the task group never logged anything": {
+			mockLogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(fmt.Errorf("get task log events for log group /copilot/db-migrate: %w", &cloudwatchlogs.ErrNoLogStreams{}))
+				return m
+			},
+		},
+		"returns error if fail to write log events": {
+			mockLogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+
+			wantedError: errors.New("write log events for task group db-migrate: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := &taskLogsOpts{
+				taskLogsVars: taskLogsVars{
+					groupName: "db-migrate",
+					follow:    tc.follow,
+					taskIDs:   tc.taskIDs,
+				},
+				startTime:   &mockStartTime,
+				endTime:     &mockEndTime,
+				initLogsSvc: func() error { return nil },
+				logsSvc:     tc.mockLogsSvc(ctrl),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -62,6 +64,13 @@ var (
 Select %s to run the task in your default VPC instead of any existing application.`, color.Emphasize(appEnvOptionNone))
 	taskRunEnvPromptHelp = fmt.Sprintf(`Task will be deployed to the selected environment.
 Select %s to run the task in your default VPC instead of any existing environment.`, color.Emphasize(appEnvOptionNone))
+
+	taskRunCommandConfirmPrompt = fmt.Sprintf("Would you like to override the %s of the task's container?", color.Emphasize("command"))
+	taskRunCommandConfirmHelp   = `By default, the container runs the command of its image.`
+	taskRunCommandPrompt        = fmt.Sprintf("What %s would you like to run in the task?", color.Emphasize("command"))
+	taskRunCommandHelp          = `The command is split into arguments using shell-style rules, for example:
+python migrate-script.py --table "user sessions"
+Arguments can span multiple lines.`
 )
 
 type runTaskVars struct {
@@ -88,7 +97,10 @@ type runTaskVars struct {
 	command      string
 	resourceTags map[string]string
 
-	follow bool
+	follow           bool
+	wait             bool // Wait for the tasks to stop and exit with the exit code of their essential container.
+	shouldOutputJSON bool
+	generateCmd      bool
 }
 
 type runTaskOpts struct {
@@ -96,10 +108,13 @@ type runTaskOpts struct {
 	isDockerfileSet bool
 
 	// Interfaces to interact with dependencies.
-	fs      afero.Fs
-	store   store
-	sel     appEnvSelector
-	spinner progress
+	fs          afero.Fs
+	store       store
+	sel         appEnvSelector
+	prompt      prompter
+	spinner     progress
+	w           io.Writer
+	interactive bool // True if the user can answer prompts that aren't required, like the command to run.
 
 	// Fields below are configured at runtime.
 	deployer             taskDeployer
@@ -107,8 +122,10 @@ type runTaskOpts struct {
 	runner               taskRunner
 	eventsWriter         eventsWriter
 	defaultClusterGetter defaultClusterGetter
+	stoppedTasksWaiter   stoppedTasksWaiter
 
 	sess              *session.Session
+	targetApp         *config.Application
 	targetEnvironment *config.Environment
 
 	// Configurer methods.
@@ -124,19 +141,31 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
 
+	var selOpts []selector.SelectOption
+	if vars.appName != "" {
+		vars.env, selOpts = defaultEnv(vars.env, vars.appName, store)
+	} else if vars.env == "" {
+		// The application is selected later, so only pre-select the workspace's default environment in the prompt.
+		_, selOpts = defaultEnv("", tryReadingAppName(), store)
+	}
+	prompter := prompt.New()
 	opts := runTaskOpts{
 		runTaskVars: vars,
 
-		fs:      &afero.Afero{Fs: afero.NewOsFs()},
-		store:   store,
-		sel:     selector.NewSelect(prompt.New(), store),
-		spinner: termprogress.NewSpinner(),
+		fs:          &afero.Afero{Fs: afero.NewOsFs()},
+		store:       store,
+		sel:         selector.NewSelect(prompter, store, selOpts...),
+		prompt:      prompter,
+		spinner:     termprogress.NewSpinner(),
+		w:           log.OutputWriter,
+		interactive: prompt.IsInteractive(),
 	}
 
 	opts.configureRuntimeOpts = func() error {
 		opts.runner = opts.configureRunner()
 		opts.deployer = cloudformation.New(opts.sess)
 		opts.defaultClusterGetter = awsecs.New(opts.sess)
+		opts.stoppedTasksWaiter = awsecs.New(opts.sess)
 		return nil
 	}
 
@@ -169,6 +198,8 @@ func (o *runTaskOpts) configureRunner() taskRunner {
 			App: o.appName,
 			Env: o.env,
 
+			AdditionalSecurityGroups: o.targetEnvironment.ImportedSecurityGroupIDs(),
+
 			VPCGetter:     vpcGetter,
 			ClusterGetter: ecs.New(o.sess),
 			Starter:       ecsService,
@@ -191,8 +222,16 @@ func (o *runTaskOpts) configureRunner() taskRunner {
 
 func (o *runTaskOpts) configureSessAndEnv() error {
 	var sess *session.Session
+	var app *config.Application
 	var env *config.Environment
 
+	if o.appName != "" {
+		var err error
+		app, err = o.store.GetApplication(o.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
 	provider := sessions.NewProvider()
 	if o.env != "" {
 		var err error
@@ -213,6 +252,7 @@ func (o *runTaskOpts) configureSessAndEnv() error {
 		}
 	}
 
+	o.targetApp = app
 	o.targetEnvironment = env
 	o.sess = sess
 	return nil
@@ -242,6 +282,22 @@ func (o *runTaskOpts) Validate() error {
 		return errors.New("cannot specify both `--image` and `--dockerfile`")
 	}
 
+	if o.generateCmd && o.follow {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", generateCmdFlag, followFlag)
+	}
+
+	if o.generateCmd && o.wait {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", generateCmdFlag, waitFlag)
+	}
+
+	if o.shouldOutputJSON && o.follow {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", jsonFlag, followFlag)
+	}
+
+	if o.shouldOutputJSON && !o.wait {
+		return fmt.Errorf("`--%s` can only be used with `--%s`", jsonFlag, waitFlag)
+	}
+
 	if o.isDockerfileSet {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return err
@@ -340,7 +396,7 @@ func (o *runTaskOpts) Ask() error {
 			return err
 		}
 	}
-	return nil
+	return o.askCommand()
 }
 
 func (o *runTaskOpts) shouldPromptForAppEnv() bool {
@@ -409,6 +465,10 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	if o.generateCmd {
+		return o.printRunTaskCmd()
+	}
+
 	tasks, err := o.runTask()
 	if err != nil {
 		return err
@@ -420,6 +480,9 @@ func (o *runTaskOpts) Execute() error {
 			return err
 		}
 	}
+	if o.follow || o.wait {
+		return o.waitUntilStopped(tasks)
+	}
 	return nil
 }
 
@@ -434,6 +497,82 @@ func (o *runTaskOpts) displayLogStream() error {
 	return nil
 }
 
+// waitUntilStopped waits for the tasks to stop, writes why they stopped, and returns an error with the exit code
+// of the first task that failed.
+func (o *runTaskOpts) waitUntilStopped(tasks []*task.Task) error {
+	if !o.follow {
+		o.spinner.Start(fmt.Sprintf("Waiting for %s %s to stop.", english.PluralWord(o.count, "task", ""), o.groupName))
+	}
+	stopped, err := task.WaitUntilStopped(o.stoppedTasksWaiter, tasks)
+	if err != nil {
+		if !o.follow {
+			o.spinner.Stop(log.Serrorf("Failed to wait for %s %s to stop.\n\n", english.PluralWord(o.count, "task", ""), o.groupName))
+		}
+		return fmt.Errorf("wait for tasks %s to stop: %w", o.groupName, err)
+	}
+	if !o.follow {
+		o.spinner.Stop(log.Ssuccessf("%s %s %s stopped.\n\n", english.PluralWord(o.count, "Task", ""), o.groupName, english.PluralWord(o.count, "has", "have")))
+	}
+	if o.shouldOutputJSON {
+		data, err := stoppedTasksJSON(stopped)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		for _, t := range stopped {
+			log.Infof("Task %s stopped: %s\n", t.Status.ID, t.StoppedReason)
+		}
+	}
+	return stoppedTasksErr(stopped)
+}
+
+func stoppedTasksJSON(tasks []*task.Task) (string, error) {
+	type serializedTasks struct {
+		Tasks []*awsecs.TaskStatus `json:"tasks"`
+	}
+	statuses := make([]*awsecs.TaskStatus, len(tasks))
+	for idx, t := range tasks {
+		statuses[idx] = t.Status
+	}
+	b, err := json.Marshal(serializedTasks{Tasks: statuses})
+	if err != nil {
+		return "", fmt.Errorf("marshal tasks: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// stoppedTasksErr returns an error with the exit code of the first task whose essential container exited with
+// a non-zero code. If a task stopped before its container could exit, such as when the image can't be pulled,
+// the error has the generic failure exit code.
+func stoppedTasksErr(tasks []*task.Task) error {
+	for _, t := range tasks {
+		if t.ExitCode == nil {
+			return &errWithExitCode{
+				err:  fmt.Errorf("task %s stopped before its essential container exited: %s", t.Status.ID, t.StoppedReason),
+				code: exitCodeFailure,
+			}
+		}
+		if *t.ExitCode != 0 {
+			return &errWithExitCode{
+				err:  fmt.Errorf("essential container of task %s exited with code %d", t.Status.ID, *t.ExitCode),
+				code: *t.ExitCode,
+			}
+		}
+	}
+	return nil
+}
+
+// printRunTaskCmd prints the AWS CLI command that runs the tasks with the same input as runTask.
+func (o *runTaskOpts) printRunTaskCmd() error {
+	input, err := o.runner.Input()
+	if err != nil {
+		return fmt.Errorf("generate the command to run task %s: %w", o.groupName, err)
+	}
+	fmt.Fprintln(o.w, input.CLICommand())
+	return nil
+}
+
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
 	tasks, err := o.runner.Run()
@@ -504,6 +643,10 @@ func (o *runTaskOpts) deploy() error {
 		Env:            o.env,
 		AdditionalTags: o.resourceTags,
 	}
+	if o.targetApp != nil {
+		// Roles of tasks run in an application are bounded like the roles of its workloads.
+		input.PermissionsBoundary = o.targetApp.PermissionsBoundary
+	}
 	return o.deployer.DeployTask(input, deployOpts...)
 }
 
@@ -568,6 +711,26 @@ func (o *runTaskOpts) askEnvName() error {
 	return nil
 }
 
+func (o *runTaskOpts) askCommand() error {
+	if o.command != "" || !o.interactive {
+		return nil
+	}
+	override, err := o.prompt.Confirm(taskRunCommandConfirmPrompt, taskRunCommandConfirmHelp, prompt.WithFinalMessage("Override command?"))
+	if err != nil {
+		return fmt.Errorf("confirm overriding the command: %w", err)
+	}
+	if !override {
+		return nil
+	}
+	command, err := o.prompt.Get(taskRunCommandPrompt, taskRunCommandHelp, validateCommand,
+		prompt.WithEditor(), prompt.WithFinalMessage("Command:"))
+	if err != nil {
+		return fmt.Errorf("ask for command: %w", err)
+	}
+	o.command = command
+	return nil
+}
+
 func (o *runTaskOpts) targetEnv() (*config.Environment, error) {
 	env, err := o.store.GetEnvironment(o.appName, o.env)
 	if err != nil {
@@ -595,7 +758,11 @@ Run a task with environment variables.
 Run a task using the current workspace with specific subnets and security groups.
 /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
 Run a task with a command.
-/code $ copilot task run --command "python migrate-script.py"`,
+/code $ copilot task run --command "python migrate-script.py"
+Print the "aws ecs run-task" command that runs the task instead of running it.
+/code $ copilot task run -n db-migrate --env test --generate-cmd
+Run a task, wait for it to stop, and output its final status in JSON.
+/code $ copilot task run -n db-migrate --env test --wait --json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -642,8 +809,11 @@ Run a task with a command.
 
 	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, envVarsFlagDescription)
 	cmd.Flags().StringVar(&vars.command, commandFlag, "", commandFlagDescription)
-	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().Var(newResourceTagsValue(&vars.resourceTags), resourceTagsFlag, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, taskWaitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, taskJSONFlagDescription)
+	cmd.Flags().BoolVar(&vars.generateCmd, generateCmdFlag, false, generateCmdFlagDescription)
 	return cmd
 }
@@ -4,11 +4,15 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 
@@ -62,6 +66,11 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault bool
 
+		inFollow      bool
+		inWait        bool
+		inJSON        bool
+		inGenerateCmd bool
+
 		appName         string
 		isDockerfileSet bool
 
@@ -274,6 +283,44 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify both `--subnets` and `--default`"),
 		},
+		"generate-cmd with follow": {
+			basicOpts: defaultOpts,
+
+			inFollow:      true,
+			inGenerateCmd: true,
+
+			wantedError: errors.New("cannot specify both `--generate-cmd` and `--follow`"),
+		},
+		"generate-cmd with wait": {
+			basicOpts: defaultOpts,
+
+			inWait:        true,
+			inGenerateCmd: true,
+
+			wantedError: errors.New("cannot specify both `--generate-cmd` and `--wait`"),
+		},
+		"json with follow": {
+			basicOpts: defaultOpts,
+
+			inFollow: true,
+			inWait:   true,
+			inJSON:   true,
+
+			wantedError: errors.New("cannot specify both `--json` and `--follow`"),
+		},
+		"json without wait": {
+			basicOpts: defaultOpts,
+
+			inJSON: true,
+
+			wantedError: errors.New("`--json` can only be used with `--wait`"),
+		},
+		"valid json with wait": {
+			basicOpts: defaultOpts,
+
+			inWait: true,
+			inJSON: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -299,6 +346,10 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					envVars:           tc.inEnvVars,
 					command:           tc.inCommand,
 					useDefaultSubnets: tc.inDefault,
+					follow:            tc.inFollow,
+					wait:              tc.inWait,
+					shouldOutputJSON:  tc.inJSON,
+					generateCmd:       tc.inGenerateCmd,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 
@@ -331,17 +382,20 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 		inSubnets        []string
 		inSecurityGroups []string
 
-		inDefault bool
-		inEnv     string
-		appName   string
+		inDefault     bool
+		inEnv         string
+		appName       string
+		inCommand     string
+		inInteractive bool
 
 		mockSel    func(m *mocks.MockappEnvSelector)
 		mockPrompt func(m *mocks.Mockprompter)
 
-		wantedError error
-		wantedApp   string
-		wantedEnv   string
-		wantedName  string
+		wantedError   error
+		wantedApp     string
+		wantedEnv     string
+		wantedName    string
+		wantedCommand string
 	}{
 		"selected an existing application": {
 			mockPrompt: func(m *mocks.Mockprompter) {
@@ -503,6 +557,61 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 
 			wantedError: errors.New("ask for environment: error selecting environment"),
 		},
+		"don't prompt for command if command is provided": {
+			inDefault:     true,
+			inCommand:     "python migrate-script.py",
+			inInteractive: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedCommand: "python migrate-script.py",
+		},
+		"don't prompt for command if the user can't answer prompts": {
+			inDefault: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"keep the default command if the user doesn't override it": {
+			inDefault:     true,
+			inInteractive: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(taskRunCommandConfirmPrompt, taskRunCommandConfirmHelp, gomock.Any()).Return(false, nil)
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"ask for the command in an editor": {
+			inDefault:     true,
+			inInteractive: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(taskRunCommandConfirmPrompt, taskRunCommandConfirmHelp, gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(taskRunCommandPrompt, taskRunCommandHelp, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("python migrate-script.py\n  --table users", nil)
+			},
+
+			wantedCommand: "python migrate-script.py\n  --table users",
+		},
+		"error confirming command override": {
+			inDefault:     true,
+			inInteractive: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(taskRunCommandConfirmPrompt, taskRunCommandConfirmHelp, gomock.Any()).Return(false, errors.New("some error"))
+			},
+
+			wantedError: errors.New("confirm overriding the command: some error"),
+		},
+		"error asking for command": {
+			inDefault:     true,
+			inInteractive: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(taskRunCommandConfirmPrompt, taskRunCommandConfirmHelp, gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(taskRunCommandPrompt, taskRunCommandHelp, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("ask for command: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -529,8 +638,11 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 					useDefaultSubnets: tc.inDefault,
 					subnets:           tc.inSubnets,
 					securityGroups:    tc.inSecurityGroups,
+					command:           tc.inCommand,
 				},
-				sel: mockSel,
+				sel:         mockSel,
+				prompt:      mockPrompter,
+				interactive: tc.inInteractive,
 			}
 
 			err := opts.Ask()
@@ -539,6 +651,7 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedEnv, opts.env)
 				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedCommand, opts.command)
 				if tc.wantedName != "" {
 					require.Equal(t, tc.wantedName, opts.groupName)
 				}
@@ -556,6 +669,7 @@ type runTaskMocks struct {
 	store                *mocks.Mockstore
 	eventsWriter         *mocks.MockeventsWriter
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
+	stoppedTasksWaiter   *mocks.MockstoppedTasksWaiter
 }
 
 func mockHasDefaultCluster(m runTaskMocks) {
@@ -572,23 +686,55 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inGroupName = "my-task"
 		mockRepoURI = "uri/repo"
 		tag         = "tag"
+
+		mockTaskARN    = "arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/my-cluster"
+		mockTaskDefARN = "arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-my-task:1"
 	)
+	mockTaskDef := &awsecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name: aws.String("my-task"),
+			},
+		},
+	}
+	mockStoppedTask := func(exitCode *int64) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:           aws.String(mockTaskARN),
+			ClusterArn:        aws.String(mockClusterARN),
+			TaskDefinitionArn: aws.String(mockTaskDefARN),
+			LastStatus:        aws.String("STOPPED"),
+			StoppedReason:     aws.String("Essential container in task exited"),
+			Containers: []*ecs.Container{
+				{
+					Name:     aws.String("my-task"),
+					ExitCode: exitCode,
+				},
+			},
+		}
+	}
 	defaultBuildArguments := docker.BuildArguments{
 		Context:  filepath.Dir(defaultDockerfilePath),
 		ImageTag: imageTagLatest,
 	}
 
 	testCases := map[string]struct {
-		inImage   string
-		inTag     string
-		inFollow  bool
-		inCommand string
-
+		inImage       string
+		inTag         string
+		inFollow      bool
+		inWait        bool
+		inJSON        bool
+		inGenerateCmd bool
+		inCommand     string
+
+		inApp string
 		inEnv string
 
 		setupMocks func(m runTaskMocks)
 
-		wantedError error
+		wantedOutput   string
+		wantedError    error
+		wantedExitCode int
 	}{
 		"check if default cluster exists if deploying to default cluster": {
 			setupMocks: func(m runTaskMocks) {
@@ -613,6 +759,26 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.runner.EXPECT().Run().AnyTimes()
 			},
 		},
+		"provisions the task resources with the permissions boundary of the application": {
+			inApp:   "my-app",
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:                "my-app",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+				}, nil)
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:                inGroupName,
+					Image:               "image",
+					Command:             []string{},
+					App:                 "my-app",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+				}).Return(nil)
+				mockRepositoryAnytime(m)
+				m.runner.EXPECT().Run().AnyTimes()
+				mockHasDefaultCluster(m)
+			},
+		},
 		"error deploying resources": {
 			setupMocks: func(m runTaskMocks) {
 				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).AnyTimes()
@@ -735,6 +901,120 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"error waiting for the tasks to stop": {
+			inImage: "image",
+			inWait:  true,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    mockTaskARN,
+						ClusterARN: mockClusterARN,
+					},
+				}, nil)
+				m.stoppedTasksWaiter.EXPECT().WaitUntilTasksStopped(mockClusterARN, []string{mockTaskARN}).
+					Return(nil, errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("wait for tasks my-task to stop: some error"),
+		},
+		"exit with the exit code of the essential container": {
+			inImage:  "image",
+			inFollow: true,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    mockTaskARN,
+						ClusterARN: mockClusterARN,
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.stoppedTasksWaiter.EXPECT().WaitUntilTasksStopped(mockClusterARN, []string{mockTaskARN}).
+					Return([]*awsecs.Task{mockStoppedTask(aws.Int64(2))}, nil)
+				m.stoppedTasksWaiter.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError:    errors.New("essential container of task 4082490ee6c245e09d2145010aa1ba8d exited with code 2"),
+			wantedExitCode: 2,
+		},
+		"exit with a failure if the task stopped before its container exited": {
+			inImage: "image",
+			inWait:  true,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    mockTaskARN,
+						ClusterARN: mockClusterARN,
+					},
+				}, nil)
+				stoppedTask := mockStoppedTask(nil)
+				stoppedTask.StoppedReason = aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)")
+				m.stoppedTasksWaiter.EXPECT().WaitUntilTasksStopped(mockClusterARN, []string{mockTaskARN}).
+					Return([]*awsecs.Task{stoppedTask}, nil)
+				m.stoppedTasksWaiter.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError:    errors.New("task 4082490ee6c245e09d2145010aa1ba8d stopped before its essential container exited: CannotPullContainerError: pull image manifest has been retried 5 time(s)"),
+			wantedExitCode: exitCodeFailure,
+		},
+		"output the final status of the stopped tasks in JSON": {
+			inImage: "image",
+			inWait:  true,
+			inJSON:  true,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    mockTaskARN,
+						ClusterARN: mockClusterARN,
+					},
+				}, nil)
+				m.stoppedTasksWaiter.EXPECT().WaitUntilTasksStopped(mockClusterARN, []string{mockTaskARN}).
+					Return([]*awsecs.Task{mockStoppedTask(aws.Int64(0))}, nil)
+				m.stoppedTasksWaiter.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedOutput: `{"tasks":[{"health":"","id":"4082490ee6c245e09d2145010aa1ba8d","images":[{"ID":"","Digest":""}],"lastStatus":"STOPPED","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"Essential container in task exited","exitCodes":[{"name":"my-task","exitCode":0}],"containers":[{"name":"my-task","image":{"ID":"","Digest":""},"lastStatus":"","health":"","exitCode":0}]}]}
+`,
+		},
+		"error generating the run task command": {
+			inImage:       "image",
+			inGenerateCmd: true,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Input().Return(nil, errors.New("some error"))
+				m.runner.EXPECT().Run().Times(0)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("generate the command to run task my-task: some error"),
+		},
+		"print the run task command instead of running tasks": {
+			inImage:       "image",
+			inGenerateCmd: true,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Input().Return(&awsecs.RunTaskInput{
+					Cluster:        "my-cluster",
+					Count:          1,
+					Subnets:        []string{"subnet-1"},
+					SecurityGroups: []string{"sg-1"},
+					TaskFamilyName: "copilot-my-task",
+					StartedBy:      "copilot-task",
+				}, nil)
+				m.runner.EXPECT().Run().Times(0)
+				mockHasDefaultCluster(m)
+			},
+			wantedOutput: `aws ecs run-task \
+  --cluster my-cluster \
+  --count 1 \
+  --launch-type FARGATE \
+  --started-by copilot-task \
+  --task-definition copilot-my-task \
+  --network-configuration 'awsvpcConfiguration={subnets=[subnet-1],securityGroups=[sg-1],assignPublicIp=ENABLED}'
+`,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -749,26 +1029,34 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				store:                mocks.NewMockstore(ctrl),
 				eventsWriter:         mocks.NewMockeventsWriter(ctrl),
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
+				stoppedTasksWaiter:   mocks.NewMockstoppedTasksWaiter(ctrl),
 			}
 			tc.setupMocks(mocks)
+			b := &bytes.Buffer{}
 
 			opts := &runTaskOpts{
 				runTaskVars: runTaskVars{
 					groupName: inGroupName,
 
-					image:    tc.inImage,
-					imageTag: tc.inTag,
-					env:      tc.inEnv,
-					follow:   tc.inFollow,
-					command:  tc.inCommand,
+					image:            tc.inImage,
+					imageTag:         tc.inTag,
+					appName:          tc.inApp,
+					env:              tc.inEnv,
+					follow:           tc.inFollow,
+					wait:             tc.inWait,
+					shouldOutputJSON: tc.inJSON,
+					generateCmd:      tc.inGenerateCmd,
+					command:          tc.inCommand,
 				},
 				spinner: &mockSpinner{},
 				store:   mocks.store,
+				w:       b,
 			}
 			opts.configureRuntimeOpts = func() error {
 				opts.runner = mocks.runner
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
+				opts.stoppedTasksWaiter = mocks.stoppedTasksWaiter
 				return nil
 			}
 			opts.configureRepository = func() error {
@@ -782,8 +1070,12 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			err := opts.Execute()
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
+				if tc.wantedExitCode != 0 {
+					require.Equal(t, tc.wantedExitCode, exitCode(err))
+				}
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, b.String())
 			}
 		})
 	}
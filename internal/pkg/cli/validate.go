@@ -12,6 +12,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/google/shlex"
 	"github.com/robfig/cron/v3"
 
 	"github.com/spf13/afero"
@@ -38,11 +40,16 @@ var (
 	errDDBValueBadSize                    = errors.New("value must be between 3 and 255 characters in length")
 	errValueBadFormatWithPeriodUnderscore = errors.New("value must contain only alphanumeric characters and ._-")
 	errDDBAttributeBadFormat              = errors.New("value must be of the form <name>:<T> where T is one of S, N, or B")
+	errDDBAttributeNameBadSize            = errors.New("value must be between 1 and 255 characters in length")
+	errDDBCapacityInvalid                 = errors.New("value must be a positive integer")
 	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
 	errDomainInvalid                      = errors.New("value must contain at least one '.' character")
 	errDurationInvalid                    = errors.New("value must be a valid Go duration string (example: 1h30m)")
 	errDurationBadUnits                   = errors.New("duration cannot be in units smaller than a second")
 	errScheduleInvalid                    = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
+	errValueNotASNSTopicARN               = errors.New("value must be the ARN of an SNS topic (example: arn:aws:sns:us-west-2:123456789012:deployments)")
+	errValueNotAnACMCertARN               = errors.New("value must be the ARN of an ACM certificate (example: arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012)")
+	errValueNotAnIAMPolicyARN             = errors.New("value must be the ARN of an IAM managed policy (example: arn:aws:iam::123456789012:policy/MyBoundary)")
 )
 
 var (
@@ -50,7 +57,11 @@ var (
 	emptyIP    = net.IP{}
 )
 
-var fmtErrInvalidStorageType = "invalid storage type %s: must be one of %s"
+var (
+	fmtErrInvalidStorageType    = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidDDBBillingMode = "invalid billing mode %s: must be one of %s"
+	fmtErrInvalidDDBStreamType  = "invalid stream view type %s: must be one of %s"
+)
 
 // matches alphanumeric, ._-, from 3 to 255 characters long
 // https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/HowItWorks.NamingRulesDataTypes.html
@@ -80,6 +91,8 @@ var (
 
 	domainNameRegexp = regexp.MustCompile(`\.`) //check for at least one dot in domain name
 
+	awsAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
 	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`)
 )
 
@@ -148,6 +161,15 @@ func validateSchedule(sched interface{}) error {
 	return validateCron(s)
 }
 
+func validateEventPattern(pattern interface{}) error {
+	p, ok := pattern.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	_, err := manifest.ParseEventPattern(p)
+	return err
+}
+
 func validateTimeout(timeout interface{}) error {
 	t, ok := timeout.(string)
 	if !ok {
@@ -395,6 +417,61 @@ func validateKey(val interface{}) error {
 	return nil
 }
 
+func validateDDBAttributeName(val interface{}) error {
+	// https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/HowItWorks.NamingRulesDataTypes.html
+	const maxDDBAttributeNameLength = 255
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) == 0 || len(s) > maxDDBAttributeNameLength {
+		return errDDBAttributeNameBadSize
+	}
+	if !ddbRegExp.MatchString(s) {
+		return errValueBadFormatWithPeriodUnderscore
+	}
+	return nil
+}
+
+func validateDDBBillingMode(val interface{}) error {
+	mode, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, validMode := range ddbBillingModes {
+		if mode == validMode {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidDDBBillingMode, mode, prettify(ddbBillingModes))
+}
+
+func validateDDBCapacity(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	units, err := strconv.Atoi(s)
+	if err != nil || units < 1 {
+		return errDDBCapacityInvalid
+	}
+	return nil
+}
+
+func validateDDBStreamViewType(val interface{}) error {
+	viewType, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, validType := range ddbStreamViewTypes {
+		if viewType == validType {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidDDBStreamType, viewType, prettify(ddbStreamViewTypes))
+}
+
 func validateDynamoDataType(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -428,6 +505,17 @@ func prettify(inputStrings []string) string {
 	return strings.Join(prettyTypes, ", ")
 }
 
+func validateCommand(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if _, err := shlex.Split(s); err != nil {
+		return fmt.Errorf("split command into tokens using shell-style rules: %w", err)
+	}
+	return nil
+}
+
 func validateCIDR(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -440,6 +528,43 @@ func validateCIDR(val interface{}) error {
 	return nil
 }
 
+func validateSNSTopicARN(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil || parsed.Service != snsServiceName || parsed.Region == "" || parsed.Resource == "" {
+		return errValueNotASNSTopicARN
+	}
+	return nil
+}
+
+func validateACMCertARN(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil || parsed.Service != acmServiceName || parsed.Region == "" || !strings.HasPrefix(parsed.Resource, "certificate/") {
+		return errValueNotAnACMCertARN
+	}
+	return nil
+}
+
+func validateIAMPolicyARN(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil || parsed.Service != iamServiceName || parsed.Region != "" || !awsAccountIDRegexp.MatchString(parsed.AccountID) ||
+		!strings.HasPrefix(parsed.Resource, "policy/") || strings.HasSuffix(parsed.Resource, "/") {
+		return errValueNotAnIAMPolicyARN
+	}
+	return nil
+}
+
 func validateCIDRSlice(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -357,6 +357,149 @@ func TestValidateCIDR(t *testing.T) {
 	}
 }
 
+func TestValidateCommand(t *testing.T) {
+	testCases := map[string]struct {
+		in        interface{}
+		wantError error
+	}{
+		"good case": {
+			in: "python migrate-script.py\n  --table \"user sessions\"",
+		},
+		"unclosed quote": {
+			in:        "python migrate-script.py --table \"user sessions",
+			wantError: errors.New("split command into tokens using shell-style rules: EOF found when expecting closing quote"),
+		},
+		"not a string": {
+			in:        123,
+			wantError: errValueNotAString,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateCommand(tc.in)
+			if tc.wantError != nil {
+				require.EqualError(t, got, tc.wantError.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateSNSTopicARN(t *testing.T) {
+	testCases := map[string]struct {
+		in        interface{}
+		wantError error
+	}{
+		"good case": {
+			in: "arn:aws:sns:us-west-2:123456789012:deployments",
+		},
+		"not an ARN": {
+			in:        "deployments",
+			wantError: errValueNotASNSTopicARN,
+		},
+		"not an SNS topic": {
+			in:        "arn:aws:sqs:us-west-2:123456789012:deployments",
+			wantError: errValueNotASNSTopicARN,
+		},
+		"not a string": {
+			in:        123,
+			wantError: errValueNotAString,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateSNSTopicARN(tc.in)
+			if tc.wantError != nil {
+				require.EqualError(t, got, tc.wantError.Error())
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateACMCertARN(t *testing.T) {
+	testCases := map[string]struct {
+		in        interface{}
+		wantError error
+	}{
+		"good case": {
+			in: "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+		},
+		"not an ARN": {
+			in:        "12345678-1234-1234-1234-123456789012",
+			wantError: errValueNotAnACMCertARN,
+		},
+		"not an ACM certificate": {
+			in:        "arn:aws:iam::123456789012:server-certificate/example",
+			wantError: errValueNotAnACMCertARN,
+		},
+		"not a string": {
+			in:        123,
+			wantError: errValueNotAString,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateACMCertARN(tc.in)
+			if tc.wantError != nil {
+				require.EqualError(t, got, tc.wantError.Error())
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateIAMPolicyARN(t *testing.T) {
+	testCases := map[string]struct {
+		in        interface{}
+		wantError error
+	}{
+		"good case": {
+			in: "arn:aws:iam::123456789012:policy/MyBoundary",
+		},
+		"policy with a path in another partition": {
+			in: "arn:aws-cn:iam::123456789012:policy/boundaries/MyBoundary",
+		},
+		"not an ARN": {
+			in:        "MyBoundary",
+			wantError: errValueNotAnIAMPolicyARN,
+		},
+		"not an IAM policy": {
+			in:        "arn:aws:iam::123456789012:role/MyBoundary",
+			wantError: errValueNotAnIAMPolicyARN,
+		},
+		"no policy name": {
+			in:        "arn:aws:iam::123456789012:policy/",
+			wantError: errValueNotAnIAMPolicyARN,
+		},
+		"invalid account ID": {
+			in:        "arn:aws:iam::1234:policy/MyBoundary",
+			wantError: errValueNotAnIAMPolicyARN,
+		},
+		"regional ARN": {
+			in:        "arn:aws:iam:us-west-2:123456789012:policy/MyBoundary",
+			wantError: errValueNotAnIAMPolicyARN,
+		},
+		"not a string": {
+			in:        123,
+			wantError: errValueNotAString,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateIAMPolicyARN(tc.in)
+			if tc.wantError != nil {
+				require.EqualError(t, got, tc.wantError.Error())
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
 func TestValidateCIDRSlice(t *testing.T) {
 	testCases := map[string]struct {
 		inputCIDRSlice string
@@ -14,11 +14,12 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name      string            `json:"name"`           // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID string            `json:"account"`        // AccountID this app is mastered in.
-	Domain    string            `json:"domain"`         // Existing domain name in Route53. An empty domain name means the user does not have one.
-	Version   string            `json:"version"`        // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags      map[string]string `json:"tags,omitempty"` // Labels to apply to resources created within the app.
+	Name                string            `json:"name"`                          // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID           string            `json:"account"`                       // AccountID this app is mastered in.
+	Domain              string            `json:"domain"`                        // Existing domain name in Route53. An empty domain name means the user does not have one.
+	Version             string            `json:"version"`                       // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags                map[string]string `json:"tags,omitempty"`                // Labels to apply to resources created within the app.
+	PermissionsBoundary string            `json:"permissionsBoundary,omitempty"` // ARN of the IAM policy set as the permissions boundary of every role created within the app.
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
@@ -55,6 +56,27 @@ func (s *Store) CreateApplication(application *Application) error {
 	return nil
 }
 
+// UpdateApplication overwrites the stored configuration of an existing application.
+func (s *Store) UpdateApplication(application *Application) error {
+	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
+	data, err := marshal(application)
+	if err != nil {
+		return fmt.Errorf("serializing application %s: %w", application.Name, err)
+	}
+
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(applicationPath),
+		Description: aws.String("Copilot Application"),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update application %s: %w", application.Name, err)
+	}
+	return nil
+}
+
 // GetApplication fetches an application by name. If it can't be found, return a ErrNoSuchApplication
 func (s *Store) GetApplication(applicationName string) (*Application, error) {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, applicationName)
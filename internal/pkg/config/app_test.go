@@ -299,6 +299,56 @@ func TestStore_CreateApplication(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateApplication(t *testing.T) {
+	testCases := map[string]struct {
+		inApplication *Application
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the application": {
+			inApplication: &Application{Name: "phonetool", AccountID: "1234", Version: "1.0", Tags: map[string]string{"owner": "boss", "cost-center": "1234"}},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtApplicationPath, "phonetool"), *param.Name)
+				require.Equal(t, `{"name":"phonetool","account":"1234","domain":"","version":"1.0","tags":{"cost-center":"1234","owner":"boss"}}`, *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inApplication: &Application{Name: "phonetool", AccountID: "1234"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update application phonetool: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateApplication(tc.inApplication)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeleteApplication(t *testing.T) {
 	mockApplicationName := "mockApplicationName"
 	mockError := errors.New("mockError")
@@ -0,0 +1,192 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// InconsistencyKind categorizes how a record disagrees with the rest of an application's records.
+type InconsistencyKind string
+
+const (
+	// MissingApplication means environment or workload records exist for an application that has no record.
+	MissingApplication InconsistencyKind = "missing application"
+	// MismatchedRecord means the contents of a record don't match the parameter it's stored in.
+	MismatchedRecord InconsistencyKind = "mismatched record"
+	// UnreadableRecord means a record can't be deserialized.
+	UnreadableRecord InconsistencyKind = "unreadable record"
+)
+
+// Inconsistency is a record in the store that disagrees with the rest of an application's records.
+type Inconsistency struct {
+	Kind      InconsistencyKind
+	Parameter string // Name of the SSM parameter holding the record.
+	Detail    string // Description of the disagreement.
+
+	// canonical is the record to write to Parameter to repair the inconsistency.
+	// It's nil if the inconsistency can't be repaired automatically.
+	canonical   interface{}
+	description string // Description of the SSM parameter.
+	overwrite   bool   // True if the canonical record replaces an existing parameter.
+}
+
+// Fixable returns true if the inconsistency can be repaired with FixInconsistency.
+func (i *Inconsistency) Fixable() bool {
+	return i.canonical != nil
+}
+
+// String returns a one line summary of the inconsistency.
+func (i *Inconsistency) String() string {
+	return fmt.Sprintf("%s (%s): %s", i.Parameter, i.Kind, i.Detail)
+}
+
+// CheckConsistency cross-validates the application, environment, and workload records of an application.
+// It returns an inconsistency for every orphaned, mismatched, or unreadable record.
+func (s *Store) CheckConsistency(appName string) ([]*Inconsistency, error) {
+	envParams, err := s.listParameters(fmt.Sprintf(rootEnvParamPath, appName))
+	if err != nil {
+		return nil, fmt.Errorf("list environment records for application %s: %w", appName, err)
+	}
+	wkldParams, err := s.listParameters(fmt.Sprintf(rootWkldParamPath, appName))
+	if err != nil {
+		return nil, fmt.Errorf("list workload records for application %s: %w", appName, err)
+	}
+
+	var inconsistencies []*Inconsistency
+	if len(envParams) != 0 || len(wkldParams) != 0 {
+		inc, err := s.checkAppRecord(appName)
+		if err != nil {
+			return nil, err
+		}
+		if inc != nil {
+			inconsistencies = append(inconsistencies, inc)
+		}
+	}
+	for _, param := range envParams {
+		if inc := checkEnvRecord(appName, param); inc != nil {
+			inconsistencies = append(inconsistencies, inc)
+		}
+	}
+	for _, param := range wkldParams {
+		if inc := checkWorkloadRecord(appName, param); inc != nil {
+			inconsistencies = append(inconsistencies, inc)
+		}
+	}
+	return inconsistencies, nil
+}
+
+// FixInconsistency rewrites the canonical record of an inconsistency.
+// Fixing an inconsistency that was already repaired is a no-op.
+func (s *Store) FixInconsistency(inc *Inconsistency) error {
+	if !inc.Fixable() {
+		return fmt.Errorf("%s in parameter %s can't be fixed automatically", inc.Kind, inc.Parameter)
+	}
+	data, err := marshal(inc.canonical)
+	if err != nil {
+		return fmt.Errorf("serialize record for parameter %s: %w", inc.Parameter, err)
+	}
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(inc.Parameter),
+		Description: aws.String(inc.description),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(inc.overwrite),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterAlreadyExists:
+				return nil
+			}
+		}
+		return fmt.Errorf("rewrite parameter %s: %w", inc.Parameter, err)
+	}
+	return nil
+}
+
+func (s *Store) checkAppRecord(appName string) (*Inconsistency, error) {
+	_, err := s.GetApplication(appName)
+	if err == nil {
+		return nil, nil
+	}
+	var errNoSuchApp *ErrNoSuchApplication
+	if !errors.As(err, &errNoSuchApp) {
+		return nil, err
+	}
+	caller, err := s.idClient.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get caller identity: %w", err)
+	}
+	return &Inconsistency{
+		Kind:      MissingApplication,
+		Parameter: fmt.Sprintf(fmtApplicationPath, appName),
+		Detail:    fmt.Sprintf("environments or workloads exist for application %s but the application record is missing", appName),
+		canonical: &Application{
+			Name:      appName,
+			AccountID: caller.Account,
+			Version:   schemaVersion,
+		},
+		description: "Copilot Application",
+	}, nil
+}
+
+func checkEnvRecord(appName string, param *ssm.Parameter) *Inconsistency {
+	paramName := aws.StringValue(param.Name)
+	envName := path.Base(paramName)
+	var env Environment
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Value)), &env); err != nil {
+		return &Inconsistency{
+			Kind:      UnreadableRecord,
+			Parameter: paramName,
+			Detail:    fmt.Sprintf("read environment record: %v", err),
+		}
+	}
+	if env.App == appName && env.Name == envName {
+		return nil
+	}
+	detail := fmt.Sprintf("environment record refers to environment %q in application %q", env.Name, env.App)
+	env.App, env.Name = appName, envName
+	return &Inconsistency{
+		Kind:        MismatchedRecord,
+		Parameter:   paramName,
+		Detail:      detail,
+		canonical:   &env,
+		description: fmt.Sprintf("The %s deployment stage", envName),
+		overwrite:   true,
+	}
+}
+
+func checkWorkloadRecord(appName string, param *ssm.Parameter) *Inconsistency {
+	paramName := aws.StringValue(param.Name)
+	wkldName := path.Base(paramName)
+	var wkld Workload
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Value)), &wkld); err != nil {
+		return &Inconsistency{
+			Kind:      UnreadableRecord,
+			Parameter: paramName,
+			Detail:    fmt.Sprintf("read workload record: %v", err),
+		}
+	}
+	if wkld.App == appName && wkld.Name == wkldName {
+		return nil
+	}
+	detail := fmt.Sprintf("workload record refers to workload %q in application %q", wkld.Name, wkld.App)
+	wkld.App, wkld.Name = appName, wkldName
+	return &Inconsistency{
+		Kind:        MismatchedRecord,
+		Parameter:   paramName,
+		Detail:      detail,
+		canonical:   &wkld,
+		description: fmt.Sprintf("Copilot %s %s", wkld.Type, wkldName),
+		overwrite:   true,
+	}
+}
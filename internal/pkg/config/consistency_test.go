@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSSM is an in-memory parameter store.
+type fakeSSM struct {
+	ssmiface.SSMAPI
+	params map[string]string
+	puts   int
+}
+
+func (f *fakeSSM) GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	val, ok := f.params[aws.StringValue(in.Name)]
+	if !ok {
+		return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+	}
+	return &ssm.GetParameterOutput{
+		Parameter: &ssm.Parameter{Name: in.Name, Value: aws.String(val)},
+	}, nil
+}
+
+func (f *fakeSSM) GetParametersByPath(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+	var names []string
+	for name := range f.params {
+		rest := strings.TrimPrefix(name, aws.StringValue(in.Path))
+		if rest == name || strings.Contains(rest, "/") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var params []*ssm.Parameter
+	for _, name := range names {
+		params = append(params, &ssm.Parameter{Name: aws.String(name), Value: aws.String(f.params[name])})
+	}
+	return &ssm.GetParametersByPathOutput{Parameters: params}, nil
+}
+
+func (f *fakeSSM) PutParameter(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	if _, ok := f.params[aws.StringValue(in.Name)]; ok && !aws.BoolValue(in.Overwrite) {
+		return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "exists", nil)
+	}
+	f.params[aws.StringValue(in.Name)] = aws.StringValue(in.Value)
+	f.puts++
+	return &ssm.PutParameterOutput{}, nil
+}
+
+func TestStore_CheckAndFixConsistency(t *testing.T) {
+	testCases := map[string]struct {
+		params map[string]string
+
+		wantedKinds      []InconsistencyKind
+		wantedRemaining  []InconsistencyKind
+		wantedFixedParam map[string]string
+	}{
+		"consistent application": {
+			params: map[string]string{
+				"/copilot/applications/phonetool":                         `{"name":"phonetool","account":"1234","domain":"","version":"1.0"}`,
+				"/copilot/applications/phonetool/environments/test":       `{"app":"phonetool","name":"test"}`,
+				"/copilot/applications/phonetool/components/frontend":     `{"app":"phonetool","name":"frontend","type":"Load Balanced Web Service"}`,
+				"/copilot/applications/phonetool/components/frontend/foo": `not a record under the components path`,
+			},
+		},
+		"no records at all": {
+			params: map[string]string{},
+		},
+		"environment without an application record": {
+			params: map[string]string{
+				"/copilot/applications/phonetool/environments/test": `{"app":"phonetool","name":"test"}`,
+			},
+			wantedKinds: []InconsistencyKind{MissingApplication},
+			wantedFixedParam: map[string]string{
+				"/copilot/applications/phonetool": `{"name":"phonetool","account":"1234","domain":"","version":"1.0"}`,
+			},
+		},
+		"records that don't match their parameters": {
+			params: map[string]string{
+				"/copilot/applications/phonetool":                     `{"name":"phonetool","account":"1234","domain":"","version":"1.0"}`,
+				"/copilot/applications/phonetool/environments/test":   `{"app":"phonetool","name":"prod","region":"us-west-2"}`,
+				"/copilot/applications/phonetool/components/frontend": `{"app":"oldapp","name":"frontend","type":"Backend Service"}`,
+			},
+			wantedKinds: []InconsistencyKind{MismatchedRecord, MismatchedRecord},
+			wantedFixedParam: map[string]string{
+				"/copilot/applications/phonetool/environments/test":   `{"app":"phonetool","name":"test","region":"us-west-2","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}`,
+				"/copilot/applications/phonetool/components/frontend": `{"app":"phonetool","name":"frontend","type":"Backend Service"}`,
+			},
+		},
+		"unreadable records are reported but not fixed": {
+			params: map[string]string{
+				"/copilot/applications/phonetool":                     `{"name":"phonetool","account":"1234","domain":"","version":"1.0"}`,
+				"/copilot/applications/phonetool/components/frontend": `oops`,
+			},
+			wantedKinds:     []InconsistencyKind{UnreadableRecord},
+			wantedRemaining: []InconsistencyKind{UnreadableRecord},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fake := &fakeSSM{params: tc.params}
+			store := &Store{
+				ssmClient: fake,
+				idClient: mockIdentityService{
+					mockIdentityServiceGet: func() (identity.Caller, error) {
+						return identity.Caller{Account: "1234"}, nil
+					},
+				},
+			}
+
+			// WHEN
+			incs, err := store.CheckConsistency("phonetool")
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedKinds, kindsOf(incs))
+
+			// WHEN
+			fixAll(t, store, incs)
+
+			// THEN
+			for param, wanted := range tc.wantedFixedParam {
+				require.Equal(t, wanted, fake.params[param])
+			}
+			remaining, err := store.CheckConsistency("phonetool")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRemaining, kindsOf(remaining))
+
+			// Fixing again should be a no-op.
+			puts := fake.puts
+			fixAll(t, store, remaining)
+			require.Equal(t, puts, fake.puts)
+		})
+	}
+}
+
+func TestStore_CheckConsistency_Errors(t *testing.T) {
+	t.Run("returns an error if the caller's identity can't be retrieved", func(t *testing.T) {
+		store := &Store{
+			ssmClient: &fakeSSM{params: map[string]string{
+				"/copilot/applications/phonetool/environments/test": `{"app":"phonetool","name":"test"}`,
+			}},
+			idClient: mockIdentityService{
+				mockIdentityServiceGet: func() (identity.Caller, error) {
+					return identity.Caller{}, errors.New("some error")
+				},
+			},
+		}
+
+		_, err := store.CheckConsistency("phonetool")
+
+		require.EqualError(t, err, "get caller identity: some error")
+	})
+	t.Run("returns an error if an unfixable inconsistency is fixed", func(t *testing.T) {
+		store := &Store{}
+
+		err := store.FixInconsistency(&Inconsistency{Kind: UnreadableRecord, Parameter: "/copilot/applications/phonetool"})
+
+		require.EqualError(t, err, "unreadable record in parameter /copilot/applications/phonetool can't be fixed automatically")
+	})
+}
+
+func kindsOf(incs []*Inconsistency) []InconsistencyKind {
+	var kinds []InconsistencyKind
+	for _, inc := range incs {
+		kinds = append(kinds, inc.Kind)
+	}
+	return kinds
+}
+
+func fixAll(t *testing.T, store *Store, incs []*Inconsistency) {
+	for _, inc := range incs {
+		if !inc.Fixable() {
+			continue
+		}
+		require.NoError(t, store.FixInconsistency(inc))
+	}
+}
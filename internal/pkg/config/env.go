@@ -24,22 +24,56 @@ type Environment struct {
 	ExecutionRoleARN string        `json:"executionRoleARN"`       // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string        `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
 	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
+
+	CreatedByVersion     string `json:"createdByVersion,omitempty"`     // Version of the CLI that created the environment.
+	LastUpdatedByVersion string `json:"lastUpdatedByVersion,omitempty"` // Version of the CLI that last upgraded the environment.
+}
+
+// CLIVersion returns the version of the CLI that last created or upgraded the environment.
+// Environments stored before the version was recorded return an empty string.
+func (e *Environment) CLIVersion() string {
+	if e.LastUpdatedByVersion != "" {
+		return e.LastUpdatedByVersion
+	}
+	return e.CreatedByVersion
+}
+
+// ImportedSecurityGroupIDs returns the IDs of the existing security groups attached to all services of the environment.
+func (e *Environment) ImportedSecurityGroupIDs() []string {
+	if e.CustomConfig == nil || e.CustomConfig.ImportVPC == nil {
+		return nil
+	}
+	return e.CustomConfig.ImportVPC.SecurityGroupIDs
+}
+
+// ImportedCertARNs returns the ARNs of the existing ACM certificates attached to the HTTPS listener of the environment.
+func (e *Environment) ImportedCertARNs() []string {
+	if e.CustomConfig == nil {
+		return nil
+	}
+	return e.CustomConfig.ImportCertARNs
 }
 
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC      *ImportVPC  `json:"importVPC,omitempty"`
+	VPCConfig      *AdjustVPC  `json:"adjustVPC,omitempty"`
+	EnableIPv6     bool        `json:"enableIPv6,omitempty"`     // True means the VPC, subnets and load balancer support IPv6.
+	AccessLogs     *AccessLogs `json:"accessLogs,omitempty"`     // Set if the access logs of the load balancer are stored in S3.
+	ImportCertARNs []string    `json:"importCertARNs,omitempty"` // ARNs of existing ACM certificates used by the HTTPS listener.
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
-func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC) *CustomizeEnv {
-	if importVPC == nil && adjustVPC == nil {
+func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC, enableIPv6 bool, accessLogs *AccessLogs, importCertARNs []string) *CustomizeEnv {
+	if importVPC == nil && adjustVPC == nil && !enableIPv6 && accessLogs == nil && len(importCertARNs) == 0 {
 		return nil
 	}
 	return &CustomizeEnv{
-		ImportVPC: importVPC,
-		VPCConfig: adjustVPC,
+		ImportVPC:      importVPC,
+		VPCConfig:      adjustVPC,
+		EnableIPv6:     enableIPv6,
+		AccessLogs:     accessLogs,
+		ImportCertARNs: importCertARNs,
 	}
 }
 
@@ -48,6 +82,7 @@ type ImportVPC struct {
 	ID               string   `json:"id"` // ID for the VPC.
 	PublicSubnetIDs  []string `json:"publicSubnetIDs"`
 	PrivateSubnetIDs []string `json:"privateSubnetIDs"`
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"` // Security groups attached to all services in addition to the environment's.
 }
 
 // AdjustVPC holds the fields to adjust default VPC resources.
@@ -55,6 +90,13 @@ type AdjustVPC struct {
 	CIDR               string   `json:"cidr"` // CIDR range for the VPC.
 	PublicSubnetCIDRs  []string `json:"publicSubnetCIDRs"`
 	PrivateSubnetCIDRs []string `json:"privateSubnetCIDRs"`
+	AZs                []string `json:"availabilityZoneNames,omitempty"` // Availability zones to spread the subnets across.
+}
+
+// AccessLogs holds the fields to store the access logs of the environment's load balancer in S3.
+type AccessLogs struct {
+	BucketName string `json:"bucketName,omitempty"` // Name of an existing bucket. If empty, the environment creates a bucket.
+	Prefix     string `json:"prefix,omitempty"`     // Prefix of the log objects in the bucket.
 }
 
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
@@ -88,6 +130,27 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 	return nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(environmentPath),
+		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
@@ -168,6 +168,17 @@ func TestStore_GetEnvironment(t *testing.T) {
 			wantedEnvironment: testEnvironment,
 			wantedErr:         nil,
 		},
+		"with an environment stored without the CLI version": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testEnvironmentPath),
+						Value: aws.String(`{"app":"chicken","name":"test","region":"us-west-2s","accountID":"12345","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}`),
+					},
+				}, nil
+			},
+			wantedEnvironment: testEnvironment,
+		},
 		"with no existing environment": {
 			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
 				require.Equal(t, testEnvironmentPath, *param.Name)
@@ -238,6 +249,10 @@ func TestStore_CreateEnvironment(t *testing.T) {
 			PrivateSubnetCIDRs: []string{"mockSubnetCIDR"},
 			PublicSubnetCIDRs:  []string{"mockSubnetCIDR"},
 		},
+		AccessLogs: &AccessLogs{
+			BucketName: "mockBucket",
+			Prefix:     "mockPrefix",
+		},
 	}
 	testEnvironment := Environment{Name: "test", App: testApplication.Name, AccountID: "1234", Region: "us-west-2", CustomConfig: testCustomConfig}
 	testEnvironmentString, err := marshal(testEnvironment)
@@ -330,6 +345,78 @@ func TestStore_CreateEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvironment *Environment
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the environment": {
+			inEnvironment: &Environment{Name: "test", App: "chicken", AccountID: "1234", Region: "us-west-2", CreatedByVersion: "v1.0.0", LastUpdatedByVersion: "v1.2.0"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtEnvParamPath, "chicken", "test"), *param.Name)
+				require.Equal(t, `{"app":"chicken","name":"test","region":"us-west-2","accountID":"1234","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","createdByVersion":"v1.0.0","lastUpdatedByVersion":"v1.2.0"}`, *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inEnvironment: &Environment{Name: "test", App: "chicken"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update environment test in application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateEnvironment(tc.inEnvironment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnvironment_CLIVersion(t *testing.T) {
+	testCases := map[string]struct {
+		in     Environment
+		wanted string
+	}{
+		"stored without the version": {},
+		"never upgraded": {
+			in:     Environment{CreatedByVersion: "v1.0.0"},
+			wanted: "v1.0.0",
+		},
+		"upgraded": {
+			in:     Environment{CreatedByVersion: "v1.0.0", LastUpdatedByVersion: "v1.2.0"},
+			wanted: "v1.2.0",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.CLIVersion())
+		})
+	}
+}
+
 func TestStore_DeleteEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		inApplicationName string
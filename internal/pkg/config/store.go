@@ -14,6 +14,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -59,19 +60,37 @@ func NewStore() (*Store, error) {
 		return nil, err
 	}
 
+	return NewStoreFromSession(sess), nil
+}
+
+// NewStoreFromSession returns a new store that queries or creates the configuration in the account and region of the session.
+func NewStoreFromSession(sess *session.Session) *Store {
 	return &Store{
 		idClient:      identity.New(sess),
 		ssmClient:     ssm.New(sess),
-		sessionRegion: *sess.Config.Region,
-	}, nil
+		sessionRegion: aws.StringValue(sess.Config.Region),
+	}
 }
 
 func (s *Store) listParams(path string) ([]*string, error) {
+	params, err := s.listParameters(path)
+	if err != nil {
+		return nil, err
+	}
 	var serializedParams []*string
+	for _, param := range params {
+		serializedParams = append(serializedParams, param.Value)
+	}
+	return serializedParams, nil
+}
+
+// listParameters returns all the parameters directly under path, including their names.
+func (s *Store) listParameters(path string) ([]*ssm.Parameter, error) {
+	var params []*ssm.Parameter
 
 	var nextToken *string
 	for {
-		params, err := s.ssmClient.GetParametersByPath(&ssm.GetParametersByPathInput{
+		resp, err := s.ssmClient.GetParametersByPath(&ssm.GetParametersByPathInput{
 			Path:      aws.String(path),
 			Recursive: aws.Bool(false),
 			NextToken: nextToken,
@@ -81,16 +100,14 @@ func (s *Store) listParams(path string) ([]*string, error) {
 			return nil, err
 		}
 
-		for _, param := range params.Parameters {
-			serializedParams = append(serializedParams, param.Value)
-		}
+		params = append(params, resp.Parameters...)
 
-		nextToken = params.NextToken
+		nextToken = resp.NextToken
 		if nextToken == nil {
 			break
 		}
 	}
-	return serializedParams, nil
+	return params, nil
 }
 
 // Retrieves the caller's Account ID with a best effort. If it fails to fetch the Account ID,
@@ -23,6 +23,32 @@ type Workload struct {
 	App  string `json:"app"`  // Name of the app this workload belongs to.
 	Name string `json:"name"` // Name of the workload, which must be unique within a app.
 	Type string `json:"type"` // Type of the workload (ex: Load Balanced Web Service, etc)
+
+	DisabledScheduleEnvs []string `json:"disabledScheduleEnvs,omitempty"` // Environments where the schedule of the job is disabled.
+}
+
+// IsScheduleDisabled returns true if the schedule of the job was disabled in the environment.
+func (w *Workload) IsScheduleDisabled(env string) bool {
+	for _, disabledEnv := range w.DisabledScheduleEnvs {
+		if disabledEnv == env {
+			return true
+		}
+	}
+	return false
+}
+
+// SetScheduleDisabled records whether the schedule of the job is disabled in the environment.
+func (w *Workload) SetScheduleDisabled(env string, disabled bool) {
+	var envs []string
+	for _, disabledEnv := range w.DisabledScheduleEnvs {
+		if disabledEnv != env {
+			envs = append(envs, disabledEnv)
+		}
+	}
+	if disabled {
+		envs = append(envs, env)
+	}
+	w.DisabledScheduleEnvs = envs
 }
 
 // CreateService instantiates a new service within an existing application. Skip if
@@ -72,6 +98,25 @@ func (s *Store) createWorkload(wkld *Workload) error {
 	return nil
 }
 
+// UpdateJob overwrites the configuration of an existing job.
+func (s *Store) UpdateJob(job *Workload) error {
+	data, err := marshal(job)
+	if err != nil {
+		return fmt.Errorf("serialize data: %w", err)
+	}
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtWkldParamPath, job.App, job.Name)),
+		Description: aws.String(fmt.Sprintf("Copilot %s %s", job.Type, job.Name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update job %s in application %s: %w", job.Name, job.App, err)
+	}
+	return nil
+}
+
 // GetService gets a service belonging to a particular application by name. If no job or svc is found
 // it returns ErrNoSuchService.
 func (s *Store) GetService(appName, svcName string) (*Workload, error) {
@@ -547,6 +547,99 @@ func TestStore_CreateService(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateJob(t *testing.T) {
+	testCases := map[string]struct {
+		inJob *Workload
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the job": {
+			inJob: &Workload{Name: "report", App: "chicken", Type: "Scheduled Job", DisabledScheduleEnvs: []string{"prod"}},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtWkldParamPath, "chicken", "report"), *param.Name)
+				require.Equal(t, `{"app":"chicken","name":"report","type":"Scheduled Job","disabledScheduleEnvs":["prod"]}`, *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inJob: &Workload{Name: "report", App: "chicken", Type: "Scheduled Job"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update job report in application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateJob(tc.inJob)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkload_SetScheduleDisabled(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvs     []string
+		inEnv      string
+		inDisabled bool
+
+		wantedEnvs []string
+	}{
+		"disables the schedule in a new environment": {
+			inEnvs:     []string{"test"},
+			inEnv:      "prod",
+			inDisabled: true,
+			wantedEnvs: []string{"test", "prod"},
+		},
+		"disabling twice records the environment once": {
+			inEnvs:     []string{"prod"},
+			inEnv:      "prod",
+			inDisabled: true,
+			wantedEnvs: []string{"prod"},
+		},
+		"enables the schedule": {
+			inEnvs:     []string{"test", "prod"},
+			inEnv:      "test",
+			wantedEnvs: []string{"prod"},
+		},
+		"enables the last disabled schedule": {
+			inEnvs: []string{"test"},
+			inEnv:  "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			job := Workload{DisabledScheduleEnvs: tc.inEnvs}
+
+			job.SetScheduleDisabled(tc.inEnv, tc.inDisabled)
+
+			require.Equal(t, tc.wantedEnvs, job.DisabledScheduleEnvs)
+			require.Equal(t, tc.inDisabled, job.IsScheduleDisabled(tc.inEnv))
+		})
+	}
+}
+
 func TestDeleteService(t *testing.T) {
 	mockApplicationName := "mockApplicationName"
 	mockSvcName := "mockSvcName"
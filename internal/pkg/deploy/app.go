@@ -11,5 +11,13 @@ type CreateAppInput struct {
 	AccountID             string            // AWS account ID to administrate the application.
 	DNSDelegationAccounts []string          // Accounts to grant DNS access to for this application.
 	DomainName            string            // DNS Name used for this application.
+	PermissionsBoundary   string            // ARN of the IAM policy set as the permissions boundary of the application's roles.
 	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
 }
+
+// AppInformation holds information about the application that workload stacks need.
+type AppInformation struct {
+	Name      string // Name of the application.
+	AccountID string // AWS account ID the application is administrated from.
+	DNSName   string // DNS Name used for this application, empty if the application doesn't have one.
+}
@@ -6,7 +6,9 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	sdkcloudformationiface "github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -16,6 +18,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
+// appStackInstanceTimeout is how long to wait for the application's resources to be created in a new region.
+const appStackInstanceTimeout = 30 * time.Minute
+
 // DeployApp sets up everything required for our application-wide resources.
 // These resources include things that are regional, rather than scoped to a particular
 // environment, such as ECR Repos, CodePipeline KMS keys & S3 buckets.
@@ -33,6 +38,11 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 		if !errors.As(err, &alreadyExists) {
 			return err
 		}
+		if in.PermissionsBoundary != "" {
+			if err := cf.updateAppPermissionsBoundary(in); err != nil {
+				return err
+			}
+		}
 	}
 
 	blankAppTemplate, err := appConfig.ResourceTemplate(&stack.AppResourcesConfig{
@@ -49,13 +59,47 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 		stackset.WithTags(toMap(appConfig.Tags())))
 }
 
+// updateAppPermissionsBoundary sets the permissions boundary on the roles of an existing application stack.
+// The domain, DNS delegated accounts and tags the stack was deployed with are kept.
+func (cf CloudFormation) updateAppPermissionsBoundary(in *deploy.CreateAppInput) error {
+	appStack, err := cf.cfnClient.Describe(stack.NewAppStackConfig(in).StackName())
+	if err != nil {
+		return fmt.Errorf("get existing application infrastructure stack: %w", err)
+	}
+	deployApp := *in
+	deployApp.DNSDelegationAccounts = stack.DNSDelegatedAccountsForStack(appStack.SDK())
+	if deployApp.DomainName == "" {
+		deployApp.DomainName = stack.AppDomainNameForStack(appStack.SDK())
+	}
+	if len(deployApp.AdditionalTags) == 0 {
+		deployApp.AdditionalTags = make(map[string]string)
+		for _, tag := range appStack.Tags {
+			deployApp.AdditionalTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
+	s, err := toStack(stack.NewAppStackConfig(&deployApp))
+	if err != nil {
+		return err
+	}
+	if err := cf.cfnClient.UpdateAndWait(s); err != nil {
+		var errNoUpdates *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errNoUpdates) {
+			return nil
+		}
+		return fmt.Errorf("update application to set the permissions boundary: %w", err)
+	}
+	return nil
+}
+
 // DelegateDNSPermissions grants the provided account ID the ability to write to this application's
 // DNS HostedZone. This allows us to perform cross account DNS delegation.
 func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, accountID string) error {
 	deployApp := deploy.CreateAppInput{
-		Name:       app.Name,
-		AccountID:  app.AccountID,
-		DomainName: app.Domain,
+		Name:                app.Name,
+		AccountID:           app.AccountID,
+		DomainName:          app.Domain,
+		PermissionsBoundary: app.PermissionsBoundary,
 	}
 
 	appConfig := stack.NewAppStackConfig(&deployApp)
@@ -81,6 +125,70 @@ func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, account
 	return nil
 }
 
+// ImageBuildProject returns the name of the CodeBuild project that builds the images of the application's workloads remotely.
+func (cf CloudFormation) ImageBuildProject(app *config.Application) (string, error) {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:      app.Name,
+		AccountID: app.AccountID,
+	})
+	appStack, err := cf.cfnClient.Describe(appConfig.StackName())
+	if err != nil {
+		return "", fmt.Errorf("get application infrastructure stack: %w", err)
+	}
+	project := stack.ImageBuildProjectForStack(appStack.SDK())
+	if project == "" {
+		return "", fmt.Errorf("application %s has no image build project since its stack %s was created with an older version of Copilot", app.Name, appConfig.StackName())
+	}
+	return project, nil
+}
+
+// RevokeDNSPermissions removes the provided account ID from the accounts that can write to this application's
+// DNS HostedZone. The application's own account is always permitted, and accounts that were never delegated are skipped.
+func (cf CloudFormation) RevokeDNSPermissions(app *config.Application, accountID string) error {
+	if accountID == app.AccountID {
+		return nil
+	}
+	deployApp := deploy.CreateAppInput{
+		Name:                app.Name,
+		AccountID:           app.AccountID,
+		DomainName:          app.Domain,
+		PermissionsBoundary: app.PermissionsBoundary,
+		AdditionalTags:      app.Tags,
+	}
+
+	appConfig := stack.NewAppStackConfig(&deployApp)
+	appStack, err := cf.cfnClient.Describe(appConfig.StackName())
+	if err != nil {
+		return fmt.Errorf("get existing application infrastructure stack: %w", err)
+	}
+
+	dnsDelegatedAccounts := stack.DNSDelegatedAccountsForStack(appStack.SDK())
+	var remainingAccounts []string
+	for _, delegatedAccount := range dnsDelegatedAccounts {
+		if delegatedAccount == accountID {
+			continue
+		}
+		remainingAccounts = append(remainingAccounts, delegatedAccount)
+	}
+	if len(remainingAccounts) == len(dnsDelegatedAccounts) {
+		return nil
+	}
+	deployApp.DNSDelegationAccounts = remainingAccounts
+
+	s, err := toStack(stack.NewAppStackConfig(&deployApp))
+	if err != nil {
+		return err
+	}
+	if err := cf.cfnClient.UpdateAndWait(s); err != nil {
+		var errNoUpdates *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errNoUpdates) {
+			return nil
+		}
+		return fmt.Errorf("update application to revoke DNS delegation from account %s: %w", accountID, err)
+	}
+	return nil
+}
+
 // GetAppResourcesByRegion fetches all the regional resources for a particular region.
 func (cf CloudFormation) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	resources, err := cf.getResourcesForStackInstances(app, &region)
@@ -261,12 +369,30 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 // AddEnvToApp takes a new environment and updates the application configuration
 // with new Account IDs in resource policies (KMS Keys and ECR Repos) - and
 // sets up a new stack instance if the environment is in a new region.
-func (cf CloudFormation) AddEnvToApp(app *config.Application, env *config.Environment) error {
+// An environment in the application's account doesn't change the resource policies,
+// while an environment in another account is allowed to pull the images of the ECR repositories.
+// The options allow to follow the creation of the stack instance in the environment's region.
+func (cf CloudFormation) AddEnvToApp(app *config.Application, env *config.Environment, opts ...stackset.WaitOption) error {
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
 		Name:           app.Name,
 		AccountID:      app.AccountID,
 		AdditionalTags: app.Tags,
 	})
+	if env.AccountID != app.AccountID {
+		if err := cf.addAccountToApp(appConfig, env.AccountID); err != nil {
+			return fmt.Errorf("adding %s environment resources to application: %w", env.Name, err)
+		}
+	}
+
+	if err := cf.addNewAppStackInstances(appConfig, env.Region, opts...); err != nil {
+		return fmt.Errorf("adding new stack instance for environment %s: %w", env.Name, err)
+	}
+
+	return nil
+}
+
+// addAccountToApp adds the account to the resource policies of the application if it isn't there yet.
+func (cf CloudFormation) addAccountToApp(appConfig *stack.AppStackConfig, accountID string) error {
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
 		return fmt.Errorf("getting previous deployed stackset %w", err)
@@ -276,17 +402,13 @@ func (cf CloudFormation) AddEnvToApp(app *config.Application, env *config.Enviro
 	// infrastructure by appending the environment's account if it
 	// doesn't already exist.
 	var accountList []string
-	shouldAddNewAccountID := true
-	for _, accountID := range previouslyDeployedConfig.Accounts {
-		accountList = append(accountList, accountID)
-		if accountID == env.AccountID {
-			shouldAddNewAccountID = false
+	for _, existing := range previouslyDeployedConfig.Accounts {
+		if existing == accountID {
+			return nil
 		}
+		accountList = append(accountList, existing)
 	}
-
-	if shouldAddNewAccountID {
-		accountList = append(accountList, env.AccountID)
-	}
+	accountList = append(accountList, accountID)
 
 	newDeploymentConfig := stack.AppResourcesConfig{
 		Version:  previouslyDeployedConfig.Version + 1,
@@ -294,15 +416,68 @@ func (cf CloudFormation) AddEnvToApp(app *config.Application, env *config.Enviro
 		Accounts: accountList,
 		App:      appConfig.Name,
 	}
+	return cf.deployAppConfig(appConfig, &newDeploymentConfig)
+}
 
-	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
-		return fmt.Errorf("adding %s environment resources to application: %w", env.Name, err)
+// RemoveEnvFromApp removes the environment's account and region from the application resources
+// if none of the remaining environments of the application is deployed there.
+// The account is removed from the resource policies (KMS Keys and ECR Repos), and the stack instance
+// in the environment's region is deleted unless the region is the application's region, which hosts pipeline resources.
+func (cf CloudFormation) RemoveEnvFromApp(in *deploy.RemoveEnvFromAppInput) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           in.App.Name,
+		AccountID:      in.App.AccountID,
+		AdditionalTags: in.App.Tags,
+	})
+	accountInUse, regionInUse := false, false
+	for _, env := range in.RemainingEnvs {
+		if env.AccountID == in.Env.AccountID {
+			accountInUse = true
+		}
+		if env.Region == in.Env.Region {
+			regionInUse = true
+		}
 	}
 
-	if err := cf.addNewAppStackInstances(appConfig, env.Region); err != nil {
-		return fmt.Errorf("adding new stack instance for environment %s: %w", env.Name, err)
+	if !accountInUse && in.Env.AccountID != in.App.AccountID {
+		previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+		if err != nil {
+			return fmt.Errorf("get previous application %s config: %w", in.App.Name, err)
+		}
+		var accountList []string
+		for _, accountID := range previouslyDeployedConfig.Accounts {
+			if accountID == in.Env.AccountID {
+				continue
+			}
+			accountList = append(accountList, accountID)
+		}
+		if len(accountList) != len(previouslyDeployedConfig.Accounts) {
+			newDeploymentConfig := stack.AppResourcesConfig{
+				Version:  previouslyDeployedConfig.Version + 1,
+				Services: previouslyDeployedConfig.Services,
+				Accounts: accountList,
+				App:      appConfig.Name,
+			}
+			if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
+				return fmt.Errorf("remove account %s from application %s: %w", in.Env.AccountID, in.App.Name, err)
+			}
+		}
 	}
 
+	if regionInUse || in.Env.Region == in.AppRegion {
+		return nil
+	}
+	summaries, err := cf.appStackSet.InstanceSummaries(appConfig.StackSetName(), stackset.FilterSummariesByRegion(in.Env.Region))
+	if err != nil {
+		return err
+	}
+	if len(summaries) == 0 {
+		// The stack instance was already removed.
+		return nil
+	}
+	if err := cf.appStackSet.DeleteInstancesAndWait(appConfig.StackSetName(), []string{appConfig.AccountID}, []string{in.Env.Region}); err != nil {
+		return fmt.Errorf("remove stack instance in region %s from application %s: %w", in.Env.Region, in.App.Name, err)
+	}
 	return nil
 }
 
@@ -360,7 +535,7 @@ func (cf CloudFormation) deployAppConfig(appConfig *stack.AppStackConfig, resour
 
 // addNewAppStackInstances takes an environment and determines if we need to create a new
 // stack instance. We only spin up a new stack instance if the env is in a new region.
-func (cf CloudFormation) addNewAppStackInstances(appConfig *stack.AppStackConfig, region string) error {
+func (cf CloudFormation) addNewAppStackInstances(appConfig *stack.AppStackConfig, region string, opts ...stackset.WaitOption) error {
 	summaries, err := cf.appStackSet.InstanceSummaries(appConfig.StackSetName())
 	if err != nil {
 		return err
@@ -380,7 +555,9 @@ func (cf CloudFormation) addNewAppStackInstances(appConfig *stack.AppStackConfig
 	}
 
 	// Set up a new Stack Instance for the new region. The Stack Instance will inherit the latest StackSet template.
-	return cf.appStackSet.CreateInstancesAndWait(appConfig.StackSetName(), []string{appConfig.AccountID}, []string{region})
+	// The instance isn't usable until the operation succeeded: services pushing images to the region need its ECR repositories.
+	opts = append([]stackset.WaitOption{stackset.WithTimeout(appStackInstanceTimeout)}, opts...)
+	return cf.appStackSet.CreateInstancesAndWait(appConfig.StackSetName(), []string{appConfig.AccountID}, []string{region}, opts...)
 }
 
 func (cf CloudFormation) getLastDeployedAppConfig(appConfig *stack.AppStackConfig) (*stack.AppResourcesConfig, error) {
@@ -30,12 +30,13 @@ func TestCloudFormation_DeployApp(t *testing.T) {
 		AccountID: "1234",
 	}
 	testCases := map[string]struct {
-		mockStack    func(ctrl *gomock.Controller) cfnClient
+		app          *deploy.CreateAppInput
+		mockStack    func(t *testing.T, ctrl *gomock.Controller) cfnClient
 		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
 		want         error
 	}{
 		"Infrastructure Roles Stack Fails": {
-			mockStack: func(ctrl *gomock.Controller) cfnClient {
+			mockStack: func(t *testing.T, ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
 				m.EXPECT().CreateAndWait(gomock.Any()).Return(errors.New("error creating stack"))
 				return m
@@ -46,7 +47,7 @@ func TestCloudFormation_DeployApp(t *testing.T) {
 			want: errors.New("error creating stack"),
 		},
 		"Infrastructure Roles Stack Already Exists": {
-			mockStack: func(ctrl *gomock.Controller) cfnClient {
+			mockStack: func(t *testing.T, ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
 				m.EXPECT().CreateAndWait(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{})
 				return m
@@ -58,8 +59,40 @@ func TestCloudFormation_DeployApp(t *testing.T) {
 				return m
 			},
 		},
+		"Infrastructure Roles Stack Already Exists sets the permissions boundary": {
+			app: &deploy.CreateAppInput{
+				Name:                "testapp",
+				AccountID:           "1234",
+				PermissionsBoundary: "arn:aws:iam::1234:policy/Boundary",
+			},
+			mockStack: func(t *testing.T, ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateAndWait(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().Describe("testapp-infrastructure-roles").Return(mockAppRolesStack("stack-arn", map[string]string{
+					"AppDNSDelegatedAccounts": "1234,5678",
+					"AppDomainName":           "example.com",
+				}), nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).DoAndReturn(func(s *cloudformation.Stack) error {
+					params := make(map[string]string)
+					for _, p := range s.Parameters {
+						params[aws.StringValue(p.ParameterKey)] = aws.StringValue(p.ParameterValue)
+					}
+					require.Equal(t, "1234,5678", params["AppDNSDelegatedAccounts"])
+					require.Equal(t, "example.com", params["AppDomainName"])
+					require.Equal(t, "arn:aws:iam::1234:policy/Boundary", params["PermissionsBoundary"])
+					return nil
+				})
+				return m
+			},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				return m
+			},
+		},
 		"Infrastructure Roles StackSet Created": {
-			mockStack: func(ctrl *gomock.Controller) cfnClient {
+			mockStack: func(t *testing.T, ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
 				m.EXPECT().CreateAndWait(gomock.Any()).Return(nil)
 				return m
@@ -81,14 +114,18 @@ func TestCloudFormation_DeployApp(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			app := mockApp
+			if tc.app != nil {
+				app = tc.app
+			}
 			cf := CloudFormation{
-				cfnClient:   tc.mockStack(ctrl),
+				cfnClient:   tc.mockStack(t, ctrl),
 				appStackSet: tc.mockStackSet(t, ctrl),
 				box:         templates.Box(),
 			}
 
 			// WHEN
-			got := cf.DeployApp(mockApp)
+			got := cf.DeployApp(app)
 
 			// THEN
 			if tc.want != nil {
@@ -111,54 +148,74 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 		env          *config.Environment
 		want         error
 	}{
-		"with no existing deployments and adding an env": {
+		"with no existing deployments and adding an env in another account": {
 			app: &mockApp,
-			env: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+			env: &config.Environment{Name: "test", AccountID: "4567", Region: "us-west-2"},
 			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
 				m := mocks.NewMockstackSetClient(ctrl)
-				body, err := yaml.Marshal(stack.DeployedAppMetadata{})
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{
+					Metadata: stack.AppResourcesConfig{
+						Services: []string{"frontend"},
+					},
+				})
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
 				}, nil)
 				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).
-					Do(func(_, _ string, op, _, _, _, _ stackset.CreateOrUpdateOption) {
+					Do(func(_, template string, op, _, _, _, _ stackset.CreateOrUpdateOption) {
 						actual := &sdkcloudformation.UpdateStackSetInput{}
 						op(actual)
 						wanted := &sdkcloudformation.UpdateStackSetInput{}
 						stackset.WithOperationID("1")(wanted)
 						require.Equal(t, actual, wanted)
+						require.Contains(t, template, `- Sid: AllowPullFromEnvAccounts
+          Effect: Allow
+          Principal:
+              AWS:
+                - arn:aws:iam::4567:root`)
 					})
 				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"})
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any())
 				return m
 			},
 		},
-		"with no new account ID added": {
+		"with an env in the application's account (no stackset update)": {
 			app: &mockApp,
 			env: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Times(0)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any()).Return(nil)
+				return m
+			},
+		},
+		"with no new account ID added": {
+			app: &mockApp,
+			env: &config.Environment{Name: "test", AccountID: "4567", Region: "us-west-2"},
 			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
 				m := mocks.NewMockstackSetClient(ctrl)
 				body, err := yaml.Marshal(stack.DeployedAppMetadata{
 					Metadata: stack.AppResourcesConfig{
-						Accounts: []string{"1234"},
+						Accounts: []string{"4567"},
 					},
 				})
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
 				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}).Return(nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any()).Return(nil)
 				return m
 			},
 		},
 		"with existing stack instances in same region but different account (no new stack instances, but update stackset)": {
 			app: &mockApp,
-			env: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+			env: &config.Environment{Name: "test", AccountID: "4567", Region: "us-west-2"},
 			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
 				m := mocks.NewMockstackSetClient(ctrl)
 				body, err := yaml.Marshal(stack.DeployedAppMetadata{
@@ -186,7 +243,7 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 						Account: "1234",
 					},
 				}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				return m
 			},
 		},
@@ -228,7 +285,7 @@ func TestCloudFormation_AddPipelineResourcesToApp(t *testing.T) {
 			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
 				m := mocks.NewMockstackSetClient(ctrl)
 				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}).Return(nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any()).Return(nil)
 				return m
 			},
 			getRegionFromClient: func(client cloudformationiface.CloudFormationAPI) (string, error) {
@@ -245,7 +302,7 @@ func TestCloudFormation_AddPipelineResourcesToApp(t *testing.T) {
 						Account: mockApp.AccountID,
 					},
 				}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				return m
 			},
 			getRegionFromClient: func(client cloudformationiface.CloudFormationAPI) (string, error) {
@@ -430,6 +487,123 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_RemoveEnvFromApp(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	mockEnv := &config.Environment{
+		Name:      "test",
+		AccountID: "5678",
+		Region:    "us-west-2",
+	}
+
+	tests := map[string]struct {
+		remainingEnvs []*config.Environment
+		appRegion     string
+		mockStackSet  func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		want          error
+	}{
+		"should not change the stack set if the account and region are still used": {
+			remainingEnvs: []*config.Environment{
+				{
+					Name:      "prod",
+					AccountID: "5678",
+					Region:    "us-west-2",
+				},
+			},
+			appRegion: "us-east-1",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().DeleteInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"should remove the account and delete the stack instance if they are unused": {
+			appRegion: "us-east-1",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Accounts: []string{"5678", "9012"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _, _, _, _, _ stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []string{"9012"}, configToDeploy.Accounts)
+						require.Equal(t, 2, configToDeploy.Version)
+					})
+				m.EXPECT().InstanceSummaries("testapp-infrastructure", gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						Account: "1234",
+						Region:  "us-west-2",
+					},
+				}, nil)
+				m.EXPECT().DeleteInstancesAndWait("testapp-infrastructure", []string{"1234"}, []string{"us-west-2"}).Return(nil)
+				return m
+			},
+		},
+		"should not delete the stack instance in the application region": {
+			appRegion: "us-west-2",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Version: 1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().DeleteInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"should succeed if the stack instance was already deleted": {
+			remainingEnvs: []*config.Environment{
+				{
+					Name:      "prod",
+					AccountID: "5678",
+					Region:    "us-east-1",
+				},
+			},
+			appRegion: "us-east-1",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().InstanceSummaries("testapp-infrastructure", gomock.Any()).Return(nil, nil)
+				m.EXPECT().DeleteInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				box:         templates.Box(),
+			}
+
+			got := cf.RemoveEnvFromApp(&deploy.RemoveEnvFromAppInput{
+				App:           mockApp,
+				Env:           mockEnv,
+				RemainingEnvs: tc.remainingEnvs,
+				AppRegion:     tc.appRegion,
+			})
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestCloudFormation_GetRegionalAppResources(t *testing.T) {
 	mockApp := config.Application{Name: "app", AccountID: "12345"}
 
@@ -707,6 +881,166 @@ func TestCloudFormation_DelegateDNSPermissions(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_ImageBuildProject(t *testing.T) {
+	app := &config.Application{
+		AccountID: "1234",
+		Name:      "app",
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantProject string
+		wantErr     error
+	}{
+		"returns the project from the outputs of the infrastructure roles stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("app-infrastructure-roles").Return(&cloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{
+							OutputKey:   aws.String("ImageBuildProject"),
+							OutputValue: aws.String("ImageBuildProject-Abc123"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantProject: "ImageBuildProject-Abc123",
+		},
+		"returns error if the stack has no project": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("app-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+				return m
+			},
+			wantErr: errors.New("application app has no image build project since its stack app-infrastructure-roles was created with an older version of Copilot"),
+		},
+		"returns error from Describe Stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("app-infrastructure-roles").Return(nil, errors.New("error"))
+				return m
+			},
+			wantErr: errors.New("get application infrastructure stack: error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			got, err := cf.ImageBuildProject(app)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantProject, got)
+		})
+	}
+}
+
+func TestCloudFormation_RevokeDNSPermissions(t *testing.T) {
+	app := &config.Application{
+		AccountID: "1234",
+		Name:      "app",
+		Domain:    "amazon.com",
+	}
+	testCases := map[string]struct {
+		accountID  string
+		createMock func(ctrl *gomock.Controller) cfnClient
+		want       error
+	}{
+		"skips the application account": {
+			accountID: "1234",
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				return mocks.NewMockcfnClient(ctrl)
+			},
+		},
+		"skips accounts that were never delegated": {
+			accountID: "5678",
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(mockAppRolesStack("stackname", map[string]string{
+					"AppDNSDelegatedAccounts": "1234,9012",
+				}), nil)
+				return m
+			},
+		},
+		"removes the account from the delegated accounts": {
+			accountID: "5678",
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(mockAppRolesStack("stackname", map[string]string{
+					"AppDNSDelegatedAccounts": "1234,5678,9012",
+				}), nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).DoAndReturn(func(s *cloudformation.Stack) error {
+					for _, param := range s.Parameters {
+						if aws.StringValue(param.ParameterKey) == "AppDNSDelegatedAccounts" {
+							require.Equal(t, "1234,9012", aws.StringValue(param.ParameterValue))
+							return nil
+						}
+					}
+					require.FailNow(t, "missing AppDNSDelegatedAccounts parameter")
+					return nil
+				})
+				return m
+			},
+		},
+		"returns error from Describe Stack": {
+			accountID: "5678",
+			want:      fmt.Errorf("get existing application infrastructure stack: error"),
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(nil, errors.New("error"))
+				return m
+			},
+		},
+		"returns error from Update Stack": {
+			accountID: "5678",
+			want:      fmt.Errorf("update application to revoke DNS delegation from account 5678: error"),
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(mockAppRolesStack("stackname", map[string]string{
+					"AppDNSDelegatedAccounts": "1234,5678",
+				}), nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(errors.New("error"))
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+				box:       templates.Box(),
+			}
+
+			// WHEN
+			got := cf.RevokeDNSPermissions(app, tc.accountID)
+
+			// THEN
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func mockValidAppResourceStack() *cloudformation.StackDescription {
 	return mockAppResourceStack("stack", map[string]string{
 		"KMSKeyARN":      "arn:aws:kms:us-west-2:01234567890:key/0000",
@@ -5,6 +5,9 @@
 package cloudformation
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,10 +31,15 @@ type StackConfiguration interface {
 
 type cfnClient interface {
 	Create(*cloudformation.Stack) error
+	CreateWithContext(ctx context.Context, stack *cloudformation.Stack) error
 	CreateAndWait(*cloudformation.Stack) error
+	CreateAndWaitWithContext(ctx context.Context, stack *cloudformation.Stack) error
 	WaitForCreate(stackName string) error
+	WaitForCreateWithContext(ctx context.Context, stackName string) error
 	Update(*cloudformation.Stack) error
+	UpdateWithContext(ctx context.Context, stack *cloudformation.Stack) error
 	UpdateAndWait(*cloudformation.Stack) error
+	UpdateAndWaitWithContext(ctx context.Context, stack *cloudformation.Stack) error
 	WaitForUpdate(stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
@@ -44,7 +52,8 @@ type cfnClient interface {
 
 type stackSetClient interface {
 	Create(name, template string, opts ...stackset.CreateOrUpdateOption) error
-	CreateInstancesAndWait(name string, accounts, regions []string) error
+	CreateInstancesAndWait(name string, accounts, regions []string, opts ...stackset.WaitOption) error
+	DeleteInstancesAndWait(name string, accounts, regions []string) error
 	UpdateAndWait(name, template string, opts ...stackset.CreateOrUpdateOption) error
 	Describe(name string) (stackset.Description, error)
 	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
@@ -57,6 +66,7 @@ type CloudFormation struct {
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	box            packd.Box
+	ctx            context.Context
 }
 
 // New returns a configured CloudFormation client.
@@ -73,6 +83,21 @@ func New(sess *session.Session) CloudFormation {
 	}
 }
 
+// WithContext returns a copy of the client whose service and environment deployments stop once the context is canceled.
+// Change sets that were created but not executed yet are deleted.
+func (cf CloudFormation) WithContext(ctx context.Context) CloudFormation {
+	cf.ctx = ctx
+	return cf
+}
+
+// context returns the context of the client, or a background context if none was set.
+func (cf CloudFormation) context() context.Context {
+	if cf.ctx == nil {
+		return context.Background()
+	}
+	return cf.ctx
+}
+
 // streamResourceEvents sends a list of ResourceEvent every 3 seconds to the events channel.
 // The events channel is closed only when the done channel receives a message.
 // If an error occurs while describing stack events, it is ignored so that the stream is not interrupted.
@@ -148,3 +173,44 @@ func (cf CloudFormation) ErrorEvents(conf StackConfiguration) ([]deploy.Resource
 	}
 	return transformedEvents, nil
 }
+
+// StackTags returns the tags of an existing stack.
+func (cf CloudFormation) StackTags(stackName string) (map[string]string, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	tags := make(map[string]string, len(descr.Tags))
+	for _, tag := range descr.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+// UpdateStackTags replaces the tags of an existing stack while keeping its template and parameter values.
+// CloudFormation propagates the new tags to the resources of the stack.
+func (cf CloudFormation) UpdateStackTags(stackName string, tags map[string]string) error {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	var params []*sdkcloudformation.Parameter
+	for _, param := range descr.Parameters {
+		params = append(params, &sdkcloudformation.Parameter{
+			ParameterKey:     param.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	s := cloudformation.NewStack(stackName, "", cloudformation.WithPreviousTemplate(), cloudformation.WithTags(tags))
+	s.Parameters = params
+	err = cf.cfnClient.UpdateAndWait(s)
+	var emptyChangeSet *cloudformation.ErrChangeSetEmpty
+	if errors.As(err, &emptyChangeSet) {
+		// The tags are already applied.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("update tags of stack %s: %w", stackName, err)
+	}
+	return nil
+}
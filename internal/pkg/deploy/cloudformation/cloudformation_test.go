@@ -0,0 +1,152 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_StackTags(t *testing.T) {
+	testCases := map[string]struct {
+		mockCfn func(m *mocks.MockcfnClient)
+
+		wantedTags map[string]string
+		wantedErr  string
+	}{
+		"returns the tags of the stack": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Tags: []*sdkcloudformation.Tag{
+						{
+							Key:   aws.String("copilot-application"),
+							Value: aws.String("phonetool"),
+						},
+						{
+							Key:   aws.String("cost-center"),
+							Value: aws.String("1234"),
+						},
+					},
+				}, nil)
+			},
+			wantedTags: map[string]string{
+				"copilot-application": "phonetool",
+				"cost-center":         "1234",
+			},
+		},
+		"wraps the describe error": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe stack phonetool-test: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCfn(m)
+			c := CloudFormation{
+				cfnClient: m,
+			}
+
+			// WHEN
+			tags, err := c.StackTags("phonetool-test")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTags, tags)
+		})
+	}
+}
+
+func TestCloudFormation_UpdateStackTags(t *testing.T) {
+	testCases := map[string]struct {
+		mockCfn func(m *mocks.MockcfnClient)
+
+		wantedErr string
+	}{
+		"updates the tags with the previous template and parameter values": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("AppName"),
+							ParameterValue: aws.String("phonetool"),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).DoAndReturn(func(s *cloudformation.Stack) error {
+					require.Equal(t, "phonetool-test", s.Name)
+					require.True(t, s.UsePreviousTemplate)
+					require.Equal(t, []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:     aws.String("AppName"),
+							UsePreviousValue: aws.Bool(true),
+						},
+					}, s.Parameters)
+					require.Equal(t, []*sdkcloudformation.Tag{
+						{
+							Key:   aws.String("cost-center"),
+							Value: aws.String("1234"),
+						},
+					}, s.Tags)
+					return nil
+				})
+			},
+		},
+		"succeeds if the tags are already applied": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(&cloudformation.ErrChangeSetEmpty{})
+			},
+		},
+		"wraps the update error": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "update tags of stack phonetool-test: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCfn(m)
+			c := CloudFormation{
+				cfnClient: m,
+			}
+
+			// WHEN
+			err := c.UpdateStackTags("phonetool-test", map[string]string{
+				"cost-center": "1234",
+			})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
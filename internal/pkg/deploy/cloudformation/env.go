@@ -28,13 +28,14 @@ const (
 // If the deployment succeeds, returns nil.
 // If the stack already exists, returns a ErrStackAlreadyExists.
 // If the change set to create the stack cannot be executed, returns a ErrNotExecutableChangeSet.
+// If the context of the client is canceled before the change set is executed, returns a ErrStackDeployInterrupted.
 // Otherwise, returns a wrapped error.
 func (cf CloudFormation) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
 	s, err := toStack(stack.NewEnvStackConfig(env))
 	if err != nil {
 		return err
 	}
-	return cf.cfnClient.Create(s)
+	return cf.cfnClient.CreateWithContext(cf.context(), s)
 }
 
 // StreamEnvironmentCreation streams resource update events while a deployment is taking place.
@@ -64,7 +65,7 @@ func (cf CloudFormation) DeleteEnvironment(appName, envName, cfnExecRoleARN stri
 // The done channel is closed once this method exits to notify other streams that they should stop working.
 func (cf CloudFormation) streamEnvironmentResponse(done chan struct{}, resp chan deploy.CreateEnvironmentResponse, stack *stack.EnvStackConfig) {
 	defer close(done)
-	if err := cf.cfnClient.WaitForCreate(stack.StackName()); err != nil {
+	if err := cf.cfnClient.WaitForCreateWithContext(cf.context(), stack.StackName()); err != nil {
 		resp <- deploy.CreateEnvironmentResponse{Err: err}
 		return
 	}
@@ -93,12 +94,55 @@ func (cf CloudFormation) GetEnvironment(appName, envName string) (*config.Enviro
 	return conf.ToEnv(descr.SDK())
 }
 
+// EnvironmentFromStack returns the environment described by its existing stack, including the customized resources
+// that its template was deployed with, and the version of the template.
+func (cf CloudFormation) EnvironmentFromStack(appName, envName string) (*config.Environment, string, error) {
+	conf := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+		AppName: appName,
+		Name:    envName,
+	})
+	descr, err := cf.cfnClient.Describe(conf.StackName())
+	if err != nil {
+		return nil, "", err
+	}
+	body, err := cf.cfnClient.TemplateBody(conf.StackName())
+	if err != nil {
+		return nil, "", fmt.Errorf("get template of stack %s: %w", conf.StackName(), err)
+	}
+	env, err := conf.ToEnvWithCustomConfig(descr.SDK(), body)
+	if err != nil {
+		return nil, "", err
+	}
+	version, err := stack.EnvTemplateVersion(body)
+	if err != nil {
+		return nil, "", err
+	}
+	return env, version, nil
+}
+
 // EnvironmentTemplate returns the environment's stack's template.
 func (cf CloudFormation) EnvironmentTemplate(appName, envName string) (string, error) {
 	stackName := stack.NameForEnv(appName, envName)
 	return cf.cfnClient.TemplateBody(stackName)
 }
 
+// EnvironmentOutputExports returns the export names of the environment stack's exported outputs keyed by output name.
+func (cf CloudFormation) EnvironmentOutputExports(appName, envName string) (map[string]string, error) {
+	stackName := stack.NameForEnv(appName, envName)
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	exports := make(map[string]string)
+	for _, output := range descr.Outputs {
+		if output.ExportName == nil {
+			continue
+		}
+		exports[aws.StringValue(output.OutputKey)] = aws.StringValue(output.ExportName)
+	}
+	return exports, nil
+}
+
 // UpdateEnvironmentTemplate updates the cloudformation stack's template body while maintaining the parameters and tags.
 func (cf CloudFormation) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error {
 	stackName := stack.NameForEnv(appName, envName)
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/golang/mock/gomock"
@@ -252,6 +253,180 @@ func TestCloudFormation_EnvironmentTemplate(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_EnvironmentFromStack(t *testing.T) {
+	mockStack := &cloudformation.StackDescription{
+		StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/1a2b3c"),
+		Outputs: []*awscfn.Output{
+			{
+				OutputKey:   aws.String("EnvironmentManagerRoleARN"),
+				OutputValue: aws.String("arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole"),
+			},
+			{
+				OutputKey:   aws.String("CFNExecutionRoleARN"),
+				OutputValue: aws.String("arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole"),
+			},
+			{
+				OutputKey:   aws.String("VpcId"),
+				OutputValue: aws.String("vpc-1234"),
+			},
+			{
+				OutputKey:   aws.String("PublicSubnets"),
+				OutputValue: aws.String("subnet-1,subnet-2"),
+			},
+			{
+				OutputKey:   aws.String("PrivateSubnets"),
+				OutputValue: aws.String("subnet-3,subnet-4"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedEnv     *config.Environment
+		wantedVersion string
+		wantedError   error
+	}{
+		"returns the error if the stack can't be described": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				m.EXPECT().TemplateBody(gomock.Any()).Times(0)
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"wraps error if the template can't be retrieved": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(mockStack, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return("", errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("get template of stack phonetool-test: some error"),
+		},
+		"returns the environment and the version of its template": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(mockStack, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return(`Metadata:
+  Version: 'v1.1.0'
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+`, nil)
+				return m
+			},
+			wantedEnv: &config.Environment{
+				App:              "phonetool",
+				Name:             "test",
+				Region:           "us-west-2",
+				AccountID:        "123456789012",
+				ManagerRoleARN:   "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+				CustomConfig: &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "vpc-1234",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+					},
+				},
+			},
+			wantedVersion: "v1.1.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			env, version, err := cf.EnvironmentFromStack("phonetool", "test")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, env)
+			require.Equal(t, tc.wantedVersion, version)
+		})
+	}
+}
+
+func TestCloudFormation_EnvironmentOutputExports(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wanted      map[string]string
+		wantedError error
+	}{
+		"wraps error if describe fails": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack phonetool-test: some error"),
+		},
+		"returns the export names of the exported outputs only": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Outputs: []*awscfn.Output{
+						{
+							OutputKey:   aws.String("PrivateSubnets"),
+							OutputValue: aws.String("subnet-1,subnet-2"),
+							ExportName:  aws.String("phonetool-test-PrivateSubnets"),
+						},
+						{
+							OutputKey:   aws.String("PublicLoadBalancerDNSName"),
+							OutputValue: aws.String("lb.us-west-2.elb.amazonaws.com"),
+							ExportName:  aws.String("phonetool-test-PublicLoadBalancerDNS"),
+						},
+						{
+							OutputKey:   aws.String("EnabledFeatures"),
+							OutputValue: aws.String(""),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wanted: map[string]string{
+				"PrivateSubnets":            "phonetool-test-PrivateSubnets",
+				"PublicLoadBalancerDNSName": "phonetool-test-PublicLoadBalancerDNS",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			exports, err := cf.EnvironmentOutputExports("phonetool", "test")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, exports)
+		})
+	}
+}
+
 func TestCloudFormation_UpdateEnvironmentTemplate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName      string
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
@@ -302,6 +303,76 @@ func (mr *MockcfnClientMockRecorder) ErrorEvents(stackName interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorEvents", reflect.TypeOf((*MockcfnClient)(nil).ErrorEvents), stackName)
 }
 
+// CreateAndWaitWithContext mocks base method
+func (m *MockcfnClient) CreateAndWaitWithContext(ctx context.Context, stack *cloudformation0.Stack) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndWaitWithContext", ctx, stack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAndWaitWithContext indicates an expected call of CreateAndWaitWithContext
+func (mr *MockcfnClientMockRecorder) CreateAndWaitWithContext(ctx, stack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWaitWithContext", reflect.TypeOf((*MockcfnClient)(nil).CreateAndWaitWithContext), ctx, stack)
+}
+
+// CreateWithContext mocks base method
+func (m *MockcfnClient) CreateWithContext(ctx context.Context, stack *cloudformation0.Stack) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithContext", ctx, stack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithContext indicates an expected call of CreateWithContext
+func (mr *MockcfnClientMockRecorder) CreateWithContext(ctx, stack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithContext", reflect.TypeOf((*MockcfnClient)(nil).CreateWithContext), ctx, stack)
+}
+
+// UpdateAndWaitWithContext mocks base method
+func (m *MockcfnClient) UpdateAndWaitWithContext(ctx context.Context, stack *cloudformation0.Stack) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAndWaitWithContext", ctx, stack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAndWaitWithContext indicates an expected call of UpdateAndWaitWithContext
+func (mr *MockcfnClientMockRecorder) UpdateAndWaitWithContext(ctx, stack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAndWaitWithContext", reflect.TypeOf((*MockcfnClient)(nil).UpdateAndWaitWithContext), ctx, stack)
+}
+
+// UpdateWithContext mocks base method
+func (m *MockcfnClient) UpdateWithContext(ctx context.Context, stack *cloudformation0.Stack) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithContext", ctx, stack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithContext indicates an expected call of UpdateWithContext
+func (mr *MockcfnClientMockRecorder) UpdateWithContext(ctx, stack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithContext", reflect.TypeOf((*MockcfnClient)(nil).UpdateWithContext), ctx, stack)
+}
+
+// WaitForCreateWithContext mocks base method
+func (m *MockcfnClient) WaitForCreateWithContext(ctx context.Context, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForCreateWithContext", ctx, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForCreateWithContext indicates an expected call of WaitForCreateWithContext
+func (mr *MockcfnClientMockRecorder) WaitForCreateWithContext(ctx, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForCreateWithContext", reflect.TypeOf((*MockcfnClient)(nil).WaitForCreateWithContext), ctx, stackName)
+}
+
 // MockstackSetClient is a mock of stackSetClient interface
 type MockstackSetClient struct {
 	ctrl     *gomock.Controller
@@ -345,17 +416,22 @@ func (mr *MockstackSetClientMockRecorder) Create(name, template interface{}, opt
 }
 
 // CreateInstancesAndWait mocks base method
-func (m *MockstackSetClient) CreateInstancesAndWait(name string, accounts, regions []string) error {
+func (m *MockstackSetClient) CreateInstancesAndWait(name string, accounts, regions []string, opts ...stackset.WaitOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInstancesAndWait", name, accounts, regions)
+	varargs := []interface{}{name, accounts, regions}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInstancesAndWait", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateInstancesAndWait indicates an expected call of CreateInstancesAndWait
-func (mr *MockstackSetClientMockRecorder) CreateInstancesAndWait(name, accounts, regions interface{}) *gomock.Call {
+func (mr *MockstackSetClientMockRecorder) CreateInstancesAndWait(name, accounts, regions interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstancesAndWait", reflect.TypeOf((*MockstackSetClient)(nil).CreateInstancesAndWait), name, accounts, regions)
+	varargs := append([]interface{}{name, accounts, regions}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstancesAndWait", reflect.TypeOf((*MockstackSetClient)(nil).CreateInstancesAndWait), varargs...)
 }
 
 // UpdateAndWait mocks base method
@@ -425,3 +501,17 @@ func (mr *MockstackSetClientMockRecorder) Delete(name interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockstackSetClient)(nil).Delete), name)
 }
+
+// DeleteInstancesAndWait mocks base method
+func (m *MockstackSetClient) DeleteInstancesAndWait(name string, accounts, regions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstancesAndWait", name, accounts, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInstancesAndWait indicates an expected call of DeleteInstancesAndWait
+func (mr *MockstackSetClientMockRecorder) DeleteInstancesAndWait(name, accounts, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstancesAndWait", reflect.TypeOf((*MockstackSetClient)(nil).DeleteInstancesAndWait), name, accounts, regions)
+}
@@ -52,9 +52,11 @@ const (
 	appAdminRoleParamName         = "AdminRoleName"
 	appExecutionRoleParamName     = "ExecutionRoleName"
 	appDNSDelegationRoleParamName = "DNSDelegationRoleName"
+	appPermissionsBoundaryKey     = "PermissionsBoundary"
 	appOutputKMSKey               = "KMSKeyARN"
 	appOutputS3Bucket             = "PipelineBucket"
 	appOutputECRRepoPrefix        = "ECRRepo"
+	appOutputImageBuildProject    = "ImageBuildProject"
 	appDNSDelegatedAccountsKey    = "AppDNSDelegatedAccounts"
 	appDomainNameKey              = "AppDomainName"
 	appNameKey                    = "AppName"
@@ -100,9 +102,11 @@ func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, e
 	content, err := c.parser.Parse(appResourcesTemplatePath, struct {
 		*AppResourcesConfig
 		ServiceTagKey string
+		CrossAccounts []string
 	}{
 		config,
 		deploy.ServiceTagKey,
+		c.crossAccounts(config.Accounts),
 	}, template.WithFuncs(cfTemplateFunctions))
 	if err != nil {
 		return "", err
@@ -110,6 +114,19 @@ func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, e
 	return content.String(), err
 }
 
+// crossAccounts returns the environment accounts that are different from the application's account.
+// They're only allowed to pull the images of the ECR repositories, which live in the application's account.
+func (c *AppStackConfig) crossAccounts(accounts []string) []string {
+	var crossAccounts []string
+	for _, account := range accounts {
+		if account == c.AccountID {
+			continue
+		}
+		crossAccounts = append(crossAccounts, account)
+	}
+	return crossAccounts
+}
+
 // Parameters returns a list of parameters which accompany the app CloudFormation template.
 func (c *AppStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
@@ -137,6 +154,10 @@ func (c *AppStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(appDNSDelegationRoleParamName),
 			ParameterValue: aws.String(dnsDelegationRoleName(c.Name)),
 		},
+		{
+			ParameterKey:   aws.String(appPermissionsBoundaryKey),
+			ParameterValue: aws.String(c.PermissionsBoundary),
+		},
 	}, nil
 }
 
@@ -249,6 +270,28 @@ func DNSDelegatedAccountsForStack(stack *cloudformation.Stack) []string {
 	return []string{}
 }
 
+// AppDomainNameForStack returns the domain name of the application from its infrastructure roles stack.
+func AppDomainNameForStack(stack *cloudformation.Stack) string {
+	for _, parameter := range stack.Parameters {
+		if aws.StringValue(parameter.ParameterKey) == appDomainNameKey {
+			return aws.StringValue(parameter.ParameterValue)
+		}
+	}
+	return ""
+}
+
+// ImageBuildProjectForStack returns the name of the CodeBuild project that builds images remotely
+// from the outputs of an application's infrastructure roles stack.
+// Stacks created before the project was introduced don't have one, in that case it returns an empty string.
+func ImageBuildProjectForStack(stack *cloudformation.Stack) string {
+	for _, output := range stack.Outputs {
+		if aws.StringValue(output.OutputKey) == appOutputImageBuildProject {
+			return aws.StringValue(output.OutputValue)
+		}
+	}
+	return ""
+}
+
 func dnsDelegationRoleName(appName string) string {
 	return fmt.Sprintf("%s-%s", appName, appDNSDelegationRoleName)
 }
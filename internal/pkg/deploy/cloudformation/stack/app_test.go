@@ -137,6 +137,7 @@ func TestAppResourceTemplate(t *testing.T) {
 				m.EXPECT().Parse(appResourcesTemplatePath, struct {
 					*AppResourcesConfig
 					ServiceTagKey string
+					CrossAccounts []string
 				}{
 					&AppResourcesConfig{
 						Accounts: []string{"1234", "4567"},
@@ -145,6 +146,37 @@ func TestAppResourceTemplate(t *testing.T) {
 						App:      "testapp",
 					},
 					deploy.ServiceTagKey,
+					[]string{"4567"},
+				}, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("template"),
+				}, nil)
+				c.parser = m
+			},
+
+			wantedTemplate: "template",
+		},
+		"should not allow the application's account to pull across accounts": {
+			given: &AppResourcesConfig{
+				Accounts: []string{"1234"},
+				Services: []string{"app-1"},
+				Version:  1,
+				App:      "testapp",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, c *AppStackConfig) {
+				m := mocks.NewMockReadParser(ctrl)
+				m.EXPECT().Parse(appResourcesTemplatePath, struct {
+					*AppResourcesConfig
+					ServiceTagKey string
+					CrossAccounts []string
+				}{
+					&AppResourcesConfig{
+						Accounts: []string{"1234"},
+						Services: []string{"app-1"},
+						Version:  1,
+						App:      "testapp",
+					},
+					deploy.ServiceTagKey,
+					nil,
 				}, gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("template"),
 				}, nil)
@@ -198,9 +230,14 @@ func TestAppParameters(t *testing.T) {
 			ParameterKey:   aws.String(appNameKey),
 			ParameterValue: aws.String("testapp"),
 		},
+		{
+			ParameterKey:   aws.String(appPermissionsBoundaryKey),
+			ParameterValue: aws.String("arn:aws:iam::1234:policy/Boundary"),
+		},
 	}
 	app := &AppStackConfig{
-		CreateAppInput: &deploy.CreateAppInput{Name: "testapp", AccountID: "1234", DomainName: "amazon.com"},
+		CreateAppInput: &deploy.CreateAppInput{Name: "testapp", AccountID: "1234", DomainName: "amazon.com",
+			PermissionsBoundary: "arn:aws:iam::1234:policy/Boundary"},
 	}
 	params, _ := app.Parameters()
 	require.ElementsMatch(t, expectedParams, params)
@@ -316,6 +353,58 @@ func TestDNSDelegatedAccountsForStack(t *testing.T) {
 	}
 }
 
+func TestAppDomainNameForStack(t *testing.T) {
+	testCases := map[string]struct {
+		given map[string]string
+		want  string
+	}{
+		"should read the domain name from the parameters": {
+			given: map[string]string{
+				appDomainNameKey: "example.com",
+			},
+			want: "example.com",
+		},
+		"should return empty when the stack has no domain": {
+			given: map[string]string{
+				appDNSDelegatedAccountsKey: "1234",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := AppDomainNameForStack(mockAppRolesStack("stack", tc.given))
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestImageBuildProjectForStack(t *testing.T) {
+	testCases := map[string]struct {
+		given map[string]string
+		want  string
+	}{
+		"should read the project name from the outputs": {
+			given: map[string]string{
+				appOutputImageBuildProject: "ImageBuildProject-Abc123",
+			},
+			want: "ImageBuildProject-Abc123",
+		},
+		"should return empty when the stack has no project": {
+			given: map[string]string{
+				"ExecutionRoleARN": "arn:aws:iam::1234:role/app-executionrole",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := ImageBuildProjectForStack(mockAppResourceStack("stack", tc.given))
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func mockAppResourceStack(stackArn string, outputs map[string]string) *cloudformation.Stack {
 	outputList := []*cloudformation.Output{}
 	for key, val := range outputs {
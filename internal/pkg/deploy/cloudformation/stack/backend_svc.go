@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
@@ -44,20 +45,26 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	patches, err := override.New(aws.StringValue(mft.Name))
+	if err != nil {
+		return nil, fmt.Errorf("new patches: %w", err)
+	}
 	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %w", env, err)
 	}
 	return &BackendService{
 		wkld: &wkld{
-			name:   aws.StringValue(mft.Name),
-			env:    env,
-			app:    app,
-			tc:     envManifest.BackendServiceConfig.TaskConfig,
-			rc:     rc,
-			image:  envManifest.ImageConfig,
-			parser: parser,
-			addons: addons,
+			name:    aws.StringValue(mft.Name),
+			env:     env,
+			app:     app,
+			tc:      envManifest.BackendServiceConfig.TaskConfig,
+			rc:      rc,
+			image:   envManifest.ImageConfig,
+			logging: envManifest.Logging,
+			parser:  parser,
+			addons:  addons,
+			patches: patches,
 		},
 		manifest: envManifest,
 
@@ -75,28 +82,97 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := s.manifest.Sidecar.Options()
+	if err := manifest.ValidateContainerResources(s.name, s.manifest.TaskConfig, s.manifest.ImageConfig.ContainerResources, s.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the container resources for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateLogging(s.manifest.Logging); err != nil {
+		return "", fmt.Errorf("validate the logging configuration for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidatePlatform(s.manifest.Platform); err != nil {
+		return "", fmt.Errorf("validate the platform for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateMessaging(s.manifest.Messaging); err != nil {
+		return "", fmt.Errorf("validate the messaging configuration for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateObservability(s.manifest.Observability, s.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the observability configuration for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateDockerLabels(s.manifest.ImageConfig.DockerLabels, s.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the docker labels for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateContainerDependencies(s.name, s.manifest.ImageConfig.DependsOn, s.manifest.ImageConfig.HealthCheck != nil, s.manifest.Sidecar, s.manifest.Logging, s.manifest.Observability); err != nil {
+		return "", fmt.Errorf("validate the container dependencies for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateServiceTags(s.manifest.ServiceTags); err != nil {
+		return "", fmt.Errorf("validate the service tags for service %s: %w", s.name, err)
+	}
+	copilotVars := append(messagingEnvVars(s.manifest.Messaging), observabilityEnvVars(s.manifest.Observability)...)
+	if err := validateEnvVarNames(s.manifest.TaskConfig, outputs, copilotVars...); err != nil {
+		return "", fmt.Errorf("validate the environment variables for service %s: %w", s.name, err)
+	}
+	variables, err := s.variablesOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the variables for service %s: %w", s.name, err)
+	}
+	sidecars, err := s.sidecarOpts(s.manifest.Sidecar)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	observability, xraySidecar := observabilityOpts(s.manifest.Observability)
+	if xraySidecar != nil {
+		sidecars = append(sidecars, xraySidecar)
+	}
 	autoscaling, err := s.manifest.Count.Autoscaling.Options()
 	if err != nil {
 		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
 	}
+	capacityProviders, err := s.manifest.Count.CapacityProviderStrategy()
+	if err != nil {
+		return "", fmt.Errorf("convert the Fargate Spot configuration for service %s: %w", s.name, err)
+	}
+	deploymentConfig, err := s.manifest.Deployment.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	alarms, err := s.manifest.Alarms.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the alarms for service %s: %w", s.name, err)
+	}
+	serviceConnect, err := s.serviceConnectOpts(s.manifest.Network, s.manifest.BackendServiceConfig.ImageConfig.Port)
+	if err != nil {
+		return "", fmt.Errorf("convert the Service Connect configuration for service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
-		Variables:          s.manifest.BackendServiceConfig.Variables,
-		Secrets:            s.manifest.BackendServiceConfig.Secrets,
-		NestedStack:        outputs,
-		Sidecars:           sidecars,
-		Autoscaling:        autoscaling,
-		HealthCheck:        s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
-		LogConfig:          s.manifest.LogConfigOpts(),
-		DesiredCountLambda: desiredCountLambda.String(),
+		Variables:           variables,
+		Secrets:             s.manifest.BackendServiceConfig.Secrets,
+		NestedStack:         outputs,
+		Sidecars:            sidecars,
+		ContainerResources:  s.manifest.ImageConfig.ContainerResources.Options(),
+		DockerLabels:        s.manifest.ImageConfig.DockerLabels,
+		DependsOn:           manifest.ContainerDependencyOpts(s.manifest.ImageConfig.DependsOn),
+		ServiceTags:         s.manifest.ServiceTags,
+		RuntimePlatform:     s.manifest.RuntimePlatformOpts(),
+		Autoscaling:         autoscaling,
+		CapacityProviders:   capacityProviders,
+		DeploymentConfig:    deploymentConfig,
+		Alarms:              alarms,
+		ServiceConnect:      serviceConnect,
+		Messaging:           s.messagingOpts(s.manifest.Messaging),
+		Observability:       observability,
+		HealthCheck:         s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
+		AdditionalPorts:     s.manifest.BackendServiceConfig.ImageConfig.AdditionalPorts,
+		LogConfig:           s.manifest.LogConfigOpts(),
+		LogGroupName:        s.manifest.Logging.LogGroupName(),
+		SecurityGroups:      s.rc.SecurityGroups,
+		EnableExec:          aws.BoolValue(s.manifest.Exec),
+		CrossAccountRepoARN: crossAccountRepoARN(s.rc.Image, s.rc.AccountID),
+		PermissionsBoundary: s.rc.PermissionsBoundary,
+		DesiredCountLambda:  desiredCountLambda.String(),
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
 	}
-	return content.String(), nil
+	return s.applyPatches(content.String())
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -5,6 +5,7 @@ package stack
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -19,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 // Test settings for container healthchecks in the backend service manifest.
@@ -63,6 +65,51 @@ func TestBackendService_Template(t *testing.T) {
 	testBackendSvcManifestWithBadAutoScaling.Count.Autoscaling = manifest.Autoscaling{
 		Range: &badRange,
 	}
+	testBackendSvcManifestWithBadSpot := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithBadSpot.Count.CapacityProviders = &manifest.CapacityProviders{
+		SpotWeight: aws.Int(3),
+	}
+	testBackendSvcManifestWithBadDeployment := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithBadDeployment.Deployment = manifest.DeploymentConfig{
+		MinHealthyPercent: aws.Int(150),
+	}
+	testBackendSvcManifestWithBadAlarms := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithBadAlarms.Alarms = manifest.AlarmsConfig{
+		CPU: aws.Int(0),
+	}
+	testBackendSvcManifestWithConnect := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithConnect.Network = manifest.NetworkConfig{
+		Connect: aws.Bool(true),
+	}
+	testBackendSvcManifestWithBadRetention := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithBadRetention.Logging = &manifest.Logging{
+		Retention: aws.Int(2),
+	}
+	testBackendSvcManifestWithBadMessaging := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithBadMessaging.Publish = []string{"orders", "orders"}
+	testBackendSvcManifestWithXRaySidecar := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithXRaySidecar.Observability.Tracing = aws.String("awsxray")
+	testBackendSvcManifestWithXRaySidecar.Sidecars = map[string]*manifest.SidecarConfig{
+		"xray": {
+			Image: manifest.SidecarImage{Location: aws.String("amazon/aws-xray-daemon")},
+		},
+	}
+	testBackendSvcManifestWithReservedLabel := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithReservedLabel.ImageConfig.DockerLabels = map[string]string{
+		"com.amazonaws.ecs.cluster": "my-cluster",
+	}
+	testBackendSvcManifestWithUnknownDependency := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithUnknownDependency.ImageConfig.DependsOn = map[string]string{
+		"nginx": "START",
+	}
+	testBackendSvcManifestWithReservedTag := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithReservedTag.ServiceTags = map[string]string{
+		"copilot-service": "api",
+	}
+	testBackendSvcManifestWithEnvVarCollision := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithEnvVarCollision.Variables = map[string]manifest.Variable{
+		"DB_HOST": {Value: aws.String("localhost")},
+	}
 	testCases := map[string]struct {
 		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *BackendService)
 		manifest         *manifest.BackendService
@@ -88,6 +135,20 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("generate addons template for %s: %w", aws.StringValue(testBackendSvcManifest.Name), errors.New("some error")),
 		},
+		"environment variable set by both the manifest and the addons": {
+			manifest: testBackendSvcManifestWithEnvVarCollision,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  DbHost:
+    Value: hello`,
+				}
+			},
+			wantedErr: errors.New(`validate the environment variables for service frontend: environment variables set by more than one source: DB_HOST (variables, addons output DbHost); set "allow_env_override: true" in the manifest if this is intended`),
+		},
 		"failed parsing sidecars template": {
 			manifest: testBackendSvcManifestWithBadSidecar,
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
@@ -116,6 +177,122 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("convert the Auto Scaling configuration for service frontend: %w", errors.New("invalid range value badRange. Should be in format of ${min}-${max}")),
 		},
+		"failed parsing Fargate Spot configuration": {
+			manifest: testBackendSvcManifestWithBadSpot,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf("convert the Fargate Spot configuration for service frontend: %w", errors.New(`"count.capacity_providers" requires "count.range"`)),
+		},
+		"failed parsing deployment configuration": {
+			manifest: testBackendSvcManifestWithBadDeployment,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf("convert the deployment configuration for service frontend: %w", errors.New(`"deployment.min_healthy_percent" 150 must be between 0 and 100`)),
+		},
+		"failed parsing alarms": {
+			manifest: testBackendSvcManifestWithBadAlarms,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf("convert the alarms for service frontend: %w", errors.New(`"alarms.cpu_percentage" 0 must be between 1 and 100`)),
+		},
+		"failed parsing Service Connect configuration": {
+			manifest: testBackendSvcManifestWithConnect,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("convert the Service Connect configuration for service frontend: %w", errors.New(`environment test does not support "network.connect", run "copilot env upgrade --app phonetool --name test" first`)),
+		},
+		"failed validating logging configuration": {
+			manifest: testBackendSvcManifestWithBadRetention,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf("validate the logging configuration for service frontend: %w", errors.New("logging.retention 2 must be one of 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653")),
+		},
+		"failed validating messaging configuration": {
+			manifest: testBackendSvcManifestWithBadMessaging,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("validate the messaging configuration for service frontend: %w", errors.New(`"publish" topic "orders" is listed more than once`)),
+		},
+		"failed validating observability configuration": {
+			manifest: testBackendSvcManifestWithXRaySidecar,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("validate the observability configuration for service frontend: %w", errors.New(`sidecar "xray" conflicts with the X-Ray daemon sidecar added by "observability.tracing", remove the sidecar or the "tracing" field`)),
+		},
+		"failed validating docker labels": {
+			manifest: testBackendSvcManifestWithReservedLabel,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("validate the docker labels for service frontend: %w", errors.New(`"com.amazonaws.ecs.cluster" in "image.labels" cannot start with the reserved prefix "com.amazonaws.ecs."`)),
+		},
+		"failed validating container dependencies": {
+			manifest: testBackendSvcManifestWithUnknownDependency,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("validate the container dependencies for service frontend: %w", errors.New(`"nginx" in "image.depends_on" is not a container of the task`)),
+		},
+		"failed validating service tags": {
+			manifest: testBackendSvcManifestWithReservedTag,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("validate the service tags for service frontend: %w", errors.New(`"copilot-service" in "service_tags" cannot start with the reserved prefix "copilot-"`)),
+		},
 		"failed parsing svc template": {
 			manifest: testBackendSvcManifest,
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
@@ -159,6 +336,30 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"error applying patches": {
+			manifest: testBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+				svc.patches = mockTemplatePatcher{err: errors.New("some error")}
+			},
+			wantedErr: fmt.Errorf("apply patches to the template of frontend: %w", errors.New("some error")),
+		},
+		"render patched template": {
+			manifest: testBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+				svc.patches = mockTemplatePatcher{patched: "patched template"}
+			},
+			wantedTemplate: "patched template",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -196,6 +397,137 @@ func TestBackendService_Template(t *testing.T) {
 	}
 }
 
+func TestBackendService_TemplateDockerLabelsAndServiceTags(t *testing.T) {
+	// GIVEN
+	mft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	mft.ImageConfig.DockerLabels = map[string]string{
+		"com.example.team":    "payments",
+		"com.example.version": "1.0",
+	}
+	mft.Sidecars = map[string]*manifest.SidecarConfig{
+		"nginx": {
+			Image: manifest.SidecarImage{Location: aws.String("public.ecr.aws/nginx/nginx")},
+			DockerLabels: map[string]string{
+				"com.example.proxy": "true",
+			},
+		},
+	}
+	mft.ServiceTags = map[string]string{
+		"cost-center": "1234",
+		"owner":       "payments team",
+	}
+	parser := template.New()
+	svc := &BackendService{
+		wkld: &wkld{
+			name: aws.StringValue(mft.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			rc: RuntimeConfig{
+				Image: &ECRImage{
+					RepoURL:  testImageRepoURL,
+					ImageTag: testImageTag,
+				},
+			},
+			parser: parser,
+			addons: mockTemplater{err: &addon.ErrAddonsDirNotExist{}},
+		},
+		manifest: mft,
+		parser:   parser,
+	}
+
+	// WHEN
+	tpl, err := svc.Template()
+	require.NoError(t, err)
+
+	// THEN
+	var rendered struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []map[string]interface{} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+			Service struct {
+				Properties struct {
+					PropagateTags string              `yaml:"PropagateTags"`
+					Tags          []map[string]string `yaml:"Tags"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &rendered))
+	containers := rendered.Resources.TaskDefinition.Properties.ContainerDefinitions
+	require.Len(t, containers, 2)
+	mainLabels, err := json.Marshal(containers[0]["DockerLabels"])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"com.example.team": "payments", "com.example.version": "1.0"}`, string(mainLabels))
+	sidecarLabels, err := json.Marshal(containers[1]["DockerLabels"])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"com.example.proxy": "true"}`, string(sidecarLabels))
+
+	require.Equal(t, "SERVICE", rendered.Resources.Service.Properties.PropagateTags)
+	tags, err := json.Marshal(rendered.Resources.Service.Properties.Tags)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"Key": "cost-center", "Value": "1234"}, {"Key": "owner", "Value": "payments team"}]`, string(tags))
+}
+
+func TestBackendService_TemplatePermissionsBoundary(t *testing.T) {
+	// GIVEN
+	const boundary = "arn:aws:iam::123456789012:policy/Boundary"
+	mft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	autoscalingRange := manifest.Range("1-10")
+	mft.Count = manifest.Count{
+		Autoscaling: manifest.Autoscaling{
+			Range: &autoscalingRange,
+			CPU:   aws.Int(70),
+		},
+	}
+	parser := template.New()
+	svc := &BackendService{
+		wkld: &wkld{
+			name: aws.StringValue(mft.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			tc:   mft.TaskConfig,
+			rc: RuntimeConfig{
+				Image: &ECRImage{
+					RepoURL:  testImageRepoURL,
+					ImageTag: testImageTag,
+				},
+				PermissionsBoundary: boundary,
+			},
+			parser: parser,
+			addons: mockTemplater{err: &addon.ErrAddonsDirNotExist{}},
+		},
+		manifest: mft,
+		parser:   parser,
+	}
+
+	// WHEN
+	tpl, err := svc.Template()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]interface{}{
+		"ExecutionRole":      boundary,
+		"TaskRole":           boundary,
+		"AutoScalingRole":    boundary,
+		"CustomResourceRole": boundary,
+	}, rolesPermissionsBoundary(t, tpl))
+}
+
 func TestBackendService_Parameters(t *testing.T) {
 	// GIVEN
 	conf := &BackendService{
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
 )
 
 type envReadParser interface {
@@ -40,11 +41,26 @@ const (
 	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 
 	// Output keys.
-	EnvOutputVPCID               = "VpcId"
-	EnvOutputPublicSubnets       = "PublicSubnets"
-	EnvOutputPrivateSubnets      = "PrivateSubnets"
-	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
-	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
+	EnvOutputVPCID                   = "VpcId"
+	EnvOutputPublicSubnets           = "PublicSubnets"
+	EnvOutputPrivateSubnets          = "PrivateSubnets"
+	EnvOutputIPv6Enabled             = "IPv6Enabled"
+	EnvOutputImportedSecurityGroups  = "ImportedSecurityGroups"
+	EnvOutputAccessLogsBucket        = "AccessLogsBucket"
+	EnvOutputImportedCertARNs        = "ImportedCertificateARNs"
+	EnvOutputClusterID               = "ClusterId"
+	EnvOutputServiceConnectNamespace = "ServiceConnectNamespace"
+	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
+	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
+
+	// Logical IDs of the resources that an environment's customizations are read from.
+	envResourceVPC              = "VPC"
+	envResourcePublicSubnet     = "PublicSubnet"
+	envResourcePrivateSubnet    = "PrivateSubnet"
+	envResourceLoadBalancer     = "PublicLoadBalancer"
+	envResourceAccessLogsBucket = "ELBAccessLogsBucket"
+
+	envLBAttrAccessLogsPrefix = "access_logs.s3.prefix"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -91,6 +107,10 @@ func (e *EnvStackConfig) Template() (string, error) {
 		EnableLongARNFormatLambda: enableLongARNsLambda.String(),
 		ImportVPC:                 e.in.ImportVPCConfig,
 		VPCConfig:                 vpcConf,
+		EnableIPv6:                e.in.EnableIPv6,
+		AccessLogs:                e.in.AccessLogsConfig,
+		ImportCertARNs:            e.in.ImportCertARNs,
+		PermissionsBoundary:       e.in.PermissionsBoundary,
 		Version:                   e.in.Version,
 	}, template.WithFuncs(map[string]interface{}{
 		"inc": template.IncFunc,
@@ -176,3 +196,148 @@ func (e *EnvStackConfig) ToEnv(stack *cloudformation.Stack) (*config.Environment
 		ExecutionRoleARN: stackOutputs[envOutputCFNExecutionRoleARN],
 	}, nil
 }
+
+// envTemplate holds the parts of a deployed environment template that describe how the environment was configured.
+type envTemplate struct {
+	Metadata struct {
+		Version string `yaml:"Version"`
+	} `yaml:"Metadata"`
+	Resources map[string]envTemplateResource `yaml:"Resources"`
+}
+
+type envTemplateResource struct {
+	Properties struct {
+		// Nodes since the values can be intrinsic functions, such as "!Select [ 0, !GetAZs '' ]".
+		CidrBlock              yaml.Node `yaml:"CidrBlock"`
+		AvailabilityZone       yaml.Node `yaml:"AvailabilityZone"`
+		LoadBalancerAttributes []struct {
+			Key   string    `yaml:"Key"`
+			Value yaml.Node `yaml:"Value"`
+		} `yaml:"LoadBalancerAttributes"`
+	} `yaml:"Properties"`
+}
+
+func parseEnvTemplate(body string) (*envTemplate, error) {
+	var tpl envTemplate
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal environment template: %w", err)
+	}
+	return &tpl, nil
+}
+
+// EnvTemplateVersion returns the version of an environment template from its Metadata.Version field.
+// If the field does not exist, then it's a legacy template and it returns deploy.LegacyEnvTemplateVersion.
+func EnvTemplateVersion(templateBody string) (string, error) {
+	tpl, err := parseEnvTemplate(templateBody)
+	if err != nil {
+		return "", err
+	}
+	if tpl.Metadata.Version == "" {
+		return deploy.LegacyEnvTemplateVersion, nil
+	}
+	return tpl.Metadata.Version, nil
+}
+
+// ToEnvWithCustomConfig inspects an existing environment cloudformation stack and the template it was deployed with,
+// and constructs an environment struct out of them including the customized resources, such as an imported VPC.
+func (e *EnvStackConfig) ToEnvWithCustomConfig(stack *cloudformation.Stack, templateBody string) (*config.Environment, error) {
+	env, err := e.ToEnv(stack)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := parseEnvTemplate(templateBody)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, output := range stack.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+	_, ipv6 := outputs[EnvOutputIPv6Enabled]
+	env.CustomConfig = config.NewCustomizeEnv(tpl.importVPC(outputs), tpl.adjustVPC(), ipv6, tpl.accessLogs(outputs),
+		splitOutputList(outputs[EnvOutputImportedCertARNs]))
+	return env, nil
+}
+
+// importVPC returns the imported VPC resources, or nil if the VPC is created by the environment.
+func (t *envTemplate) importVPC(outputs map[string]string) *config.ImportVPC {
+	if _, ok := t.Resources[envResourceVPC]; ok {
+		return nil
+	}
+	return &config.ImportVPC{
+		ID:               outputs[EnvOutputVPCID],
+		PublicSubnetIDs:  splitOutputList(outputs[EnvOutputPublicSubnets]),
+		PrivateSubnetIDs: splitOutputList(outputs[EnvOutputPrivateSubnets]),
+		SecurityGroupIDs: splitOutputList(outputs[EnvOutputImportedSecurityGroups]),
+	}
+}
+
+// adjustVPC returns the configuration of the VPC created by the environment,
+// or nil if the VPC is imported or has the default configuration.
+func (t *envTemplate) adjustVPC() *config.AdjustVPC {
+	vpc, ok := t.Resources[envResourceVPC]
+	if !ok {
+		return nil
+	}
+	conf := &config.AdjustVPC{
+		CIDR: vpc.Properties.CidrBlock.Value,
+	}
+	var azs []string
+	explicitAZs := true
+	for i := 1; ; i++ {
+		subnet, ok := t.Resources[fmt.Sprintf("%s%d", envResourcePublicSubnet, i)]
+		if !ok {
+			break
+		}
+		conf.PublicSubnetCIDRs = append(conf.PublicSubnetCIDRs, subnet.Properties.CidrBlock.Value)
+		az := subnet.Properties.AvailabilityZone
+		// Zones that aren't chosen are selected with the "!Select" function instead of a string.
+		if az.Kind != yaml.ScalarNode || az.Tag != "!!str" {
+			explicitAZs = false
+		}
+		azs = append(azs, az.Value)
+	}
+	for i := 1; ; i++ {
+		subnet, ok := t.Resources[fmt.Sprintf("%s%d", envResourcePrivateSubnet, i)]
+		if !ok {
+			break
+		}
+		conf.PrivateSubnetCIDRs = append(conf.PrivateSubnetCIDRs, subnet.Properties.CidrBlock.Value)
+	}
+	if explicitAZs {
+		conf.AZs = azs
+	}
+	isDefault := conf.CIDR == DefaultVPCCIDR &&
+		strings.Join(conf.PublicSubnetCIDRs, ",") == DefaultPublicSubnetCIDRs &&
+		strings.Join(conf.PrivateSubnetCIDRs, ",") == DefaultPrivateSubnetCIDRs &&
+		len(conf.AZs) == 0
+	if isDefault {
+		return nil
+	}
+	return conf
+}
+
+// accessLogs returns the configuration of the load balancer's access logs, or nil if they aren't enabled.
+func (t *envTemplate) accessLogs(outputs map[string]string) *config.AccessLogs {
+	bucket, ok := outputs[EnvOutputAccessLogsBucket]
+	if !ok {
+		return nil
+	}
+	conf := &config.AccessLogs{}
+	if _, created := t.Resources[envResourceAccessLogsBucket]; !created {
+		conf.BucketName = bucket
+	}
+	for _, attr := range t.Resources[envResourceLoadBalancer].Properties.LoadBalancerAttributes {
+		if attr.Key == envLBAttrAccessLogsPrefix {
+			conf.Prefix = attr.Value.Value
+		}
+	}
+	return conf
+}
+
+func splitOutputList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -81,6 +81,26 @@ func TestEnvTemplate(t *testing.T) {
 	}
 }
 
+func TestEnvTemplate_PermissionsBoundary(t *testing.T) {
+	// GIVEN
+	const boundary = "arn:aws:iam::123456789012:policy/Boundary"
+	in := mockDeployEnvironmentInput()
+	in.Version = deploy.LatestEnvTemplateVersion
+	in.PermissionsBoundary = boundary
+	envStack := NewEnvStackConfig(in)
+
+	// WHEN
+	tpl, err := envStack.Template()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]interface{}{
+		"CloudformationExecutionRole": boundary,
+		"EnvironmentManagerRole":      boundary,
+		"CustomResourceRole":          boundary,
+	}, rolesPermissionsBoundary(t, tpl))
+}
+
 func TestEnvParameters(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
@@ -283,6 +303,225 @@ func TestToEnv(t *testing.T) {
 	}
 }
 
+func TestEnvStackConfig_ToEnvWithCustomConfig(t *testing.T) {
+	mockStackARN := "arn:aws:cloudformation:eu-west-3:902697171733:stack/project-env"
+	testCases := map[string]struct {
+		inDeployed    *deploy.CreateEnvironmentInput // Configuration that the stack's template is rendered with.
+		inOutputs     map[string]string
+		inTemplate    string // Overrides the rendered template.
+		wantedConfig  *config.CustomizeEnv
+		wantedErrPref string
+	}{
+		"returns error if the template is invalid": {
+			inTemplate:    "Resources: [",
+			wantedErrPref: "unmarshal environment template: ",
+		},
+		"default configuration": {
+			inDeployed: &deploy.CreateEnvironmentInput{},
+		},
+		"adjusted VPC": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				AdjustVPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.3.0/24", "10.1.4.0/24", "10.1.5.0/24"},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.3.0/24", "10.1.4.0/24", "10.1.5.0/24"},
+				},
+			},
+		},
+		"default CIDRs in chosen availability zones": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				AdjustVPCConfig: &config.AdjustVPC{
+					CIDR:               DefaultVPCCIDR,
+					PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+					AZs:                []string{"eu-west-3b", "eu-west-3c"},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               DefaultVPCCIDR,
+					PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+					AZs:                []string{"eu-west-3b", "eu-west-3c"},
+				},
+			},
+		},
+		"imported VPC with security groups": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				ImportVPCConfig: &config.ImportVPC{
+					ID:               "vpc-1234",
+					PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+					SecurityGroupIDs: []string{"sg-1"},
+				},
+			},
+			inOutputs: map[string]string{
+				EnvOutputVPCID:                  "vpc-1234",
+				EnvOutputPublicSubnets:          "subnet-1,subnet-2",
+				EnvOutputPrivateSubnets:         "subnet-3,subnet-4",
+				EnvOutputImportedSecurityGroups: "sg-1",
+			},
+			wantedConfig: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-1234",
+					PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+					SecurityGroupIDs: []string{"sg-1"},
+				},
+			},
+		},
+		"imported VPC without public subnets": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				ImportVPCConfig: &config.ImportVPC{
+					ID:               "vpc-1234",
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				},
+			},
+			inOutputs: map[string]string{
+				EnvOutputVPCID:          "vpc-1234",
+				EnvOutputPublicSubnets:  "",
+				EnvOutputPrivateSubnets: "subnet-3,subnet-4",
+			},
+			wantedConfig: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-1234",
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				},
+			},
+		},
+		"IPv6 and access logs in the environment's bucket": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				EnableIPv6: true,
+				AccessLogsConfig: &config.AccessLogs{
+					Prefix: "prod",
+				},
+			},
+			inOutputs: map[string]string{
+				EnvOutputIPv6Enabled:      "true",
+				EnvOutputAccessLogsBucket: "project-env-elbaccesslogsbucket-1a2b3c",
+			},
+			wantedConfig: &config.CustomizeEnv{
+				EnableIPv6: true,
+				AccessLogs: &config.AccessLogs{
+					Prefix: "prod",
+				},
+			},
+		},
+		"access logs in an existing bucket": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				AccessLogsConfig: &config.AccessLogs{
+					BucketName: "my-audit-logs",
+				},
+			},
+			inOutputs: map[string]string{
+				EnvOutputAccessLogsBucket: "my-audit-logs",
+			},
+			wantedConfig: &config.CustomizeEnv{
+				AccessLogs: &config.AccessLogs{
+					BucketName: "my-audit-logs",
+				},
+			},
+		},
+		"imported certificates": {
+			inDeployed: &deploy.CreateEnvironmentInput{
+				ImportCertARNs: []string{
+					"arn:aws:acm:eu-west-3:902697171733:certificate/cert-1",
+					"arn:aws:acm:eu-west-3:902697171733:certificate/cert-2",
+				},
+			},
+			inOutputs: map[string]string{
+				EnvOutputImportedCertARNs: "arn:aws:acm:eu-west-3:902697171733:certificate/cert-1,arn:aws:acm:eu-west-3:902697171733:certificate/cert-2",
+			},
+			wantedConfig: &config.CustomizeEnv{
+				ImportCertARNs: []string{
+					"arn:aws:acm:eu-west-3:902697171733:certificate/cert-1",
+					"arn:aws:acm:eu-west-3:902697171733:certificate/cert-2",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			body := tc.inTemplate
+			if tc.inDeployed != nil {
+				tc.inDeployed.AppName = "project"
+				tc.inDeployed.Name = "env"
+				tc.inDeployed.Version = deploy.LatestEnvTemplateVersion
+				tpl, err := NewEnvStackConfig(tc.inDeployed).Template()
+				require.NoError(t, err)
+				body = tpl
+			}
+			mockStack := mockEnvironmentStack(mockStackARN, "arn:aws:iam::902697171733:role/project-env-EnvManagerRole", "arn:aws:iam::902697171733:role/project-env-CFNExecutionRole")
+			for key, value := range tc.inOutputs {
+				mockStack.Outputs = append(mockStack.Outputs, &cloudformation.Output{
+					OutputKey:   aws.String(key),
+					OutputValue: aws.String(value),
+				})
+			}
+			conf := NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+				AppName: "project",
+				Name:    "env",
+			})
+
+			// WHEN
+			env, err := conf.ToEnvWithCustomConfig(mockStack, body)
+
+			// THEN
+			if tc.wantedErrPref != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), tc.wantedErrPref), "got error %q", err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "eu-west-3", env.Region)
+			require.Equal(t, "902697171733", env.AccountID)
+			require.Equal(t, "arn:aws:iam::902697171733:role/project-env-EnvManagerRole", env.ManagerRoleARN)
+			require.Equal(t, "arn:aws:iam::902697171733:role/project-env-CFNExecutionRole", env.ExecutionRoleARN)
+			require.Equal(t, tc.wantedConfig, env.CustomConfig)
+		})
+	}
+}
+
+func TestEnvTemplateVersion(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wanted string
+	}{
+		"legacy template without a version": {
+			inTemplate: `Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+`,
+			wanted: deploy.LegacyEnvTemplateVersion,
+		},
+		"versioned template": {
+			inTemplate: `Metadata:
+  Version: 'v1.1.0'
+`,
+			wanted: "v1.1.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := EnvTemplateVersion(tc.inTemplate)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func mockEnvironmentStack(stackArn, managerRoleARN, executionRoleARN string) *cloudformation.Stack {
 	return &cloudformation.Stack{
 		StackId: aws.String(stackArn),
@@ -6,11 +6,14 @@ package stack
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
@@ -19,18 +22,29 @@ const (
 	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
 	desiredCountGeneratorPath         = "custom-resources/desired-count-delegation.js"
 	envControllerPath                 = "custom-resources/env-controller.js"
+	customDomainPath                  = "custom-resources/custom-domain.js"
 )
 
 // Parameter logical IDs for a load balanced web service.
 const (
-	LBWebServiceHTTPSParamKey           = "HTTPSEnabled"
-	LBWebServiceContainerPortParamKey   = "ContainerPort"
-	LBWebServiceRulePathParamKey        = "RulePath"
-	LBWebServiceTargetContainerParamKey = "TargetContainer"
-	LBWebServiceTargetPortParamKey      = "TargetPort"
-	LBWebServiceStickinessParamKey      = "Stickiness"
+	LBWebServiceHTTPSParamKey               = "HTTPSEnabled"
+	LBWebServiceContainerPortParamKey       = "ContainerPort"
+	LBWebServiceRulePathParamKey            = "RulePath"
+	LBWebServiceTargetContainerParamKey     = "TargetContainer"
+	LBWebServiceTargetPortParamKey          = "TargetPort"
+	LBWebServiceStickinessParamKey          = "Stickiness"
+	LBWebServiceAdditionalRulePathsParamKey = "AdditionalRulePaths"
 )
 
+// Output logical IDs for a load balanced web service.
+const (
+	LBWebServiceURLOutputKey     = "ServiceURL"
+	LBWebServiceAliasesOutputKey = "Aliases" // Comma-separated custom domain names of the service.
+)
+
+// lbWebSvcLBDNSEnvVar is the environment variable set to the DNS name of the load balancer in the main container.
+const lbWebSvcLBDNSEnvVar = "COPILOT_LB_DNS"
+
 type loadBalancedWebSvcReadParser interface {
 	template.ReadParser
 	ParseLoadBalancedWebService(template.WorkloadOpts) (*template.Content, error)
@@ -41,6 +55,8 @@ type LoadBalancedWebService struct {
 	*wkld
 	manifest     *manifest.LoadBalancedWebService
 	httpsEnabled bool
+	appDNSName   string // Domain name of the application, only set if the environment has an HTTPS listener.
+	appAccountID string
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -52,20 +68,26 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	patches, err := override.New(aws.StringValue(mft.Name))
+	if err != nil {
+		return nil, fmt.Errorf("new patches: %w", err)
+	}
 	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %s", env, err)
 	}
 	return &LoadBalancedWebService{
 		wkld: &wkld{
-			name:   aws.StringValue(mft.Name),
-			env:    env,
-			app:    app,
-			tc:     envManifest.TaskConfig,
-			rc:     rc,
-			image:  envManifest.ImageConfig,
-			parser: parser,
-			addons: addons,
+			name:    aws.StringValue(mft.Name),
+			env:     env,
+			app:     app,
+			tc:      envManifest.TaskConfig,
+			rc:      rc,
+			image:   envManifest.ImageConfig,
+			logging: envManifest.Logging,
+			parser:  parser,
+			addons:  addons,
+			patches: patches,
 		},
 		manifest:     envManifest,
 		httpsEnabled: false,
@@ -77,12 +99,14 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 // NewHTTPSLoadBalancedWebService  creates a new LoadBalancedWebService stack from its manifest that needs to be deployed to
 // a environment within an application. It creates an HTTPS listener and assumes that the environment
 // it's being deployed into has an HTTPS configured listener.
-func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig) (*LoadBalancedWebService, error) {
-	webSvc, err := NewLoadBalancedWebService(mft, env, app, rc)
+func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env string, app deploy.AppInformation, rc RuntimeConfig) (*LoadBalancedWebService, error) {
+	webSvc, err := NewLoadBalancedWebService(mft, env, app.Name, rc)
 	if err != nil {
 		return nil, err
 	}
 	webSvc.httpsEnabled = true
+	webSvc.appDNSName = app.DNSName
+	webSvc.appAccountID = app.AccountID
 	return webSvc, nil
 }
 
@@ -104,31 +128,170 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := s.manifest.Sidecar.Options()
+	if err := manifest.ValidateContainerResources(s.name, s.manifest.TaskConfig, s.manifest.ImageConfig.ContainerResources, s.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the container resources for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateRoutingRules(s.manifest.ImageConfig, s.manifest.RoutingRule); err != nil {
+		return "", fmt.Errorf("validate the routing rules for service %s: %w", s.name, err)
+	}
+	if err := s.validateAliases(); err != nil {
+		return "", fmt.Errorf("validate the aliases for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateHealthCheckDelays(s.manifest.RoutingRule); err != nil {
+		return "", fmt.Errorf("validate the health check for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateLogging(s.manifest.Logging); err != nil {
+		return "", fmt.Errorf("validate the logging configuration for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidatePlatform(s.manifest.Platform); err != nil {
+		return "", fmt.Errorf("validate the platform for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateMessaging(s.manifest.Messaging); err != nil {
+		return "", fmt.Errorf("validate the messaging configuration for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateObservability(s.manifest.Observability, s.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the observability configuration for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateDockerLabels(s.manifest.ImageConfig.DockerLabels, s.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the docker labels for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateContainerDependencies(s.name, s.manifest.ImageConfig.DependsOn, false, s.manifest.Sidecar, s.manifest.Logging, s.manifest.Observability); err != nil {
+		return "", fmt.Errorf("validate the container dependencies for service %s: %w", s.name, err)
+	}
+	if err := manifest.ValidateServiceTags(s.manifest.ServiceTags); err != nil {
+		return "", fmt.Errorf("validate the service tags for service %s: %w", s.name, err)
+	}
+	copilotVars := append(messagingEnvVars(s.manifest.Messaging), observabilityEnvVars(s.manifest.Observability)...)
+	if err := validateEnvVarNames(s.manifest.TaskConfig, outputs, append(copilotVars, lbWebSvcLBDNSEnvVar)...); err != nil {
+		return "", fmt.Errorf("validate the environment variables for service %s: %w", s.name, err)
+	}
+	variables, err := s.variablesOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the variables for service %s: %w", s.name, err)
+	}
+	httpVersion, err := s.manifest.ProtocolVersionOpts()
+	if err != nil {
+		return "", fmt.Errorf("validate the protocol version for service %s: %w", s.name, err)
+	}
+	if s.manifest.IsGRPC() && !s.httpsEnabled {
+		return "", fmt.Errorf("service %s uses gRPC which requires an HTTPS listener but environment %s doesn't have one", s.name, s.env)
+	}
+	sidecars, err := s.sidecarOpts(s.manifest.Sidecar)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	observability, xraySidecar := observabilityOpts(s.manifest.Observability)
+	if xraySidecar != nil {
+		sidecars = append(sidecars, xraySidecar)
+	}
 	autoscaling, err := s.manifest.Count.Autoscaling.Options()
 	if err != nil {
 		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
 	}
+	capacityProviders, err := s.manifest.Count.CapacityProviderStrategy()
+	if err != nil {
+		return "", fmt.Errorf("convert the Fargate Spot configuration for service %s: %w", s.name, err)
+	}
+	deploymentConfig, err := s.manifest.Deployment.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	alarms, err := s.manifest.Alarms.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the alarms for service %s: %w", s.name, err)
+	}
+	serviceConnect, err := s.serviceConnectOpts(s.manifest.Network, s.manifest.ImageConfig.Port)
+	if err != nil {
+		return "", fmt.Errorf("convert the Service Connect configuration for service %s: %w", s.name, err)
+	}
+	var aliases *template.AliasesOpts
+	var acmValidationLambda, customDomainLambda string
+	if len(s.manifest.Alias) > 0 && s.importedCerts() {
+		// The records of the aliases are managed outside of Copilot and the environment's certificates cover them.
+		aliases = &template.AliasesOpts{
+			Names: s.manifest.Alias,
+		}
+	} else if len(s.manifest.Alias) > 0 {
+		aliases = &template.AliasesOpts{
+			Names:                s.manifest.Alias,
+			AppDNSName:           s.appDNSName,
+			AppDNSDelegationRole: fmt.Sprintf("arn:aws:iam::%s:role/%s", s.appAccountID, dnsDelegationRoleName(s.app)),
+		}
+		lambda, err := s.parser.Read(acmValidationTemplatePath)
+		if err != nil {
+			return "", fmt.Errorf("read ACM validation lambda: %w", err)
+		}
+		acmValidationLambda = lambda.String()
+		if lambda, err = s.parser.Read(customDomainPath); err != nil {
+			return "", fmt.Errorf("read custom domain lambda: %w", err)
+		}
+		customDomainLambda = lambda.String()
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
-		Variables:           s.manifest.Variables,
+		Variables:           variables,
 		Secrets:             s.manifest.Secrets,
 		NestedStack:         outputs,
 		Sidecars:            sidecars,
+		ContainerResources:  s.manifest.ImageConfig.ContainerResources.Options(),
+		DockerLabels:        s.manifest.ImageConfig.DockerLabels,
+		DependsOn:           manifest.ContainerDependencyOpts(s.manifest.ImageConfig.DependsOn),
+		ServiceTags:         s.manifest.ServiceTags,
+		RuntimePlatform:     s.manifest.RuntimePlatformOpts(),
 		LogConfig:           s.manifest.LogConfigOpts(),
+		LogGroupName:        s.manifest.Logging.LogGroupName(),
+		SecurityGroups:      s.rc.SecurityGroups,
+		CrossAccountRepoARN: crossAccountRepoARN(s.rc.Image, s.rc.AccountID),
+		PermissionsBoundary: s.rc.PermissionsBoundary,
 		Autoscaling:         autoscaling,
+		CapacityProviders:   capacityProviders,
+		DeploymentConfig:    deploymentConfig,
+		Alarms:              alarms,
+		ServiceConnect:      serviceConnect,
+		Messaging:           s.messagingOpts(s.manifest.Messaging),
+		Observability:       observability,
 		HTTPHealthCheck:     s.manifest.HealthCheck.HTTPHealthCheckOpts(),
+		HTTPVersion:         httpVersion,
+		EnableIPv6:          s.rc.EnableIPv6,
+		EnableExec:          aws.BoolValue(s.manifest.Exec),
 		AllowedSourceIps:    s.manifest.AllowedSourceIps,
+		DeregistrationDelay: s.manifest.DeregistrationDelaySeconds(),
+		AdditionalPorts:     s.manifest.ImageConfig.AdditionalPorts,
+		AdditionalRules:     s.manifest.AdditionalRoutingRuleOpts(aws.Uint16Value(s.manifest.ImageConfig.Port)),
+		Aliases:             aliases,
+		HTTPSImportedCerts:  s.importedCerts(),
 		RulePriorityLambda:  rulePriorityLambda.String(),
 		DesiredCountLambda:  desiredCountLambda.String(),
 		EnvControllerLambda: envControllerLambda.String(),
+		ACMValidationLambda: acmValidationLambda,
+		CustomDomainLambda:  customDomainLambda,
 	})
 	if err != nil {
 		return "", err
 	}
-	return content.String(), nil
+	return s.applyPatches(content.String())
+}
+
+// validateAliases returns an error if the aliases can't be routed to the service. If the environment imported its
+// certificates, whether they cover the aliases is validated against ACM before the stack is created.
+func (s *LoadBalancedWebService) validateAliases() error {
+	if s.importedCerts() {
+		return manifest.ValidateImportedCertAliases(s.manifest.RoutingRule)
+	}
+	return manifest.ValidateAliases(s.manifest.RoutingRule, s.appDomain())
+}
+
+// importedCerts returns true if the HTTPS listener of the environment uses certificates imported with the environment.
+func (s *LoadBalancedWebService) importedCerts() bool {
+	return s.httpsEnabled && len(s.rc.ImportedCertARNs) != 0
+}
+
+// appDomain returns the domain name of the application's hosted zone, such as "my-app.example.com",
+// or an empty string if the environment doesn't have an HTTPS listener.
+func (s *LoadBalancedWebService) appDomain() string {
+	if !s.httpsEnabled || s.appDNSName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s", s.app, s.appDNSName)
 }
 
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
@@ -188,6 +351,10 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
 			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(s.manifest.Stickiness))),
 		},
+		{
+			ParameterKey:   aws.String(LBWebServiceAdditionalRulePathsParamKey),
+			ParameterValue: aws.String(strings.Join(s.manifest.AdditionalRulePaths(), ",")),
+		},
 	}...), nil
 }
 
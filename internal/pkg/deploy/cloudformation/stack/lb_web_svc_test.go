@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -48,6 +49,18 @@ func (m mockTemplater) Template() (string, error) {
 	return m.tpl, nil
 }
 
+type mockTemplatePatcher struct {
+	patched string
+	err     error
+}
+
+func (m mockTemplatePatcher) Apply(tpl string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return m.patched, nil
+}
+
 func TestLoadBalancedWebService_StackName(t *testing.T) {
 	testCases := map[string]struct {
 		inSvcName string
@@ -182,6 +195,337 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 
 			wantedTemplate: "template",
 		},
+		"render template for an environment with IPv6 enabled": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+					},
+					EnableIPv6:          true,
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				c.parser = m
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+				c.wkld.rc.EnableIPv6 = true
+			},
+
+			wantedTemplate: "template",
+		},
+		"failed validating the routing rules": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).Times(0)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.ImageConfig.AdditionalPorts = []uint16{80}
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("validate the routing rules for service frontend: port 80 is exposed more than once"),
+		},
+		"render template with additional routing rules": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+					},
+					AdditionalPorts: []uint16{9090},
+					AdditionalRules: []template.AdditionalRoutingRuleOpts{
+						{
+							TargetPort: 9090,
+							HTTPHealthCheck: template.HTTPHealthCheckOpts{
+								HealthCheckPath: "/metrics",
+							},
+						},
+					},
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.ImageConfig.AdditionalPorts = []uint16{9090}
+				mft.AdditionalRules = []manifest.AdditionalRoutingRule{
+					{
+						Path:       aws.String("/frontend/metrics"),
+						TargetPort: aws.Uint16(9090),
+						HealthCheck: manifest.HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/metrics"),
+						},
+					},
+				}
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"failed validating the health check delays": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).Times(0)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				delay := 2 * time.Hour
+				mft.DeregistrationDelay = &delay
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("validate the health check for service frontend: http.deregistration_delay 2h0m0s must be between 0s and 3600s"),
+		},
+		"render template with health check delays": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+						GracePeriod:     aws.Int64(90),
+					},
+					DeregistrationDelay: aws.Int64(30),
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				gracePeriod, delay := 90*time.Second, 30*time.Second
+				mft.HealthCheck = manifest.HealthCheckArgsOrString{
+					HealthCheckArgs: manifest.HTTPHealthCheckArgs{
+						GracePeriod: &gracePeriod,
+					},
+				}
+				mft.DeregistrationDelay = &delay
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"failed validating the protocol version": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).Times(0)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.ProtocolVersion = aws.String("http3")
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("validate the protocol version for service frontend: http.version http3 must be one of grpc, http2 or http1"),
+		},
+		"failed rendering a gRPC service without HTTPS": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).Times(0)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.ProtocolVersion = aws.String("grpc")
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("service frontend uses gRPC which requires an HTTPS listener but environment test doesn't have one"),
+		},
+		"render template for a gRPC service": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/grpc.health.v1.Health/Check",
+						SuccessCodes:    aws.String("0-99"),
+					},
+					HTTPVersion:         "GRPC",
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.ProtocolVersion = aws.String("grpc")
+				mft.HealthCheck = manifest.HealthCheckArgsOrString{
+					HealthCheckArgs: manifest.HTTPHealthCheckArgs{
+						Path:         aws.String("/grpc.health.v1.Health/Check"),
+						SuccessCodes: aws.String("0-99"),
+					},
+				}
+				c.parser = m
+				c.manifest = mft
+				c.httpsEnabled = true
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"returns error if aliases are used without an HTTPS listener": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.Alias = manifest.Alias{"api.phonetool.example.com"}
+				c.parser = m
+				c.manifest = mft
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("validate the aliases for service frontend: http.alias requires an application with a domain name, create one with `copilot app init --domain`"),
+		},
+		"render template with aliases": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(acmValidationTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("acm")}, nil)
+				m.EXPECT().Read(customDomainPath).Return(&template.Content{Buffer: bytes.NewBufferString("domain")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+					},
+					Aliases: &template.AliasesOpts{
+						Names:                []string{"api.phonetool.example.com", "api.test.phonetool.example.com"},
+						AppDNSName:           "example.com",
+						AppDNSDelegationRole: "arn:aws:iam::123456789012:role/phonetool-DNSDelegationRole",
+					},
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+					ACMValidationLambda: "acm",
+					CustomDomainLambda:  "domain",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.Alias = manifest.Alias{"api.phonetool.example.com", "api.test.phonetool.example.com"}
+				c.parser = m
+				c.manifest = mft
+				c.httpsEnabled = true
+				c.appDNSName = "example.com"
+				c.appAccountID = "123456789012"
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with aliases covered by imported certificates": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+					},
+					Aliases: &template.AliasesOpts{
+						Names: []string{"api.example.com"},
+					},
+					HTTPSImportedCerts:  true,
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "frontend",
+						Dockerfile: "frontend/Dockerfile",
+					},
+					Path: "frontend",
+					Port: 80,
+				})
+				mft.Alias = manifest.Alias{"api.example.com"}
+				c.parser = m
+				c.manifest = mft
+				c.httpsEnabled = true
+				c.rc.ImportedCertARNs = []string{"arn:aws:acm:us-west-2:123456789012:certificate/cert"}
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
@@ -190,10 +534,11 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
 					NestedStack: &template.WorkloadNestedStackOpts{
-						StackName:       addon.StackName,
-						VariableOutputs: []string{"Hello"},
-						SecretOutputs:   []string{"MySecretArn"},
-						PolicyOutputs:   []string{"AdditionalResourcesPolicyArn"},
+						StackName:            addon.StackName,
+						VariableOutputs:      []string{"Hello"},
+						SecretOutputs:        []string{"MySecretArn"},
+						PolicyOutputs:        []string{"AdditionalResourcesPolicyArn"},
+						SecurityGroupOutputs: []string{"DBClientSecurityGroupId"},
 					},
 					HTTPHealthCheck: template.HTTPHealthCheckOpts{
 						HealthCheckPath: "/",
@@ -221,11 +566,20 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
         GenerateStringKey: 'password'
         PasswordLength: 16
         ExcludeCharacters: '"@/\'
+  DBClientSecurityGroup:
+    Type: AWS::EC2::SecurityGroup
+    Metadata:
+      copilot:
+        type: security-group
+    Properties:
+      GroupDescription: 'Clients of my rds instance'
 Outputs:
   AdditionalResourcesPolicyArn:
     Value: !Ref AdditionalResourcesPolicy
   MySecretArn:
     Value: !Ref MySecret
+  DBClientSecurityGroupId:
+    Value: !GetAtt DBClientSecurityGroup.GroupId
   Hello:
     Value: hello`,
 				}
@@ -327,6 +681,15 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		Port: 80,
 	})
 	testLBWebServiceManifestWithBadSidecar.TargetContainer = aws.String("xray")
+	testLBWebServiceManifestWithGRPC := manifest.NewLoadBalancedWebService(baseProps)
+	testLBWebServiceManifestWithGRPCRange := manifest.Range("2-100")
+	testLBWebServiceManifestWithGRPC.Count = manifest.Count{
+		Value: aws.Int(1),
+		Autoscaling: manifest.Autoscaling{
+			Range: &testLBWebServiceManifestWithGRPCRange,
+		},
+	}
+	testLBWebServiceManifestWithGRPC.ProtocolVersion = aws.String("grpc")
 	expectedParams := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadAppNameParamKey),
@@ -372,6 +735,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 			ParameterKey:   aws.String(WorkloadAddonsTemplateURLParamKey),
 			ParameterValue: aws.String(""),
 		},
+		{
+			ParameterKey:   aws.String(LBWebServiceAdditionalRulePathsParamKey),
+			ParameterValue: aws.String(""),
+		},
 	}
 	testCases := map[string]struct {
 		httpsEnabled bool
@@ -472,6 +839,29 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"with gRPC": {
+			httpsEnabled: true,
+			manifest:     testLBWebServiceManifestWithGRPC,
+
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+					ParameterValue: aws.String("false"),
+				},
+			}...),
+		},
 		"with bad sidecar container": {
 			httpsEnabled: true,
 			manifest:     testLBWebServiceManifestWithBadSidecar,
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/robfig/cron/v3"
 )
@@ -24,6 +26,11 @@ const (
 	ScheduledJobScheduleParamKey = "Schedule"
 )
 
+// Output logical IDs for a scheduled job.
+const (
+	ScheduledJobStateMachineARNOutputKey = "StateMachineArn"
+)
+
 type scheduledJobParser interface {
 	ParseScheduledJob(template.WorkloadOpts) (*template.Content, error)
 }
@@ -94,20 +101,26 @@ func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConf
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	patches, err := override.New(aws.StringValue(mft.Name))
+	if err != nil {
+		return nil, fmt.Errorf("new patches: %w", err)
+	}
 	envManifest, err := mft.ApplyEnv(env)
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %w", env, err)
 	}
 	return &ScheduledJob{
 		wkld: &wkld{
-			name:   aws.StringValue(mft.Name),
-			env:    env,
-			app:    app,
-			tc:     envManifest.ScheduledJobConfig.TaskConfig,
-			rc:     rc,
-			image:  envManifest.ImageConfig,
-			parser: parser,
-			addons: addons,
+			name:    aws.StringValue(mft.Name),
+			env:     env,
+			app:     app,
+			tc:      envManifest.ScheduledJobConfig.TaskConfig,
+			rc:      rc,
+			image:   envManifest.ImageConfig,
+			logging: envManifest.Logging,
+			parser:  parser,
+			addons:  addons,
+			patches: patches,
 		},
 		manifest: envManifest,
 
@@ -122,14 +135,50 @@ func (j *ScheduledJob) Template() (string, error) {
 		return "", err
 	}
 
-	sidecars, err := j.manifest.Sidecar.Options()
+	if err := manifest.ValidateContainerResources(j.name, j.manifest.TaskConfig, j.manifest.ImageConfig.ContainerResources, j.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the container resources for job %s: %w", j.name, err)
+	}
+	if err := manifest.ValidateLogging(j.manifest.Logging); err != nil {
+		return "", fmt.Errorf("validate the logging configuration for job %s: %w", j.name, err)
+	}
+	if err := manifest.ValidatePlatform(j.manifest.Platform); err != nil {
+		return "", fmt.Errorf("validate the platform for job %s: %w", j.name, err)
+	}
+	if err := manifest.ValidateMessaging(j.manifest.Messaging); err != nil {
+		return "", fmt.Errorf("validate the messaging configuration for job %s: %w", j.name, err)
+	}
+	if err := manifest.ValidateDockerLabels(j.manifest.ImageConfig.DockerLabels, j.manifest.Sidecar); err != nil {
+		return "", fmt.Errorf("validate the docker labels for job %s: %w", j.name, err)
+	}
+	if err := manifest.ValidateContainerDependencies(j.name, j.manifest.ImageConfig.DependsOn, false, j.manifest.Sidecar, j.manifest.Logging, manifest.Observability{}); err != nil {
+		return "", fmt.Errorf("validate the container dependencies for job %s: %w", j.name, err)
+	}
+	if err := validateEnvVarNames(j.manifest.TaskConfig, outputs, messagingEnvVars(j.manifest.Messaging)...); err != nil {
+		return "", fmt.Errorf("validate the environment variables for job %s: %w", j.name, err)
+	}
+	variables, err := j.variablesOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the variables for job %s: %w", j.name, err)
+	}
+	if j.manifest.Count.Spot != nil || j.manifest.Count.CapacityProviders != nil {
+		return "", fmt.Errorf("validate the task count for job %s: Fargate Spot is not supported for scheduled jobs", j.name)
+	}
+	sidecars, err := j.sidecarOpts(j.manifest.Sidecar)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
 	}
 
-	schedule, err := j.awsSchedule()
-	if err != nil {
-		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+	var schedule, eventPattern string
+	if j.manifest.On.Event != nil {
+		eventPattern, err = j.eventPattern()
+		if err != nil {
+			return "", fmt.Errorf("convert event pattern for job %s: %w", j.name, err)
+		}
+	} else {
+		schedule, err = j.awsSchedule()
+		if err != nil {
+			return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+		}
 	}
 
 	stateMachine, err := j.stateMachineOpts()
@@ -138,18 +187,28 @@ func (j *ScheduledJob) Template() (string, error) {
 	}
 
 	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
-		Variables:          j.manifest.Variables,
-		Secrets:            j.manifest.Secrets,
-		NestedStack:        outputs,
-		Sidecars:           sidecars,
-		ScheduleExpression: schedule,
-		StateMachine:       stateMachine,
-		LogConfig:          j.manifest.LogConfigOpts(),
+		Variables:           variables,
+		Secrets:             j.manifest.Secrets,
+		NestedStack:         outputs,
+		Sidecars:            sidecars,
+		ContainerResources:  j.manifest.ImageConfig.ContainerResources.Options(),
+		DockerLabels:        j.manifest.ImageConfig.DockerLabels,
+		DependsOn:           manifest.ContainerDependencyOpts(j.manifest.ImageConfig.DependsOn),
+		RuntimePlatform:     j.manifest.RuntimePlatformOpts(),
+		ScheduleExpression:  schedule,
+		EventPattern:        eventPattern,
+		StateMachine:        stateMachine,
+		Messaging:           j.messagingOpts(j.manifest.Messaging),
+		LogConfig:           j.manifest.LogConfigOpts(),
+		LogGroupName:        j.manifest.Logging.LogGroupName(),
+		CrossAccountRepoARN: crossAccountRepoARN(j.rc.Image, j.rc.AccountID),
+		PermissionsBoundary: j.rc.PermissionsBoundary,
+		ScheduleDisabled:    j.rc.ScheduleDisabled,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse scheduled job template: %w", err)
 	}
-	return content.String(), nil
+	return j.applyPatches(content.String())
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -158,6 +217,10 @@ func (j *ScheduledJob) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
+	if j.manifest.On.Event != nil {
+		// Jobs triggered by events don't have a schedule.
+		return wkldParams, nil
+	}
 	schedule, err := j.awsSchedule()
 	if err != nil {
 		return nil, err
@@ -186,32 +249,33 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
 // validated server-side by CloudFormation.
 func (j *ScheduledJob) awsSchedule() (string, error) {
-	if j.manifest.On.Schedule == "" {
+	schedule := aws.StringValue(j.manifest.On.Schedule)
+	if schedule == "" {
 		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
 	}
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
-	if match := awsScheduleRegexp.FindStringSubmatch(j.manifest.On.Schedule); match != nil {
-		return j.manifest.On.Schedule, nil
+	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
-	if _, err := cron.ParseStandard(j.manifest.On.Schedule); err != nil {
+	if _, err := cron.ParseStandard(schedule); err != nil {
 		return "", errScheduleInvalid{reason: err}
 	}
 	var scheduleExpression string
 	var err error
 	switch {
-	case strings.HasPrefix(j.manifest.On.Schedule, every):
-		scheduleExpression, err = toRate(j.manifest.On.Schedule[len(every):])
+	case strings.HasPrefix(schedule, every):
+		scheduleExpression, err = toRate(schedule[len(every):])
 		if err != nil {
 			return "", fmt.Errorf("parse fixed interval: %w", err)
 		}
-	case strings.HasPrefix(j.manifest.On.Schedule, "@"):
-		scheduleExpression, err = toFixedSchedule(j.manifest.On.Schedule)
+	case strings.HasPrefix(schedule, "@"):
+		scheduleExpression, err = toFixedSchedule(schedule)
 		if err != nil {
 			return "", fmt.Errorf("parse preset schedule: %w", err)
 		}
 	default:
-		scheduleExpression, err = toAWSCron(j.manifest.On.Schedule)
+		scheduleExpression, err = toAWSCron(schedule)
 		if err != nil {
 			return "", fmt.Errorf("parse cron schedule: %w", err)
 		}
@@ -219,6 +283,30 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 	return scheduleExpression, nil
 }
 
+// eventPattern converts the event that triggers the job to an EventBridge event pattern in JSON.
+// The sources and detail types of the event are added to the fields of its pattern.
+func (j *ScheduledJob) eventPattern() (string, error) {
+	event := j.manifest.On.Event
+	pattern := make(map[string]interface{}, len(event.Pattern)+2)
+	for field, value := range event.Pattern {
+		pattern[field] = value
+	}
+	if len(event.Source) != 0 {
+		pattern["source"] = event.Source
+	}
+	if len(event.DetailType) != 0 {
+		pattern["detail-type"] = event.DetailType
+	}
+	if len(pattern) == 0 {
+		return "", errors.New(`"on.event" must specify at least one of "source", "detail_type" or "pattern"`)
+	}
+	out, err := json.Marshal(pattern)
+	if err != nil {
+		return "", fmt.Errorf("marshal event pattern: %w", err)
+	}
+	return string(out), nil
+}
+
 // toRate converts a cron "@every" directive to a rate expression defined in minutes.
 // example input: @every 1h30m
 //        output: rate(90 minutes)
@@ -340,8 +428,8 @@ func toAWSCron(schedule string) (string, error) {
 // It also performs basic validations to provide a fast feedback loop to the customer.
 func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 	var timeoutSeconds *int
-	if j.manifest.Timeout != "" {
-		parsedTimeout, err := time.ParseDuration(j.manifest.Timeout)
+	if timeout := aws.StringValue(j.manifest.Timeout); timeout != "" {
+		parsedTimeout, err := time.ParseDuration(timeout)
 		if err != nil {
 			return nil, errDurationInvalid{reason: err}
 		}
@@ -355,11 +443,11 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 	}
 
 	var retries *int
-	if j.manifest.Retries != 0 {
-		if j.manifest.Retries < 0 {
+	if n := aws.IntValue(j.manifest.Retries); n != 0 {
+		if n < 0 {
 			return nil, errors.New("number of retries cannot be negative")
 		}
-		retries = aws.Int(j.manifest.Retries)
+		retries = aws.Int(n)
 	}
 	return &template.StateMachineOpts{
 		Timeout: timeoutSeconds,
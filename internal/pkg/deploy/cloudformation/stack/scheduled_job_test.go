@@ -108,6 +108,24 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"render template with the schedule disabled": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
+					ScheduleExpression: "cron(0 0 * * ? *)",
+					StateMachine: &template.StateMachineOpts{
+						Timeout: aws.Int(5400),
+						Retries: aws.Int(3),
+					},
+					ScheduleDisabled: true,
+				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				addons := mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+				j.parser = m
+				j.wkld.addons = addons
+				j.rc.ScheduleDisabled = true
+			},
+			wantedTemplate: "template",
+		},
 		"error parsing addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				m := mocks.NewMockscheduledJobParser(ctrl)
@@ -163,6 +181,42 @@ Outputs:
 	}
 }
 
+func TestScheduledJob_TemplatePermissionsBoundary(t *testing.T) {
+	// GIVEN
+	const boundary = "arn:aws:iam::123456789012:policy/Boundary"
+	parser := template.New()
+	job := &ScheduledJob{
+		wkld: &wkld{
+			name: aws.StringValue(testScheduledJobManifest.Name),
+			env:  testJobEnvName,
+			app:  testJobAppName,
+			rc: RuntimeConfig{
+				Image: &ECRImage{
+					ImageTag: testJobImageTag,
+					RepoURL:  testJobImageRepoURL,
+				},
+				PermissionsBoundary: boundary,
+			},
+			parser: parser,
+			addons: mockTemplater{err: &addon.ErrAddonsDirNotExist{}},
+		},
+		manifest: testScheduledJobManifest,
+		parser:   parser,
+	}
+
+	// WHEN
+	tpl, err := job.Template()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]interface{}{
+		"ExecutionRole":    boundary,
+		"TaskRole":         boundary,
+		"RuleRole":         boundary,
+		"StateMachineRole": boundary,
+	}, rolesPermissionsBoundary(t, tpl))
+}
+
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule   string
@@ -285,7 +339,7 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						On: manifest.JobTriggerConfig{
-							Schedule: tc.inputSchedule,
+							Schedule: aws.String(tc.inputSchedule),
 						},
 					},
 				},
@@ -308,6 +362,69 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduledJob_eventPattern(t *testing.T) {
+	testCases := map[string]struct {
+		inputEvent manifest.JobEventTrigger
+
+		wantedPattern string
+		wantedError   error
+	}{
+		"error if the event has no fields": {
+			wantedError: errors.New(`"on.event" must specify at least one of "source", "detail_type" or "pattern"`),
+		},
+		"sources and detail types only": {
+			inputEvent: manifest.JobEventTrigger{
+				Source:     []string{"aws.s3"},
+				DetailType: []string{"Object Created"},
+			},
+			wantedPattern: `{"detail-type":["Object Created"],"source":["aws.s3"]}`,
+		},
+		"sources override the source field of the pattern": {
+			inputEvent: manifest.JobEventTrigger{
+				Source: []string{"aws.s3"},
+				Pattern: manifest.EventPattern{
+					"source": []interface{}{"aws.ec2"},
+					"detail": map[string]interface{}{
+						"bucket": map[string]interface{}{
+							"name": []interface{}{"uploads"},
+						},
+					},
+				},
+			},
+			wantedPattern: `{"detail":{"bucket":{"name":["uploads"]}},"source":["aws.s3"]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			event := tc.inputEvent
+			job := &ScheduledJob{
+				wkld: &wkld{
+					name: "mailer",
+				},
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: manifest.JobTriggerConfig{
+							Event: &event,
+						},
+					},
+				},
+			}
+
+			// WHEN
+			pattern, err := job.eventPattern()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPattern, pattern)
+			}
+		})
+	}
+}
+
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
 		inputTimeout    string
@@ -365,8 +482,8 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
-							Retries: tc.inputRetries,
-							Timeout: tc.inputTimeout,
+							Retries: aws.Int(tc.inputRetries),
+							Timeout: aws.String(tc.inputTimeout),
 						},
 					},
 				},
@@ -400,6 +517,13 @@ func TestScheduledJob_Parameters(t *testing.T) {
 	testScheduledJobManifest.Count = manifest.Count{
 		Value: aws.Int(1),
 	}
+	eventJobManifest := manifest.NewScheduledJob(&manifest.ScheduledJobProps{
+		WorkloadProps: baseProps.WorkloadProps,
+		EventPattern:  manifest.EventPattern{"source": []interface{}{"aws.s3"}},
+	})
+	eventJobManifest.Count = manifest.Count{
+		Value: aws.Int(1),
+	}
 	expectedParams := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadAppNameParamKey),
@@ -454,6 +578,11 @@ func TestScheduledJob_Parameters(t *testing.T) {
 
 			expectedParams: expectedParams,
 		},
+		"doesn't render the schedule of a job triggered by events": {
+			manifest: eventJobManifest,
+
+			expectedParams: expectedParams[:len(expectedParams)-1],
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -53,9 +53,11 @@ func (t *taskStackConfig) StackName() string {
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
 	content, err := t.parser.Parse(taskTemplatePath, struct {
-		EnvVars map[string]string
+		EnvVars             map[string]string
+		PermissionsBoundary string
 	}{
-		EnvVars: t.EnvVars,
+		EnvVars:             t.EnvVars,
+		PermissionsBoundary: t.PermissionsBoundary,
 	})
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
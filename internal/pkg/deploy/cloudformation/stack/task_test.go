@@ -74,6 +74,24 @@ func TestTaskStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestTaskStackConfig_TemplatePermissionsBoundary(t *testing.T) {
+	// GIVEN
+	const boundary = "arn:aws:iam::123456789012:policy/Boundary"
+	task := NewTaskStackConfig(&deploy.CreateTaskResourcesInput{
+		Name:                "my-task",
+		PermissionsBoundary: boundary,
+	})
+
+	// WHEN
+	tpl, err := task.Template()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]interface{}{
+		"DefaultExecutionRole": boundary,
+	}, rolesPermissionsBoundary(t, tpl))
+}
+
 func TestTaskStackConfig_Parameters(t *testing.T) {
 	expectedParams := []*cloudformation.Parameter{
 		{
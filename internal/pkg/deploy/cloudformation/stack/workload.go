@@ -6,7 +6,9 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -34,12 +36,74 @@ const (
 	WorkloadAddonsTemplateURLParamKey = "AddonsTemplateURL"
 )
 
+// Output logical IDs common across services.
+const (
+	WorkloadDiscoveryServiceEndpointOutputKey = "DiscoveryServiceEndpoint"
+	WorkloadServiceConnectEndpointOutputKey   = "ServiceConnectEndpoint"
+)
+
+// Output logical IDs common across workloads.
+const (
+	WorkloadSNSTopicsOutputKey = "SNSTopicArns" // ARNs of the SNS topics of the workload keyed by topic name, in JSON.
+	WorkloadSQSQueuesOutputKey = "SQSQueueURIs" // URLs of the SQS queues of the workload keyed by queue name, in JSON.
+)
+
+// Resource logical IDs common across workloads.
+const (
+	WorkloadLogGroupLogicalID = "LogGroup"
+)
+
+// Sources of the environment variables of the main container.
+const (
+	envVarSourceCopilot      = "copilot"
+	envVarSourceVariables    = "variables"
+	envVarSourceSecrets      = "secrets"
+	fmtEnvVarSourceAddonsOut = "addons output %s"
+)
+
+// copilotEnvVars are the environment variables that Copilot sets in the main container of every workload.
+var copilotEnvVars = []string{
+	"COPILOT_APPLICATION_NAME",
+	"COPILOT_SERVICE_DISCOVERY_ENDPOINT",
+	"COPILOT_ENVIRONMENT_NAME",
+	"COPILOT_SERVICE_NAME",
+}
+
+// Environment variables that Copilot sets in the main container of workloads that publish or subscribe to SNS topics.
+const (
+	snsTopicARNsEnvVar = "COPILOT_SNS_TOPIC_ARNS"
+	queueURIEnvVar     = "COPILOT_QUEUE_URI"
+)
+
+// Settings of the X-Ray daemon sidecar of services that trace their requests with X-Ray.
+const (
+	xrayDaemonImage         = "public.ecr.aws/xray/aws-xray-daemon:latest"
+	xrayDaemonPort          = "2000"
+	xrayDaemonProtocol      = "udp"
+	xrayDaemonAddressEnvVar = "AWS_XRAY_DAEMON_ADDRESS" // Set in the main container so that the X-Ray SDKs send the traces to the sidecar.
+
+	tracingAWSXRay = "AWSXRAY" // Value of template.ObservabilityOpts.Tracing for the X-Ray daemon sidecar.
+)
+
+// fmtSNSTopicExportName is the name of the export of an SNS topic's ARN, formatted with the application, environment
+// and topic names. Topic names are unique within an application, so subscribers import the ARN without knowing the publisher.
+const fmtSNSTopicExportName = "%s-%s-%s-SNSTopicArn"
+
 // RuntimeConfig represents configuration that's defined outside of the manifest file
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
 	Image             *ECRImage         // Optional. Image location in an ECR repository.
 	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the workload stack.
+	SidecarImages     map[string]string // Optional. Image locations of the sidecars built from a Dockerfile, keyed by sidecar name.
+	EnableIPv6        bool              // Optional. True if the environment's VPC and load balancer support IPv6.
+	SecurityGroups    []string          // Optional. Security groups imported with the environment's VPC, attached in addition to the environment's.
+	AccountID         string            // Optional. ID of the environment's account, used to grant pull access to an image repository in another account.
+	EnvOutputExports  map[string]string // Optional. Export names of the environment stack's outputs keyed by output name, for variables set "from_env_output".
+	ImportedCertARNs  []string          // Optional. ACM certificates imported by the environment for its HTTPS listener.
+	ScheduleDisabled  bool              // Optional. True if the schedule of the job was disabled in the environment with "job disable".
+
+	PermissionsBoundary string // Optional. ARN of the IAM policy set as the permissions boundary of the workload's roles.
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -54,10 +118,49 @@ func (i ECRImage) GetLocation() string {
 	return fmt.Sprintf("%s:%s", i.RepoURL, i.ImageTag)
 }
 
+// SidecarImageLocations returns the location of each sidecar image pushed to the ECR repository, keyed by sidecar name.
+func SidecarImageLocations(repoURL string, imageTags map[string]string) map[string]string {
+	if len(imageTags) == 0 {
+		return nil
+	}
+	locations := make(map[string]string, len(imageTags))
+	for name, tag := range imageTags {
+		locations[name] = fmt.Sprintf("%s:%s", repoURL, tag)
+	}
+	return locations
+}
+
+// crossAccountRepoARN returns the ARN of the image's ECR repository if the repository is in another account
+// than the environment. Otherwise, returns an empty string.
+func crossAccountRepoARN(img *ECRImage, envAccountID string) string {
+	if img == nil || envAccountID == "" {
+		return ""
+	}
+	// Repository URLs are formatted as "<account>.dkr.ecr.<region>.amazonaws.com/<name>".
+	parts := strings.SplitN(img.RepoURL, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	host, name := parts[0], parts[1]
+	labels := strings.Split(host, ".")
+	if len(labels) < 4 || labels[1] != "dkr" || labels[2] != "ecr" {
+		return ""
+	}
+	account, region := labels[0], labels[3]
+	if account == envAccountID {
+		return ""
+	}
+	return fmt.Sprintf("arn:${AWS::Partition}:ecr:%s:%s:repository/%s", region, account, name)
+}
+
 type templater interface {
 	Template() (string, error)
 }
 
+type templatePatcher interface {
+	Apply(tpl string) (string, error)
+}
+
 type location interface {
 	GetLocation() string
 }
@@ -65,25 +168,26 @@ type location interface {
 // wkld represents a containerized workload running on Amazon ECS.
 // A workload can be a long-running service, an ephemeral task, or a periodic task.
 type wkld struct {
-	name  string
-	env   string
-	app   string
-	tc    manifest.TaskConfig
-	rc    RuntimeConfig
-	image location
+	name    string
+	env     string
+	app     string
+	tc      manifest.TaskConfig
+	rc      RuntimeConfig
+	image   location
+	logging *manifest.Logging
 
-	parser template.Parser
-	addons templater
+	parser  template.Parser
+	addons  templater
+	patches templatePatcher // Optional. Patches from the workload's "overrides/" directory.
 }
 
-// StackName returns the name of the stack.
-func (w *wkld) StackName() string {
-	return NameForService(w.app, w.env, w.name)
-}
-
-// Parameters returns the list of CloudFormation parameters used by the template.
-func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
+// desiredCount returns the number of tasks that the service starts with.
+// An explicit count of 0, or a range with a minimum of 0, starts no tasks in the environment.
+func (w *wkld) desiredCount() (*int, error) {
 	desiredCount := w.tc.Count.Value
+	if w.tc.Count.Spot != nil {
+		desiredCount = w.tc.Count.Spot
+	}
 	// If auto scaling is configured, override the desired count value.
 	if !w.tc.Count.Autoscaling.IsEmpty() {
 		min, _, err := w.tc.Count.Autoscaling.Range.Parse()
@@ -92,6 +196,20 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 		}
 		desiredCount = aws.Int(min)
 	}
+	return desiredCount, nil
+}
+
+// StackName returns the name of the stack.
+func (w *wkld) StackName() string {
+	return NameForService(w.app, w.env, w.name)
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
+	desiredCount, err := w.desiredCount()
+	if err != nil {
+		return nil, err
+	}
 	var img string
 	if w.image != nil {
 		img = w.image.GetLocation()
@@ -130,7 +248,7 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 		},
 		{
 			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
-			ParameterValue: aws.String("30"),
+			ParameterValue: aws.String(strconv.Itoa(w.logging.LogRetention())),
 		},
 		{
 			ParameterKey:   aws.String(WorkloadAddonsTemplateURLParamKey),
@@ -173,6 +291,210 @@ func (w *wkld) templateConfiguration(tc templateConfigurer) (string, error) {
 	return doc.String(), nil
 }
 
+// sidecarOpts converts the sidecar configuration into template options, and substitutes
+// the image locations of the sidecars that were built and pushed during deployment.
+func (w *wkld) sidecarOpts(s manifest.Sidecar) ([]*template.SidecarOpts, error) {
+	sidecars, err := s.Options()
+	if err != nil {
+		return nil, err
+	}
+	for _, sidecar := range sidecars {
+		if img, ok := w.rc.SidecarImages[aws.StringValue(sidecar.Name)]; ok {
+			sidecar.Image = aws.String(img)
+		}
+	}
+	return sidecars, nil
+}
+
+// serviceConnectOpts converts the network configuration of a service into ECS Service Connect template options.
+// It returns nil if Service Connect isn't enabled.
+func (w *wkld) serviceConnectOpts(network manifest.NetworkConfig, port *uint16) (*template.ServiceConnectOpts, error) {
+	if !network.ConnectEnabled() {
+		return nil, nil
+	}
+	if port == nil {
+		return nil, errors.New(`"network.connect" requires "image.port" to be set`)
+	}
+	export, ok := w.rc.EnvOutputExports[EnvOutputServiceConnectNamespace]
+	if !ok {
+		return nil, fmt.Errorf(`environment %s does not support "network.connect", run "copilot env upgrade --app %s --name %s" first`, w.env, w.app, w.env)
+	}
+	return &template.ServiceConnectOpts{
+		NamespaceExport: export,
+		// The port name is also the discovery name of the service in the namespace,
+		// it must not clash with the name of the service discovery service.
+		PortName: fmt.Sprintf("%s-%d", w.name, aws.Uint16Value(port)),
+	}, nil
+}
+
+// messagingOpts converts the SNS topics and SQS queues of the manifest into a format parsable by the templates pkg.
+// Queues subscribed to a topic that the workload doesn't publish import the topic's ARN exported by its publisher.
+// It returns nil if the workload neither publishes nor subscribes to topics.
+func (w *wkld) messagingOpts(m manifest.Messaging) *template.MessagingOpts {
+	if m.IsEmpty() {
+		return nil
+	}
+	opts := &template.MessagingOpts{}
+	published := make(map[string]bool)
+	for _, topic := range m.Publish {
+		opts.Topics = append(opts.Topics, &template.TopicOpts{
+			Name:       topic,
+			ExportName: fmt.Sprintf(fmtSNSTopicExportName, w.app, w.env, topic),
+		})
+		published[topic] = true
+	}
+	queues := make(map[string]*template.QueueOpts)
+	for _, sub := range m.Subscribe {
+		name := aws.StringValue(sub.Queue)
+		queue, ok := queues[name]
+		if !ok {
+			queue = &template.QueueOpts{Name: name}
+			queues[name] = queue
+			opts.Queues = append(opts.Queues, queue)
+		}
+		topic := &template.SubscribedTopicOpts{Name: aws.StringValue(sub.Topic)}
+		if !published[topic.Name] {
+			topic.ImportName = fmt.Sprintf(fmtSNSTopicExportName, w.app, w.env, topic.Name)
+		}
+		queue.Topics = append(queue.Topics, topic)
+	}
+	return opts
+}
+
+// observabilityOpts converts the observability configuration of a service into a format parsable by the templates pkg,
+// and returns the X-Ray daemon sidecar that receives the traces of the main container. Both are nil if tracing isn't enabled.
+func observabilityOpts(o manifest.Observability) (*template.ObservabilityOpts, *template.SidecarOpts) {
+	if !o.TracingEnabled() {
+		return nil, nil
+	}
+	sidecar := &template.SidecarOpts{
+		Name:     aws.String(manifest.XRaySidecarName),
+		Image:    aws.String(xrayDaemonImage),
+		Port:     aws.String(xrayDaemonPort),
+		Protocol: aws.String(xrayDaemonProtocol),
+	}
+	return &template.ObservabilityOpts{Tracing: tracingAWSXRay}, sidecar
+}
+
+// observabilityEnvVars returns the environment variables that Copilot sets for the observability features of a service.
+func observabilityEnvVars(o manifest.Observability) []string {
+	if !o.TracingEnabled() {
+		return nil
+	}
+	return []string{xrayDaemonAddressEnvVar}
+}
+
+// messagingEnvVars returns the environment variables that Copilot sets for the SNS topics and SQS queues of the workload.
+func messagingEnvVars(m manifest.Messaging) []string {
+	var vars []string
+	if len(m.Publish) != 0 {
+		vars = append(vars, snsTopicARNsEnvVar)
+	}
+	if len(m.Subscribe) != 0 {
+		vars = append(vars, queueURIEnvVar)
+	}
+	return vars
+}
+
+// variablesOpts converts the manifest variables into a format parsable by the templates pkg.
+// Variables set "from_cfn" or "from_env_output" are imported by CloudFormation when the stack is deployed.
+func (w *wkld) variablesOpts() (map[string]template.Variable, error) {
+	if w.tc.Variables == nil {
+		return nil, nil
+	}
+	vars := make(map[string]template.Variable, len(w.tc.Variables))
+	for name, v := range w.tc.Variables {
+		switch {
+		case v.From.CFNExport != nil && v.From.EnvOutput != nil:
+			return nil, fmt.Errorf(`variable %s: "from_cfn" and "from_env_output" cannot be specified together`, name)
+		case v.From.CFNExport != nil:
+			vars[name] = template.Variable{ImportName: aws.StringValue(v.From.CFNExport)}
+		case v.From.EnvOutput != nil:
+			output := aws.StringValue(v.From.EnvOutput)
+			export, ok := w.rc.EnvOutputExports[output]
+			if !ok {
+				available := "none"
+				if len(w.rc.EnvOutputExports) != 0 {
+					available = strings.Join(sortedKeys(w.rc.EnvOutputExports), ", ")
+				}
+				return nil, fmt.Errorf("variable %s: environment %s has no exported output %q, available outputs: %s",
+					name, w.env, output, available)
+			}
+			vars[name] = template.Variable{ImportName: export}
+		default:
+			vars[name] = template.Variable{Value: aws.StringValue(v.Value)}
+		}
+	}
+	return vars, nil
+}
+
+// sortedKeys returns the keys of the map in alphabetical order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateEnvVarNames returns an error if an environment variable of the main container is set by more than one
+// of Copilot, the manifest variables and secrets, and the addons outputs, since ECS silently picks one of the values.
+// The extra Copilot variables of the workload type are passed with copilotVars.
+// The check is skipped if the manifest sets allow_env_override.
+func validateEnvVarNames(tc manifest.TaskConfig, addons *template.WorkloadNestedStackOpts, copilotVars ...string) error {
+	if aws.BoolValue(tc.AllowEnvOverride) {
+		return nil
+	}
+	sources := make(map[string][]string)
+	for _, name := range copilotEnvVars {
+		sources[name] = append(sources[name], envVarSourceCopilot)
+	}
+	for _, name := range copilotVars {
+		sources[name] = append(sources[name], envVarSourceCopilot)
+	}
+	for name := range tc.Variables {
+		sources[name] = append(sources[name], envVarSourceVariables)
+	}
+	for name := range tc.Secrets {
+		sources[name] = append(sources[name], envVarSourceSecrets)
+	}
+	if addons != nil {
+		for _, out := range addons.VariableOutputs {
+			name := template.ToSnakeCaseFunc(out)
+			sources[name] = append(sources[name], fmt.Sprintf(fmtEnvVarSourceAddonsOut, out))
+		}
+		for _, out := range addons.SecretOutputs {
+			name := template.ToSnakeCaseFunc(out)
+			sources[name] = append(sources[name], fmt.Sprintf(fmtEnvVarSourceAddonsOut, out))
+		}
+	}
+	var duplicates []string
+	for name, srcs := range sources {
+		if len(srcs) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%s (%s)", name, strings.Join(srcs, ", ")))
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	sort.Strings(duplicates)
+	return fmt.Errorf(`environment variables set by more than one source: %s; set "allow_env_override: true" in the manifest if this is intended`,
+		strings.Join(duplicates, ", "))
+}
+
+// applyPatches applies the workload's patches to its rendered template.
+func (w *wkld) applyPatches(tpl string) (string, error) {
+	if w.patches == nil {
+		return tpl, nil
+	}
+	patched, err := w.patches.Apply(tpl)
+	if err != nil {
+		return "", fmt.Errorf("apply patches to the template of %s: %w", w.name, err)
+	}
+	return patched, nil
+}
+
 func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	stack, err := w.addons.Template()
 	if err != nil {
@@ -188,10 +510,11 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 		return nil, fmt.Errorf("get addons outputs for %s: %w", w.name, err)
 	}
 	return &template.WorkloadNestedStackOpts{
-		StackName:       addon.StackName,
-		VariableOutputs: envVarOutputNames(out),
-		SecretOutputs:   secretOutputNames(out),
-		PolicyOutputs:   managedPolicyOutputNames(out),
+		StackName:            addon.StackName,
+		VariableOutputs:      envVarOutputNames(out),
+		SecretOutputs:        secretOutputNames(out),
+		PolicyOutputs:        managedPolicyOutputNames(out),
+		SecurityGroupOutputs: securityGroupOutputNames(out),
 	}, nil
 }
 
@@ -215,10 +538,20 @@ func managedPolicyOutputNames(outputs []addon.Output) []string {
 	return policies
 }
 
+func securityGroupOutputNames(outputs []addon.Output) []string {
+	var securityGroups []string
+	for _, out := range outputs {
+		if out.IsSecurityGroup {
+			securityGroups = append(securityGroups, out.Name)
+		}
+	}
+	return securityGroups
+}
+
 func envVarOutputNames(outputs []addon.Output) []string {
 	var envVars []string
 	for _, out := range outputs {
-		if !out.IsSecret && !out.IsManagedPolicy {
+		if !out.IsSecret && !out.IsManagedPolicy && !out.IsSecurityGroup {
 			envVars = append(envVars, out.Name)
 		}
 	}
@@ -0,0 +1,498 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// rolesPermissionsBoundary returns the permissions boundary of each IAM role of a rendered template, keyed by logical ID.
+func rolesPermissionsBoundary(t *testing.T, tpl string) map[string]interface{} {
+	var rendered struct {
+		Resources map[string]struct {
+			Type       string                 `yaml:"Type"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &rendered))
+	boundaries := make(map[string]interface{})
+	for logicalID, resource := range rendered.Resources {
+		if resource.Type != "AWS::IAM::Role" {
+			continue
+		}
+		boundaries[logicalID] = resource.Properties["PermissionsBoundary"]
+	}
+	return boundaries
+}
+
+func TestValidateEnvVarNames(t *testing.T) {
+	const fmtWantedErr = `environment variables set by more than one source: %s; set "allow_env_override: true" in the manifest if this is intended`
+	testCases := map[string]struct {
+		inTaskConfig  manifest.TaskConfig
+		inAddons      *template.WorkloadNestedStackOpts
+		inCopilotVars []string
+
+		wantedErr error
+	}{
+		"no collisions": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]manifest.Variable{"LOG_LEVEL": {Value: aws.String("info")}},
+				Secrets:   map[string]string{"GITHUB_TOKEN": "GITHUB_TOKEN"},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"DbHost"},
+				SecretOutputs:   []string{"DbPassword"},
+			},
+		},
+		"variable collides with a copilot variable": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]manifest.Variable{"COPILOT_ENVIRONMENT_NAME": {Value: aws.String("prod")}},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "COPILOT_ENVIRONMENT_NAME (copilot, variables)"),
+		},
+		"secret collides with a copilot variable of the workload type": {
+			inTaskConfig: manifest.TaskConfig{
+				Secrets: map[string]string{"COPILOT_LB_DNS": "LB_DNS"},
+			},
+			inCopilotVars: []string{"COPILOT_LB_DNS"},
+			wantedErr:     fmt.Errorf(fmtWantedErr, "COPILOT_LB_DNS (copilot, secrets)"),
+		},
+		"addons output collides with a copilot variable": {
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"CopilotServiceName"},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "COPILOT_SERVICE_NAME (copilot, addons output CopilotServiceName)"),
+		},
+		"variable collides with a secret": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]manifest.Variable{"API_KEY": {Value: aws.String("key")}},
+				Secrets:   map[string]string{"API_KEY": "API_KEY"},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "API_KEY (variables, secrets)"),
+		},
+		"variable collides with an addons variable output": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]manifest.Variable{"DB_HOST": {Value: aws.String("localhost")}},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"DbHost"},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "DB_HOST (variables, addons output DbHost)"),
+		},
+		"secret collides with an addons secret output": {
+			inTaskConfig: manifest.TaskConfig{
+				Secrets: map[string]string{"DB_PASSWORD": "DB_PASSWORD"},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				SecretOutputs: []string{"DbPassword"},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "DB_PASSWORD (secrets, addons output DbPassword)"),
+		},
+		"addons variable output collides with an addons secret output": {
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"DbName"},
+				SecretOutputs:   []string{"DBName"},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "DB_NAME (addons output DbName, addons output DBName)"),
+		},
+		"lists every collision": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]manifest.Variable{"DB_HOST": {Value: aws.String("localhost")}, "COPILOT_APPLICATION_NAME": {Value: aws.String("app")}},
+				Secrets:   map[string]string{"DB_HOST": "DB_HOST"},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"DbHost"},
+			},
+			wantedErr: fmt.Errorf(fmtWantedErr, "COPILOT_APPLICATION_NAME (copilot, variables), DB_HOST (variables, secrets, addons output DbHost)"),
+		},
+		"allows collisions with allow_env_override": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables:        map[string]manifest.Variable{"DB_HOST": {Value: aws.String("localhost")}},
+				AllowEnvOverride: aws.Bool(true),
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"DbHost"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := validateEnvVarNames(tc.inTaskConfig, tc.inAddons, tc.inCopilotVars...)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorkload_variablesOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inVariables map[string]manifest.Variable
+		inExports   map[string]string
+
+		wanted    map[string]template.Variable
+		wantedErr error
+	}{
+		"no variables": {},
+		"converts values and imports": {
+			inVariables: map[string]manifest.Variable{
+				"LOG_LEVEL": {Value: aws.String("info")},
+				"DB_HOST": {
+					From: manifest.VariableFrom{CFNExport: aws.String("phonetool-test-db-Host")},
+				},
+				"SUBNETS": {
+					From: manifest.VariableFrom{EnvOutput: aws.String("PrivateSubnets")},
+				},
+			},
+			inExports: map[string]string{
+				"PrivateSubnets": "phonetool-test-PrivateSubnets",
+			},
+			wanted: map[string]template.Variable{
+				"LOG_LEVEL": {Value: "info"},
+				"DB_HOST":   {ImportName: "phonetool-test-db-Host"},
+				"SUBNETS":   {ImportName: "phonetool-test-PrivateSubnets"},
+			},
+		},
+		"errors if both sources are set": {
+			inVariables: map[string]manifest.Variable{
+				"SUBNETS": {
+					From: manifest.VariableFrom{
+						CFNExport: aws.String("phonetool-test-PrivateSubnets"),
+						EnvOutput: aws.String("PrivateSubnets"),
+					},
+				},
+			},
+			wantedErr: errors.New(`variable SUBNETS: "from_cfn" and "from_env_output" cannot be specified together`),
+		},
+		"errors with the available outputs if the environment doesn't export the output": {
+			inVariables: map[string]manifest.Variable{
+				"SUBNETS": {
+					From: manifest.VariableFrom{EnvOutput: aws.String("PrivateSubnetIds")},
+				},
+			},
+			inExports: map[string]string{
+				"VpcId":          "phonetool-test-VpcId",
+				"PrivateSubnets": "phonetool-test-PrivateSubnets",
+			},
+			wantedErr: errors.New(`variable SUBNETS: environment test has no exported output "PrivateSubnetIds", available outputs: PrivateSubnets, VpcId`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &wkld{
+				env: "test",
+				tc: manifest.TaskConfig{
+					Variables: tc.inVariables,
+				},
+				rc: RuntimeConfig{
+					EnvOutputExports: tc.inExports,
+				},
+			}
+
+			// WHEN
+			got, err := w.variablesOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestWorkload_serviceConnectOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inNetwork manifest.NetworkConfig
+		inPort    *uint16
+		inExports map[string]string
+
+		wanted    *template.ServiceConnectOpts
+		wantedErr error
+	}{
+		"disabled": {
+			inNetwork: manifest.NetworkConfig{Connect: aws.Bool(false)},
+			inPort:    aws.Uint16(80),
+		},
+		"errors if the service has no port": {
+			inNetwork: manifest.NetworkConfig{Connect: aws.Bool(true)},
+			wantedErr: errors.New(`"network.connect" requires "image.port" to be set`),
+		},
+		"errors if the environment doesn't export a Service Connect namespace": {
+			inNetwork: manifest.NetworkConfig{Connect: aws.Bool(true)},
+			inPort:    aws.Uint16(80),
+			inExports: map[string]string{
+				"ClusterId": "phonetool-test-ClusterId",
+			},
+			wantedErr: errors.New(`environment test does not support "network.connect", run "copilot env upgrade --app phonetool --name test" first`),
+		},
+		"imports the namespace of the environment": {
+			inNetwork: manifest.NetworkConfig{Connect: aws.Bool(true)},
+			inPort:    aws.Uint16(80),
+			inExports: map[string]string{
+				"ServiceConnectNamespace": "phonetool-test-ServiceConnectNamespace",
+			},
+			wanted: &template.ServiceConnectOpts{
+				NamespaceExport: "phonetool-test-ServiceConnectNamespace",
+				PortName:        "api-80",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &wkld{
+				name: "api",
+				env:  "test",
+				app:  "phonetool",
+				rc: RuntimeConfig{
+					EnvOutputExports: tc.inExports,
+				},
+			}
+
+			// WHEN
+			got, err := w.serviceConnectOpts(tc.inNetwork, tc.inPort)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestWorkload_messagingOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.Messaging
+
+		wanted *template.MessagingOpts
+	}{
+		"no messaging": {},
+		"publishes topics": {
+			in: manifest.Messaging{
+				Publish: []string{"orders", "order-events"},
+			},
+			wanted: &template.MessagingOpts{
+				Topics: []*template.TopicOpts{
+					{Name: "orders", ExportName: "phonetool-test-orders-SNSTopicArn"},
+					{Name: "order-events", ExportName: "phonetool-test-order-events-SNSTopicArn"},
+				},
+			},
+		},
+		"subscribes queues to its own and other workloads' topics": {
+			in: manifest.Messaging{
+				Publish: []string{"orders"},
+				Subscribe: []manifest.Subscription{
+					{Queue: aws.String("fulfillment"), Topic: aws.String("orders")},
+					{Queue: aws.String("audit"), Topic: aws.String("payments")},
+					{Queue: aws.String("fulfillment"), Topic: aws.String("payments")},
+				},
+			},
+			wanted: &template.MessagingOpts{
+				Topics: []*template.TopicOpts{
+					{Name: "orders", ExportName: "phonetool-test-orders-SNSTopicArn"},
+				},
+				Queues: []*template.QueueOpts{
+					{
+						Name: "fulfillment",
+						Topics: []*template.SubscribedTopicOpts{
+							{Name: "orders"},
+							{Name: "payments", ImportName: "phonetool-test-payments-SNSTopicArn"},
+						},
+					},
+					{
+						Name: "audit",
+						Topics: []*template.SubscribedTopicOpts{
+							{Name: "payments", ImportName: "phonetool-test-payments-SNSTopicArn"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &wkld{
+				name: "api",
+				env:  "test",
+				app:  "phonetool",
+			}
+
+			// WHEN
+			got := w.messagingOpts(tc.in)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestObservabilityOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.Observability
+
+		wantedOpts    *template.ObservabilityOpts
+		wantedSidecar *template.SidecarOpts
+		wantedEnvVars []string
+	}{
+		"no tracing": {},
+		"tracing disabled": {
+			in: manifest.Observability{
+				Tracing: aws.String("none"),
+			},
+		},
+		"traces with X-Ray": {
+			in: manifest.Observability{
+				Tracing: aws.String("awsxray"),
+			},
+			wantedOpts: &template.ObservabilityOpts{
+				Tracing: "AWSXRAY",
+			},
+			wantedSidecar: &template.SidecarOpts{
+				Name:     aws.String("xray"),
+				Image:    aws.String("public.ecr.aws/xray/aws-xray-daemon:latest"),
+				Port:     aws.String("2000"),
+				Protocol: aws.String("udp"),
+			},
+			wantedEnvVars: []string{"AWS_XRAY_DAEMON_ADDRESS"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			opts, sidecar := observabilityOpts(tc.in)
+
+			// THEN
+			require.Equal(t, tc.wantedOpts, opts)
+			require.Equal(t, tc.wantedSidecar, sidecar)
+			require.Equal(t, tc.wantedEnvVars, observabilityEnvVars(tc.in))
+		})
+	}
+}
+
+func TestWorkload_desiredCount(t *testing.T) {
+	zeroRange := manifest.Range("0-5")
+	badRange := manifest.Range("badRange")
+	testCases := map[string]struct {
+		in manifest.Count
+
+		wanted    *int
+		wantedErr error
+	}{
+		"task count": {
+			in:     manifest.Count{Value: aws.Int(3)},
+			wanted: aws.Int(3),
+		},
+		"service scaled to zero": {
+			in:     manifest.Count{Value: aws.Int(0)},
+			wanted: aws.Int(0),
+		},
+		"tasks on Fargate Spot": {
+			in:     manifest.Count{Spot: aws.Int(2)},
+			wanted: aws.Int(2),
+		},
+		"autoscaling from zero": {
+			in: manifest.Count{
+				Autoscaling: manifest.Autoscaling{
+					Range: &zeroRange,
+					CPU:   aws.Int(70),
+				},
+			},
+			wanted: aws.Int(0),
+		},
+		"error if the range is invalid": {
+			in: manifest.Count{
+				Autoscaling: manifest.Autoscaling{
+					Range: &badRange,
+				},
+			},
+			wantedErr: errors.New("parse task count value badRange: invalid range value badRange. Should be in format of ${min}-${max}"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &wkld{
+				tc: manifest.TaskConfig{
+					Count: tc.in,
+				},
+			}
+
+			got, err := w.desiredCount()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCrossAccountRepoARN(t *testing.T) {
+	testCases := map[string]struct {
+		inImage        *ECRImage
+		inEnvAccountID string
+
+		wanted string
+	}{
+		"no image": {
+			inEnvAccountID: "123456789012",
+		},
+		"repository in the environment's account": {
+			inImage: &ECRImage{
+				RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				ImageTag: "v1.0",
+			},
+			inEnvAccountID: "123456789012",
+		},
+		"repository in another account": {
+			inImage: &ECRImage{
+				RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				ImageTag: "v1.0",
+			},
+			inEnvAccountID: "210987654321",
+			wanted:         "arn:${AWS::Partition}:ecr:us-west-2:123456789012:repository/phonetool/frontend",
+		},
+		"repository that isn't in ECR": {
+			inImage: &ECRImage{
+				RepoURL:  "public.ecr.aws/nginx/nginx",
+				ImageTag: "latest",
+			},
+			inEnvAccountID: "210987654321",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, crossAccountRepoARN(tc.inImage, tc.inEnvAccountID))
+		})
+	}
+}
@@ -4,9 +4,15 @@
 package cloudformation
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 )
@@ -15,6 +21,16 @@ import (
 // If the service stack doesn't exist, then it creates the stack.
 // If the service stack already exists, it updates the stack.
 func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudformation.StackOption) error {
+	return cf.deployService(conf, cf.cfnClient.CreateAndWaitWithContext, cf.cfnClient.UpdateAndWaitWithContext, opts...)
+}
+
+// DeployServiceNoWait creates or updates a service stack like DeployService,
+// but returns as soon as CloudFormation accepts the operation instead of waiting until the deployment is done.
+func (cf CloudFormation) DeployServiceNoWait(conf StackConfiguration, opts ...cloudformation.StackOption) error {
+	return cf.deployService(conf, cf.cfnClient.CreateWithContext, cf.cfnClient.UpdateWithContext, opts...)
+}
+
+func (cf CloudFormation) deployService(conf StackConfiguration, create, update func(context.Context, *cloudformation.Stack) error, opts ...cloudformation.StackOption) error {
 	stack, err := toStack(conf)
 	if err != nil {
 		return err
@@ -23,7 +39,7 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 		opt(stack)
 	}
 
-	err = cf.cfnClient.CreateAndWait(stack)
+	err = create(cf.context(), stack)
 	if err == nil { // Created a new stack, stop execution.
 		return nil
 	}
@@ -32,7 +48,7 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 	if !errors.As(err, &errAlreadyExists) {
 		return cf.handleStackError(conf, err)
 	}
-	err = cf.cfnClient.UpdateAndWait(stack)
+	err = update(cf.context(), stack)
 	return cf.handleStackError(conf, err)
 }
 
@@ -40,6 +56,11 @@ func (cf CloudFormation) handleStackError(conf StackConfiguration, err error) er
 	if err == nil {
 		return nil
 	}
+	var errInterrupted *cloudformation.ErrStackDeployInterrupted
+	if errors.As(err, &errInterrupted) {
+		// The stack might still be in progress, so its error events aren't final.
+		return err
+	}
 	errors, describeErr := cf.ErrorEvents(conf)
 	if describeErr != nil {
 		return fmt.Errorf("%w: describe stack: %v", err, describeErr)
@@ -50,6 +71,83 @@ func (cf CloudFormation) handleStackError(conf StackConfiguration, err error) er
 	return fmt.Errorf("%w: %s", err, errors[0].StatusReason)
 }
 
+// updateIDPattern matches the random IDs that force custom resources to run on every deployment.
+var updateIDPattern = regexp.MustCompile(`(?m)^(\s*UpdateID:).*$`)
+
+// IsServiceUpToDate returns true if the service stack is deployed with the same template, parameters and tags as the configuration.
+// The random IDs of the custom resources that run on every deployment are ignored when comparing the templates.
+// If the service stack doesn't exist, returns false.
+func (cf CloudFormation) IsServiceUpToDate(conf StackConfiguration) (bool, error) {
+	descr, err := cf.cfnClient.Describe(conf.StackName())
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("describe stack %s: %w", conf.StackName(), err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return false, err
+	}
+	if !equalMaps(paramsToMap(descr.Parameters), paramsToMap(params)) || !equalMaps(toMap(descr.Tags), toMap(conf.Tags())) {
+		return false, nil
+	}
+	deployed, err := cf.cfnClient.TemplateBody(conf.StackName())
+	if err != nil {
+		return false, fmt.Errorf("get template of stack %s: %w", conf.StackName(), err)
+	}
+	tpl, err := conf.Template()
+	if err != nil {
+		return false, err
+	}
+	return templateHash(deployed) == templateHash(tpl), nil
+}
+
+// TemplateHash returns the hex-encoded SHA-256 hash of the stack template of the configuration.
+// Like in IsServiceUpToDate, the random IDs of the custom resources are ignored so that the hash is stable across deployments.
+func TemplateHash(conf StackConfiguration) (string, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return "", err
+	}
+	hash := templateHash(tpl)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func templateHash(tpl string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(updateIDPattern.ReplaceAllString(tpl, "$1")))
+}
+
+func paramsToMap(params []*sdkcloudformation.Parameter) map[string]string {
+	m := make(map[string]string, len(params))
+	for _, p := range params {
+		m[aws.StringValue(p.ParameterKey)] = aws.StringValue(p.ParameterValue)
+	}
+	return m
+}
+
+func equalMaps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
+
+// WorkloadStackID returns the unique ID of the stack of a deployed workload.
+func (cf CloudFormation) WorkloadStackID(stackName string) (string, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return "", fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	return aws.StringValue(descr.StackId), nil
+}
+
 // DeleteWorkload removes the CloudFormation stack of a deployed workload.
 func (cf CloudFormation) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
@@ -69,25 +69,25 @@ func TestCloudFormation_DeployService(t *testing.T) {
 					}),
 					cloudformation.WithRoleARN("myrole"))
 				m := mocks.NewMockcfnClient(ctrl)
-				m.EXPECT().CreateAndWait(stack).Return(nil)
-				m.EXPECT().UpdateAndWait(gomock.Any()).Times(0)
+				m.EXPECT().CreateAndWaitWithContext(gomock.Any(), stack).Return(nil)
+				m.EXPECT().UpdateAndWaitWithContext(gomock.Any(), gomock.Any()).Times(0)
 				return m
 			},
 		},
 		"calls update if the stack already exists": {
 			createMock: func(ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
-				m.EXPECT().CreateAndWait(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+				m.EXPECT().CreateAndWaitWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
 					Name: "name",
 				})
-				m.EXPECT().UpdateAndWait(gomock.Any())
+				m.EXPECT().UpdateAndWaitWithContext(gomock.Any(), gomock.Any())
 				return m
 			},
 		},
 		"calls describe if create fails": {
 			createMock: func(ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
-				m.EXPECT().CreateAndWait(gomock.Any()).Return(errors.New("some error"))
+				m.EXPECT().CreateAndWaitWithContext(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
 				m.EXPECT().ErrorEvents(gomock.Any()).Return([]cloudformation.StackEvent{
 					{ResourceStatusReason: aws.String("Bad things happened. (Service abcd)")},
 				}, nil)
@@ -98,12 +98,27 @@ func TestCloudFormation_DeployService(t *testing.T) {
 		"returns descriptive error if describe fails": {
 			createMock: func(ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
-				m.EXPECT().CreateAndWait(gomock.Any()).Return(errors.New("some error"))
+				m.EXPECT().CreateAndWaitWithContext(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
 				m.EXPECT().ErrorEvents(gomock.Any()).Return(nil, errors.New("other error"))
 				return m
 			},
 			wantedErr: "some error: describe stack: other error",
 		},
+		"does not describe the stack if the deployment is interrupted": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateAndWaitWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.ErrStackDeployInterrupted{
+					Name:     "webhook",
+					Executed: true,
+				})
+				m.EXPECT().ErrorEvents(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: (&cloudformation.ErrStackDeployInterrupted{
+				Name:     "webhook",
+				Executed: true,
+			}).Error(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -138,6 +153,246 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DeployServiceNoWait(t *testing.T) {
+	testCases := map[string]struct {
+		wantedErr  string
+		createMock func(ctrl *gomock.Controller) cfnClient
+	}{
+		"does not wait for the stack to be created": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateWithContext(gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().UpdateWithContext(gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"does not wait for the stack to be updated if it already exists": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().UpdateWithContext(gomock.Any(), gomock.Any()).Return(nil)
+				return m
+			},
+		},
+		"returns descriptive error if the update is rejected": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().UpdateWithContext(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.EXPECT().ErrorEvents("webhook").Return(nil, nil)
+				return m
+			},
+			wantedErr: "some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+			}
+
+			// WHEN
+			err := c.DeployServiceNoWait(conf, cloudformation.WithRoleARN("myrole"))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_WorkloadStackID(t *testing.T) {
+	testCases := map[string]struct {
+		mockCfn func(m *mocks.MockcfnClient)
+
+		wantedID  string
+		wantedErr string
+	}{
+		"returns the stack ID": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(&cloudformation.StackDescription{
+					StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc"),
+				}, nil)
+			},
+			wantedID: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc",
+		},
+		"wraps the describe error": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe stack phonetool-test-frontend: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCfn(m)
+			c := CloudFormation{
+				cfnClient: m,
+			}
+
+			// WHEN
+			id, err := c.WorkloadStackID("phonetool-test-frontend")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCloudFormation_IsServiceUpToDate(t *testing.T) {
+	const (
+		deployedTpl = `Resources:
+  EnvControllerAction:
+    Properties:
+      UpdateID: 0b6b0b0c-5b2e-4e6b-9d3a-1c1c5a8b2c11
+`
+		renderedTpl = `Resources:
+  EnvControllerAction:
+    Properties:
+      UpdateID: 7f1e3c2a-9c4d-4b1e-8a6f-2d2e6b9c3d22
+`
+	)
+	conf := &mockStackConfig{
+		name:       "phonetool-test-frontend",
+		template:   renderedTpl,
+		tags:       map[string]string{"copilot-application": "phonetool"},
+		parameters: map[string]string{"ContainerImage": "frontend:v1"},
+	}
+	deployedStack := func(image string) *cloudformation.StackDescription {
+		return &cloudformation.StackDescription{
+			Parameters: []*sdkcloudformation.Parameter{
+				{
+					ParameterKey:   aws.String("ContainerImage"),
+					ParameterValue: aws.String(image),
+				},
+			},
+			Tags: []*sdkcloudformation.Tag{
+				{
+					Key:   aws.String("copilot-application"),
+					Value: aws.String("phonetool"),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		mockCfn func(m *mocks.MockcfnClient)
+
+		wanted    bool
+		wantedErr string
+	}{
+		"returns true if only the update IDs of the templates are different": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(deployedStack("frontend:v1"), nil)
+				m.EXPECT().TemplateBody("phonetool-test-frontend").Return(deployedTpl, nil)
+			},
+			wanted: true,
+		},
+		"returns false if the templates are different": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(deployedStack("frontend:v1"), nil)
+				m.EXPECT().TemplateBody("phonetool-test-frontend").Return("Resources: {}", nil)
+			},
+			wanted: false,
+		},
+		"returns false if the parameters are different": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(deployedStack("frontend:v0"), nil)
+			},
+			wanted: false,
+		},
+		"returns false if the stack doesn't exist": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+			wanted: false,
+		},
+		"wraps the describe error": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe stack phonetool-test-frontend: some error",
+		},
+		"wraps the get template error": {
+			mockCfn: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(deployedStack("frontend:v1"), nil)
+				m.EXPECT().TemplateBody("phonetool-test-frontend").Return("", errors.New("some error"))
+			},
+			wantedErr: "get template of stack phonetool-test-frontend: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCfn(m)
+			c := CloudFormation{
+				cfnClient: m,
+			}
+
+			// WHEN
+			upToDate, err := c.IsServiceUpToDate(conf)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, upToDate)
+		})
+	}
+}
+
+func TestTemplateHash(t *testing.T) {
+	hash, err := TemplateHash(&mockStackConfig{
+		template: `Resources:
+  EnvControllerAction:
+    Properties:
+      UpdateID: 0b6b0b0c-5b2e-4e6b-9d3a-1c1c5a8b2c11
+`,
+	})
+	require.NoError(t, err)
+
+	other, err := TemplateHash(&mockStackConfig{
+		template: `Resources:
+  EnvControllerAction:
+    Properties:
+      UpdateID: 7f1e3c2a-9c4d-4b1e-8a6f-2d2e6b9c3d22
+`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, hash, other, "the update IDs are ignored")
+	require.Len(t, hash, 64)
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteWorkloadInput
@@ -7,6 +7,7 @@ package deploy
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -24,7 +25,8 @@ const (
 )
 
 const (
-	ecsServiceResourceType = "ecs:service"
+	ecsServiceResourceType   = "ecs:service"
+	stepFunctionResourceType = "states:stateMachine"
 )
 
 // Resource represents an AWS resource.
@@ -49,9 +51,10 @@ type ConfigStoreClient interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 	ListEnvironments(appName string) ([]*config.Environment, error)
 	GetService(appName, svcName string) (*config.Workload, error)
+	GetJob(appName, jobName string) (*config.Workload, error)
 }
 
-// Store fetches information on deployed services.
+// Store fetches information on deployed services and jobs.
 type Store struct {
 	configStore         ConfigStoreClient
 	newRgClientFromIDs  func(string, string) (resourceGetter, error)
@@ -60,6 +63,19 @@ type Store struct {
 
 // NewStore returns a new store.
 func NewStore(store ConfigStoreClient) (*Store, error) {
+	return newStore(store, func(roleARN, region string) (*session.Session, error) {
+		return sessions.NewProvider().FromRole(roleARN, region)
+	}), nil
+}
+
+// NewStoreFromSession returns a new store that assumes the environment manager roles with the credentials of the session.
+func NewStoreFromSession(store ConfigStoreClient, sess *session.Session) *Store {
+	return newStore(store, func(roleARN, region string) (*session.Session, error) {
+		return sessions.AssumeRole(sess, roleARN, region)
+	})
+}
+
+func newStore(store ConfigStoreClient, fromRole func(roleARN, region string) (*session.Session, error)) *Store {
 	s := &Store{
 		configStore: store,
 	}
@@ -68,48 +84,60 @@ func NewStore(store ConfigStoreClient) (*Store, error) {
 		if err != nil {
 			return nil, fmt.Errorf("get environment config %s: %w", envName, err)
 		}
-		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		sess, err := fromRole(env.ManagerRoleARN, env.Region)
 		if err != nil {
 			return nil, fmt.Errorf("create new session from env role: %w", err)
 		}
 		return rg.New(sess), nil
 	}
 	s.newRgClientFromRole = func(roleARN, region string) (resourceGetter, error) {
-		sess, err := sessions.NewProvider().FromRole(roleARN, region)
+		sess, err := fromRole(roleARN, region)
 		if err != nil {
 			return nil, fmt.Errorf("create new session from env role: %w", err)
 		}
 		return rg.New(sess), nil
 	}
-	return s, nil
+	return s
 }
 
 // ListDeployedServices returns the names of deployed services in an environment part of an application.
 func (s *Store) ListDeployedServices(appName string, envName string) ([]string, error) {
+	return s.listDeployedWorkloads(appName, envName, ecsServiceResourceType, "service", s.configStore.GetService)
+}
+
+// ListDeployedJobs returns the names of deployed jobs in an environment part of an application.
+func (s *Store) ListDeployedJobs(appName string, envName string) ([]string, error) {
+	return s.listDeployedWorkloads(appName, envName, stepFunctionResourceType, "job", s.configStore.GetJob)
+}
+
+// listDeployedWorkloads returns the names of the workloads whose resource of the given type is deployed in the environment.
+// Jobs and services are both tagged with the service tag key, the resource type tells them apart.
+func (s *Store) listDeployedWorkloads(appName, envName, resourceType, workloadType string,
+	getWorkload func(appName, name string) (*config.Workload, error)) ([]string, error) {
 	rgClient, err := s.newRgClientFromIDs(appName, envName)
 	if err != nil {
 		return nil, err
 	}
-	resources, err := rgClient.GetResourcesByTags(ecsServiceResourceType, map[string]string{
+	resources, err := rgClient.GetResourcesByTags(resourceType, map[string]string{
 		AppTagKey: appName,
 		EnvTagKey: envName,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get resources by Copilot tags: %w", err)
 	}
-	svcs := make([]string, len(resources))
+	wklds := make([]string, len(resources))
 	for ind, resource := range resources {
-		svcName := resource.Tags[ServiceTagKey]
-		if svcName == "" {
-			return nil, fmt.Errorf("service with ARN %s is not tagged with %s", resource.ARN, ServiceTagKey)
+		name := resource.Tags[ServiceTagKey]
+		if name == "" {
+			return nil, fmt.Errorf("%s with ARN %s is not tagged with %s", workloadType, resource.ARN, ServiceTagKey)
 		}
-		svc, err := s.configStore.GetService(appName, svcName)
+		wkld, err := getWorkload(appName, name)
 		if err != nil {
-			return nil, fmt.Errorf("get service %s: %w", svcName, err)
+			return nil, fmt.Errorf("get %s %s: %w", workloadType, name, err)
 		}
-		svcs[ind] = svc.Name
+		wklds[ind] = wkld.Name
 	}
-	return svcs, nil
+	return wklds, nil
 }
 
 type result struct {
@@ -167,19 +195,28 @@ func (s *Store) ListEnvironmentsDeployedTo(appName string, svcName string) ([]st
 
 // IsServiceDeployed returns whether a service is deployed in an environment or not.
 func (s *Store) IsServiceDeployed(appName string, envName string, svcName string) (bool, error) {
+	return s.isWorkloadDeployed(appName, envName, svcName, ecsServiceResourceType)
+}
+
+// IsJobDeployed returns whether a job is deployed in an environment or not.
+func (s *Store) IsJobDeployed(appName string, envName string, jobName string) (bool, error) {
+	return s.isWorkloadDeployed(appName, envName, jobName, stepFunctionResourceType)
+}
+
+func (s *Store) isWorkloadDeployed(appName, envName, name, resourceType string) (bool, error) {
 	rgClient, err := s.newRgClientFromIDs(appName, envName)
 	if err != nil {
 		return false, err
 	}
-	svcARNs, err := rgClient.GetResourcesByTags(ecsServiceResourceType, map[string]string{
+	arns, err := rgClient.GetResourcesByTags(resourceType, map[string]string{
 		AppTagKey:     appName,
 		EnvTagKey:     envName,
-		ServiceTagKey: svcName,
+		ServiceTagKey: name,
 	})
 	if err != nil {
 		return false, fmt.Errorf("get resources by Copilot tags: %w", err)
 	}
-	if len(svcARNs) != 0 {
+	if len(arns) != 0 {
 		return true, nil
 	}
 	return false, nil
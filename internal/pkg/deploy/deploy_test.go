@@ -135,6 +135,115 @@ func TestStore_ListDeployedServices(t *testing.T) {
 	}
 }
 
+func TestStore_ListDeployedJobs(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputEnv   string
+		setupMocks func(mocks storeMock)
+
+		wantedError error
+		wantedJobs  []string
+	}{
+		"return error if fail to get resources by tag": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+
+			setupMocks: func(m storeMock) {
+				m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+					AppTagKey: "mockApp",
+					EnvTagKey: "mockEnv",
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get resources by Copilot tags: some error"),
+		},
+		"return error if fail to get job name": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+
+			setupMocks: func(m storeMock) {
+				m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+					AppTagKey: "mockApp",
+					EnvTagKey: "mockEnv",
+				}).Return([]*rg.Resource{{ARN: "mockARN", Tags: map[string]string{}}}, nil)
+			},
+
+			wantedError: fmt.Errorf("job with ARN mockARN is not tagged with %s", ServiceTagKey),
+		},
+		"return error if fail to get config job": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+
+			setupMocks: func(m storeMock) {
+				gomock.InOrder(
+					m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+						AppTagKey: "mockApp",
+						EnvTagKey: "mockEnv",
+					}).Return([]*rg.Resource{{ARN: "mockARN", Tags: map[string]string{ServiceTagKey: "mockJob"}}}, nil),
+					m.configStore.EXPECT().GetJob("mockApp", "mockJob").Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("get job mockJob: some error"),
+		},
+		"success": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+
+			setupMocks: func(m storeMock) {
+				gomock.InOrder(
+					m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+						AppTagKey: "mockApp",
+						EnvTagKey: "mockEnv",
+					}).Return([]*rg.Resource{{ARN: "mockARN1", Tags: map[string]string{ServiceTagKey: "mockJob1"}},
+						{ARN: "mockARN2", Tags: map[string]string{ServiceTagKey: "mockJob2"}}}, nil),
+					m.configStore.EXPECT().GetJob("mockApp", "mockJob1").Return(&config.Workload{
+						App:  "mockApp",
+						Name: "mockJob1",
+					}, nil),
+					m.configStore.EXPECT().GetJob("mockApp", "mockJob2").Return(&config.Workload{
+						App:  "mockApp",
+						Name: "mockJob2",
+					}, nil),
+				)
+			},
+
+			wantedJobs: []string{"mockJob1", "mockJob2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockConfigStore := mocks.NewMockConfigStoreClient(ctrl)
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+
+			tc.setupMocks(storeMock{
+				rgGetter:    mockRgGetter,
+				configStore: mockConfigStore,
+			})
+
+			store := &Store{
+				configStore:        mockConfigStore,
+				newRgClientFromIDs: func(string, string) (resourceGetter, error) { return mockRgGetter, nil },
+			}
+
+			// WHEN
+			jobs, err := store.ListDeployedJobs(tc.inputApp, tc.inputEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJobs, jobs)
+		})
+	}
+}
+
 func TestStore_ListEnvironmentsDeployedTo(t *testing.T) {
 	testCases := map[string]struct {
 		inputApp   string
@@ -339,3 +448,92 @@ func TestStore_IsDeployed(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_IsJobDeployed(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputEnv   string
+		inputJob   string
+		setupMocks func(mocks storeMock)
+
+		wantedError    error
+		wantedDeployed bool
+	}{
+		"return error if fail to get resources by tags": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+			inputJob: "mockJob",
+
+			setupMocks: func(m storeMock) {
+				m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+					AppTagKey:     "mockApp",
+					EnvTagKey:     "mockEnv",
+					ServiceTagKey: "mockJob",
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get resources by Copilot tags: some error"),
+		},
+		"success with false": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+			inputJob: "mockJob",
+
+			setupMocks: func(m storeMock) {
+				m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+					AppTagKey:     "mockApp",
+					EnvTagKey:     "mockEnv",
+					ServiceTagKey: "mockJob",
+				}).Return([]*rg.Resource{}, nil)
+			},
+
+			wantedDeployed: false,
+		},
+		"success with true": {
+			inputApp: "mockApp",
+			inputEnv: "mockEnv",
+			inputJob: "mockJob",
+
+			setupMocks: func(m storeMock) {
+				m.rgGetter.EXPECT().GetResourcesByTags(stepFunctionResourceType, map[string]string{
+					AppTagKey:     "mockApp",
+					EnvTagKey:     "mockEnv",
+					ServiceTagKey: "mockJob",
+				}).Return([]*rg.Resource{{ARN: "mockJobARN"}}, nil)
+			},
+
+			wantedDeployed: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockConfigStore := mocks.NewMockConfigStoreClient(ctrl)
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+
+			tc.setupMocks(storeMock{
+				rgGetter:    mockRgGetter,
+				configStore: mockConfigStore,
+			})
+
+			store := &Store{
+				configStore:        mockConfigStore,
+				newRgClientFromIDs: func(string, string) (resourceGetter, error) { return mockRgGetter, nil },
+			}
+
+			// WHEN
+			deployed, err := store.IsJobDeployed(tc.inputApp, tc.inputEnv, tc.inputJob)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployed, deployed)
+		})
+	}
+}
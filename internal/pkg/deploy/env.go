@@ -6,14 +6,20 @@
 package deploy
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"golang.org/x/mod/semver"
 )
 
 const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.1.0"
+	LatestEnvTemplateVersion = "v1.2.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -21,21 +27,123 @@ type CreateEnvironmentInput struct {
 	// The version of the environment template to create the stack. If empty, creates the legacy stack.
 	Version string
 
-	AppName                  string            // Name of the application this environment belongs to.
-	Name                     string            // Name of the environment, must be unique within an application.
-	Prod                     bool              // Whether or not this environment is a production environment.
-	ToolsAccountPrincipalARN string            // The Principal ARN of the tools account.
-	AppDNSName               string            // The DNS name of this application, if it exists
-	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources under the application.
-	ImportVPCConfig          *config.ImportVPC // Optional configuration if users have an existing VPC.
-	AdjustVPCConfig          *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	AppName                  string             // Name of the application this environment belongs to.
+	Name                     string             // Name of the environment, must be unique within an application.
+	Prod                     bool               // Whether or not this environment is a production environment.
+	ToolsAccountPrincipalARN string             // The Principal ARN of the tools account.
+	AppDNSName               string             // The DNS name of this application, if it exists
+	AdditionalTags           map[string]string  // AdditionalTags are labels applied to resources under the application.
+	ImportVPCConfig          *config.ImportVPC  // Optional configuration if users have an existing VPC.
+	AdjustVPCConfig          *config.AdjustVPC  // Optional configuration if users want to override default VPC configuration.
+	EnableIPv6               bool               // Whether the VPC, subnets and load balancer support IPv6.
+	AccessLogsConfig         *config.AccessLogs // Optional configuration if users want to store the load balancer's access logs in S3.
+	ImportCertARNs           []string           // Optional ARNs of existing ACM certificates for the HTTPS listener of the load balancer.
+	PermissionsBoundary      string             // Optional ARN of the IAM policy set as the permissions boundary of the environment's roles.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
 
+// RemoveEnvFromAppInput holds the fields required to remove an environment's resources from an application.
+type RemoveEnvFromAppInput struct {
+	App           *config.Application   // Application the environment belongs to.
+	Env           *config.Environment   // Environment being removed.
+	RemainingEnvs []*config.Environment // Other environments of the application.
+	AppRegion     string                // Region where the application is mastered, its stack instance is never removed.
+}
+
 // CreateEnvironmentResponse holds the created environment on successful deployment.
 // Otherwise, the environment is set to nil and a descriptive error is returned.
 type CreateEnvironmentResponse struct {
 	Env *config.Environment
 	Err error
 }
+
+// envFeatureMinVersions maps the manifest fields of a workload that depend on resources of the environment stack
+// to the first version of the environment template that creates them.
+var envFeatureMinVersions = map[string]string{
+	"count.requests":      "v1.1.0", // Scales on the load balancer's metrics, whose full name is an output since v1.1.0.
+	"count.response_time": "v1.1.0",
+	"network.connect":     "v1.2.0", // Registers the service in the Service Connect namespace created since v1.2.0.
+	// Alarms on the load balancer's metrics, like scaling on them, require the load balancer's full name.
+	"alarms.http_5xx_percentage": "v1.1.0",
+	"alarms.p99_latency":         "v1.1.0",
+}
+
+// EnvFeatures returns the manifest fields used by the workload in the environment that require a minimum
+// version of the environment template, sorted by name.
+func EnvFeatures(mft interface{}, env string) ([]string, error) {
+	var features []string
+	switch v := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		mft, err := v.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		if mft.Count.Autoscaling.Requests != nil {
+			features = append(features, "count.requests")
+		}
+		if mft.Count.Autoscaling.ResponseTime != nil {
+			features = append(features, "count.response_time")
+		}
+		if mft.Network.ConnectEnabled() {
+			features = append(features, "network.connect")
+		}
+		if mft.Alarms.HTTP5xx != nil {
+			features = append(features, "alarms.http_5xx_percentage")
+		}
+		if mft.Alarms.P99Latency != nil {
+			features = append(features, "alarms.p99_latency")
+		}
+	case *manifest.BackendService:
+		mft, err := v.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		if mft.Network.ConnectEnabled() {
+			features = append(features, "network.connect")
+		}
+	}
+	sort.Strings(features)
+	return features, nil
+}
+
+// ValidateEnvVersion returns an *ErrEnvVersionTooOld if the version of the environment template
+// is older than the minimum version of any of the features.
+func ValidateEnvVersion(app, env, version string, features []string) error {
+	var unsupported []string
+	for _, feature := range features {
+		min, ok := envFeatureMinVersions[feature]
+		if !ok {
+			continue
+		}
+		if semver.Compare(version, min) < 0 {
+			unsupported = append(unsupported, feature)
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	return &ErrEnvVersionTooOld{
+		App:      app,
+		Env:      env,
+		Version:  version,
+		Features: unsupported,
+	}
+}
+
+// ErrEnvVersionTooOld occurs when a workload uses features that the version of its environment's template doesn't support.
+type ErrEnvVersionTooOld struct {
+	App      string
+	Env      string
+	Version  string   // Version of the environment template.
+	Features []string // Manifest fields that require a newer version.
+}
+
+func (e *ErrEnvVersionTooOld) Error() string {
+	features := make([]string, len(e.Features))
+	for i, feature := range e.Features {
+		features[i] = fmt.Sprintf("%q (requires %s)", feature, envFeatureMinVersions[feature])
+	}
+	return fmt.Sprintf(`environment %s is on version %s which does not support %s, run "copilot env upgrade --app %s --name %s" first`,
+		e.Env, e.Version, strings.Join(features, ", "), e.App, e.Env)
+}
@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvFeatures(t *testing.T) {
+	responseTime := 2 * time.Second
+	testCases := map[string]struct {
+		mft interface{}
+		env string
+
+		wanted []string
+	}{
+		"no features": {
+			mft: &manifest.LoadBalancedWebService{},
+			env: "test",
+		},
+		"load balanced web service scaling on requests with Service Connect": {
+			mft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						Count: manifest.Count{
+							Autoscaling: manifest.Autoscaling{
+								Requests:     aws.Int(100),
+								ResponseTime: &responseTime,
+							},
+						},
+					},
+					Network: manifest.NetworkConfig{
+						Connect: aws.Bool(true),
+					},
+				},
+			},
+			env: "test",
+
+			wanted: []string{"count.requests", "count.response_time", "network.connect"},
+		},
+		"load balanced web service alarming on its target group": {
+			mft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					Alarms: manifest.WebAlarmsConfig{
+						AlarmsConfig: manifest.AlarmsConfig{
+							CPU: aws.Int(70),
+						},
+						HTTP5xx:    aws.Int(5),
+						P99Latency: &responseTime,
+					},
+				},
+			},
+			env: "test",
+
+			wanted: []string{"alarms.http_5xx_percentage", "alarms.p99_latency"},
+		},
+		"backend service enabling Service Connect in the environment": {
+			mft: &manifest.BackendService{
+				Environments: map[string]*manifest.BackendServiceConfig{
+					"prod": {
+						Network: manifest.NetworkConfig{
+							Connect: aws.Bool(true),
+						},
+					},
+				},
+			},
+			env: "prod",
+
+			wanted: []string{"network.connect"},
+		},
+		"backend service enabling Service Connect in another environment": {
+			mft: &manifest.BackendService{
+				Environments: map[string]*manifest.BackendServiceConfig{
+					"prod": {
+						Network: manifest.NetworkConfig{
+							Connect: aws.Bool(true),
+						},
+					},
+				},
+			},
+			env: "test",
+		},
+		"scheduled job": {
+			mft: &manifest.ScheduledJob{},
+			env: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := EnvFeatures(tc.mft, tc.env)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestValidateEnvVersion(t *testing.T) {
+	testCases := map[string]struct {
+		version  string
+		features []string
+
+		wantedErr error
+	}{
+		"no features": {
+			version: LegacyEnvTemplateVersion,
+		},
+		"supported features": {
+			version:  "v1.2.0",
+			features: []string{"count.requests", "network.connect"},
+		},
+		"environment newer than the features": {
+			version:  "v1.3.0",
+			features: []string{"network.connect"},
+		},
+		"one unsupported feature": {
+			version:  "v1.1.0",
+			features: []string{"count.requests", "network.connect"},
+
+			wantedErr: errors.New(`environment test is on version v1.1.0 which does not support "network.connect" (requires v1.2.0), run "copilot env upgrade --app phonetool --name test" first`),
+		},
+		"legacy environment": {
+			version:  LegacyEnvTemplateVersion,
+			features: []string{"count.requests", "network.connect"},
+
+			wantedErr: errors.New(`environment test is on version v0.0.0 which does not support "count.requests" (requires v1.1.0), "network.connect" (requires v1.2.0), run "copilot env upgrade --app phonetool --name test" first`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateEnvVersion("phonetool", "test", tc.version, tc.features)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				var tooOld *ErrEnvVersionTooOld
+				require.True(t, errors.As(err, &tooOld))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
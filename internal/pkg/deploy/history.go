@@ -0,0 +1,121 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultDeploymentHistoryLimit is the number of records kept in the deployment history of a workload
+	// in an environment if no limit is configured.
+	DefaultDeploymentHistoryLimit = 100
+
+	deploymentHistoryDirName = "deployments"
+	// Format of the timestamp that names a record, it sorts lexically in chronological order.
+	deploymentRecordTimeFormat = "20060102T150405.000000000Z"
+)
+
+type deploymentHistoryBucket interface {
+	PutObject(bucket, key string, data io.Reader) error
+	GetObject(bucket, key string) ([]byte, error)
+	ListObjectKeys(bucket, prefix string) ([]string, error)
+	DeleteObjects(bucket string, keys []string) error
+}
+
+// WorkloadDeploymentRecord is the record of a successful deployment of a workload to an environment.
+type WorkloadDeploymentRecord struct {
+	App          string    `json:"app"`
+	Env          string    `json:"env"`
+	Workload     string    `json:"workload"`
+	Timestamp    time.Time `json:"timestamp"`
+	ImageTag     string    `json:"imageTag,omitempty"`    // Empty if the workload runs an existing image.
+	ImageDigest  string    `json:"imageDigest,omitempty"` // Empty if the digest of the image isn't known.
+	GitSHA       string    `json:"gitSha,omitempty"`      // Empty if the workspace isn't a git repository.
+	DeployerARN  string    `json:"deployerArn"`
+	TemplateHash string    `json:"templateHash"` // SHA-256 hash of the deployed stack template.
+}
+
+// DeploymentHistory reads and writes the deployment records of workloads in an application's regional S3 bucket.
+// The records of a workload in an environment are stored under the "deployments/<app>/<env>/<workload>/" prefix.
+type DeploymentHistory struct {
+	s3 deploymentHistoryBucket
+}
+
+// NewDeploymentHistory returns a DeploymentHistory that stores the records with the S3 client.
+func NewDeploymentHistory(s3 deploymentHistoryBucket) *DeploymentHistory {
+	return &DeploymentHistory{
+		s3: s3,
+	}
+}
+
+// Record adds the record to the deployment history in the bucket.
+// Only the keep most recent records of the workload in the environment are kept, the older ones are deleted.
+// If keep isn't positive, DefaultDeploymentHistoryLimit records are kept.
+func (h *DeploymentHistory) Record(bucket string, rec *WorkloadDeploymentRecord, keep int) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal deployment record: %w", err)
+	}
+	prefix := deploymentHistoryPrefix(rec.App, rec.Env, rec.Workload)
+	key := path.Join(prefix, rec.Timestamp.UTC().Format(deploymentRecordTimeFormat)+".json")
+	if err := h.s3.PutObject(bucket, key, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	if keep <= 0 {
+		keep = DefaultDeploymentHistoryLimit
+	}
+	keys, err := h.recordKeys(bucket, prefix)
+	if err != nil {
+		return err
+	}
+	if len(keys) <= keep {
+		return nil
+	}
+	return h.s3.DeleteObjects(bucket, keys[keep:])
+}
+
+// List returns up to limit of the most recent deployment records of the workload in the environment, newest first.
+func (h *DeploymentHistory) List(bucket, app, env, workload string, limit int) ([]*WorkloadDeploymentRecord, error) {
+	keys, err := h.recordKeys(bucket, deploymentHistoryPrefix(app, env, workload))
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) > limit {
+		keys = keys[:limit]
+	}
+	records := make([]*WorkloadDeploymentRecord, 0, len(keys))
+	for _, key := range keys {
+		data, err := h.s3.GetObject(bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		rec := &WorkloadDeploymentRecord{}
+		if err := json.Unmarshal(data, rec); err != nil {
+			return nil, fmt.Errorf("unmarshal deployment record %s: %w", key, err)
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// recordKeys returns the keys of the records under the prefix, newest first.
+func (h *DeploymentHistory) recordKeys(bucket, prefix string) ([]string, error) {
+	keys, err := h.s3.ListObjectKeys(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	return keys, nil
+}
+
+func deploymentHistoryPrefix(app, env, workload string) string {
+	return path.Join(deploymentHistoryDirName, app, env, workload) + "/"
+}
@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentHistory_Record(t *testing.T) {
+	const mockPrefix = "deployments/phonetool/test/frontend/"
+	mockRecord := &WorkloadDeploymentRecord{
+		App:          "phonetool",
+		Env:          "test",
+		Workload:     "frontend",
+		Timestamp:    time.Date(2020, 11, 5, 19, 50, 30, 0, time.UTC),
+		ImageTag:     "v1.2.0",
+		ImageDigest:  "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+		GitSHA:       "bb133e7",
+		DeployerARN:  "arn:aws:iam::123456789012:user/alice",
+		TemplateHash: "5e884898da28",
+	}
+	testCases := map[string]struct {
+		inKeep   int
+		setupS3  func(m *mocks.MockdeploymentHistoryBucket)
+		wantsErr error
+	}{
+		"stores the record under the workload's prefix": {
+			inKeep: 2,
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().PutObject("bucket", mockPrefix+"20201105T195030.000000000Z.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) error {
+						b, err := ioutil.ReadAll(data)
+						require.NoError(t, err)
+						require.Equal(t, `{"app":"phonetool","env":"test","workload":"frontend","timestamp":"2020-11-05T19:50:30Z","imageTag":"v1.2.0","imageDigest":"sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7","gitSha":"bb133e7","deployerArn":"arn:aws:iam::123456789012:user/alice","templateHash":"5e884898da28"}`, string(b))
+						return nil
+					})
+				m.EXPECT().ListObjectKeys("bucket", mockPrefix).Return([]string{
+					mockPrefix + "20201104T100000.000000000Z.json",
+					mockPrefix + "20201105T195030.000000000Z.json",
+				}, nil)
+				m.EXPECT().DeleteObjects(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"deletes the oldest records beyond the limit": {
+			inKeep: 2,
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().PutObject("bucket", gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().ListObjectKeys("bucket", mockPrefix).Return([]string{
+					mockPrefix + "20201101T100000.000000000Z.json",
+					mockPrefix + "20201103T100000.000000000Z.json",
+					mockPrefix + "20201104T100000.000000000Z.json",
+					mockPrefix + "20201105T195030.000000000Z.json",
+				}, nil)
+				m.EXPECT().DeleteObjects("bucket", []string{
+					mockPrefix + "20201103T100000.000000000Z.json",
+					mockPrefix + "20201101T100000.000000000Z.json",
+				}).Return(nil)
+			},
+		},
+		"keeps the default number of records without a limit": {
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().PutObject("bucket", gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().ListObjectKeys("bucket", mockPrefix).Return(make([]string, DefaultDeploymentHistoryLimit), nil)
+				m.EXPECT().DeleteObjects(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"returns the error if the record can't be stored": {
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().PutObject("bucket", gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantsErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentHistoryBucket(ctrl)
+			tc.setupS3(m)
+			history := NewDeploymentHistory(m)
+
+			// WHEN
+			err := history.Record("bucket", mockRecord, tc.inKeep)
+
+			// THEN
+			if tc.wantsErr != nil {
+				require.EqualError(t, err, tc.wantsErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeploymentHistory_List(t *testing.T) {
+	const mockPrefix = "deployments/phonetool/test/frontend/"
+	testCases := map[string]struct {
+		setupS3 func(m *mocks.MockdeploymentHistoryBucket)
+
+		wanted   []*WorkloadDeploymentRecord
+		wantsErr error
+	}{
+		"returns the most recent records newest first": {
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().ListObjectKeys("bucket", mockPrefix).Return([]string{
+					mockPrefix + "20201103T100000.000000000Z.json",
+					mockPrefix + "20201104T100000.000000000Z.json",
+					mockPrefix + "20201105T195030.000000000Z.json",
+				}, nil)
+				m.EXPECT().GetObject("bucket", mockPrefix+"20201105T195030.000000000Z.json").
+					Return([]byte(`{"app":"phonetool","env":"test","workload":"frontend","timestamp":"2020-11-05T19:50:30Z","imageTag":"v1.2.0"}`), nil)
+				m.EXPECT().GetObject("bucket", mockPrefix+"20201104T100000.000000000Z.json").
+					Return([]byte(`{"app":"phonetool","env":"test","workload":"frontend","timestamp":"2020-11-04T10:00:00Z","imageTag":"v1.1.0"}`), nil)
+			},
+			wanted: []*WorkloadDeploymentRecord{
+				{
+					App:       "phonetool",
+					Env:       "test",
+					Workload:  "frontend",
+					Timestamp: time.Date(2020, 11, 5, 19, 50, 30, 0, time.UTC),
+					ImageTag:  "v1.2.0",
+				},
+				{
+					App:       "phonetool",
+					Env:       "test",
+					Workload:  "frontend",
+					Timestamp: time.Date(2020, 11, 4, 10, 0, 0, 0, time.UTC),
+					ImageTag:  "v1.1.0",
+				},
+			},
+		},
+		"returns an empty list if the workload wasn't deployed": {
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().ListObjectKeys("bucket", mockPrefix).Return(nil, nil)
+			},
+			wanted: []*WorkloadDeploymentRecord{},
+		},
+		"wraps the error if a record is invalid": {
+			setupS3: func(m *mocks.MockdeploymentHistoryBucket) {
+				m.EXPECT().ListObjectKeys("bucket", mockPrefix).Return([]string{mockPrefix + "20201105T195030.000000000Z.json"}, nil)
+				m.EXPECT().GetObject("bucket", gomock.Any()).Return([]byte(`{`), nil)
+			},
+			wantsErr: errors.New("unmarshal deployment record deployments/phonetool/test/frontend/20201105T195030.000000000Z.json: unexpected end of JSON input"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentHistoryBucket(ctrl)
+			tc.setupS3(m)
+			history := NewDeploymentHistory(m)
+
+			// WHEN
+			records, err := history.List("bucket", "phonetool", "test", "frontend", 2)
+
+			// THEN
+			if tc.wantsErr != nil {
+				require.EqualError(t, err, tc.wantsErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, records)
+		})
+	}
+}
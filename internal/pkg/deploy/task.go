@@ -20,5 +20,7 @@ type CreateTaskResourcesInput struct {
 	App string
 	Env string
 
+	PermissionsBoundary string // ARN of the IAM policy set as the permissions boundary of the task's execution role.
+
 	AdditionalTags map[string]string
 }
@@ -5,6 +5,12 @@
 // This file defines workload deployment resources.
 package deploy
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
 const (
 	// WorkloadCfnTemplateNameFormat is the base output file name when `service package`
 	// or `job package` is called. This is also used to render the pipeline CFN template.
@@ -16,6 +22,9 @@ const (
 	// AddonsCfnTemplateNameFormat is the addons output file name when `service package`
 	// is called.
 	AddonsCfnTemplateNameFormat = "%s.addons.stack.yml"
+	// ServiceAlarmNamePrefixFormat is the prefix of the names of the CloudWatch alarms
+	// that Copilot creates from the "alarms" section of a service's manifest.
+	ServiceAlarmNamePrefixFormat = "%s-%s-%s-CopilotAlarm-"
 )
 
 // DeleteWorkloadInput holds the fields required to delete a workload.
@@ -24,3 +33,32 @@ type DeleteWorkloadInput struct {
 	EnvName string // Name of the environment the service is deployed in.
 	AppName string // Name of the application the service belongs to.
 }
+
+const (
+	// WorkloadDeploymentSucceeded means the stack of the workload finished deploying.
+	WorkloadDeploymentSucceeded = "succeeded"
+	// WorkloadDeploymentStarted means the stack create or update started but wasn't waited on.
+	WorkloadDeploymentStarted = "started"
+)
+
+// WorkloadDeploymentEvent is the message published to notify that a workload was deployed.
+type WorkloadDeploymentEvent struct {
+	App         string    `json:"app"`
+	Env         string    `json:"env"`
+	Workload    string    `json:"workload"`
+	ImageTag    string    `json:"imageTag"`
+	GitSHA      string    `json:"gitSha,omitempty"` // Empty if the workspace isn't a git repository.
+	DeployerARN string    `json:"deployerArn"`
+	StackID     string    `json:"stackId"`
+	Status      string    `json:"status"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// JSONString returns the event serialized as JSON.
+func (e *WorkloadDeploymentEvent) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal deployment event: %w", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadDeploymentEvent_JSONString(t *testing.T) {
+	timestamp, _ := time.Parse(time.RFC3339, "2020-11-05T19:50:30+00:00")
+	testCases := map[string]struct {
+		in     WorkloadDeploymentEvent
+		wanted string
+	}{
+		"with a git sha": {
+			in: WorkloadDeploymentEvent{
+				App:         "phonetool",
+				Env:         "test",
+				Workload:    "frontend",
+				ImageTag:    "v1.2.0",
+				GitSHA:      "bb133e7",
+				DeployerARN: "arn:aws:sts::123456789012:assumed-role/ci/session",
+				StackID:     "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc",
+				Status:      WorkloadDeploymentSucceeded,
+				Timestamp:   timestamp,
+			},
+			wanted: `{"app":"phonetool","env":"test","workload":"frontend","imageTag":"v1.2.0","gitSha":"bb133e7","deployerArn":"arn:aws:sts::123456789012:assumed-role/ci/session","stackId":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abc","status":"succeeded","timestamp":"2020-11-05T19:50:30Z"}`,
+		},
+		"omits the git sha outside of a git repository": {
+			in: WorkloadDeploymentEvent{
+				App:         "phonetool",
+				Env:         "test",
+				Workload:    "report-gen",
+				ImageTag:    "latest",
+				DeployerARN: "arn:aws:iam::123456789012:user/alice",
+				StackID:     "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-report-gen/abc",
+				Status:      WorkloadDeploymentStarted,
+				Timestamp:   timestamp,
+			},
+			wanted: `{"app":"phonetool","env":"test","workload":"report-gen","imageTag":"latest","deployerArn":"arn:aws:iam::123456789012:user/alice","stackId":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-report-gen/abc","status":"started","timestamp":"2020-11-05T19:50:30Z"}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -29,6 +29,7 @@ type BackendServiceDescriber struct {
 	enableResources bool
 
 	store                DeployedEnvServicesLister
+	ws                   WorkspaceManifestReader
 	svcDescriber         map[string]svcDescriber
 	initServiceDescriber func(string) error
 }
@@ -38,6 +39,7 @@ type NewBackendServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
 	DeployStore     DeployedEnvServicesLister
+	Workspace       WorkspaceManifestReader // Optional. Used to describe the configuration of a service that's not deployed yet.
 }
 
 // NewBackendServiceDescriber instantiates a backend service describer.
@@ -47,6 +49,7 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
 		store:           opt.DeployStore,
+		ws:              opt.Workspace,
 		svcDescriber:    make(map[string]svcDescriber),
 	}
 	describer.initServiceDescriber = func(env string) error {
@@ -96,9 +99,23 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
 	}
+	if len(environments) == 0 {
+		configs, err := undeployedConfigs(d.ws, d.svc)
+		if err != nil {
+			return nil, fmt.Errorf("describe configuration of service %s from its manifest: %w", d.svc, err)
+		}
+		return &backendSvcDesc{
+			Service:        d.svc,
+			Type:           manifest.BackendServiceType,
+			App:            d.app,
+			Configurations: configs,
+		}, nil
+	}
 
 	var configs []*ServiceConfig
 	var services []*ServiceDiscovery
+	var serviceConnects []*ServiceConnect
+	var messagingRes []*MessagingResource
 	var envVars []*EnvVars
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
@@ -118,6 +135,18 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 				App:     d.app,
 			}, env)
 		}
+		svcOutputs, err := d.svcDescriber[env].StackOutputs()
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of service %s in environment %s: %w", d.svc, env, err)
+		}
+		if endpoint, ok := svcOutputs[stack.WorkloadServiceConnectEndpointOutputKey]; ok {
+			serviceConnects = appendServiceConnect(serviceConnects, endpoint, env)
+		}
+		res, err := messaging(env, svcOutputs)
+		if err != nil {
+			return nil, fmt.Errorf("get messaging resources of service %s in environment %s: %w", d.svc, env, err)
+		}
+		messagingRes = append(messagingRes, res...)
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        port,
@@ -133,6 +162,10 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
+	imageRepos, err := describeImageRepositories(environments, d.svcDescriber)
+	if err != nil {
+		return nil, err
+	}
 
 	resources := make(map[string][]*CfnResource)
 	if d.enableResources {
@@ -153,9 +186,13 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Service:          d.svc,
 		Type:             manifest.BackendServiceType,
 		App:              d.app,
+		Deployed:         true,
 		Configurations:   configs,
 		ServiceDiscovery: services,
+		ServiceConnect:   serviceConnects,
+		Messaging:        messagingRes,
 		Variables:        envVars,
+		ImageRepos:       imageRepos,
 		Resources:        resources,
 	}, nil
 }
@@ -165,9 +202,13 @@ type backendSvcDesc struct {
 	Service          string             `json:"service"`
 	Type             string             `json:"type"`
 	App              string             `json:"application"`
+	Deployed         bool               `json:"deployed"`
 	Configurations   configurations     `json:"configurations"`
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
+	ServiceConnect   serviceConnects    `json:"serviceConnect,omitempty"`
+	Messaging        messagingResources `json:"messaging,omitempty"`
 	Variables        envVars            `json:"variables"`
+	ImageRepos       imageRepositories  `json:"imageRepositories,omitempty"`
 	Resources        cfnResources       `json:"resources,omitempty"`
 }
 
@@ -192,12 +233,34 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if !w.Deployed {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployments\n\n"))
+		writer.Flush()
+		undeployedHumanString(writer)
+		writer.Flush()
+		return b.String()
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
+	if len(w.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		w.ServiceConnect.humanString(writer)
+	}
+	if len(w.Messaging) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nMessaging\n\n"))
+		writer.Flush()
+		w.Messaging.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.ImageRepos) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nImage Repositories\n\n"))
+		writer.Flush()
+		w.ImageRepos.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -19,6 +19,7 @@ import (
 type backendSvcDescriberMocks struct {
 	storeSvc     *mocks.MockDeployedEnvServicesLister
 	svcDescriber *mocks.MocksvcDescriber
+	ws           *mocks.MockWorkspaceManifestReader
 }
 
 func TestBackendServiceDescriber_Describe(t *testing.T) {
@@ -46,6 +47,36 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("list deployed environments for application phonetool: some error"),
 		},
+		"describes the configuration from the workspace manifest if the service is not deployed": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return(nil, nil),
+					m.ws.EXPECT().ReadServiceManifest(testSvc).Return([]byte(`name: jobs
+type: Backend Service
+image:
+  build: ./Dockerfile
+cpu: 256
+memory: 512
+count: 2
+`), nil),
+				)
+			},
+			wantedBackendSvc: &backendSvcDesc{
+				Service:  testSvc,
+				Type:     "Backend Service",
+				App:      testApp,
+				Deployed: false,
+				Configurations: []*ServiceConfig{
+					{
+						CPU:         "256",
+						Environment: "-",
+						Memory:      "512",
+						Port:        "-",
+						Tasks:       "2",
+					},
+				},
+			},
+		},
 		"return error if fail to retrieve service deployment configuration": {
 			setupMocks: func(m backendSvcDescriberMocks) {
 				gomock.InOrder(
@@ -55,6 +86,38 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve service deployment configuration: some error"),
 		},
+		"return error if fail to retrieve the outputs of the service stack": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get outputs of service jobs in environment test: some error"),
+		},
+		"return error if fail to parse the messaging outputs of the service stack": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{
+						stack.WorkloadSNSTopicsOutputKey: "not json",
+					}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("get messaging resources of service jobs in environment test: unmarshal output SNSTopicArns: invalid character 'o' in literal null (expecting 'u')"),
+		},
 		"return error if fail to retrieve environment variables": {
 			setupMocks: func(m backendSvcDescriberMocks) {
 				gomock.InOrder(
@@ -65,11 +128,34 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("retrieve environment variables: some error"),
 		},
+		"return error if fail to retrieve images of the repository": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_ENVIRONMENT_NAME": testEnv,
+						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(10, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(0, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve images of repository in us-west-2: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m backendSvcDescriberMocks) {
@@ -82,6 +168,11 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{
+						stack.WorkloadServiceConnectEndpointOutputKey: "jobs:5000",
+						stack.WorkloadSNSTopicsOutputKey:              `{"orders":"arn:aws:sns:us-west-2:123456789012:phonetool-test-jobs-orders"}`,
+						stack.WorkloadSQSQueuesOutputKey:              `{"events":"https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-events"}`,
+					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -93,6 +184,9 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "512",
 						stack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{
+						stack.WorkloadServiceConnectEndpointOutputKey: "jobs:5000",
+					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
@@ -104,10 +198,21 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "512",
 						stack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": mockEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(10, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(12, nil),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
+					m.svcDescriber.EXPECT().Region().Return("us-east-1"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(0, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(3, nil),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(true),
 
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
@@ -130,9 +235,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 				)
 			},
 			wantedBackendSvc: &backendSvcDesc{
-				Service: testSvc,
-				Type:    "Backend Service",
-				App:     testApp,
+				Service:  testSvc,
+				Type:     "Backend Service",
+				App:      testApp,
+				Deployed: true,
 				Configurations: []*ServiceConfig{
 					{
 						CPU:         "256",
@@ -162,6 +268,26 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						Namespace:   "jobs.phonetool.local:5000",
 					},
 				},
+				ServiceConnect: []*ServiceConnect{
+					{
+						Environment: []string{"test", "prod"},
+						Endpoint:    "jobs:5000",
+					},
+				},
+				Messaging: []*MessagingResource{
+					{
+						Environment: "test",
+						Type:        "SNS Topic",
+						Name:        "orders",
+						ID:          "arn:aws:sns:us-west-2:123456789012:phonetool-test-jobs-orders",
+					},
+					{
+						Environment: "test",
+						Type:        "SQS Queue",
+						Name:        "events",
+						ID:          "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-events",
+					},
+				},
 				Variables: []*EnvVars{
 					{
 						Environment: "mockEnv",
@@ -179,6 +305,18 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						Value:       "test",
 					},
 				},
+				ImageRepos: []*ImageRepository{
+					{
+						Region:    "us-west-2",
+						Retention: 10,
+						Images:    12,
+					},
+					{
+						Region:           "us-east-1",
+						Images:           3,
+						CrossAccountEnvs: []string{"mockEnv"},
+					},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -210,9 +348,11 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
+			mockWS := mocks.NewMockWorkspaceManifestReader(ctrl)
 			mocks := backendSvcDescriberMocks{
 				storeSvc:     mockStore,
 				svcDescriber: mockSvcDescriber,
+				ws:           mockWS,
 			}
 
 			tc.setupMocks(mocks)
@@ -222,6 +362,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 				svc:             testSvc,
 				enableResources: tc.shouldOutputResources,
 				store:           mockStore,
+				ws:              mockWS,
 				svcDescriber: map[string]svcDescriber{
 					"test":    mockSvcDescriber,
 					"prod":    mockSvcDescriber,
@@ -267,6 +408,17 @@ Service Discovery
   Environment       Namespace
   test, prod        http://my-svc.my-app.local:5000
 
+Service Connect
+
+  Environment       Endpoint
+  test, prod        my-svc:5000
+
+Messaging
+
+  Environment       Type                Name                ARN or URL
+  test              SNS Topic           orders              arn:aws:sns:us-west-2:123456789012:my-app-test-my-svc-orders
+  test              SQS Queue           events              https://sqs.us-west-2.amazonaws.com/123456789012/my-app-test-my-svc-events
+
 Variables
 
   Name                      Environment         Value
@@ -281,7 +433,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"deployed\":true,\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"serviceConnect\":[{\"environment\":[\"test\",\"prod\"],\"endpoint\":\"my-svc:5000\"}],\"messaging\":[{\"environment\":\"test\",\"type\":\"SNS Topic\",\"name\":\"orders\",\"id\":\"arn:aws:sns:us-west-2:123456789012:my-app-test-my-svc-orders\"},{\"environment\":\"test\",\"type\":\"SQS Queue\",\"name\":\"events\",\"id\":\"https://sqs.us-west-2.amazonaws.com/123456789012/my-app-test-my-svc-events\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -321,6 +473,26 @@ Resources
 					Namespace:   "http://my-svc.my-app.local:5000",
 				},
 			}
+			scs := []*ServiceConnect{
+				{
+					Environment: []string{"test", "prod"},
+					Endpoint:    "my-svc:5000",
+				},
+			}
+			msgs := []*MessagingResource{
+				{
+					Environment: "test",
+					Type:        "SNS Topic",
+					Name:        "orders",
+					ID:          "arn:aws:sns:us-west-2:123456789012:my-app-test-my-svc-orders",
+				},
+				{
+					Environment: "test",
+					Type:        "SQS Queue",
+					Name:        "events",
+					ID:          "https://sqs.us-west-2.amazonaws.com/123456789012/my-app-test-my-svc-events",
+				},
+			}
 			resources := map[string][]*CfnResource{
 				"test": {
 					{
@@ -340,8 +512,11 @@ Resources
 				Type:             "Backend Service",
 				Configurations:   config,
 				App:              "my-app",
+				Deployed:         true,
 				Variables:        envVars,
 				ServiceDiscovery: sds,
+				ServiceConnect:   scs,
+				Messaging:        msgs,
 				Resources:        resources,
 			}
 			human := backendSvc.HumanString()
@@ -352,3 +527,41 @@ Resources
 		})
 	}
 }
+
+func TestBackendSvcDesc_UndeployedString(t *testing.T) {
+	backendSvc := &backendSvcDesc{
+		Service: "my-svc",
+		Type:    "Backend Service",
+		App:     "my-app",
+		Configurations: []*ServiceConfig{
+			{
+				CPU:         "256",
+				Environment: "-",
+				Memory:      "512",
+				Port:        "80",
+				Tasks:       "1",
+			},
+		},
+	}
+
+	human := backendSvc.HumanString()
+	json, err := backendSvc.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `About
+
+  Application       my-app
+  Name              my-svc
+  Type              Backend Service
+
+Configurations
+
+  Environment       Tasks               CPU (vCPU)          Memory (MiB)        Port
+  -                 1                   0.25                512                 80
+
+Deployments
+
+  This service is not deployed to any environment yet.
+`+"  Run `copilot svc deploy` to deploy it.\n", human)
+	require.Equal(t, "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"deployed\":false,\"configurations\":[{\"environment\":\"-\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"}],\"serviceDiscovery\":null,\"variables\":null}\n", json)
+}
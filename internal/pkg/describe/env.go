@@ -5,13 +5,21 @@ package describe
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -20,32 +28,49 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// maxServicesHealthWorkers is the number of services whose health is retrieved concurrently.
+const maxServicesHealthWorkers = 5
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
-	Environment *config.Environment `json:"environment"`
-	Services    []*config.Workload  `json:"services"`
-	Tags        map[string]string   `json:"tags,omitempty"`
-	Resources   []*CfnResource      `json:"resources,omitempty"`
+	Environment    *config.Environment       `json:"environment"`
+	Services       []*config.Workload        `json:"services"`
+	Jobs           []*config.Workload        `json:"jobs,omitempty"`
+	ServicesHealth map[string]*ServiceHealth `json:"servicesHealth,omitempty"` // Health of the services keyed by name, only set if requested.
+	Tags           map[string]string         `json:"tags,omitempty"`
+	Resources      []*CfnResource            `json:"resources,omitempty"`
+
+	AccessLogsBucket string `json:"accessLogsBucket,omitempty"` // Bucket that the load balancer's access logs are stored in, if enabled.
+}
+
+type serviceHealthDescriber interface {
+	Health() (*ServiceHealth, error)
 }
 
 // EnvDescriber retrieves information about an environment.
 type EnvDescriber struct {
-	app             string
-	env             *config.Environment
-	enableResources bool
+	app                   string
+	env                   *config.Environment
+	enableResources       bool
+	enableServicesHealth  bool
+	servicesHealthTimeout time.Duration
 
 	configStore    ConfigStoreSvc
 	deployStore    DeployedEnvServicesLister
 	stackDescriber stackAndResourcesDescriber
+	svcHealth      func(svc string) serviceHealthDescriber
 }
 
 // NewEnvDescriberConfig contains fields that initiates EnvDescriber struct.
 type NewEnvDescriberConfig struct {
-	App             string
-	Env             string
-	EnableResources bool
-	ConfigStore     ConfigStoreSvc
-	DeployStore     DeployedEnvServicesLister
+	App                   string
+	Env                   string
+	EnableResources       bool
+	EnableServicesHealth  bool          // Whether to retrieve the health of each service deployed to the environment.
+	ServicesHealthTimeout time.Duration // Optional. Services whose health isn't retrieved within the timeout have no health.
+	ConfigStore           ConfigStoreSvc
+	DeployStore           DeployedEnvServicesLister
+	Session               *session.Session // Optional, the session that assumes the environment manager role instead of the default one.
 }
 
 // NewEnvDescriber instantiates an environment describer.
@@ -54,19 +79,37 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get environment: %w", err)
 	}
-	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	var sess *session.Session
+	if opt.Session != nil {
+		sess, err = sessions.AssumeRole(opt.Session, env.ManagerRoleARN, env.Region)
+	} else {
+		sess, err = sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
 	d := newStackDescriber(sess)
+	rgClient, ecsClient, elbClient := rg.New(sess), ecs.New(sess), elbv2.New(sess)
 	return &EnvDescriber{
-		app:             opt.App,
-		env:             env,
-		enableResources: opt.EnableResources,
+		app:                   opt.App,
+		env:                   env,
+		enableResources:       opt.EnableResources,
+		enableServicesHealth:  opt.EnableServicesHealth,
+		servicesHealthTimeout: opt.ServicesHealthTimeout,
 
 		configStore:    opt.ConfigStore,
 		deployStore:    opt.DeployStore,
 		stackDescriber: d,
+		svcHealth: func(svc string) serviceHealthDescriber {
+			return &ServiceStatus{
+				app:    opt.App,
+				env:    opt.Env,
+				svc:    svc,
+				ecsSvc: ecsClient,
+				rgSvc:  rgClient,
+				elbSvc: elbClient,
+			}
+		},
 	}, nil
 }
 
@@ -76,8 +119,12 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 	if err != nil {
 		return nil, err
 	}
+	jobs, err := d.filterDeployedJobs()
+	if err != nil {
+		return nil, err
+	}
 
-	tags, err := d.stackTags()
+	envStack, err := d.stackDescriber.Stack(stack.NameForEnv(d.app, d.env.Name))
 	if err != nil {
 		return nil, fmt.Errorf("retrieve environment tags: %w", err)
 	}
@@ -90,11 +137,20 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		}
 	}
 
+	var svcsHealth map[string]*ServiceHealth
+	if d.enableServicesHealth {
+		svcsHealth = d.servicesHealth(svcs)
+	}
+
 	return &EnvDescription{
-		Environment: d.env,
-		Services:    svcs,
-		Tags:        tags,
-		Resources:   stackResources,
+		Environment:    d.env,
+		Services:       svcs,
+		Jobs:           jobs,
+		ServicesHealth: svcsHealth,
+		Tags:           stackTags(envStack),
+		Resources:      stackResources,
+
+		AccessLogsBucket: d.accessLogsBucket(envStack),
 	}, nil
 }
 
@@ -127,16 +183,27 @@ type EnvironmentVPC struct {
 	PrivateSubnetIDs []string
 }
 
-func (d *EnvDescriber) stackTags() (map[string]string, error) {
+func stackTags(envStack *cloudformation.Stack) map[string]string {
 	tags := make(map[string]string)
-	envStack, err := d.stackDescriber.Stack(stack.NameForEnv(d.app, d.env.Name))
-	if err != nil {
-		return nil, err
-	}
 	for _, tag := range envStack.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	return tags, nil
+	return tags
+}
+
+// accessLogsBucket returns the bucket that the environment's load balancer stores its access logs in,
+// or an empty string if access logs aren't enabled.
+func (d *EnvDescriber) accessLogsBucket(envStack *cloudformation.Stack) string {
+	if d.env.CustomConfig == nil || d.env.CustomConfig.AccessLogs == nil {
+		return ""
+	}
+	for _, out := range envStack.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.EnvOutputAccessLogsBucket {
+			return aws.StringValue(out.OutputValue)
+		}
+	}
+	// The environment stack wasn't upgraded since access logs were enabled.
+	return d.env.CustomConfig.AccessLogs.BucketName
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
@@ -159,6 +226,83 @@ func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
 	return deployedSvcs, nil
 }
 
+func (d *EnvDescriber) filterDeployedJobs() ([]*config.Workload, error) {
+	allJobs, err := d.configStore.ListJobs(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs for app %s: %w", d.app, err)
+	}
+	jobs := make(map[string]*config.Workload)
+	for _, job := range allJobs {
+		jobs[job.Name] = job
+	}
+	deployedJobNames, err := d.deployStore.ListDeployedJobs(d.app, d.env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed jobs in env %s: %w", d.env.Name, err)
+	}
+	var deployedJobs []*config.Workload
+	for _, deployedJobName := range deployedJobNames {
+		deployedJobs = append(deployedJobs, jobs[deployedJobName])
+	}
+	return deployedJobs, nil
+}
+
+// servicesHealth retrieves the health of the services concurrently. A service whose health can't be retrieved,
+// or isn't retrieved before the timeout, has a health with no fields set.
+func (d *EnvDescriber) servicesHealth(svcs []*config.Workload) map[string]*ServiceHealth {
+	ctx := context.Background()
+	if d.servicesHealthTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.servicesHealthTimeout)
+		defer cancel()
+	}
+	type result struct {
+		svc    string
+		health *ServiceHealth
+	}
+	names := make(chan string)
+	// The results are buffered so that the workers never block once the timeout is reached.
+	results := make(chan result, len(svcs))
+	workers := maxServicesHealthWorkers
+	if len(svcs) < workers {
+		workers = len(svcs)
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for svc := range names {
+				health, err := d.svcHealth(svc).Health()
+				if err != nil {
+					health = &ServiceHealth{}
+				}
+				results <- result{svc: svc, health: health}
+			}
+		}()
+	}
+	go func() {
+		defer close(names)
+		for _, svc := range svcs {
+			select {
+			case names <- svc.Name:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	healths := make(map[string]*ServiceHealth, len(svcs))
+	for _, svc := range svcs {
+		healths[svc.Name] = &ServiceHealth{}
+	}
+	for range svcs {
+		select {
+		case res := <-results:
+			healths[res.svc] = res.health
+		case <-ctx.Done():
+			return healths
+		}
+	}
+	return healths
+}
+
 func (d *EnvDescriber) resources() ([]*CfnResource, error) {
 	envStack, err := d.stackDescriber.StackResources(stack.NameForEnv(d.app, d.env.Name))
 	if err != nil {
@@ -187,21 +331,24 @@ func (e *EnvDescription) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%t\n", "Production", e.Environment.Prod)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Environment.Region)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.Environment.AccountID)
+	// Environments stored before the CLI version was recorded don't show it.
+	if e.Environment.CreatedByVersion != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Created By", e.Environment.CreatedByVersion)
+	}
+	if e.Environment.LastUpdatedByVersion != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Last Updated By", e.Environment.LastUpdatedByVersion)
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "Access Logs", e.accessLogsHumanString())
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
-	fmt.Fprintf(writer, "  %s\t%s\n", "Name", "Type")
-	nameLengthMax := len("Name")
-	typeLengthMax := len("Type")
-	for _, svc := range e.Services {
-		nameLengthMax = int(math.Max(float64(nameLengthMax), float64(len(svc.Name))))
-		typeLengthMax = int(math.Max(float64(typeLengthMax), float64(len(svc.Type))))
-	}
-	fmt.Fprintf(writer, "  %s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", typeLengthMax))
+	e.servicesHumanString(writer)
 	writer.Flush()
-	for _, svc := range e.Services {
-		fmt.Fprintf(writer, "  %s\t%s\n", svc.Name, svc.Type)
+	if len(e.Jobs) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nJobs\n\n"))
+		writer.Flush()
+		e.jobsHumanString(writer)
+		writer.Flush()
 	}
-	writer.Flush()
 	if len(e.Tags) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nTags\n\n"))
 		writer.Flush()
@@ -236,3 +383,80 @@ func (e *EnvDescription) HumanString() string {
 	writer.Flush()
 	return b.String()
 }
+
+// accessLogsHumanString returns whether the load balancer's access logs are stored, and in which bucket.
+func (e *EnvDescription) accessLogsHumanString() string {
+	if e.Environment.CustomConfig == nil || e.Environment.CustomConfig.AccessLogs == nil {
+		return "Disabled"
+	}
+	if e.AccessLogsBucket == "" {
+		return "Enabled"
+	}
+	location := "s3://" + e.AccessLogsBucket
+	if prefix := e.Environment.CustomConfig.AccessLogs.Prefix; prefix != "" {
+		location += "/" + prefix
+	}
+	return fmt.Sprintf("Enabled (%s)", location)
+}
+
+// servicesHumanString writes the table of services, along with their health if it was retrieved.
+func (e *EnvDescription) servicesHumanString(w *tabwriter.Writer) {
+	headers := []string{"Name", "Type"}
+	if e.ServicesHealth != nil {
+		headers = append(headers, "Tasks", "Targets", "Deployment")
+	}
+	rows := make([][]string, len(e.Services))
+	for i, svc := range e.Services {
+		rows[i] = []string{svc.Name, svc.Type}
+		if e.ServicesHealth != nil {
+			rows[i] = append(rows[i], e.ServicesHealth[svc.Name].cells()...)
+		}
+	}
+	dashes := make([]string, len(headers))
+	for i, header := range headers {
+		lengthMax := len(header)
+		for _, row := range rows {
+			lengthMax = int(math.Max(float64(lengthMax), float64(len(row[i]))))
+		}
+		dashes[i] = strings.Repeat("-", lengthMax)
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(dashes, "\t"))
+	w.Flush()
+	for _, row := range rows {
+		fmt.Fprintf(w, "  %s\n", strings.Join(row, "\t"))
+	}
+}
+
+// jobsHumanString writes the table of jobs.
+func (e *EnvDescription) jobsHumanString(w *tabwriter.Writer) {
+	nameLengthMax, typeLengthMax := len("Name"), len("Type")
+	for _, job := range e.Jobs {
+		nameLengthMax = int(math.Max(float64(nameLengthMax), float64(len(job.Name))))
+		typeLengthMax = int(math.Max(float64(typeLengthMax), float64(len(job.Type))))
+	}
+	fmt.Fprintf(w, "  %s\t%s\n", "Name", "Type")
+	fmt.Fprintf(w, "  %s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", typeLengthMax))
+	w.Flush()
+	for _, job := range e.Jobs {
+		fmt.Fprintf(w, "  %s\t%s\n", job.Name, job.Type)
+	}
+}
+
+// cells returns the tasks, targets and deployment columns of a service, with dashes for the missing ones.
+func (h *ServiceHealth) cells() []string {
+	tasks, targets, deployment := "-", "-", "-"
+	if h == nil {
+		return []string{tasks, targets, deployment}
+	}
+	if h.RunningCount != nil && h.DesiredCount != nil {
+		tasks = fmt.Sprintf("%d/%d running", *h.RunningCount, *h.DesiredCount)
+	}
+	if h.Targets != nil {
+		targets = fmt.Sprintf("%d/%d healthy", h.Targets.Healthy, h.Targets.Total)
+	}
+	if h.LatestDeployment != nil {
+		deployment = h.LatestDeployment.Status
+	}
+	return []string{tasks, targets, deployment}
+}
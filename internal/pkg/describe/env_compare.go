@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// ComparedEnv is an environment to compare along with its description.
+type ComparedEnv struct {
+	Env         *config.Environment
+	Description *EnvDescription // Nil if the environment couldn't be described, then only its configuration is compared.
+}
+
+// EnvDifference is a value that differs between the base and the target environments.
+type EnvDifference struct {
+	Name   string  `json:"name"`
+	Base   *string `json:"base"`   // Nil if the value only exists in the target environment.
+	Target *string `json:"target"` // Nil if the value only exists in the base environment.
+}
+
+// EnvComparison contains the differences between a base and a target environment.
+// A nil list of differences means that the values couldn't be compared.
+type EnvComparison struct {
+	Base   string `json:"base"`
+	Target string `json:"target"`
+
+	About         []*EnvDifference `json:"about"`
+	Services      []*EnvDifference `json:"services"`      // The values are the types of the services.
+	Jobs          []*EnvDifference `json:"jobs"`          // The values are the types of the jobs.
+	Tags          []*EnvDifference `json:"tags"`          // The values are the values of the tags.
+	ResourceTypes []*EnvDifference `json:"resourceTypes"` // The values are the number of resources of the type.
+}
+
+// CompareEnvs returns the differences between the base and the target environments.
+// Services, jobs and tags are only compared if both environments are described, and
+// resource types if both descriptions include resources.
+func CompareEnvs(base, target ComparedEnv) *EnvComparison {
+	cmp := &EnvComparison{
+		Base:   base.Env.Name,
+		Target: target.Env.Name,
+		About:  diffValues(aboutValues(base), aboutValues(target), aboutFields),
+	}
+	if base.Description == nil || target.Description == nil {
+		return cmp
+	}
+	cmp.Services = diffValues(workloadTypes(base.Description.Services), workloadTypes(target.Description.Services), nil)
+	cmp.Jobs = diffValues(workloadTypes(base.Description.Jobs), workloadTypes(target.Description.Jobs), nil)
+	cmp.Tags = diffValues(base.Description.Tags, target.Description.Tags, nil)
+	if len(base.Description.Resources) != 0 && len(target.Description.Resources) != 0 {
+		cmp.ResourceTypes = diffResourceTypes(base.Description.Resources, target.Description.Resources)
+	}
+	return cmp
+}
+
+// aboutFields are the fields of the "About" section of an environment, in the order that they're displayed.
+var aboutFields = []string{"Production", "Region", "Account ID", "Created By", "Last Updated By", "Access Logs"}
+
+func aboutValues(env ComparedEnv) map[string]string {
+	desc := env.Description
+	if desc == nil {
+		desc = &EnvDescription{Environment: env.Env}
+	}
+	values := map[string]string{
+		"Production":  strconv.FormatBool(env.Env.Prod),
+		"Region":      env.Env.Region,
+		"Account ID":  env.Env.AccountID,
+		"Access Logs": desc.accessLogsHumanString(),
+	}
+	// Environments stored before the CLI version was recorded don't have it.
+	if env.Env.CreatedByVersion != "" {
+		values["Created By"] = env.Env.CreatedByVersion
+	}
+	if env.Env.LastUpdatedByVersion != "" {
+		values["Last Updated By"] = env.Env.LastUpdatedByVersion
+	}
+	return values
+}
+
+func workloadTypes(wklds []*config.Workload) map[string]string {
+	types := make(map[string]string, len(wklds))
+	for _, wkld := range wklds {
+		types[wkld.Name] = wkld.Type
+	}
+	return types
+}
+
+// diffResourceTypes returns the resource types that are only present in one of the environments.
+func diffResourceTypes(base, target []*CfnResource) []*EnvDifference {
+	count := func(resources []*CfnResource) map[string]int {
+		counts := make(map[string]int)
+		for _, resource := range resources {
+			counts[resource.Type]++
+		}
+		return counts
+	}
+	baseCounts, targetCounts := count(base), count(target)
+	baseOnly, targetOnly := make(map[string]string), make(map[string]string)
+	for typ, n := range baseCounts {
+		if _, ok := targetCounts[typ]; !ok {
+			baseOnly[typ] = strconv.Itoa(n)
+		}
+	}
+	for typ, n := range targetCounts {
+		if _, ok := baseCounts[typ]; !ok {
+			targetOnly[typ] = strconv.Itoa(n)
+		}
+	}
+	return diffValues(baseOnly, targetOnly, nil)
+}
+
+// diffValues returns the values that differ between base and target, in the order of names.
+// If names is nil, the values are ordered by name.
+func diffValues(base, target map[string]string, names []string) []*EnvDifference {
+	if names == nil {
+		for name := range base {
+			names = append(names, name)
+		}
+		for name := range target {
+			if _, ok := base[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	diffs := []*EnvDifference{}
+	for _, name := range names {
+		baseVal, inBase := base[name]
+		targetVal, inTarget := target[name]
+		if inBase == inTarget && baseVal == targetVal {
+			continue
+		}
+		diff := &EnvDifference{Name: name}
+		if inBase {
+			diff.Base = &baseVal
+		}
+		if inTarget {
+			diff.Target = &targetVal
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// JSONString returns the stringified EnvComparison struct with json format.
+func (c *EnvComparison) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment comparison: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvComparison struct with human readable format.
+// Values of the base environment are marked with "-" and values of the target environment with "+".
+func (c *EnvComparison) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(&b, "Comparing environment %s (%s) to %s (%s)\n", c.Base, color.Red.Sprint("-"), c.Target, color.Green.Sprint("+"))
+	sections := []struct {
+		title string
+		diffs []*EnvDifference
+	}{
+		{"About", c.About},
+		{"Services", c.Services},
+		{"Jobs", c.Jobs},
+		{"Tags", c.Tags},
+		{"Resource Types", c.ResourceTypes},
+	}
+	for _, section := range sections {
+		if section.diffs == nil {
+			continue
+		}
+		fmt.Fprint(writer, color.Bold.Sprintf("\n%s\n\n", section.title))
+		writer.Flush()
+		if len(section.diffs) == 0 {
+			fmt.Fprintf(writer, "  No differences\n")
+			continue
+		}
+		for _, diff := range section.diffs {
+			if diff.Base != nil {
+				fmt.Fprintf(writer, "  %s %s\t%s\n", color.Red.Sprint("-"), diff.Name, color.Red.Sprint(*diff.Base))
+			}
+			if diff.Target != nil {
+				fmt.Fprintf(writer, "  %s %s\t%s\n", color.Green.Sprint("+"), diff.Name, color.Green.Sprint(*diff.Target))
+			}
+		}
+		writer.Flush()
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareEnvs(t *testing.T) {
+	staging := &config.Environment{
+		App:              "phonetool",
+		Name:             "staging",
+		Region:           "us-west-2",
+		AccountID:        "111111111111",
+		CreatedByVersion: "v1.1.0",
+	}
+	prod := &config.Environment{
+		App:              "phonetool",
+		Name:             "prod",
+		Region:           "us-east-1",
+		AccountID:        "111111111111",
+		Prod:             true,
+		CreatedByVersion: "v1.1.0",
+		CustomConfig: &config.CustomizeEnv{
+			AccessLogs: &config.AccessLogs{},
+		},
+	}
+	stagingDesc := &EnvDescription{
+		Environment: staging,
+		Services: []*config.Workload{
+			{Name: "api", Type: "Load Balanced Web Service"},
+			{Name: "worker", Type: "Backend Service"},
+		},
+		Tags: map[string]string{"copilot-environment": "staging", "owner": "platform"},
+	}
+	prodDesc := &EnvDescription{
+		Environment: prod,
+		Services: []*config.Workload{
+			{Name: "api", Type: "Load Balanced Web Service"},
+			{Name: "worker", Type: "Load Balanced Web Service"},
+			{Name: "frontend", Type: "Load Balanced Web Service"},
+		},
+		Jobs: []*config.Workload{
+			{Name: "report", Type: "Scheduled Job"},
+		},
+		Tags:             map[string]string{"copilot-environment": "prod", "owner": "platform"},
+		AccessLogsBucket: "logs",
+	}
+	wantedAbout := []*EnvDifference{
+		{Name: "Production", Base: aws.String("false"), Target: aws.String("true")},
+		{Name: "Region", Base: aws.String("us-west-2"), Target: aws.String("us-east-1")},
+		{Name: "Access Logs", Base: aws.String("Disabled"), Target: aws.String("Enabled (s3://logs)")},
+	}
+
+	testCases := map[string]struct {
+		base   ComparedEnv
+		target ComparedEnv
+
+		wanted *EnvComparison
+	}{
+		"compares the services, jobs and tags of described environments": {
+			base:   ComparedEnv{Env: staging, Description: stagingDesc},
+			target: ComparedEnv{Env: prod, Description: prodDesc},
+
+			wanted: &EnvComparison{
+				Base:   "staging",
+				Target: "prod",
+				About:  wantedAbout,
+				Services: []*EnvDifference{
+					{Name: "frontend", Target: aws.String("Load Balanced Web Service")},
+					{Name: "worker", Base: aws.String("Backend Service"), Target: aws.String("Load Balanced Web Service")},
+				},
+				Jobs: []*EnvDifference{
+					{Name: "report", Target: aws.String("Scheduled Job")},
+				},
+				Tags: []*EnvDifference{
+					{Name: "copilot-environment", Base: aws.String("staging"), Target: aws.String("prod")},
+				},
+			},
+		},
+		"compares only the configuration if an environment isn't described": {
+			base:   ComparedEnv{Env: staging, Description: stagingDesc},
+			target: ComparedEnv{Env: prod},
+
+			wanted: &EnvComparison{
+				Base:   "staging",
+				Target: "prod",
+				About: []*EnvDifference{
+					{Name: "Production", Base: aws.String("false"), Target: aws.String("true")},
+					{Name: "Region", Base: aws.String("us-west-2"), Target: aws.String("us-east-1")},
+					{Name: "Access Logs", Base: aws.String("Disabled"), Target: aws.String("Enabled")},
+				},
+			},
+		},
+		"compares the resource types present in only one environment": {
+			base: ComparedEnv{Env: staging, Description: &EnvDescription{
+				Environment: staging,
+				Resources: []*CfnResource{
+					{Type: "AWS::ECS::Cluster", PhysicalID: "cluster"},
+					{Type: "AWS::EC2::Subnet", PhysicalID: "subnet-1"},
+				},
+			}},
+			target: ComparedEnv{Env: staging, Description: &EnvDescription{
+				Environment: staging,
+				Resources: []*CfnResource{
+					{Type: "AWS::ECS::Cluster", PhysicalID: "cluster"},
+					{Type: "AWS::EC2::NatGateway", PhysicalID: "nat-1"},
+					{Type: "AWS::EC2::NatGateway", PhysicalID: "nat-2"},
+				},
+			}},
+
+			wanted: &EnvComparison{
+				Base:     "staging",
+				Target:   "staging",
+				About:    []*EnvDifference{},
+				Services: []*EnvDifference{},
+				Jobs:     []*EnvDifference{},
+				Tags:     []*EnvDifference{},
+				ResourceTypes: []*EnvDifference{
+					{Name: "AWS::EC2::NatGateway", Target: aws.String("2")},
+					{Name: "AWS::EC2::Subnet", Base: aws.String("1")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, CompareEnvs(tc.base, tc.target))
+		})
+	}
+}
+
+func TestEnvComparison_String(t *testing.T) {
+	testCases := map[string]struct {
+		cmp *EnvComparison
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"only the configuration is compared": {
+			cmp: &EnvComparison{
+				Base:   "staging",
+				Target: "prod",
+				About:  []*EnvDifference{},
+			},
+			wantedHumanString: `Comparing environment staging (-) to prod (+)
+
+About
+
+  No differences
+`,
+			wantedJSONString: "{\"base\":\"staging\",\"target\":\"prod\",\"about\":[],\"services\":null,\"jobs\":null,\"tags\":null,\"resourceTypes\":null}\n",
+		},
+		"with differences": {
+			cmp: &EnvComparison{
+				Base:   "staging",
+				Target: "prod",
+				About: []*EnvDifference{
+					{Name: "Region", Base: aws.String("us-west-2"), Target: aws.String("us-east-1")},
+				},
+				Services: []*EnvDifference{
+					{Name: "frontend", Target: aws.String("Load Balanced Web Service")},
+					{Name: "worker", Base: aws.String("Backend Service"), Target: aws.String("Load Balanced Web Service")},
+				},
+				Jobs: []*EnvDifference{},
+				Tags: []*EnvDifference{
+					{Name: "copilot-environment", Base: aws.String("staging"), Target: aws.String("prod")},
+				},
+			},
+			wantedHumanString: `Comparing environment staging (-) to prod (+)
+
+About
+
+  - Region          us-west-2
+  + Region          us-east-1
+
+Services
+
+  + frontend        Load Balanced Web Service
+  - worker          Backend Service
+  + worker          Load Balanced Web Service
+
+Jobs
+
+  No differences
+
+Tags
+
+  - copilot-environment  staging
+  + copilot-environment  prod
+`,
+			wantedJSONString: "{\"base\":\"staging\",\"target\":\"prod\",\"about\":[{\"name\":\"Region\",\"base\":\"us-west-2\",\"target\":\"us-east-1\"}],\"services\":[{\"name\":\"frontend\",\"base\":null,\"target\":\"Load Balanced Web Service\"},{\"name\":\"worker\",\"base\":\"Backend Service\",\"target\":\"Load Balanced Web Service\"}],\"jobs\":[],\"tags\":[{\"name\":\"copilot-environment\",\"base\":\"staging\",\"target\":\"prod\"}],\"resourceTypes\":null}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.cmp.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, tc.cmp.HumanString())
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -61,6 +62,16 @@ func TestEnvDescriber_Describe(t *testing.T) {
 		Name: "testSvc3",
 		Type: "load-balanced",
 	}
+	testJob1 := &config.Workload{
+		App:  "testApp",
+		Name: "testJob1",
+		Type: "Scheduled Job",
+	}
+	testJob2 := &config.Workload{
+		App:  "testApp",
+		Name: "testJob2",
+		Type: "Scheduled Job",
+	}
 	stackTags := []*cloudformation.Tag{
 		{
 			Key:   aws.String("copilot-application"),
@@ -110,6 +121,36 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("list deployed services in env testEnv: some error"),
 		},
+		"error if fail to list all jobs": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("list jobs for app testApp: some error"),
+		},
+		"error if fail to list deployed jobs": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return([]*config.Workload{
+						testJob1, testJob2,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).
+						Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("list deployed jobs in env testEnv: some error"),
+		},
 		"error if fail to get env tags": {
 			setupMocks: func(m envDescriberMocks) {
 				gomock.InOrder(
@@ -118,6 +159,11 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
 						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return([]*config.Workload{
+						testJob1, testJob2,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).
+						Return([]string{"testJob1"}, nil),
 					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(nil, mockError),
 				)
 			},
@@ -132,6 +178,11 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
 						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return([]*config.Workload{
+						testJob1, testJob2,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).
+						Return([]string{"testJob1"}, nil),
 					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
 						Tags: stackTags,
 					}, nil),
@@ -149,6 +200,11 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
 						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return([]*config.Workload{
+						testJob1, testJob2,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).
+						Return([]string{"testJob1"}, nil),
 					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
 						Tags: stackTags,
 					}, nil),
@@ -157,6 +213,7 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			wantedEnv: &EnvDescription{
 				Environment: testEnv,
 				Services:    envSvcs,
+				Jobs:        []*config.Workload{testJob1},
 				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
 			},
 		},
@@ -169,6 +226,11 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
 						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return([]*config.Workload{
+						testJob1, testJob2,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).
+						Return([]string{"testJob1"}, nil),
 					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
 						Tags: stackTags,
 					}, nil),
@@ -181,6 +243,7 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			wantedEnv: &EnvDescription{
 				Environment: testEnv,
 				Services:    envSvcs,
+				Jobs:        []*config.Workload{testJob1},
 				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
 				Resources:   wantedResources,
 			},
@@ -283,6 +346,47 @@ func TestEnvDescriber_Version(t *testing.T) {
 	}
 }
 
+func TestEnvDescriber_accessLogsBucket(t *testing.T) {
+	testCases := map[string]struct {
+		inCustomConfig *config.CustomizeEnv
+		inOutputs      []*cloudformation.Output
+
+		wanted string
+	}{
+		"returns an empty string if access logs are disabled": {
+			inCustomConfig: &config.CustomizeEnv{EnableIPv6: true},
+			inOutputs: []*cloudformation.Output{
+				{OutputKey: aws.String("IPv6Enabled"), OutputValue: aws.String("true")},
+			},
+		},
+		"returns the bucket from the stack outputs": {
+			inCustomConfig: &config.CustomizeEnv{AccessLogs: &config.AccessLogs{}},
+			inOutputs: []*cloudformation.Output{
+				{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1234")},
+				{OutputKey: aws.String("AccessLogsBucket"), OutputValue: aws.String("testapp-testenv-elbaccesslogsbucket-1a2b3c")},
+			},
+			wanted: "testapp-testenv-elbaccesslogsbucket-1a2b3c",
+		},
+		"falls back to the configured bucket if the stack doesn't have the output": {
+			inCustomConfig: &config.CustomizeEnv{AccessLogs: &config.AccessLogs{BucketName: "my-logs"}},
+			wanted:         "my-logs",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := &EnvDescriber{
+				app: "testApp",
+				env: &config.Environment{Name: "testEnv", CustomConfig: tc.inCustomConfig},
+			}
+
+			got := d.accessLogsBucket(&cloudformation.Stack{Outputs: tc.inOutputs})
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestEnvDescription_JSONString(t *testing.T) {
 	testApp := &config.Application{
 		Name: "testApp",
@@ -298,6 +402,9 @@ func TestEnvDescription_JSONString(t *testing.T) {
 		ExecutionRoleARN: "",
 		ManagerRoleARN:   "",
 		CustomConfig:     &config.CustomizeEnv{},
+
+		CreatedByVersion:     "v1.2.0",
+		LastUpdatedByVersion: "v1.3.0",
 	}
 	testSvc1 := &config.Workload{
 		App:  "testApp",
@@ -315,7 +422,12 @@ func TestEnvDescription_JSONString(t *testing.T) {
 		Type: "load-balanced",
 	}
 	allSvcs := []*config.Workload{testSvc1, testSvc2, testSvc3}
-	wantedContent := "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"customConfig\":{}},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}]}\n"
+	testJob := &config.Workload{
+		App:  "testApp",
+		Name: "testJob",
+		Type: "Scheduled Job",
+	}
+	wantedContent := "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"customConfig\":{},\"createdByVersion\":\"v1.2.0\",\"lastUpdatedByVersion\":\"v1.3.0\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"jobs\":[{\"app\":\"testApp\",\"name\":\"testJob\",\"type\":\"Scheduled Job\"}],\"tags\":{\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}]}\n"
 
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -324,6 +436,7 @@ func TestEnvDescription_JSONString(t *testing.T) {
 	d := &EnvDescription{
 		Environment: testEnv,
 		Services:    allSvcs,
+		Jobs:        []*config.Workload{testJob},
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
 	}
@@ -349,6 +462,12 @@ func TestEnvDescription_HumanString(t *testing.T) {
 		RegistryURL:      "",
 		ExecutionRoleARN: "",
 		ManagerRoleARN:   "",
+		CustomConfig: &config.CustomizeEnv{
+			AccessLogs: &config.AccessLogs{Prefix: "alb"},
+		},
+
+		CreatedByVersion:     "v1.2.0",
+		LastUpdatedByVersion: "v1.3.0",
 	}
 	testSvc1 := &config.Workload{
 		App:  "testApp",
@@ -366,6 +485,11 @@ func TestEnvDescription_HumanString(t *testing.T) {
 		Type: "load-balanced",
 	}
 	allSvcs := []*config.Workload{testSvc1, testSvc2, testSvc3}
+	testJob := &config.Workload{
+		App:  "testApp",
+		Name: "testJob",
+		Type: "Scheduled Job",
+	}
 
 	wantedContent := `About
 
@@ -373,6 +497,9 @@ func TestEnvDescription_HumanString(t *testing.T) {
   Production        false
   Region            us-west-2
   Account ID        123456789012
+  Created By        v1.2.0
+  Last Updated By   v1.3.0
+  Access Logs       Enabled (s3://mockBucket/alb)
 
 Services
 
@@ -382,6 +509,12 @@ Services
   testSvc2          load-balanced
   testSvc3          load-balanced
 
+Jobs
+
+  Name              Type
+  -------           -------------
+  testJob           Scheduled Job
+
 Tags
 
   Key               Value
@@ -401,8 +534,11 @@ Resources
 	d := &EnvDescription{
 		Environment: testEnv,
 		Services:    allSvcs,
+		Jobs:        []*config.Workload{testJob},
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
+
+		AccessLogsBucket: "mockBucket",
 	}
 
 	// WHEN
@@ -411,3 +547,148 @@ Resources
 	// THEN
 	require.Equal(t, wantedContent, actual)
 }
+
+type fakeServiceHealth struct {
+	health  *ServiceHealth
+	err     error
+	release chan struct{} // Optional. Blocks the retrieval of the health until closed.
+}
+
+func (f *fakeServiceHealth) Health() (*ServiceHealth, error) {
+	if f.release != nil {
+		<-f.release
+	}
+	return f.health, f.err
+}
+
+func TestEnvDescriber_servicesHealth(t *testing.T) {
+	svcs := []*config.Workload{
+		{App: "testApp", Name: "frontend", Type: "Load Balanced Web Service"},
+		{App: "testApp", Name: "api", Type: "Backend Service"},
+	}
+	frontendHealth := &ServiceHealth{
+		RunningCount: aws.Int64(2),
+		DesiredCount: aws.Int64(2),
+		Targets: &TargetsHealth{
+			Healthy: 2,
+			Total:   2,
+		},
+		LatestDeployment: &DeploymentRollout{
+			Status: DeploymentRolloutCompleted,
+		},
+	}
+	testCases := map[string]struct {
+		timeout    time.Duration
+		describers map[string]*fakeServiceHealth
+
+		wanted map[string]*ServiceHealth
+	}{
+		"leaves the fields empty for services whose health can't be retrieved": {
+			describers: map[string]*fakeServiceHealth{
+				"frontend": {health: frontendHealth},
+				"api":      {err: errors.New("some error")},
+			},
+			wanted: map[string]*ServiceHealth{
+				"frontend": frontendHealth,
+				"api":      {},
+			},
+		},
+		"leaves the fields empty for services whose health isn't retrieved before the timeout": {
+			timeout: 10 * time.Millisecond,
+			describers: map[string]*fakeServiceHealth{
+				"frontend": {health: frontendHealth, release: make(chan struct{})},
+				"api":      {health: frontendHealth, release: make(chan struct{})},
+			},
+			wanted: map[string]*ServiceHealth{
+				"frontend": {},
+				"api":      {},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			for _, describer := range tc.describers {
+				if describer.release != nil {
+					defer close(describer.release)
+				}
+			}
+			d := &EnvDescriber{
+				servicesHealthTimeout: tc.timeout,
+				svcHealth: func(svc string) serviceHealthDescriber {
+					return tc.describers[svc]
+				},
+			}
+
+			// WHEN
+			got := d.servicesHealth(svcs)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEnvDescription_ServicesHealth(t *testing.T) {
+	d := &EnvDescription{
+		Environment: &config.Environment{
+			App:       "testApp",
+			Name:      "testEnv",
+			Region:    "us-west-2",
+			AccountID: "123456789012",
+		},
+		Services: []*config.Workload{
+			{App: "testApp", Name: "testSvc1", Type: "load-balanced"},
+			{App: "testApp", Name: "testSvc2", Type: "load-balanced"},
+			{App: "testApp", Name: "testSvc3", Type: "load-balanced"},
+		},
+		ServicesHealth: map[string]*ServiceHealth{
+			"testSvc1": {
+				RunningCount: aws.Int64(2),
+				DesiredCount: aws.Int64(2),
+				Targets: &TargetsHealth{
+					Healthy: 2,
+					Total:   2,
+				},
+				LatestDeployment: &DeploymentRollout{
+					Status:    DeploymentRolloutCompleted,
+					UpdatedAt: time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC),
+				},
+			},
+			"testSvc2": {},
+			"testSvc3": {
+				RunningCount: aws.Int64(1),
+				DesiredCount: aws.Int64(2),
+				LatestDeployment: &DeploymentRollout{
+					Status:    DeploymentRolloutInProgress,
+					UpdatedAt: time.Date(2021, time.March, 1, 13, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+	wantedHuman := `About
+
+  Name              testEnv
+  Production        false
+  Region            us-west-2
+  Account ID        123456789012
+  Access Logs       Disabled
+
+Services
+
+  Name              Type                Tasks               Targets             Deployment
+  --------          -------------       -----------         -----------         -----------
+  testSvc1          load-balanced       2/2 running         2/2 healthy         COMPLETED
+  testSvc2          load-balanced       -                   -                   -
+  testSvc3          load-balanced       1/2 running         -                   IN_PROGRESS
+`
+	wantedJSON := "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"servicesHealth\":{\"testSvc1\":{\"runningCount\":2,\"desiredCount\":2,\"targets\":{\"healthy\":2,\"total\":2},\"latestDeployment\":{\"status\":\"COMPLETED\",\"updatedAt\":\"2021-03-01T12:00:00Z\"}},\"testSvc2\":{\"runningCount\":null,\"desiredCount\":null,\"targets\":null,\"latestDeployment\":null},\"testSvc3\":{\"runningCount\":1,\"desiredCount\":2,\"targets\":null,\"latestDeployment\":{\"status\":\"IN_PROGRESS\",\"updatedAt\":\"2021-03-01T13:00:00Z\"}}}}\n"
+
+	human := d.HumanString()
+	json, err := d.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, wantedHuman, human)
+	require.Equal(t, wantedJSON, json)
+}
@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// stateMachineLogicalID is the logical ID of the state machine that runs a job.
+const stateMachineLogicalID = "StateMachine"
+
+type executionsDescriber interface {
+	Executions(stateMachineARN, status string, maxResults int) ([]*stepfunctions.Execution, error)
+	ExecutionFailure(executionARN string) (*stepfunctions.ExecutionFailure, error)
+}
+
+// JobExecution contains the status of an execution of a job.
+type JobExecution struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// JobHistory contains the most recent executions of a job, newest first.
+type JobHistory struct {
+	Executions []*JobExecution `json:"executions"`
+}
+
+// JobHistoryDescriber retrieves the executions of a job deployed to an environment.
+type JobHistoryDescriber struct {
+	app string
+	env string
+	job string
+
+	stackDescriber stackAndResourcesDescriber
+	sfnClient      executionsDescriber
+}
+
+// NewJobHistoryConfig contains fields that initiate a JobHistoryDescriber struct.
+type NewJobHistoryConfig struct {
+	App         string
+	Env         string
+	Job         string
+	ConfigStore ConfigStoreSvc
+}
+
+// NewJobHistoryDescriber instantiates a describer for the executions of a job in an environment.
+func NewJobHistoryDescriber(opt NewJobHistoryConfig) (*JobHistoryDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &JobHistoryDescriber{
+		app:            opt.App,
+		env:            opt.Env,
+		job:            opt.Job,
+		stackDescriber: newStackDescriber(sess),
+		sfnClient:      stepfunctions.New(sess),
+	}, nil
+}
+
+// History returns up to limit of the most recent executions of the job, newest first.
+// If status is not empty, only the executions with that status, like "FAILED", are returned.
+func (d *JobHistoryDescriber) History(limit int, status string) (*JobHistory, error) {
+	stateMachineARN, err := d.stateMachineARN()
+	if err != nil {
+		return nil, err
+	}
+	executions, err := d.sfnClient.Executions(stateMachineARN, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	history := &JobHistory{
+		Executions: make([]*JobExecution, 0, len(executions)),
+	}
+	for _, execution := range executions {
+		jobExecution := &JobExecution{
+			Name:      execution.Name,
+			Status:    execution.Status,
+			StartedAt: execution.StartDate,
+			StoppedAt: execution.StopDate,
+		}
+		if execution.Status != "RUNNING" && execution.Status != "SUCCEEDED" {
+			failure, err := d.sfnClient.ExecutionFailure(execution.ARN)
+			if err != nil {
+				return nil, err
+			}
+			jobExecution.Error = failureMessage(failure)
+		}
+		history.Executions = append(history.Executions, jobExecution)
+	}
+	return history, nil
+}
+
+// stateMachineARN returns the ARN of the state machine that runs the job from the outputs of its stack.
+// Jobs deployed with an older template don't have the output, so the state machine is looked up in the stack resources instead.
+func (d *JobHistoryDescriber) stateMachineARN() (string, error) {
+	stackName := stack.NameForService(d.app, d.env, d.job)
+	jobStack, err := d.stackDescriber.Stack(stackName)
+	if err != nil {
+		return "", fmt.Errorf("retrieve job stack: %w", err)
+	}
+	for _, out := range jobStack.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.ScheduledJobStateMachineARNOutputKey {
+			return aws.StringValue(out.OutputValue), nil
+		}
+	}
+	resources, err := d.stackDescriber.StackResources(stackName)
+	if err != nil {
+		return "", fmt.Errorf("retrieve job stack resources: %w", err)
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == stateMachineLogicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("state machine of job %s not found in stack %s", d.job, stackName)
+}
+
+// failureMessage returns the error of a failed execution. When the task of the job fails,
+// the cause is the ECS task that stopped, so its stopped reason is added to the error.
+func failureMessage(failure *stepfunctions.ExecutionFailure) string {
+	if failure == nil {
+		return ""
+	}
+	var task struct {
+		StoppedReason string
+	}
+	if err := json.Unmarshal([]byte(failure.Cause), &task); err == nil && task.StoppedReason != "" {
+		return fmt.Sprintf("%s: %s", failure.Error, task.StoppedReason)
+	}
+	if failure.Cause != "" && failure.Error != "" {
+		return fmt.Sprintf("%s: %s", failure.Error, failure.Cause)
+	}
+	return failure.Error + failure.Cause
+}
+
+// JSONString returns the stringified JobHistory struct in json format.
+func (h *JobHistory) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal job history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified JobHistory struct in human readable format.
+func (h *JobHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Executions\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "Name", "Status", "Started At", "Duration", "Error")
+	for _, execution := range h.Executions {
+		duration := "-"
+		if execution.StoppedAt != nil {
+			duration = execution.StoppedAt.Sub(execution.StartedAt).Round(time.Second).String()
+		}
+		errMsg := execution.Error
+		if errMsg == "" {
+			errMsg = "-"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", execution.Name, executionStatusColor(execution.Status),
+			humanizeTime(execution.StartedAt), duration, errMsg)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func executionStatusColor(status string) string {
+	switch status {
+	case "SUCCEEDED":
+		return color.Green.Sprint(status)
+	case "RUNNING":
+		return status
+	default:
+		return color.Red.Sprint(status)
+	}
+}
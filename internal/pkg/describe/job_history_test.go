@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobHistoryMocks struct {
+	stackDescriber *mocks.MockstackAndResourcesDescriber
+	sfnClient      *mocks.MockexecutionsDescriber
+}
+
+func TestJobHistoryDescriber_History(t *testing.T) {
+	const (
+		mockStackName       = "phonetool-test-report"
+		mockStateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report"
+	)
+	startDate := time.Date(2020, time.November, 23, 0, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(90 * time.Second)
+	stackWithOutput := &cloudformation.Stack{
+		Outputs: []*cloudformation.Output{
+			{
+				OutputKey:   aws.String("StateMachineArn"),
+				OutputValue: aws.String(mockStateMachineARN),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inStatus   string
+		setupMocks func(m jobHistoryMocks)
+
+		wantedHistory *JobHistory
+		wantedError   error
+	}{
+		"returns error if fail to describe the job stack": {
+			setupMocks: func(m jobHistoryMocks) {
+				m.stackDescriber.EXPECT().Stack(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("retrieve job stack: some error"),
+		},
+		"returns error if the state machine cannot be found": {
+			setupMocks: func(m jobHistoryMocks) {
+				m.stackDescriber.EXPECT().Stack(mockStackName).Return(&cloudformation.Stack{}, nil)
+				m.stackDescriber.EXPECT().StackResources(mockStackName).Return([]*cloudformation.StackResource{}, nil)
+			},
+			wantedError: fmt.Errorf("state machine of job report not found in stack phonetool-test-report"),
+		},
+		"returns an empty history if the job never ran": {
+			setupMocks: func(m jobHistoryMocks) {
+				m.stackDescriber.EXPECT().Stack(mockStackName).Return(stackWithOutput, nil)
+				m.sfnClient.EXPECT().Executions(mockStateMachineARN, "", 10).Return(nil, nil)
+			},
+			wantedHistory: &JobHistory{
+				Executions: []*JobExecution{},
+			},
+		},
+		"looks up the state machine in the resources of a stack deployed with an older template": {
+			inStatus: "SUCCEEDED",
+			setupMocks: func(m jobHistoryMocks) {
+				m.stackDescriber.EXPECT().Stack(mockStackName).Return(&cloudformation.Stack{}, nil)
+				m.stackDescriber.EXPECT().StackResources(mockStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("StateMachine"),
+						PhysicalResourceId: aws.String(mockStateMachineARN),
+					},
+				}, nil)
+				m.sfnClient.EXPECT().Executions(mockStateMachineARN, "SUCCEEDED", 10).Return([]*stepfunctions.Execution{
+					{
+						Name:      "exec-1",
+						ARN:       "arn-1",
+						Status:    "SUCCEEDED",
+						StartDate: startDate,
+						StopDate:  aws.Time(stopDate),
+					},
+				}, nil)
+			},
+			wantedHistory: &JobHistory{
+				Executions: []*JobExecution{
+					{
+						Name:      "exec-1",
+						Status:    "SUCCEEDED",
+						StartedAt: startDate,
+						StoppedAt: aws.Time(stopDate),
+					},
+				},
+			},
+		},
+		"adds the error of the failed executions": {
+			setupMocks: func(m jobHistoryMocks) {
+				m.stackDescriber.EXPECT().Stack(mockStackName).Return(stackWithOutput, nil)
+				m.sfnClient.EXPECT().Executions(mockStateMachineARN, "", 10).Return([]*stepfunctions.Execution{
+					{
+						Name:      "exec-2",
+						ARN:       "arn-2",
+						Status:    "RUNNING",
+						StartDate: startDate,
+					},
+					{
+						Name:      "exec-1",
+						ARN:       "arn-1",
+						Status:    "FAILED",
+						StartDate: startDate,
+						StopDate:  aws.Time(stopDate),
+					},
+				}, nil)
+				m.sfnClient.EXPECT().ExecutionFailure("arn-1").Return(&stepfunctions.ExecutionFailure{
+					Error: "States.TaskFailed",
+					Cause: `{"StoppedReason":"Essential container in task exited"}`,
+				}, nil)
+			},
+			wantedHistory: &JobHistory{
+				Executions: []*JobExecution{
+					{
+						Name:      "exec-2",
+						Status:    "RUNNING",
+						StartedAt: startDate,
+					},
+					{
+						Name:      "exec-1",
+						Status:    "FAILED",
+						StartedAt: startDate,
+						StoppedAt: aws.Time(stopDate),
+						Error:     "States.TaskFailed: Essential container in task exited",
+					},
+				},
+			},
+		},
+		"returns error if fail to get the error of a failed execution": {
+			setupMocks: func(m jobHistoryMocks) {
+				m.stackDescriber.EXPECT().Stack(mockStackName).Return(stackWithOutput, nil)
+				m.sfnClient.EXPECT().Executions(mockStateMachineARN, "", 10).Return([]*stepfunctions.Execution{
+					{
+						Name:   "exec-1",
+						ARN:    "arn-1",
+						Status: "TIMED_OUT",
+					},
+				}, nil)
+				m.sfnClient.EXPECT().ExecutionFailure("arn-1").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobHistoryMocks{
+				stackDescriber: mocks.NewMockstackAndResourcesDescriber(ctrl),
+				sfnClient:      mocks.NewMockexecutionsDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &JobHistoryDescriber{
+				app:            "phonetool",
+				env:            "test",
+				job:            "report",
+				stackDescriber: m.stackDescriber,
+				sfnClient:      m.sfnClient,
+			}
+
+			// WHEN
+			history, err := d.History(10, tc.inStatus)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHistory, history)
+		})
+	}
+}
+
+func TestJobHistory_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-11-23T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startDate := time.Date(2020, time.November, 23, 0, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(90 * time.Second)
+	testCases := map[string]struct {
+		history *JobHistory
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no executions": {
+			history: &JobHistory{
+				Executions: []*JobExecution{},
+			},
+			wantedHumanString: `Executions
+
+  Name              Status              Started At          Duration            Error
+`,
+			wantedJSONString: "{\"executions\":[]}\n",
+		},
+		"with executions": {
+			history: &JobHistory{
+				Executions: []*JobExecution{
+					{
+						Name:      "exec-2",
+						Status:    "RUNNING",
+						StartedAt: startDate,
+					},
+					{
+						Name:      "exec-1",
+						Status:    "FAILED",
+						StartedAt: startDate,
+						StoppedAt: aws.Time(stopDate),
+						Error:     "States.TaskFailed: Essential container in task exited",
+					},
+				},
+			},
+			wantedHumanString: `Executions
+
+  Name              Status              Started At          Duration            Error
+  exec-2            RUNNING             12 hours ago        -                   -
+  exec-1            FAILED              12 hours ago        1m30s               States.TaskFailed: Essential container in task exited
+`,
+			wantedJSONString: "{\"executions\":[{\"name\":\"exec-2\",\"status\":\"RUNNING\",\"startedAt\":\"2020-11-23T00:00:00Z\"},{\"name\":\"exec-1\",\"status\":\"FAILED\",\"startedAt\":\"2020-11-23T00:00:00Z\",\"stoppedAt\":\"2020-11-23T00:01:30Z\",\"error\":\"States.TaskFailed: Essential container in task exited\"}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.history.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, tc.history.HumanString())
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// ruleLogicalID is the logical ID of the EventBridge rule that triggers a job.
+const ruleLogicalID = "Rule"
+
+// JobScheduleDescriber retrieves the rule that triggers a job deployed to an environment.
+type JobScheduleDescriber struct {
+	app string
+	env string
+	job string
+
+	stackDescriber stackAndResourcesDescriber
+}
+
+// NewJobScheduleConfig contains fields that initiate a JobScheduleDescriber struct.
+type NewJobScheduleConfig struct {
+	App         string
+	Env         string
+	Job         string
+	ConfigStore ConfigStoreSvc
+}
+
+// NewJobScheduleDescriber instantiates a describer for the rule of a job in an environment.
+func NewJobScheduleDescriber(opt NewJobScheduleConfig) (*JobScheduleDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &JobScheduleDescriber{
+		app:            opt.App,
+		env:            opt.Env,
+		job:            opt.Job,
+		stackDescriber: newStackDescriber(sess),
+	}, nil
+}
+
+// RuleName returns the name of the EventBridge rule that triggers the job, from the resources of its stack.
+func (d *JobScheduleDescriber) RuleName() (string, error) {
+	stackName := stack.NameForService(d.app, d.env, d.job)
+	resources, err := d.stackDescriber.StackResources(stackName)
+	if err != nil {
+		return "", fmt.Errorf("retrieve job stack resources: %w", err)
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == ruleLogicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("rule of job %s not found in stack %s", d.job, stackName)
+}
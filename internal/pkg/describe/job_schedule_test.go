@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobScheduleDescriber_RuleName(t *testing.T) {
+	const mockStackName = "phonetool-test-report"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackAndResourcesDescriber)
+
+		wantedRule  string
+		wantedError error
+	}{
+		"wraps the error from describing the stack resources": {
+			setupMocks: func(m *mocks.MockstackAndResourcesDescriber) {
+				m.EXPECT().StackResources(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("retrieve job stack resources: some error"),
+		},
+		"error if the stack has no rule": {
+			setupMocks: func(m *mocks.MockstackAndResourcesDescriber) {
+				m.EXPECT().StackResources(mockStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report"),
+					},
+				}, nil)
+			},
+			wantedError: fmt.Errorf("rule of job report not found in stack phonetool-test-report"),
+		},
+		"returns the name of the rule": {
+			setupMocks: func(m *mocks.MockstackAndResourcesDescriber) {
+				m.EXPECT().StackResources(mockStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report"),
+					},
+					{
+						LogicalResourceId:  aws.String("Rule"),
+						PhysicalResourceId: aws.String("phonetool-test-report-Rule-1A2B3C"),
+					},
+				}, nil)
+			},
+			wantedRule: "phonetool-test-report-Rule-1A2B3C",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(m)
+			d := &JobScheduleDescriber{
+				app:            "phonetool",
+				env:            "test",
+				job:            "report",
+				stackDescriber: m,
+			}
+
+			// WHEN
+			rule, err := d.RuleName()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRule, rule)
+		})
+	}
+}
@@ -50,6 +50,14 @@ func (uri *WebServiceURI) String() string {
 	}
 }
 
+// additionalRouteURL returns the URL of a path that's routed to the service by an additional routing rule.
+func (uri *WebServiceURI) additionalRouteURL(path string) string {
+	if uri.Path == "" {
+		return fmt.Sprintf("https://%s/%s", uri.DNSName, path)
+	}
+	return fmt.Sprintf("http://%s/%s", uri.DNSName, path)
+}
+
 type serviceDiscovery struct {
 	Service string
 	App     string
@@ -63,8 +71,13 @@ func (s *serviceDiscovery) String() string {
 type svcDescriber interface {
 	Params() (map[string]string, error)
 	EnvOutputs() (map[string]string, error)
+	StackOutputs() (map[string]string, error)
 	EnvVars() (map[string]string, error)
 	ServiceStackResources() ([]*cloudformation.StackResource, error)
+	Region() string
+	ImageRetention() (int, error)
+	ImageCount() (int, error)
+	IsCrossAccount() bool
 }
 
 // WebServiceDescriber retrieves information about a load balanced web service.
@@ -74,6 +87,7 @@ type WebServiceDescriber struct {
 	enableResources bool
 
 	store                DeployedEnvServicesLister
+	ws                   WorkspaceManifestReader
 	svcDescriber         map[string]svcDescriber
 	initServiceDescriber func(string) error
 
@@ -86,6 +100,7 @@ type NewWebServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
 	DeployStore     DeployedEnvServicesLister
+	Workspace       WorkspaceManifestReader // Optional. Used to describe the configuration of a service that's not deployed yet.
 }
 
 // NewWebServiceDescriber instantiates a load balanced service describer.
@@ -95,6 +110,7 @@ func NewWebServiceDescriber(opt NewWebServiceConfig) (*WebServiceDescriber, erro
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
 		store:           opt.DeployStore,
+		ws:              opt.Workspace,
 		svcDescriber:    make(map[string]svcDescriber),
 	}
 	describer.initServiceDescriber = func(env string) error {
@@ -122,24 +138,55 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
 	}
+	if len(environments) == 0 {
+		configs, err := undeployedConfigs(d.ws, d.svc)
+		if err != nil {
+			return nil, fmt.Errorf("describe configuration of service %s from its manifest: %w", d.svc, err)
+		}
+		return &webSvcDesc{
+			Service:        d.svc,
+			Type:           manifest.LoadBalancedWebServiceType,
+			App:            d.app,
+			Configurations: configs,
+		}, nil
+	}
 
 	var routes []*WebServiceRoute
 	var configs []*ServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
+	var serviceConnects []*ServiceConnect
+	var messagingRes []*MessagingResource
 	var envVars []*EnvVars
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
 			return nil, err
 		}
-		webServiceURI, err := d.URI(env)
+		webServiceURI, err := d.uri(env)
 		if err != nil {
 			return nil, fmt.Errorf("retrieve service URI: %w", err)
 		}
 		routes = append(routes, &WebServiceRoute{
 			Environment: env,
-			URL:         webServiceURI,
+			URL:         webServiceURI.String(),
 		})
+		for _, path := range additionalRulePaths(d.svcParams) {
+			routes = append(routes, &WebServiceRoute{
+				Environment: env,
+				URL:         webServiceURI.additionalRouteURL(path),
+			})
+		}
+		svcOutputs, err := d.svcDescriber[env].StackOutputs()
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of service %s in environment %s: %w", d.svc, env, err)
+		}
+		// Only services behind an HTTPS listener can have aliases.
+		for _, alias := range aliases(svcOutputs) {
+			routes = append(routes, &WebServiceRoute{
+				Environment: env,
+				URL:         fmt.Sprintf("https://%s", alias),
+			})
+		}
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        d.svcParams[stack.LBWebServiceContainerPortParamKey],
@@ -152,6 +199,14 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Port:    d.svcParams[stack.LBWebServiceContainerPortParamKey],
 			App:     d.app,
 		}, env)
+		if endpoint, ok := svcOutputs[stack.WorkloadServiceConnectEndpointOutputKey]; ok {
+			serviceConnects = appendServiceConnect(serviceConnects, endpoint, env)
+		}
+		res, err := messaging(env, svcOutputs)
+		if err != nil {
+			return nil, fmt.Errorf("get messaging resources of service %s in environment %s: %w", d.svc, env, err)
+		}
+		messagingRes = append(messagingRes, res...)
 		webSvcEnvVars, err := d.svcDescriber[env].EnvVars()
 		if err != nil {
 			return nil, fmt.Errorf("retrieve environment variables: %w", err)
@@ -160,6 +215,10 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
+	imageRepos, err := describeImageRepositories(environments, d.svcDescriber)
+	if err != nil {
+		return nil, err
+	}
 
 	resources := make(map[string][]*CfnResource)
 	if d.enableResources {
@@ -180,28 +239,40 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Service:          d.svc,
 		Type:             manifest.LoadBalancedWebServiceType,
 		App:              d.app,
+		Deployed:         true,
 		Configurations:   configs,
 		Routes:           routes,
 		ServiceDiscovery: serviceDiscoveries,
+		ServiceConnect:   serviceConnects,
+		Messaging:        messagingRes,
 		Variables:        envVars,
+		ImageRepos:       imageRepos,
 		Resources:        resources,
 	}, nil
 }
 
 // URI returns the WebServiceURI to identify this service uniquely given an environment name.
 func (d *WebServiceDescriber) URI(envName string) (string, error) {
-	err := d.initServiceDescriber(envName)
+	uri, err := d.uri(envName)
 	if err != nil {
 		return "", err
 	}
+	return uri.String(), nil
+}
+
+func (d *WebServiceDescriber) uri(envName string) (*WebServiceURI, error) {
+	err := d.initServiceDescriber(envName)
+	if err != nil {
+		return nil, err
+	}
 
 	envOutputs, err := d.svcDescriber[envName].EnvOutputs()
 	if err != nil {
-		return "", fmt.Errorf("get output for environment %s: %w", envName, err)
+		return nil, fmt.Errorf("get output for environment %s: %w", envName, err)
 	}
 	svcParams, err := d.svcDescriber[envName].Params()
 	if err != nil {
-		return "", fmt.Errorf("get parameters for service %s: %w", d.svc, err)
+		return nil, fmt.Errorf("get parameters for service %s: %w", d.svc, err)
 	}
 	d.svcParams = svcParams
 
@@ -216,7 +287,25 @@ func (d *WebServiceDescriber) URI(envName string) (string, error) {
 			DNSName: dnsName,
 		}
 	}
-	return uri.String(), nil
+	return uri, nil
+}
+
+// additionalRulePaths returns the paths routed to the service by its additional routing rules.
+func additionalRulePaths(svcParams map[string]string) []string {
+	paths := svcParams[stack.LBWebServiceAdditionalRulePathsParamKey]
+	if paths == "" {
+		return nil
+	}
+	return strings.Split(paths, ",")
+}
+
+// aliases returns the custom domain names of the service.
+func aliases(svcOutputs map[string]string) []string {
+	names := svcOutputs[stack.LBWebServiceAliasesOutputKey]
+	if names == "" {
+		return nil
+	}
+	return strings.Split(names, ",")
 }
 
 // EnvVars contains serialized environment variables for a service.
@@ -273,15 +362,92 @@ func (s serviceDiscoveries) humanString(w io.Writer) {
 	}
 }
 
+// ServiceConnect contains serialized ECS Service Connect info for a service.
+type ServiceConnect struct {
+	Environment []string `json:"environment"`
+	Endpoint    string   `json:"endpoint"`
+}
+
+type serviceConnects []*ServiceConnect
+
+func (s serviceConnects) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\n", "Environment", "Endpoint")
+	for _, sc := range s {
+		fmt.Fprintf(w, "  %s\t%s\n", strings.Join(sc.Environment, ", "), sc.Endpoint)
+	}
+}
+
+// Types of the messaging resources of a service.
+const (
+	messagingTypeTopic = "SNS Topic"
+	messagingTypeQueue = "SQS Queue"
+)
+
+// MessagingResource contains serialized info of an SNS topic or an SQS queue of a service in an environment.
+type MessagingResource struct {
+	Environment string `json:"environment"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	ID          string `json:"id"` // ARN of the topic or URL of the queue.
+}
+
+type messagingResources []*MessagingResource
+
+func (m messagingResources) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", "Environment", "Type", "Name", "ARN or URL")
+	for _, res := range m {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", res.Environment, res.Type, res.Name, res.ID)
+	}
+}
+
+// messaging returns the SNS topics and SQS queues of a service in an environment from the outputs of its stack.
+func messaging(env string, svcOutputs map[string]string) ([]*MessagingResource, error) {
+	var resources []*MessagingResource
+	for _, output := range []struct {
+		key     string
+		resType string
+	}{
+		{key: stack.WorkloadSNSTopicsOutputKey, resType: messagingTypeTopic},
+		{key: stack.WorkloadSQSQueuesOutputKey, resType: messagingTypeQueue},
+	} {
+		value, ok := svcOutputs[output.key]
+		if !ok {
+			continue
+		}
+		ids := make(map[string]string)
+		if err := json.Unmarshal([]byte(value), &ids); err != nil {
+			return nil, fmt.Errorf("unmarshal output %s: %w", output.key, err)
+		}
+		names := make([]string, 0, len(ids))
+		for name := range ids {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			resources = append(resources, &MessagingResource{
+				Environment: env,
+				Type:        output.resType,
+				Name:        name,
+				ID:          ids[name],
+			})
+		}
+	}
+	return resources, nil
+}
+
 // webSvcDesc contains serialized parameters for a web service.
 type webSvcDesc struct {
 	Service          string             `json:"service"`
 	Type             string             `json:"type"`
 	App              string             `json:"application"`
+	Deployed         bool               `json:"deployed"`
 	Configurations   configurations     `json:"configurations"`
 	Routes           []*WebServiceRoute `json:"routes"`
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
+	ServiceConnect   serviceConnects    `json:"serviceConnect,omitempty"`
+	Messaging        messagingResources `json:"messaging,omitempty"`
 	Variables        envVars            `json:"variables"`
+	ImageRepos       imageRepositories  `json:"imageRepositories,omitempty"`
 	Resources        cfnResources       `json:"resources,omitempty"`
 }
 
@@ -306,6 +472,13 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if !w.Deployed {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployments\n\n"))
+		writer.Flush()
+		undeployedHumanString(writer)
+		writer.Flush()
+		return b.String()
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Environment", "URL")
@@ -315,9 +488,24 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
+	if len(w.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		w.ServiceConnect.humanString(writer)
+	}
+	if len(w.Messaging) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nMessaging\n\n"))
+		writer.Flush()
+		w.Messaging.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.ImageRepos) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nImage Repositories\n\n"))
+		writer.Flush()
+		w.ImageRepos.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -370,3 +558,16 @@ func appendServiceDiscovery(sds []*ServiceDiscovery, sd serviceDiscovery, env st
 	}
 	return sds
 }
+
+func appendServiceConnect(scs []*ServiceConnect, endpoint, env string) []*ServiceConnect {
+	for _, sc := range scs {
+		if sc.Endpoint == endpoint {
+			sc.Environment = append(sc.Environment, env)
+			return scs
+		}
+	}
+	return append(scs, &ServiceConnect{
+		Environment: []string{env},
+		Endpoint:    endpoint,
+	})
+}
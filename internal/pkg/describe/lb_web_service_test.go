@@ -58,6 +58,7 @@ func TestWebServiceURI_String(t *testing.T) {
 type webSvcDescriberMocks struct {
 	storeSvc     *mocks.MockDeployedEnvServicesLister
 	svcDescriber *mocks.MocksvcDescriber
+	ws           *mocks.MockWorkspaceManifestReader
 }
 
 func TestWebServiceDescriber_URI(t *testing.T) {
@@ -191,6 +192,211 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("list deployed environments for application phonetool: some error"),
 		},
+		"describes the configuration from the workspace manifest if the service is not deployed": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return(nil, nil),
+					m.ws.EXPECT().ReadServiceManifest(testSvc).Return([]byte(`name: jobs
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+cpu: 512
+memory: 1024
+count:
+  range: 1-10
+`), nil),
+				)
+			},
+			wantedWebSvc: &webSvcDesc{
+				Service:  testSvc,
+				Type:     "Load Balanced Web Service",
+				App:      testApp,
+				Deployed: false,
+				Configurations: []*ServiceConfig{
+					{
+						CPU:         "512",
+						Environment: "-",
+						Memory:      "1024",
+						Port:        "8080",
+						Tasks:       "1-10",
+					},
+				},
+			},
+		},
+		"return error if fail to read the manifest of a service that is not deployed": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return(nil, nil),
+					m.ws.EXPECT().ReadServiceManifest(testSvc).Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("describe configuration of service jobs from its manifest: some error"),
+		},
+		"describes only the environments that the service is deployed to": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					// The application has the "test", "staging" and "prod" environments but the service is only in "prod".
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{prodEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: prodEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:      prodSvcPath,
+						stack.LBWebServiceContainerPortParamKey: "5000",
+						stack.WorkloadTaskCountParamKey:         "2",
+						stack.WorkloadTaskCPUParamKey:           "512",
+						stack.WorkloadTaskMemoryParamKey:        "1024",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_ENVIRONMENT_NAME": prodEnv,
+						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(10, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(12, nil),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
+				)
+			},
+			wantedWebSvc: &webSvcDesc{
+				Service:  testSvc,
+				Type:     "Load Balanced Web Service",
+				App:      testApp,
+				Deployed: true,
+				Configurations: []*ServiceConfig{
+					{
+						CPU:         "512",
+						Environment: "prod",
+						Memory:      "1024",
+						Port:        "5000",
+						Tasks:       "2",
+					},
+				},
+				Routes: []*WebServiceRoute{
+					{
+						Environment: "prod",
+						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"prod"},
+						Namespace:   "jobs.phonetool.local:5000",
+					},
+				},
+				Variables: []*EnvVars{
+					{
+						Environment: "prod",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "prod",
+					},
+				},
+				ImageRepos: []*ImageRepository{
+					{
+						Region:    "us-west-2",
+						Retention: 10,
+						Images:    12,
+					},
+				},
+				Resources: map[string][]*CfnResource{},
+			},
+		},
+		"describes the aliases of a service behind an HTTPS listener": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						envOutputSubdomain:                 "test.phonetool.example.com",
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:      testSvcPath,
+						stack.LBWebServiceContainerPortParamKey: "5000",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{
+						stack.LBWebServiceAliasesOutputKey: "jobs.phonetool.example.com,v1.jobs.phonetool.example.com",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_ENVIRONMENT_NAME": testEnv,
+						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(10, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(12, nil),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
+				)
+			},
+			wantedWebSvc: &webSvcDesc{
+				Service:  testSvc,
+				Type:     "Load Balanced Web Service",
+				App:      testApp,
+				Deployed: true,
+				Configurations: []*ServiceConfig{
+					{
+						CPU:         "256",
+						Environment: "test",
+						Memory:      "512",
+						Port:        "5000",
+						Tasks:       "1",
+					},
+				},
+				Routes: []*WebServiceRoute{
+					{
+						Environment: "test",
+						URL:         "https://jobs.test.phonetool.example.com",
+					},
+					{
+						Environment: "test",
+						URL:         "https://jobs.phonetool.example.com",
+					},
+					{
+						Environment: "test",
+						URL:         "https://v1.jobs.phonetool.example.com",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"test"},
+						Namespace:   "jobs.phonetool.local:5000",
+					},
+				},
+				Variables: []*EnvVars{
+					{
+						Environment: "test",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "test",
+					},
+				},
+				ImageRepos: []*ImageRepository{
+					{
+						Region:    "us-west-2",
+						Retention: 10,
+						Images:    12,
+					},
+				},
+				Resources: map[string][]*CfnResource{},
+			},
+		},
+		"return error if fail to retrieve the aliases": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						envOutputSubdomain:                 "test.phonetool.example.com",
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get outputs of service jobs in environment test: some error"),
+		},
 		"return error if fail to retrieve URI": {
 			setupMocks: func(m webSvcDescriberMocks) {
 				gomock.InOrder(
@@ -214,11 +420,37 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskMemoryParamKey:        "512",
 						stack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("retrieve environment variables: some error"),
 		},
+		"return error if fail to retrieve image repository": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:      testSvcPath,
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_ENVIRONMENT_NAME": testEnv,
+						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(0, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve image retention of repository in us-west-2: some error"),
+		},
 		"return error if fail to retrieve service resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m webSvcDescriberMocks) {
@@ -234,10 +466,15 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(10, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(12, nil),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
 					m.svcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
@@ -259,6 +496,9 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{
+						stack.WorkloadServiceConnectEndpointOutputKey: "jobs:5000",
+					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -268,16 +508,26 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						envOutputPublicLoadBalancerDNSName: prodEnvLBDNSName,
 					}, nil),
 					m.svcDescriber.EXPECT().Params().Return(map[string]string{
-						stack.LBWebServiceRulePathParamKey:      prodSvcPath,
-						stack.LBWebServiceContainerPortParamKey: "5000",
-						stack.WorkloadTaskCountParamKey:         "2",
-						stack.WorkloadTaskCPUParamKey:           "512",
-						stack.WorkloadTaskMemoryParamKey:        "1024",
+						stack.LBWebServiceRulePathParamKey:            prodSvcPath,
+						stack.LBWebServiceContainerPortParamKey:       "5000",
+						stack.WorkloadTaskCountParamKey:               "2",
+						stack.WorkloadTaskCPUParamKey:                 "512",
+						stack.WorkloadTaskMemoryParamKey:              "1024",
+						stack.LBWebServiceAdditionalRulePathsParamKey: "metrics,admin",
+					}, nil),
+					m.svcDescriber.EXPECT().StackOutputs().Return(map[string]string{
+						stack.WorkloadServiceConnectEndpointOutputKey: "jobs:5000",
 					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().ImageRetention().Return(10, nil),
+					m.svcDescriber.EXPECT().ImageCount().Return(12, nil),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
+					m.svcDescriber.EXPECT().Region().Return("us-west-2"),
+					m.svcDescriber.EXPECT().IsCrossAccount().Return(false),
 
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
@@ -294,9 +544,10 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 				)
 			},
 			wantedWebSvc: &webSvcDesc{
-				Service: testSvc,
-				Type:    "Load Balanced Web Service",
-				App:     testApp,
+				Service:  testSvc,
+				Type:     "Load Balanced Web Service",
+				App:      testApp,
+				Deployed: true,
 				Configurations: []*ServiceConfig{
 					{
 						CPU:         "256",
@@ -322,6 +573,14 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						Environment: "prod",
 						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
 					},
+					{
+						Environment: "prod",
+						URL:         "http://abc.us-west-1.elb.amazonaws.com/metrics",
+					},
+					{
+						Environment: "prod",
+						URL:         "http://abc.us-west-1.elb.amazonaws.com/admin",
+					},
 				},
 				ServiceDiscovery: []*ServiceDiscovery{
 					{
@@ -329,6 +588,12 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						Namespace:   "jobs.phonetool.local:5000",
 					},
 				},
+				ServiceConnect: []*ServiceConnect{
+					{
+						Environment: []string{"test", "prod"},
+						Endpoint:    "jobs:5000",
+					},
+				},
 				Variables: []*EnvVars{
 					{
 						Environment: "prod",
@@ -341,6 +606,13 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						Value:       "test",
 					},
 				},
+				ImageRepos: []*ImageRepository{
+					{
+						Region:    "us-west-2",
+						Retention: 10,
+						Images:    12,
+					},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -366,9 +638,11 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
+			mockWS := mocks.NewMockWorkspaceManifestReader(ctrl)
 			mocks := webSvcDescriberMocks{
 				storeSvc:     mockStore,
 				svcDescriber: mockSvcDescriber,
+				ws:           mockWS,
 			}
 
 			tc.setupMocks(mocks)
@@ -378,6 +652,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 				svc:             testSvc,
 				enableResources: tc.shouldOutputResources,
 				store:           mockStore,
+				ws:              mockWS,
 				svcDescriber: map[string]svcDescriber{
 					"test": mockSvcDescriber,
 					"prod": mockSvcDescriber,
@@ -434,6 +709,13 @@ Variables
   COPILOT_ENVIRONMENT_NAME  prod                prod
   -                         test                test
 
+Image Repositories
+
+  Region            Retention           Images
+  us-west-2         10 most recent      12
+
+  Environments in other accounts than the application pulling from us-west-2: prod
+
 Resources
 
   test
@@ -442,7 +724,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"deployed\":true,\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"imageRepositories\":[{\"region\":\"us-west-2\",\"retention\":10,\"images\":12,\"crossAccountEnvironments\":[\"prod\"]}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -511,10 +793,19 @@ Resources
 				Type:             "Load Balanced Web Service",
 				Configurations:   config,
 				App:              "my-app",
+				Deployed:         true,
 				Variables:        envVars,
 				Routes:           routes,
 				ServiceDiscovery: sds,
-				Resources:        resources,
+				ImageRepos: []*ImageRepository{
+					{
+						Region:           "us-west-2",
+						Retention:        10,
+						Images:           12,
+						CrossAccountEnvs: []string{"prod"},
+					},
+				},
+				Resources: resources,
 			}
 			human := webSvc.HumanString()
 			json, _ := webSvc.JSONString()
@@ -92,3 +92,76 @@ func (mr *MocksvcDescriberMockRecorder) ServiceStackResources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackResources", reflect.TypeOf((*MocksvcDescriber)(nil).ServiceStackResources))
 }
+
+// ImageCount mocks base method
+func (m *MocksvcDescriber) ImageCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageCount indicates an expected call of ImageCount
+func (mr *MocksvcDescriberMockRecorder) ImageCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageCount", reflect.TypeOf((*MocksvcDescriber)(nil).ImageCount))
+}
+
+// ImageRetention mocks base method
+func (m *MocksvcDescriber) ImageRetention() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageRetention")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageRetention indicates an expected call of ImageRetention
+func (mr *MocksvcDescriberMockRecorder) ImageRetention() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageRetention", reflect.TypeOf((*MocksvcDescriber)(nil).ImageRetention))
+}
+
+// Region mocks base method
+func (m *MocksvcDescriber) Region() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Region")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Region indicates an expected call of Region
+func (mr *MocksvcDescriberMockRecorder) Region() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Region", reflect.TypeOf((*MocksvcDescriber)(nil).Region))
+}
+
+// StackOutputs mocks base method
+func (m *MocksvcDescriber) StackOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackOutputs indicates an expected call of StackOutputs
+func (mr *MocksvcDescriberMockRecorder) StackOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackOutputs", reflect.TypeOf((*MocksvcDescriber)(nil).StackOutputs))
+}
+
+// IsCrossAccount mocks base method
+func (m *MocksvcDescriber) IsCrossAccount() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCrossAccount")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsCrossAccount indicates an expected call of IsCrossAccount
+func (mr *MocksvcDescriberMockRecorder) IsCrossAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCrossAccount", reflect.TypeOf((*MocksvcDescriber)(nil).IsCrossAccount))
+}
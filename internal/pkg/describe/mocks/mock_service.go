@@ -6,6 +6,7 @@ package mocks
 
 import (
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	gomock "github.com/golang/mock/gomock"
@@ -118,6 +119,59 @@ func (mr *MockecsClientMockRecorder) TaskDefinition(taskDefName interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsClient)(nil).TaskDefinition), taskDefName)
 }
 
+// MockimageRepoDescriber is a mock of imageRepoDescriber interface
+type MockimageRepoDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRepoDescriberMockRecorder
+}
+
+// MockimageRepoDescriberMockRecorder is the mock recorder for MockimageRepoDescriber
+type MockimageRepoDescriberMockRecorder struct {
+	mock *MockimageRepoDescriber
+}
+
+// NewMockimageRepoDescriber creates a new mock instance
+func NewMockimageRepoDescriber(ctrl *gomock.Controller) *MockimageRepoDescriber {
+	mock := &MockimageRepoDescriber{ctrl: ctrl}
+	mock.recorder = &MockimageRepoDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageRepoDescriber) EXPECT() *MockimageRepoDescriberMockRecorder {
+	return m.recorder
+}
+
+// ImageRetention mocks base method
+func (m *MockimageRepoDescriber) ImageRetention(repoName string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageRetention", repoName)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageRetention indicates an expected call of ImageRetention
+func (mr *MockimageRepoDescriberMockRecorder) ImageRetention(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageRetention", reflect.TypeOf((*MockimageRepoDescriber)(nil).ImageRetention), repoName)
+}
+
+// ListImages mocks base method
+func (m *MockimageRepoDescriber) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages
+func (mr *MockimageRepoDescriberMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockimageRepoDescriber)(nil).ListImages), repoName)
+}
+
 // MockConfigStoreSvc is a mock of ConfigStoreSvc interface
 type MockConfigStoreSvc struct {
 	ctrl     *gomock.Controller
@@ -186,6 +240,36 @@ func (mr *MockConfigStoreSvcMockRecorder) ListServices(appName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockConfigStoreSvc)(nil).ListServices), appName)
 }
 
+// GetApplication mocks base method
+func (m *MockConfigStoreSvc) GetApplication(appName string) (*config.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", appName)
+	ret0, _ := ret[0].(*config.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication
+func (mr *MockConfigStoreSvcMockRecorder) GetApplication(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockConfigStoreSvc)(nil).GetApplication), appName)
+}
+
+// ListJobs mocks base method
+func (m *MockConfigStoreSvc) ListJobs(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockConfigStoreSvcMockRecorder) ListJobs(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockConfigStoreSvc)(nil).ListJobs), appName)
+}
+
 // MockDeployedEnvServicesLister is a mock of DeployedEnvServicesLister interface
 type MockDeployedEnvServicesLister struct {
 	ctrl     *gomock.Controller
@@ -238,3 +322,56 @@ func (mr *MockDeployedEnvServicesListerMockRecorder) ListDeployedServices(appNam
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedServices", reflect.TypeOf((*MockDeployedEnvServicesLister)(nil).ListDeployedServices), appName, envName)
 }
+
+// ListDeployedJobs mocks base method
+func (m *MockDeployedEnvServicesLister) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs
+func (mr *MockDeployedEnvServicesListerMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockDeployedEnvServicesLister)(nil).ListDeployedJobs), appName, envName)
+}
+
+// MockWorkspaceManifestReader is a mock of WorkspaceManifestReader interface
+type MockWorkspaceManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceManifestReaderMockRecorder
+}
+
+// MockWorkspaceManifestReaderMockRecorder is the mock recorder for MockWorkspaceManifestReader
+type MockWorkspaceManifestReaderMockRecorder struct {
+	mock *MockWorkspaceManifestReader
+}
+
+// NewMockWorkspaceManifestReader creates a new mock instance
+func NewMockWorkspaceManifestReader(ctrl *gomock.Controller) *MockWorkspaceManifestReader {
+	mock := &MockWorkspaceManifestReader{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWorkspaceManifestReader) EXPECT() *MockWorkspaceManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockWorkspaceManifestReader) ReadServiceManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockWorkspaceManifestReaderMockRecorder) ReadServiceManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockWorkspaceManifestReader)(nil).ReadServiceManifest), name)
+}
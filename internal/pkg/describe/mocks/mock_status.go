@@ -5,8 +5,10 @@
 package mocks
 
 import (
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -65,6 +67,21 @@ func (mr *MockalarmStatusGetterMockRecorder) AlarmStatus(alarms interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStatus", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmStatus), alarms)
 }
 
+// AlarmsWithPrefix mocks base method
+func (m *MockalarmStatusGetter) AlarmsWithPrefix(prefix string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmsWithPrefix", prefix)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmsWithPrefix indicates an expected call of AlarmsWithPrefix
+func (mr *MockalarmStatusGetterMockRecorder) AlarmsWithPrefix(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmsWithPrefix", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmsWithPrefix), prefix)
+}
+
 // MockresourcesGetter is a mock of resourcesGetter interface
 type MockresourcesGetter struct {
 	ctrl     *gomock.Controller
@@ -156,31 +173,69 @@ func (mr *MockecsServiceGetterMockRecorder) Service(clusterName, serviceName int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceGetter)(nil).Service), clusterName, serviceName)
 }
 
-// MockautoscalingAlarmNamesGetter is a mock of autoscalingAlarmNamesGetter interface
-type MockautoscalingAlarmNamesGetter struct {
+// MockserviceStoppedTasksGetter is a mock of serviceStoppedTasksGetter interface
+type MockserviceStoppedTasksGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockautoscalingAlarmNamesGetterMockRecorder
+	recorder *MockserviceStoppedTasksGetterMockRecorder
 }
 
-// MockautoscalingAlarmNamesGetterMockRecorder is the mock recorder for MockautoscalingAlarmNamesGetter
-type MockautoscalingAlarmNamesGetterMockRecorder struct {
-	mock *MockautoscalingAlarmNamesGetter
+// MockserviceStoppedTasksGetterMockRecorder is the mock recorder for MockserviceStoppedTasksGetter
+type MockserviceStoppedTasksGetterMockRecorder struct {
+	mock *MockserviceStoppedTasksGetter
 }
 
-// NewMockautoscalingAlarmNamesGetter creates a new mock instance
-func NewMockautoscalingAlarmNamesGetter(ctrl *gomock.Controller) *MockautoscalingAlarmNamesGetter {
-	mock := &MockautoscalingAlarmNamesGetter{ctrl: ctrl}
-	mock.recorder = &MockautoscalingAlarmNamesGetterMockRecorder{mock}
+// NewMockserviceStoppedTasksGetter creates a new mock instance
+func NewMockserviceStoppedTasksGetter(ctrl *gomock.Controller) *MockserviceStoppedTasksGetter {
+	mock := &MockserviceStoppedTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceStoppedTasksGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockautoscalingAlarmNamesGetter) EXPECT() *MockautoscalingAlarmNamesGetterMockRecorder {
+func (m *MockserviceStoppedTasksGetter) EXPECT() *MockserviceStoppedTasksGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceStoppedTasks mocks base method
+func (m *MockserviceStoppedTasksGetter) ServiceStoppedTasks(app, env, svc string) ([]ecs.TaskStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStoppedTasks", app, env, svc)
+	ret0, _ := ret[0].([]ecs.TaskStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStoppedTasks indicates an expected call of ServiceStoppedTasks
+func (mr *MockserviceStoppedTasksGetterMockRecorder) ServiceStoppedTasks(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStoppedTasks", reflect.TypeOf((*MockserviceStoppedTasksGetter)(nil).ServiceStoppedTasks), app, env, svc)
+}
+
+// MockautoscalingGetter is a mock of autoscalingGetter interface
+type MockautoscalingGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockautoscalingGetterMockRecorder
+}
+
+// MockautoscalingGetterMockRecorder is the mock recorder for MockautoscalingGetter
+type MockautoscalingGetterMockRecorder struct {
+	mock *MockautoscalingGetter
+}
+
+// NewMockautoscalingGetter creates a new mock instance
+func NewMockautoscalingGetter(ctrl *gomock.Controller) *MockautoscalingGetter {
+	mock := &MockautoscalingGetter{ctrl: ctrl}
+	mock.recorder = &MockautoscalingGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockautoscalingGetter) EXPECT() *MockautoscalingGetterMockRecorder {
 	return m.recorder
 }
 
 // ECSServiceAlarmNames mocks base method
-func (m *MockautoscalingAlarmNamesGetter) ECSServiceAlarmNames(cluster, service string) ([]string, error) {
+func (m *MockautoscalingGetter) ECSServiceAlarmNames(cluster, service string) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ECSServiceAlarmNames", cluster, service)
 	ret0, _ := ret[0].([]string)
@@ -189,7 +244,75 @@ func (m *MockautoscalingAlarmNamesGetter) ECSServiceAlarmNames(cluster, service
 }
 
 // ECSServiceAlarmNames indicates an expected call of ECSServiceAlarmNames
-func (mr *MockautoscalingAlarmNamesGetterMockRecorder) ECSServiceAlarmNames(cluster, service interface{}) *gomock.Call {
+func (mr *MockautoscalingGetterMockRecorder) ECSServiceAlarmNames(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingGetter)(nil).ECSServiceAlarmNames), cluster, service)
+}
+
+// ECSServiceCapacity mocks base method
+func (m *MockautoscalingGetter) ECSServiceCapacity(cluster, service string) (*aas.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceCapacity", cluster, service)
+	ret0, _ := ret[0].(*aas.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceCapacity indicates an expected call of ECSServiceCapacity
+func (mr *MockautoscalingGetterMockRecorder) ECSServiceCapacity(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceCapacity", reflect.TypeOf((*MockautoscalingGetter)(nil).ECSServiceCapacity), cluster, service)
+}
+
+// ECSServiceScalingActivities mocks base method
+func (m *MockautoscalingGetter) ECSServiceScalingActivities(cluster, service string, limit int) ([]aas.ScalingActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalingActivities", cluster, service, limit)
+	ret0, _ := ret[0].([]aas.ScalingActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalingActivities indicates an expected call of ECSServiceScalingActivities
+func (mr *MockautoscalingGetterMockRecorder) ECSServiceScalingActivities(cluster, service, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalingActivities", reflect.TypeOf((*MockautoscalingGetter)(nil).ECSServiceScalingActivities), cluster, service, limit)
+}
+
+// MocktargetHealthGetter is a mock of targetHealthGetter interface
+type MocktargetHealthGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktargetHealthGetterMockRecorder
+}
+
+// MocktargetHealthGetterMockRecorder is the mock recorder for MocktargetHealthGetter
+type MocktargetHealthGetterMockRecorder struct {
+	mock *MocktargetHealthGetter
+}
+
+// NewMocktargetHealthGetter creates a new mock instance
+func NewMocktargetHealthGetter(ctrl *gomock.Controller) *MocktargetHealthGetter {
+	mock := &MocktargetHealthGetter{ctrl: ctrl}
+	mock.recorder = &MocktargetHealthGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktargetHealthGetter) EXPECT() *MocktargetHealthGetterMockRecorder {
+	return m.recorder
+}
+
+// TargetsHealth mocks base method
+func (m *MocktargetHealthGetter) TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetsHealth", targetGroupARN)
+	ret0, _ := ret[0].([]*elbv2.TargetHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetsHealth indicates an expected call of TargetsHealth
+func (mr *MocktargetHealthGetterMockRecorder) TargetsHealth(targetGroupARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingAlarmNamesGetter)(nil).ECSServiceAlarmNames), cluster, service)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetsHealth", reflect.TypeOf((*MocktargetHealthGetter)(nil).TargetsHealth), targetGroupARN)
 }
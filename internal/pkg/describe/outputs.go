@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+type stackOutputsDescriber interface {
+	StackOutputs() (map[string]string, error)
+	AddonsOutputs() (map[string]string, error)
+}
+
+// ServiceOutputs contains serialized outputs of a service deployed to an environment.
+type ServiceOutputs struct {
+	Environment      string            `json:"environment"`
+	URL              string            `json:"url,omitempty"`
+	ServiceDiscovery string            `json:"serviceDiscovery,omitempty"`
+	Addons           map[string]string `json:"addons,omitempty"`
+}
+
+// JSONString returns the stringified ServiceOutputs struct in json format.
+func (o *ServiceOutputs) JSONString() (string, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return "", fmt.Errorf("marshal service outputs: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceOutputs struct in human readable format.
+// Outputs that are not set are omitted.
+func (o *ServiceOutputs) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	if o.URL != "" || o.ServiceDiscovery != "" {
+		fmt.Fprint(writer, color.Bold.Sprint("Outputs\n\n"))
+		writer.Flush()
+		if o.URL != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "URL", o.URL)
+		}
+		if o.ServiceDiscovery != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "Service Discovery", o.ServiceDiscovery)
+		}
+	}
+	if len(o.Addons) != 0 {
+		if b.Len() != 0 {
+			fmt.Fprintln(writer)
+		}
+		fmt.Fprint(writer, color.Bold.Sprint("Addons\n\n"))
+		writer.Flush()
+		var keys []string
+		for key := range o.Addons {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(writer, "  %s\t%s\n", key, o.Addons[key])
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// ServiceOutputsDescriber retrieves the outputs of a service deployed to an environment.
+type ServiceOutputsDescriber struct {
+	env string
+
+	svcDescriber stackOutputsDescriber
+}
+
+// NewServiceOutputsDescriber instantiates a describer for the outputs of a service in an environment.
+func NewServiceOutputsDescriber(opt NewServiceConfig) (*ServiceOutputsDescriber, error) {
+	d, err := NewServiceDescriber(opt)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceOutputsDescriber{
+		env:          opt.Env,
+		svcDescriber: d,
+	}, nil
+}
+
+// Outputs returns the URL, the service discovery endpoint and the addons outputs of the service.
+// Outputs that are missing from the stack, for example if it was deployed with an older template, are left empty.
+func (d *ServiceOutputsDescriber) Outputs() (*ServiceOutputs, error) {
+	stackOutputs, err := d.svcDescriber.StackOutputs()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve service stack outputs: %w", err)
+	}
+	addonsOutputs, err := d.svcDescriber.AddonsOutputs()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve addons stack outputs: %w", err)
+	}
+	return &ServiceOutputs{
+		Environment:      d.env,
+		URL:              stackOutputs[stack.LBWebServiceURLOutputKey],
+		ServiceDiscovery: stackOutputs[stack.WorkloadDiscoveryServiceEndpointOutputKey],
+		Addons:           addonsOutputs,
+	}, nil
+}
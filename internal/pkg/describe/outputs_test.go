@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceOutputsDescriber_Outputs(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackOutputsDescriber)
+
+		wantedOutputs *ServiceOutputs
+		wantedError   error
+	}{
+		"returns error if fail to retrieve the service stack outputs": {
+			setupMocks: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().StackOutputs().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("retrieve service stack outputs: some error"),
+		},
+		"returns error if fail to retrieve the addons stack outputs": {
+			setupMocks: func(m *mocks.MockstackOutputsDescriber) {
+				gomock.InOrder(
+					m.EXPECT().StackOutputs().Return(map[string]string{}, nil),
+					m.EXPECT().AddonsOutputs().Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("retrieve addons stack outputs: some error"),
+		},
+		"returns the outputs of a load balanced web service with addons": {
+			setupMocks: func(m *mocks.MockstackOutputsDescriber) {
+				gomock.InOrder(
+					m.EXPECT().StackOutputs().Return(map[string]string{
+						"ServiceURL":               "https://frontend.test.phonetool.com",
+						"DiscoveryServiceEndpoint": "frontend.phonetool.local:80",
+					}, nil),
+					m.EXPECT().AddonsOutputs().Return(map[string]string{
+						"MyBucketName": "phonetool-test-frontend-mybucket",
+					}, nil),
+				)
+			},
+
+			wantedOutputs: &ServiceOutputs{
+				Environment:      "test",
+				URL:              "https://frontend.test.phonetool.com",
+				ServiceDiscovery: "frontend.phonetool.local:80",
+				Addons: map[string]string{
+					"MyBucketName": "phonetool-test-frontend-mybucket",
+				},
+			},
+		},
+		"omits the outputs missing from a stack deployed with an older template": {
+			setupMocks: func(m *mocks.MockstackOutputsDescriber) {
+				gomock.InOrder(
+					m.EXPECT().StackOutputs().Return(map[string]string{}, nil),
+					m.EXPECT().AddonsOutputs().Return(nil, nil),
+				)
+			},
+
+			wantedOutputs: &ServiceOutputs{
+				Environment: "test",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockstackOutputsDescriber(ctrl)
+			tc.setupMocks(m)
+
+			d := &ServiceOutputsDescriber{
+				env:          "test",
+				svcDescriber: m,
+			}
+
+			// WHEN
+			outputs, err := d.Outputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, outputs)
+		})
+	}
+}
+
+func TestServiceOutputs_String(t *testing.T) {
+	testCases := map[string]struct {
+		outputs *ServiceOutputs
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"all outputs": {
+			outputs: &ServiceOutputs{
+				Environment:      "test",
+				URL:              "https://frontend.test.phonetool.com",
+				ServiceDiscovery: "frontend.phonetool.local:80",
+				Addons: map[string]string{
+					"MyTableName":  "phonetool-test-frontend-mytable",
+					"MyBucketName": "phonetool-test-frontend-mybucket",
+				},
+			},
+
+			wantedHumanString: `Outputs
+
+  URL                https://frontend.test.phonetool.com
+  Service Discovery  frontend.phonetool.local:80
+
+Addons
+
+  MyBucketName      phonetool-test-frontend-mybucket
+  MyTableName       phonetool-test-frontend-mytable
+`,
+			wantedJSONString: "{\"environment\":\"test\",\"url\":\"https://frontend.test.phonetool.com\",\"serviceDiscovery\":\"frontend.phonetool.local:80\",\"addons\":{\"MyBucketName\":\"phonetool-test-frontend-mybucket\",\"MyTableName\":\"phonetool-test-frontend-mytable\"}}\n",
+		},
+		"only addons": {
+			outputs: &ServiceOutputs{
+				Environment: "test",
+				Addons: map[string]string{
+					"MyBucketName": "phonetool-test-frontend-mybucket",
+				},
+			},
+
+			wantedHumanString: `Addons
+
+  MyBucketName      phonetool-test-frontend-mybucket
+`,
+			wantedJSONString: "{\"environment\":\"test\",\"addons\":{\"MyBucketName\":\"phonetool-test-frontend-mybucket\"}}\n",
+		},
+		"no outputs": {
+			outputs: &ServiceOutputs{
+				Environment: "test",
+			},
+
+			wantedJSONString: "{\"environment\":\"test\"}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			human := tc.outputs.HumanString()
+			json, err := tc.outputs.JSONString()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -6,13 +6,19 @@ package describe
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 const (
@@ -22,6 +28,12 @@ const (
 	waitConditionHandle  = "AWS::CloudFormation::WaitConditionHandle"
 )
 
+const (
+	// Placeholders for the configuration of a service that's not deployed yet.
+	undeployedEnvironment = "-"
+	undeployedTasks       = "-"
+)
+
 type stackAndResourcesDescriber interface {
 	Stack(stackName string) (*cloudformation.Stack, error)
 	StackResources(stackName string) ([]*cloudformation.StackResource, error)
@@ -32,17 +44,30 @@ type ecsClient interface {
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
 }
 
+type imageRepoDescriber interface {
+	ImageRetention(repoName string) (int, error)
+	ListImages(repoName string) ([]ecr.Image, error)
+}
+
 // ConfigStoreSvc wraps methods of config store.
 type ConfigStoreSvc interface {
+	GetApplication(appName string) (*config.Application, error)
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 	ListEnvironments(appName string) ([]*config.Environment, error)
 	ListServices(appName string) ([]*config.Workload, error)
+	ListJobs(appName string) ([]*config.Workload, error)
 }
 
 // DeployedEnvServicesLister wraps methods of deploy store.
 type DeployedEnvServicesLister interface {
 	ListEnvironmentsDeployedTo(appName string, svcName string) ([]string, error)
 	ListDeployedServices(appName string, envName string) ([]string, error)
+	ListDeployedJobs(appName string, envName string) ([]string, error)
+}
+
+// WorkspaceManifestReader reads the manifest of a service from the workspace.
+type WorkspaceManifestReader interface {
+	ReadServiceManifest(name string) ([]byte, error)
 }
 
 // ServiceConfig contains serialized configuration parameters for a service.
@@ -63,14 +88,130 @@ func (c configurations) humanString(w io.Writer) {
 	}
 }
 
+// ImageRepository contains the image retention and the number of images of a service's ECR repository in a region.
+type ImageRepository struct {
+	Region    string `json:"region"`
+	Retention int    `json:"retention"`
+	Images    int    `json:"images"`
+	// Environments in other accounts than the application that pull the images from the repository.
+	CrossAccountEnvs []string `json:"crossAccountEnvironments,omitempty"`
+}
+
+type imageRepositories []*ImageRepository
+
+func (r imageRepositories) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\n", "Region", "Retention", "Images")
+	for _, repo := range r {
+		retention := "all images"
+		if repo.Retention != 0 {
+			retention = fmt.Sprintf("%d most recent", repo.Retention)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%d\n", repo.Region, retention, repo.Images)
+	}
+	for _, repo := range r {
+		if len(repo.CrossAccountEnvs) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n  Environments in other accounts than the application pulling from %s: %s\n", repo.Region, strings.Join(repo.CrossAccountEnvs, ", "))
+	}
+}
+
+// describeImageRepositories returns the image repository of the service in each region it's deployed to,
+// along with the environments in other accounts that pull from it.
+func describeImageRepositories(envs []string, describers map[string]svcDescriber) (imageRepositories, error) {
+	var repos imageRepositories
+	repoByRegion := make(map[string]*ImageRepository)
+	for _, env := range envs {
+		region := describers[env].Region()
+		repo, ok := repoByRegion[region]
+		if !ok {
+			retention, err := describers[env].ImageRetention()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve image retention of repository in %s: %w", region, err)
+			}
+			count, err := describers[env].ImageCount()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve images of repository in %s: %w", region, err)
+			}
+			repo = &ImageRepository{
+				Region:    region,
+				Retention: retention,
+				Images:    count,
+			}
+			repoByRegion[region] = repo
+			repos = append(repos, repo)
+		}
+		if describers[env].IsCrossAccount() {
+			repo.CrossAccountEnvs = append(repo.CrossAccountEnvs, env)
+		}
+	}
+	return repos, nil
+}
+
+// undeployedConfigs returns the configuration of a service that's not deployed to any environment yet
+// from its manifest in the workspace. Returns no configuration if there is no workspace.
+func undeployedConfigs(ws WorkspaceManifestReader, svc string) (configurations, error) {
+	if ws == nil {
+		return nil, nil
+	}
+	raw, err := ws.ReadServiceManifest(svc)
+	if err != nil {
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of service %s: %w", svc, err)
+	}
+	var port *uint16
+	var task manifest.TaskConfig
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		port, task = t.ImageConfig.Port, t.TaskConfig
+	case *manifest.BackendService:
+		port, task = t.ImageConfig.Port, t.TaskConfig
+	default:
+		return nil, fmt.Errorf("manifest of %s is not a service manifest", svc)
+	}
+
+	config := &ServiceConfig{
+		Environment: undeployedEnvironment,
+		Port:        blankContainerPort,
+		Tasks:       undeployedTasks,
+		CPU:         strconv.Itoa(aws.IntValue(task.CPU)),
+		Memory:      strconv.Itoa(aws.IntValue(task.Memory)),
+	}
+	if port != nil {
+		config.Port = strconv.Itoa(int(*port))
+	}
+	switch {
+	case task.Count.Value != nil:
+		config.Tasks = strconv.Itoa(*task.Count.Value)
+	case task.Count.Spot != nil:
+		config.Tasks = strconv.Itoa(*task.Count.Spot)
+	case task.Count.Autoscaling.Range != nil:
+		config.Tasks = string(*task.Count.Autoscaling.Range)
+	}
+	return []*ServiceConfig{config}, nil
+}
+
+// undeployedHumanString writes the deployments section of a service that's not deployed to any environment yet.
+func undeployedHumanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\n", "This service is not deployed to any environment yet.")
+	fmt.Fprintf(w, "  Run %s to deploy it.\n", color.HighlightCode("copilot svc deploy"))
+}
+
 // ServiceDescriber retrieves information about a service.
 type ServiceDescriber struct {
 	app     string
 	service string
 	env     string
+	region  string
+	// True if the environment is in another account than the application.
+	crossAccount bool
 
 	ecsClient      ecsClient
 	stackDescriber stackAndResourcesDescriber
+	imageRepo      imageRepoDescriber
 }
 
 // NewServiceConfig contains fields that initiates ServiceDescriber struct.
@@ -83,6 +224,10 @@ type NewServiceConfig struct {
 
 // NewServiceDescriber instantiates a new service.
 func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
+	app, err := opt.ConfigStore.GetApplication(opt.App)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", opt.App, err)
+	}
 	environment, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
 	if err != nil {
 		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
@@ -91,14 +236,23 @@ func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The image repositories live in the application account.
+	defaultSess, err := sessions.NewProvider().DefaultWithRegion(environment.Region)
+	if err != nil {
+		return nil, err
+	}
 	d := newStackDescriber(sess)
 	return &ServiceDescriber{
 		app:     opt.App,
 		service: opt.Svc,
 		env:     opt.Env,
+		region:  environment.Region,
+
+		crossAccount: environment.AccountID != app.AccountID,
 
 		ecsClient:      ecs.New(sess),
 		stackDescriber: d,
+		imageRepo:      ecr.New(defaultSess),
 	}, nil
 }
 
@@ -149,6 +303,57 @@ func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	return outputs, nil
 }
 
+// StackOutputs returns the outputs of the service stack.
+func (d *ServiceDescriber) StackOutputs() (map[string]string, error) {
+	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, out := range svcStack.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	return outputs, nil
+}
+
+// AddonsOutputs returns the outputs of the nested addons stack of the service.
+// Returns nothing if the service doesn't have addons.
+func (d *ServiceDescriber) AddonsOutputs() (map[string]string, error) {
+	svcResources, err := d.stackDescriber.StackResources(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return nil, err
+	}
+	for _, svcResource := range svcResources {
+		if aws.StringValue(svcResource.LogicalResourceId) != addon.StackName {
+			continue
+		}
+		addonsStack, err := d.stackDescriber.Stack(aws.StringValue(svcResource.PhysicalResourceId))
+		if err != nil {
+			return nil, err
+		}
+		outputs := make(map[string]string)
+		for _, out := range addonsStack.Outputs {
+			outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+		}
+		return outputs, nil
+	}
+	return nil, nil
+}
+
+// LogGroupName returns the name of the log group that the service's containers write their logs to.
+func (d *ServiceDescriber) LogGroupName() (string, error) {
+	svcResources, err := d.stackDescriber.StackResources(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return "", err
+	}
+	for _, svcResource := range svcResources {
+		if aws.StringValue(svcResource.LogicalResourceId) == stack.WorkloadLogGroupLogicalID {
+			return aws.StringValue(svcResource.PhysicalResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("log group not found in stack %s", stack.NameForService(d.app, d.env, d.service))
+}
+
 // Params returns the parameters of the service stack.
 func (d *ServiceDescriber) Params() (map[string]string, error) {
 	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
@@ -161,3 +366,29 @@ func (d *ServiceDescriber) Params() (map[string]string, error) {
 	}
 	return params, nil
 }
+
+// Region returns the region of the environment.
+func (d *ServiceDescriber) Region() string {
+	return d.region
+}
+
+// ImageRetention returns the number of most recent images kept in the service's repository
+// in the region of the environment, 0 if all images are kept.
+func (d *ServiceDescriber) ImageRetention() (int, error) {
+	return d.imageRepo.ImageRetention(fmt.Sprintf("%s/%s", d.app, d.service))
+}
+
+// ImageCount returns the number of images in the service's repository in the region of the environment.
+func (d *ServiceDescriber) ImageCount() (int, error) {
+	images, err := d.imageRepo.ListImages(fmt.Sprintf("%s/%s", d.app, d.service))
+	if err != nil {
+		return 0, err
+	}
+	return len(images), nil
+}
+
+// IsCrossAccount returns true if the environment is in another account than the application,
+// so its tasks pull the images from the application's account.
+func (d *ServiceDescriber) IsCrossAccount() bool {
+	return d.crossAccount
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
@@ -112,6 +113,63 @@ func TestServiceDescriber_EnvVars(t *testing.T) {
 	}
 }
 
+func TestServiceDescriber_ImageCount(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockimageRepoDescriber)
+
+		wantedCount int
+		wantedError error
+	}{
+		"returns error if fails to list images": {
+			setupMocks: func(m *mocks.MockimageRepoDescriber) {
+				m.EXPECT().ListImages("phonetool/jobs").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"counts the images of the repository": {
+			setupMocks: func(m *mocks.MockimageRepoDescriber) {
+				m.EXPECT().ListImages("phonetool/jobs").Return([]ecr.Image{
+					{Digest: "sha256:1"},
+					{Digest: "sha256:2"},
+				}, nil)
+			},
+
+			wantedCount: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockImageRepo := mocks.NewMockimageRepoDescriber(ctrl)
+			tc.setupMocks(mockImageRepo)
+
+			d := &ServiceDescriber{
+				app:     "phonetool",
+				service: "jobs",
+				env:     "test",
+
+				imageRepo: mockImageRepo,
+			}
+
+			// WHEN
+			actual, err := d.ImageCount()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCount, actual)
+			}
+		})
+	}
+}
+
 func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 	const (
 		testApp            = "phonetool"
@@ -201,3 +259,259 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDescriber_StackOutputs(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "frontend"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedOutputs map[string]string
+		wantedError   error
+	}{
+		"returns error when fail to describe stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().Stack(stack.NameForService(testApp, testEnv, testSvc)).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns the outputs of the service stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().Stack(stack.NameForService(testApp, testEnv, testSvc)).Return(&cloudformation.Stack{
+					Outputs: []*cloudformation.Output{
+						{
+							OutputKey:   aws.String("ServiceURL"),
+							OutputValue: aws.String("https://frontend.test.phonetool.com"),
+						},
+						{
+							OutputKey:   aws.String("DiscoveryServiceEndpoint"),
+							OutputValue: aws.String("frontend.phonetool.local:80"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedOutputs: map[string]string{
+				"ServiceURL":               "https://frontend.test.phonetool.com",
+				"DiscoveryServiceEndpoint": "frontend.phonetool.local:80",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			})
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.StackOutputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, actual)
+			}
+		})
+	}
+}
+
+func TestServiceDescriber_AddonsOutputs(t *testing.T) {
+	const (
+		testApp         = "phonetool"
+		testEnv         = "test"
+		testSvc         = "frontend"
+		testAddonsStack = "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-frontend-AddonsStack-1A2B3C/abc"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedOutputs map[string]string
+		wantedError   error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns nothing when the service has no addons": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("LogGroup"),
+						PhysicalResourceId: aws.String("/copilot/phonetool-test-frontend"),
+					},
+				}, nil)
+			},
+		},
+		"returns error when fail to describe the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							PhysicalResourceId: aws.String(testAddonsStack),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().Stack(testAddonsStack).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns the outputs of the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("LogGroup"),
+							PhysicalResourceId: aws.String("/copilot/phonetool-test-frontend"),
+						},
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							PhysicalResourceId: aws.String(testAddonsStack),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().Stack(testAddonsStack).Return(&cloudformation.Stack{
+						Outputs: []*cloudformation.Output{
+							{
+								OutputKey:   aws.String("MyBucketName"),
+								OutputValue: aws.String("phonetool-test-frontend-mybucket"),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedOutputs: map[string]string{
+				"MyBucketName": "phonetool-test-frontend-mybucket",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			})
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.AddonsOutputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, actual)
+			}
+		})
+	}
+}
+
+func TestServiceDescriber_LogGroupName(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "frontend"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wanted      string
+		wantedError error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns error when the stack has no log group": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{}, nil)
+			},
+
+			wantedError: fmt.Errorf("log group not found in stack phonetool-test-frontend"),
+		},
+		"returns the name of the log group": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/frontend"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogGroup"),
+						PhysicalResourceId: aws.String("/phonetool/prod/frontend"),
+					},
+				}, nil)
+			},
+
+			wanted: "/phonetool/prod/frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			})
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.LogGroupName()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
@@ -10,24 +10,51 @@ import (
 	"math"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 const (
 	ecsServiceResourceType    = "ecs:service"
 	maxAlarmStatusColumnWidth = 30
+	maxServiceEvents          = 25 // Number of service events retrieved when events are requested.
+	maxStoppedTasks           = 5  // Number of recently stopped tasks displayed.
+	maxScalingActivities      = 5  // Number of recent scaling activities displayed.
+	shortTaskIDLength         = 8
 )
 
+// Rollout states of the latest deployment of a service.
+const (
+	DeploymentRolloutInProgress = "IN_PROGRESS"
+	DeploymentRolloutCompleted  = "COMPLETED"
+)
+
+// State of a load balancer target that passes its health checks.
+const targetHealthStateHealthy = "healthy"
+
+// Substrings of service event messages that indicate the scheduler failed to place or start tasks.
+var serviceEventFailureMessages = []string{
+	"unable to place",
+	"insufficient",
+	"capacity",
+	"unable to consistently start tasks",
+	"failed",
+}
+
 type alarmStatusGetter interface {
 	AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error)
 	AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error)
+	AlarmsWithPrefix(prefix string) ([]cloudwatch.AlarmStatus, error)
 }
 
 type resourcesGetter interface {
@@ -39,8 +66,18 @@ type ecsServiceGetter interface {
 	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
-type autoscalingAlarmNamesGetter interface {
+type serviceStoppedTasksGetter interface {
+	ServiceStoppedTasks(app, env, svc string) ([]ecs.TaskStatus, error)
+}
+
+type autoscalingGetter interface {
 	ECSServiceAlarmNames(cluster, service string) ([]string, error)
+	ECSServiceCapacity(cluster, service string) (*aas.Capacity, error)
+	ECSServiceScalingActivities(cluster, service string, limit int) ([]aas.ScalingActivity, error)
+}
+
+type targetHealthGetter interface {
+	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
 }
 
 // ServiceStatus retrieves status of a service.
@@ -49,17 +86,66 @@ type ServiceStatus struct {
 	env string
 	svc string
 
-	ecsSvc ecsServiceGetter
-	cwSvc  alarmStatusGetter
-	aasSvc autoscalingAlarmNamesGetter
-	rgSvc  resourcesGetter
+	withEvents bool
+	withTasks  bool
+
+	ecsSvc       ecsServiceGetter
+	stoppedTasks serviceStoppedTasksGetter
+	cwSvc        alarmStatusGetter
+	aasSvc       autoscalingGetter
+	rgSvc        resourcesGetter
+	elbSvc       targetHealthGetter
 }
 
 // ServiceStatusDesc contains the status for a service.
 type ServiceStatusDesc struct {
-	Service ecs.ServiceStatus
-	Tasks   []ecs.TaskStatus         `json:"tasks"`
-	Alarms  []cloudwatch.AlarmStatus `json:"alarms"`
+	Service      ecs.ServiceStatus
+	Tasks        []ecs.TaskStatus             `json:"tasks"`
+	StoppedTasks []ecs.TaskStatus             `json:"stoppedTasks,omitempty"`
+	Alarms       []cloudwatch.AlarmStatus     `json:"alarms"`
+	Autoscaling  *ServiceAutoscalingDesc      `json:"autoscaling,omitempty"` // Nil if the service doesn't autoscale.
+	Deployment   *ecs.ServiceDeploymentConfig `json:"deployment,omitempty"`
+	Events       []ServiceEventDesc           `json:"events,omitempty"`
+	ScaledToZero bool                         `json:"scaledToZero,omitempty"` // True if the service has a desired count of 0 and doesn't autoscale.
+
+	env       string
+	withTasks bool // Whether to show the containers of each task in the human readable format.
+}
+
+// ServiceAutoscalingDesc contains the range of tasks of a service that autoscales and its most recent scaling activities.
+type ServiceAutoscalingDesc struct {
+	MinCount     int64                 `json:"minCount"`
+	MaxCount     int64                 `json:"maxCount"`
+	DesiredCount int64                 `json:"desiredCount"`
+	Activities   []aas.ScalingActivity `json:"activities"`
+}
+
+// ServiceHealth summarizes the health of a service. The fields are nil if they couldn't be retrieved.
+type ServiceHealth struct {
+	RunningCount     *int64             `json:"runningCount"`
+	DesiredCount     *int64             `json:"desiredCount"`
+	Targets          *TargetsHealth     `json:"targets"` // Nil if the service isn't behind a load balancer.
+	LatestDeployment *DeploymentRollout `json:"latestDeployment"`
+}
+
+// TargetsHealth counts the load balancer targets of a service that pass their health checks.
+type TargetsHealth struct {
+	Healthy int `json:"healthy"`
+	Total   int `json:"total"`
+}
+
+// DeploymentRollout holds the rollout state of a deployment of a service.
+type DeploymentRollout struct {
+	Status    string    `json:"status"` // Either "IN_PROGRESS" or "COMPLETED".
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ServiceEventDesc is a service event where consecutive events with the same message are collapsed into one.
+type ServiceEventDesc struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Message   string    `json:"message"`
+	Count     int       `json:"count"`
+	Failure   bool      `json:"failure"`
 }
 
 // NewServiceStatusConfig contains fields that initiates ServiceStatus struct.
@@ -68,6 +154,8 @@ type NewServiceStatusConfig struct {
 	Env         string
 	Svc         string
 	ConfigStore ConfigStoreSvc
+	WithEvents  bool // Whether to retrieve the deployments and recent events of the service.
+	WithTasks   bool // Whether to show the status of each container of the service's tasks.
 }
 
 // NewServiceStatus instantiates a new ServiceStatus struct.
@@ -81,13 +169,17 @@ func NewServiceStatus(opt *NewServiceStatusConfig) (*ServiceStatus, error) {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
 	return &ServiceStatus{
-		app:    opt.App,
-		env:    opt.Env,
-		svc:    opt.Svc,
-		rgSvc:  rg.New(sess),
-		cwSvc:  cloudwatch.New(sess),
-		ecsSvc: ecs.New(sess),
-		aasSvc: aas.New(sess),
+		app:          opt.App,
+		env:          opt.Env,
+		svc:          opt.Svc,
+		withEvents:   opt.WithEvents,
+		withTasks:    opt.WithTasks,
+		rgSvc:        rg.New(sess),
+		cwSvc:        cloudwatch.New(sess),
+		ecsSvc:       ecs.New(sess),
+		stoppedTasks: copilotecs.New(sess),
+		aasSvc:       aas.New(sess),
+		elbSvc:       elbv2.New(sess),
 	}, nil
 }
 
@@ -107,19 +199,111 @@ func (s *ServiceStatus) getServiceArn() (*ecs.ServiceArn, error) {
 	return &serviceArn, nil
 }
 
-// Describe returns status of a service.
-func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
+func (s *ServiceStatus) clusterAndServiceName() (cluster string, service string, err error) {
 	serviceArn, err := s.getServiceArn()
 	if err != nil {
-		return nil, fmt.Errorf("get service ARN: %w", err)
+		return "", "", fmt.Errorf("get service ARN: %w", err)
 	}
 	clusterName, err := serviceArn.ClusterName()
 	if err != nil {
-		return nil, fmt.Errorf("get cluster name: %w", err)
+		return "", "", fmt.Errorf("get cluster name: %w", err)
 	}
 	serviceName, err := serviceArn.ServiceName()
 	if err != nil {
-		return nil, fmt.Errorf("get service name: %w", err)
+		return "", "", fmt.Errorf("get service name: %w", err)
+	}
+	return clusterName, serviceName, nil
+}
+
+// DeployedImage is an image run by a container of the service's tasks.
+type DeployedImage struct {
+	Container string
+	URI       string
+	Digest    string
+}
+
+// DeployedImages returns the images run by the containers of the service's tasks.
+func (s *ServiceStatus) DeployedImages() ([]DeployedImage, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.ecsSvc.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, err)
+	}
+	seen := make(map[DeployedImage]bool)
+	var images []DeployedImage
+	for _, task := range tasks {
+		for _, container := range task.Containers {
+			image := DeployedImage{
+				Container: aws.StringValue(container.Name),
+				URI:       aws.StringValue(container.Image),
+				Digest:    aws.StringValue(container.ImageDigest),
+			}
+			if image.Digest == "" || seen[image] {
+				continue
+			}
+			seen[image] = true
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+// Health returns the number of running tasks of the service, the health of its load balancer targets
+// and the rollout state of its latest deployment.
+func (s *ServiceStatus) Health() (*ServiceHealth, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	service, err := s.ecsSvc.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
+	}
+	health := &ServiceHealth{
+		RunningCount: aws.Int64(aws.Int64Value(service.RunningCount)),
+		DesiredCount: aws.Int64(aws.Int64Value(service.DesiredCount)),
+	}
+	if deployments := service.DeploymentConfig().Deployments; len(deployments) != 0 {
+		// The primary deployment is listed first, any other deployment is still being replaced.
+		latest := deployments[0]
+		rollout := &DeploymentRollout{
+			Status:    DeploymentRolloutCompleted,
+			UpdatedAt: latest.UpdatedAt,
+		}
+		if len(deployments) > 1 || latest.RunningCount != latest.DesiredCount {
+			rollout.Status = DeploymentRolloutInProgress
+		}
+		health.LatestDeployment = rollout
+	}
+	targetGroups := service.TargetGroups()
+	if len(targetGroups) == 0 {
+		return health, nil
+	}
+	targetsHealth := &TargetsHealth{}
+	for _, targetGroup := range targetGroups {
+		targets, err := s.elbSvc.TargetsHealth(targetGroup)
+		if err != nil {
+			return nil, fmt.Errorf("get health of targets for service %s: %w", serviceName, err)
+		}
+		for _, target := range targets {
+			targetsHealth.Total++
+			if target.State == targetHealthStateHealthy {
+				targetsHealth.Healthy++
+			}
+		}
+	}
+	health.Targets = targetsHealth
+	return health, nil
+}
+
+// Describe returns status of a service.
+func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
 	}
 	service, err := s.ecsSvc.Service(clusterName, serviceName)
 	if err != nil {
@@ -147,16 +331,89 @@ func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
 		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
 	}
 	alarms = append(alarms, taggedAlarms...)
+	manifestAlarms, err := s.cwSvc.AlarmsWithPrefix(fmt.Sprintf(deploy.ServiceAlarmNamePrefixFormat, s.app, s.env, s.svc))
+	if err != nil {
+		return nil, fmt.Errorf("get CloudWatch alarms created for service %s: %w", s.svc, err)
+	}
+	alarms = appendUniqueAlarms(alarms, manifestAlarms...)
 	autoscalingAlarms, err := s.ecsServiceAutoscalingAlarms(clusterName, serviceName)
 	if err != nil {
 		return nil, err
 	}
-	alarms = append(alarms, autoscalingAlarms...)
-	return &ServiceStatusDesc{
-		Service: service.ServiceStatus(),
-		Tasks:   taskStatus,
-		Alarms:  alarms,
-	}, nil
+	alarms = appendUniqueAlarms(alarms, autoscalingAlarms...)
+	stoppedTasks, err := s.stoppedTasks.ServiceStoppedTasks(s.app, s.env, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get stopped tasks for service %s: %w", s.svc, err)
+	}
+	if len(stoppedTasks) > maxStoppedTasks {
+		stoppedTasks = stoppedTasks[:maxStoppedTasks]
+	}
+	serviceStatus := service.ServiceStatus()
+	autoscaling, err := s.ecsServiceAutoscaling(clusterName, serviceName, serviceStatus.DesiredCount)
+	if err != nil {
+		return nil, err
+	}
+	desc := &ServiceStatusDesc{
+		Service:      serviceStatus,
+		Tasks:        taskStatus,
+		StoppedTasks: stoppedTasks,
+		Alarms:       alarms,
+		Autoscaling:  autoscaling,
+		ScaledToZero: serviceStatus.DesiredCount == 0 && autoscaling == nil,
+		env:          s.env,
+		withTasks:    s.withTasks,
+	}
+	if s.withEvents {
+		deployment := service.DeploymentConfig()
+		desc.Deployment = &deployment
+		desc.Events = collapseServiceEvents(service.LatestEvents(maxServiceEvents))
+	}
+	return desc, nil
+}
+
+// collapseServiceEvents merges consecutive events with the same message into a single event
+// that keeps the time of the most recent occurrence, and flags the events reporting placement or capacity failures.
+func collapseServiceEvents(events []ecs.ServiceEvent) []ServiceEventDesc {
+	var collapsed []ServiceEventDesc
+	for _, event := range events {
+		if last := len(collapsed) - 1; last >= 0 && collapsed[last].Message == event.Message {
+			collapsed[last].Count++
+			continue
+		}
+		collapsed = append(collapsed, ServiceEventDesc{
+			CreatedAt: event.CreatedAt,
+			Message:   event.Message,
+			Count:     1,
+			Failure:   isServiceEventFailure(event.Message),
+		})
+	}
+	return collapsed
+}
+
+func isServiceEventFailure(msg string) bool {
+	msg = strings.ToLower(msg)
+	for _, failure := range serviceEventFailureMessages {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendUniqueAlarms appends the alarms that aren't in the list yet, as an alarm can be both tagged and created from the manifest.
+func appendUniqueAlarms(alarms []cloudwatch.AlarmStatus, others ...cloudwatch.AlarmStatus) []cloudwatch.AlarmStatus {
+	seen := make(map[string]bool, len(alarms))
+	for _, alarm := range alarms {
+		seen[alarm.Name] = true
+	}
+	for _, alarm := range others {
+		if seen[alarm.Name] {
+			continue
+		}
+		seen[alarm.Name] = true
+		alarms = append(alarms, alarm)
+	}
+	return alarms
 }
 
 func (s *ServiceStatus) ecsServiceAutoscalingAlarms(cluster, service string) ([]cloudwatch.AlarmStatus, error) {
@@ -171,6 +428,27 @@ func (s *ServiceStatus) ecsServiceAutoscalingAlarms(cluster, service string) ([]
 	return alarms, nil
 }
 
+// ecsServiceAutoscaling returns the capacity and the recent scaling activities of the service, or nil if it doesn't autoscale.
+func (s *ServiceStatus) ecsServiceAutoscaling(cluster, service string, desiredCount int64) (*ServiceAutoscalingDesc, error) {
+	capacity, err := s.aasSvc.ECSServiceCapacity(cluster, service)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve auto scaling capacity for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if capacity == nil {
+		return nil, nil
+	}
+	activities, err := s.aasSvc.ECSServiceScalingActivities(cluster, service, maxScalingActivities)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve scaling activities for ECS service %s/%s: %w", cluster, service, err)
+	}
+	return &ServiceAutoscalingDesc{
+		MinCount:     capacity.Min,
+		MaxCount:     capacity.Max,
+		DesiredCount: desiredCount,
+		Activities:   activities,
+	}, nil
+}
+
 // JSONString returns the stringified ServiceStatusDesc struct with json format.
 func (s *ServiceStatusDesc) JSONString() (string, error) {
 	b, err := json.Marshal(s)
@@ -188,6 +466,9 @@ func (s *ServiceStatusDesc) HumanString() string {
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s %v / %v running tasks (%v pending)\n", statusColor(s.Service.Status),
 		s.Service.RunningCount, s.Service.DesiredCount, s.Service.DesiredCount-s.Service.RunningCount)
+	if s.ScaledToZero {
+		fmt.Fprintf(writer, "  The service is scaled to zero in environment %s: it runs no tasks until its %s is raised.\n", s.env, color.HighlightCode("count"))
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Updated At", humanizeTime(s.Service.LastDeploymentAt))
@@ -198,18 +479,99 @@ func (s *ServiceStatusDesc) HumanString() string {
 	for _, task := range s.Tasks {
 		fmt.Fprint(writer, task.HumanString())
 	}
+	if s.withTasks {
+		for _, task := range s.Tasks {
+			fmt.Fprint(writer, color.Bold.Sprintf("\nTask %s\n\n", shortTaskID(task.ID)))
+			writer.Flush()
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", "Container", "Image Tag", "Image Digest", "Last Status", "Health Status", "Exit Code")
+			for _, container := range task.Containers {
+				fmt.Fprint(writer, container.HumanString())
+			}
+		}
+	}
+	if len(s.StoppedTasks) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "ID", "Stopped At", "Exit Codes", "Reason")
+		for _, task := range s.StoppedTasks {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", shortTaskID(task.ID), humanizeTime(task.StoppedAt), exitCodesString(task.ExitCodes), task.StoppedReason)
+		}
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
 	writer.Flush()
-	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Name", "Condition", "Last Updated", "Health")
+	if len(s.Alarms) == 0 {
+		fmt.Fprintln(writer, "  No alarms found.")
+	} else {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Name", "Condition", "Last Updated", "Health")
+	}
 	for _, alarm := range s.Alarms {
 		updatedTimeSince := humanizeTime(alarm.UpdatedTimes)
 		printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, alarm.Name, alarm.Condition, updatedTimeSince, alarmHealthColor(alarm.Status))
 		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "", "", "", "")
 	}
 	writer.Flush()
+	if s.Autoscaling != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAutoscaling\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d / %d\n", "Min / Max", s.Autoscaling.MinCount, s.Autoscaling.MaxCount)
+		fmt.Fprintf(writer, "  %s\t%d\n", "Desired Count", s.Autoscaling.DesiredCount)
+		if len(s.Autoscaling.Activities) > 0 {
+			fmt.Fprintf(writer, "\n  %s\t%s\t%s\n", "Started At", "Status", "Cause")
+			for _, activity := range s.Autoscaling.Activities {
+				fmt.Fprintf(writer, "  %s\t%s\t%s\n", humanizeTime(activity.StartTime), scalingActivityStatusColor(activity.Status), activity.Cause)
+			}
+		}
+		writer.Flush()
+	}
+	if s.Deployment != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployments\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d%%\n", "Minimum Healthy Percent", s.Deployment.MinimumHealthyPercent)
+		fmt.Fprintf(writer, "  %s\t%d%%\n\n", "Maximum Percent", s.Deployment.MaximumPercent)
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "Status", "Task Definition", "Running", "Pending", "Updated At")
+		for _, d := range s.Deployment.Deployments {
+			fmt.Fprintf(writer, "  %s\t%s\t%d / %d\t%d\t%s\n", d.Status, d.TaskDefinition,
+				d.RunningCount, d.DesiredCount, d.PendingCount, humanizeTime(d.UpdatedAt))
+		}
+		writer.Flush()
+	}
+	if len(s.Events) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nEvents\n\n"))
+		writer.Flush()
+		for _, event := range s.Events {
+			msg := event.Message
+			if event.Count > 1 {
+				msg = fmt.Sprintf("%s (x%d)", msg, event.Count)
+			}
+			if event.Failure {
+				msg = color.Red.Sprint(msg)
+			}
+			fmt.Fprintf(writer, "  %s\t%s\n", humanizeTime(event.CreatedAt), msg)
+		}
+		writer.Flush()
+	}
 	return b.String()
 }
 
+func shortTaskID(id string) string {
+	if len(id) < shortTaskIDLength {
+		return id
+	}
+	return id[:shortTaskIDLength]
+}
+
+// exitCodesString returns the exit codes of the containers, such as "web: 1, firelens: 0", or "-" if none exited.
+func exitCodesString(exitCodes []ecs.ContainerExitCode) string {
+	if len(exitCodes) == 0 {
+		return "-"
+	}
+	codes := make([]string, len(exitCodes))
+	for i, code := range exitCodes {
+		codes[i] = fmt.Sprintf("%s: %d", code.Name, code.ExitCode)
+	}
+	return strings.Join(codes, ", ")
+}
+
 func printWithMaxWidth(w *tabwriter.Writer, format string, width int, members ...string) {
 	columns := make([][]string, len(members))
 	maxNumOfLinesPerCol := 0
@@ -256,6 +618,19 @@ func alarmHealthColor(status string) string {
 	}
 }
 
+func scalingActivityStatusColor(status string) string {
+	switch status {
+	case "Successful":
+		return color.Green.Sprint(status)
+	case "Pending", "InProgress":
+		return color.Yellow.Sprint(status)
+	case "Failed", "Unfulfilled":
+		return color.Red.Sprint(status)
+	default:
+		return status
+	}
+}
+
 func statusColor(status string) string {
 	switch status {
 	case "ACTIVE":
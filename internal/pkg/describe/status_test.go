@@ -11,8 +11,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -24,10 +26,12 @@ import (
 )
 
 type serviceStatusMocks struct {
-	ecsServiceGetter  *mocks.MockecsServiceGetter
-	alarmStatusGetter *mocks.MockalarmStatusGetter
-	resourcesGetter   *mocks.MockresourcesGetter
-	aas               *mocks.MockautoscalingAlarmNamesGetter
+	ecsServiceGetter   *mocks.MockecsServiceGetter
+	stoppedTasksGetter *mocks.MockserviceStoppedTasksGetter
+	alarmStatusGetter  *mocks.MockalarmStatusGetter
+	resourcesGetter    *mocks.MockresourcesGetter
+	aas                *mocks.MockautoscalingGetter
+	targetHealthGetter *mocks.MocktargetHealthGetter
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -47,6 +51,8 @@ func TestServiceStatus_Describe(t *testing.T) {
 	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
+		withEvents bool
+		withTasks  bool
 		setupMocks func(mocks serviceStatusMocks)
 
 		wantedError   error
@@ -143,6 +149,28 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get tagged CloudWatch alarms: some error"),
 		},
+		"errors if failed to get CloudWatch alarms created from the manifest": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{
+						{
+							TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+							StartedAt: &startTime,
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("get CloudWatch alarms created for service mockSvc: some error"),
+		},
 		"errors if failed to get auto scaling CloudWatch alarm names": {
 			setupMocks: func(m serviceStatusMocks) {
 				gomock.InOrder(
@@ -159,6 +187,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 						},
 					}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
 					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, mockError),
 				)
 			},
@@ -181,6 +210,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 						},
 					}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
 					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{"mockAlarmName"}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{"mockAlarmName"}).Return(nil, mockError),
 				)
@@ -188,7 +218,71 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get auto scaling CloudWatch alarms: some error"),
 		},
+		"errors if failed to get stopped tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.stoppedTasksGetter.EXPECT().ServiceStoppedTasks("mockApp", "mockEnv", "mockSvc").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("get stopped tasks for service mockSvc: some error"),
+		},
+		"errors if failed to get auto scaling capacity": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.stoppedTasksGetter.EXPECT().ServiceStoppedTasks("mockApp", "mockEnv", "mockSvc").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("retrieve auto scaling capacity for ECS service mockCluster/mockService: some error"),
+		},
+		"errors if failed to get scaling activities": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.stoppedTasksGetter.EXPECT().ServiceStoppedTasks("mockApp", "mockEnv", "mockSvc").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 1, Max: 10}, nil),
+					m.aas.EXPECT().ECSServiceScalingActivities(mockCluster, mockService, 5).Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("retrieve scaling activities for ECS service mockCluster/mockService: some error"),
+		},
 		"success": {
+			withTasks: true,
 			setupMocks: func(m serviceStatusMocks) {
 				gomock.InOrder(
 					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
@@ -241,6 +335,24 @@ func TestServiceStatus_Describe(t *testing.T) {
 							UpdatedTimes: updateTime,
 						},
 					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return([]cloudwatch.AlarmStatus{
+						{
+							Arn:          "mockAlarmArn1",
+							Name:         "mockAlarm1",
+							Condition:    "mockCondition",
+							Status:       "OK",
+							Type:         "Metric",
+							UpdatedTimes: updateTime,
+						},
+						{
+							Arn:          "mockAlarmArn3",
+							Name:         "mockApp-mockEnv-mockSvc-CopilotAlarm-CPUUtilization",
+							Condition:    "CPUUtilization > 70.00 for 3 datapoints within 3 minutes",
+							Status:       "ALARM",
+							Type:         "Metric",
+							UpdatedTimes: updateTime,
+						},
+					}, nil),
 					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{"mockAlarm2"}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{"mockAlarm2"}).Return([]cloudwatch.AlarmStatus{
 						{
@@ -252,6 +364,23 @@ func TestServiceStatus_Describe(t *testing.T) {
 							UpdatedTimes: updateTime,
 						},
 					}, nil),
+					m.stoppedTasksGetter.EXPECT().ServiceStoppedTasks("mockApp", "mockEnv", "mockSvc").Return([]ecs.TaskStatus{
+						{ID: "stopped1", StoppedReason: "Essential container in task exited"},
+						{ID: "stopped2"},
+						{ID: "stopped3"},
+						{ID: "stopped4"},
+						{ID: "stopped5"},
+						{ID: "stopped6"},
+					}, nil),
+					m.aas.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 1, Max: 10}, nil),
+					m.aas.EXPECT().ECSServiceScalingActivities(mockCluster, mockService, 5).Return([]aas.ScalingActivity{
+						{
+							Description: "Setting desired count to 1.",
+							Cause:       "monitor alarm mockAlarm2 in state ALARM triggered policy mockPolicy",
+							Status:      "Successful",
+							StartTime:   updateTime,
+						},
+					}, nil),
 				)
 			},
 
@@ -272,6 +401,14 @@ func TestServiceStatus_Describe(t *testing.T) {
 						Type:         "Metric",
 						UpdatedTimes: updateTime,
 					},
+					{
+						Arn:          "mockAlarmArn3",
+						Name:         "mockApp-mockEnv-mockSvc-CopilotAlarm-CPUUtilization",
+						Condition:    "CPUUtilization > 70.00 for 3 datapoints within 3 minutes",
+						Status:       "ALARM",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
 					{
 						Arn:          "mockAlarmArn2",
 						Condition:    "mockCondition",
@@ -296,11 +433,167 @@ func TestServiceStatus_Describe(t *testing.T) {
 								Digest: "ca27a44e25ce17fea7b07940ad793",
 							},
 						},
+						Containers: []ecs.ContainerStatus{
+							{
+								Image: ecs.Image{
+									Digest: "69671a968e8ec3648e2697417750e",
+									ID:     "mockImageID1",
+								},
+							},
+							{
+								Image: ecs.Image{
+									ID:     "mockImageID2",
+									Digest: "ca27a44e25ce17fea7b07940ad793",
+								},
+							},
+						},
 						StartedAt:     startTime,
 						StoppedAt:     stopTime,
 						StoppedReason: "some reason",
 					},
 				},
+				StoppedTasks: []ecs.TaskStatus{
+					{ID: "stopped1", StoppedReason: "Essential container in task exited"},
+					{ID: "stopped2"},
+					{ID: "stopped3"},
+					{ID: "stopped4"},
+					{ID: "stopped5"},
+				},
+				Autoscaling: &ServiceAutoscalingDesc{
+					MinCount:     1,
+					MaxCount:     10,
+					DesiredCount: 1,
+					Activities: []aas.ScalingActivity{
+						{
+							Description: "Setting desired count to 1.",
+							Cause:       "monitor alarm mockAlarm2 in state ALARM triggered policy mockPolicy",
+							Status:      "Successful",
+							StartTime:   updateTime,
+						},
+					},
+				},
+				env:       "mockEnv",
+				withTasks: true,
+			},
+		},
+		"success with a service scaled to zero": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						Status:       aws.String("ACTIVE"),
+						DesiredCount: aws.Int64(0),
+						RunningCount: aws.Int64(0),
+					}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(mockTags).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.stoppedTasksGetter.EXPECT().ServiceStoppedTasks("mockApp", "mockEnv", "mockSvc").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(nil, nil),
+				)
+			},
+
+			wantedContent: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					Status: "ACTIVE",
+				},
+				ScaledToZero: true,
+				env:          "mockEnv",
+			},
+		},
+		"success with deployments and collapsed events": {
+			withEvents: true,
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						Status:       aws.String("ACTIVE"),
+						DesiredCount: aws.Int64(1),
+						RunningCount: aws.Int64(0),
+						DeploymentConfiguration: &ecsapi.DeploymentConfiguration{
+							MinimumHealthyPercent: aws.Int64(100),
+							MaximumPercent:        aws.Int64(200),
+						},
+						Deployments: []*ecsapi.Deployment{
+							{
+								Status:         aws.String("PRIMARY"),
+								UpdatedAt:      &startTime,
+								TaskDefinition: aws.String("mockTaskDefinition"),
+								DesiredCount:   aws.Int64(1),
+								PendingCount:   aws.Int64(1),
+								RunningCount:   aws.Int64(0),
+							},
+						},
+						Events: []*ecsapi.ServiceEvent{
+							{
+								CreatedAt: &updateTime,
+								Message:   aws.String("(service mockService) was unable to place a task because no container instance met all of its requirements."),
+							},
+							{
+								CreatedAt: &stopTime,
+								Message:   aws.String("(service mockService) was unable to place a task because no container instance met all of its requirements."),
+							},
+							{
+								CreatedAt: &startTime,
+								Message:   aws.String("(service mockService) has started 1 tasks."),
+							},
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(mockTags).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithPrefix("mockApp-mockEnv-mockSvc-CopilotAlarm-").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.stoppedTasksGetter.EXPECT().ServiceStoppedTasks("mockApp", "mockEnv", "mockSvc").Return(nil, nil),
+					m.aas.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(nil, nil),
+				)
+			},
+
+			wantedContent: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     0,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Deployment: &ecs.ServiceDeploymentConfig{
+					MinimumHealthyPercent: 100,
+					MaximumPercent:        200,
+					Deployments: []ecs.ServiceDeployment{
+						{
+							Status:         "PRIMARY",
+							TaskDefinition: "mockTaskDefinition",
+							DesiredCount:   1,
+							PendingCount:   1,
+							UpdatedAt:      startTime,
+						},
+					},
+				},
+				Events: []ServiceEventDesc{
+					{
+						CreatedAt: updateTime,
+						Message:   "(service mockService) was unable to place a task because no container instance met all of its requirements.",
+						Count:     2,
+						Failure:   true,
+					},
+					{
+						CreatedAt: startTime,
+						Message:   "(service mockService) has started 1 tasks.",
+						Count:     1,
+					},
+				},
+				env: "mockEnv",
 			},
 		},
 	}
@@ -311,26 +604,31 @@ func TestServiceStatus_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockStoppedTasks := mocks.NewMockserviceStoppedTasksGetter(ctrl)
 			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
 			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
-			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			mockaasClient := mocks.NewMockautoscalingGetter(ctrl)
 			mocks := serviceStatusMocks{
-				ecsServiceGetter:  mockecsSvc,
-				alarmStatusGetter: mockcwSvc,
-				resourcesGetter:   mockrgSvc,
-				aas:               mockaasClient,
+				ecsServiceGetter:   mockecsSvc,
+				stoppedTasksGetter: mockStoppedTasks,
+				alarmStatusGetter:  mockcwSvc,
+				resourcesGetter:    mockrgSvc,
+				aas:                mockaasClient,
 			}
 
 			tc.setupMocks(mocks)
 
 			svcStatus := &ServiceStatus{
-				svc:    "mockSvc",
-				env:    "mockEnv",
-				app:    "mockApp",
-				cwSvc:  mockcwSvc,
-				ecsSvc: mockecsSvc,
-				rgSvc:  mockrgSvc,
-				aasSvc: mockaasClient,
+				svc:          "mockSvc",
+				env:          "mockEnv",
+				app:          "mockApp",
+				withEvents:   tc.withEvents,
+				withTasks:    tc.withTasks,
+				cwSvc:        mockcwSvc,
+				ecsSvc:       mockecsSvc,
+				stoppedTasks: mockStoppedTasks,
+				rgSvc:        mockrgSvc,
+				aasSvc:       mockaasClient,
 			}
 
 			// WHEN
@@ -347,87 +645,365 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
-func TestServiceStatusDesc_String(t *testing.T) {
-	// from the function changes (ex: from "1 month ago" to "2 months ago"). To make our tests stable,
-	oldHumanize := humanizeTime
-	humanizeTime = func(then time.Time) string {
-		now, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00+00:00")
-		return humanize.RelTime(then, now, "ago", "from now")
+func TestServiceStatus_DeployedImages(t *testing.T) {
+	const (
+		mockCluster    = "mockCluster"
+		mockService    = "mockService"
+		mockServiceArn = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	)
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
 	}
-	defer func() {
-		humanizeTime = oldHumanize
-	}()
-
-	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
-	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
-
+	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		desc  *ServiceStatusDesc
-		human string
-		json  string
+		setupMocks func(mocks serviceStatusMocks)
+
+		wantedError  error
+		wantedImages []DeployedImage
 	}{
-		"while provisioning": {
-			desc: &ServiceStatusDesc{
-				Service: ecs.ServiceStatus{
-					DesiredCount:     1,
-					RunningCount:     0,
-					Status:           "ACTIVE",
-					LastDeploymentAt: startTime,
-					TaskDefinition:   "mockTaskDefinition",
-				},
-				Alarms: []cloudwatch.AlarmStatus{
-					{
-						Arn:          "mockAlarmArn1",
-						Name:         "mySupercalifragilisticexpialidociousAlarm",
-						Condition:    "RequestCount > 100.00 for 3 datapoints within 25 minutes",
-						Status:       "OK",
-						Type:         "Metric",
-						UpdatedTimes: updateTime,
-					},
-					{
-						Arn:          "mockAlarmArn2",
-						Name:         "Um-dittle-ittl-um-dittle-I-Alarm",
-						Condition:    "CPUUtilization > 70.00 for 3 datapoints within 3 minutes",
-						Status:       "OK",
-						Type:         "Metric",
-						UpdatedTimes: updateTime,
-					},
-				},
-				Tasks: []ecs.TaskStatus{
-					{
-						Health:     "HEALTHY",
-						LastStatus: "PROVISIONING",
-						ID:         "1234567890123456789",
-					},
-				},
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, mockError)
 			},
-			human: `Service Status
-
-  ACTIVE 0 / 1 running tasks (1 pending)
-
-Last Deployment
 
-  Updated At         14 years ago
-  Task Definition    mockTaskDefinition
-
-Task Status
-
-  ID                Image Digest        Last Status         Started At          Stopped At          Health Status
-  12345678          -                   PROVISIONING        -                   -                   HEALTHY
-
-Alarms
+			wantedError: fmt.Errorf("get service ARN: some error"),
+		},
+		"errors if failed to get tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, mockError),
+				)
+			},
 
-  Name                              Condition                         Last Updated         Health
-  mySupercalifragilisticexpialid    RequestCount > 100.00 for 3 da    2 months from now    OK
-  ociousAlarm                       tapoints within 25 minutes                             
-                                                                                           
-  Um-dittle-ittl-um-dittle-I-Ala    CPUUtilization > 70.00 for 3 d    2 months from now    OK
-  rm                                atapoints within 3 minutes                             
-                                                                                           
-`,
-			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":0,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":null,\"lastStatus\":\"PROVISIONING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"\"}],\"alarms\":[{\"arn\":\"mockAlarmArn1\",\"name\":\"mySupercalifragilisticexpialidociousAlarm\",\"condition\":\"RequestCount \\u003e 100.00 for 3 datapoints within 25 minutes\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"},{\"arn\":\"mockAlarmArn2\",\"name\":\"Um-dittle-ittl-um-dittle-I-Alarm\",\"condition\":\"CPUUtilization \\u003e 70.00 for 3 datapoints within 3 minutes\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
+			wantedError: fmt.Errorf("get tasks for service mockService: some error"),
 		},
-		"running": {
+		"returns the distinct images of the tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				container := func(name, digest string) *ecsapi.Container {
+					return &ecsapi.Container{
+						Name:        aws.String(name),
+						Image:       aws.String("mockRepo:" + name),
+						ImageDigest: aws.String(digest),
+					}
+				}
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{
+						{
+							Containers: []*ecsapi.Container{container("mockSvc", "sha256:1234"), container("nginx", "sha256:5678")},
+						},
+						{
+							Containers: []*ecsapi.Container{container("mockSvc", "sha256:1234"), container("nginx", "")},
+						},
+					}, nil),
+				)
+			},
+
+			wantedImages: []DeployedImage{
+				{
+					Container: "mockSvc",
+					URI:       "mockRepo:mockSvc",
+					Digest:    "sha256:1234",
+				},
+				{
+					Container: "nginx",
+					URI:       "mockRepo:nginx",
+					Digest:    "sha256:5678",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			mocks := serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			}
+
+			tc.setupMocks(mocks)
+
+			svcStatus := &ServiceStatus{
+				svc:    "mockSvc",
+				env:    "mockEnv",
+				app:    "mockApp",
+				ecsSvc: mockecsSvc,
+				rgSvc:  mockrgSvc,
+			}
+
+			// WHEN
+			images, err := svcStatus.DeployedImages()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedImages, images)
+			}
+		})
+	}
+}
+
+func TestServiceStatus_Health(t *testing.T) {
+	const (
+		mockCluster     = "mockCluster"
+		mockService     = "mockService"
+		mockServiceArn  = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockTargetGroup = "arn:aws:elasticloadbalancing:us-west-2:1234567890:targetgroup/mockTargetGroup/1234"
+	)
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	updatedAt := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m serviceStatusMocks)
+
+		wantedHealth *ServiceHealth
+		wantedError  error
+	}{
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get service ARN: some error"),
+		},
+		"errors if failed to get the health of the targets": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						LoadBalancers: []*ecsapi.LoadBalancer{
+							{
+								TargetGroupArn: aws.String(mockTargetGroup),
+							},
+						},
+					}, nil),
+					m.targetHealthGetter.EXPECT().TargetsHealth(mockTargetGroup).Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get health of targets for service mockService: some error"),
+		},
+		"summarizes the health of a service behind a load balancer": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						DesiredCount: aws.Int64(3),
+						RunningCount: aws.Int64(3),
+						Deployments: []*ecsapi.Deployment{
+							{
+								DesiredCount: aws.Int64(3),
+								RunningCount: aws.Int64(3),
+								UpdatedAt:    aws.Time(updatedAt),
+							},
+						},
+						LoadBalancers: []*ecsapi.LoadBalancer{
+							{
+								TargetGroupArn: aws.String(mockTargetGroup),
+							},
+						},
+					}, nil),
+					m.targetHealthGetter.EXPECT().TargetsHealth(mockTargetGroup).Return([]*elbv2.TargetHealth{
+						{State: "healthy"},
+						{State: "healthy"},
+						{State: "draining"},
+					}, nil),
+				)
+			},
+			wantedHealth: &ServiceHealth{
+				RunningCount: aws.Int64(3),
+				DesiredCount: aws.Int64(3),
+				Targets: &TargetsHealth{
+					Healthy: 2,
+					Total:   3,
+				},
+				LatestDeployment: &DeploymentRollout{
+					Status:    DeploymentRolloutCompleted,
+					UpdatedAt: updatedAt,
+				},
+			},
+		},
+		"reports a deployment in progress while the previous one is being replaced": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						DesiredCount: aws.Int64(2),
+						RunningCount: aws.Int64(3),
+						Deployments: []*ecsapi.Deployment{
+							{
+								DesiredCount: aws.Int64(2),
+								RunningCount: aws.Int64(2),
+								UpdatedAt:    aws.Time(updatedAt),
+							},
+							{
+								DesiredCount: aws.Int64(1),
+								RunningCount: aws.Int64(1),
+								UpdatedAt:    aws.Time(updatedAt.Add(-time.Hour)),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedHealth: &ServiceHealth{
+				RunningCount: aws.Int64(3),
+				DesiredCount: aws.Int64(2),
+				LatestDeployment: &DeploymentRollout{
+					Status:    DeploymentRolloutInProgress,
+					UpdatedAt: updatedAt,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceStatusMocks{
+				ecsServiceGetter:   mocks.NewMockecsServiceGetter(ctrl),
+				resourcesGetter:    mocks.NewMockresourcesGetter(ctrl),
+				targetHealthGetter: mocks.NewMocktargetHealthGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			svcStatus := &ServiceStatus{
+				svc:    "mockSvc",
+				env:    "mockEnv",
+				app:    "mockApp",
+				ecsSvc: m.ecsServiceGetter,
+				rgSvc:  m.resourcesGetter,
+				elbSvc: m.targetHealthGetter,
+			}
+
+			// WHEN
+			health, err := svcStatus.Health()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedHealth, health)
+			}
+		})
+	}
+}
+
+func TestServiceStatusDesc_String(t *testing.T) {
+	// from the function changes (ex: from "1 month ago" to "2 months ago"). To make our tests stable,
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+
+	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
+
+	testCases := map[string]struct {
+		desc  *ServiceStatusDesc
+		human string
+		json  string
+	}{
+		"while provisioning": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     0,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Arn:          "mockAlarmArn1",
+						Name:         "mySupercalifragilisticexpialidociousAlarm",
+						Condition:    "RequestCount > 100.00 for 3 datapoints within 25 minutes",
+						Status:       "OK",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+					{
+						Arn:          "mockAlarmArn2",
+						Name:         "Um-dittle-ittl-um-dittle-I-Alarm",
+						Condition:    "CPUUtilization > 70.00 for 3 datapoints within 3 minutes",
+						Status:       "OK",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+				},
+				Tasks: []ecs.TaskStatus{
+					{
+						Health:     "HEALTHY",
+						LastStatus: "PROVISIONING",
+						ID:         "1234567890123456789",
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 0 / 1 running tasks (1 pending)
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Health Status
+  12345678          -                   PROVISIONING        -                   -                   HEALTHY
+
+Alarms
+
+  Name                              Condition                         Last Updated         Health
+  mySupercalifragilisticexpialid    RequestCount > 100.00 for 3 da    2 months from now    OK
+  ociousAlarm                       tapoints within 25 minutes                             
+                                                                                           
+  Um-dittle-ittl-um-dittle-I-Ala    CPUUtilization > 70.00 for 3 d    2 months from now    OK
+  rm                                atapoints within 3 minutes                             
+                                                                                           
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":0,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":null,\"lastStatus\":\"PROVISIONING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"\"}],\"alarms\":[{\"arn\":\"mockAlarmArn1\",\"name\":\"mySupercalifragilisticexpialidociousAlarm\",\"condition\":\"RequestCount \\u003e 100.00 for 3 datapoints within 25 minutes\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"},{\"arn\":\"mockAlarmArn2\",\"name\":\"Um-dittle-ittl-um-dittle-I-Alarm\",\"condition\":\"CPUUtilization \\u003e 70.00 for 3 datapoints within 3 minutes\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
+		},
+		"running": {
 			desc: &ServiceStatusDesc{
 				Service: ecs.ServiceStatus{
 					DesiredCount:     1,
@@ -487,6 +1063,314 @@ Alarms
 `,
 			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":[{\"ID\":\"mockImageID1\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},{\"ID\":\"mockImageID2\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"}],\"lastStatus\":\"RUNNING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"some reason\"}],\"alarms\":[{\"arn\":\"mockAlarmArn\",\"name\":\"mockAlarm\",\"condition\":\"mockCondition\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
 		},
+		"with stopped tasks": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Tasks: []ecs.TaskStatus{
+					{
+						Health:     "HEALTHY",
+						LastStatus: "RUNNING",
+						ID:         "1234567890123456789",
+					},
+				},
+				StoppedTasks: []ecs.TaskStatus{
+					{
+						ID:            "abcdef0123456789",
+						LastStatus:    "STOPPED",
+						StoppedAt:     updateTime,
+						StoppedReason: "Essential container in task exited",
+						ExitCodes: []ecs.ContainerExitCode{
+							{
+								Name:     "web",
+								ExitCode: 1,
+							},
+							{
+								Name:     "firelens",
+								ExitCode: 0,
+							},
+						},
+					},
+					{
+						ID:            "9876543210fedcba",
+						LastStatus:    "STOPPED",
+						StoppedAt:     startTime,
+						StoppedReason: "Task failed ELB health checks",
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Health Status
+  12345678          -                   RUNNING             -                   -                   HEALTHY
+
+Stopped Tasks
+
+  ID                Stopped At           Exit Codes             Reason
+  abcdef01          2 months from now    web: 1, firelens: 0    Essential container in task exited
+  98765432          14 years ago         -                      Task failed ELB health checks
+
+Alarms
+
+  No alarms found.
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":null,\"lastStatus\":\"RUNNING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"\"}],\"stoppedTasks\":[{\"health\":\"\",\"id\":\"abcdef0123456789\",\"images\":null,\"lastStatus\":\"STOPPED\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"2020-03-13T19:50:30Z\",\"stoppedReason\":\"Essential container in task exited\",\"exitCodes\":[{\"name\":\"web\",\"exitCode\":1},{\"name\":\"firelens\",\"exitCode\":0}]},{\"health\":\"\",\"id\":\"9876543210fedcba\",\"images\":null,\"lastStatus\":\"STOPPED\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"2006-01-02T15:04:05Z\",\"stoppedReason\":\"Task failed ELB health checks\"}],\"alarms\":null}\n",
+		},
+		"with task containers": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Tasks: []ecs.TaskStatus{
+					{
+						Health:     "UNHEALTHY",
+						LastStatus: "RUNNING",
+						ID:         "1234567890123456789",
+						Images: []ecs.Image{
+							{
+								ID:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2",
+								Digest: "69671a968e8ec3648e2697417750e",
+							},
+							{
+								ID:     "amazon/aws-for-fluent-bit",
+								Digest: "ca27a44e25ce17fea7b07940ad793",
+							},
+						},
+						Containers: []ecs.ContainerStatus{
+							{
+								Name: "web",
+								Image: ecs.Image{
+									ID:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2",
+									Digest: "69671a968e8ec3648e2697417750e",
+								},
+								LastStatus: "RUNNING",
+								Health:     "HEALTHY",
+							},
+							{
+								Name: "firelens",
+								Image: ecs.Image{
+									ID:     "amazon/aws-for-fluent-bit",
+									Digest: "ca27a44e25ce17fea7b07940ad793",
+								},
+								LastStatus: "STOPPED",
+								Health:     "UNKNOWN",
+								ExitCode:   aws.Int(1),
+							},
+						},
+					},
+				},
+				withTasks: true,
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest         Last Status         Started At          Stopped At          Health Status
+  12345678          69671a96,ca27a44e    RUNNING             -                   -                   UNHEALTHY
+
+Task 12345678
+
+  Container         Image Tag           Image Digest        Last Status         Health Status       Exit Code
+  web               v1.2                69671a96            RUNNING             HEALTHY             -
+  firelens          -                   ca27a44e            STOPPED             UNKNOWN             1
+
+Alarms
+
+  No alarms found.
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"UNHEALTHY\",\"id\":\"1234567890123456789\",\"images\":[{\"ID\":\"123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},{\"ID\":\"amazon/aws-for-fluent-bit\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"}],\"lastStatus\":\"RUNNING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"\",\"containers\":[{\"name\":\"web\",\"image\":{\"ID\":\"123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/web:v1.2\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},\"lastStatus\":\"RUNNING\",\"health\":\"HEALTHY\"},{\"name\":\"firelens\",\"image\":{\"ID\":\"amazon/aws-for-fluent-bit\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"},\"lastStatus\":\"STOPPED\",\"health\":\"UNKNOWN\",\"exitCode\":1}]}],\"alarms\":null}\n",
+		},
+		"with autoscaling": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     3,
+					RunningCount:     3,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Autoscaling: &ServiceAutoscalingDesc{
+					MinCount:     1,
+					MaxCount:     10,
+					DesiredCount: 3,
+					Activities: []aas.ScalingActivity{
+						{
+							Description: "Setting desired count to 3.",
+							Cause:       "monitor alarm mockAlarm in state ALARM triggered policy mockPolicy",
+							Status:      "InProgress",
+							StartTime:   updateTime,
+						},
+						{
+							Description:   "Setting desired count to 2.",
+							Cause:         "monitor alarm mockAlarm in state ALARM triggered policy mockPolicy",
+							Status:        "Successful",
+							StatusMessage: "Successfully set desired count to 2.",
+							StartTime:     startTime,
+							EndTime:       aws.Time(startTime.Add(2 * time.Minute)),
+						},
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 3 / 3 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Health Status
+
+Alarms
+
+  No alarms found.
+
+Autoscaling
+
+  Min / Max         1 / 10
+  Desired Count     3
+
+  Started At           Status              Cause
+  2 months from now    InProgress          monitor alarm mockAlarm in state ALARM triggered policy mockPolicy
+  14 years ago         Successful          monitor alarm mockAlarm in state ALARM triggered policy mockPolicy
+`,
+			json: "{\"Service\":{\"desiredCount\":3,\"runningCount\":3,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"autoscaling\":{\"minCount\":1,\"maxCount\":10,\"desiredCount\":3,\"activities\":[{\"description\":\"Setting desired count to 3.\",\"cause\":\"monitor alarm mockAlarm in state ALARM triggered policy mockPolicy\",\"status\":\"InProgress\",\"startTime\":\"2020-03-13T19:50:30Z\"},{\"description\":\"Setting desired count to 2.\",\"cause\":\"monitor alarm mockAlarm in state ALARM triggered policy mockPolicy\",\"status\":\"Successful\",\"statusMessage\":\"Successfully set desired count to 2.\",\"startTime\":\"2006-01-02T15:04:05Z\",\"endTime\":\"2006-01-02T15:06:05Z\"}]}}\n",
+		},
+		"with deployments and events": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Deployment: &ecs.ServiceDeploymentConfig{
+					MinimumHealthyPercent: 100,
+					MaximumPercent:        200,
+					Deployments: []ecs.ServiceDeployment{
+						{
+							Status:         "PRIMARY",
+							TaskDefinition: "mockTaskDefinition:2",
+							DesiredCount:   2,
+							RunningCount:   1,
+							PendingCount:   1,
+							UpdatedAt:      updateTime,
+						},
+						{
+							Status:         "ACTIVE",
+							TaskDefinition: "mockTaskDefinition:1",
+							DesiredCount:   1,
+							RunningCount:   1,
+							UpdatedAt:      startTime,
+						},
+					},
+				},
+				Events: []ServiceEventDesc{
+					{
+						CreatedAt: updateTime,
+						Message:   "(service mockService) was unable to place a task because no container instance met all of its requirements.",
+						Count:     3,
+						Failure:   true,
+					},
+					{
+						CreatedAt: startTime,
+						Message:   "(service mockService) has reached a steady state.",
+						Count:     1,
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Health Status
+
+Alarms
+
+  No alarms found.
+
+Deployments
+
+  Minimum Healthy Percent    100%
+  Maximum Percent            200%
+
+  Status            Task Definition         Running             Pending             Updated At
+  PRIMARY           mockTaskDefinition:2    1 / 2               1                   2 months from now
+  ACTIVE            mockTaskDefinition:1    1 / 1               0                   14 years ago
+
+Events
+
+  2 months from now    (service mockService) was unable to place a task because no container instance met all of its requirements. (x3)
+  14 years ago         (service mockService) has reached a steady state.
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"deployment\":{\"minimumHealthyPercent\":100,\"maximumPercent\":200,\"deployments\":[{\"status\":\"PRIMARY\",\"taskDefinition\":\"mockTaskDefinition:2\",\"desiredCount\":2,\"runningCount\":1,\"pendingCount\":1,\"updatedAt\":\"2020-03-13T19:50:30Z\"},{\"status\":\"ACTIVE\",\"taskDefinition\":\"mockTaskDefinition:1\",\"desiredCount\":1,\"runningCount\":1,\"pendingCount\":0,\"updatedAt\":\"2006-01-02T15:04:05Z\"}]},\"events\":[{\"createdAt\":\"2020-03-13T19:50:30Z\",\"message\":\"(service mockService) was unable to place a task because no container instance met all of its requirements.\",\"count\":3,\"failure\":true},{\"createdAt\":\"2006-01-02T15:04:05Z\",\"message\":\"(service mockService) has reached a steady state.\",\"count\":1,\"failure\":false}]}\n",
+		},
+		"scaled to zero": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				ScaledToZero: true,
+				env:          "test",
+			},
+			human: `Service Status
+
+  ACTIVE 0 / 0 running tasks (0 pending)
+  The service is scaled to zero in environment test: it runs no tasks until its ` + "`count`" + ` is raised.
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Health Status
+
+Alarms
+
+  No alarms found.
+`,
+			json: "{\"Service\":{\"desiredCount\":0,\"runningCount\":0,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"scaledToZero\":true}\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,143 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	shortGitSHALength       = 7
+	shortImageDigestLength  = 12
+	shortTemplateHashLength = 12
+)
+
+type appRegionalResourcesGetter interface {
+	GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error)
+}
+
+type deploymentHistoryLister interface {
+	List(bucket, app, env, workload string, limit int) ([]*deploy.WorkloadDeploymentRecord, error)
+}
+
+// ServiceDeployments contains the most recent deployments of a service to an environment, newest first.
+type ServiceDeployments struct {
+	Deployments []*deploy.WorkloadDeploymentRecord `json:"deployments"`
+}
+
+// ServiceDeploymentsDescriber retrieves the deployment history of a service in an environment.
+type ServiceDeploymentsDescriber struct {
+	app *config.Application
+	env *config.Environment
+	svc string
+
+	appResources appRegionalResourcesGetter
+	history      deploymentHistoryLister
+}
+
+// NewServiceDeploymentsConfig contains fields that initiate a ServiceDeploymentsDescriber struct.
+type NewServiceDeploymentsConfig struct {
+	App         string
+	Env         string
+	Svc         string
+	ConfigStore ConfigStoreSvc
+}
+
+// NewServiceDeploymentsDescriber instantiates a describer for the deployment history of a service in an environment.
+// The history is stored in the application's S3 bucket of the environment's region.
+func NewServiceDeploymentsDescriber(opt NewServiceDeploymentsConfig) (*ServiceDeploymentsDescriber, error) {
+	app, err := opt.ConfigStore.GetApplication(opt.App)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", opt.App, err)
+	}
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	defaultSessEnvRegion, err := sessions.NewProvider().DefaultWithRegion(env.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceDeploymentsDescriber{
+		app:          app,
+		env:          env,
+		svc:          opt.Svc,
+		appResources: deploycfn.New(defaultSess),
+		history:      deploy.NewDeploymentHistory(s3.New(defaultSessEnvRegion)),
+	}, nil
+}
+
+// Deployments returns up to limit of the most recent deployments of the service, newest first.
+func (d *ServiceDeploymentsDescriber) Deployments(limit int) (*ServiceDeployments, error) {
+	resources, err := d.appResources.GetAppResourcesByRegion(d.app, d.env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", d.app.Name, d.env.Region, err)
+	}
+	records, err := d.history.List(resources.S3Bucket, d.app.Name, d.env.Name, d.svc, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list deployments of service %s: %w", d.svc, err)
+	}
+	return &ServiceDeployments{
+		Deployments: records,
+	}, nil
+}
+
+// JSONString returns the stringified ServiceDeployments struct in json format.
+func (s *ServiceDeployments) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal service deployments: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceDeployments struct in human readable format.
+// The git commits, image digests and template hashes are shortened.
+func (s *ServiceDeployments) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Deployments\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", "Deployed At", "Image Tag", "Image Digest", "Git Commit", "Template Hash", "Deployed By")
+	for _, rec := range s.Deployments {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", humanizeTime(rec.Timestamp),
+			valueOrDash(rec.ImageTag),
+			valueOrDash(shorten(strings.TrimPrefix(rec.ImageDigest, "sha256:"), shortImageDigestLength)),
+			valueOrDash(shorten(rec.GitSHA, shortGitSHALength)),
+			valueOrDash(shorten(rec.TemplateHash, shortTemplateHashLength)),
+			valueOrDash(rec.DeployerARN))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func shorten(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length]
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceDeploymentsMocks struct {
+	appResources *mocks.MockappRegionalResourcesGetter
+	history      *mocks.MockdeploymentHistoryLister
+}
+
+func TestServiceDeploymentsDescriber_Deployments(t *testing.T) {
+	mockApp := &config.Application{
+		Name: "phonetool",
+	}
+	mockRecords := []*deploy.WorkloadDeploymentRecord{
+		{
+			App:       "phonetool",
+			Env:       "test",
+			Workload:  "frontend",
+			Timestamp: time.Date(2020, time.November, 23, 0, 0, 0, 0, time.UTC),
+			ImageTag:  "v1.2.0",
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m serviceDeploymentsMocks)
+
+		wanted      *ServiceDeployments
+		wantedError error
+	}{
+		"wraps error if fail to get the application's resources": {
+			setupMocks: func(m serviceDeploymentsMocks) {
+				m.appResources.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool resources from region us-west-2: some error"),
+		},
+		"wraps error if fail to list the deployments": {
+			setupMocks: func(m serviceDeploymentsMocks) {
+				m.appResources.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "bucket",
+				}, nil)
+				m.history.EXPECT().List("bucket", "phonetool", "test", "frontend", 5).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list deployments of service frontend: some error"),
+		},
+		"returns the deployments from the bucket of the environment's region": {
+			setupMocks: func(m serviceDeploymentsMocks) {
+				m.appResources.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "bucket",
+				}, nil)
+				m.history.EXPECT().List("bucket", "phonetool", "test", "frontend", 5).Return(mockRecords, nil)
+			},
+			wanted: &ServiceDeployments{
+				Deployments: mockRecords,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceDeploymentsMocks{
+				appResources: mocks.NewMockappRegionalResourcesGetter(ctrl),
+				history:      mocks.NewMockdeploymentHistoryLister(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &ServiceDeploymentsDescriber{
+				app: mockApp,
+				env: &config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				},
+				svc:          "frontend",
+				appResources: m.appResources,
+				history:      m.history,
+			}
+
+			// WHEN
+			got, err := d.Deployments(5)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestServiceDeployments_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-11-23T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	testCases := map[string]struct {
+		deployments *ServiceDeployments
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no deployments": {
+			deployments: &ServiceDeployments{
+				Deployments: []*deploy.WorkloadDeploymentRecord{},
+			},
+			wantedHumanString: `Deployments
+
+  Deployed At       Image Tag           Image Digest        Git Commit          Template Hash       Deployed By
+`,
+			wantedJSONString: "{\"deployments\":[]}\n",
+		},
+		"with deployments": {
+			deployments: &ServiceDeployments{
+				Deployments: []*deploy.WorkloadDeploymentRecord{
+					{
+						App:          "phonetool",
+						Env:          "test",
+						Workload:     "frontend",
+						Timestamp:    time.Date(2020, time.November, 23, 9, 0, 0, 0, time.UTC),
+						ImageTag:     "v1.2.0",
+						ImageDigest:  "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+						GitSHA:       "bb133e7a8f23a5bb7a4ba5a3b2cd5e1f4c9b41d2",
+						DeployerARN:  "arn:aws:iam::123456789012:user/alice",
+						TemplateHash: "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8",
+					},
+					{
+						App:          "phonetool",
+						Env:          "test",
+						Workload:     "frontend",
+						Timestamp:    time.Date(2020, time.November, 20, 12, 0, 0, 0, time.UTC),
+						DeployerARN:  "arn:aws:iam::123456789012:user/bob",
+						TemplateHash: "2cf24dba5fb0",
+					},
+				},
+			},
+			wantedHumanString: `Deployments
+
+  Deployed At       Image Tag           Image Digest        Git Commit          Template Hash       Deployed By
+  3 hours ago       v1.2.0              18f7eb6cff6e        bb133e7             5e884898da28        arn:aws:iam::123456789012:user/alice
+  3 days ago        -                   -                   -                   2cf24dba5fb0        arn:aws:iam::123456789012:user/bob
+`,
+			wantedJSONString: "{\"deployments\":[{\"app\":\"phonetool\",\"env\":\"test\",\"workload\":\"frontend\",\"timestamp\":\"2020-11-23T09:00:00Z\",\"imageTag\":\"v1.2.0\",\"imageDigest\":\"sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7\",\"gitSha\":\"bb133e7a8f23a5bb7a4ba5a3b2cd5e1f4c9b41d2\",\"deployerArn\":\"arn:aws:iam::123456789012:user/alice\",\"templateHash\":\"5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8\"},{\"app\":\"phonetool\",\"env\":\"test\",\"workload\":\"frontend\",\"timestamp\":\"2020-11-20T12:00:00Z\",\"deployerArn\":\"arn:aws:iam::123456789012:user/bob\",\"templateHash\":\"2cf24dba5fb0\"}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.deployments.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, tc.deployments.HumanString())
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
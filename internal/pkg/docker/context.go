@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	dockerignoreFileName = ".dockerignore"
+
+	// maxLargestContextDirs is the number of largest directories reported for a build context.
+	maxLargestContextDirs = 5
+)
+
+// ContextSize is the size of the files of a build context that are sent to the Docker daemon.
+type ContextSize struct {
+	Bytes       int64     // Total size of the files that aren't excluded by the .dockerignore file of the context.
+	LargestDirs []DirSize // Top-level directories of the context with the most bytes, largest first.
+}
+
+// DirSize is the size of the files under a directory of a build context.
+type DirSize struct {
+	Path  string // Path of the directory relative to the build context, such as "node_modules".
+	Bytes int64
+}
+
+// BuildContextSize returns the size of the build context directory. Files and directories that match
+// a pattern of the ".dockerignore" file in the directory are excluded, like they are by `docker build`.
+// Only the metadata of the files is read, so that computing the size of small contexts stays fast.
+func BuildContextSize(dir string) (*ContextSize, error) {
+	ignored, err := readDockerignore(dir)
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	dirSizes := make(map[string]int64)
+	err = filepath.Walk(dir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, fullPath)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+		relPath = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			// Files of an excluded directory can be included again by an exception, such as "!logs/keep.log".
+			if ignored.match(relPath) && !ignored.hasExceptions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ignored.match(relPath) {
+			return nil
+		}
+		total += info.Size()
+		if i := strings.Index(relPath, "/"); i != -1 {
+			dirSizes[relPath[:i]] += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk build context %s: %w", dir, err)
+	}
+	return &ContextSize{
+		Bytes:       total,
+		LargestDirs: largestDirs(dirSizes),
+	}, nil
+}
+
+// largestDirs returns the directories with the most bytes, largest first.
+func largestDirs(sizes map[string]int64) []DirSize {
+	var dirs []DirSize
+	for dir, size := range sizes {
+		dirs = append(dirs, DirSize{Path: dir, Bytes: size})
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		if dirs[i].Bytes == dirs[j].Bytes {
+			return dirs[i].Path < dirs[j].Path
+		}
+		return dirs[i].Bytes > dirs[j].Bytes
+	})
+	if len(dirs) > maxLargestContextDirs {
+		dirs = dirs[:maxLargestContextDirs]
+	}
+	return dirs
+}
+
+// dockerignorePattern is a pattern of a ".dockerignore" file.
+type dockerignorePattern struct {
+	re        *regexp.Regexp
+	exception bool // true means the pattern starts with "!" and includes the paths that it matches again.
+}
+
+// dockerignorePatterns are the patterns of a ".dockerignore" file in order.
+type dockerignorePatterns []dockerignorePattern
+
+// readDockerignore returns the patterns of the ".dockerignore" file in the build context directory, if any.
+// Blank lines and lines starting with "#" are skipped.
+func readDockerignore(dir string) (dockerignorePatterns, error) {
+	f, err := os.Open(filepath.Join(dir, dockerignoreFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("open %s: %w", dockerignoreFileName, err)
+	}
+	defer f.Close()
+
+	var patterns dockerignorePatterns
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		pattern := dockerignorePattern{}
+		if strings.HasPrefix(line, "!") {
+			pattern.exception = true
+			line = strings.TrimSpace(line[1:])
+		}
+		line = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(line)), "/")
+		re, err := regexp.Compile(dockerignoreRegexp(line))
+		if err != nil {
+			return nil, fmt.Errorf("parse pattern %q of %s: %w", line, dockerignoreFileName, err)
+		}
+		pattern.re = re
+		patterns = append(patterns, pattern)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", dockerignoreFileName, err)
+	}
+	return patterns, nil
+}
+
+// dockerignoreRegexp translates a ".dockerignore" pattern to a regular expression.
+// Like in Go's filepath.Match, "*" and "?" don't match "/", and "**" matches any number of directories.
+func dockerignoreRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// "**/" matches zero or more directories.
+					i++
+					b.WriteString("(.*/)?")
+					continue
+				}
+				b.WriteString(".*")
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(pattern[i])))
+			}
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end == -1 {
+				b.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// match returns true if the path, relative to the build context, is excluded by the patterns.
+// A pattern that matches a directory also excludes the files under it, and the last matching pattern wins.
+func (patterns dockerignorePatterns) match(relPath string) bool {
+	excluded := false
+	for _, pattern := range patterns {
+		if pattern.matchesPathOrParent(relPath) {
+			excluded = !pattern.exception
+		}
+	}
+	return excluded
+}
+
+// hasExceptions returns true if a pattern includes paths again.
+func (patterns dockerignorePatterns) hasExceptions() bool {
+	for _, pattern := range patterns {
+		if pattern.exception {
+			return true
+		}
+	}
+	return false
+}
+
+func (p dockerignorePattern) matchesPathOrParent(relPath string) bool {
+	for {
+		if p.re.MatchString(relPath) {
+			return true
+		}
+		i := strings.LastIndex(relPath, "/")
+		if i == -1 {
+			return false
+		}
+		relPath = relPath[:i]
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildContextSize(t *testing.T) {
+	const dockerignore = `# dependencies
+node_modules
+
+*.log
+**/*.log
+/static/dist
+`
+	testCases := map[string]struct {
+		files        map[string]int // Size of the files of the context, keyed by path.
+		dockerignore string
+
+		wantedBytes int64
+		wantedDirs  []DirSize
+	}{
+		"adds up every file without a .dockerignore file": {
+			files: map[string]int{
+				"Dockerfile":                 10,
+				"main.go":                    100,
+				"node_modules/left-pad/a.js": 1000,
+				"node_modules/b.js":          500,
+				"static/logo.png":            200,
+			},
+			wantedBytes: 1810,
+			wantedDirs: []DirSize{
+				{Path: "node_modules", Bytes: 1500},
+				{Path: "static", Bytes: 200},
+			},
+		},
+		"skips the files and directories matching a pattern": {
+			files: map[string]int{
+				"Dockerfile":                 10,
+				"main.go":                    100,
+				"debug.log":                  50,
+				"node_modules/left-pad/a.js": 1000,
+				"static/logo.png":            200,
+				"static/dist/bundle.js":      300,
+				"static/tmp/cache.log":       70,
+			},
+			dockerignore: dockerignore,
+			wantedBytes:  10 + 100 + 200 + int64(len(dockerignore)),
+			wantedDirs: []DirSize{
+				{Path: "static", Bytes: 200},
+			},
+		},
+		"includes the files of an exception again": {
+			files: map[string]int{
+				"Dockerfile":           10,
+				"logs/keep.log":        40,
+				"logs/2022/error.log":  500,
+				"docs/README.md":       30,
+				"docs/internal/pdf.md": 90,
+			},
+			dockerignore: "logs\n!logs/keep.log\ndocs/internal/\n",
+			wantedBytes:  10 + 40 + 30 + int64(len("logs\n!logs/keep.log\ndocs/internal/\n")),
+			wantedDirs: []DirSize{
+				{Path: "logs", Bytes: 40},
+				{Path: "docs", Bytes: 30},
+			},
+		},
+		"reports only the five largest directories": {
+			files: map[string]int{
+				"a/f": 10,
+				"b/f": 60,
+				"c/f": 30,
+				"d/f": 50,
+				"e/f": 20,
+				"f/f": 40,
+			},
+			wantedBytes: 210,
+			wantedDirs: []DirSize{
+				{Path: "b", Bytes: 60},
+				{Path: "d", Bytes: 50},
+				{Path: "f", Bytes: 40},
+				{Path: "c", Bytes: 30},
+				{Path: "e", Bytes: 20},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			dir, err := ioutil.TempDir("", "context")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			for path, size := range tc.files {
+				fullPath := filepath.Join(dir, filepath.FromSlash(path))
+				require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+				require.NoError(t, ioutil.WriteFile(fullPath, []byte(strings.Repeat("x", size)), 0644))
+			}
+			if tc.dockerignore != "" {
+				require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".dockerignore"), []byte(tc.dockerignore), 0644))
+			}
+
+			// WHEN
+			got, err := BuildContextSize(dir)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBytes, got.Bytes)
+			require.Equal(t, tc.wantedDirs, got.LargestDirs)
+		})
+	}
+}
+
+func TestBuildContextSize_NoDirectory(t *testing.T) {
+	_, err := BuildContextSize(filepath.Join(os.TempDir(), "copilot-no-such-context"))
+
+	require.Error(t, err)
+}
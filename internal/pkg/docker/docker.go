@@ -5,7 +5,9 @@
 package docker
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -39,15 +41,31 @@ type BuildArguments struct {
 	CacheFrom      []string          // Optional. Images to consider as cache sources to pass to `docker build`
 	Args           map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 	AdditionalTags []string          // Optional. Additional image tags to pass to docker.
+	Platform       string            // Optional. OS/Arch, like "linux/arm64", to pass to `docker build` via --platform flag.
 }
 
 // Build will run a `docker build` command with the input uri, tag, and Dockerfile path.
 func (r Runner) Build(in *BuildArguments) error {
-	dfDir := in.Context
-	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
-		dfDir = filepath.Dir(in.Dockerfile)
+	err := r.Run("docker", in.BuildCommandArgs())
+	if err != nil {
+		return fmt.Errorf("building image: %w", err)
 	}
 
+	return nil
+}
+
+// ContextDir returns the build context directory of the image.
+func (in *BuildArguments) ContextDir() string {
+	if in.Context == "" { // Context wasn't specified use the Dockerfile's directory as context.
+		return filepath.Dir(in.Dockerfile)
+	}
+	return in.Context
+}
+
+// BuildCommandArgs returns the arguments to pass to the docker CLI to build the image.
+func (in *BuildArguments) BuildCommandArgs() []string {
+	dfDir := in.ContextDir()
+
 	args := []string{"build"}
 
 	// Add additional image tags to the docker build call.
@@ -65,6 +83,11 @@ func (r Runner) Build(in *BuildArguments) error {
 		args = append(args, "--target", in.Target)
 	}
 
+	// Add platform option
+	if in.Platform != "" {
+		args = append(args, "--platform", in.Platform)
+	}
+
 	// Add the "args:" override section from manifest to the docker build call
 
 	// Collect the keys in a slice to sort for test stability
@@ -77,14 +100,7 @@ func (r Runner) Build(in *BuildArguments) error {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
 
-	args = append(args, dfDir, "-f", in.Dockerfile)
-
-	err := r.Run("docker", args)
-	if err != nil {
-		return fmt.Errorf("building image: %w", err)
-	}
-
-	return nil
+	return append(args, dfDir, "-f", in.Dockerfile)
 }
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
@@ -114,6 +130,36 @@ func (r Runner) Push(uri, imageTag string, additionalTags ...string) error {
 	return nil
 }
 
+// BuilderPlatforms returns the platforms, like "linux/arm64", that the current builder can build images for
+// as reported by `docker buildx inspect`.
+func (r Runner) BuilderPlatforms() ([]string, error) {
+	buf := &bytes.Buffer{}
+	err := r.Run("docker", []string{"buildx", "inspect"}, command.Stdout(buf), command.Stderr(ioutil.Discard))
+	if err != nil {
+		return nil, fmt.Errorf("docker buildx inspect: %w", err)
+	}
+	var platforms []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "Platforms:") {
+			continue
+		}
+		for _, platform := range strings.Split(strings.TrimPrefix(line, "Platforms:"), ",") {
+			// Platforms that the builder was explicitly configured with are suffixed with "*".
+			platform = strings.TrimSuffix(strings.TrimSpace(platform), "*")
+			if platform != "" {
+				platforms = append(platforms, platform)
+			}
+		}
+	}
+	return platforms, nil
+}
+
+// BuildContextSize returns the size of the build context directory, see BuildContextSize.
+func (r Runner) BuildContextSize(dir string) (*ContextSize, error) {
+	return BuildContextSize(dir)
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
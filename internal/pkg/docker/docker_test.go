@@ -6,9 +6,11 @@ package docker
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +35,7 @@ func TestBuild(t *testing.T) {
 		args           map[string]string
 		target         string
 		cacheFrom      []string
+		platform       string
 		setupMocks     func(controller *gomock.Controller)
 
 		wantedError error
@@ -119,6 +122,17 @@ func TestBuild(t *testing.T) {
 					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
 		},
+		"runs with platform field": {
+			path:     mockPath,
+			platform: "linux/arm64",
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"build",
+					"-t", mockURI + ":" + mockTag1,
+					"--platform", "linux/arm64",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -137,6 +151,7 @@ func TestBuild(t *testing.T) {
 				Args:           tc.args,
 				Target:         tc.target,
 				CacheFrom:      tc.cacheFrom,
+				Platform:       tc.platform,
 			}
 			got := s.Build(&buildInput)
 
@@ -196,6 +211,64 @@ func TestLogin(t *testing.T) {
 	}
 }
 
+func TestBuilderPlatforms(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	testCases := map[string]struct {
+		inspectOutput string
+		inspectErr    error
+
+		wantedPlatforms []string
+		wantedErr       error
+	}{
+		"wrap error returned from Run()": {
+			inspectErr: mockError,
+			wantedErr:  fmt.Errorf("docker buildx inspect: %w", mockError),
+		},
+		"parses the platforms of the builder": {
+			inspectOutput: `Name:   default
+Driver: docker
+
+Nodes:
+Name:      default
+Endpoint:  default
+Status:    running
+Platforms: linux/amd64, linux/arm64*, linux/riscv64, linux/386
+`,
+			wantedPlatforms: []string{"linux/amd64", "linux/arm64", "linux/riscv64", "linux/386"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := mocks.NewMockrunner(ctrl)
+			mockRunner.EXPECT().Run("docker", []string{"buildx", "inspect"}, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(name string, args []string, opts ...command.Option) error {
+					cmd := &exec.Cmd{}
+					for _, opt := range opts {
+						opt(cmd)
+					}
+					cmd.Stdout.Write([]byte(tc.inspectOutput))
+					return tc.inspectErr
+				})
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got, err := s.BuilderPlatforms()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPlatforms, got)
+		})
+	}
+}
+
 func TestPush(t *testing.T) {
 	mockError := errors.New("mockError")
 
@@ -6,60 +6,155 @@ package ecs
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
 const (
 	fmtWorkloadTaskDefinitionFamily = "%s-%s-%s"
 	clusterResourceType             = "ecs:cluster"
+	serviceResourceType             = "ecs:service"
+
+	// Prefix of the reason of tasks stopped by the service scheduler because the service scaled in or got redeployed.
+	scaleInStoppedReasonPrefix = "Scaling activity initiated by"
+
+	// Tags of a paused service that record what to restore when the service is resumed.
+	pausedDesiredCountTagKey = "copilot-paused-desired-count"
+	pausedMinCapacityTagKey  = "copilot-paused-min-capacity"
+	pausedMaxCapacityTagKey  = "copilot-paused-max-capacity"
 )
 
 type resourceGetter interface {
 	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
 }
 
-type runningTasksInFamilyGetter interface {
+type stackDescriber interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
+}
+
+type tasksInFamilyGetter interface {
 	RunningTasksInFamily(cluster, family string) ([]*ecs.Task, error)
+	StoppedTasksInFamily(cluster, family string) ([]*ecs.Task, error)
+}
+
+type serviceUpdater interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+	UpdateServiceDesiredCount(clusterName, serviceName string, count int64) error
+	TagService(serviceARN string, tags map[string]string) error
+	UntagService(serviceARN string, keys []string) error
+}
+
+type taskDefinitionDeregisterer interface {
+	ActiveTaskDefinitions(family string) ([]string, error)
+	DeregisterTaskDefinition(taskDefARN string) error
+}
+
+type capacityUpdater interface {
+	ECSServiceCapacity(cluster, service string) (*aas.Capacity, error)
+	UpdateECSServiceCapacity(cluster, service string, capacity aas.Capacity) error
 }
 
 // Client retrieves Copilot information from ECS endpoint.
 type Client struct {
-	rgGetter   resourceGetter
-	taskGetter runningTasksInFamilyGetter
+	rgGetter       resourceGetter
+	stackDescriber stackDescriber
+	taskGetter     tasksInFamilyGetter
+	svcUpdater     serviceUpdater
+	taskDefs       taskDefinitionDeregisterer
+	capacity       capacityUpdater
 }
 
 // New inits a new Client.
 func New(sess *session.Session) *Client {
+	ecsClient := ecs.New(sess)
 	return &Client{
-		rgGetter:   resourcegroups.New(sess),
-		taskGetter: ecs.New(sess),
+		rgGetter:       resourcegroups.New(sess),
+		stackDescriber: cloudformation.New(sess),
+		taskGetter:     ecsClient,
+		svcUpdater:     ecsClient,
+		taskDefs:       ecsClient,
+		capacity:       aas.New(sess),
 	}
 }
 
 // Cluster returns the ARN of the cluster in an environment.
+// The cluster is looked up by its tags, and by the outputs of the environment stack if its tags are missing.
 func (c Client) Cluster(app, env string) (string, error) {
 	clusters, err := c.rgGetter.GetResourcesByTags(clusterResourceType, map[string]string{
 		deploy.AppTagKey: app,
 		deploy.EnvTagKey: env,
 	})
-
-	if err != nil {
-		return "", fmt.Errorf("get cluster resources for environment %s: %w", env, err)
+	if err == nil && len(clusters) == 1 {
+		return clusters[0].ARN, nil
 	}
-
-	if len(clusters) == 0 {
-		return "", fmt.Errorf("no cluster found in environment %s", env)
+	var tagErr error
+	switch {
+	case err != nil:
+		tagErr = fmt.Errorf("get cluster resources for environment %s: %w", env, err)
+	case len(clusters) == 0:
+		tagErr = fmt.Errorf("no cluster found in environment %s", env)
 	}
+	stackName := stack.NameForEnv(app, env)
+	stackCluster, stackErr := c.clusterInStack(stackName)
 
 	// NOTE: only one cluster is associated with an application and an environment.
 	if len(clusters) > 1 {
+		if stackErr == nil {
+			for _, cluster := range clusters {
+				if cluster.ARN == stackCluster {
+					return cluster.ARN, nil
+				}
+			}
+		}
 		return "", fmt.Errorf("more than one cluster is found in environment %s", env)
 	}
-	return clusters[0].ARN, nil
+	if stackErr != nil {
+		return "", fmt.Errorf("find cluster by tags: %v; find cluster in the outputs of stack %s: %w", tagErr, stackName, stackErr)
+	}
+	return stackCluster, nil
+}
+
+// clusterInStack returns the ARN of the cluster in the outputs of an environment stack.
+func (c Client) clusterInStack(stackName string) (string, error) {
+	descr, err := c.stackDescriber.Describe(stackName)
+	if err != nil {
+		return "", fmt.Errorf("describe stack: %w", err)
+	}
+	var cluster string
+	for _, out := range descr.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.EnvOutputClusterID {
+			cluster = aws.StringValue(out.OutputValue)
+		}
+	}
+	if cluster == "" {
+		return "", fmt.Errorf("output %s not found", stack.EnvOutputClusterID)
+	}
+	if arn.IsARN(cluster) {
+		return cluster, nil
+	}
+	// The output holds the name of the cluster, which is in the same partition, region and account as the stack.
+	stackARN, err := arn.Parse(aws.StringValue(descr.StackId))
+	if err != nil {
+		return "", fmt.Errorf("parse stack ID %s: %w", aws.StringValue(descr.StackId), err)
+	}
+	return arn.ARN{
+		Partition: stackARN.Partition,
+		Service:   "ecs",
+		Region:    stackARN.Region,
+		AccountID: stackARN.AccountID,
+		Resource:  "cluster/" + cluster,
+	}.String(), nil
 }
 
 // ListActiveWorkloadTasks lists all active workload tasks (with desired status to be RUNNING) in the environment.
@@ -78,3 +173,216 @@ func (c Client) ListActiveWorkloadTasks(app, env, workload string) (clusterARN s
 	}
 	return
 }
+
+// ServiceStoppedTasks returns the status of the recently stopped tasks of a service, most recently stopped first.
+// Tasks stopped because the service scaled in are left out.
+func (c Client) ServiceStoppedTasks(app, env, svc string) ([]ecs.TaskStatus, error) {
+	clusterARN, err := c.Cluster(app, env)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster for env %s: %w", env, err)
+	}
+	tdFamilyName := fmt.Sprintf(fmtWorkloadTaskDefinitionFamily, app, env, svc)
+	tasks, err := c.taskGetter.StoppedTasksInFamily(clusterARN, tdFamilyName)
+	if err != nil {
+		return nil, fmt.Errorf("list stopped tasks that belong to family %s: %w", tdFamilyName, err)
+	}
+	var stopped []ecs.TaskStatus
+	for _, task := range tasks {
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, fmt.Errorf("get status for task %s: %w", *task.TaskArn, err)
+		}
+		if strings.HasPrefix(status.StoppedReason, scaleInStoppedReasonPrefix) {
+			continue
+		}
+		stopped = append(stopped, *status)
+	}
+	sort.SliceStable(stopped, func(i, j int) bool {
+		return stopped[i].StoppedAt.After(stopped[j].StoppedAt)
+	})
+	return stopped, nil
+}
+
+// PauseService scales the service down to zero tasks and records its desired count in the service's tags,
+// so that ResumeService can restore it.
+// If the service autoscales, an ErrAutoscalingEnabled is returned unless suspendAutoscaling is true,
+// in which case the minimum and maximum capacity of the service are also set to zero.
+func (c Client) PauseService(app, env, svc string, suspendAutoscaling bool) error {
+	resource, err := c.service(app, env, svc)
+	if err != nil {
+		return err
+	}
+	if _, ok := resource.Tags[pausedDesiredCountTagKey]; ok {
+		return fmt.Errorf("service %s is already paused", svc)
+	}
+	clusterName, serviceName, err := clusterAndServiceName(resource.ARN)
+	if err != nil {
+		return err
+	}
+	service, err := c.svcUpdater.Service(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("get ECS service of service %s: %w", svc, err)
+	}
+	capacity, err := c.capacity.ECSServiceCapacity(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("get capacity of service %s: %w", svc, err)
+	}
+	if capacity != nil && !suspendAutoscaling {
+		return &ErrAutoscalingEnabled{Svc: svc}
+	}
+
+	tags := map[string]string{
+		pausedDesiredCountTagKey: strconv.FormatInt(aws.Int64Value(service.DesiredCount), 10),
+	}
+	if capacity != nil {
+		tags[pausedMinCapacityTagKey] = strconv.FormatInt(capacity.Min, 10)
+		tags[pausedMaxCapacityTagKey] = strconv.FormatInt(capacity.Max, 10)
+	}
+	if err := c.svcUpdater.TagService(resource.ARN, tags); err != nil {
+		return fmt.Errorf("record desired count of service %s: %w", svc, err)
+	}
+	if capacity != nil {
+		if err := c.capacity.UpdateECSServiceCapacity(clusterName, serviceName, aas.Capacity{}); err != nil {
+			return fmt.Errorf("suspend autoscaling of service %s: %w", svc, err)
+		}
+	}
+	return c.svcUpdater.UpdateServiceDesiredCount(clusterName, serviceName, 0)
+}
+
+// ResumeService restores the desired count, and the capacity if autoscaling was suspended, of a service paused with PauseService.
+func (c Client) ResumeService(app, env, svc string) error {
+	resource, err := c.service(app, env, svc)
+	if err != nil {
+		return err
+	}
+	desiredCount, ok := resource.Tags[pausedDesiredCountTagKey]
+	if !ok {
+		return fmt.Errorf("service %s is not paused", svc)
+	}
+	count, err := strconv.ParseInt(desiredCount, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse desired count %s of paused service %s: %w", desiredCount, svc, err)
+	}
+	clusterName, serviceName, err := clusterAndServiceName(resource.ARN)
+	if err != nil {
+		return err
+	}
+
+	pausedTagKeys := []string{pausedDesiredCountTagKey}
+	minCapacity, hasMin := resource.Tags[pausedMinCapacityTagKey]
+	maxCapacity, hasMax := resource.Tags[pausedMaxCapacityTagKey]
+	if hasMin && hasMax {
+		min, err := strconv.ParseInt(minCapacity, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse minimum capacity %s of paused service %s: %w", minCapacity, svc, err)
+		}
+		max, err := strconv.ParseInt(maxCapacity, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse maximum capacity %s of paused service %s: %w", maxCapacity, svc, err)
+		}
+		if err := c.capacity.UpdateECSServiceCapacity(clusterName, serviceName, aas.Capacity{Min: min, Max: max}); err != nil {
+			return fmt.Errorf("restore autoscaling of service %s: %w", svc, err)
+		}
+		pausedTagKeys = append(pausedTagKeys, pausedMinCapacityTagKey, pausedMaxCapacityTagKey)
+	}
+	if err := c.svcUpdater.UpdateServiceDesiredCount(clusterName, serviceName, count); err != nil {
+		return err
+	}
+	if err := c.svcUpdater.UntagService(resource.ARN, pausedTagKeys); err != nil {
+		return fmt.Errorf("remove paused tags of service %s: %w", svc, err)
+	}
+	return nil
+}
+
+// StaleTaskDefinitions returns the ARNs of the ACTIVE task definition revisions of a service that can be deregistered, newest first.
+// The keep most recent revisions are left out, and so are the revision used by the ECS service, the revision right before it
+// so that the service can be rolled back, and the revisions registered after it, which may belong to a deployment in progress.
+func (c Client) StaleTaskDefinitions(app, env, svc string, keep int) ([]string, error) {
+	resource, err := c.service(app, env, svc)
+	if err != nil {
+		return nil, err
+	}
+	clusterName, serviceName, err := clusterAndServiceName(resource.ARN)
+	if err != nil {
+		return nil, err
+	}
+	service, err := c.svcUpdater.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of service %s: %w", svc, err)
+	}
+	family, activeRevision, err := ecs.TaskDefinitionRevision(aws.StringValue(service.TaskDefinition))
+	if err != nil {
+		return nil, fmt.Errorf("get task definition of service %s: %w", svc, err)
+	}
+	revisions, err := c.taskDefs.ActiveTaskDefinitions(family)
+	if err != nil {
+		return nil, fmt.Errorf("list task definitions of service %s: %w", svc, err)
+	}
+	var stale []string
+	keptPrevious := false
+	for i, taskDefARN := range revisions {
+		_, revision, err := ecs.TaskDefinitionRevision(taskDefARN)
+		if err != nil {
+			return nil, err
+		}
+		if revision >= activeRevision {
+			continue
+		}
+		if !keptPrevious {
+			keptPrevious = true
+			continue
+		}
+		if i < keep {
+			continue
+		}
+		stale = append(stale, taskDefARN)
+	}
+	return stale, nil
+}
+
+// DeregisterTaskDefinition deregisters a task definition revision returned by StaleTaskDefinitions.
+func (c Client) DeregisterTaskDefinition(taskDefARN string) error {
+	return c.taskDefs.DeregisterTaskDefinition(taskDefARN)
+}
+
+// service returns the ECS service resource of a service along with its tags.
+func (c Client) service(app, env, svc string) (*resourcegroups.Resource, error) {
+	services, err := c.rgGetter.GetResourcesByTags(serviceResourceType, map[string]string{
+		deploy.AppTagKey:     app,
+		deploy.EnvTagKey:     env,
+		deploy.ServiceTagKey: svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service resources for service %s: %w", svc, err)
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no ECS service found for service %s in environment %s", svc, env)
+	}
+	// NOTE: only one ECS service is associated with a service in an environment.
+	if len(services) > 1 {
+		return nil, fmt.Errorf("more than one ECS service is found for service %s in environment %s", svc, env)
+	}
+	return services[0], nil
+}
+
+func clusterAndServiceName(serviceARN string) (cluster, service string, err error) {
+	arn := ecs.ServiceArn(serviceARN)
+	cluster, err = arn.ClusterName()
+	if err != nil {
+		return "", "", fmt.Errorf("get cluster name: %w", err)
+	}
+	service, err = arn.ServiceName()
+	if err != nil {
+		return "", "", fmt.Errorf("get service name: %w", err)
+	}
+	return cluster, service, nil
+}
+
+// ErrAutoscalingEnabled occurs when a service with autoscaling is paused without suspending autoscaling.
+type ErrAutoscalingEnabled struct {
+	Svc string
+}
+
+func (e *ErrAutoscalingEnabled) Error() string {
+	return fmt.Sprintf("service %s has autoscaling enabled", e.Svc)
+}
@@ -7,8 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -19,18 +24,30 @@ import (
 
 type clientMocks struct {
 	resourceGetter *mocks.MockresourceGetter
-	ecsTaskGetter  *mocks.MockRunningTasksInFamilyGetter
+	stackDescriber *mocks.MockstackDescriber
+	ecsTaskGetter  *mocks.MocktasksInFamilyGetter
+	svcUpdater     *mocks.MockserviceUpdater
+	taskDefs       *mocks.MocktaskDefinitionDeregisterer
+	capacity       *mocks.MockcapacityUpdater
 }
 
 func TestClient_Cluster(t *testing.T) {
 	const (
-		mockApp = "mockApp"
-		mockEnv = "mockEnv"
+		mockApp        = "mockApp"
+		mockEnv        = "mockEnv"
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/mockApp-mockEnv-Cluster"
 	)
 	getRgInput := map[string]string{
 		deploy.AppTagKey: mockApp,
 		deploy.EnvTagKey: mockEnv,
 	}
+	envStack := &cloudformation.StackDescription{
+		StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/mockApp-mockEnv/1111"),
+		Outputs: []*awscfn.Output{
+			{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1234")},
+			{OutputKey: aws.String("ClusterId"), OutputValue: aws.String("mockApp-mockEnv-Cluster")},
+		},
+	}
 	testError := errors.New("some error")
 
 	tests := map[string]struct {
@@ -39,35 +56,72 @@ func TestClient_Cluster(t *testing.T) {
 		wantedError   error
 		wantedCluster string
 	}{
-		"errors if fail to get resources by tags": {
+		"errors if fail to get resources by tags and to describe the environment stack": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return(nil, testError),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(nil, testError),
+				)
+			},
+			wantedError: fmt.Errorf("find cluster by tags: get cluster resources for environment mockEnv: some error; find cluster in the outputs of stack mockApp-mockEnv: describe stack: some error"),
+		},
+		"errors if no cluster found by tags or in the environment stack": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(&cloudformation.StackDescription{
+						StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/mockApp-mockEnv/1111"),
+					}, nil),
 				)
 			},
-			wantedError: fmt.Errorf("get cluster resources for environment mockEnv: some error"),
+			wantedError: fmt.Errorf("find cluster by tags: no cluster found in environment mockEnv; find cluster in the outputs of stack mockApp-mockEnv: output ClusterId not found"),
 		},
-		"errors if no cluster found": {
+		"falls back to the environment stack if no cluster is found by tags": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(envStack, nil),
 				)
 			},
-			wantedError: fmt.Errorf("no cluster found in environment mockEnv"),
+			wantedCluster: mockClusterARN,
 		},
-		"errors if more than one cluster found": {
+		"falls back to the environment stack if fail to get resources by tags": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return(nil, testError),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(envStack, nil),
+				)
+			},
+			wantedCluster: mockClusterARN,
+		},
+		"errors if more than one cluster found and none is in the environment stack": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return([]*resourcegroups.Resource{
 							{ARN: "mockARN1"}, {ARN: "mockARN2"},
 						}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(envStack, nil),
 				)
 			},
 			wantedError: fmt.Errorf("more than one cluster is found in environment mockEnv"),
 		},
+		"prefers the cluster in the environment stack if more than one cluster found": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: "mockARN1"}, {ARN: mockClusterARN},
+						}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(envStack, nil),
+				)
+			},
+			wantedCluster: mockClusterARN,
+		},
 		"success": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
@@ -87,15 +141,16 @@ func TestClient_Cluster(t *testing.T) {
 			defer ctrl.Finish()
 
 			// GIVEN
-			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
 			mocks := clientMocks{
-				resourceGetter: mockRgGetter,
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				stackDescriber: mocks.NewMockstackDescriber(ctrl),
 			}
 
 			test.setupMocks(mocks)
 
 			client := Client{
-				rgGetter: mockRgGetter,
+				rgGetter:       mocks.resourceGetter,
+				stackDescriber: mocks.stackDescriber,
 			}
 
 			// WHEN
@@ -136,9 +191,10 @@ func TestClient_ListActiveWorkloadTasks(t *testing.T) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return(nil, testError),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(nil, testError),
 				)
 			},
-			wantedError: fmt.Errorf("get cluster for env mockEnv: get cluster resources for environment mockEnv: some error"),
+			wantedError: fmt.Errorf("get cluster for env mockEnv: find cluster by tags: get cluster resources for environment mockEnv: some error; find cluster in the outputs of stack mockApp-mockEnv: describe stack: some error"),
 		},
 		"errors if fail to get running tasks": {
 			setupMocks: func(m clientMocks) {
@@ -181,17 +237,20 @@ func TestClient_ListActiveWorkloadTasks(t *testing.T) {
 
 			// GIVEN
 			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
-			mockECSTasksGetter := mocks.NewMockRunningTasksInFamilyGetter(ctrl)
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
+			mockECSTasksGetter := mocks.NewMocktasksInFamilyGetter(ctrl)
 			mocks := clientMocks{
 				resourceGetter: mockRgGetter,
+				stackDescriber: mockStackDescriber,
 				ecsTaskGetter:  mockECSTasksGetter,
 			}
 
 			test.setupMocks(mocks)
 
 			client := Client{
-				rgGetter:   mockRgGetter,
-				taskGetter: mockECSTasksGetter,
+				rgGetter:       mockRgGetter,
+				stackDescriber: mockStackDescriber,
+				taskGetter:     mockECSTasksGetter,
 			}
 
 			// WHEN
@@ -208,3 +267,506 @@ func TestClient_ListActiveWorkloadTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ServiceStoppedTasks(t *testing.T) {
+	const (
+		mockApp = "mockApp"
+		mockEnv = "mockEnv"
+		mockSvc = "mockSvc"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey: mockApp,
+		deploy.EnvTagKey: mockEnv,
+	}
+	testError := errors.New("some error")
+	earlier := time.Date(2020, 11, 23, 10, 0, 0, 0, time.UTC)
+	later := time.Date(2020, 11, 23, 11, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+		wantedTasks []ecs.TaskStatus
+	}{
+		"errors if fail to get cluster": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+					Return(nil, testError)
+				m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get cluster for env mockEnv: find cluster by tags: get cluster resources for environment mockEnv: some error; find cluster in the outputs of stack mockApp-mockEnv: describe stack: some error"),
+		},
+		"errors if fail to get stopped tasks": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: "mockCluster"},
+						}, nil),
+					m.ecsTaskGetter.EXPECT().StoppedTasksInFamily("mockCluster", "mockApp-mockEnv-mockSvc").Return(nil, testError),
+				)
+			},
+			wantedError: fmt.Errorf("list stopped tasks that belong to family mockApp-mockEnv-mockSvc: some error"),
+		},
+		"errors if fail to get the status of a task": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: "mockCluster"},
+						}, nil),
+					m.ecsTaskGetter.EXPECT().StoppedTasksInFamily("mockCluster", "mockApp-mockEnv-mockSvc").Return([]*ecs.Task{
+						{
+							TaskArn: aws.String("badTaskArn"),
+						},
+					}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("get status for task badTaskArn: parse ECS task ARN: arn: invalid prefix"),
+		},
+		"success, most recently stopped first and without scaled-in tasks": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: "mockCluster"},
+						}, nil),
+					m.ecsTaskGetter.EXPECT().StoppedTasksInFamily("mockCluster", "mockApp-mockEnv-mockSvc").Return([]*ecs.Task{
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/task1"),
+							LastStatus:    aws.String("STOPPED"),
+							StoppedAt:     &earlier,
+							StoppedReason: aws.String("Essential container in task exited"),
+							Containers: []*awsecs.Container{
+								{
+									Name:     aws.String("mockSvc"),
+									ExitCode: aws.Int64(1),
+								},
+							},
+						},
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/task2"),
+							LastStatus:    aws.String("STOPPED"),
+							StoppedAt:     &later,
+							StoppedReason: aws.String("Scaling activity initiated by (deployment ecs-svc/1234)"),
+						},
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/task3"),
+							LastStatus:    aws.String("STOPPED"),
+							StoppedAt:     &later,
+							StoppedReason: aws.String("Task failed ELB health checks"),
+						},
+					}, nil),
+				)
+			},
+			wantedTasks: []ecs.TaskStatus{
+				{
+					ID:            "task3",
+					LastStatus:    "STOPPED",
+					StoppedAt:     later,
+					StoppedReason: "Task failed ELB health checks",
+				},
+				{
+					ID:            "task1",
+					LastStatus:    "STOPPED",
+					StoppedAt:     earlier,
+					StoppedReason: "Essential container in task exited",
+					Images: []ecs.Image{
+						{},
+					},
+					ExitCodes: []ecs.ContainerExitCode{
+						{
+							Name:     "mockSvc",
+							ExitCode: 1,
+						},
+					},
+					Containers: []ecs.ContainerStatus{
+						{
+							Name:     "mockSvc",
+							ExitCode: aws.Int(1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
+			mockECSTasksGetter := mocks.NewMocktasksInFamilyGetter(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				stackDescriber: mockStackDescriber,
+				ecsTaskGetter:  mockECSTasksGetter,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:       mockRgGetter,
+				stackDescriber: mockStackDescriber,
+				taskGetter:     mockECSTasksGetter,
+			}
+
+			// WHEN
+			got, err := client.ServiceStoppedTasks(mockApp, mockEnv, mockSvc)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantedTasks, got)
+			}
+		})
+	}
+}
+
+func TestClient_PauseService(t *testing.T) {
+	const (
+		mockApp        = "mockApp"
+		mockEnv        = "mockEnv"
+		mockSvc        = "mockSvc"
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	testError := errors.New("some error")
+
+	tests := map[string]struct {
+		suspendAutoscaling bool
+		setupMocks         func(mocks clientMocks)
+
+		wantedError error
+	}{
+		"errors if fail to find the ECS service": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{}, nil)
+			},
+			wantedError: fmt.Errorf("no ECS service found for service mockSvc in environment mockEnv"),
+		},
+		"errors if the service is already paused": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{
+						{ARN: mockServiceARN, Tags: map[string]string{pausedDesiredCountTagKey: "2"}},
+					}, nil)
+			},
+			wantedError: fmt.Errorf("service mockSvc is already paused"),
+		},
+		"errors if the service autoscales and autoscaling isn't suspended": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					DesiredCount: aws.Int64(2),
+				}, nil)
+				m.capacity.EXPECT().ECSServiceCapacity("mockCluster", "mockService").Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.svcUpdater.EXPECT().TagService(gomock.Any(), gomock.Any()).Times(0)
+				m.svcUpdater.EXPECT().UpdateServiceDesiredCount(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: &ErrAutoscalingEnabled{Svc: mockSvc},
+		},
+		"errors if fail to record the desired count": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					DesiredCount: aws.Int64(2),
+				}, nil)
+				m.capacity.EXPECT().ECSServiceCapacity("mockCluster", "mockService").Return(nil, nil)
+				m.svcUpdater.EXPECT().TagService(mockServiceARN, gomock.Any()).Return(testError)
+				m.svcUpdater.EXPECT().UpdateServiceDesiredCount(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("record desired count of service mockSvc: some error"),
+		},
+		"scales a service without autoscaling to zero": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					DesiredCount: aws.Int64(2),
+				}, nil)
+				m.capacity.EXPECT().ECSServiceCapacity("mockCluster", "mockService").Return(nil, nil)
+				gomock.InOrder(
+					m.svcUpdater.EXPECT().TagService(mockServiceARN, map[string]string{
+						pausedDesiredCountTagKey: "2",
+					}).Return(nil),
+					m.svcUpdater.EXPECT().UpdateServiceDesiredCount("mockCluster", "mockService", int64(0)).Return(nil),
+				)
+			},
+		},
+		"suspends autoscaling before scaling the service to zero": {
+			suspendAutoscaling: true,
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					DesiredCount: aws.Int64(3),
+				}, nil)
+				m.capacity.EXPECT().ECSServiceCapacity("mockCluster", "mockService").Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				gomock.InOrder(
+					m.svcUpdater.EXPECT().TagService(mockServiceARN, map[string]string{
+						pausedDesiredCountTagKey: "3",
+						pausedMinCapacityTagKey:  "1",
+						pausedMaxCapacityTagKey:  "10",
+					}).Return(nil),
+					m.capacity.EXPECT().UpdateECSServiceCapacity("mockCluster", "mockService", aas.Capacity{}).Return(nil),
+					m.svcUpdater.EXPECT().UpdateServiceDesiredCount("mockCluster", "mockService", int64(0)).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			m := clientMocks{
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				svcUpdater:     mocks.NewMockserviceUpdater(ctrl),
+				capacity:       mocks.NewMockcapacityUpdater(ctrl),
+			}
+			test.setupMocks(m)
+
+			client := Client{
+				rgGetter:   m.resourceGetter,
+				svcUpdater: m.svcUpdater,
+				capacity:   m.capacity,
+			}
+
+			// WHEN
+			err := client.PauseService(mockApp, mockEnv, mockSvc, test.suspendAutoscaling)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_ResumeService(t *testing.T) {
+	const (
+		mockApp        = "mockApp"
+		mockEnv        = "mockEnv"
+		mockSvc        = "mockSvc"
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	testError := errors.New("some error")
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+	}{
+		"errors if the service is not paused": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+			},
+			wantedError: fmt.Errorf("service mockSvc is not paused"),
+		},
+		"errors if fail to remove the paused tags": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{
+						{ARN: mockServiceARN, Tags: map[string]string{pausedDesiredCountTagKey: "2"}},
+					}, nil)
+				m.svcUpdater.EXPECT().UpdateServiceDesiredCount("mockCluster", "mockService", int64(2)).Return(nil)
+				m.svcUpdater.EXPECT().UntagService(mockServiceARN, gomock.Any()).Return(testError)
+			},
+			wantedError: fmt.Errorf("remove paused tags of service mockSvc: some error"),
+		},
+		"restores the desired count of a service without autoscaling": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{
+						{ARN: mockServiceARN, Tags: map[string]string{pausedDesiredCountTagKey: "2"}},
+					}, nil)
+				m.capacity.EXPECT().UpdateECSServiceCapacity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				gomock.InOrder(
+					m.svcUpdater.EXPECT().UpdateServiceDesiredCount("mockCluster", "mockService", int64(2)).Return(nil),
+					m.svcUpdater.EXPECT().UntagService(mockServiceARN, []string{pausedDesiredCountTagKey}).Return(nil),
+				)
+			},
+		},
+		"restores the capacity and desired count of a service with suspended autoscaling": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{
+						{ARN: mockServiceARN, Tags: map[string]string{
+							pausedDesiredCountTagKey: "3",
+							pausedMinCapacityTagKey:  "1",
+							pausedMaxCapacityTagKey:  "10",
+						}},
+					}, nil)
+				gomock.InOrder(
+					m.capacity.EXPECT().UpdateECSServiceCapacity("mockCluster", "mockService", aas.Capacity{Min: 1, Max: 10}).Return(nil),
+					m.svcUpdater.EXPECT().UpdateServiceDesiredCount("mockCluster", "mockService", int64(3)).Return(nil),
+					m.svcUpdater.EXPECT().UntagService(mockServiceARN, []string{
+						pausedDesiredCountTagKey, pausedMinCapacityTagKey, pausedMaxCapacityTagKey,
+					}).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			m := clientMocks{
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				svcUpdater:     mocks.NewMockserviceUpdater(ctrl),
+				capacity:       mocks.NewMockcapacityUpdater(ctrl),
+			}
+			test.setupMocks(m)
+
+			client := Client{
+				rgGetter:   m.resourceGetter,
+				svcUpdater: m.svcUpdater,
+				capacity:   m.capacity,
+			}
+
+			// WHEN
+			err := client.ResumeService(mockApp, mockEnv, mockSvc)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_StaleTaskDefinitions(t *testing.T) {
+	const (
+		mockApp        = "mockApp"
+		mockEnv        = "mockEnv"
+		mockSvc        = "mockSvc"
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService"
+		mockFamily     = "mockApp-mockEnv-mockSvc"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	revision := func(rev int) string {
+		return fmt.Sprintf("arn:aws:ecs:us-west-2:123456789012:task-definition/%s:%d", mockFamily, rev)
+	}
+	revisions := func(revs ...int) []string {
+		var arns []string
+		for _, rev := range revs {
+			arns = append(arns, revision(rev))
+		}
+		return arns
+	}
+	testError := errors.New("some error")
+
+	tests := map[string]struct {
+		keep       int
+		setupMocks func(mocks clientMocks)
+
+		wantedStale []string
+		wantedError error
+	}{
+		"errors if fail to find the ECS service": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{}, nil)
+			},
+			wantedError: fmt.Errorf("no ECS service found for service mockSvc in environment mockEnv"),
+		},
+		"errors if fail to list the task definitions": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					TaskDefinition: aws.String(revision(5)),
+				}, nil)
+				m.taskDefs.EXPECT().ActiveTaskDefinitions(mockFamily).Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("list task definitions of service mockSvc: some error"),
+		},
+		"keeps the active and the previous revisions": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					TaskDefinition: aws.String(revision(5)),
+				}, nil)
+				m.taskDefs.EXPECT().ActiveTaskDefinitions(mockFamily).Return(revisions(5, 4, 2, 1), nil)
+			},
+			wantedStale: revisions(2, 1),
+		},
+		"keeps the newest revisions and the ones registered after the active revision": {
+			keep: 5,
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.svcUpdater.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					TaskDefinition: aws.String(revision(6)),
+				}, nil)
+				m.taskDefs.EXPECT().ActiveTaskDefinitions(mockFamily).Return(revisions(8, 7, 6, 5, 4, 3, 2, 1), nil)
+			},
+			wantedStale: revisions(3, 2, 1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			m := clientMocks{
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				svcUpdater:     mocks.NewMockserviceUpdater(ctrl),
+				taskDefs:       mocks.NewMocktaskDefinitionDeregisterer(ctrl),
+			}
+			test.setupMocks(m)
+
+			client := Client{
+				rgGetter:   m.resourceGetter,
+				svcUpdater: m.svcUpdater,
+				taskDefs:   m.taskDefs,
+			}
+
+			// WHEN
+			stale, err := client.StaleTaskDefinitions(mockApp, mockEnv, mockSvc, test.keep)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantedStale, stale)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	gomock "github.com/golang/mock/gomock"
@@ -49,31 +51,69 @@ func (mr *MockresourceGetterMockRecorder) GetResourcesByTags(resourceType, tags
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourceGetter)(nil).GetResourcesByTags), resourceType, tags)
 }
 
-// MockRunningTasksInFamilyGetter is a mock of RunningTasksInFamilyGetter interface
-type MockRunningTasksInFamilyGetter struct {
+// MockstackDescriber is a mock of stackDescriber interface
+type MockstackDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockRunningTasksInFamilyGetterMockRecorder
+	recorder *MockstackDescriberMockRecorder
 }
 
-// MockRunningTasksInFamilyGetterMockRecorder is the mock recorder for MockRunningTasksInFamilyGetter
-type MockRunningTasksInFamilyGetterMockRecorder struct {
-	mock *MockRunningTasksInFamilyGetter
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
 }
 
-// NewMockRunningTasksInFamilyGetter creates a new mock instance
-func NewMockRunningTasksInFamilyGetter(ctrl *gomock.Controller) *MockRunningTasksInFamilyGetter {
-	mock := &MockRunningTasksInFamilyGetter{ctrl: ctrl}
-	mock.recorder = &MockRunningTasksInFamilyGetterMockRecorder{mock}
+// NewMockstackDescriber creates a new mock instance
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockRunningTasksInFamilyGetter) EXPECT() *MockRunningTasksInFamilyGetterMockRecorder {
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockstackDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockstackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), name)
+}
+
+// MocktasksInFamilyGetter is a mock of tasksInFamilyGetter interface
+type MocktasksInFamilyGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktasksInFamilyGetterMockRecorder
+}
+
+// MocktasksInFamilyGetterMockRecorder is the mock recorder for MocktasksInFamilyGetter
+type MocktasksInFamilyGetterMockRecorder struct {
+	mock *MocktasksInFamilyGetter
+}
+
+// NewMocktasksInFamilyGetter creates a new mock instance
+func NewMocktasksInFamilyGetter(ctrl *gomock.Controller) *MocktasksInFamilyGetter {
+	mock := &MocktasksInFamilyGetter{ctrl: ctrl}
+	mock.recorder = &MocktasksInFamilyGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktasksInFamilyGetter) EXPECT() *MocktasksInFamilyGetterMockRecorder {
 	return m.recorder
 }
 
 // RunningTasksInFamily mocks base method
-func (m *MockRunningTasksInFamilyGetter) RunningTasksInFamily(cluster, family string) ([]*ecs.Task, error) {
+func (m *MocktasksInFamilyGetter) RunningTasksInFamily(cluster, family string) ([]*ecs.Task, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RunningTasksInFamily", cluster, family)
 	ret0, _ := ret[0].([]*ecs.Task)
@@ -82,7 +122,206 @@ func (m *MockRunningTasksInFamilyGetter) RunningTasksInFamily(cluster, family st
 }
 
 // RunningTasksInFamily indicates an expected call of RunningTasksInFamily
-func (mr *MockRunningTasksInFamilyGetterMockRecorder) RunningTasksInFamily(cluster, family interface{}) *gomock.Call {
+func (mr *MocktasksInFamilyGetterMockRecorder) RunningTasksInFamily(cluster, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasksInFamily", reflect.TypeOf((*MocktasksInFamilyGetter)(nil).RunningTasksInFamily), cluster, family)
+}
+
+// StoppedTasksInFamily mocks base method
+func (m *MocktasksInFamilyGetter) StoppedTasksInFamily(cluster, family string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedTasksInFamily", cluster, family)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedTasksInFamily indicates an expected call of StoppedTasksInFamily
+func (mr *MocktasksInFamilyGetterMockRecorder) StoppedTasksInFamily(cluster, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTasksInFamily", reflect.TypeOf((*MocktasksInFamilyGetter)(nil).StoppedTasksInFamily), cluster, family)
+}
+
+// MockserviceUpdater is a mock of serviceUpdater interface
+type MockserviceUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceUpdaterMockRecorder
+}
+
+// MockserviceUpdaterMockRecorder is the mock recorder for MockserviceUpdater
+type MockserviceUpdaterMockRecorder struct {
+	mock *MockserviceUpdater
+}
+
+// NewMockserviceUpdater creates a new mock instance
+func NewMockserviceUpdater(ctrl *gomock.Controller) *MockserviceUpdater {
+	mock := &MockserviceUpdater{ctrl: ctrl}
+	mock.recorder = &MockserviceUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceUpdater) EXPECT() *MockserviceUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method
+func (m *MockserviceUpdater) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service
+func (mr *MockserviceUpdaterMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockserviceUpdater)(nil).Service), clusterName, serviceName)
+}
+
+// TagService mocks base method
+func (m *MockserviceUpdater) TagService(serviceARN string, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagService", serviceARN, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagService indicates an expected call of TagService
+func (mr *MockserviceUpdaterMockRecorder) TagService(serviceARN, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagService", reflect.TypeOf((*MockserviceUpdater)(nil).TagService), serviceARN, tags)
+}
+
+// UntagService mocks base method
+func (m *MockserviceUpdater) UntagService(serviceARN string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagService", serviceARN, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntagService indicates an expected call of UntagService
+func (mr *MockserviceUpdaterMockRecorder) UntagService(serviceARN, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagService", reflect.TypeOf((*MockserviceUpdater)(nil).UntagService), serviceARN, keys)
+}
+
+// UpdateServiceDesiredCount mocks base method
+func (m *MockserviceUpdater) UpdateServiceDesiredCount(clusterName, serviceName string, count int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceDesiredCount", clusterName, serviceName, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceDesiredCount indicates an expected call of UpdateServiceDesiredCount
+func (mr *MockserviceUpdaterMockRecorder) UpdateServiceDesiredCount(clusterName, serviceName, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceDesiredCount", reflect.TypeOf((*MockserviceUpdater)(nil).UpdateServiceDesiredCount), clusterName, serviceName, count)
+}
+
+// MocktaskDefinitionDeregisterer is a mock of taskDefinitionDeregisterer interface
+type MocktaskDefinitionDeregisterer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionDeregistererMockRecorder
+}
+
+// MocktaskDefinitionDeregistererMockRecorder is the mock recorder for MocktaskDefinitionDeregisterer
+type MocktaskDefinitionDeregistererMockRecorder struct {
+	mock *MocktaskDefinitionDeregisterer
+}
+
+// NewMocktaskDefinitionDeregisterer creates a new mock instance
+func NewMocktaskDefinitionDeregisterer(ctrl *gomock.Controller) *MocktaskDefinitionDeregisterer {
+	mock := &MocktaskDefinitionDeregisterer{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionDeregistererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskDefinitionDeregisterer) EXPECT() *MocktaskDefinitionDeregistererMockRecorder {
+	return m.recorder
+}
+
+// ActiveTaskDefinitions mocks base method
+func (m *MocktaskDefinitionDeregisterer) ActiveTaskDefinitions(family string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveTaskDefinitions", family)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveTaskDefinitions indicates an expected call of ActiveTaskDefinitions
+func (mr *MocktaskDefinitionDeregistererMockRecorder) ActiveTaskDefinitions(family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveTaskDefinitions", reflect.TypeOf((*MocktaskDefinitionDeregisterer)(nil).ActiveTaskDefinitions), family)
+}
+
+// DeregisterTaskDefinition mocks base method
+func (m *MocktaskDefinitionDeregisterer) DeregisterTaskDefinition(taskDefARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinition", taskDefARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTaskDefinition indicates an expected call of DeregisterTaskDefinition
+func (mr *MocktaskDefinitionDeregistererMockRecorder) DeregisterTaskDefinition(taskDefARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinition", reflect.TypeOf((*MocktaskDefinitionDeregisterer)(nil).DeregisterTaskDefinition), taskDefARN)
+}
+
+// MockcapacityUpdater is a mock of capacityUpdater interface
+type MockcapacityUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockcapacityUpdaterMockRecorder
+}
+
+// MockcapacityUpdaterMockRecorder is the mock recorder for MockcapacityUpdater
+type MockcapacityUpdaterMockRecorder struct {
+	mock *MockcapacityUpdater
+}
+
+// NewMockcapacityUpdater creates a new mock instance
+func NewMockcapacityUpdater(ctrl *gomock.Controller) *MockcapacityUpdater {
+	mock := &MockcapacityUpdater{ctrl: ctrl}
+	mock.recorder = &MockcapacityUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcapacityUpdater) EXPECT() *MockcapacityUpdaterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceCapacity mocks base method
+func (m *MockcapacityUpdater) ECSServiceCapacity(cluster, service string) (*aas.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceCapacity", cluster, service)
+	ret0, _ := ret[0].(*aas.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceCapacity indicates an expected call of ECSServiceCapacity
+func (mr *MockcapacityUpdaterMockRecorder) ECSServiceCapacity(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceCapacity", reflect.TypeOf((*MockcapacityUpdater)(nil).ECSServiceCapacity), cluster, service)
+}
+
+// UpdateECSServiceCapacity mocks base method
+func (m *MockcapacityUpdater) UpdateECSServiceCapacity(cluster, service string, capacity aas.Capacity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateECSServiceCapacity", cluster, service, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateECSServiceCapacity indicates an expected call of UpdateECSServiceCapacity
+func (mr *MockcapacityUpdaterMockRecorder) UpdateECSServiceCapacity(cluster, service, capacity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasksInFamily", reflect.TypeOf((*MockRunningTasksInFamilyGetter)(nil).RunningTasksInFamily), cluster, family)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateECSServiceCapacity", reflect.TypeOf((*MockcapacityUpdater)(nil).UpdateECSServiceCapacity), cluster, service, capacity)
 }
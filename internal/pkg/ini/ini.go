@@ -50,3 +50,13 @@ func (i *INI) Sections() []string {
 	}
 	return names
 }
+
+// HasKey returns true if the section in the file contains the key, false otherwise.
+func (i *INI) HasKey(section, key string) bool {
+	for _, s := range i.cfg.Sections() {
+		if s.Name() == section {
+			return s.HasKey(key)
+		}
+	}
+	return false
+}
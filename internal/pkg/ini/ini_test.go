@@ -28,3 +28,20 @@ protocol = http
 	// THEN
 	require.Equal(t, []string{"paths", "server"}, actualNames)
 }
+
+func TestINI_HasKey(t *testing.T) {
+	// GIVEN
+	content := `[profile sso]
+sso_start_url = https://my-sso-portal.awsapps.com/start
+
+[default]
+region = us-west-2
+`
+	cfg, _ := ini.Load([]byte(content))
+	ini := &INI{cfg: cfg}
+
+	// THEN
+	require.True(t, ini.HasKey("profile sso", "sso_start_url"))
+	require.False(t, ini.HasKey("default", "sso_start_url"))
+	require.False(t, ini.HasKey("profile test", "region"))
+}
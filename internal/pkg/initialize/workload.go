@@ -70,9 +70,10 @@ type WorkloadProps struct {
 // JobProps contains the information needed to represent a Job.
 type JobProps struct {
 	WorkloadProps
-	Schedule string
-	Timeout  string
-	Retries  int
+	Schedule     string
+	EventPattern manifest.EventPattern // Mutually exclusive with Schedule.
+	Timeout      string
+	Retries      int
 }
 
 // ServiceProps contains the information needed to represent a Service (port, HealthCheck, and workload common props).
@@ -101,6 +102,18 @@ func (w *WorkloadInitializer) Job(i *JobProps) (string, error) {
 	return w.initJob(i)
 }
 
+// ServiceManifest returns the manifest that Service would write for the service,
+// without writing it or adding the service to the application.
+func (w *WorkloadInitializer) ServiceManifest(i *ServiceProps) (encoding.BinaryMarshaler, error) {
+	return w.newServiceManifest(i)
+}
+
+// JobManifest returns the manifest that Job would write for the job,
+// without writing it or adding the job to the application.
+func (w *WorkloadInitializer) JobManifest(i *JobProps) (encoding.BinaryMarshaler, error) {
+	return newJobManifest(i)
+}
+
 func (w *WorkloadInitializer) writeManifest(mf encoding.BinaryMarshaler, wlName string, wlType string) (string, error) {
 	switch wlType {
 	case svcWlType:
@@ -172,6 +185,9 @@ func (w *WorkloadInitializer) initJob(props *JobProps) (string, error) {
 		sched = "None"
 	}
 	helpText := fmt.Sprintf("Your manifest contains configurations like your container size and job schedule (%s).", sched)
+	if props.EventPattern != nil {
+		helpText = "Your manifest contains configurations like your container size and the event pattern that triggers the job."
+	}
 	log.Infoln(color.Help(helpText))
 	log.Infoln()
 
@@ -264,9 +280,10 @@ func newJobManifest(i *JobProps) (encoding.BinaryMarshaler, error) {
 				Dockerfile: i.DockerfilePath,
 				Image:      i.Image,
 			},
-			Schedule: i.Schedule,
-			Timeout:  i.Timeout,
-			Retries:  i.Retries,
+			Schedule:     i.Schedule,
+			EventPattern: i.EventPattern,
+			Timeout:      i.Timeout,
+			Retries:      i.Retries,
 		}), nil
 	default:
 		return nil, fmt.Errorf("job type %s doesn't have a manifest", i.Type)
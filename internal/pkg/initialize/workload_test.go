@@ -712,3 +712,75 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadInitializer_ServiceManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcType string
+		mockstore func(m *mocks.MockStore)
+
+		wantedErr error
+	}{
+		"returns a load balanced web service manifest": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().ListServices("app").Return([]*config.Workload{}, nil)
+			},
+		},
+		"returns a backend service manifest": {
+			inSvcType: manifest.BackendServiceType,
+		},
+		"returns the error if the services of the application can't be listed": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().ListServices("app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// The manifest isn't written and the service isn't added to the application.
+			mockWriter := mocks.NewMockWorkspace(ctrl)
+			mockstore := mocks.NewMockStore(ctrl)
+			mockappDeployer := mocks.NewMockWorkloadAdder(ctrl)
+			mockProg := mocks.NewMockProg(ctrl)
+			if tc.mockstore != nil {
+				tc.mockstore(mockstore)
+			}
+
+			initializer := &WorkloadInitializer{
+				Store:    mockstore,
+				Ws:       mockWriter,
+				Prog:     mockProg,
+				Deployer: mockappDeployer,
+			}
+
+			// WHEN
+			mft, err := initializer.ServiceManifest(&ServiceProps{
+				WorkloadProps: WorkloadProps{
+					App:            "app",
+					Name:           "frontend",
+					Type:           tc.inSvcType,
+					DockerfilePath: "frontend/Dockerfile",
+				},
+				Port: 80,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			out, err := mft.MarshalBinary()
+			require.NoError(t, err)
+			require.Contains(t, string(out), "name: frontend")
+			require.Contains(t, string(out), "type: "+tc.inSvcType)
+		})
+	}
+}
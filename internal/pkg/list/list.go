@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -27,6 +31,13 @@ const (
 	svcWorkloadType = "service"
 )
 
+// Sources of the services listed by SvcListWriter.
+const (
+	svcSourceWorkspace  = "workspace"  // The service has a manifest in the workspace but isn't initialized in the application.
+	svcSourceRegistered = "registered" // The service is initialized in the application but has no manifest in the workspace.
+	svcSourceBoth       = "both"
+)
+
 // Store wraps the methods required for interacting with config stores.
 type Store interface {
 	GetApplication(appName string) (*config.Application, error)
@@ -36,8 +47,10 @@ type Store interface {
 
 // Workspace wraps the methods required to interact with a local workspace.
 type Workspace interface {
+	Summary() (*workspace.Summary, error)
 	JobNames() ([]string, error)
 	ServiceNames() ([]string, error)
+	ReadServiceManifest(name string) ([]byte, error)
 }
 
 // JobListWriter holds all the metadata and clients needed to list all jobs in a given
@@ -54,8 +67,9 @@ type JobListWriter struct {
 
 // SvcListWriter holds all the metadata and clients needed to list all services in a given
 // workspace or app in a human- or machine-readable format.
+// The services initialized in the application are listed along with the ones in the workspace, if it belongs to the application.
 type SvcListWriter struct {
-	ShowLocalSvcs bool
+	ShowLocalSvcs bool // Only lists the services with a manifest in the workspace.
 	OutputJSON    bool
 
 	Store Store     // Client to retrieve application configuration and service metadata.
@@ -86,39 +100,142 @@ func (l *JobListWriter) Write(appName string) error {
 		}
 		fmt.Fprint(l.Out, data)
 	} else {
-		humanOutput(wklds, l.Out)
+		jobHumanOutput(wklds, l.Out)
 	}
 	return nil
 }
 
-// Write lists all services, either locally or in the workspace, and writes the output to a writer.
+// svcListing is a service listed by SvcListWriter, along with whether it's in the workspace or in the application.
+type svcListing struct {
+	App    string `json:"app"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Source string `json:"source"` // One of "workspace", "registered" or "both".
+}
+
+// Write lists all services of the application and of the workspace, and writes the output to a writer.
+// The application and the workspace are looked up concurrently.
 func (l *SvcListWriter) Write(appName string) error {
-	if _, err := l.Store.GetApplication(appName); err != nil {
-		return fmt.Errorf("get application: %w", err)
+	var (
+		wg                 sync.WaitGroup
+		registered         []*config.Workload
+		local              map[string]string
+		storeErr, localErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		registered, storeErr = l.registeredSvcs(appName)
+	}()
+	go func() {
+		defer wg.Done()
+		local, localErr = l.localSvcs(appName)
+	}()
+	wg.Wait()
+	if storeErr != nil {
+		return storeErr
 	}
-	wklds, err := l.Store.ListServices(appName)
-	if err != nil {
-		return fmt.Errorf("get %s names: %w", svcWorkloadType, err)
+	if localErr != nil {
+		return localErr
 	}
+
+	svcs := mergeSvcs(appName, registered, local)
 	if l.ShowLocalSvcs {
-		localWklds, err := l.Ws.ServiceNames()
-		if err != nil {
-			return fmt.Errorf("get local %s names: %w", svcWorkloadType, err)
+		var localSvcs []*svcListing
+		for _, svc := range svcs {
+			if svc.Source != svcSourceRegistered {
+				localSvcs = append(localSvcs, svc)
+			}
 		}
-		wklds = filterByName(wklds, localWklds)
+		svcs = localSvcs
 	}
 	if l.OutputJSON {
-		data, err := l.jsonOutputSvcs(wklds)
+		data, err := l.jsonOutputSvcs(svcs)
 		if err != nil {
 			return err
 		}
 		fmt.Fprint(l.Out, data)
 	} else {
-		humanOutput(wklds, l.Out)
+		svcHumanOutput(svcs, l.Out)
 	}
 	return nil
 }
 
+func (l *SvcListWriter) registeredSvcs(appName string) ([]*config.Workload, error) {
+	if _, err := l.Store.GetApplication(appName); err != nil {
+		return nil, fmt.Errorf("get application: %w", err)
+	}
+	svcs, err := l.Store.ListServices(appName)
+	if err != nil {
+		return nil, fmt.Errorf("get %s names: %w", svcWorkloadType, err)
+	}
+	return svcs, nil
+}
+
+// localSvcs returns the types of the services in the workspace keyed by name.
+// It returns nil if there is no workspace, or if the workspace belongs to another application.
+func (l *SvcListWriter) localSvcs(appName string) (map[string]string, error) {
+	summary, err := l.Ws.Summary()
+	if err != nil || summary.Application != appName {
+		// Only the services initialized in the application are listed outside of its workspace.
+		return nil, nil
+	}
+	names, err := l.Ws.ServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("get local %s names: %w", svcWorkloadType, err)
+	}
+	types := make(map[string]string, len(names))
+	for _, name := range names {
+		raw, err := l.Ws.ReadServiceManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest of %s %s: %w", svcWorkloadType, name, err)
+		}
+		mft := struct {
+			Type string `yaml:"type"`
+		}{}
+		if err := yaml.Unmarshal(raw, &mft); err != nil {
+			return nil, fmt.Errorf("unmarshal manifest of %s %s: %w", svcWorkloadType, name, err)
+		}
+		types[name] = mft.Type
+	}
+	return types, nil
+}
+
+// mergeSvcs returns the union of the registered and local services sorted by name. A service that is both
+// registered and in the workspace has the type it's registered with.
+func mergeSvcs(appName string, registered []*config.Workload, local map[string]string) []*svcListing {
+	var svcs []*svcListing
+	isRegistered := make(map[string]bool, len(registered))
+	for _, svc := range registered {
+		isRegistered[svc.Name] = true
+		source := svcSourceRegistered
+		if _, ok := local[svc.Name]; ok {
+			source = svcSourceBoth
+		}
+		svcs = append(svcs, &svcListing{
+			App:    appName,
+			Name:   svc.Name,
+			Type:   svc.Type,
+			Source: source,
+		})
+	}
+	for name, typ := range local {
+		if isRegistered[name] {
+			continue
+		}
+		svcs = append(svcs, &svcListing{
+			App:    appName,
+			Name:   name,
+			Type:   typ,
+			Source: svcSourceWorkspace,
+		})
+	}
+	sort.SliceStable(svcs, func(i, j int) bool {
+		return svcs[i].Name < svcs[j].Name
+	})
+	return svcs
+}
+
 func filterByName(wklds []*config.Workload, wantedNames []string) []*config.Workload {
 	isWanted := make(map[string]bool)
 	for _, name := range wantedNames {
@@ -134,25 +251,49 @@ func filterByName(wklds []*config.Workload, wantedNames []string) []*config.Work
 	return filtered
 }
 
-func humanOutput(wklds []*config.Workload, w io.Writer) {
+func jobHumanOutput(jobs []*config.Workload, w io.Writer) {
 	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
-	fmt.Fprintf(writer, "%s\t%s\n", "Name", "Type")
-	nameLengthMax := len("Name")
-	typeLengthMax := len("Type")
-	for _, svc := range wklds {
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Name", "Type", "Schedule")
+	nameLengthMax, typeLengthMax, scheduleLengthMax := len("Name"), len("Type"), len("Schedule")
+	for _, job := range jobs {
+		nameLengthMax = int(math.Max(float64(nameLengthMax), float64(len(job.Name))))
+		typeLengthMax = int(math.Max(float64(typeLengthMax), float64(len(job.Type))))
+		scheduleLengthMax = int(math.Max(float64(scheduleLengthMax), float64(len(scheduleState(job)))))
+	}
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", typeLengthMax), strings.Repeat("-", scheduleLengthMax))
+	for _, job := range jobs {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", job.Name, job.Type, scheduleState(job))
+	}
+	writer.Flush()
+}
+
+// scheduleState returns whether the schedule of the job is enabled, or the environments where it's disabled.
+func scheduleState(job *config.Workload) string {
+	if len(job.DisabledScheduleEnvs) == 0 {
+		return "enabled"
+	}
+	return fmt.Sprintf("disabled (%s)", strings.Join(job.DisabledScheduleEnvs, ", "))
+}
+
+func svcHumanOutput(svcs []*svcListing, w io.Writer) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Name", "Type", "Source")
+	nameLengthMax, typeLengthMax, sourceLengthMax := len("Name"), len("Type"), len("Source")
+	for _, svc := range svcs {
 		nameLengthMax = int(math.Max(float64(nameLengthMax), float64(len(svc.Name))))
 		typeLengthMax = int(math.Max(float64(typeLengthMax), float64(len(svc.Type))))
+		sourceLengthMax = int(math.Max(float64(sourceLengthMax), float64(len(svc.Source))))
 	}
-	fmt.Fprintf(writer, "%s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", typeLengthMax))
-	for _, wkld := range wklds {
-		fmt.Fprintf(writer, "%s\t%s\n", wkld.Name, wkld.Type)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", typeLengthMax), strings.Repeat("-", sourceLengthMax))
+	for _, svc := range svcs {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", svc.Name, svc.Type, svc.Source)
 	}
 	writer.Flush()
 }
 
-func (l *SvcListWriter) jsonOutputSvcs(svcs []*config.Workload) (string, error) {
+func (l *SvcListWriter) jsonOutputSvcs(svcs []*svcListing) (string, error) {
 	type out struct {
-		Services []*config.Workload `json:"services"`
+		Services []*svcListing `json:"services"`
 	}
 	b, err := json.Marshal(out{Services: svcs})
 	if err != nil {
@@ -5,11 +5,13 @@ package list
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/list/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -36,7 +38,7 @@ func TestList_JobListWriter(t *testing.T) {
 			inputAppName:   mockAppName,
 			inputWriteJSON: false,
 
-			wantedContent: "Name                Type\n--------            -------------\nbadgoose            Scheduled Job\nfarmer              Scheduled Job\n",
+			wantedContent: "Name                Type                Schedule\n--------            -------------       ---------------\nbadgoose            Scheduled Job       enabled\nfarmer              Scheduled Job       disabled (prod)\n",
 			mocking: func() {
 				mockStore.EXPECT().
 					GetApplication(gomock.Eq("barnyard")).
@@ -46,7 +48,7 @@ func TestList_JobListWriter(t *testing.T) {
 					ListJobs(gomock.Eq("barnyard")).
 					Return([]*config.Workload{
 						{Name: "badgoose", Type: "Scheduled Job"},
-						{Name: "farmer", Type: "Scheduled Job"},
+						{Name: "farmer", Type: "Scheduled Job", DisabledScheduleEnvs: []string{"prod"}},
 					}, nil)
 			},
 		},
@@ -88,7 +90,7 @@ func TestList_JobListWriter(t *testing.T) {
 			inputAppName:   mockAppName,
 			inputListLocal: true,
 
-			wantedContent: "Name                Type\n--------            -------------\nbadgoose            Scheduled Job\n",
+			wantedContent: "Name                Type                Schedule\n--------            -------------       --------\nbadgoose            Scheduled Job       enabled\n",
 
 			mocking: func() {
 				mockStore.EXPECT().GetApplication("barnyard").
@@ -117,7 +119,7 @@ func TestList_JobListWriter(t *testing.T) {
 			inputAppName:   mockAppName,
 			inputListLocal: true,
 
-			wantedContent: "Name                Type\n----                ----\n",
+			wantedContent: "Name                Type                Schedule\n----                ----                --------\n",
 
 			mocking: func() {
 				mockStore.EXPECT().GetApplication("barnyard").
@@ -176,12 +178,18 @@ func TestList_JobListWriter(t *testing.T) {
 }
 
 func TestList_SvcListWriter(t *testing.T) {
-	ctrl := gomock.NewController(t)
 	mockError := fmt.Errorf("error")
-	mockStore := mocks.NewMockStore(ctrl)
-	mockWs := mocks.NewMockWorkspace(ctrl)
-
 	mockAppName := "barnyard"
+	mockRegisteredSvcs := []*config.Workload{
+		{App: "barnyard", Name: "trough", Type: "Backend Service"},
+		{App: "barnyard", Name: "gaggle", Type: "Load Balanced Web Service"},
+	}
+	mockLocalSvcs := func(ws *mocks.MockWorkspace) {
+		ws.EXPECT().Summary().Return(&workspace.Summary{Application: "barnyard"}, nil)
+		ws.EXPECT().ServiceNames().Return([]string{"trough", "pond"}, nil)
+		ws.EXPECT().ReadServiceManifest("trough").Return([]byte("name: trough\ntype: Backend Service\n"), nil)
+		ws.EXPECT().ReadServiceManifest("pond").Return([]byte("name: pond\ntype: Backend Service\n"), nil)
+	}
 
 	testCases := map[string]struct {
 		inputAppName   string
@@ -191,43 +199,63 @@ func TestList_SvcListWriter(t *testing.T) {
 		wantedError   error
 		wantedContent string
 
-		mocking func()
+		mocking func(store *mocks.MockStore, ws *mocks.MockWorkspace)
 	}{
 		"should succeed writing human readable": {
 			inputAppName:   mockAppName,
 			inputWriteJSON: false,
 
-			wantedContent: "Name                Type\n------              -------------------------\ntrough              Backend Service\ngaggle              Load Balanced Web Service\n",
-			mocking: func() {
-				mockStore.EXPECT().
+			wantedContent: "Name                Type                       Source\n------              -------------------------  ----------\ngaggle              Load Balanced Web Service  registered\npond                Backend Service            workspace\ntrough              Backend Service            both\n",
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().
 					GetApplication(gomock.Eq("barnyard")).
 					Return(&config.Application{}, nil)
-				mockStore.
+				store.
 					EXPECT().
 					ListServices(gomock.Eq("barnyard")).
-					Return([]*config.Workload{
-						{Name: "trough", Type: "Backend Service"},
-						{Name: "gaggle", Type: "Load Balanced Web Service"},
-					}, nil)
+					Return(mockRegisteredSvcs, nil)
+				mockLocalSvcs(ws)
 			},
 		},
 		"should succeed writing json": {
 			inputAppName:   mockAppName,
 			inputWriteJSON: true,
 
-			wantedContent: `{"services":[{"app":"","name":"trough","type":"Backend Service"},{"app":"","name":"gaggle","type":"Load Balanced Web Service"}]}
+			wantedContent: `{"services":[{"app":"barnyard","name":"gaggle","type":"Load Balanced Web Service","source":"registered"},{"app":"barnyard","name":"pond","type":"Backend Service","source":"workspace"},{"app":"barnyard","name":"trough","type":"Backend Service","source":"both"}]}
 `,
-			mocking: func() {
-				mockStore.EXPECT().
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().
 					GetApplication(gomock.Eq("barnyard")).
 					Return(&config.Application{}, nil)
-				mockStore.
+				store.
 					EXPECT().
 					ListServices(gomock.Eq("barnyard")).
-					Return([]*config.Workload{
-						{Name: "trough", Type: "Backend Service"},
-						{Name: "gaggle", Type: "Load Balanced Web Service"},
-					}, nil)
+					Return(mockRegisteredSvcs, nil)
+				mockLocalSvcs(ws)
+			},
+		},
+		"lists only registered services outside of a workspace": {
+			inputAppName: mockAppName,
+
+			wantedContent: "Name                Type                       Source\n------              -------------------------  ----------\ngaggle              Load Balanced Web Service  registered\ntrough              Backend Service            registered\n",
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().GetApplication("barnyard").
+					Return(&config.Application{}, nil)
+				store.EXPECT().ListServices("barnyard").
+					Return(mockRegisteredSvcs, nil)
+				ws.EXPECT().Summary().Return(nil, errors.New("couldn't find an application associated with this workspace"))
+			},
+		},
+		"lists only registered services in the workspace of another application": {
+			inputAppName: mockAppName,
+
+			wantedContent: "Name                Type                       Source\n------              -------------------------  ----------\ngaggle              Load Balanced Web Service  registered\ntrough              Backend Service            registered\n",
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().GetApplication("barnyard").
+					Return(&config.Application{}, nil)
+				store.EXPECT().ListServices("barnyard").
+					Return(mockRegisteredSvcs, nil)
+				ws.EXPECT().Summary().Return(&workspace.Summary{Application: "farm"}, nil)
 			},
 		},
 		"with bad application name": {
@@ -235,60 +263,56 @@ func TestList_SvcListWriter(t *testing.T) {
 
 			wantedError: fmt.Errorf("get application: error"),
 
-			mocking: func() {
-				mockStore.EXPECT().
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().
 					GetApplication(gomock.Eq("barnyard")).
 					Return(nil, mockError)
-				mockStore.
+				store.
 					EXPECT().
 					ListServices(gomock.Eq("barnyard")).
 					Times(0)
+				mockLocalSvcs(ws)
 			},
 		},
 		"listing local services": {
 			inputAppName:   mockAppName,
 			inputListLocal: true,
 
-			wantedContent: "Name                Type\n------              ---------------\ntrough              Backend Service\n",
+			wantedContent: "Name                Type                Source\n------              ---------------     ---------\npond                Backend Service     workspace\ntrough              Backend Service     both\n",
 
-			mocking: func() {
-				mockStore.EXPECT().GetApplication("barnyard").
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().GetApplication("barnyard").
 					Return(&config.Application{}, nil)
-				mockStore.EXPECT().ListServices("barnyard").
-					Return([]*config.Workload{
-						{Name: "trough", Type: "Backend Service"},
-						{Name: "gaggle", Type: "Load Balanced Web Service"},
-					}, nil)
-				mockWs.EXPECT().ServiceNames().Return([]string{"trough"}, nil)
+				store.EXPECT().ListServices("barnyard").
+					Return(mockRegisteredSvcs, nil)
+				mockLocalSvcs(ws)
 			},
 		},
-		"with failed call to ListJobs": {
+		"with failed call to ListServices": {
 			inputAppName: mockAppName,
 
 			wantedError: fmt.Errorf("get service names: error"),
 
-			mocking: func() {
-				mockStore.EXPECT().GetApplication("barnyard").
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().GetApplication("barnyard").
 					Return(&config.Application{}, nil)
-				mockStore.EXPECT().ListServices("barnyard").
+				store.EXPECT().ListServices("barnyard").
 					Return(nil, mockError)
+				mockLocalSvcs(ws)
 			},
 		},
-		"with no local services": {
-			inputAppName:   mockAppName,
-			inputListLocal: true,
+		"with failed call to ServiceNames": {
+			inputAppName: mockAppName,
 
-			wantedContent: "Name                Type\n----                ----\n",
+			wantedError: fmt.Errorf("get local service names: error"),
 
-			mocking: func() {
-				mockStore.EXPECT().GetApplication("barnyard").
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().GetApplication("barnyard").
 					Return(&config.Application{}, nil)
-				mockStore.EXPECT().ListServices("barnyard").
-					Return([]*config.Workload{
-						{Name: "trough", Type: "Backend Service"},
-						{Name: "gaggle", Type: "Load Balanced Web Service"},
-					}, nil)
-				mockWs.EXPECT().ServiceNames().Return([]string{}, nil)
+				store.EXPECT().ListServices("barnyard").
+					Return(mockRegisteredSvcs, nil)
+				ws.EXPECT().Summary().Return(&workspace.Summary{Application: "barnyard"}, nil)
+				ws.EXPECT().ServiceNames().Return(nil, mockError)
 			},
 		},
 		"with no local services json": {
@@ -298,23 +322,26 @@ func TestList_SvcListWriter(t *testing.T) {
 
 			wantedContent: "{\"services\":null}\n",
 
-			mocking: func() {
-				mockStore.EXPECT().GetApplication("barnyard").
+			mocking: func(store *mocks.MockStore, ws *mocks.MockWorkspace) {
+				store.EXPECT().GetApplication("barnyard").
 					Return(&config.Application{}, nil)
-				mockStore.EXPECT().ListServices("barnyard").
-					Return([]*config.Workload{
-						{Name: "trough", Type: "Backend Service"},
-						{Name: "gaggle", Type: "Load Balanced Web Service"},
-					}, nil)
-				mockWs.EXPECT().ServiceNames().Return([]string{}, nil)
+				store.EXPECT().ListServices("barnyard").
+					Return(mockRegisteredSvcs, nil)
+				ws.EXPECT().Summary().Return(&workspace.Summary{Application: "barnyard"}, nil)
+				ws.EXPECT().ServiceNames().Return([]string{}, nil)
 			},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockStore(ctrl)
+			mockWs := mocks.NewMockWorkspace(ctrl)
+			tc.mocking(mockStore, mockWs)
+
 			b := &bytes.Buffer{}
-			tc.mocking()
 			list := &SvcListWriter{
 				Ws:    mockWs,
 				Store: mockStore,
@@ -330,6 +357,7 @@ func TestList_SvcListWriter(t *testing.T) {
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
+				require.NoError(t, err)
 				require.Equal(t, tc.wantedContent, b.String())
 			}
 		})
@@ -6,6 +6,7 @@ package logging
 
 import (
 	"fmt"
+	"hash/fnv"
 	"io"
 	"time"
 
@@ -18,8 +19,13 @@ import (
 const (
 	defaultServiceLogsLimit = 10
 
-	fmtSvclogGroupName    = "/copilot/%s-%s-%s"
-	fmtSvcLogStreamPrefix = "copilot/%s"
+	fmtSvclogGroupName     = "/copilot/%s-%s-%s"
+	svcAWSLogsStreamPrefix = "copilot"
+	fmtSvcLogStreamPrefix  = svcAWSLogsStreamPrefix + "/%s"
+
+	// In follow mode, each log stream is polled again from this long before its last forwarded event
+	// so that the events ingested after a poll with an earlier timestamp are not missed.
+	followLookback = 5 * time.Second
 )
 
 type logGetter interface {
@@ -29,9 +35,12 @@ type logGetter interface {
 // ServiceClient retrieves the logs of an Amazon ECS service.
 type ServiceClient struct {
 	logGroupName        string
-	logStreamNamePrefix string
-	eventsGetter        logGetter
-	w                   io.Writer
+	logStreamNamePrefix string // Prefix of the log streams of the main container.
+	awslogsStreamPrefix string // Prefix of the log streams of every container, "{prefix}/{container name}/{task ID}".
+	// If true, only the log streams starting with the prefix are read when no task IDs are given.
+	onlyPrefixedLogStreams bool
+	eventsGetter           logGetter
+	w                      io.Writer
 }
 
 // WriteLogEventsOpts wraps the parameters to call WriteLogEvents.
@@ -41,6 +50,10 @@ type WriteLogEventsOpts struct {
 	StartTime *int64
 	EndTime   *int64
 	TaskIDs   []string
+	// ContainerName only retrieves the log events of the container, if empty the events of every container are retrieved.
+	ContainerName string
+	// FilterPattern only retrieves the log events matching the CloudWatch Logs filter pattern, it applies to every poll in follow mode.
+	FilterPattern string
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 }
@@ -59,47 +72,165 @@ func (o WriteLogEventsOpts) limit() *int64 {
 
 // NewServiceClient returns a ServiceClient for the svc service under env and app.
 // The logging client is initialized from the given sess session.
-func NewServiceClient(sess *session.Session, app, env, svc string) *ServiceClient {
+// If logGroup is empty, the logs are read from the default log group of the service.
+func NewServiceClient(sess *session.Session, app, env, svc, logGroup string) *ServiceClient {
+	if logGroup == "" {
+		logGroup = fmt.Sprintf(fmtSvclogGroupName, app, env, svc)
+	}
 	return &ServiceClient{
-		logGroupName:        fmt.Sprintf(fmtSvclogGroupName, app, env, svc),
+		logGroupName:        logGroup,
 		logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, svc),
+		awslogsStreamPrefix: svcAWSLogsStreamPrefix,
 		eventsGetter:        cloudwatchlogs.New(sess),
 		w:                   log.OutputWriter,
 	}
 }
 
 // WriteLogEvents writes service logs.
+// In follow mode, only the first poll is limited and the following ones write all the new events.
 func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
-		LogGroup:   s.logGroupName,
-		Limit:      opts.limit(),
-		EndTime:    opts.EndTime,
-		StartTime:  opts.StartTime,
-		LogStreams: s.logStreams(opts.TaskIDs),
+		LogGroup:      s.logGroupName,
+		Limit:         opts.limit(),
+		EndTime:       opts.EndTime,
+		StartTime:     opts.StartTime,
+		LogStreams:    s.logStreams(opts.TaskIDs, opts.ContainerName),
+		FilterPattern: opts.FilterPattern,
+	}
+	logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
+	if err != nil {
+		return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
+	}
+	if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
+		return err
+	}
+	if !opts.Follow {
+		return nil
 	}
+	streams := newFollowedStreams(logEventsOutput)
+	logEventsOpts.Limit = nil
 	for {
-		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
+		// for unit test.
+		if logEventsOutput.StreamLastEventTime == nil {
+			return nil
+		}
+		time.Sleep(cloudwatchlogs.SleepDuration)
+		logEventsOpts.StreamLastEventTime = streams.lastEventTimes()
+		logEventsOutput, err = s.eventsGetter.LogEvents(logEventsOpts)
 		if err != nil {
 			return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
 		}
-		if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
+		if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(streams.unseen(logEventsOutput.Events))); err != nil {
 			return err
 		}
-		if !opts.Follow {
-			return nil
+	}
+}
+
+// eventKey identifies an event within a log stream.
+type eventKey struct {
+	timestamp   int64
+	messageHash uint64
+}
+
+// followedStream holds the events forwarded from a log stream in follow mode.
+type followedStream struct {
+	fetchedUntil  int64            // Timestamp of the last event fetched by the first poll, whether it was forwarded or left out by the limit.
+	lastEventTime int64            // Timestamp of the last forwarded event.
+	forwarded     map[eventKey]int // Number of times each event was forwarded within the lookback window.
+}
+
+// followedStreams tracks the events forwarded from each log stream in follow mode,
+// so that overlapping polls don't forward an event more than once.
+type followedStreams map[string]*followedStream
+
+func newFollowedStreams(first *cloudwatchlogs.LogEventsOutput) followedStreams {
+	streams := make(followedStreams)
+	for name, lastEventTime := range first.StreamLastEventTime {
+		streams[name] = &followedStream{
+			fetchedUntil:  lastEventTime,
+			lastEventTime: lastEventTime,
+			forwarded:     make(map[eventKey]int),
 		}
-		// for unit test.
-		if logEventsOutput.StreamLastEventTime == nil {
-			return nil
+	}
+	streams.unseen(first.Events)
+	return streams
+}
+
+// unseen returns the events that weren't forwarded yet, in their original order, and marks them as forwarded.
+func (streams followedStreams) unseen(events []*cloudwatchlogs.Event) []*cloudwatchlogs.Event {
+	polled := make(map[string]map[eventKey]int)
+	var unseen []*cloudwatchlogs.Event
+	for _, event := range events {
+		stream, ok := streams[event.LogStreamName]
+		if !ok {
+			stream = &followedStream{
+				forwarded: make(map[eventKey]int),
+			}
+			streams[event.LogStreamName] = stream
 		}
-		logEventsOpts.StreamLastEventTime = logEventsOutput.StreamLastEventTime
-		time.Sleep(cloudwatchlogs.SleepDuration)
+		if polled[event.LogStreamName] == nil {
+			polled[event.LogStreamName] = make(map[eventKey]int)
+		}
+		h := fnv.New64a()
+		h.Write([]byte(event.Message))
+		key := eventKey{
+			timestamp:   event.Timestamp,
+			messageHash: h.Sum64(),
+		}
+		// The same message can be logged more than once at the same time, so only the occurrences
+		// beyond the ones already forwarded are new.
+		polled[event.LogStreamName][key]++
+		if polled[event.LogStreamName][key] <= stream.forwarded[key] {
+			continue
+		}
+		stream.forwarded[key]++
+		if event.Timestamp > stream.lastEventTime {
+			stream.lastEventTime = event.Timestamp
+		}
+		unseen = append(unseen, event)
 	}
+	for _, stream := range streams {
+		for key := range stream.forwarded {
+			if key.timestamp < stream.startTime() {
+				delete(stream.forwarded, key)
+			}
+		}
+	}
+	return unseen
+}
+
+// lastEventTimes returns the time after which each log stream should be polled next.
+func (streams followedStreams) lastEventTimes() map[string]int64 {
+	times := make(map[string]int64)
+	for name, stream := range streams {
+		if start := stream.startTime(); start > 0 {
+			// The events are retrieved after the last event time, so step back to include the start time.
+			times[name] = start - 1
+		}
+	}
+	return times
 }
 
-func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
+// startTime returns the timestamp of the earliest event that the next poll of the log stream should retrieve.
+func (s *followedStream) startTime() int64 {
+	start := s.lastEventTime - followLookback.Milliseconds()
+	if start < s.fetchedUntil {
+		return s.fetchedUntil
+	}
+	return start
+}
+
+// logStreams returns the names or prefixes of the log streams to read, or nil to read all the log streams of the log group.
+func (s *ServiceClient) logStreams(taskIDs []string, container string) (logStreamName []string) {
+	prefix := s.logStreamNamePrefix
+	if container != "" {
+		prefix = fmt.Sprintf("%s/%s", s.awslogsStreamPrefix, container)
+	}
+	if len(taskIDs) == 0 && (s.onlyPrefixedLogStreams || container != "") {
+		return []string{prefix + "/"}
+	}
 	for _, taskID := range taskIDs {
-		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
+		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", prefix, taskID))
 	}
 	return
 }
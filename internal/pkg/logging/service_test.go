@@ -24,32 +24,36 @@ func TestServiceClient_WriteLogEvents(t *testing.T) {
 	const (
 		mockLogGroupName     = "mockLogGroup"
 		mockLogStreamPrefix  = "mockLogStreamPrefix"
-		logEventsHumanString = `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -
-firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
-firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
+		logEventsHumanString = `frontend             fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -
+firelens_log_router  fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
+envoy                fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
 `
-		logEventsJSONString = "{\"logStreamName\":\"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"GET / HTTP/1.1\\\" 200 -\",\"timestamp\":0}\n{\"logStreamName\":\"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"FATA some error\\\" - -\",\"timestamp\":0}\n{\"logStreamName\":\"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"WARN some warning\\\" - -\",\"timestamp\":0}\n"
+		logEventsJSONString = "{\"logStreamName\":\"copilot/frontend/fcfe4ab8043841c08162318e5ad805f1\",\"containerName\":\"frontend\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"GET / HTTP/1.1\\\" 200 -\",\"timestamp\":0}\n{\"logStreamName\":\"copilot/firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"containerName\":\"firelens_log_router\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"FATA some error\\\" - -\",\"timestamp\":0}\n{\"logStreamName\":\"copilot/envoy/fcfe4ab8043841c08162318e5ad805f1\",\"containerName\":\"envoy\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"WARN some warning\\\" - -\",\"timestamp\":0}\n"
 	)
 	mockLastEventTime := map[string]int64{
 		"mockLogStreamName": 123456,
 	}
 	logEvents := []*cloudwatchlogs.Event{
 		{
-			LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+			LogStreamName: "copilot/frontend/fcfe4ab8043841c08162318e5ad805f1",
+			ContainerName: "frontend",
 			Message:       `10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -`,
 		},
 		{
-			LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+			LogStreamName: "copilot/firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+			ContainerName: "firelens_log_router",
 			Message:       `10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -`,
 		},
 		{
-			LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+			LogStreamName: "copilot/envoy/fcfe4ab8043841c08162318e5ad805f1",
+			ContainerName: "envoy",
 			Message:       `10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -`,
 		},
 	}
 	moreLogEvents := []*cloudwatchlogs.Event{
 		{
-			LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
+			LogStreamName: "copilot/frontend/fcfe4ab8043841c08162318e5ad805f1",
+			ContainerName: "frontend",
 			Message:       `10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -`,
 		},
 	}
@@ -63,6 +67,8 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		startTime  *int64
 		jsonOutput bool
 		taskIDs    []string
+		container  string
+		filter     string
 		setupMocks func(mocks serviceLogsMocks)
 
 		wantedError   error
@@ -132,12 +138,112 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 				)
 			},
 
-			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -
-firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
-firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
-firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
+			wantedContent: `frontend             fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -
+firelens_log_router  fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
+envoy                fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
+frontend             fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
 `,
 		},
+		"only reads the log streams of the container": {
+			container: "firelens_log_router",
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"copilot/firelens_log_router/"}, param.LogStreams)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: logEvents[1:2],
+						}, nil),
+				)
+			},
+
+			wantedContent: `firelens_log_router  fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
+`,
+		},
+		"keeps the filter pattern across polls in follow mode": {
+			follow: true,
+			filter: "?ERROR ?FATAL ?panic",
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, "?ERROR ?FATAL ?panic", param.FilterPattern)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events:              logEvents[1:2],
+							StreamLastEventTime: mockLastEventTime,
+						}, nil),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, "?ERROR ?FATAL ?panic", param.FilterPattern)
+							require.Equal(t, map[string]int64{"mockLogStreamName": 123455}, param.StreamLastEventTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							StreamLastEventTime: nil,
+						}, nil),
+				)
+			},
+
+			wantedContent: `firelens_log_router  fcfe4ab8 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
+`,
+		},
+		"forwards the interleaved events of log streams exactly once in follow mode": {
+			follow: true,
+			setupMocks: func(m serviceLogsMocks) {
+				event := func(stream string, timestamp int64, message string) *cloudwatchlogs.Event {
+					return &cloudwatchlogs.Event{
+						LogStreamName: stream,
+						Timestamp:     timestamp,
+						Message:       message,
+					}
+				}
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: []*cloudwatchlogs.Event{
+								event("task1", 1000, "a1"),
+								event("task2", 1001, "b1"),
+								event("task3", 1002, "c1"),
+							},
+							StreamLastEventTime: map[string]int64{"task1": 1000, "task2": 1001, "task3": 1002},
+						}, nil),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Nil(t, param.Limit)
+							require.Equal(t, map[string]int64{"task1": 999, "task2": 1000, "task3": 1001}, param.StreamLastEventTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: []*cloudwatchlogs.Event{
+								event("task1", 1000, "a1"),
+								event("task2", 1001, "b1"),
+								event("task3", 1002, "c1"),
+								event("task1", 1003, "a2"),
+								event("task3", 1003, "c2"),
+								event("task2", 1004, "b2"),
+							},
+							StreamLastEventTime: map[string]int64{"task1": 1003, "task2": 1004, "task3": 1003},
+						}, nil),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, map[string]int64{"task1": 999, "task2": 1000, "task3": 1001}, param.StreamLastEventTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: []*cloudwatchlogs.Event{
+								event("task2", 1002, "b-late"),
+								event("task1", 1003, "a2"),
+								event("task3", 1003, "c2"),
+								event("task3", 1003, "c2"),
+								event("task2", 1004, "b2"),
+								event("task1", 1005, "a3"),
+							},
+							StreamLastEventTime: nil,
+						}, nil),
+				)
+			},
+
+			wantedContent: "task1 a1\ntask2 b1\ntask3 c1\ntask1 a2\ntask3 c2\ntask2 b2\ntask2 b-late\ntask3 c2\ntask1 a3\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -157,6 +263,7 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			svcLogs := &ServiceClient{
 				logGroupName:        mockLogGroupName,
 				logStreamNamePrefix: mockLogStreamPrefix,
+				awslogsStreamPrefix: svcAWSLogsStreamPrefix,
 				eventsGetter:        mocklogGetter,
 				w:                   b,
 			}
@@ -167,11 +274,13 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 				logWriter = WriteJSONLogs
 			}
 			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
-				Follow:    tc.follow,
-				TaskIDs:   tc.taskIDs,
-				Limit:     tc.limit,
-				StartTime: tc.startTime,
-				OnEvents:  logWriter,
+				Follow:        tc.follow,
+				TaskIDs:       tc.taskIDs,
+				ContainerName: tc.container,
+				Limit:         tc.limit,
+				StartTime:     tc.startTime,
+				FilterPattern: tc.filter,
+				OnEvents:      logWriter,
 			})
 
 			// THEN
@@ -184,3 +293,73 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 		})
 	}
 }
+
+func TestServiceClient_logStreams(t *testing.T) {
+	testCases := map[string]struct {
+		client    *ServiceClient
+		taskIDs   []string
+		container string
+
+		wantedLogStreams []string
+	}{
+		"service without task IDs reads all log streams": {
+			client: &ServiceClient{
+				logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, "frontend"),
+			},
+		},
+		"service with task IDs": {
+			client: &ServiceClient{
+				logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, "frontend"),
+			},
+			taskIDs: []string{"709c7eae05f947f6861b150372ddc443", "1de57fd63c6a4920ac416d02add891b9"},
+
+			wantedLogStreams: []string{"copilot/frontend/709c7eae05f947f6861b150372ddc443", "copilot/frontend/1de57fd63c6a4920ac416d02add891b9"},
+		},
+		"service container without task IDs reads the log streams of the container": {
+			client: &ServiceClient{
+				logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, "frontend"),
+				awslogsStreamPrefix: svcAWSLogsStreamPrefix,
+			},
+			container: "envoy",
+
+			wantedLogStreams: []string{"copilot/envoy/"},
+		},
+		"service container with task IDs": {
+			client: &ServiceClient{
+				logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, "frontend"),
+				awslogsStreamPrefix: svcAWSLogsStreamPrefix,
+			},
+			taskIDs:   []string{"709c7eae05f947f6861b150372ddc443"},
+			container: "firelens_log_router",
+
+			wantedLogStreams: []string{"copilot/firelens_log_router/709c7eae05f947f6861b150372ddc443"},
+		},
+		"task group without task IDs reads the log streams of copilot tasks": {
+			client: &ServiceClient{
+				logStreamNamePrefix:    fmt.Sprintf(fmtTaskLogStreamPrefix, "db-migrate"),
+				onlyPrefixedLogStreams: true,
+			},
+
+			wantedLogStreams: []string{"copilot-task/db-migrate/"},
+		},
+		"task group with task IDs": {
+			client: &ServiceClient{
+				logStreamNamePrefix:    fmt.Sprintf(fmtTaskLogStreamPrefix, "db-migrate"),
+				onlyPrefixedLogStreams: true,
+			},
+			taskIDs: []string{"4f8243e83f8a4bdaa7587fa1eaff2ea3"},
+
+			wantedLogStreams: []string{"copilot-task/db-migrate/4f8243e83f8a4bdaa7587fa1eaff2ea3"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			logStreams := tc.client.logStreams(tc.taskIDs, tc.container)
+
+			// THEN
+			require.Equal(t, tc.wantedLogStreams, logStreams)
+		})
+	}
+}
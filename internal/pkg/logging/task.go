@@ -20,7 +20,9 @@ const (
 	numCWLogsCallsPerRound = 10
 	fmtTaskLogGroupName    = "/copilot/%s"
 	// e.g., copilot-task/python/4f8243e83f8a4bdaa7587fa1eaff2ea3
-	fmtTaskLogStreamName = "copilot-task/%s/%s"
+	fmtTaskLogStreamName    = "copilot-task/%s/%s"
+	taskAWSLogsStreamPrefix = "copilot-task"
+	fmtTaskLogStreamPrefix  = taskAWSLogsStreamPrefix + "/%s"
 )
 
 // TasksDescriber describes ECS tasks.
@@ -50,10 +52,28 @@ func NewTaskClient(sess *session.Session, groupName string, tasks []*task.Task)
 	}
 }
 
+// NewTaskGroupClient returns a ServiceClient that retrieves the logs of the tasks started under the groupName.
+// If no task IDs are passed to WriteLogEvents, the logs of the tasks that logged most recently are retrieved.
+func NewTaskGroupClient(sess *session.Session, groupName string) *ServiceClient {
+	return &ServiceClient{
+		logGroupName:           TaskLogGroupName(groupName),
+		logStreamNamePrefix:    fmt.Sprintf(fmtTaskLogStreamPrefix, groupName),
+		awslogsStreamPrefix:    taskAWSLogsStreamPrefix,
+		onlyPrefixedLogStreams: true,
+		eventsGetter:           cloudwatchlogs.New(sess),
+		w:                      log.OutputWriter,
+	}
+}
+
+// TaskLogGroupName returns the name of the log group of the tasks started under the groupName.
+func TaskLogGroupName(groupName string) string {
+	return fmt.Sprintf(fmtTaskLogGroupName, groupName)
+}
+
 // WriteEventsUntilStopped writes tasks' events to a writer until all tasks have stopped.
 func (t *TaskClient) WriteEventsUntilStopped() error {
 	in := cloudwatchlogs.LogEventsOpts{
-		LogGroup: fmt.Sprintf(fmtTaskLogGroupName, t.GroupName),
+		LogGroup: TaskLogGroupName(t.GroupName),
 	}
 	for {
 		logStreams, err := t.logStreamNamesFromTasks(t.Tasks)
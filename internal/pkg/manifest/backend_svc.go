@@ -35,18 +35,25 @@ type BackendService struct {
 
 // BackendServiceConfig holds the configuration that can be overriden per environments.
 type BackendServiceConfig struct {
-	ImageConfig imageWithPortAndHealthcheck `yaml:"image,flow"`
-	TaskConfig  `yaml:",inline"`
-	*Logging    `yaml:"logging,flow"`
-	Sidecar     `yaml:",inline"`
+	ImageConfig   imageWithPortAndHealthcheck `yaml:"image,flow"`
+	TaskConfig    `yaml:",inline"`
+	Logging       *Logging `yaml:"logging,flow"`
+	Sidecar       `yaml:",inline"`
+	Deployment    DeploymentConfig `yaml:"deployment"`
+	Exec          *bool            `yaml:"exec"` // True lets commands run in the service's containers with ECS Exec.
+	Network       NetworkConfig    `yaml:"network"`
+	Messaging     `yaml:",inline"`
+	Observability Observability     `yaml:"observability"`
+	ServiceTags   map[string]string `yaml:"service_tags"` // Tags of the ECS service, propagated to its tasks.
+	Alarms        AlarmsConfig      `yaml:"alarms"`       // CloudWatch alarms created with the service.
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
 func (bc *BackendServiceConfig) LogConfigOpts() *template.LogConfigOpts {
-	if bc.Logging == nil {
+	if bc.Logging == nil || !bc.Logging.routesWithFirelens() {
 		return nil
 	}
-	return bc.logConfigOpts()
+	return bc.Logging.logConfigOpts()
 }
 
 type imageWithPortAndHealthcheck struct {
@@ -103,6 +110,11 @@ func (s *BackendService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
 }
 
+// ImageRetention returns the number of tagged images to keep in the service's repository.
+func (s *BackendService) ImageRetention() *int {
+	return s.ImageConfig.Retention
+}
+
 // BuildArgs returns a docker.BuildArguments object for the service given a workspace root directory
 func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
@@ -115,10 +127,13 @@ func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
 	if !ok {
 		return &s, nil
 	}
+	if overrideConfig.Platform != nil {
+		return nil, &ErrPlatformOverride{Env: envName}
+	}
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, BackendService{
 		BackendServiceConfig: *overrideConfig,
-	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(overrideTransformer{}))
 	if err != nil {
 		return nil, err
 	}
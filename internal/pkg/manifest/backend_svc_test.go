@@ -236,7 +236,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 				Sidecars: map[string]*SidecarConfig{
 					"xray": {
 						Port:  aws.String("2000/udp"),
-						Image: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+						Image: SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 					},
 				},
 			},
@@ -256,8 +256,8 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 						},
 					},
 					CPU: aws.Int(512),
-					Variables: map[string]string{
-						"LOG_LEVEL": "",
+					Variables: map[string]Variable{
+						"LOG_LEVEL": {Value: aws.String("")},
 					},
 				},
 				Sidecar: Sidecar{
@@ -276,6 +276,84 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 		},
 	}
+	mockBackendServiceWithExecOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			Exec: aws.Bool(true),
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				Exec: aws.Bool(false),
+			},
+		},
+	}
+	mockBackendServiceWithNetworkOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			Exec: aws.Bool(true),
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				Network: NetworkConfig{
+					Connect: aws.Bool(true),
+				},
+			},
+		},
+	}
+	mockBackendServiceWithLabelsOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			ImageConfig: imageWithPortAndHealthcheck{
+				ServiceImageWithPort: ServiceImageWithPort{
+					Image: Image{
+						DockerLabels: map[string]string{
+							"team":    "payments",
+							"version": "1",
+						},
+					},
+				},
+			},
+			ServiceTags: map[string]string{
+				"cost-center": "1234",
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				ImageConfig: imageWithPortAndHealthcheck{
+					ServiceImageWithPort: ServiceImageWithPort{
+						Image: Image{
+							DockerLabels: map[string]string{
+								"version": "2",
+							},
+						},
+					},
+				},
+				ServiceTags: map[string]string{
+					"stage": "test",
+				},
+			},
+		},
+	}
+	mockBackendServiceWithVariableOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"LOG_LEVEL": {Value: aws.String("DEBUG")},
+					"DB_HOST":   {Value: aws.String("localhost")},
+				},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				TaskConfig: TaskConfig{
+					Variables: map[string]Variable{
+						"DB_HOST": {
+							From: VariableFrom{
+								CFNExport: aws.String("test-db-Host"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
 	testCases := map[string]struct {
 		svc       *BackendService
 		inEnvName string
@@ -320,20 +398,19 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 						CPU:    aws.Int(512),
 						Memory: aws.Int(256),
 						Count: Count{
-							Value: aws.Int(1),
 							Autoscaling: Autoscaling{
 								CPU: aws.Int(70),
 							},
 						},
-						Variables: map[string]string{
-							"LOG_LEVEL": "",
+						Variables: map[string]Variable{
+							"LOG_LEVEL": {Value: aws.String("")},
 						},
 					},
 					Sidecar: Sidecar{
 						Sidecars: map[string]*SidecarConfig{
 							"xray": {
 								Port:       aws.String("2000/udp"),
-								Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+								Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 								CredsParam: aws.String("some arn"),
 							},
 						},
@@ -349,6 +426,75 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 			original: &mockBackendServiceWithAllOverride,
 		},
+		"replaces a variable with the one of the environment": {
+			svc:       &mockBackendServiceWithVariableOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{
+						Variables: map[string]Variable{
+							"LOG_LEVEL": {Value: aws.String("DEBUG")},
+							"DB_HOST": {
+								From: VariableFrom{
+									CFNExport: aws.String("test-db-Host"),
+								},
+							},
+						},
+					},
+				},
+			},
+			original: &mockBackendServiceWithVariableOverride,
+		},
+		"disables exec in the environment": {
+			svc:       &mockBackendServiceWithExecOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					Exec: aws.Bool(false),
+				},
+			},
+			original: &mockBackendServiceWithExecOverride,
+		},
+		"enables Service Connect in the environment": {
+			svc:       &mockBackendServiceWithNetworkOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					Exec: aws.Bool(true),
+					Network: NetworkConfig{
+						Connect: aws.Bool(true),
+					},
+				},
+			},
+			original: &mockBackendServiceWithNetworkOverride,
+		},
+		"merges docker labels and service tags per key": {
+			svc:       &mockBackendServiceWithLabelsOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: imageWithPortAndHealthcheck{
+						ServiceImageWithPort: ServiceImageWithPort{
+							Image: Image{
+								DockerLabels: map[string]string{
+									"team":    "payments",
+									"version": "2",
+								},
+							},
+						},
+					},
+					ServiceTags: map[string]string{
+						"cost-center": "1234",
+						"stage":       "test",
+					},
+				},
+			},
+			original: &mockBackendServiceWithLabelsOverride,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -362,3 +508,58 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendSvc_ApplyEnv_PlatformOverride(t *testing.T) {
+	svc := BackendService{
+		Workload: Workload{
+			Name: aws.String("phonetool"),
+			Type: aws.String(BackendServiceType),
+		},
+		BackendServiceConfig: BackendServiceConfig{
+			TaskConfig: TaskConfig{
+				Platform: aws.String("linux/amd64"),
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"prod-iad": {
+				TaskConfig: TaskConfig{
+					Platform: aws.String("linux/arm64"),
+				},
+			},
+		},
+	}
+
+	_, err := svc.ApplyEnv("prod-iad")
+
+	require.EqualError(t, err, "platform cannot be overridden in environment prod-iad since the image is built once for all environments")
+}
+
+func TestBackendSvc_ApplyEnv_Tracing(t *testing.T) {
+	svc := BackendService{
+		Workload: Workload{
+			Name: aws.String("phonetool"),
+			Type: aws.String(BackendServiceType),
+		},
+		BackendServiceConfig: BackendServiceConfig{
+			Observability: Observability{
+				Tracing: aws.String("awsxray"),
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"dev": {
+				Observability: Observability{
+					Tracing: aws.String("none"),
+				},
+			},
+			"prod": {},
+		},
+	}
+
+	dev, err := svc.ApplyEnv("dev")
+	require.NoError(t, err)
+	prod, err := svc.ApplyEnv("prod")
+	require.NoError(t, err)
+
+	require.False(t, dev.Observability.TracingEnabled())
+	require.True(t, prod.Observability.TracingEnabled())
+}
@@ -5,6 +5,7 @@ package manifest
 
 import (
 	"fmt"
+	"strings"
 )
 
 // ErrInvalidWorkloadType occurs when a user requested a manifest template type that doesn't exist.
@@ -16,6 +17,20 @@ func (e *ErrInvalidWorkloadType) Error() string {
 	return fmt.Sprintf("invalid manifest type: %s", e.Type)
 }
 
+// ErrIgnoredFields occurs when a manifest has fields that have no effect for the type of the workload.
+type ErrIgnoredFields struct {
+	Type   string
+	Fields []IgnoredField
+}
+
+func (e *ErrIgnoredFields) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		fields[i] = field.String()
+	}
+	return fmt.Sprintf("manifest fields that have no effect for a %s: %s", e.Type, strings.Join(fields, ", "))
+}
+
 // ErrInvalidPipelineManifestVersion occurs when the pipeline.yml file
 // contains invalid schema version during unmarshalling.
 type ErrInvalidPipelineManifestVersion struct {
@@ -50,3 +65,25 @@ func (e *ErrUnknownProvider) Is(target error) bool {
 	_, ok := target.(*ErrUnknownProvider)
 	return ok
 }
+
+// ErrPlatformOverride occurs when an environment overrides the platform of a workload.
+// The platform can't vary between environments since the image is only built once.
+type ErrPlatformOverride struct {
+	Env string
+}
+
+func (e *ErrPlatformOverride) Error() string {
+	return fmt.Sprintf("platform cannot be overridden in environment %s since the image is built once for all environments", e.Env)
+}
+
+// ErrInvalidJobTrigger occurs when a job doesn't set exactly one of "on.schedule" and "on.event".
+type ErrInvalidJobTrigger struct {
+	Missing bool // True if neither is set, false if both are.
+}
+
+func (e *ErrInvalidJobTrigger) Error() string {
+	if e.Missing {
+		return `one of "on.schedule" or "on.event" must be specified`
+	}
+	return `"on.schedule" and "on.event" cannot be specified together`
+}
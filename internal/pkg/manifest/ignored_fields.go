@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"gopkg.in/yaml.v3"
+)
+
+// workloadManifestTypes are the manifest structs that the keys of each workload type are checked against.
+var workloadManifestTypes = map[string]reflect.Type{
+	LoadBalancedWebServiceType: reflect.TypeOf(LoadBalancedWebService{}),
+	BackendServiceType:         reflect.TypeOf(BackendService{}),
+	ScheduledJobType:           reflect.TypeOf(ScheduledJob{}),
+}
+
+// mapForms holds the structs whose keys are accepted by the types that unmarshal either a scalar or a map
+// with a custom UnmarshalYAML method, since the fields of these types don't match their keys.
+var mapForms = map[reflect.Type][]reflect.Type{
+	reflect.TypeOf(Count{}):                   {reflect.TypeOf(Autoscaling{}), reflect.TypeOf(spotCount{})},
+	reflect.TypeOf(HealthCheckArgsOrString{}): {reflect.TypeOf(HTTPHealthCheckArgs{})},
+	reflect.TypeOf(BuildArgsOrString{}):       {reflect.TypeOf(DockerBuildArgs{})},
+	reflect.TypeOf(SidecarImage{}):            {reflect.TypeOf(sidecarImageBuild{})},
+	reflect.TypeOf(Variable{}):                {reflect.TypeOf(VariableFrom{})},
+	reflect.TypeOf(Logging{}):                 {reflect.TypeOf(Logging{}), reflect.TypeOf(deprecatedLogging{})},
+}
+
+// warnedIgnoredFields holds the ignored fields that were already warned about,
+// so that each warning is only printed once even if the manifest is read multiple times.
+var warnedIgnoredFields sync.Map
+
+// IgnoredField is a manifest field that has no effect for the type of the workload.
+type IgnoredField struct {
+	Key  string // Path of the field, such as "http.path".
+	Line int    // Line of the key in the manifest.
+}
+
+func (f IgnoredField) String() string {
+	return fmt.Sprintf("%q on line %d", f.Key, f.Line)
+}
+
+// ignoredFields returns the fields of the manifest that aren't read for the workload type, in the order they appear.
+func ignoredFields(in []byte, workloadType string) ([]IgnoredField, error) {
+	typ, ok := workloadManifestTypes[workloadType]
+	if !ok {
+		return nil, &ErrInvalidWorkloadType{Type: workloadType}
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(in, &node); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest into YAML nodes: %w", err)
+	}
+	return ignoredFieldsOfNode(&node, typ, ""), nil
+}
+
+func ignoredFieldsOfNode(node *yaml.Node, typ reflect.Type, path string) []IgnoredField {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return ignoredFieldsOfNode(node.Content[0], typ, path)
+	case yaml.AliasNode:
+		return ignoredFieldsOfNode(node.Alias, typ, path)
+	case yaml.SequenceNode:
+		if typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array {
+			return nil
+		}
+		var ignored []IgnoredField
+		for i, item := range node.Content {
+			ignored = append(ignored, ignoredFieldsOfNode(item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return ignored
+	case yaml.MappingNode:
+	default:
+		// Scalars don't have fields, their type is checked when decoding.
+		return nil
+	}
+
+	var ignored []IgnoredField
+	switch typ.Kind() {
+	case reflect.Map:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			ignored = append(ignored, ignoredFieldsOfNode(node.Content[i+1], typ.Elem(), joinKey(path, node.Content[i].Value))...)
+		}
+	case reflect.Struct:
+		keys := fieldKeys(typ)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldType, ok := keys[key.Value]
+			if !ok {
+				ignored = append(ignored, IgnoredField{
+					Key:  joinKey(path, key.Value),
+					Line: key.Line,
+				})
+				continue
+			}
+			ignored = append(ignored, ignoredFieldsOfNode(value, fieldType, joinKey(path, key.Value))...)
+		}
+	}
+	return ignored
+}
+
+// fieldKeys returns the types of the fields of a manifest struct keyed by their YAML key.
+func fieldKeys(typ reflect.Type) map[string]reflect.Type {
+	keys := make(map[string]reflect.Type)
+	forms, ok := mapForms[typ]
+	if !ok {
+		forms = []reflect.Type{typ}
+	}
+	for _, form := range forms {
+		addStructKeys(keys, form)
+	}
+	return keys
+}
+
+// addStructKeys adds the YAML keys of the fields of the struct, including the ones of inlined structs.
+func addStructKeys(keys map[string]reflect.Type, typ reflect.Type) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			// Unexported fields aren't unmarshaled.
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("yaml"), ",")
+		name, flags := tag[0], tag[1:]
+		if name == "-" {
+			continue
+		}
+		if contains(flags, "inline") {
+			if embedded := field.Type; embedded.Kind() == reflect.Struct || embedded.Kind() == reflect.Ptr {
+				addStructKeys(keys, embedded)
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		keys[name] = field.Type
+	}
+}
+
+// warnIgnoredFields prints a warning for each ignored field the first time it's found.
+func warnIgnoredFields(workloadType string, fields []IgnoredField) {
+	for _, field := range fields {
+		if _, warned := warnedIgnoredFields.LoadOrStore(field, true); warned {
+			continue
+		}
+		log.Warningf("The manifest field %s has no effect for a %s and is ignored.\n", field, workloadType)
+	}
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func contains(elems []string, s string) bool {
+	for _, elem := range elems {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
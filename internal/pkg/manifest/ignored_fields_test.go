@@ -0,0 +1,234 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoredFields(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inType    string
+
+		wanted    []IgnoredField
+		wantedErr error
+	}{
+		"no ignored fields": {
+			inContent: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build:
+    dockerfile: ./frontend/Dockerfile
+    args:
+      GOOS: linux
+  port: 80
+http:
+  path: /
+  alias: example.com
+  healthcheck:
+    path: /_healthz
+    grace_period: 60s
+count:
+  range: 1-10
+  cpu_percentage: 70
+  capacity_providers:
+    spot_weight: 2
+variables:
+  LOG_LEVEL: info
+  DB_HOST:
+    from_cfn: db-Host
+sidecars:
+  nginx:
+    port: 80
+    image:
+      build: ./nginx/Dockerfile
+logging:
+  destination:
+    Name: datadog
+  enableMetadata: true
+environments:
+  test:
+    count: 1
+    logging:
+      retention: 7
+`,
+			inType: LoadBalancedWebServiceType,
+		},
+		"fields of another workload type": {
+			inContent: `
+name: api
+type: Backend Service
+http:
+  path: /api
+on:
+  schedule: "@daily"
+`,
+			inType: BackendServiceType,
+			wanted: []IgnoredField{
+				{Key: "http", Line: 4},
+				{Key: "on", Line: 6},
+			},
+		},
+		"unknown nested fields": {
+			inContent: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  location: nginx
+  port: 80
+  ports: 8080
+http:
+  path: /
+  healthcheck:
+    path: /
+    interval_seconds: 10
+count:
+  range: 1-10
+  cpu: 70
+environments:
+  test:
+    http:
+      paths: /test
+    sidecars:
+      nginx:
+        image: nginx
+        portMappings: 80
+`,
+			inType: LoadBalancedWebServiceType,
+			wanted: []IgnoredField{
+				{Key: "image.ports", Line: 7},
+				{Key: "http.healthcheck.interval_seconds", Line: 12},
+				{Key: "count.cpu", Line: 15},
+				{Key: "environments.test.http.paths", Line: 19},
+				{Key: "environments.test.sidecars.nginx.portMappings", Line: 23},
+			},
+		},
+		"fields of a job": {
+			inContent: `
+name: report
+type: Scheduled Job
+image:
+  build: ./Dockerfile
+  port: 80
+on:
+  event:
+    source: [aws.s3]
+    pattern:
+      detail:
+        bucket: [uploads]
+retries: 3
+http:
+  path: /
+`,
+			inType: ScheduledJobType,
+			wanted: []IgnoredField{
+				{Key: "image.port", Line: 6},
+				{Key: "http", Line: 14},
+			},
+		},
+		"invalid workload type": {
+			inContent: `type: Worker Service`,
+			inType:    "Worker Service",
+			wantedErr: &ErrInvalidWorkloadType{Type: "Worker Service"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ignoredFields([]byte(tc.inContent), tc.inType)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestUnmarshalWorkload_IgnoredFields(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inStrict  bool
+
+		wantedWarning string
+		wantedErr     error
+	}{
+		"warns about the ignored fields": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  location: nginx
+http:
+  path: /api
+`,
+			wantedWarning: `Note: The manifest field "http" on line 6 has no effect for a Backend Service and is ignored.
+`,
+		},
+		"errors on the ignored fields in strict mode": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+http:
+  path: /api
+environments:
+  test:
+    on:
+      schedule: "@daily"
+`,
+			inStrict: true,
+			wantedErr: &ErrIgnoredFields{
+				Type: BackendServiceType,
+				Fields: []IgnoredField{
+					{Key: "http", Line: 7},
+					{Key: "environments.test.on", Line: 11},
+				},
+			},
+		},
+		"no warning without ignored fields in strict mode": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  location: nginx
+`,
+			inStrict: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			b := &bytes.Buffer{}
+			log.DiagnosticWriter = b
+
+			// WHEN
+			unmarshal := UnmarshalWorkload
+			if tc.inStrict {
+				unmarshal = UnmarshalWorkloadStrict
+			}
+			_, err := unmarshal([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				require.Empty(t, b.String())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarning, b.String())
+		})
+	}
+}
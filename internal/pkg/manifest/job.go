@@ -5,9 +5,12 @@
 package manifest
 
 import (
+	"encoding/json"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -38,37 +41,109 @@ type ScheduledJob struct {
 type ScheduledJobConfig struct {
 	ImageConfig             Image `yaml:"image,flow"`
 	TaskConfig              `yaml:",inline"`
-	*Logging                `yaml:"logging,flow"`
+	Logging                 *Logging `yaml:"logging,flow"`
 	Sidecar                 `yaml:",inline"`
 	On                      JobTriggerConfig `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
+	Messaging               `yaml:",inline"`
 }
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
+// Only one of Schedule and Event can be set.
 type JobTriggerConfig struct {
-	Schedule string `yaml:"schedule"`
+	Schedule *string          `yaml:"schedule"`
+	Event    *JobEventTrigger `yaml:"event"`
+}
+
+// JobEventTrigger represents the EventBridge events that trigger the job.
+// Source and DetailType are added to the fields of Pattern.
+type JobEventTrigger struct {
+	Source     []string     `yaml:"source"`
+	DetailType []string     `yaml:"detail_type"`
+	Pattern    EventPattern `yaml:"pattern"`
+}
+
+// EventPattern is an EventBridge event pattern.
+// It can be written as a YAML map, or as a string holding the pattern in JSON or YAML.
+type EventPattern map[string]interface{}
+
+// ParseEventPattern parses an EventBridge event pattern written in JSON or YAML.
+func ParseEventPattern(in string) (EventPattern, error) {
+	var pattern map[string]interface{}
+	if err := yaml.Unmarshal([]byte(in), &pattern); err != nil {
+		return nil, errUnmarshalEventPattern
+	}
+	if len(pattern) == 0 {
+		return nil, errUnmarshalEventPattern
+	}
+	return pattern, nil
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the EventPattern
+// type, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (p *EventPattern) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var pattern map[string]interface{}
+	if err := unmarshal(&pattern); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if pattern != nil {
+		*p = pattern
+		return nil
+	}
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return errUnmarshalEventPattern
+	}
+	parsed, err := ParseEventPattern(raw)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the JobTriggerConfig
+// struct to make sure that a schedule and an event aren't both set.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (c *JobTriggerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type jobTriggerConfig JobTriggerConfig // Alias without the UnmarshalYAML method to avoid recursion.
+	if err := unmarshal((*jobTriggerConfig)(c)); err != nil {
+		return err
+	}
+	if c.Schedule != nil && c.Event != nil {
+		return &ErrInvalidJobTrigger{}
+	}
+	return nil
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
+// The fields are pointers so that an environment can override them, including setting the retries to 0.
 type JobFailureHandlerConfig struct {
-	Timeout string `yaml:"timeout"`
-	Retries int    `yaml:"retries"`
+	Timeout *string `yaml:"timeout"`
+	Retries *int    `yaml:"retries"`
 }
 
 // ScheduledJobProps contains properties for creating a new scheduled job manifest.
 type ScheduledJobProps struct {
 	*WorkloadProps
-	Schedule string
-	Timeout  string
-	Retries  int
+	Schedule     string
+	EventPattern EventPattern // Mutually exclusive with Schedule.
+	Timeout      string
+	Retries      int
 }
 
 // LogConfigOpts converts the job's Firelens configuration into a format parsable by the templates pkg.
 func (lc *ScheduledJobConfig) LogConfigOpts() *template.LogConfigOpts {
-	if lc.Logging == nil {
+	if lc.Logging == nil || !lc.Logging.routesWithFirelens() {
 		return nil
 	}
-	return lc.logConfigOpts()
+	return lc.Logging.logConfigOpts()
 }
 
 // newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
@@ -97,9 +172,14 @@ func NewScheduledJob(props *ScheduledJobProps) *ScheduledJob {
 	job.Name = aws.String(props.Name)
 	job.ScheduledJobConfig.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	job.ScheduledJobConfig.ImageConfig.Location = stringP(props.Image)
-	job.On.Schedule = props.Schedule
-	job.Retries = props.Retries
-	job.Timeout = props.Timeout
+	job.On.Schedule = stringP(props.Schedule)
+	if props.EventPattern != nil {
+		job.On.Event = &JobEventTrigger{
+			Pattern: props.EventPattern,
+		}
+	}
+	job.Retries = intP(props.Retries)
+	job.Timeout = stringP(props.Timeout)
 
 	job.parser = template.New()
 	return job
@@ -108,7 +188,9 @@ func NewScheduledJob(props *ScheduledJobProps) *ScheduledJob {
 // MarshalBinary serializes the manifest object into a binary YAML document.
 // Implements the encoding.BinaryMarshaler interface.
 func (j *ScheduledJob) MarshalBinary() ([]byte, error) {
-	content, err := j.parser.Parse(scheduledJobManifestPath, *j)
+	content, err := j.parser.Parse(scheduledJobManifestPath, *j, template.WithFuncs(map[string]interface{}{
+		"toJSON": toJSON,
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -121,10 +203,13 @@ func (j ScheduledJob) ApplyEnv(envName string) (*ScheduledJob, error) {
 	if !ok {
 		return &j, nil
 	}
+	if overrideConfig.Platform != nil {
+		return nil, &ErrPlatformOverride{Env: envName}
+	}
 	// Apply overrides to the original job
 	err := mergo.Merge(&j, ScheduledJob{
 		ScheduledJobConfig: *overrideConfig,
-	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(overrideTransformer{}))
 	if err != nil {
 		return nil, err
 	}
@@ -146,3 +231,19 @@ func (j *ScheduledJob) BuildRequired() (bool, error) {
 func JobDockerfileBuildRequired(job interface{}) (bool, error) {
 	return dockerfileBuildRequired("job", job)
 }
+
+// validateTrigger returns an error if the job doesn't set exactly one of a schedule and an event.
+func (j *ScheduledJob) validateTrigger() error {
+	if j.On.Schedule == nil && j.On.Event == nil {
+		return &ErrInvalidJobTrigger{Missing: true}
+	}
+	return nil
+}
+
+func toJSON(v interface{}) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
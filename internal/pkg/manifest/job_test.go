@@ -9,7 +9,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestScheduledJob_MarshalBinary(t *testing.T) {
@@ -63,6 +65,24 @@ func TestScheduledJob_MarshalBinary(t *testing.T) {
 			},
 			wantedTestData: "scheduled-job-no-timeout.yml",
 		},
+		"triggered by an event pattern": {
+			inProps: ScheduledJobProps{
+				WorkloadProps: &WorkloadProps{
+					Name:  "cuteness-aggregator",
+					Image: "copilot/cuteness-aggregator",
+				},
+				EventPattern: EventPattern{
+					"source":      []interface{}{"aws.s3"},
+					"detail-type": []interface{}{"Object Created"},
+					"detail": map[string]interface{}{
+						"bucket": map[string]interface{}{
+							"name": []interface{}{"uploads"},
+						},
+					},
+				},
+			},
+			wantedTestData: "scheduled-job-event-pattern.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -82,3 +102,296 @@ func TestScheduledJob_MarshalBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduledJob_ApplyEnv(t *testing.T) {
+	testCases := map[string]struct {
+		inJob     *ScheduledJob
+		inEnvName string
+
+		wantedJob *ScheduledJob
+	}{
+		"should keep the schedule, timeout and retries if the environment doesn't override them": {
+			inJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					TaskConfig: TaskConfig{
+						CPU: aws.Int(256),
+					},
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Timeout: aws.String("1h"),
+						Retries: aws.Int(2),
+					},
+				},
+				Environments: map[string]*ScheduledJobConfig{
+					"prod": {
+						TaskConfig: TaskConfig{
+							CPU: aws.Int(512),
+						},
+					},
+				},
+			},
+			inEnvName: "prod",
+
+			wantedJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					TaskConfig: TaskConfig{
+						CPU: aws.Int(512),
+					},
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Timeout: aws.String("1h"),
+						Retries: aws.Int(2),
+					},
+				},
+			},
+		},
+		"should override the schedule, timeout and retries": {
+			inJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Timeout: aws.String("1h"),
+						Retries: aws.Int(2),
+					},
+				},
+				Environments: map[string]*ScheduledJobConfig{
+					"prod": {
+						On: JobTriggerConfig{
+							Schedule: aws.String("@hourly"),
+						},
+						JobFailureHandlerConfig: JobFailureHandlerConfig{
+							Timeout: aws.String("30m"),
+							Retries: aws.Int(5),
+						},
+					},
+				},
+			},
+			inEnvName: "prod",
+
+			wantedJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@hourly"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Timeout: aws.String("30m"),
+						Retries: aws.Int(5),
+					},
+				},
+			},
+		},
+		"should replace the schedule with the event of the environment": {
+			inJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+				},
+				Environments: map[string]*ScheduledJobConfig{
+					"prod": {
+						On: JobTriggerConfig{
+							Event: &JobEventTrigger{
+								Source: []string{"aws.s3"},
+							},
+						},
+					},
+				},
+			},
+			inEnvName: "prod",
+
+			wantedJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Event: &JobEventTrigger{
+							Source: []string{"aws.s3"},
+						},
+					},
+				},
+			},
+		},
+		"should override the retries with 0": {
+			inJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Retries: aws.Int(3),
+					},
+				},
+				Environments: map[string]*ScheduledJobConfig{
+					"test": {
+						JobFailureHandlerConfig: JobFailureHandlerConfig{
+							Retries: aws.Int(0),
+						},
+					},
+				},
+			},
+			inEnvName: "test",
+
+			wantedJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Retries: aws.Int(0),
+					},
+				},
+			},
+		},
+		"should return the job as is if the environment has no overrides": {
+			inJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+				},
+			},
+			inEnvName: "test",
+
+			wantedJob: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("cuteness-aggregator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := tc.inJob.ApplyEnv(tc.inEnvName)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJob, got)
+		})
+	}
+}
+
+func TestJobTriggerConfig_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wanted      JobTriggerConfig
+		wantedError error
+	}{
+		"schedule": {
+			inContent: []byte(`on:
+  schedule: "@daily"`),
+			wanted: JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+			},
+		},
+		"event with a YAML pattern": {
+			inContent: []byte(`on:
+  event:
+    source: [aws.s3]
+    detail_type: [Object Created]
+    pattern:
+      detail:
+        bucket:
+          name: [uploads]`),
+			wanted: JobTriggerConfig{
+				Event: &JobEventTrigger{
+					Source:     []string{"aws.s3"},
+					DetailType: []string{"Object Created"},
+					Pattern: EventPattern{
+						"detail": map[string]interface{}{
+							"bucket": map[string]interface{}{
+								"name": []interface{}{"uploads"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"event with a JSON pattern": {
+			inContent: []byte(`on:
+  event:
+    pattern: |
+      {"source": ["aws.s3"]}`),
+			wanted: JobTriggerConfig{
+				Event: &JobEventTrigger{
+					Pattern: EventPattern{
+						"source": []interface{}{"aws.s3"},
+					},
+				},
+			},
+		},
+		"error if the pattern isn't a map": {
+			inContent: []byte(`on:
+  event:
+    pattern: aws.s3`),
+			wantedError: errUnmarshalEventPattern,
+		},
+		"error if both a schedule and an event are set": {
+			inContent: []byte(`on:
+  schedule: "@daily"
+  event:
+    source: [aws.s3]`),
+			wantedError: &ErrInvalidJobTrigger{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var job ScheduledJobConfig
+			err := yaml.Unmarshal(tc.inContent, &job)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, job.On)
+		})
+	}
+}
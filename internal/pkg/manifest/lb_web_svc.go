@@ -5,7 +5,9 @@ package manifest
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -25,8 +27,28 @@ const (
 	defaultHealthCheckPath = "/"
 )
 
+// maxHealthCheckDelay is the longest health check grace period and deregistration delay allowed by ELB.
+const maxHealthCheckDelay = time.Hour
+
+// Protocol versions that the load balancer can use to send requests to the service.
+const (
+	httpVersionGRPC  = "grpc"
+	httpVersionHTTP2 = "http2"
+	httpVersionHTTP1 = "http1"
+)
+
+var httpVersions = []string{httpVersionGRPC, httpVersionHTTP2, httpVersionHTTP1}
+
+// Limits of the custom domain names of a service, a listener rule allows up to 5 condition values
+// and one of them is already taken by the service's default domain name.
+const (
+	maxAliases                    = 4
+	maxAliasesWithAdditionalRules = 2 // Additional rules also use two condition values for their paths.
+)
+
 var (
 	errUnmarshalHealthCheckArgs = errors.New("can't unmarshal healthcheck field into string or compose-style map")
+	errUnmarshalAlias           = errors.New("can't unmarshal alias field into string or list of strings")
 )
 
 // LoadBalancedWebService holds the configuration to build a container image with an exposed port that receives
@@ -42,19 +64,26 @@ type LoadBalancedWebService struct {
 
 // LoadBalancedWebServiceConfig holds the configuration for a load balanced web service.
 type LoadBalancedWebServiceConfig struct {
-	ImageConfig ServiceImageWithPort `yaml:"image,flow"`
-	RoutingRule `yaml:"http,flow"`
-	TaskConfig  `yaml:",inline"`
-	*Logging    `yaml:"logging,flow"`
-	Sidecar     `yaml:",inline"`
+	ImageConfig   ServiceImageWithPort `yaml:"image,flow"`
+	RoutingRule   `yaml:"http,flow"`
+	TaskConfig    `yaml:",inline"`
+	Logging       *Logging `yaml:"logging,flow"`
+	Sidecar       `yaml:",inline"`
+	Deployment    DeploymentConfig `yaml:"deployment"`
+	Exec          *bool            `yaml:"exec"` // True lets commands run in the service's containers with ECS Exec.
+	Network       NetworkConfig    `yaml:"network"`
+	Messaging     `yaml:",inline"`
+	Observability Observability     `yaml:"observability"`
+	ServiceTags   map[string]string `yaml:"service_tags"` // Tags of the ECS service, propagated to its tasks.
+	Alarms        WebAlarmsConfig   `yaml:"alarms"`       // CloudWatch alarms created with the service.
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
 func (lc *LoadBalancedWebServiceConfig) LogConfigOpts() *template.LogConfigOpts {
-	if lc.Logging == nil {
+	if lc.Logging == nil || !lc.Logging.routesWithFirelens() {
 		return nil
 	}
-	return lc.logConfigOpts()
+	return lc.Logging.logConfigOpts()
 }
 
 // HTTPHealthCheckArgs holds the configuration to determine if the load balanced web service is healthy.
@@ -66,6 +95,9 @@ type HTTPHealthCheckArgs struct {
 	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
 	Timeout            *time.Duration `yaml:"timeout"`
 	Interval           *time.Duration `yaml:"interval"`
+	GracePeriod        *time.Duration `yaml:"grace_period"` // Time ECS ignores failing health checks after a task starts.
+	// SuccessCodes are the HTTP codes, or gRPC codes if the service uses gRPC, of a healthy target. For example: "200-299" or "0-99".
+	SuccessCodes *string `yaml:"success_codes"`
 }
 
 // HealthCheckArgsOrString is a custom type which supports unmarshaling yaml which
@@ -81,6 +113,7 @@ func (hc HealthCheckArgsOrString) HTTPHealthCheckOpts() template.HTTPHealthCheck
 		HealthCheckPath:    defaultHealthCheckPath,
 		HealthyThreshold:   hc.HealthCheckArgs.HealthyThreshold,
 		UnhealthyThreshold: hc.HealthCheckArgs.UnhealthyThreshold,
+		SuccessCodes:       hc.HealthCheckArgs.SuccessCodes,
 	}
 	if hc.HealthCheckArgs.Path != nil {
 		opts.HealthCheckPath = *hc.HealthCheckArgs.Path
@@ -93,6 +126,9 @@ func (hc HealthCheckArgsOrString) HTTPHealthCheckOpts() template.HTTPHealthCheck
 	if hc.HealthCheckArgs.Timeout != nil {
 		opts.Timeout = aws.Int64(int64(hc.HealthCheckArgs.Timeout.Seconds()))
 	}
+	if hc.HealthCheckArgs.GracePeriod != nil {
+		opts.GracePeriod = aws.Int64(int64(hc.HealthCheckArgs.GracePeriod.Seconds()))
+	}
 	return opts
 }
 
@@ -130,6 +166,204 @@ type RoutingRule struct {
 	TargetContainer          *string  `yaml:"target_container"`
 	TargetContainerCamelCase *string  `yaml:"targetContainer"` // "targetContainerCamelCase" for backwards compatibility
 	AllowedSourceIps         []string `yaml:"allowed_source_ips"`
+	// DeregistrationDelay is the time the load balancer waits before deregistering a draining target.
+	DeregistrationDelay *time.Duration `yaml:"deregistration_delay"`
+	// AdditionalRules route requests to other ports of the main container, each with its own target group.
+	AdditionalRules []AdditionalRoutingRule `yaml:"additional_rules"`
+	// ProtocolVersion is the protocol version the load balancer uses to send requests to the service: grpc, http2 or http1.
+	ProtocolVersion *string `yaml:"version"`
+	// Alias holds the custom domain names that route requests to the service in addition to its default domain name.
+	Alias Alias `yaml:"alias"`
+}
+
+// Alias is a custom type which supports unmarshaling yaml which
+// can either be of type string or type []string.
+type Alias []string
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Alias
+// type, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (a *Alias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var aliases []string
+	if err := unmarshal(&aliases); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+		var alias string
+		if err := unmarshal(&alias); err != nil {
+			return errUnmarshalAlias
+		}
+		aliases = []string{alias}
+	}
+	*a = aliases
+	return nil
+}
+
+// AdditionalRoutingRule holds the path to route requests to a port of the main container other than the service's port.
+type AdditionalRoutingRule struct {
+	Path        *string                 `yaml:"path"`
+	TargetPort  *uint16                 `yaml:"target_port"` // Defaults to the port of the image.
+	HealthCheck HealthCheckArgsOrString `yaml:"healthcheck"`
+}
+
+// AdditionalRoutingRuleOpts converts the additional routing rules into a format parsable by the templates pkg.
+// Rules without a target port are routed to the port of the image.
+func (r RoutingRule) AdditionalRoutingRuleOpts(imagePort uint16) []template.AdditionalRoutingRuleOpts {
+	var opts []template.AdditionalRoutingRuleOpts
+	for _, rule := range r.AdditionalRules {
+		port := imagePort
+		if rule.TargetPort != nil {
+			port = *rule.TargetPort
+		}
+		opts = append(opts, template.AdditionalRoutingRuleOpts{
+			TargetPort:      port,
+			HTTPHealthCheck: rule.HealthCheck.HTTPHealthCheckOpts(),
+		})
+	}
+	return opts
+}
+
+// DeregistrationDelaySeconds returns the deregistration delay of the target groups in seconds, or nil if it isn't set.
+func (r RoutingRule) DeregistrationDelaySeconds() *int64 {
+	if r.DeregistrationDelay == nil {
+		return nil
+	}
+	return aws.Int64(int64(r.DeregistrationDelay.Seconds()))
+}
+
+// ProtocolVersionOpts returns the protocol version of the target groups in the format of the templates pkg,
+// or an empty string if it isn't set.
+func (r RoutingRule) ProtocolVersionOpts() (string, error) {
+	if r.ProtocolVersion == nil {
+		return "", nil
+	}
+	version := strings.ToLower(*r.ProtocolVersion)
+	for _, valid := range httpVersions {
+		if version == valid {
+			return strings.ToUpper(version), nil
+		}
+	}
+	return "", fmt.Errorf("http.version %s must be one of %s, %s or %s", *r.ProtocolVersion, httpVersionGRPC, httpVersionHTTP2, httpVersionHTTP1)
+}
+
+// IsGRPC returns true if the load balancer sends gRPC requests to the service.
+func (r RoutingRule) IsGRPC() bool {
+	return strings.ToLower(aws.StringValue(r.ProtocolVersion)) == httpVersionGRPC
+}
+
+// AdditionalRulePaths returns the paths of the additional routing rules without leading or trailing slashes.
+func (r RoutingRule) AdditionalRulePaths() []string {
+	var paths []string
+	for _, rule := range r.AdditionalRules {
+		paths = append(paths, normalizeRulePath(aws.StringValue(rule.Path)))
+	}
+	return paths
+}
+
+// ValidateRoutingRules returns an error if a port is exposed more than once by the main container, if two routing rules
+// share the same path, or if an additional routing rule targets a port that the main container doesn't expose.
+func ValidateRoutingRules(image ServiceImageWithPort, rule RoutingRule) error {
+	ports := make(map[uint16]bool)
+	for _, port := range append([]uint16{aws.Uint16Value(image.Port)}, image.AdditionalPorts...) {
+		if ports[port] {
+			return fmt.Errorf("port %d is exposed more than once", port)
+		}
+		ports[port] = true
+	}
+	paths := map[string]bool{
+		normalizeRulePath(aws.StringValue(rule.Path)): true,
+	}
+	for _, additional := range rule.AdditionalRules {
+		path := normalizeRulePath(aws.StringValue(additional.Path))
+		if path == "" {
+			return errors.New("additional routing rules must route a path other than the root path")
+		}
+		if paths[path] {
+			return fmt.Errorf("path %s is routed more than once", aws.StringValue(additional.Path))
+		}
+		paths[path] = true
+		if additional.TargetPort != nil && !ports[*additional.TargetPort] {
+			return fmt.Errorf("target port %d of the routing rule for path %s is not exposed by the container",
+				*additional.TargetPort, aws.StringValue(additional.Path))
+		}
+	}
+	return nil
+}
+
+// ValidateAliases returns an error if the custom domain names of the routing rule can't be routed to the service.
+// Each alias must be the application's domain, such as "my-app.example.com", or a subdomain of it
+// since the application's hosted zone is the only one that Copilot can add records to.
+func ValidateAliases(rule RoutingRule, appDomain string) error {
+	if len(rule.Alias) == 0 {
+		return nil
+	}
+	if appDomain == "" {
+		return errors.New("http.alias requires an application with a domain name, create one with `copilot app init --domain`")
+	}
+	if err := validateAliasNames(rule); err != nil {
+		return err
+	}
+	for _, alias := range rule.Alias {
+		name := strings.ToLower(strings.TrimSuffix(alias, "."))
+		if name != appDomain && !strings.HasSuffix(name, "."+appDomain) {
+			return fmt.Errorf("alias %s must be %s or a subdomain of it", alias, appDomain)
+		}
+	}
+	return nil
+}
+
+// ValidateImportedCertAliases returns an error if the custom domain names of the routing rule can't be routed to
+// the service of an environment with imported certificates. The aliases can be any domain name, since their DNS
+// records are managed outside of Copilot, but whether the certificates cover them is validated against ACM.
+func ValidateImportedCertAliases(rule RoutingRule) error {
+	return validateAliasNames(rule)
+}
+
+// validateAliasNames returns an error if there are more aliases than the listener rule conditions allow,
+// or if an alias is specified more than once.
+func validateAliasNames(rule RoutingRule) error {
+	if len(rule.AdditionalRules) > 0 && len(rule.Alias) > maxAliasesWithAdditionalRules {
+		return fmt.Errorf("http.alias can have at most %d domain names when the service has additional routing rules", maxAliasesWithAdditionalRules)
+	}
+	if len(rule.Alias) > maxAliases {
+		return fmt.Errorf("http.alias can have at most %d domain names", maxAliases)
+	}
+	seen := make(map[string]bool)
+	for _, alias := range rule.Alias {
+		name := strings.ToLower(strings.TrimSuffix(alias, "."))
+		if seen[name] {
+			return fmt.Errorf("alias %s is specified more than once", alias)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// ValidateHealthCheckDelays returns an error if the health check grace period or the deregistration delay
+// of the routing rule is outside of the range allowed by ELB.
+func ValidateHealthCheckDelays(rule RoutingRule) error {
+	if err := validateHealthCheckDelay("http.healthcheck.grace_period", rule.HealthCheck.HealthCheckArgs.GracePeriod); err != nil {
+		return err
+	}
+	return validateHealthCheckDelay("http.deregistration_delay", rule.DeregistrationDelay)
+}
+
+func validateHealthCheckDelay(field string, delay *time.Duration) error {
+	if delay == nil {
+		return nil
+	}
+	if *delay < 0 || *delay > maxHealthCheckDelay {
+		return fmt.Errorf("%s %s must be between 0s and %ds", field, delay, int(maxHealthCheckDelay.Seconds()))
+	}
+	return nil
+}
+
+// normalizeRulePath trims the leading and trailing slashes of a path, so that "/" becomes the empty string.
+func normalizeRulePath(path string) string {
+	return strings.Trim(path, "/")
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -178,7 +412,8 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 }
 
 func (h *HTTPHealthCheckArgs) isEmpty() bool {
-	return h.Path == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Interval == nil && h.Timeout == nil
+	return h.Path == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Interval == nil && h.Timeout == nil &&
+		h.GracePeriod == nil && h.SuccessCodes == nil
 }
 
 // MarshalBinary serializes the manifest object into a binary YAML document.
@@ -202,6 +437,11 @@ func (s *LoadBalancedWebService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
 }
 
+// ImageRetention returns the number of tagged images to keep in the service's repository.
+func (s *LoadBalancedWebService) ImageRetention() *int {
+	return s.ImageConfig.Retention
+}
+
 // BuildArgs returns a docker.BuildArguments object given a ws root directory.
 func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
@@ -214,10 +454,13 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebServic
 	if !ok {
 		return &s, nil
 	}
+	if overrideConfig.Platform != nil {
+		return nil, &ErrPlatformOverride{Env: envName}
+	}
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, LoadBalancedWebService{
 		LoadBalancedWebServiceConfig: *overrideConfig,
-	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(overrideTransformer{}))
 	if err != nil {
 		return nil, err
 	}
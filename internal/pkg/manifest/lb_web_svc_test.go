@@ -25,6 +25,8 @@ func TestHealthCheckArgsOrString_HTTPHealthCheckOpts(t *testing.T) {
 		inputUnhealthyThreshold *int64
 		inputInterval           *time.Duration
 		inputTimeout            *time.Duration
+		inputGracePeriod        *time.Duration
+		inputSuccessCodes       *string
 
 		wantedOpts template.HTTPHealthCheckOpts
 	}{
@@ -87,12 +89,29 @@ func TestHealthCheckArgsOrString_HTTPHealthCheckOpts(t *testing.T) {
 				Timeout:         aws.Int64(15),
 			},
 		},
+		"just GracePeriod": {
+			inputGracePeriod: durationp(2 * time.Minute),
+
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     aws.Int64(120),
+			},
+		},
+		"just SuccessCodes": {
+			inputSuccessCodes: aws.String("0-99"),
+
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				SuccessCodes:    aws.String("0-99"),
+			},
+		},
 		"all values changed in manifest": {
 			inputPath:               aws.String("/road/to/nowhere"),
 			inputHealthyThreshold:   aws.Int64(3),
 			inputUnhealthyThreshold: aws.Int64(3),
 			inputInterval:           durationp(60 * time.Second),
 			inputTimeout:            durationp(60 * time.Second),
+			inputGracePeriod:        durationp(90 * time.Second),
 
 			wantedOpts: template.HTTPHealthCheckOpts{
 				HealthCheckPath:    "/road/to/nowhere",
@@ -100,6 +119,7 @@ func TestHealthCheckArgsOrString_HTTPHealthCheckOpts(t *testing.T) {
 				UnhealthyThreshold: aws.Int64(3),
 				Interval:           aws.Int64(60),
 				Timeout:            aws.Int64(60),
+				GracePeriod:        aws.Int64(90),
 			},
 		},
 	}
@@ -114,6 +134,8 @@ func TestHealthCheckArgsOrString_HTTPHealthCheckOpts(t *testing.T) {
 					UnhealthyThreshold: tc.inputUnhealthyThreshold,
 					Timeout:            tc.inputTimeout,
 					Interval:           tc.inputInterval,
+					GracePeriod:        tc.inputGracePeriod,
+					SuccessCodes:       tc.inputSuccessCodes,
 				},
 			}
 			// WHEN
@@ -157,6 +179,15 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
 				HealthCheckPath: nil,
 			},
 		},
+		"should unmarshal a configuration with only a grace period": {
+			inContent: []byte(`  healthcheck:
+    grace_period: 2m`),
+			wantedStruct: HealthCheckArgsOrString{
+				HealthCheckArgs: HTTPHealthCheckArgs{
+					GracePeriod: durationp(2 * time.Minute),
+				},
+			},
+		},
 		"error if unmarshalable": {
 			inContent: []byte(`  healthcheck:
     bath: to ruin
@@ -178,6 +209,45 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.HealthCheckArgs.UnhealthyThreshold, rr.HealthCheck.HealthCheckArgs.UnhealthyThreshold)
 				require.Equal(t, tc.wantedStruct.HealthCheckArgs.Interval, rr.HealthCheck.HealthCheckArgs.Interval)
 				require.Equal(t, tc.wantedStruct.HealthCheckArgs.Timeout, rr.HealthCheck.HealthCheckArgs.Timeout)
+				require.Equal(t, tc.wantedStruct.HealthCheckArgs.GracePeriod, rr.HealthCheck.HealthCheckArgs.GracePeriod)
+			}
+		})
+	}
+}
+
+func TestAlias_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedAlias Alias
+		wantedError error
+	}{
+		"single domain name": {
+			inContent: []byte(`alias: api.my-app.example.com`),
+
+			wantedAlias: Alias{"api.my-app.example.com"},
+		},
+		"list of domain names": {
+			inContent: []byte(`alias: [api.my-app.example.com, v1.api.my-app.example.com]`),
+
+			wantedAlias: Alias{"api.my-app.example.com", "v1.api.my-app.example.com"},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`alias:
+  name: api.my-app.example.com`),
+
+			wantedError: errUnmarshalAlias,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var rr RoutingRule
+			err := yaml.Unmarshal(tc.inContent, &rr)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAlias, rr.Alias)
 			}
 		})
 	}
@@ -332,9 +402,9 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						Count: Count{
 							Value: aws.Int(1),
 						},
-						Variables: map[string]string{
-							"LOG_LEVEL":      "DEBUG",
-							"DDB_TABLE_NAME": "awards",
+						Variables: map[string]Variable{
+							"LOG_LEVEL":      {Value: aws.String("DEBUG")},
+							"DDB_TABLE_NAME": {Value: aws.String("awards")},
 						},
 						Secrets: map[string]string{
 							"GITHUB_TOKEN": "1111",
@@ -345,7 +415,7 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						Sidecars: map[string]*SidecarConfig{
 							"xray": {
 								Port:       aws.String("2000"),
-								Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+								Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 								CredsParam: aws.String("some arn"),
 							},
 						},
@@ -374,8 +444,8 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 							Count: Count{
 								Value: aws.Int(0),
 							},
-							Variables: map[string]string{
-								"DDB_TABLE_NAME": "awards-prod",
+							Variables: map[string]Variable{
+								"DDB_TABLE_NAME": {Value: aws.String("awards-prod")},
 							},
 						},
 						Sidecar: Sidecar{
@@ -424,9 +494,9 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						Count: Count{
 							Value: aws.Int(0),
 						},
-						Variables: map[string]string{
-							"LOG_LEVEL":      "DEBUG",
-							"DDB_TABLE_NAME": "awards-prod",
+						Variables: map[string]Variable{
+							"LOG_LEVEL":      {Value: aws.String("DEBUG")},
+							"DDB_TABLE_NAME": {Value: aws.String("awards-prod")},
 						},
 						Secrets: map[string]string{
 							"GITHUB_TOKEN": "1111",
@@ -437,7 +507,7 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						Sidecars: map[string]*SidecarConfig{
 							"xray": {
 								Port:       aws.String("2000/udp"),
-								Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+								Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 								CredsParam: aws.String("some arn"),
 							},
 						},
@@ -483,6 +553,202 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		"with fargate spot turned off": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{
+							Autoscaling: Autoscaling{
+								Range: &mockRange,
+								CPU:   aws.Int(80),
+							},
+							CapacityProviders: &CapacityProviders{
+								SpotWeight: aws.Int(3),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						TaskConfig: TaskConfig{
+							Count: Count{
+								Value: aws.Int(2),
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{
+							Value: aws.Int(2),
+						},
+					},
+				},
+			},
+		},
+		"with logging overrides": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Logging: &Logging{
+						Destination: map[string]string{
+							"Name":           "cloudwatch",
+							"region":         "us-west-2",
+							"log_group_name": "/copilot/phonetool",
+						},
+						EnableMetadata: aws.Bool(false),
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						Logging: &Logging{
+							Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/fluent-bit:prod"),
+							Destination: map[string]string{
+								"region":          "us-east-1",
+								"log_stream_name": "prod",
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Logging: &Logging{
+						Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/fluent-bit:prod"),
+						Destination: map[string]string{
+							"Name":            "cloudwatch",
+							"region":          "us-east-1",
+							"log_group_name":  "/copilot/phonetool",
+							"log_stream_name": "prod",
+						},
+						EnableMetadata: aws.Bool(false),
+					},
+				},
+			},
+		},
+		"with log retention overrides": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Logging: &Logging{
+						Retention: aws.Int(7),
+						LogGroup:  aws.String("/phonetool/frontend"),
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						Logging: &Logging{
+							Retention: aws.Int(365),
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Logging: &Logging{
+						Retention: aws.Int(365),
+						LogGroup:  aws.String("/phonetool/frontend"),
+					},
+				},
+			},
+		},
+		"enables exec only in the environment that overrides it": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(false),
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"dev": {
+						Exec: aws.Bool(true),
+					},
+				},
+			},
+			envToApply: "dev",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(true),
+				},
+			},
+		},
+		"keeps exec disabled in an environment that doesn't override it": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(false),
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"dev": {
+						Exec: aws.Bool(true),
+					},
+					"prod": {
+						TaskConfig: TaskConfig{
+							CPU: aws.Int(1024),
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						CPU: aws.Int(1024),
+					},
+					Exec: aws.Bool(false),
+				},
+			},
+		},
+		"keeps the additional ports and routing rules if the environment doesn't override them": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ServiceImageWithPort{
+						Port:            aws.Uint16(80),
+						AdditionalPorts: []uint16{9090},
+					},
+					RoutingRule: RoutingRule{
+						Path: aws.String("api"),
+						AdditionalRules: []AdditionalRoutingRule{
+							{
+								Path:       aws.String("api/metrics"),
+								TargetPort: aws.Uint16(9090),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						RoutingRule: RoutingRule{
+							Path: aws.String("prod-api"),
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ServiceImageWithPort{
+						Port:            aws.Uint16(80),
+						AdditionalPorts: []uint16{9090},
+					},
+					RoutingRule: RoutingRule{
+						Path: aws.String("prod-api"),
+						AdditionalRules: []AdditionalRoutingRule{
+							{
+								Path:       aws.String("api/metrics"),
+								TargetPort: aws.Uint16(9090),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -498,6 +764,325 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 	}
 }
 
+func TestValidateRoutingRules(t *testing.T) {
+	testCases := map[string]struct {
+		inImage ServiceImageWithPort
+		inRule  RoutingRule
+
+		wantedErr error
+	}{
+		"no additional rules": {
+			inImage: ServiceImageWithPort{Port: aws.Uint16(8080)},
+			inRule:  RoutingRule{Path: aws.String("/")},
+		},
+		"additional rules routed to exposed ports": {
+			inImage: ServiceImageWithPort{Port: aws.Uint16(8080), AdditionalPorts: []uint16{9090}},
+			inRule: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{Path: aws.String("api/metrics"), TargetPort: aws.Uint16(9090)},
+					{Path: aws.String("api/admin")},
+				},
+			},
+		},
+		"duplicate ports": {
+			inImage: ServiceImageWithPort{Port: aws.Uint16(8080), AdditionalPorts: []uint16{9090, 8080}},
+			inRule:  RoutingRule{Path: aws.String("api")},
+
+			wantedErr: errors.New("port 8080 is exposed more than once"),
+		},
+		"duplicate paths": {
+			inImage: ServiceImageWithPort{Port: aws.Uint16(8080), AdditionalPorts: []uint16{9090}},
+			inRule: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{Path: aws.String("/api/"), TargetPort: aws.Uint16(9090)},
+				},
+			},
+
+			wantedErr: errors.New("path /api/ is routed more than once"),
+		},
+		"additional rule for the root path": {
+			inImage: ServiceImageWithPort{Port: aws.Uint16(8080), AdditionalPorts: []uint16{9090}},
+			inRule: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{Path: aws.String("/"), TargetPort: aws.Uint16(9090)},
+				},
+			},
+
+			wantedErr: errors.New("additional routing rules must route a path other than the root path"),
+		},
+		"target port not exposed": {
+			inImage: ServiceImageWithPort{Port: aws.Uint16(8080)},
+			inRule: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{Path: aws.String("api/metrics"), TargetPort: aws.Uint16(9090)},
+				},
+			},
+
+			wantedErr: errors.New("target port 9090 of the routing rule for path api/metrics is not exposed by the container"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateRoutingRules(tc.inImage, tc.inRule)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateHealthCheckDelays(t *testing.T) {
+	testCases := map[string]struct {
+		inRule RoutingRule
+
+		wantedErr error
+	}{
+		"no delays": {},
+		"delays within the limits": {
+			inRule: RoutingRule{
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						GracePeriod: durationp(time.Hour),
+					},
+				},
+				DeregistrationDelay: durationp(0),
+			},
+		},
+		"grace period out of range": {
+			inRule: RoutingRule{
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						GracePeriod: durationp(2 * time.Hour),
+					},
+				},
+			},
+
+			wantedErr: errors.New("http.healthcheck.grace_period 2h0m0s must be between 0s and 3600s"),
+		},
+		"negative deregistration delay": {
+			inRule: RoutingRule{
+				DeregistrationDelay: durationp(-30 * time.Second),
+			},
+
+			wantedErr: errors.New("http.deregistration_delay -30s must be between 0s and 3600s"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateHealthCheckDelays(tc.inRule)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAliases(t *testing.T) {
+	testCases := map[string]struct {
+		inRule      RoutingRule
+		inAppDomain string
+
+		wantedErr error
+	}{
+		"no aliases": {},
+		"application without a domain": {
+			inRule: RoutingRule{
+				Alias: Alias{"api.example.com"},
+			},
+
+			wantedErr: errors.New("http.alias requires an application with a domain name, create one with `copilot app init --domain`"),
+		},
+		"alias outside of the application's domain": {
+			inRule: RoutingRule{
+				Alias: Alias{"api.example.com"},
+			},
+			inAppDomain: "my-app.example.com",
+
+			wantedErr: errors.New("alias api.example.com must be my-app.example.com or a subdomain of it"),
+		},
+		"alias specified more than once": {
+			inRule: RoutingRule{
+				Alias: Alias{"api.my-app.example.com", "API.my-app.example.com."},
+			},
+			inAppDomain: "my-app.example.com",
+
+			wantedErr: errors.New("alias API.my-app.example.com. is specified more than once"),
+		},
+		"too many aliases": {
+			inRule: RoutingRule{
+				Alias: Alias{"a.my-app.example.com", "b.my-app.example.com", "c.my-app.example.com", "d.my-app.example.com", "e.my-app.example.com"},
+			},
+			inAppDomain: "my-app.example.com",
+
+			wantedErr: errors.New("http.alias can have at most 4 domain names"),
+		},
+		"too many aliases with additional rules": {
+			inRule: RoutingRule{
+				Alias: Alias{"a.my-app.example.com", "b.my-app.example.com", "c.my-app.example.com"},
+				AdditionalRules: []AdditionalRoutingRule{
+					{Path: aws.String("admin")},
+				},
+			},
+			inAppDomain: "my-app.example.com",
+
+			wantedErr: errors.New("http.alias can have at most 2 domain names when the service has additional routing rules"),
+		},
+		"valid aliases": {
+			inRule: RoutingRule{
+				Alias: Alias{"my-app.example.com", "api.my-app.example.com", "api.test.my-app.example.com"},
+			},
+			inAppDomain: "my-app.example.com",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAliases(tc.inRule, tc.inAppDomain)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateImportedCertAliases(t *testing.T) {
+	testCases := map[string]struct {
+		inRule RoutingRule
+
+		wantedErr error
+	}{
+		"no aliases": {},
+		"aliases outside of an application's domain": {
+			inRule: RoutingRule{
+				Alias: Alias{"example.com", "api.example.org"},
+			},
+		},
+		"alias specified more than once": {
+			inRule: RoutingRule{
+				Alias: Alias{"api.example.com", "API.example.com."},
+			},
+
+			wantedErr: errors.New("alias API.example.com. is specified more than once"),
+		},
+		"too many aliases": {
+			inRule: RoutingRule{
+				Alias: Alias{"a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com"},
+			},
+
+			wantedErr: errors.New("http.alias can have at most 4 domain names"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateImportedCertAliases(tc.inRule)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRoutingRule_AdditionalRoutingRuleOpts(t *testing.T) {
+	// GIVEN
+	var rule RoutingRule
+	err := yaml.Unmarshal([]byte(`path: api
+additional_rules:
+  - path: /api/metrics
+    target_port: 9090
+    healthcheck: /metrics
+  - path: api/admin
+`), &rule)
+	require.NoError(t, err)
+
+	// WHEN
+	opts := rule.AdditionalRoutingRuleOpts(8080)
+
+	// THEN
+	require.Equal(t, []string{"api/metrics", "api/admin"}, rule.AdditionalRulePaths())
+	require.Equal(t, []template.AdditionalRoutingRuleOpts{
+		{
+			TargetPort: 9090,
+			HTTPHealthCheck: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/metrics",
+			},
+		},
+		{
+			TargetPort: 8080,
+			HTTPHealthCheck: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+			},
+		},
+	}, opts)
+}
+
+func TestRoutingRule_ProtocolVersionOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedVersion string
+		wantedGRPC    bool
+		wantedErr     error
+	}{
+		"defaults to the load balancer's protocol version": {},
+		"grpc": {
+			in: `version: grpc
+healthcheck:
+  success_codes: 0-99`,
+
+			wantedVersion: "GRPC",
+			wantedGRPC:    true,
+		},
+		"case insensitive": {
+			in: "version: HTTP2",
+
+			wantedVersion: "HTTP2",
+		},
+		"invalid version": {
+			in: "version: http3",
+
+			wantedErr: errors.New("http.version http3 must be one of grpc, http2 or http1"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var rule RoutingRule
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &rule))
+
+			// WHEN
+			version, err := rule.ProtocolVersionOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVersion, version)
+			require.Equal(t, tc.wantedGRPC, rule.IsGRPC())
+		})
+	}
+}
+
 func TestLoadBalancedWebService_BuildRequired(t *testing.T) {
 	testCases := map[string]struct {
 		image   Image
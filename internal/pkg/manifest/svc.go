@@ -5,7 +5,9 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +24,24 @@ const (
 	BackendServiceType = "Backend Service"
 )
 
+const (
+	capacityProviderFargate     = "FARGATE"
+	capacityProviderFargateSpot = "FARGATE_SPOT"
+)
+
+const (
+	// DeploymentRollingDefault replaces the tasks of a service while keeping all of them healthy.
+	DeploymentRollingDefault = "default"
+	// DeploymentRollingRecreate stops the tasks of a service before starting the new ones.
+	DeploymentRollingRecreate = "recreate"
+)
+
+// DeploymentRollingStrategies are the supported values of "deployment.rolling".
+var DeploymentRollingStrategies = []string{
+	DeploymentRollingDefault,
+	DeploymentRollingRecreate,
+}
+
 // ServiceTypes are the supported service manifest types.
 var ServiceTypes = []string{
 	LoadBalancedWebServiceType,
@@ -51,31 +71,99 @@ func (r Range) Parse() (min int, max int, err error) {
 
 // ServiceImageWithPort represents a container image with an exposed port.
 type ServiceImageWithPort struct {
-	Image `yaml:",inline"`
-	Port  *uint16 `yaml:"port"`
+	Image           `yaml:",inline"`
+	Port            *uint16  `yaml:"port"`
+	AdditionalPorts []uint16 `yaml:"additional_ports"` // Other ports exposed by the container, such as a metrics port.
+	Retention       *int     `yaml:"retention"`        // Number of most recent tagged images to keep in the ECR repository.
+}
+
+// overrideTransformer overrides a slice or the task count only if the environment sets it.
+// Otherwise, merging with mergo.WithOverwriteWithEmptyValue would reset the slice for every environment with overrides.
+// The task count is replaced as a whole so that an environment can, for example, opt out of Fargate Spot.
+// The trigger of a job is replaced as a whole so that an environment can switch between a schedule and an event.
+// Variables are replaced per name since mergo doesn't override the struct values of a map.
+// Maps of strings, such as docker labels and service tags, are merged per key into a copy as well,
+// so that overriding them in an environment doesn't modify the maps of the other environments.
+type overrideTransformer struct{}
+
+// Transformer implements the mergo.Transformers interface.
+func (t overrideTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ == reflect.TypeOf(map[string]Variable{}) || typ == reflect.TypeOf(map[string]string{}) {
+		return func(dst, src reflect.Value) error {
+			if src.IsNil() {
+				return nil
+			}
+			// Copy the map so that the variables of the original manifest aren't modified.
+			merged := reflect.MakeMap(typ)
+			for _, m := range []reflect.Value{dst, src} {
+				for _, name := range m.MapKeys() {
+					merged.SetMapIndex(name, m.MapIndex(name))
+				}
+			}
+			dst.Set(merged)
+			return nil
+		}
+	}
+	// Observability is replaced as a whole so that overriding the tracing of an environment doesn't modify
+	// the value that the other environments point to.
+	if typ == reflect.TypeOf(Count{}) || typ == reflect.TypeOf(JobTriggerConfig{}) || typ == reflect.TypeOf(Observability{}) {
+		return func(dst, src reflect.Value) error {
+			if !src.IsZero() {
+				dst.Set(src)
+			}
+			return nil
+		}
+	}
+	if typ.Kind() != reflect.Slice {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		if !src.IsNil() {
+			dst.Set(src)
+		}
+		return nil
+	}
 }
 
 // Count is a custom type which supports unmarshaling yaml which
-// can either be of type int or type Autoscaling.
+// can either be of type int, type Autoscaling, or a Fargate Spot configuration.
 type Count struct {
-	Value       *int        // 0 is a valid value, so we want the default value to be nil.
-	Autoscaling Autoscaling // Mutually exclusive with Value.
+	Value             *int               // 0 is a valid value, so we want the default value to be nil.
+	Autoscaling       Autoscaling        // Mutually exclusive with Value.
+	Spot              *int               // Number of tasks to run on Fargate Spot. Mutually exclusive with Value and Autoscaling.
+	CapacityProviders *CapacityProviders // Split of the autoscaled tasks between Fargate and Fargate Spot.
+}
+
+// spotCount holds the Fargate Spot fields of the map form of Count.
+type spotCount struct {
+	Spot              *int               `yaml:"spot"`
+	CapacityProviders *CapacityProviders `yaml:"capacity_providers"`
+}
+
+// CapacityProviders represents how the tasks of a service are split between Fargate and Fargate Spot.
+type CapacityProviders struct {
+	SpotWeight   *int `yaml:"spot_weight"`    // Relative weight of Fargate Spot against on-demand Fargate, which has a weight of 1.
+	OnDemandBase *int `yaml:"on_demand_base"` // Number of tasks to run on on-demand Fargate before splitting the rest.
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Count
 // struct, allowing it to perform more complex unmarshaling behavior.
 // This method implements the yaml.Unmarshaler (v2) interface.
 func (a *Count) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	if err := unmarshal(&a.Autoscaling); err != nil {
-		switch err.(type) {
-		case *yaml.TypeError:
-			break
-		default:
-			return err
+	var spot spotCount
+	for _, out := range []interface{}{&a.Autoscaling, &spot} {
+		if err := unmarshal(out); err != nil {
+			switch err.(type) {
+			case *yaml.TypeError:
+				break
+			default:
+				return err
+			}
 		}
 	}
+	a.Spot, a.CapacityProviders = spot.Spot, spot.CapacityProviders
 
-	if !a.Autoscaling.IsEmpty() {
+	if !a.Autoscaling.IsEmpty() || a.Spot != nil || a.CapacityProviders != nil {
 		return nil
 	}
 
@@ -85,6 +173,54 @@ func (a *Count) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// CapacityProviderStrategy converts the service's Fargate Spot configuration into a format parsable
+// by the templates pkg. It returns nil if the tasks only run on on-demand Fargate.
+func (a *Count) CapacityProviderStrategy() ([]*template.CapacityProviderStrategyOpts, error) {
+	if a.Spot != nil {
+		if !a.Autoscaling.IsEmpty() {
+			return nil, errors.New(`"count.spot" cannot be specified with "count.range"`)
+		}
+		if a.CapacityProviders != nil {
+			return nil, errors.New(`"count.spot" cannot be specified with "count.capacity_providers"`)
+		}
+		return []*template.CapacityProviderStrategyOpts{
+			{
+				CapacityProvider: capacityProviderFargateSpot,
+				Weight:           aws.Int(1),
+			},
+		}, nil
+	}
+	if a.CapacityProviders == nil {
+		return nil, nil
+	}
+	if a.Autoscaling.Range == nil {
+		return nil, errors.New(`"count.capacity_providers" requires "count.range"`)
+	}
+	_, max, err := a.Autoscaling.Range.Parse()
+	if err != nil {
+		return nil, err
+	}
+	spotWeight, base := aws.IntValue(a.CapacityProviders.SpotWeight), aws.IntValue(a.CapacityProviders.OnDemandBase)
+	if spotWeight < 1 {
+		return nil, fmt.Errorf(`"count.capacity_providers.spot_weight" %d must be at least 1`, spotWeight)
+	}
+	// Target tracking scales the desired count between the range, so the base must fit in it.
+	if base < 0 || base > max {
+		return nil, fmt.Errorf(`"count.capacity_providers.on_demand_base" %d must be between 0 and the maximum task count %d`, base, max)
+	}
+	return []*template.CapacityProviderStrategyOpts{
+		{
+			CapacityProvider: capacityProviderFargate,
+			Base:             a.CapacityProviders.OnDemandBase,
+			Weight:           aws.Int(1),
+		},
+		{
+			CapacityProvider: capacityProviderFargateSpot,
+			Weight:           aws.Int(spotWeight),
+		},
+	}, nil
+}
+
 // Autoscaling represents the configurable options for Auto Scaling.
 type Autoscaling struct {
 	Range        *Range         `yaml:"range"`
@@ -130,6 +266,147 @@ func (a *Autoscaling) IsEmpty() bool {
 		a.Requests == nil && a.ResponseTime == nil
 }
 
+// NetworkConfig represents how a service is reached by the other services of its environment.
+type NetworkConfig struct {
+	Connect *bool `yaml:"connect"` // True registers the service in the environment's ECS Service Connect namespace.
+}
+
+// ConnectEnabled returns whether the service is reachable through ECS Service Connect.
+func (n NetworkConfig) ConnectEnabled() bool {
+	return aws.BoolValue(n.Connect)
+}
+
+// DeploymentConfig represents how the tasks of a service are replaced during a deployment.
+type DeploymentConfig struct {
+	Rolling           *string  `yaml:"rolling"`
+	MinHealthyPercent *int     `yaml:"min_healthy_percent"`
+	MaxPercent        *int     `yaml:"max_percent"`
+	RollbackAlarms    []string `yaml:"rollback_alarms"` // Names of CloudWatch alarms that roll back the deployment when in ALARM.
+}
+
+// Options converts the service's deployment configuration into a format parsable by the templates pkg.
+// It returns nil if the deployment configuration is empty so that the default rolling deployment is used.
+func (d *DeploymentConfig) Options() (*template.DeploymentConfigurationOpts, error) {
+	if d.IsEmpty() {
+		return nil, nil
+	}
+	opts := &template.DeploymentConfigurationOpts{
+		MinHealthyPercent: 100,
+		MaxPercent:        200,
+		RollbackAlarms:    d.RollbackAlarms,
+	}
+	switch rolling := aws.StringValue(d.Rolling); rolling {
+	case "", DeploymentRollingDefault:
+	case DeploymentRollingRecreate:
+		if d.MinHealthyPercent != nil || d.MaxPercent != nil {
+			return nil, errors.New(`"deployment.rolling" recreate cannot be specified with "deployment.min_healthy_percent" or "deployment.max_percent"`)
+		}
+		// Stop the running tasks first so that a singleton service never runs twice its capacity.
+		opts.MinHealthyPercent, opts.MaxPercent = 0, 100
+		return opts, nil
+	default:
+		return nil, fmt.Errorf(`invalid "deployment.rolling" value %s: must be one of %s`, rolling, strings.Join(DeploymentRollingStrategies, ", "))
+	}
+	if d.MinHealthyPercent != nil {
+		opts.MinHealthyPercent = aws.IntValue(d.MinHealthyPercent)
+	}
+	if d.MaxPercent != nil {
+		opts.MaxPercent = aws.IntValue(d.MaxPercent)
+	}
+	if opts.MinHealthyPercent < 0 || opts.MinHealthyPercent > 100 {
+		return nil, fmt.Errorf(`"deployment.min_healthy_percent" %d must be between 0 and 100`, opts.MinHealthyPercent)
+	}
+	if opts.MaxPercent < 100 {
+		return nil, fmt.Errorf(`"deployment.max_percent" %d must be at least 100`, opts.MaxPercent)
+	}
+	// ECS can neither stop nor start a task if both limits are equal.
+	if opts.MaxPercent == opts.MinHealthyPercent {
+		return nil, fmt.Errorf(`"deployment.max_percent" %d must be greater than "deployment.min_healthy_percent" %d`, opts.MaxPercent, opts.MinHealthyPercent)
+	}
+	return opts, nil
+}
+
+// IsEmpty returns whether DeploymentConfig is empty.
+func (d *DeploymentConfig) IsEmpty() bool {
+	return d.Rolling == nil && d.MinHealthyPercent == nil && d.MaxPercent == nil && len(d.RollbackAlarms) == 0
+}
+
+// AlarmsConfig holds the thresholds of the CloudWatch alarms that Copilot creates for a service.
+// An alarm is only created if its threshold is set.
+type AlarmsConfig struct {
+	CPU    *int `yaml:"cpu_percentage"`    // Average CPU utilization of the service, in percent of its reserved CPU.
+	Memory *int `yaml:"memory_percentage"` // Average memory utilization of the service, in percent of its reserved memory.
+}
+
+// WebAlarmsConfig holds the thresholds of the CloudWatch alarms of a Load Balanced Web Service,
+// which can also alarm on the requests that its target group serves.
+type WebAlarmsConfig struct {
+	AlarmsConfig `yaml:",inline"`
+	HTTP5xx      *int           `yaml:"http_5xx_percentage"` // Percentage of the requests that the tasks answer with a 5xx code.
+	P99Latency   *time.Duration `yaml:"p99_latency"`         // 99th percentile of the time the tasks take to answer a request.
+}
+
+// Options converts the thresholds of the service's alarms into a format parsable by the templates pkg.
+// It returns nil if no alarm is configured.
+func (a AlarmsConfig) Options() (*template.AlarmsOpts, error) {
+	if a.IsEmpty() {
+		return nil, nil
+	}
+	if err := validatePercentage("alarms.cpu_percentage", a.CPU); err != nil {
+		return nil, err
+	}
+	if err := validatePercentage("alarms.memory_percentage", a.Memory); err != nil {
+		return nil, err
+	}
+	return &template.AlarmsOpts{
+		CPU:    a.CPU,
+		Memory: a.Memory,
+	}, nil
+}
+
+// IsEmpty returns whether AlarmsConfig is empty.
+func (a AlarmsConfig) IsEmpty() bool {
+	return a.CPU == nil && a.Memory == nil
+}
+
+// Options converts the thresholds of the service's alarms into a format parsable by the templates pkg.
+// It returns nil if no alarm is configured.
+func (a WebAlarmsConfig) Options() (*template.AlarmsOpts, error) {
+	if a.IsEmpty() {
+		return nil, nil
+	}
+	opts, err := a.AlarmsConfig.Options()
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &template.AlarmsOpts{}
+	}
+	if err := validatePercentage("alarms.http_5xx_percentage", a.HTTP5xx); err != nil {
+		return nil, err
+	}
+	opts.HTTP5xx = a.HTTP5xx
+	if a.P99Latency != nil {
+		if *a.P99Latency <= 0 {
+			return nil, fmt.Errorf(`"alarms.p99_latency" %s must be greater than 0`, *a.P99Latency)
+		}
+		opts.P99Latency = aws.Float64(float64(*a.P99Latency) / float64(time.Second))
+	}
+	return opts, nil
+}
+
+// IsEmpty returns whether WebAlarmsConfig is empty.
+func (a WebAlarmsConfig) IsEmpty() bool {
+	return a.AlarmsConfig.IsEmpty() && a.HTTP5xx == nil && a.P99Latency == nil
+}
+
+func validatePercentage(field string, v *int) error {
+	if v != nil && (*v <= 0 || *v > 100) {
+		return fmt.Errorf(`"%s" %d must be between 1 and 100`, field, *v)
+	}
+	return nil
+}
+
 func durationp(v time.Duration) *time.Duration {
 	return &v
 }
@@ -138,3 +415,60 @@ func durationp(v time.Duration) *time.Duration {
 func ServiceDockerfileBuildRequired(svc interface{}) (bool, error) {
 	return dockerfileBuildRequired("service", svc)
 }
+
+// ServiceRollbackAlarms returns the names of the CloudWatch alarms that roll back the deployments of the service
+// to the environment.
+func ServiceRollbackAlarms(svc interface{}, env string) ([]string, error) {
+	switch t := svc.(type) {
+	case *LoadBalancedWebService:
+		mft, err := t.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		return mft.Deployment.RollbackAlarms, nil
+	case *BackendService:
+		mft, err := t.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		return mft.Deployment.RollbackAlarms, nil
+	}
+	return nil, nil
+}
+
+// ServiceExecEnabled returns true if the service, with the environment's overrides applied, lets commands run
+// in its containers with ECS Exec.
+func ServiceExecEnabled(svc interface{}, env string) (bool, error) {
+	switch t := svc.(type) {
+	case *LoadBalancedWebService:
+		mft, err := t.ApplyEnv(env)
+		if err != nil {
+			return false, fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		return aws.BoolValue(mft.Exec), nil
+	case *BackendService:
+		mft, err := t.ApplyEnv(env)
+		if err != nil {
+			return false, fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		return aws.BoolValue(mft.Exec), nil
+	}
+	return false, nil
+}
+
+// ServiceImageRetention returns the number of tagged images to keep in the service's ECR repository,
+// or 0 if the repository isn't managed by a lifecycle policy.
+func ServiceImageRetention(svc interface{}) (int, error) {
+	type manifest interface {
+		ImageRetention() *int
+	}
+	mf, ok := svc.(manifest)
+	if !ok || mf.ImageRetention() == nil {
+		return 0, nil
+	}
+	retention := aws.IntValue(mf.ImageRetention())
+	if retention < 1 {
+		return 0, fmt.Errorf("image.retention %d must keep at least one image", retention)
+	}
+	return retention, nil
+}
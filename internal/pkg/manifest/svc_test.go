@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -84,8 +85,8 @@ environments:
 							Count: Count{
 								Value: aws.Int(1),
 							},
-							Variables: map[string]string{
-								"LOG_LEVEL": "WARN",
+							Variables: map[string]Variable{
+								"LOG_LEVEL": {Value: aws.String("WARN")},
 							},
 							Secrets: map[string]string{
 								"DB_PASSWORD": "MYSQL_DB_PASSWORD",
@@ -95,7 +96,7 @@ environments:
 							Sidecars: map[string]*SidecarConfig{
 								"xray": {
 									Port:       aws.String("2000/udp"),
-									Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+									Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 									CredsParam: aws.String("some arn"),
 								},
 							},
@@ -197,6 +198,15 @@ type: 'OH NO'
 `,
 			wantedErr: &ErrInvalidWorkloadType{Type: "OH NO"},
 		},
+		"job without a schedule or an event": {
+			inContent: `
+name: report
+type: Scheduled Job
+image:
+  location: foo/bar
+`,
+			wantedErr: fmt.Errorf("unmarshal to scheduled job: %w", &ErrInvalidJobTrigger{Missing: true}),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -246,6 +256,33 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"With all tasks on Fargate Spot": {
+			inContent: []byte(`count:
+  spot: 2
+`),
+			wantedStruct: Count{
+				Spot: aws.Int(2),
+			},
+		},
+		"With auto scaling split between Fargate and Fargate Spot": {
+			inContent: []byte(`count:
+  range: 1-10
+  cpu_percentage: 70
+  capacity_providers:
+    spot_weight: 3
+    on_demand_base: 1
+`),
+			wantedStruct: Count{
+				Autoscaling: Autoscaling{
+					Range: &mockRange,
+					CPU:   aws.Int(70),
+				},
+				CapacityProviders: &CapacityProviders{
+					SpotWeight:   aws.Int(3),
+					OnDemandBase: aws.Int(1),
+				},
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`count: badNumber
 `),
@@ -267,6 +304,179 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.Autoscaling.Memory, b.Count.Autoscaling.Memory)
 				require.Equal(t, tc.wantedStruct.Autoscaling.Requests, b.Count.Autoscaling.Requests)
 				require.Equal(t, tc.wantedStruct.Autoscaling.ResponseTime, b.Count.Autoscaling.ResponseTime)
+				require.Equal(t, tc.wantedStruct.Spot, b.Count.Spot)
+				require.Equal(t, tc.wantedStruct.CapacityProviders, b.Count.CapacityProviders)
+			}
+		})
+	}
+}
+
+func TestCount_ApplyEnvWithZero(t *testing.T) {
+	zeroRange := Range("0-5")
+	testCases := map[string]struct {
+		inManifest string
+
+		wanted Count
+	}{
+		"zero overrides a task count": {
+			inManifest: `
+count: 2
+environments:
+  test:
+    count: 0`,
+			wanted: Count{
+				Value: aws.Int(0),
+			},
+		},
+		"zero overrides autoscaling": {
+			inManifest: `
+count:
+  range: 1-10
+  cpu_percentage: 70
+environments:
+  test:
+    count: 0`,
+			wanted: Count{
+				Value: aws.Int(0),
+			},
+		},
+		"zero is kept if the environment doesn't override it": {
+			inManifest: `
+count: 0
+environments:
+  test:
+    cpu: 512`,
+			wanted: Count{
+				Value: aws.Int(0),
+			},
+		},
+		"range overrides can scale from zero": {
+			inManifest: `
+count:
+  range: 1-10
+  cpu_percentage: 70
+environments:
+  test:
+    count:
+      range: 0-5
+      cpu_percentage: 70`,
+			wanted: Count{
+				Autoscaling: Autoscaling{
+					Range: &zeroRange,
+					CPU:   aws.Int(70),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		for _, typ := range []string{LoadBalancedWebServiceType, BackendServiceType} {
+			t.Run(fmt.Sprintf("%s/%s", name, typ), func(t *testing.T) {
+				mft, err := UnmarshalWorkload([]byte(fmt.Sprintf("name: api\ntype: %s\n%s", typ, tc.inManifest)))
+				require.NoError(t, err)
+
+				var got Count
+				switch v := mft.(type) {
+				case *LoadBalancedWebService:
+					out, err := v.ApplyEnv("test")
+					require.NoError(t, err)
+					got = out.Count
+				case *BackendService:
+					out, err := v.ApplyEnv("test")
+					require.NoError(t, err)
+					got = out.Count
+				}
+				require.Equal(t, tc.wanted, got)
+			})
+		}
+	}
+}
+
+func TestCount_CapacityProviderStrategy(t *testing.T) {
+	mockRange := Range("1-10")
+	testCases := map[string]struct {
+		in Count
+
+		wanted    []*template.CapacityProviderStrategyOpts
+		wantedErr error
+	}{
+		"returns nil if the tasks only run on Fargate": {
+			in: Count{
+				Value: aws.Int(1),
+			},
+		},
+		"places all tasks on Fargate Spot": {
+			in: Count{
+				Spot: aws.Int(2),
+			},
+			wanted: []*template.CapacityProviderStrategyOpts{
+				{
+					CapacityProvider: "FARGATE_SPOT",
+					Weight:           aws.Int(1),
+				},
+			},
+		},
+		"error if spot is specified with a range": {
+			in: Count{
+				Spot: aws.Int(2),
+				Autoscaling: Autoscaling{
+					Range: &mockRange,
+				},
+			},
+			wantedErr: errors.New(`"count.spot" cannot be specified with "count.range"`),
+		},
+		"error if capacity providers are specified without a range": {
+			in: Count{
+				CapacityProviders: &CapacityProviders{
+					SpotWeight: aws.Int(3),
+				},
+			},
+			wantedErr: errors.New(`"count.capacity_providers" requires "count.range"`),
+		},
+		"error if the on-demand base is greater than the maximum task count": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range: &mockRange,
+				},
+				CapacityProviders: &CapacityProviders{
+					SpotWeight:   aws.Int(3),
+					OnDemandBase: aws.Int(11),
+				},
+			},
+			wantedErr: errors.New(`"count.capacity_providers.on_demand_base" 11 must be between 0 and the maximum task count 10`),
+		},
+		"splits autoscaled tasks between Fargate and Fargate Spot": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range: &mockRange,
+					CPU:   aws.Int(70),
+				},
+				CapacityProviders: &CapacityProviders{
+					SpotWeight:   aws.Int(3),
+					OnDemandBase: aws.Int(1),
+				},
+			},
+			wanted: []*template.CapacityProviderStrategyOpts{
+				{
+					CapacityProvider: "FARGATE",
+					Base:             aws.Int(1),
+					Weight:           aws.Int(1),
+				},
+				{
+					CapacityProvider: "FARGATE_SPOT",
+					Weight:           aws.Int(3),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.CapacityProviderStrategy()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
 			}
 		})
 	}
@@ -377,6 +587,157 @@ func TestAutoscaling_Options(t *testing.T) {
 	}
 }
 
+func TestDeploymentConfig_Options(t *testing.T) {
+	testCases := map[string]struct {
+		in DeploymentConfig
+
+		wanted    *template.DeploymentConfigurationOpts
+		wantedErr error
+	}{
+		"nil if empty": {},
+		"defaults to rolling deployments that keep all tasks healthy": {
+			in: DeploymentConfig{
+				RollbackAlarms: []string{"high-5xx"},
+			},
+			wanted: &template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				RollbackAlarms:    []string{"high-5xx"},
+			},
+		},
+		"stops the running tasks first with recreate": {
+			in: DeploymentConfig{
+				Rolling: aws.String("recreate"),
+			},
+			wanted: &template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 0,
+				MaxPercent:        100,
+			},
+		},
+		"uses the percentages": {
+			in: DeploymentConfig{
+				Rolling:           aws.String("default"),
+				MinHealthyPercent: aws.Int(50),
+				MaxPercent:        aws.Int(150),
+			},
+			wanted: &template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 50,
+				MaxPercent:        150,
+			},
+		},
+		"error if the rolling strategy is unknown": {
+			in: DeploymentConfig{
+				Rolling: aws.String("blue-green"),
+			},
+			wantedErr: errors.New(`invalid "deployment.rolling" value blue-green: must be one of default, recreate`),
+		},
+		"error if recreate is specified with a percentage": {
+			in: DeploymentConfig{
+				Rolling:    aws.String("recreate"),
+				MaxPercent: aws.Int(200),
+			},
+			wantedErr: errors.New(`"deployment.rolling" recreate cannot be specified with "deployment.min_healthy_percent" or "deployment.max_percent"`),
+		},
+		"error if the minimum healthy percent is out of range": {
+			in: DeploymentConfig{
+				MinHealthyPercent: aws.Int(-1),
+			},
+			wantedErr: errors.New(`"deployment.min_healthy_percent" -1 must be between 0 and 100`),
+		},
+		"error if the maximum percent is less than 100": {
+			in: DeploymentConfig{
+				MaxPercent: aws.Int(50),
+			},
+			wantedErr: errors.New(`"deployment.max_percent" 50 must be at least 100`),
+		},
+		"error if the percentages leave no room to replace tasks": {
+			in: DeploymentConfig{
+				MaxPercent: aws.Int(100),
+			},
+			wantedErr: errors.New(`"deployment.max_percent" 100 must be greater than "deployment.min_healthy_percent" 100`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Options()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestWebAlarmsConfig_Options(t *testing.T) {
+	latency := 500 * time.Millisecond
+	zero := time.Duration(0)
+	testCases := map[string]struct {
+		in WebAlarmsConfig
+
+		wanted    *template.AlarmsOpts
+		wantedErr error
+	}{
+		"no alarms": {},
+		"utilization alarms": {
+			in: WebAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					CPU:    aws.Int(70),
+					Memory: aws.Int(80),
+				},
+			},
+			wanted: &template.AlarmsOpts{
+				CPU:    aws.Int(70),
+				Memory: aws.Int(80),
+			},
+		},
+		"request alarms with the latency in seconds": {
+			in: WebAlarmsConfig{
+				HTTP5xx:    aws.Int(5),
+				P99Latency: &latency,
+			},
+			wanted: &template.AlarmsOpts{
+				HTTP5xx:    aws.Int(5),
+				P99Latency: aws.Float64(0.5),
+			},
+		},
+		"error if a utilization percentage is out of range": {
+			in: WebAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					Memory: aws.Int(101),
+				},
+			},
+			wantedErr: errors.New(`"alarms.memory_percentage" 101 must be between 1 and 100`),
+		},
+		"error if the 5xx percentage is out of range": {
+			in: WebAlarmsConfig{
+				HTTP5xx: aws.Int(0),
+			},
+			wantedErr: errors.New(`"alarms.http_5xx_percentage" 0 must be between 1 and 100`),
+		},
+		"error if the latency is not positive": {
+			in: WebAlarmsConfig{
+				P99Latency: &zero,
+			},
+			wantedErr: errors.New(`"alarms.p99_latency" 0s must be greater than 0`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Options()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func Test_ServiceDockerfileBuildRequired(t *testing.T) {
 	testCases := map[string]struct {
 		svc interface{}
@@ -434,3 +795,155 @@ func Test_ServiceDockerfileBuildRequired(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceImageRetention(t *testing.T) {
+	testCases := map[string]struct {
+		svc interface{}
+
+		wanted    int
+		wantedErr error
+	}{
+		"no retention for workloads without images": {
+			svc: struct{}{},
+		},
+		"no retention if not set": {
+			svc: &LoadBalancedWebService{},
+		},
+		"error if the retention doesn't keep any image": {
+			svc: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: imageWithPortAndHealthcheck{
+						ServiceImageWithPort: ServiceImageWithPort{
+							Retention: aws.Int(0),
+						},
+					},
+				},
+			},
+			wantedErr: fmt.Errorf("image.retention 0 must keep at least one image"),
+		},
+		"success": {
+			svc: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ServiceImageWithPort{
+						Retention: aws.Int(10),
+					},
+				},
+			},
+			wanted: 10,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ServiceImageRetention(tc.svc)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestServiceExecEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		svc interface{}
+
+		wanted bool
+	}{
+		"disabled for jobs": {
+			svc: &ScheduledJob{},
+		},
+		"disabled by default": {
+			svc: &BackendService{},
+		},
+		"enabled only in the environment that overrides it": {
+			svc: &LoadBalancedWebService{
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						Exec: aws.Bool(true),
+					},
+				},
+			},
+			wanted: true,
+		},
+		"inherits exec if the environment doesn't override it": {
+			svc: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					Exec: aws.Bool(true),
+				},
+				Environments: map[string]*BackendServiceConfig{
+					"prod": {
+						TaskConfig: TaskConfig{
+							CPU: aws.Int(512),
+						},
+					},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ServiceExecEnabled(tc.svc, "prod")
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestServiceRollbackAlarms(t *testing.T) {
+	testCases := map[string]struct {
+		svc interface{}
+
+		wanted []string
+	}{
+		"no alarms for workloads without deployments": {
+			svc: &ScheduledJob{},
+		},
+		"uses the environment's alarms": {
+			svc: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Deployment: DeploymentConfig{
+						RollbackAlarms: []string{"high-5xx"},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						Deployment: DeploymentConfig{
+							RollbackAlarms: []string{"high-5xx", "high-latency"},
+						},
+					},
+				},
+			},
+			wanted: []string{"high-5xx", "high-latency"},
+		},
+		"inherits the alarms if the environment doesn't override them": {
+			svc: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					Deployment: DeploymentConfig{
+						RollbackAlarms: []string{"high-cpu"},
+					},
+				},
+				Environments: map[string]*BackendServiceConfig{
+					"prod": {
+						Deployment: DeploymentConfig{
+							Rolling: aws.String("recreate"),
+						},
+					},
+				},
+			},
+			wanted: []string{"high-cpu"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ServiceRollbackAlarms(tc.svc, "prod")
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
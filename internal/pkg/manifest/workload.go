@@ -8,11 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"gopkg.in/yaml.v3"
 )
 
@@ -22,26 +26,93 @@ const (
 	defaultFluentbitImage = "amazon/aws-for-fluent-bit:latest"
 )
 
+// Values of the "observability.tracing" field.
+const (
+	TracingAWSXRay = "awsxray" // Runs the AWS X-Ray daemon as a sidecar of the service.
+	TracingNone    = "none"    // Disables tracing, for example in an environment override.
+)
+
+// XRaySidecarName is the name of the X-Ray daemon sidecar that Copilot adds to services that enable tracing.
+const XRaySidecarName = "xray"
+
+// firelensContainerName is the name of the sidecar that Copilot adds to route the logs of the main container with Firelens.
+const firelensContainerName = "firelens_log_router"
+
+// Conditions that a container must reach before the containers that depend on it start.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ecs-taskdefinition-containerdependency.html
+const (
+	ContainerConditionStart    = "START"
+	ContainerConditionComplete = "COMPLETE"
+	ContainerConditionSuccess  = "SUCCESS"
+	ContainerConditionHealthy  = "HEALTHY"
+)
+
+// xrayDaemonImageName is the repository name of the X-Ray daemon image, used to detect sidecars that run it.
+const xrayDaemonImageName = "aws-xray-daemon"
+
 var (
-	errUnmarshalBuildOpts = errors.New("can't unmarshal build field into string or compose-style map")
-	errUnmarshalCountOpts = errors.New(`unmarshal "count" field to an integer or autoscaling configuration`)
+	errUnmarshalBuildOpts    = errors.New("can't unmarshal build field into string or compose-style map")
+	errUnmarshalCountOpts    = errors.New(`unmarshal "count" field to an integer or autoscaling configuration`)
+	errUnmarshalSidecarImage = errors.New(`can't unmarshal sidecar "image" field into string or map with "build"`)
+	errUnmarshalVariable     = errors.New(`can't unmarshal variable into string or map with "from_cfn" or "from_env_output"`)
+	errUnmarshalEventPattern = errors.New(`can't unmarshal "pattern" field into a non-empty map or a string holding one in JSON or YAML`)
 )
 
 var dockerfileDefaultName = "Dockerfile"
 
+// warnedDeprecatedKeys holds the deprecated manifest keys that were already warned about,
+// so that each warning is only printed once even if the key appears in multiple sections.
+var warnedDeprecatedKeys sync.Map
+
+// validUlimitNames are the names of the ulimits that can be set on a container.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ecs-taskdefinition-ulimit.html
+var validUlimitNames = []string{"core", "cpu", "data", "fsize", "locks", "memlock", "msgqueue", "nice",
+	"nofile", "nproc", "rss", "rtprio", "rttime", "sigpending", "stack"}
+
+// validContainerConditions are the conditions of the "depends_on" field of a container.
+var validContainerConditions = []string{ContainerConditionStart, ContainerConditionComplete, ContainerConditionSuccess, ContainerConditionHealthy}
+
+// validLogRetentionDays are the numbers of days that CloudWatch Logs can retain log events for.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-logs-loggroup.html#cfn-logs-loggroup-retentionindays
+var validLogRetentionDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653}
+
+// reservedDockerLabelPrefixes are the prefixes of the docker labels that ECS sets on the containers of a task,
+// and of the labels reserved for Copilot.
+var reservedDockerLabelPrefixes = []string{"com.amazonaws.ecs.", "copilot-"}
+
+// reservedServiceTagPrefixes are the prefixes of the tags that AWS and Copilot set on the resources of a stack,
+// such as "copilot-application".
+var reservedServiceTagPrefixes = []string{"aws:", "copilot-"}
+
+// validMessagingName matches the names of the SNS topics and SQS queues of a workload,
+// which are converted into CloudFormation logical IDs by replacing the hyphens.
+var validMessagingName = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// runtimePlatforms maps the platforms that a task can run on to its operating system family and CPU architecture.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ecs-taskdefinition-runtimeplatform.html
+var runtimePlatforms = map[string]template.RuntimePlatformOpts{
+	"linux/amd64":   {OS: "LINUX", Arch: "X86_64"},
+	"linux/arm64":   {OS: "LINUX", Arch: "ARM64"},
+	"windows/amd64": {OS: "WINDOWS_SERVER_2019_CORE", Arch: "X86_64"},
+}
+
 // WorkloadTypes holds all workload manifest types.
 var WorkloadTypes = append(ServiceTypes, JobTypes...)
 
 // Workload holds the basic data that every workload manifest file needs to have.
 type Workload struct {
-	Name *string `yaml:"name"`
-	Type *string `yaml:"type"` // must be one of the supported manifest types.
+	Name      *string  `yaml:"name"`
+	Type      *string  `yaml:"type"`       // must be one of the supported manifest types.
+	DependsOn []string `yaml:"depends_on"` // Services and jobs that must be deployed before this workload.
 }
 
 // Image represents the workload's container image.
 type Image struct {
-	Build    BuildArgsOrString `yaml:"build"`    // Build an image from a Dockerfile.
-	Location *string           `yaml:"location"` // Use an existing image instead.
+	Build              BuildArgsOrString `yaml:"build"`       // Build an image from a Dockerfile.
+	Location           *string           `yaml:"location"`    // Use an existing image instead.
+	DockerLabels       map[string]string `yaml:"labels,flow"` // Docker labels of the container.
+	DependsOn          map[string]string `yaml:"depends_on"`  // Conditions that other containers must reach before the container starts, keyed by container name.
+	ContainerResources `yaml:",inline"`
 }
 
 // GetLocation returns the location of the image.
@@ -178,13 +249,99 @@ func (b *DockerBuildArgs) isEmpty() bool {
 	return false
 }
 
-// Logging holds configuration for Firelens to route your logs.
+// Logging holds configuration for Firelens to route your logs, and for the CloudWatch log group of the workload.
 type Logging struct {
 	Image          *string           `yaml:"image"`
 	Destination    map[string]string `yaml:"destination,flow"`
-	EnableMetadata *bool             `yaml:"enableMetadata"`
-	SecretOptions  map[string]string `yaml:"secretOptions"`
-	ConfigFile     *string           `yaml:"configFilePath"`
+	EnableMetadata *bool             `yaml:"enable_metadata"`
+	SecretOptions  map[string]string `yaml:"secret_options"`
+	ConfigFile     *string           `yaml:"config_file_path"`
+	Retention      *int              `yaml:"retention"` // Number of days to retain the log events in the log group.
+	LogGroup       *string           `yaml:"log_group"` // Name of the log group, overrides the default /copilot/{app}-{env}-{name}.
+}
+
+// deprecatedLogging holds the deprecated camelCase keys of the Logging struct.
+type deprecatedLogging struct {
+	DeprecatedEnableMetadata *bool             `yaml:"enableMetadata"`
+	DeprecatedSecretOptions  map[string]string `yaml:"secretOptions"`
+	DeprecatedConfigFile     *string           `yaml:"configFilePath"`
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Logging
+// struct, allowing it to also read the deprecated camelCase keys.
+// If both spellings of a key are set, the snake_case one takes precedence.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (lc *Logging) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type logging Logging // Alias the type to avoid calling UnmarshalYAML recursively.
+	var config struct {
+		logging           `yaml:",inline"`
+		deprecatedLogging `yaml:",inline"`
+	}
+	if err := unmarshal(&config); err != nil {
+		return err
+	}
+	*lc = Logging(config.logging)
+	if config.DeprecatedEnableMetadata != nil {
+		warnDeprecatedKey("logging.enableMetadata", "logging.enable_metadata")
+		if lc.EnableMetadata == nil {
+			lc.EnableMetadata = config.DeprecatedEnableMetadata
+		}
+	}
+	if config.DeprecatedSecretOptions != nil {
+		warnDeprecatedKey("logging.secretOptions", "logging.secret_options")
+		if lc.SecretOptions == nil {
+			lc.SecretOptions = config.DeprecatedSecretOptions
+		}
+	}
+	if config.DeprecatedConfigFile != nil {
+		warnDeprecatedKey("logging.configFilePath", "logging.config_file_path")
+		if lc.ConfigFile == nil {
+			lc.ConfigFile = config.DeprecatedConfigFile
+		}
+	}
+	return nil
+}
+
+// LogRetention returns the number of days to retain the log events of the workload, or the default if it's not set.
+func (lc *Logging) LogRetention() int {
+	if lc == nil || lc.Retention == nil {
+		return LogRetentionInDays
+	}
+	return *lc.Retention
+}
+
+// LogGroupName returns the name of the log group of the workload if it's overridden, otherwise nil.
+func (lc *Logging) LogGroupName() *string {
+	if lc == nil {
+		return nil
+	}
+	return lc.LogGroup
+}
+
+// ValidateLogging returns an error if the log retention is not a number of days supported by CloudWatch Logs.
+func ValidateLogging(lc *Logging) error {
+	if lc == nil || lc.Retention == nil {
+		return nil
+	}
+	for _, days := range validLogRetentionDays {
+		if *lc.Retention == days {
+			return nil
+		}
+	}
+	allowed := make([]string, len(validLogRetentionDays))
+	for i, days := range validLogRetentionDays {
+		allowed[i] = strconv.Itoa(days)
+	}
+	return fmt.Errorf("logging.retention %d must be one of %s", *lc.Retention, strings.Join(allowed, ", "))
+}
+
+// routesWithFirelens returns true if the logs are routed with a Firelens sidecar rather than the awslogs driver.
+// Only setting the retention or the name of the log group keeps the awslogs driver.
+func (lc *Logging) routesWithFirelens() bool {
+	if lc.Image != nil || lc.Destination != nil || lc.EnableMetadata != nil || lc.SecretOptions != nil || lc.ConfigFile != nil {
+		return true
+	}
+	return lc.Retention == nil && lc.LogGroup == nil
 }
 
 func (lc *Logging) logConfigOpts() *template.LogConfigOpts {
@@ -229,21 +386,95 @@ func (s *Sidecar) Options() ([]*template.SidecarOpts, error) {
 			return nil, err
 		}
 		sidecars = append(sidecars, &template.SidecarOpts{
-			Name:       aws.String(name),
-			Image:      config.Image,
-			Port:       port,
-			Protocol:   protocol,
-			CredsParam: config.CredsParam,
+			Name:         aws.String(name),
+			Image:        config.Image.Location,
+			Port:         port,
+			Protocol:     protocol,
+			CredsParam:   config.CredsParam,
+			Essential:    config.Essential,
+			Resources:    config.ContainerResources.Options(),
+			DockerLabels: config.DockerLabels,
+			DependsOn:    ContainerDependencyOpts(config.DependsOn),
 		})
 	}
 	return sidecars, nil
 }
 
+// SidecarsBuildRequired returns true if any sidecar image is built from a Dockerfile.
+func (s *Sidecar) SidecarsBuildRequired() bool {
+	for _, config := range s.Sidecars {
+		if config != nil && config.Image.RequiresBuild() {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildConfigs returns the docker build arguments of the sidecars that are built from a Dockerfile, keyed by sidecar name.
+// Sidecars that use an existing image are not included.
+func (s *Sidecar) BuildConfigs(rootDirectory string) map[string]*DockerBuildArgs {
+	configs := make(map[string]*DockerBuildArgs)
+	for name, config := range s.Sidecars {
+		if config == nil || !config.Image.RequiresBuild() {
+			continue
+		}
+		img := Image{
+			Build: config.Image.Build,
+		}
+		configs[name] = img.BuildConfig(rootDirectory)
+	}
+	return configs
+}
+
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
-	Port       *string `yaml:"port"`
-	Image      *string `yaml:"image"`
-	CredsParam *string `yaml:"credentialsParameter"`
+	Port               *string           `yaml:"port"`
+	Image              SidecarImage      `yaml:"image"`
+	CredsParam         *string           `yaml:"credentialsParameter"`
+	Essential          *bool             `yaml:"essential"`   // Stops the task if the container exits, defaults to true.
+	DockerLabels       map[string]string `yaml:"labels,flow"` // Docker labels of the container.
+	DependsOn          map[string]string `yaml:"depends_on"`  // Conditions that other containers must reach before the sidecar starts, keyed by container name.
+	ContainerResources `yaml:",inline"`
+}
+
+// SidecarImage is a custom type which supports unmarshaling yaml which
+// can either be the location of an existing image or a map with a "build" field.
+type SidecarImage struct {
+	Location *string
+	Build    BuildArgsOrString
+}
+
+// sidecarImageBuild holds the map form of the SidecarImage.
+type sidecarImageBuild struct {
+	Build BuildArgsOrString `yaml:"build"`
+}
+
+// RequiresBuild returns true if the sidecar image is built from a Dockerfile.
+func (i *SidecarImage) RequiresBuild() bool {
+	return i.Location == nil && !i.Build.isEmpty()
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the SidecarImage
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (i *SidecarImage) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var location string
+	if err := unmarshal(&location); err == nil {
+		i.Location = aws.String(location)
+		i.Build = BuildArgsOrString{}
+		return nil
+	}
+
+	var image sidecarImageBuild
+	if err := unmarshal(&image); err != nil {
+		return errUnmarshalSidecarImage
+	}
+	if image.Build.isEmpty() {
+		return errUnmarshalSidecarImage
+	}
+	i.Location = nil
+	i.Build = image.Build
+	return nil
 }
 
 // Valid sidecar portMapping example: 2000/udp, or 2000 (default to be tcp).
@@ -265,11 +496,530 @@ func parsePortMapping(s *string) (port *string, protocol *string, err error) {
 
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
-	CPU       *int              `yaml:"cpu"`
-	Memory    *int              `yaml:"memory"`
-	Count     Count             `yaml:"count"`
-	Variables map[string]string `yaml:"variables"`
-	Secrets   map[string]string `yaml:"secrets"`
+	CPU       *int                `yaml:"cpu"`
+	Memory    *int                `yaml:"memory"`
+	Count     Count               `yaml:"count"`
+	Variables map[string]Variable `yaml:"variables"`
+	Secrets   map[string]string   `yaml:"secrets"`
+	Platform  *string             `yaml:"platform"` // Operating system and CPU architecture, like "linux/arm64", of the task.
+
+	// AllowEnvOverride allows an environment variable of the main container to be set by more than one source,
+	// like both "variables" and an addons output.
+	AllowEnvOverride *bool `yaml:"allow_env_override"`
+}
+
+// Variable is a custom type which supports unmarshaling yaml which
+// can either be of type string or a reference to a value resolved by CloudFormation when the stack is deployed.
+type Variable struct {
+	Value *string
+	From  VariableFrom
+}
+
+// VariableFrom holds the CloudFormation value that a variable is set to, only one of the fields can be set.
+type VariableFrom struct {
+	CFNExport *string `yaml:"from_cfn"`        // Name of a CloudFormation export, in the environment's account and region.
+	EnvOutput *string `yaml:"from_env_output"` // Name of an output of the environment stack, such as "PrivateSubnets".
+}
+
+func (f *VariableFrom) isEmpty() bool {
+	return f.CFNExport == nil && f.EnvOutput == nil
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Variable
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (v *Variable) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&v.From); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !v.From.isEmpty() {
+		// Unmarshaled successfully to v.From, unset v.Value, and return.
+		v.Value = nil
+		return nil
+	}
+
+	if err := unmarshal(&v.Value); err != nil {
+		return errUnmarshalVariable
+	}
+	return nil
+}
+
+// ImagePlatform returns the platform to build the images of the task for, or an empty string to build them
+// for the platform of the docker daemon.
+func (t TaskConfig) ImagePlatform() string {
+	return aws.StringValue(t.Platform)
+}
+
+// RuntimePlatformOpts converts the platform into a format parsable by the templates pkg.
+func (t TaskConfig) RuntimePlatformOpts() *template.RuntimePlatformOpts {
+	if t.Platform == nil {
+		return nil
+	}
+	opts, ok := runtimePlatforms[*t.Platform]
+	if !ok {
+		return nil
+	}
+	return &opts
+}
+
+// ValidatePlatform returns an error if the platform is not one that the task can run on.
+func ValidatePlatform(platform *string) error {
+	if platform == nil {
+		return nil
+	}
+	if _, ok := runtimePlatforms[*platform]; ok {
+		return nil
+	}
+	allowed := make([]string, 0, len(runtimePlatforms))
+	for p := range runtimePlatforms {
+		allowed = append(allowed, p)
+	}
+	sort.Strings(allowed)
+	return fmt.Errorf("platform %s must be one of %s", *platform, strings.Join(allowed, ", "))
+}
+
+// Messaging represents the SNS topics that a workload publishes to and the SQS queues that it receives messages from.
+type Messaging struct {
+	Publish   []string       `yaml:"publish"` // Names of the SNS topics created with the workload, unique within the application.
+	Subscribe []Subscription `yaml:"subscribe"`
+}
+
+// Subscription represents an SQS queue of the workload that receives the messages published to an SNS topic.
+// A queue subscribed to multiple topics is listed once per topic.
+type Subscription struct {
+	Queue *string `yaml:"queue"` // Name of the queue, created with the workload.
+	Topic *string `yaml:"topic"` // Name of a topic published by the workload or by another workload of the application.
+}
+
+// IsEmpty returns true if the workload neither publishes to topics nor subscribes to them.
+func (m Messaging) IsEmpty() bool {
+	return len(m.Publish) == 0 && len(m.Subscribe) == 0
+}
+
+// PublishedTopics returns the names of the SNS topics that the workload publishes to in any of its environments, sorted.
+func PublishedTopics(wkld interface{}) []string {
+	var lists [][]string
+	switch t := wkld.(type) {
+	case *LoadBalancedWebService:
+		lists = append(lists, t.Publish)
+		for _, env := range t.Environments {
+			if env != nil {
+				lists = append(lists, env.Publish)
+			}
+		}
+	case *BackendService:
+		lists = append(lists, t.Publish)
+		for _, env := range t.Environments {
+			if env != nil {
+				lists = append(lists, env.Publish)
+			}
+		}
+	case *ScheduledJob:
+		lists = append(lists, t.Publish)
+		for _, env := range t.Environments {
+			if env != nil {
+				lists = append(lists, env.Publish)
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	var topics []string
+	for _, list := range lists {
+		for _, topic := range list {
+			if !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// WorkloadDependencies returns the names of the services and jobs that must be deployed before the workload.
+func WorkloadDependencies(wkld interface{}) []string {
+	switch t := wkld.(type) {
+	case *LoadBalancedWebService:
+		return t.DependsOn
+	case *BackendService:
+		return t.DependsOn
+	case *ScheduledJob:
+		return t.DependsOn
+	}
+	return nil
+}
+
+// ValidateMessaging returns an error if a topic or queue name can't be used in a CloudFormation logical ID,
+// if a topic is published more than once, or if a subscription is incomplete or repeated.
+func ValidateMessaging(m Messaging) error {
+	published := make(map[string]bool)
+	for _, topic := range m.Publish {
+		if !validMessagingName.MatchString(topic) {
+			return fmt.Errorf(`"publish" topic %q must only contain letters, numbers and hyphens`, topic)
+		}
+		if published[topic] {
+			return fmt.Errorf(`"publish" topic %q is listed more than once`, topic)
+		}
+		published[topic] = true
+	}
+	type queueTopic struct{ queue, topic string }
+	subscribed := make(map[queueTopic]bool)
+	for i, sub := range m.Subscribe {
+		if sub.Queue == nil || sub.Topic == nil {
+			return fmt.Errorf(`"subscribe[%d]" must set both "queue" and "topic"`, i)
+		}
+		if !validMessagingName.MatchString(*sub.Queue) {
+			return fmt.Errorf(`"subscribe[%d].queue" %q must only contain letters, numbers and hyphens`, i, *sub.Queue)
+		}
+		if !validMessagingName.MatchString(*sub.Topic) {
+			return fmt.Errorf(`"subscribe[%d].topic" %q must only contain letters, numbers and hyphens`, i, *sub.Topic)
+		}
+		key := queueTopic{queue: *sub.Queue, topic: *sub.Topic}
+		if subscribed[key] {
+			return fmt.Errorf(`queue %q is subscribed to topic %q more than once`, key.queue, key.topic)
+		}
+		subscribed[key] = true
+	}
+	return nil
+}
+
+// Observability represents the observability features of a service.
+type Observability struct {
+	Tracing *string `yaml:"tracing"` // Either "awsxray" or "none".
+}
+
+// TracingEnabled returns true if the requests of the service are traced with AWS X-Ray.
+func (o Observability) TracingEnabled() bool {
+	return aws.StringValue(o.Tracing) == TracingAWSXRay
+}
+
+// ValidateObservability returns an error if the tracing vendor isn't supported, or if tracing is enabled
+// while a sidecar already runs the X-Ray daemon, since Copilot adds its own.
+func ValidateObservability(o Observability, s Sidecar) error {
+	if o.Tracing == nil {
+		return nil
+	}
+	if tracing := *o.Tracing; tracing != TracingAWSXRay && tracing != TracingNone {
+		return fmt.Errorf(`"observability.tracing" %q must be one of %q or %q`, tracing, TracingAWSXRay, TracingNone)
+	}
+	if !o.TracingEnabled() {
+		return nil
+	}
+	for name, config := range s.Sidecars {
+		if name == XRaySidecarName || (config != nil && strings.Contains(aws.StringValue(config.Image.Location), xrayDaemonImageName)) {
+			return fmt.Errorf(`sidecar %q conflicts with the X-Ray daemon sidecar added by "observability.tracing", remove the sidecar or the "tracing" field`, name)
+		}
+	}
+	return nil
+}
+
+// ValidateDockerLabels returns an error if a docker label of the main container or of a sidecar
+// starts with a prefix reserved for the labels that ECS and Copilot set on the containers.
+func ValidateDockerLabels(main map[string]string, s Sidecar) error {
+	if err := validateReservedPrefixes("image.labels", main, reservedDockerLabelPrefixes); err != nil {
+		return err
+	}
+	for name, config := range s.Sidecars {
+		if config == nil {
+			continue
+		}
+		if err := validateReservedPrefixes(fmt.Sprintf("sidecars.%s.labels", name), config.DockerLabels, reservedDockerLabelPrefixes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateServiceTags returns an error if a tag of the ECS service starts with a prefix reserved for
+// the tags that AWS and Copilot set on the resources of the stack.
+func ValidateServiceTags(tags map[string]string) error {
+	return validateReservedPrefixes("service_tags", tags, reservedServiceTagPrefixes)
+}
+
+func validateReservedPrefixes(field string, keys map[string]string, prefixes []string) error {
+	for key := range keys {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(key), prefix) {
+				return fmt.Errorf(`%q in "%s" cannot start with the reserved prefix %q`, key, field, prefix)
+			}
+		}
+	}
+	return nil
+}
+
+// ContainerDependencyOpts converts the "depends_on" field of a container into a format parsable by the templates pkg,
+// sorted by container name.
+func ContainerDependencyOpts(deps map[string]string) []*template.ContainerDependencyOpts {
+	var opts []*template.ContainerDependencyOpts
+	for _, name := range sortedKeys(deps) {
+		opts = append(opts, &template.ContainerDependencyOpts{
+			Name:      name,
+			Condition: strings.ToUpper(deps[name]),
+		})
+	}
+	return opts
+}
+
+// ValidateContainerDependencies returns an error if the "depends_on" field of the main container, named after the workload,
+// or of a sidecar refers to a container that isn't in the task, uses a condition that the container can't reach,
+// or if the containers depend on each other in a cycle.
+// Only non-essential sidecars can be depended on to COMPLETE or SUCCEED, and only containers with a health check to be HEALTHY.
+func ValidateContainerDependencies(name string, main map[string]string, mainHealthCheck bool, s Sidecar, logging *Logging, o Observability) error {
+	deps := map[string]map[string]string{
+		name: main,
+	}
+	essential := map[string]bool{
+		name: true,
+	}
+	if logging != nil && logging.routesWithFirelens() {
+		essential[firelensContainerName] = true
+	}
+	if o.TracingEnabled() {
+		essential[XRaySidecarName] = true
+	}
+	names := []string{name}
+	for sidecarName, config := range s.Sidecars {
+		if config == nil {
+			continue
+		}
+		deps[sidecarName] = config.DependsOn
+		essential[sidecarName] = config.Essential == nil || aws.BoolValue(config.Essential)
+		names = append(names, sidecarName)
+	}
+	sort.Strings(names[1:])
+
+	for _, container := range names {
+		field := "image.depends_on"
+		if container != name {
+			field = fmt.Sprintf("sidecars.%s.depends_on", container)
+		}
+		for _, dep := range sortedKeys(deps[container]) {
+			isEssential, ok := essential[dep]
+			if !ok {
+				return fmt.Errorf(`%q in "%s" is not a container of the task`, dep, field)
+			}
+			condition := strings.ToUpper(deps[container][dep])
+			switch condition {
+			case ContainerConditionStart:
+			case ContainerConditionComplete, ContainerConditionSuccess:
+				if isEssential {
+					return fmt.Errorf(`"%s" of container %s requires %s to %s, which is only allowed for sidecars with "essential" set to false`,
+						field, container, dep, condition)
+				}
+			case ContainerConditionHealthy:
+				if dep != name || !mainHealthCheck {
+					return fmt.Errorf(`"%s" of container %s requires %s to be %s, but %s has no health check`, field, container, dep, condition, dep)
+				}
+			default:
+				return fmt.Errorf(`condition %q of %s in "%s" must be one of %s`,
+					deps[container][dep], dep, field, strings.Join(validContainerConditions, ", "))
+			}
+		}
+	}
+	return validateContainerDependencyCycles(names, deps)
+}
+
+// validateContainerDependencyCycles returns an error with the path of the cycle if the containers depend on each other in a cycle.
+func validateContainerDependencyCycles(names []string, deps map[string]map[string]string) error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(names))
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			// The cycle goes from the earlier visit of the container to this one.
+			for i := range path {
+				if path[i] == name {
+					return fmt.Errorf(`"depends_on" of the containers forms a cycle: %s`, strings.Join(append(path[i:], name), " -> "))
+				}
+			}
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range sortedKeys(deps[name]) {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ContainerResources represents the resources reserved for, and the limits of, a single container in the task.
+// Unlike the task-level CPU and memory, these only apply to the container they're set on.
+type ContainerResources struct {
+	CPU               *int              `yaml:"cpu"`
+	Memory            *int              `yaml:"memory"`             // Hard limit in MiB.
+	MemoryReservation *int              `yaml:"memory_reservation"` // Soft limit in MiB.
+	Ulimits           map[string]Ulimit `yaml:"ulimits"`
+}
+
+// Ulimit represents the soft and hard limits of a ulimit such as "nofile".
+type Ulimit struct {
+	Soft *int `yaml:"soft"`
+	Hard *int `yaml:"hard"`
+}
+
+// IsEmpty returns true if no container-level resources are set.
+func (r *ContainerResources) IsEmpty() bool {
+	return r.CPU == nil && r.Memory == nil && r.MemoryReservation == nil && len(r.Ulimits) == 0
+}
+
+// Options converts the container-level resources into a format parsable by the templates pkg.
+// If a ulimit only sets one of its limits, the other limit takes the same value.
+func (r *ContainerResources) Options() *template.ContainerResourcesOpts {
+	if r.IsEmpty() {
+		return nil
+	}
+	var ulimits []*template.UlimitOpts
+	for _, name := range sortedUlimitNames(r.Ulimits) {
+		limit := r.Ulimits[name]
+		soft, hard := limit.Soft, limit.Hard
+		if soft == nil {
+			soft = hard
+		}
+		if hard == nil {
+			hard = soft
+		}
+		ulimits = append(ulimits, &template.UlimitOpts{
+			Name:      name,
+			SoftLimit: aws.IntValue(soft),
+			HardLimit: aws.IntValue(hard),
+		})
+	}
+	return &template.ContainerResourcesOpts{
+		CPU:               r.CPU,
+		Memory:            r.Memory,
+		MemoryReservation: r.MemoryReservation,
+		Ulimits:           ulimits,
+	}
+}
+
+// reservedMemory returns the memory in MiB that ECS reserves for the container: the soft limit if set, otherwise the hard limit.
+func (r *ContainerResources) reservedMemory() int {
+	if r.MemoryReservation != nil {
+		return aws.IntValue(r.MemoryReservation)
+	}
+	return aws.IntValue(r.Memory)
+}
+
+func (r *ContainerResources) validate(container string) error {
+	if r.MemoryReservation != nil && r.Memory != nil && *r.MemoryReservation > *r.Memory {
+		return fmt.Errorf("memory_reservation %d of container %s must not be greater than its memory %d",
+			*r.MemoryReservation, container, *r.Memory)
+	}
+	for _, name := range sortedUlimitNames(r.Ulimits) {
+		if !isValidUlimitName(name) {
+			return fmt.Errorf("ulimit %s of container %s is not one of %s", name, container, strings.Join(validUlimitNames, ", "))
+		}
+		limit := r.Ulimits[name]
+		if limit.Soft == nil && limit.Hard == nil {
+			return fmt.Errorf("ulimit %s of container %s must set at least one of soft or hard", name, container)
+		}
+		if limit.Soft != nil && limit.Hard != nil && *limit.Soft > *limit.Hard {
+			return fmt.Errorf("soft limit %d of ulimit %s of container %s must not be greater than its hard limit %d",
+				*limit.Soft, name, container, *limit.Hard)
+		}
+	}
+	return nil
+}
+
+// ValidateContainerResources returns an error if the container-level resources of the main container, named after
+// the workload, and its sidecars are invalid, or if the sum of their reservations exceeds the task-level CPU or memory.
+func ValidateContainerResources(name string, task TaskConfig, main ContainerResources, sidecar Sidecar) error {
+	containers := map[string]ContainerResources{
+		name: main,
+	}
+	names := []string{name}
+	for sidecarName, config := range sidecar.Sidecars {
+		if config == nil {
+			continue
+		}
+		containers[sidecarName] = config.ContainerResources
+		names = append(names, sidecarName)
+	}
+	sort.Strings(names[1:])
+
+	var totalCPU, totalMemory int
+	var cpuShares, memoryShares []string
+	for _, container := range names {
+		resources := containers[container]
+		if err := resources.validate(container); err != nil {
+			return err
+		}
+		if cpu := aws.IntValue(resources.CPU); cpu != 0 {
+			totalCPU += cpu
+			cpuShares = append(cpuShares, fmt.Sprintf("%s: %d", container, cpu))
+		}
+		if memory := resources.reservedMemory(); memory != 0 {
+			totalMemory += memory
+			memoryShares = append(memoryShares, fmt.Sprintf("%s: %d", container, memory))
+		}
+	}
+	if task.CPU != nil && totalCPU > *task.CPU {
+		return fmt.Errorf("containers reserve %d CPU units in total (%s) which exceeds the task cpu of %d",
+			totalCPU, strings.Join(cpuShares, ", "), *task.CPU)
+	}
+	if task.Memory != nil && totalMemory > *task.Memory {
+		return fmt.Errorf("containers reserve %d MiB of memory in total (%s) which exceeds the task memory of %d MiB",
+			totalMemory, strings.Join(memoryShares, ", "), *task.Memory)
+	}
+	return nil
+}
+
+func isValidUlimitName(name string) bool {
+	for _, valid := range validUlimitNames {
+		if name == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedUlimitNames(ulimits map[string]Ulimit) []string {
+	var names []string
+	for name := range ulimits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// warnDeprecatedKey prints a warning the first time a deprecated manifest key is used.
+func warnDeprecatedKey(deprecated, replacement string) {
+	if _, warned := warnedDeprecatedKeys.LoadOrStore(deprecated, true); warned {
+		return
+	}
+	log.Warningf("The manifest field %s is deprecated and will be removed in a future version, use %s instead.\n", deprecated, replacement)
 }
 
 // WorkloadProps contains properties for creating a new workload manifest.
@@ -282,20 +1032,32 @@ type WorkloadProps struct {
 // UnmarshalWorkload deserializes the YAML input stream into a workload manifest object.
 // If an error occurs during deserialization, then returns the error.
 // If the workload type in the manifest is invalid, then returns an ErrInvalidManifestType.
+// Fields that have no effect for the workload type are ignored with a warning.
 func UnmarshalWorkload(in []byte) (interface{}, error) {
+	return unmarshalWorkload(in, false)
+}
+
+// UnmarshalWorkloadStrict is like UnmarshalWorkload, but returns an ErrIgnoredFields
+// if the manifest has fields that have no effect for the workload type.
+func UnmarshalWorkloadStrict(in []byte) (interface{}, error) {
+	return unmarshalWorkload(in, true)
+}
+
+func unmarshalWorkload(in []byte, strict bool) (interface{}, error) {
 	am := Workload{}
 	if err := yaml.Unmarshal(in, &am); err != nil {
 		return nil, fmt.Errorf("unmarshal to workload manifest: %w", err)
 	}
 	typeVal := aws.StringValue(am.Type)
 
+	var mft interface{}
 	switch typeVal {
 	case LoadBalancedWebServiceType:
 		m := newDefaultLoadBalancedWebService()
 		if err := yaml.Unmarshal(in, m); err != nil {
 			return nil, fmt.Errorf("unmarshal to load balanced web service: %w", err)
 		}
-		return m, nil
+		mft = m
 	case BackendServiceType:
 		m := newDefaultBackendService()
 		if err := yaml.Unmarshal(in, m); err != nil {
@@ -305,16 +1067,35 @@ func UnmarshalWorkload(in []byte) (interface{}, error) {
 			// Make sure that unset fields in the healthcheck gets a default value.
 			m.BackendServiceConfig.ImageConfig.HealthCheck.applyIfNotSet(newDefaultContainerHealthCheck())
 		}
-		return m, nil
+		mft = m
 	case ScheduledJobType:
 		m := newDefaultScheduledJob()
 		if err := yaml.Unmarshal(in, m); err != nil {
 			return nil, fmt.Errorf("unmarshal to scheduled job: %w", err)
 		}
-		return m, nil
+		if err := m.validateTrigger(); err != nil {
+			return nil, fmt.Errorf("unmarshal to scheduled job: %w", err)
+		}
+		mft = m
 	default:
 		return nil, &ErrInvalidWorkloadType{Type: typeVal}
 	}
+
+	ignored, err := ignoredFields(in, typeVal)
+	if err != nil {
+		return nil, err
+	}
+	if len(ignored) == 0 {
+		return mft, nil
+	}
+	if strict {
+		return nil, &ErrIgnoredFields{
+			Type:   typeVal,
+			Fields: ignored,
+		}
+	}
+	warnIgnoredFields(typeVal, ignored)
+	return mft, nil
 }
 
 func requiresBuild(image Image) (bool, error) {
@@ -344,6 +1125,18 @@ func dockerfileBuildRequired(workloadType string, svc interface{}) (bool, error)
 	return required, nil
 }
 
+// SidecarDockerfileBuildRequired returns if any sidecar of the workload should be built from a local Dockerfile.
+func SidecarDockerfileBuildRequired(wkld interface{}) bool {
+	type manifest interface {
+		SidecarsBuildRequired() bool
+	}
+	mf, ok := wkld.(manifest)
+	if !ok {
+		return false
+	}
+	return mf.SidecarsBuildRequired()
+}
+
 func stringP(s string) *string {
 	if s == "" {
 		return nil
@@ -351,6 +1144,13 @@ func stringP(s string) *string {
 	return &s
 }
 
+func intP(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
 func uint16P(n uint16) *uint16 {
 	if n == 0 {
 		return nil
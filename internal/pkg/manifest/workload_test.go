@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -246,7 +247,7 @@ func TestSidecar_Options(t *testing.T) {
 				Sidecars: map[string]*SidecarConfig{
 					"foo": {
 						CredsParam: aws.String("mockCredsParam"),
-						Image:      aws.String("mockImage"),
+						Image:      SidecarImage{Location: aws.String("mockImage")},
 						Port:       aws.String(tc.inPort),
 					},
 				},
@@ -263,3 +264,1078 @@ func TestSidecar_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestSidecarImage_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct SidecarImage
+		wantedError  error
+	}{
+		"image location": {
+			inContent: []byte(`image: 123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon`),
+
+			wantedStruct: SidecarImage{
+				Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+			},
+		},
+		"simple build string": {
+			inContent: []byte(`image:
+  build: nginx/Dockerfile`),
+
+			wantedStruct: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("nginx/Dockerfile"),
+				},
+			},
+		},
+		"build opts": {
+			inContent: []byte(`image:
+  build:
+    dockerfile: nginx/Dockerfile
+    context: nginx
+    args:
+      arg1: value1`),
+
+			wantedStruct: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("nginx/Dockerfile"),
+						Context:    aws.String("nginx"),
+						Args: map[string]string{
+							"arg1": "value1",
+						},
+					},
+				},
+			},
+		},
+		"error if map without build": {
+			inContent: []byte(`image:
+  location: nginx`),
+
+			wantedError: errUnmarshalSidecarImage,
+		},
+		"error if build is unmarshalable": {
+			inContent: []byte(`image:
+  build:
+    badfield: OH NOES`),
+
+			wantedError: errUnmarshalSidecarImage,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var sidecar SidecarConfig
+			err := yaml.Unmarshal(tc.inContent, &sidecar)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, sidecar.Image)
+			}
+		})
+	}
+}
+
+func TestVariable_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wanted      map[string]Variable
+		wantedError error
+	}{
+		"string and scalar values": {
+			inContent: []byte(`variables:
+  LOG_LEVEL: info
+  PORT: 8080`),
+			wanted: map[string]Variable{
+				"LOG_LEVEL": {Value: aws.String("info")},
+				"PORT":      {Value: aws.String("8080")},
+			},
+		},
+		"values from CloudFormation": {
+			inContent: []byte(`variables:
+  DB_HOST:
+    from_cfn: phonetool-test-db-Host
+  SUBNETS:
+    from_env_output: PrivateSubnets`),
+			wanted: map[string]Variable{
+				"DB_HOST": {
+					From: VariableFrom{CFNExport: aws.String("phonetool-test-db-Host")},
+				},
+				"SUBNETS": {
+					From: VariableFrom{EnvOutput: aws.String("PrivateSubnets")},
+				},
+			},
+		},
+		"error if map without a source": {
+			inContent: []byte(`variables:
+  DB_HOST:
+    from_ssm: /db/host`),
+			wantedError: errUnmarshalVariable,
+		},
+		"error if list": {
+			inContent: []byte(`variables:
+  DB_HOST:
+    - localhost`),
+			wantedError: errUnmarshalVariable,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var config TaskConfig
+			err := yaml.Unmarshal(tc.inContent, &config)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, config.Variables)
+		})
+	}
+}
+
+func TestSidecar_BuildConfigs(t *testing.T) {
+	mockWsRoot := "/root/dir"
+	sidecar := Sidecar{
+		Sidecars: map[string]*SidecarConfig{
+			"xray": {
+				Image: SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
+			},
+			"nginx": {
+				Image: SidecarImage{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("nginx/Dockerfile"),
+					},
+				},
+			},
+		},
+	}
+
+	require.True(t, sidecar.SidecarsBuildRequired())
+	require.Equal(t, map[string]*DockerBuildArgs{
+		"nginx": {
+			Dockerfile: aws.String(filepath.Join(mockWsRoot, "nginx", "Dockerfile")),
+			Context:    aws.String(filepath.Join(mockWsRoot, "nginx")),
+		},
+	}, sidecar.BuildConfigs(mockWsRoot))
+}
+
+func TestContainerResources_UnmarshalYAML(t *testing.T) {
+	in := []byte(`image:
+  build: ./Dockerfile
+  cpu: 128
+  memory: 512
+  memory_reservation: 256
+  ulimits:
+    nofile:
+      soft: 1024
+      hard: 4096
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx
+    memory_reservation: 128
+    ulimits:
+      nproc:
+        hard: 64
+`)
+	var got struct {
+		ImageConfig Image `yaml:"image"`
+		Sidecar     `yaml:",inline"`
+	}
+
+	err := yaml.Unmarshal(in, &got)
+
+	require.NoError(t, err)
+	require.Equal(t, ContainerResources{
+		CPU:               aws.Int(128),
+		Memory:            aws.Int(512),
+		MemoryReservation: aws.Int(256),
+		Ulimits: map[string]Ulimit{
+			"nofile": {Soft: aws.Int(1024), Hard: aws.Int(4096)},
+		},
+	}, got.ImageConfig.ContainerResources)
+	require.Equal(t, ContainerResources{
+		MemoryReservation: aws.Int(128),
+		Ulimits: map[string]Ulimit{
+			"nproc": {Hard: aws.Int(64)},
+		},
+	}, got.Sidecars["nginx"].ContainerResources)
+}
+
+func TestContainerResources_Options(t *testing.T) {
+	testCases := map[string]struct {
+		in ContainerResources
+
+		wanted *template.ContainerResourcesOpts
+	}{
+		"nothing set": {
+			in: ContainerResources{},
+
+			wanted: nil,
+		},
+		"cpu and memory": {
+			in: ContainerResources{
+				CPU:               aws.Int(256),
+				Memory:            aws.Int(512),
+				MemoryReservation: aws.Int(128),
+			},
+
+			wanted: &template.ContainerResourcesOpts{
+				CPU:               aws.Int(256),
+				Memory:            aws.Int(512),
+				MemoryReservation: aws.Int(128),
+			},
+		},
+		"ulimits are sorted by name and missing limits take the other limit": {
+			in: ContainerResources{
+				Ulimits: map[string]Ulimit{
+					"nproc":  {Hard: aws.Int(64)},
+					"nofile": {Soft: aws.Int(1024), Hard: aws.Int(4096)},
+					"core":   {Soft: aws.Int(0)},
+				},
+			},
+
+			wanted: &template.ContainerResourcesOpts{
+				Ulimits: []*template.UlimitOpts{
+					{Name: "core", SoftLimit: 0, HardLimit: 0},
+					{Name: "nofile", SoftLimit: 1024, HardLimit: 4096},
+					{Name: "nproc", SoftLimit: 64, HardLimit: 64},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Options())
+		})
+	}
+}
+
+func TestValidateContainerResources(t *testing.T) {
+	testCases := map[string]struct {
+		inTask    TaskConfig
+		inMain    ContainerResources
+		inSidecar Sidecar
+
+		wantedErr error
+	}{
+		"no container-level resources": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+		},
+		"reservations fit in the task": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inMain: ContainerResources{CPU: aws.Int(128), Memory: aws.Int(512), MemoryReservation: aws.Int(256)},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						ContainerResources: ContainerResources{CPU: aws.Int(128), Memory: aws.Int(256)},
+					},
+				},
+			},
+		},
+		"cpu exceeds the task cpu": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inMain: ContainerResources{CPU: aws.Int(256)},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						ContainerResources: ContainerResources{CPU: aws.Int(64)},
+					},
+					"envoy": {
+						ContainerResources: ContainerResources{CPU: aws.Int(32)},
+					},
+				},
+			},
+
+			wantedErr: errors.New("containers reserve 352 CPU units in total (api: 256, envoy: 32, nginx: 64) which exceeds the task cpu of 256"),
+		},
+		"memory reservations exceed the task memory": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inMain: ContainerResources{Memory: aws.Int(1024), MemoryReservation: aws.Int(384)},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						ContainerResources: ContainerResources{Memory: aws.Int(256)},
+					},
+				},
+			},
+
+			wantedErr: errors.New("containers reserve 640 MiB of memory in total (api: 384, nginx: 256) which exceeds the task memory of 512 MiB"),
+		},
+		"memory reservation greater than memory": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inMain: ContainerResources{Memory: aws.Int(128), MemoryReservation: aws.Int(256)},
+
+			wantedErr: errors.New("memory_reservation 256 of container api must not be greater than its memory 128"),
+		},
+		"unknown ulimit": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						ContainerResources: ContainerResources{
+							Ulimits: map[string]Ulimit{"files": {Soft: aws.Int(1024)}},
+						},
+					},
+				},
+			},
+
+			wantedErr: errors.New("ulimit files of container nginx is not one of core, cpu, data, fsize, locks, memlock, msgqueue, nice, nofile, nproc, rss, rtprio, rttime, sigpending, stack"),
+		},
+		"ulimit without limits": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inMain: ContainerResources{
+				Ulimits: map[string]Ulimit{"nofile": {}},
+			},
+
+			wantedErr: errors.New("ulimit nofile of container api must set at least one of soft or hard"),
+		},
+		"soft limit greater than hard limit": {
+			inTask: TaskConfig{CPU: aws.Int(256), Memory: aws.Int(512)},
+			inMain: ContainerResources{
+				Ulimits: map[string]Ulimit{"nofile": {Soft: aws.Int(4096), Hard: aws.Int(1024)}},
+			},
+
+			wantedErr: errors.New("soft limit 4096 of ulimit nofile of container api must not be greater than its hard limit 1024"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateContainerResources("api", tc.inTask, tc.inMain, tc.inSidecar)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePlatform(t *testing.T) {
+	testCases := map[string]struct {
+		in *string
+
+		wantedErr error
+	}{
+		"no platform": {},
+		"supported platform": {
+			in: aws.String("linux/arm64"),
+		},
+		"unsupported platform": {
+			in: aws.String("linux/386"),
+
+			wantedErr: errors.New("platform linux/386 must be one of linux/amd64, linux/arm64, windows/amd64"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePlatform(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskConfig_RuntimePlatformOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in *string
+
+		wanted *template.RuntimePlatformOpts
+	}{
+		"no platform": {},
+		"linux on arm": {
+			in: aws.String("linux/arm64"),
+
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "ARM64",
+			},
+		},
+		"windows": {
+			in: aws.String("windows/amd64"),
+
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "WINDOWS_SERVER_2019_CORE",
+				Arch: "X86_64",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			conf := TaskConfig{Platform: tc.in}
+			require.Equal(t, tc.wanted, conf.RuntimePlatformOpts())
+		})
+	}
+}
+
+func TestMessaging_UnmarshalYAML(t *testing.T) {
+	in := []byte(`publish:
+  - orders
+  - order-events
+subscribe:
+  - queue: fulfillment
+    topic: orders
+  - queue: fulfillment
+    topic: payments
+`)
+	var got Messaging
+
+	err := yaml.Unmarshal(in, &got)
+
+	require.NoError(t, err)
+	require.Equal(t, Messaging{
+		Publish: []string{"orders", "order-events"},
+		Subscribe: []Subscription{
+			{Queue: aws.String("fulfillment"), Topic: aws.String("orders")},
+			{Queue: aws.String("fulfillment"), Topic: aws.String("payments")},
+		},
+	}, got)
+}
+
+func TestValidateObservability(t *testing.T) {
+	testCases := map[string]struct {
+		inObservability Observability
+		inSidecar       Sidecar
+
+		wantedErr error
+	}{
+		"no tracing": {
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"xray": {},
+				},
+			},
+		},
+		"tracing disabled with an X-Ray sidecar": {
+			inObservability: Observability{Tracing: aws.String("none")},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"xray": {},
+				},
+			},
+		},
+		"tracing with other sidecars": {
+			inObservability: Observability{Tracing: aws.String("awsxray")},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						Image: SidecarImage{Location: aws.String("nginx")},
+					},
+				},
+			},
+		},
+		"unsupported tracing vendor": {
+			inObservability: Observability{Tracing: aws.String("datadog")},
+
+			wantedErr: errors.New(`"observability.tracing" "datadog" must be one of "awsxray" or "none"`),
+		},
+		"sidecar named xray": {
+			inObservability: Observability{Tracing: aws.String("awsxray")},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"xray": {
+						Image: SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/daemon")},
+					},
+				},
+			},
+
+			wantedErr: errors.New(`sidecar "xray" conflicts with the X-Ray daemon sidecar added by "observability.tracing", remove the sidecar or the "tracing" field`),
+		},
+		"sidecar running the X-Ray daemon": {
+			inObservability: Observability{Tracing: aws.String("awsxray")},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"tracer": {
+						Image: SidecarImage{Location: aws.String("public.ecr.aws/xray/aws-xray-daemon:3.x")},
+					},
+				},
+			},
+
+			wantedErr: errors.New(`sidecar "tracer" conflicts with the X-Ray daemon sidecar added by "observability.tracing", remove the sidecar or the "tracing" field`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateObservability(tc.inObservability, tc.inSidecar)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDockerLabels(t *testing.T) {
+	testCases := map[string]struct {
+		inMain    map[string]string
+		inSidecar Sidecar
+
+		wantedErr error
+	}{
+		"no labels": {},
+		"valid labels": {
+			inMain: map[string]string{
+				"com.example.team": "payments",
+			},
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						DockerLabels: map[string]string{
+							"com.example.proxy": "true",
+						},
+					},
+					"empty": nil,
+				},
+			},
+		},
+		"reserved label on the main container": {
+			inMain: map[string]string{
+				"com.amazonaws.ecs.cluster": "my-cluster",
+			},
+
+			wantedErr: errors.New(`"com.amazonaws.ecs.cluster" in "image.labels" cannot start with the reserved prefix "com.amazonaws.ecs."`),
+		},
+		"reserved label on a sidecar": {
+			inSidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"nginx": {
+						DockerLabels: map[string]string{
+							"Copilot-Service": "api",
+						},
+					},
+				},
+			},
+
+			wantedErr: errors.New(`"Copilot-Service" in "sidecars.nginx.labels" cannot start with the reserved prefix "copilot-"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDockerLabels(tc.inMain, tc.inSidecar)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateServiceTags(t *testing.T) {
+	testCases := map[string]struct {
+		in map[string]string
+
+		wantedErr error
+	}{
+		"no tags": {},
+		"valid tags": {
+			in: map[string]string{
+				"cost-center": "1234",
+			},
+		},
+		"reserved aws tag": {
+			in: map[string]string{
+				"aws:cloudformation:stack-name": "my-stack",
+			},
+
+			wantedErr: errors.New(`"aws:cloudformation:stack-name" in "service_tags" cannot start with the reserved prefix "aws:"`),
+		},
+		"reserved copilot tag": {
+			in: map[string]string{
+				"copilot-application": "my-app",
+			},
+
+			wantedErr: errors.New(`"copilot-application" in "service_tags" cannot start with the reserved prefix "copilot-"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateServiceTags(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContainerDependencyOpts(t *testing.T) {
+	require.Nil(t, ContainerDependencyOpts(nil))
+	require.Equal(t, []*template.ContainerDependencyOpts{
+		{Name: "firelens_log_router", Condition: "START"},
+		{Name: "migrations", Condition: "SUCCESS"},
+	}, ContainerDependencyOpts(map[string]string{
+		"migrations":          "success",
+		"firelens_log_router": "START",
+	}))
+}
+
+func TestValidateContainerDependencies(t *testing.T) {
+	nonEssential := func(deps map[string]string) *SidecarConfig {
+		return &SidecarConfig{
+			Essential: aws.Bool(false),
+			DependsOn: deps,
+		}
+	}
+	testCases := map[string]struct {
+		inMain            map[string]string
+		inMainHealthCheck bool
+		inSidecars        map[string]*SidecarConfig
+		inLogging         *Logging
+		inObservability   Observability
+
+		wantedErr error
+	}{
+		"no dependencies": {},
+		"main container waits for the log router and a migration sidecar": {
+			inMain: map[string]string{
+				"firelens_log_router": "START",
+				"migrations":          "complete",
+			},
+			inSidecars: map[string]*SidecarConfig{
+				"migrations": nonEssential(map[string]string{"xray": "START"}),
+				"empty":      nil,
+			},
+			inLogging:       &Logging{},
+			inObservability: Observability{Tracing: aws.String(TracingAWSXRay)},
+		},
+		"sidecar waits for the main container to be healthy": {
+			inMainHealthCheck: true,
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {
+					DependsOn: map[string]string{"api": "HEALTHY"},
+				},
+			},
+		},
+		"unknown container": {
+			inMain: map[string]string{
+				"firelens_log_router": "START",
+			},
+			inLogging: &Logging{Retention: aws.Int(30)},
+
+			wantedErr: errors.New(`"firelens_log_router" in "image.depends_on" is not a container of the task`),
+		},
+		"invalid condition": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {
+					DependsOn: map[string]string{"api": "STOP"},
+				},
+			},
+
+			wantedErr: errors.New(`condition "STOP" of api in "sidecars.nginx.depends_on" must be one of START, COMPLETE, SUCCESS, HEALTHY`),
+		},
+		"essential sidecar depended on to complete": {
+			inMain: map[string]string{
+				"migrations": "SUCCESS",
+			},
+			inSidecars: map[string]*SidecarConfig{
+				"migrations": {},
+			},
+
+			wantedErr: errors.New(`"image.depends_on" of container api requires migrations to SUCCESS, which is only allowed for sidecars with "essential" set to false`),
+		},
+		"container without a health check depended on to be healthy": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {
+					DependsOn: map[string]string{"api": "healthy"},
+				},
+			},
+
+			wantedErr: errors.New(`"sidecars.nginx.depends_on" of container nginx requires api to be HEALTHY, but api has no health check`),
+		},
+		"cycle": {
+			inMain: map[string]string{
+				"nginx": "START",
+			},
+			inSidecars: map[string]*SidecarConfig{
+				"nginx":   {DependsOn: map[string]string{"envoy": "START"}},
+				"envoy":   {DependsOn: map[string]string{"api": "START"}},
+				"fluentd": {},
+			},
+
+			wantedErr: errors.New(`"depends_on" of the containers forms a cycle: api -> nginx -> envoy -> api`),
+		},
+		"sidecar depending on itself": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": nonEssential(map[string]string{"nginx": "COMPLETE"}),
+			},
+
+			wantedErr: errors.New(`"depends_on" of the containers forms a cycle: nginx -> nginx`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateContainerDependencies("api", tc.inMain, tc.inMainHealthCheck, Sidecar{Sidecars: tc.inSidecars}, tc.inLogging, tc.inObservability)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMessaging(t *testing.T) {
+	testCases := map[string]struct {
+		in Messaging
+
+		wantedErr error
+	}{
+		"no messaging": {},
+		"valid topics and subscriptions": {
+			in: Messaging{
+				Publish: []string{"orders", "order-events"},
+				Subscribe: []Subscription{
+					{Queue: aws.String("fulfillment"), Topic: aws.String("orders")},
+					{Queue: aws.String("fulfillment"), Topic: aws.String("payments")},
+				},
+			},
+		},
+		"invalid topic name": {
+			in: Messaging{
+				Publish: []string{"order_events"},
+			},
+			wantedErr: errors.New(`"publish" topic "order_events" must only contain letters, numbers and hyphens`),
+		},
+		"duplicate topic": {
+			in: Messaging{
+				Publish: []string{"orders", "orders"},
+			},
+			wantedErr: errors.New(`"publish" topic "orders" is listed more than once`),
+		},
+		"subscription without a queue": {
+			in: Messaging{
+				Subscribe: []Subscription{
+					{Topic: aws.String("orders")},
+				},
+			},
+			wantedErr: errors.New(`"subscribe[0]" must set both "queue" and "topic"`),
+		},
+		"invalid queue name": {
+			in: Messaging{
+				Subscribe: []Subscription{
+					{Queue: aws.String("fulfillment.fifo"), Topic: aws.String("orders")},
+				},
+			},
+			wantedErr: errors.New(`"subscribe[0].queue" "fulfillment.fifo" must only contain letters, numbers and hyphens`),
+		},
+		"duplicate subscription": {
+			in: Messaging{
+				Subscribe: []Subscription{
+					{Queue: aws.String("fulfillment"), Topic: aws.String("orders")},
+					{Queue: aws.String("fulfillment"), Topic: aws.String("orders")},
+				},
+			},
+			wantedErr: errors.New(`queue "fulfillment" is subscribed to topic "orders" more than once`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateMessaging(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPublishedTopics(t *testing.T) {
+	testCases := map[string]struct {
+		in interface{}
+
+		wanted []string
+	}{
+		"no topics": {
+			in: &BackendService{},
+		},
+		"topics of a job": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					Messaging: Messaging{
+						Publish: []string{"reports"},
+					},
+				},
+			},
+			wanted: []string{"reports"},
+		},
+		"topics of a service and its environment overrides": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Messaging: Messaging{
+						Publish: []string{"orders"},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						Messaging: Messaging{
+							Publish: []string{"orders", "audit"},
+						},
+					},
+				},
+			},
+			wanted: []string{"audit", "orders"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, PublishedTopics(tc.in))
+		})
+	}
+}
+
+func TestWorkloadDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wanted []string
+	}{
+		"no dependencies": {
+			inContent: `name: api
+type: Backend Service
+image:
+  location: nginx
+`,
+		},
+		"dependencies of a service": {
+			inContent: `name: frontend
+type: Load Balanced Web Service
+image:
+  location: nginx
+http:
+  path: /
+depends_on:
+  - api
+  - auth
+`,
+			wanted: []string{"api", "auth"},
+		},
+		"dependencies of a job": {
+			inContent: `name: report
+type: Scheduled Job
+image:
+  location: nginx
+on:
+  schedule: "@daily"
+depends_on: [api]
+`,
+			wanted: []string{"api"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft, err := UnmarshalWorkload([]byte(tc.inContent))
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, WorkloadDependencies(mft))
+		})
+	}
+}
+
+func TestLogging_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct Logging
+		wantedError  error
+	}{
+		"snake_case keys": {
+			inContent: []byte(`logging:
+  image: amazon/aws-for-fluent-bit:2.10.0
+  destination:
+    Name: cloudwatch
+  enable_metadata: false
+  secret_options:
+    LOG_TOKEN: LOG_TOKEN
+  config_file_path: /extra.conf
+`),
+			wantedStruct: Logging{
+				Image: aws.String("amazon/aws-for-fluent-bit:2.10.0"),
+				Destination: map[string]string{
+					"Name": "cloudwatch",
+				},
+				EnableMetadata: aws.Bool(false),
+				SecretOptions: map[string]string{
+					"LOG_TOKEN": "LOG_TOKEN",
+				},
+				ConfigFile: aws.String("/extra.conf"),
+			},
+		},
+		"deprecated camelCase keys": {
+			inContent: []byte(`logging:
+  destination:
+    Name: cloudwatch
+  enableMetadata: false
+  secretOptions:
+    LOG_TOKEN: LOG_TOKEN
+  configFilePath: /extra.conf
+`),
+			wantedStruct: Logging{
+				Destination: map[string]string{
+					"Name": "cloudwatch",
+				},
+				EnableMetadata: aws.Bool(false),
+				SecretOptions: map[string]string{
+					"LOG_TOKEN": "LOG_TOKEN",
+				},
+				ConfigFile: aws.String("/extra.conf"),
+			},
+		},
+		"snake_case keys take precedence over deprecated keys": {
+			inContent: []byte(`logging:
+  enableMetadata: true
+  enable_metadata: false
+  configFilePath: /old.conf
+  config_file_path: /new.conf
+`),
+			wantedStruct: Logging{
+				EnableMetadata: aws.Bool(false),
+				ConfigFile:     aws.String("/new.conf"),
+			},
+		},
+		"log group settings": {
+			inContent: []byte(`logging:
+  retention: 365
+  log_group: /phonetool/prod/frontend
+`),
+			wantedStruct: Logging{
+				Retention: aws.Int(365),
+				LogGroup:  aws.String("/phonetool/prod/frontend"),
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`logging:
+  enable_metadata: [true]
+`),
+			wantedError: errors.New("yaml: unmarshal errors:\n  line 2: cannot unmarshal !!seq into bool"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got struct {
+				Logging Logging `yaml:"logging"`
+			}
+
+			err := yaml.Unmarshal(tc.inContent, &got)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, got.Logging)
+			}
+		})
+	}
+}
+
+func TestValidateLogging(t *testing.T) {
+	testCases := map[string]struct {
+		in *Logging
+
+		wantedErr error
+	}{
+		"no logging configuration": {},
+		"no retention": {
+			in: &Logging{
+				LogGroup: aws.String("/phonetool/prod/frontend"),
+			},
+		},
+		"retention supported by CloudWatch Logs": {
+			in: &Logging{
+				Retention: aws.Int(365),
+			},
+		},
+		"retention not supported by CloudWatch Logs": {
+			in: &Logging{
+				Retention: aws.Int(10),
+			},
+
+			wantedErr: errors.New("logging.retention 10 must be one of 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateLogging(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLogging_LogConfigOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in *Logging
+
+		wanted *template.LogConfigOpts
+	}{
+		"no logging configuration": {},
+		"only the log group is configured": {
+			in: &Logging{
+				Retention: aws.Int(7),
+				LogGroup:  aws.String("/phonetool/test/frontend"),
+			},
+		},
+		"firelens with a log group retention": {
+			in: &Logging{
+				Destination: map[string]string{
+					"Name": "cloudwatch",
+				},
+				Retention: aws.Int(7),
+			},
+
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String(defaultFluentbitImage),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name": "cloudwatch",
+				},
+			},
+		},
+		"firelens with the default configuration": {
+			in: &Logging{},
+
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String(defaultFluentbitImage),
+				EnableMetadata: aws.String("true"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := LoadBalancedWebServiceConfig{
+				Logging: tc.in,
+			}
+
+			require.Equal(t, tc.wanted, svc.LogConfigOpts())
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package override applies the patches under a workload's "overrides/" directory to the CloudFormation template
+// generated for the workload. It's an escape hatch for properties that the manifest doesn't model.
+package override
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"gopkg.in/yaml.v3"
+)
+
+// Operations supported by a patch.
+const (
+	opAdd     = "add"
+	opRemove  = "remove"
+	opReplace = "replace"
+)
+
+const (
+	pathSeparator    = "/"
+	seqAppendIndex   = "-" // Index of the element after the last one of a sequence, only valid when adding.
+	templateIndent   = 2
+	resourcesSection = "Resources"
+)
+
+var supportedOps = []string{opAdd, opRemove, opReplace}
+
+type workspaceReader interface {
+	ReadCFNPatches(wlName string) ([]byte, error)
+}
+
+// Patches represents the patches to the CloudFormation template of a workload.
+type Patches struct {
+	wlName string
+
+	ws workspaceReader
+}
+
+// New creates a Patches object given a workload name.
+func New(wlName string) (*Patches, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("workspace cannot be created: %w", err)
+	}
+	return &Patches{
+		wlName: wlName,
+		ws:     ws,
+	}, nil
+}
+
+// patch is a JSON Patch-like operation on a CloudFormation template.
+type patch struct {
+	Operation string    `yaml:"op"`
+	Path      string    `yaml:"path"` // JSON Pointer to the node to patch, such as "/Resources/Service/Properties/PropagateTags".
+	Value     yaml.Node `yaml:"value"`
+}
+
+// Apply applies the workload's patches in order to the CloudFormation template and returns the patched template.
+// If the workload doesn't have a patches file, the template is returned unchanged.
+func (p *Patches) Apply(tpl string) (string, error) {
+	raw, err := p.ws.ReadCFNPatches(p.wlName)
+	if err != nil {
+		return "", fmt.Errorf("read patches of %s: %w", p.wlName, err)
+	}
+	if raw == nil {
+		return tpl, nil
+	}
+	var patches []patch
+	if err := yaml.Unmarshal(raw, &patches); err != nil {
+		return "", fmt.Errorf("unmarshal patches of %s: %w", p.wlName, err)
+	}
+	return applyPatches(tpl, patches)
+}
+
+func applyPatches(tpl string, patches []patch) (string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(tpl), &doc); err != nil {
+		return "", fmt.Errorf("unmarshal template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return "", errors.New("template is empty")
+	}
+	for idx, p := range patches {
+		if err := p.apply(doc.Content[0]); err != nil {
+			return "", fmt.Errorf("apply patch at index %d with path %q: %w", idx, p.Path, err)
+		}
+	}
+	if err := validateTemplate(doc.Content[0]); err != nil {
+		return "", fmt.Errorf("validate patched template: %w", err)
+	}
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(templateIndent)
+	if err := enc.Encode(&doc); err != nil {
+		return "", fmt.Errorf("marshal patched template: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("marshal patched template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func (p patch) apply(root *yaml.Node) error {
+	if !strings.HasPrefix(p.Path, pathSeparator) {
+		return fmt.Errorf("path must start with %q", pathSeparator)
+	}
+	keys := strings.Split(strings.TrimPrefix(p.Path, pathSeparator), pathSeparator)
+	for i, key := range keys {
+		// Unescape the key as specified by RFC 6901.
+		keys[i] = strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~")
+	}
+	parent, err := find(root, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+	switch p.Operation {
+	case opAdd:
+		if p.Value.Kind == 0 {
+			return fmt.Errorf("value is required to %s", p.Operation)
+		}
+		return add(parent, last, &p.Value)
+	case opReplace:
+		if p.Value.Kind == 0 {
+			return fmt.Errorf("value is required to %s", p.Operation)
+		}
+		return replace(parent, last, &p.Value)
+	case opRemove:
+		return remove(parent, last)
+	default:
+		return fmt.Errorf("operation %q is not supported, must be one of %s", p.Operation, strings.Join(supportedOps, ", "))
+	}
+}
+
+// find returns the node at the keys under the root node.
+func find(root *yaml.Node, keys []string) (*yaml.Node, error) {
+	node := root
+	for _, key := range keys {
+		switch node.Kind {
+		case yaml.MappingNode:
+			idx := mappingValueIndex(node, key)
+			if idx == -1 {
+				return nil, fmt.Errorf("key %q does not exist", key)
+			}
+			node = node.Content[idx]
+		case yaml.SequenceNode:
+			idx, err := sequenceIndex(node, key)
+			if err != nil {
+				return nil, err
+			}
+			node = node.Content[idx]
+		default:
+			return nil, fmt.Errorf("cannot find key %q in a scalar value", key)
+		}
+	}
+	return node, nil
+}
+
+func add(parent *yaml.Node, key string, value *yaml.Node) error {
+	switch parent.Kind {
+	case yaml.MappingNode:
+		if idx := mappingValueIndex(parent, key); idx != -1 {
+			parent.Content[idx] = value
+			return nil
+		}
+		parent.Content = append(parent.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: key,
+		}, value)
+		return nil
+	case yaml.SequenceNode:
+		idx := len(parent.Content)
+		if key != seqAppendIndex {
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i > len(parent.Content) {
+				return fmt.Errorf("index %q is out of range", key)
+			}
+			idx = i
+		}
+		parent.Content = append(parent.Content[:idx], append([]*yaml.Node{value}, parent.Content[idx:]...)...)
+		return nil
+	default:
+		return fmt.Errorf("cannot add key %q to a scalar value", key)
+	}
+}
+
+func replace(parent *yaml.Node, key string, value *yaml.Node) error {
+	switch parent.Kind {
+	case yaml.MappingNode:
+		idx := mappingValueIndex(parent, key)
+		if idx == -1 {
+			return fmt.Errorf("key %q does not exist", key)
+		}
+		parent.Content[idx] = value
+		return nil
+	case yaml.SequenceNode:
+		idx, err := sequenceIndex(parent, key)
+		if err != nil {
+			return err
+		}
+		parent.Content[idx] = value
+		return nil
+	default:
+		return fmt.Errorf("cannot replace key %q in a scalar value", key)
+	}
+}
+
+func remove(parent *yaml.Node, key string) error {
+	switch parent.Kind {
+	case yaml.MappingNode:
+		idx := mappingValueIndex(parent, key)
+		if idx == -1 {
+			return fmt.Errorf("key %q does not exist", key)
+		}
+		parent.Content = append(parent.Content[:idx-1], parent.Content[idx+1:]...)
+		return nil
+	case yaml.SequenceNode:
+		idx, err := sequenceIndex(parent, key)
+		if err != nil {
+			return err
+		}
+		parent.Content = append(parent.Content[:idx], parent.Content[idx+1:]...)
+		return nil
+	default:
+		return fmt.Errorf("cannot remove key %q from a scalar value", key)
+	}
+}
+
+// mappingValueIndex returns the index of the value of the key in the content of a mapping node, or -1 if the key doesn't exist.
+func mappingValueIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// sequenceIndex returns the index of an existing element of a sequence node.
+func sequenceIndex(seq *yaml.Node, key string) (int, error) {
+	idx, err := strconv.Atoi(key)
+	if err != nil || idx < 0 || idx >= len(seq.Content) {
+		return 0, fmt.Errorf("index %q is out of range", key)
+	}
+	return idx, nil
+}
+
+// validateTemplate returns an error if the patched template is no longer a CloudFormation template with resources.
+func validateTemplate(root *yaml.Node) error {
+	if root.Kind != yaml.MappingNode {
+		return errors.New("template must be a mapping")
+	}
+	idx := mappingValueIndex(root, resourcesSection)
+	if idx == -1 {
+		return fmt.Errorf("template must have a %q section", resourcesSection)
+	}
+	resources := root.Content[idx]
+	if resources.Kind != yaml.MappingNode || len(resources.Content) == 0 {
+		return fmt.Errorf("%q section must contain at least one resource", resourcesSection)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/override/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatches_Apply(t *testing.T) {
+	const (
+		testSvcName  = "api"
+		testTemplate = `Parameters:
+  AppName:
+    Type: String
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      LoadBalancers:
+        - ContainerName: api
+          ContainerPort: 80
+Outputs:
+  ServiceName:
+    Value: !GetAtt Service.Name
+`
+	)
+	testCases := map[string]struct {
+		patches     string
+		mockWsError error
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"return the template unchanged if there are no patches": {
+			wantedTemplate: testTemplate,
+		},
+		"error reading the patches": {
+			mockWsError: errors.New("some error"),
+			wantedErr:   errors.New("read patches of api: some error"),
+		},
+		"error if the patches are not a list": {
+			patches:   `op: add`,
+			wantedErr: errors.New("unmarshal patches of api: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!map into []override.patch"),
+		},
+		"apply patches in order": {
+			patches: `- op: add
+  path: /Resources/Service/Properties/PropagateTags
+  value: SERVICE
+- op: replace
+  path: /Resources/Service/Properties/DesiredCount
+  value: 2
+- op: add
+  path: /Resources/Service/Properties/LoadBalancers/-
+  value:
+    ContainerName: api
+    ContainerPort: !Ref AppName
+- op: remove
+  path: /Outputs
+`,
+			wantedTemplate: `Parameters:
+  AppName:
+    Type: String
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+      LoadBalancers:
+        - ContainerName: api
+          ContainerPort: 80
+        - ContainerName: api
+          ContainerPort: !Ref AppName
+      PropagateTags: SERVICE
+`,
+		},
+		"unescape keys with slashes": {
+			patches: `- op: add
+  path: /Parameters/a~1b
+  value: c
+`,
+			wantedTemplate: `Parameters:
+  AppName:
+    Type: String
+  a/b: c
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      LoadBalancers:
+        - ContainerName: api
+          ContainerPort: 80
+Outputs:
+  ServiceName:
+    Value: !GetAtt Service.Name
+`,
+		},
+		"error if the key to replace doesn't exist": {
+			patches: `- op: add
+  path: /Resources/Service/Properties/PropagateTags
+  value: SERVICE
+- op: replace
+  path: /Resources/Service/Properties/Cluster
+  value: my-cluster
+`,
+			wantedErr: errors.New(`apply patch at index 1 with path "/Resources/Service/Properties/Cluster": key "Cluster" does not exist`),
+		},
+		"error if the parent of the key to add doesn't exist": {
+			patches: `- op: add
+  path: /Resources/TaskDefinition/Properties/Cpu
+  value: 256
+`,
+			wantedErr: errors.New(`apply patch at index 0 with path "/Resources/TaskDefinition/Properties/Cpu": key "TaskDefinition" does not exist`),
+		},
+		"error if the index to remove is out of range": {
+			patches: `- op: remove
+  path: /Resources/Service/Properties/LoadBalancers/1
+`,
+			wantedErr: errors.New(`apply patch at index 0 with path "/Resources/Service/Properties/LoadBalancers/1": index "1" is out of range`),
+		},
+		"error if the value is missing": {
+			patches: `- op: replace
+  path: /Resources/Service/Properties/DesiredCount
+`,
+			wantedErr: errors.New(`apply patch at index 0 with path "/Resources/Service/Properties/DesiredCount": value is required to replace`),
+		},
+		"error if the operation is not supported": {
+			patches: `- op: move
+  path: /Resources/Service
+`,
+			wantedErr: errors.New(`apply patch at index 0 with path "/Resources/Service": operation "move" is not supported, must be one of add, remove, replace`),
+		},
+		"error if the path is not a JSON pointer": {
+			patches: `- op: remove
+  path: Resources/Service
+`,
+			wantedErr: errors.New(`apply patch at index 0 with path "Resources/Service": path must start with "/"`),
+		},
+		"error if the patched template has no resources": {
+			patches: `- op: remove
+  path: /Resources/Service
+`,
+			wantedErr: errors.New(`validate patched template: "Resources" section must contain at least one resource`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockworkspaceReader(ctrl)
+			var raw []byte
+			if tc.patches != "" {
+				raw = []byte(tc.patches)
+			}
+			ws.EXPECT().ReadCFNPatches(testSvcName).Return(raw, tc.mockWsError)
+			patches := &Patches{
+				wlName: testSvcName,
+				ws:     ws,
+			}
+
+			// WHEN
+			tpl, err := patches.Apply(testTemplate)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, tpl)
+			}
+		})
+	}
+}
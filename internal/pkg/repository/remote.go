@@ -0,0 +1,323 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// RemoteBuildPlatform is the only platform that images can be built for remotely.
+	RemoteBuildPlatform = "linux/amd64"
+
+	remoteBuildContextExpiry   = time.Hour
+	remoteBuildPollInterval    = 5 * time.Second
+	remoteBuildContextURLEnv   = "COPILOT_BUILD_CONTEXT_URL"
+	fmtRemoteBuildContextName  = "%s.tar.gz"
+	fmtRemoteBuildRepoNotFound = "image %s not found in repository %s after build %s"
+)
+
+// ArtifactUploader uploads files that can be downloaded with a presigned URL.
+type ArtifactUploader interface {
+	PutArtifactForDownload(bucket, fileName string, data io.Reader, expiry time.Duration) (string, error)
+}
+
+// ImageBuilder starts builds of a CodeBuild project and describes them.
+type ImageBuilder interface {
+	StartBuild(in codebuild.StartBuildInput) (*codebuild.Build, error)
+	Build(id string) (*codebuild.Build, error)
+}
+
+// RemoteRegistry gets information of repositories and of the images in them.
+type RemoteRegistry interface {
+	RepositoryURI(name string) (string, error)
+	ImageExists(repoName, tag string) (bool, error)
+}
+
+// LogEventsGetter gets the log events of a log stream.
+type LogEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// RemoteConfig holds the resources and clients used to build images remotely.
+type RemoteConfig struct {
+	Project  string // Name of the CodeBuild project that builds the images.
+	Bucket   string // S3 bucket the build context is uploaded to.
+	Uploader ArtifactUploader
+	Builder  ImageBuilder
+	Registry RemoteRegistry
+	Logs     LogEventsGetter
+	Out      io.Writer // Where the logs of the builds are written to.
+}
+
+// RemoteRepository builds images with a CodeBuild project instead of the local docker daemon,
+// and pushes them to a repository.
+type RemoteRepository struct {
+	name string
+	uri  string
+	RemoteConfig
+
+	pollInterval time.Duration
+}
+
+// NewRemote instantiates a new RemoteRepository.
+func NewRemote(name string, conf RemoteConfig) (*RemoteRepository, error) {
+	uri, err := conf.Registry.RepositoryURI(name)
+	if err != nil {
+		return nil, fmt.Errorf("get repository URI: %w", err)
+	}
+	return &RemoteRepository{
+		name:         name,
+		uri:          uri,
+		RemoteConfig: conf,
+		pollInterval: remoteBuildPollInterval,
+	}, nil
+}
+
+// BuildAndPush uploads the build context to S3, then builds the image from the Dockerfile and pushes it to the
+// repository with tags in a CodeBuild build while streaming its logs.
+// The docker client is unused since the image never touches the local docker daemon.
+func (r *RemoteRepository) BuildAndPush(_ ContainerLoginBuildPusher, args *docker.BuildArguments) error {
+	if args.URI == "" {
+		args.URI = r.uri
+	}
+	if args.Platform != "" && args.Platform != RemoteBuildPlatform {
+		return fmt.Errorf("platform %s can't be built remotely, only %s is supported", args.Platform, RemoteBuildPlatform)
+	}
+	contextDir, remoteArgs, err := remoteBuildArguments(args)
+	if err != nil {
+		return err
+	}
+	url, err := r.uploadContext(contextDir, args.ImageTag)
+	if err != nil {
+		return fmt.Errorf("upload build context %s: %w", contextDir, err)
+	}
+	spec, err := remoteBuildspec(remoteArgs)
+	if err != nil {
+		return err
+	}
+	build, err := r.Builder.StartBuild(codebuild.StartBuildInput{
+		Project:   r.Project,
+		Buildspec: spec,
+		EnvVars: map[string]string{
+			remoteBuildContextURLEnv: url,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
+	}
+	build, err = r.waitForBuild(build)
+	if err != nil {
+		return fmt.Errorf("wait for build %s: %w", build.ID, err)
+	}
+	if !build.IsSuccess() {
+		if build.LogsURL == "" {
+			return fmt.Errorf("build %s finished with status %s", build.ID, build.Status)
+		}
+		return fmt.Errorf("build %s finished with status %s, see the logs at %s", build.ID, build.Status, build.LogsURL)
+	}
+	for _, tag := range imageTags(args) {
+		exists, err := r.Registry.ImageExists(r.name, tag)
+		if err != nil {
+			return fmt.Errorf("verify image %s was pushed by build %s: %w", tag, build.ID, err)
+		}
+		if !exists {
+			return fmt.Errorf(fmtRemoteBuildRepoNotFound, tag, r.name, build.ID)
+		}
+	}
+	return nil
+}
+
+// URI returns the uri of the repository.
+func (r *RemoteRepository) URI() string {
+	return r.uri
+}
+
+// uploadContext archives the build context directory and uploads it, then returns the URL to download it from.
+func (r *RemoteRepository) uploadContext(dir, imageTag string) (string, error) {
+	pr, pw := io.Pipe()
+	defer pr.Close() // Unblocks the archiver if the upload fails.
+	go func() {
+		pw.CloseWithError(archiveDir(dir, pw))
+	}()
+	name := strings.ReplaceAll(r.name, "/", "-")
+	if imageTag != "" {
+		name = fmt.Sprintf("%s-%s", name, imageTag)
+	}
+	return r.Uploader.PutArtifactForDownload(r.Bucket, fmt.Sprintf(fmtRemoteBuildContextName, name), pr, remoteBuildContextExpiry)
+}
+
+// waitForBuild writes the logs of the build until it completes, and returns the completed build.
+func (r *RemoteRepository) waitForBuild(build *codebuild.Build) (*codebuild.Build, error) {
+	lastEventTime := make(map[string]int64)
+	for {
+		if build.LogGroupName != "" {
+			out, err := r.Logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+				LogGroup:            build.LogGroupName,
+				LogStreams:          []string{build.LogStreamName},
+				StreamLastEventTime: lastEventTime,
+			})
+			var errLogGroupNotFound *cloudwatchlogs.ErrLogGroupNotFound
+			var errNoLogStreams *cloudwatchlogs.ErrNoLogStreams
+			switch {
+			case errors.As(err, &errLogGroupNotFound), errors.As(err, &errNoLogStreams):
+				// The build hasn't written any logs yet.
+			case err != nil:
+				return build, fmt.Errorf("get logs: %w", err)
+			default:
+				for _, event := range out.Events {
+					fmt.Fprintln(r.Out, strings.TrimRight(event.Message, "\n"))
+				}
+				lastEventTime = out.StreamLastEventTime
+			}
+		}
+		if build.IsComplete() {
+			return build, nil
+		}
+		time.Sleep(r.pollInterval)
+		next, err := r.Builder.Build(build.ID)
+		if err != nil {
+			return build, err
+		}
+		build = next
+	}
+}
+
+// remoteBuildArguments returns the build context directory, and the arguments to build the image
+// from the root of the context once it's extracted in the build.
+func remoteBuildArguments(args *docker.BuildArguments) (string, *docker.BuildArguments, error) {
+	contextDir := args.Context
+	if contextDir == "" {
+		contextDir = filepath.Dir(args.Dockerfile)
+	}
+	dockerfile, err := filepath.Rel(contextDir, args.Dockerfile)
+	if err != nil || strings.HasPrefix(dockerfile, "..") {
+		return "", nil, fmt.Errorf("Dockerfile %s must be inside the build context %s to be built remotely", args.Dockerfile, contextDir)
+	}
+	remoteArgs := *args
+	remoteArgs.Context = "."
+	remoteArgs.Dockerfile = filepath.ToSlash(dockerfile)
+	return contextDir, &remoteArgs, nil
+}
+
+type buildspec struct {
+	Version string                    `yaml:"version"`
+	Phases  map[string]buildspecPhase `yaml:"phases"`
+}
+
+type buildspecPhase struct {
+	Commands []string `yaml:"commands"`
+}
+
+// remoteBuildspec returns the buildspec that downloads the build context, then builds and pushes the image.
+func remoteBuildspec(args *docker.BuildArguments) (string, error) {
+	registry := strings.SplitN(args.URI, "/", 2)[0]
+	// The registry is of the form "123456789012.dkr.ecr.us-west-2.amazonaws.com".
+	parts := strings.Split(registry, ".")
+	if len(parts) < 4 {
+		return "", fmt.Errorf("parse region of repository %s", args.URI)
+	}
+	region := parts[3]
+
+	build := []string{
+		shellCommand(append([]string{"docker"}, args.BuildCommandArgs()...)),
+	}
+	for _, tag := range imageTags(args) {
+		build = append(build, shellCommand([]string{"docker", "push", fmt.Sprintf("%s:%s", args.URI, tag)}))
+	}
+	out, err := yaml.Marshal(buildspec{
+		Version: "0.2",
+		Phases: map[string]buildspecPhase{
+			"pre_build": {
+				Commands: []string{
+					fmt.Sprintf(`curl -sSfL "$%s" | tar -xz`, remoteBuildContextURLEnv),
+					fmt.Sprintf("aws ecr get-login-password --region %s | docker login --username AWS --password-stdin %s", region, registry),
+				},
+			},
+			"build": {
+				Commands: build,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal buildspec: %w", err)
+	}
+	return string(out), nil
+}
+
+// imageTags returns the tags the image is pushed with.
+func imageTags(args *docker.BuildArguments) []string {
+	return append([]string{args.ImageTag}, args.AdditionalTags...)
+}
+
+// shellCommand joins the arguments into a command where each argument is single-quoted.
+func shellCommand(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// archiveDir writes the files under the directory to a gzipped tarball with paths relative to the directory.
+func archiveDir(dir string, w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("archive directory %s: %w", dir, err)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/repository/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type remoteRepositoryMocks struct {
+	uploader *mocks.MockArtifactUploader
+	builder  *mocks.MockImageBuilder
+	registry *mocks.MockRemoteRegistry
+	logs     *mocks.MockLogEventsGetter
+}
+
+func TestRemoteRepository_BuildAndPush(t *testing.T) {
+	const (
+		mockRepoURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/frontend"
+		mockURL     = "https://my-bucket.s3.amazonaws.com/frontend.tar.gz"
+	)
+	dir, err := ioutil.TempDir("", "remote-build")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "frontend"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "frontend", "Dockerfile"), []byte("FROM nginx"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("hello"), 0644))
+
+	successfulUpload := func(m remoteRepositoryMocks) {
+		m.uploader.EXPECT().PutArtifactForDownload("my-bucket", "my-app-frontend-v1.tar.gz", gomock.Any(), time.Hour).
+			DoAndReturn(func(_, _ string, data io.Reader, _ time.Duration) (string, error) {
+				_, err := ioutil.ReadAll(data)
+				return mockURL, err
+			})
+	}
+	testCases := map[string]struct {
+		inArgs docker.BuildArguments
+
+		setupMocks func(m remoteRepositoryMocks)
+
+		wantedErr  string
+		wantedLogs string
+	}{
+		"returns error if the platform can't be built remotely": {
+			inArgs: docker.BuildArguments{
+				Dockerfile: filepath.Join(dir, "frontend", "Dockerfile"),
+				ImageTag:   "v1",
+				Platform:   "linux/arm64",
+			},
+			setupMocks: func(m remoteRepositoryMocks) {},
+			wantedErr:  "platform linux/arm64 can't be built remotely, only linux/amd64 is supported",
+		},
+		"returns error if the Dockerfile is outside of the build context": {
+			inArgs: docker.BuildArguments{
+				Dockerfile: filepath.Join(dir, "Dockerfile"),
+				Context:    filepath.Join(dir, "frontend"),
+				ImageTag:   "v1",
+			},
+			setupMocks: func(m remoteRepositoryMocks) {},
+			wantedErr:  "Dockerfile " + filepath.Join(dir, "Dockerfile") + " must be inside the build context " + filepath.Join(dir, "frontend") + " to be built remotely",
+		},
+		"returns error if fail to upload the build context": {
+			inArgs: docker.BuildArguments{
+				Dockerfile: filepath.Join(dir, "frontend", "Dockerfile"),
+				ImageTag:   "v1",
+			},
+			setupMocks: func(m remoteRepositoryMocks) {
+				m.uploader.EXPECT().PutArtifactForDownload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "upload build context " + filepath.Join(dir, "frontend") + ": some error",
+		},
+		"returns error if fail to start the build": {
+			inArgs: docker.BuildArguments{
+				Dockerfile: filepath.Join(dir, "frontend", "Dockerfile"),
+				ImageTag:   "v1",
+			},
+			setupMocks: func(m remoteRepositoryMocks) {
+				successfulUpload(m)
+				m.builder.EXPECT().StartBuild(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "build Dockerfile at " + filepath.Join(dir, "frontend", "Dockerfile") + ": some error",
+		},
+		"returns error with the logs URL if the build fails": {
+			inArgs: docker.BuildArguments{
+				Dockerfile: filepath.Join(dir, "frontend", "Dockerfile"),
+				ImageTag:   "v1",
+			},
+			setupMocks: func(m remoteRepositoryMocks) {
+				successfulUpload(m)
+				m.builder.EXPECT().StartBuild(gomock.Any()).Return(&codebuild.Build{ID: "build:1", Status: "IN_PROGRESS"}, nil)
+				m.builder.EXPECT().Build("build:1").Return(&codebuild.Build{
+					ID:      "build:1",
+					Status:  "FAILED",
+					LogsURL: "https://console.aws.amazon.com/build-1",
+				}, nil)
+			},
+			wantedErr: "build build:1 finished with status FAILED, see the logs at https://console.aws.amazon.com/build-1",
+		},
+		"returns error if the image wasn't pushed": {
+			inArgs: docker.BuildArguments{
+				Dockerfile: filepath.Join(dir, "frontend", "Dockerfile"),
+				ImageTag:   "v1",
+			},
+			setupMocks: func(m remoteRepositoryMocks) {
+				successfulUpload(m)
+				m.builder.EXPECT().StartBuild(gomock.Any()).Return(&codebuild.Build{ID: "build:1", Status: "SUCCEEDED"}, nil)
+				m.registry.EXPECT().ImageExists("my-app/frontend", "v1").Return(false, nil)
+			},
+			wantedErr: "image v1 not found in repository my-app/frontend after build build:1",
+		},
+		"builds and pushes the image while writing the logs of the build": {
+			inArgs: docker.BuildArguments{
+				Dockerfile:     filepath.Join(dir, "frontend", "Dockerfile"),
+				Context:        dir,
+				ImageTag:       "v1",
+				AdditionalTags: []string{"latest"},
+			},
+			setupMocks: func(m remoteRepositoryMocks) {
+				m.uploader.EXPECT().PutArtifactForDownload("my-bucket", "my-app-frontend-v1.tar.gz", gomock.Any(), time.Hour).
+					DoAndReturn(func(_, _ string, data io.Reader, _ time.Duration) (string, error) {
+						gr, err := gzip.NewReader(data)
+						require.NoError(t, err)
+						tr := tar.NewReader(gr)
+						var files []string
+						for {
+							header, err := tr.Next()
+							if err == io.EOF {
+								break
+							}
+							require.NoError(t, err)
+							files = append(files, header.Name)
+						}
+						require.ElementsMatch(t, []string{"frontend", "frontend/Dockerfile", "index.html"}, files)
+						return mockURL, nil
+					})
+				m.builder.EXPECT().StartBuild(gomock.Any()).DoAndReturn(func(in codebuild.StartBuildInput) (*codebuild.Build, error) {
+					require.Equal(t, "my-project", in.Project)
+					require.Equal(t, map[string]string{"COPILOT_BUILD_CONTEXT_URL": mockURL}, in.EnvVars)
+					require.Contains(t, in.Buildspec, `curl -sSfL "$COPILOT_BUILD_CONTEXT_URL" | tar -xz`)
+					require.Contains(t, in.Buildspec, "aws ecr get-login-password --region us-west-2 | docker login --username AWS --password-stdin 123456789012.dkr.ecr.us-west-2.amazonaws.com")
+					require.Contains(t, in.Buildspec, `'docker' 'push' '`+mockRepoURI+`:latest'`)
+					return &codebuild.Build{ID: "build:1", Status: "IN_PROGRESS", LogGroupName: "/aws/codebuild/my-project", LogStreamName: "1"}, nil
+				})
+				m.logs.EXPECT().LogEvents(gomock.Any()).Return(nil, &cloudwatchlogs.ErrNoLogStreams{})
+				m.builder.EXPECT().Build("build:1").Return(&codebuild.Build{ID: "build:1", Status: "SUCCEEDED", LogGroupName: "/aws/codebuild/my-project", LogStreamName: "1"}, nil)
+				m.logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:            "/aws/codebuild/my-project",
+					LogStreams:          []string{"1"},
+					StreamLastEventTime: map[string]int64{},
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{Message: "Step 1/1 : FROM nginx\n"},
+						{Message: "Successfully built"},
+					},
+				}, nil)
+				m.registry.EXPECT().ImageExists("my-app/frontend", "v1").Return(true, nil)
+				m.registry.EXPECT().ImageExists("my-app/frontend", "latest").Return(true, nil)
+			},
+			wantedLogs: "Step 1/1 : FROM nginx\nSuccessfully built\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := remoteRepositoryMocks{
+				uploader: mocks.NewMockArtifactUploader(ctrl),
+				builder:  mocks.NewMockImageBuilder(ctrl),
+				registry: mocks.NewMockRemoteRegistry(ctrl),
+				logs:     mocks.NewMockLogEventsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			out := new(bytes.Buffer)
+			repo := &RemoteRepository{
+				name: "my-app/frontend",
+				uri:  mockRepoURI,
+				RemoteConfig: RemoteConfig{
+					Project:  "my-project",
+					Bucket:   "my-bucket",
+					Uploader: m.uploader,
+					Builder:  m.builder,
+					Registry: m.registry,
+					Logs:     m.logs,
+					Out:      out,
+				},
+			}
+
+			// WHEN
+			err := repo.BuildAndPush(nil, &tc.inArgs)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLogs, out.String())
+		})
+	}
+}
@@ -36,6 +36,24 @@ type NetworkConfigRunner struct {
 // Run runs tasks in the subnets and the security groups, and returns the tasks.
 // If subnets are not provided, it uses the default subnets.
 func (r *NetworkConfigRunner) Run() ([]*Task, error) {
+	input, err := r.Input()
+	if err != nil {
+		return nil, err
+	}
+	ecsTasks, err := r.Starter.RunTask(*input)
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.GroupName,
+			parentErr: err,
+		}
+	}
+
+	return convertECSTasks(ecsTasks), nil
+}
+
+// Input resolves the default cluster and, if subnets are not provided, the default subnets,
+// and returns the input that Run starts the tasks with.
+func (r *NetworkConfigRunner) Input() (*ecs.RunTaskInput, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -59,22 +77,14 @@ func (r *NetworkConfigRunner) Run() ([]*Task, error) {
 		r.Subnets = subnets
 	}
 
-	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
+	return &ecs.RunTaskInput{
 		Cluster:        cluster,
 		Count:          r.Count,
 		Subnets:        r.Subnets,
 		SecurityGroups: r.SecurityGroups,
 		TaskFamilyName: taskFamilyName(r.GroupName),
 		StartedBy:      startedBy,
-	})
-	if err != nil {
-		return nil, &errRunTask{
-			groupName: r.GroupName,
-			parentErr: err,
-		}
-	}
-
-	return convertECSTasks(ecsTasks), nil
+	}, nil
 }
 
 func (r *NetworkConfigRunner) validateDependencies() error {
@@ -187,3 +187,48 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkConfigRunner_Input(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVPCGetter := mocks.NewMockVPCGetter(ctrl)
+	mockClusterGetter := mocks.NewMockDefaultClusterGetter(ctrl)
+	mockStarter := mocks.NewMockRunner(ctrl)
+	mockClusterGetter.EXPECT().DefaultCluster().Return("cluster-1", nil).Times(2)
+	mockVPCGetter.EXPECT().SubnetIDs(ec2.FilterForDefaultVPCSubnets).Return([]string{"default-subnet-1"}, nil)
+	var started ecs.RunTaskInput
+	mockStarter.EXPECT().RunTask(gomock.Any()).DoAndReturn(func(input ecs.RunTaskInput) ([]*ecs.Task, error) {
+		started = input
+		return nil, nil
+	})
+
+	task := &NetworkConfigRunner{
+		Count:     1,
+		GroupName: "my-task",
+
+		SecurityGroups: []string{"sg-1"},
+
+		VPCGetter:     mockVPCGetter,
+		ClusterGetter: mockClusterGetter,
+		Starter:       mockStarter,
+	}
+
+	// WHEN
+	input, err := task.Input()
+	require.NoError(t, err)
+	_, err = task.Run()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, &ecs.RunTaskInput{
+		Cluster:        "cluster-1",
+		Count:          1,
+		Subnets:        []string{"default-subnet-1"},
+		SecurityGroups: []string{"sg-1"},
+		TaskFamilyName: taskFamilyName("my-task"),
+		StartedBy:      startedBy,
+	}, input)
+	require.Equal(t, started, *input, "the generated input must be the one that the tasks are run with")
+}
@@ -33,6 +33,9 @@ type EnvRunner struct {
 	App string
 	Env string
 
+	// Optional. Security groups imported with the environment's VPC, attached in addition to the environment's.
+	AdditionalSecurityGroups []string
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	VPCGetter     VPCGetter
 	ClusterGetter ClusterGetter
@@ -41,6 +44,23 @@ type EnvRunner struct {
 
 // Run runs tasks in the environment of the application, and returns the tasks.
 func (r *EnvRunner) Run() ([]*Task, error) {
+	input, err := r.Input()
+	if err != nil {
+		return nil, err
+	}
+	ecsTasks, err := r.Starter.RunTask(*input)
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.GroupName,
+			parentErr: err,
+		}
+	}
+	return convertECSTasks(ecsTasks), nil
+}
+
+// Input resolves the cluster and the network configuration of the environment, and returns
+// the input that Run starts the tasks with.
+func (r *EnvRunner) Input() (*ecs.RunTaskInput, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -65,21 +85,14 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		return nil, fmt.Errorf(fmtErrSecurityGroupsFromEnv, r.Env, err)
 	}
 
-	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
+	return &ecs.RunTaskInput{
 		Cluster:        cluster,
 		Count:          r.Count,
 		Subnets:        subnets,
-		SecurityGroups: securityGroups,
+		SecurityGroups: append(securityGroups, r.AdditionalSecurityGroups...),
 		TaskFamilyName: taskFamilyName(r.GroupName),
 		StartedBy:      startedBy,
-	})
-	if err != nil {
-		return nil, &errRunTask{
-			groupName: r.GroupName,
-			parentErr: err,
-		}
-	}
-	return convertECSTasks(ecsTasks), nil
+	}, nil
 }
 
 func (r *EnvRunner) filtersForVPCFromAppEnv() []ec2.Filter {
@@ -43,8 +43,9 @@ func TestEnvRunner_Run(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		count     int
-		groupName string
+		count                    int
+		groupName                string
+		additionalSecurityGroups []string
 
 		MockVPCGetter     func(m *mocks.MockVPCGetter)
 		MockClusterGetter func(m *mocks.MockClusterGetter)
@@ -145,6 +146,36 @@ func TestEnvRunner_Run(t *testing.T) {
 				},
 			},
 		},
+		"run in env with the security groups imported with the VPC": {
+			count:                    1,
+			groupName:                "my-task",
+			additionalSecurityGroups: []string{"sg-imported"},
+
+			MockClusterGetter: MockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().PublicSubnetIDs(filtersForVPCFromAppEnv).Return([]string{"subnet-1", "subnet-2"}, nil)
+				m.EXPECT().SecurityGroups(filtersForVPCFromAppEnv).Return([]string{"sg-1"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "cluster-1",
+					Count:          1,
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1", "sg-imported"},
+					TaskFamilyName: taskFamilyName("my-task"),
+					StartedBy:      startedBy,
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -166,6 +197,8 @@ func TestEnvRunner_Run(t *testing.T) {
 				App: inApp,
 				Env: inEnv,
 
+				AdditionalSecurityGroups: tc.additionalSecurityGroups,
+
 				VPCGetter:     MockVPCGetter,
 				ClusterGetter: MockClusterGetter,
 				Starter:       mockStarter,
@@ -181,3 +214,50 @@ func TestEnvRunner_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvRunner_Input(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVPCGetter := mocks.NewMockVPCGetter(ctrl)
+	mockClusterGetter := mocks.NewMockClusterGetter(ctrl)
+	mockStarter := mocks.NewMockRunner(ctrl)
+	mockClusterGetter.EXPECT().Cluster("my-app", "my-env").Return("cluster-1", nil).Times(2)
+	mockVPCGetter.EXPECT().PublicSubnetIDs(gomock.Any()).Return([]string{"subnet-1", "subnet-2"}, nil).Times(2)
+	mockVPCGetter.EXPECT().SecurityGroups(gomock.Any()).Return([]string{"sg-1", "sg-2"}, nil).Times(2)
+	var started ecs.RunTaskInput
+	mockStarter.EXPECT().RunTask(gomock.Any()).DoAndReturn(func(input ecs.RunTaskInput) ([]*ecs.Task, error) {
+		started = input
+		return nil, nil
+	})
+
+	task := &EnvRunner{
+		Count:     2,
+		GroupName: "my-task",
+
+		App: "my-app",
+		Env: "my-env",
+
+		VPCGetter:     mockVPCGetter,
+		ClusterGetter: mockClusterGetter,
+		Starter:       mockStarter,
+	}
+
+	// WHEN
+	input, err := task.Input()
+	require.NoError(t, err)
+	_, err = task.Run()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, &ecs.RunTaskInput{
+		Cluster:        "cluster-1",
+		Count:          2,
+		Subnets:        []string{"subnet-1", "subnet-2"},
+		SecurityGroups: []string{"sg-1", "sg-2"},
+		TaskFamilyName: taskFamilyName("my-task"),
+		StartedBy:      startedBy,
+	}, input)
+	require.Equal(t, started, *input, "the generated input must be the one that the tasks are run with")
+}
@@ -204,3 +204,56 @@ func (mr *MockRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockRunner)(nil).RunTask), input)
 }
+
+// MockStoppedTasksWaiter is a mock of StoppedTasksWaiter interface
+type MockStoppedTasksWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoppedTasksWaiterMockRecorder
+}
+
+// MockStoppedTasksWaiterMockRecorder is the mock recorder for MockStoppedTasksWaiter
+type MockStoppedTasksWaiterMockRecorder struct {
+	mock *MockStoppedTasksWaiter
+}
+
+// NewMockStoppedTasksWaiter creates a new mock instance
+func NewMockStoppedTasksWaiter(ctrl *gomock.Controller) *MockStoppedTasksWaiter {
+	mock := &MockStoppedTasksWaiter{ctrl: ctrl}
+	mock.recorder = &MockStoppedTasksWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStoppedTasksWaiter) EXPECT() *MockStoppedTasksWaiterMockRecorder {
+	return m.recorder
+}
+
+// TaskDefinition mocks base method
+func (m *MockStoppedTasksWaiter) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MockStoppedTasksWaiterMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockStoppedTasksWaiter)(nil).TaskDefinition), taskDefName)
+}
+
+// WaitUntilTasksStopped mocks base method
+func (m *MockStoppedTasksWaiter) WaitUntilTasksStopped(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilTasksStopped", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitUntilTasksStopped indicates an expected call of WaitUntilTasksStopped
+func (mr *MockStoppedTasksWaiterMockRecorder) WaitUntilTasksStopped(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTasksStopped", reflect.TypeOf((*MockStoppedTasksWaiter)(nil).WaitUntilTasksStopped), cluster, taskARNs)
+}
@@ -35,11 +35,22 @@ type Runner interface {
 	RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error)
 }
 
+// StoppedTasksWaiter wraps the methods of waiting for tasks to stop and getting their task definition.
+type StoppedTasksWaiter interface {
+	WaitUntilTasksStopped(cluster string, taskARNs []string) ([]*ecs.Task, error)
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+}
+
 // Task represents a one-off workload that runs until completed or an error occurs.
 type Task struct {
 	TaskARN    string
 	ClusterARN string
 	StartedAt  *time.Time
+
+	// Fields below are only set once the task is stopped.
+	StoppedReason string
+	ExitCode      *int            // Exit code of the task's essential container, nil if the container never exited.
+	Status        *ecs.TaskStatus // Final status of the task.
 }
 
 const (
@@ -69,3 +80,69 @@ func convertECSTasks(ecsTasks []*ecs.Task) []*Task {
 	}
 	return tasks
 }
+
+// WaitUntilStopped waits until the tasks, which run in the same cluster, are stopped and returns them along with
+// their stopped reason, final status and the exit code of their essential container.
+func WaitUntilStopped(waiter StoppedTasksWaiter, tasks []*Task) ([]*Task, error) {
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	taskARNs := make([]string, len(tasks))
+	for idx, task := range tasks {
+		taskARNs[idx] = task.TaskARN
+	}
+	ecsTasks, err := waiter.WaitUntilTasksStopped(tasks[0].ClusterARN, taskARNs)
+	if err != nil {
+		return nil, err
+	}
+	essentialContainers := make(map[string]map[string]bool) // Names of the essential containers by task definition ARN.
+	stopped := make([]*Task, len(ecsTasks))
+	for idx, ecsTask := range ecsTasks {
+		taskDefARN := aws.StringValue(ecsTask.TaskDefinitionArn)
+		if _, ok := essentialContainers[taskDefARN]; !ok {
+			taskDef, err := waiter.TaskDefinition(taskDefARN)
+			if err != nil {
+				return nil, fmt.Errorf("get task definition %s: %w", taskDefARN, err)
+			}
+			essentialContainers[taskDefARN] = essentialContainerNames(taskDef)
+		}
+		status, err := ecsTask.TaskStatus()
+		if err != nil {
+			return nil, err
+		}
+		task := newTaskFromECS(ecsTask)
+		task.StoppedReason = aws.StringValue(ecsTask.StoppedReason)
+		task.ExitCode = essentialContainerExitCode(ecsTask, essentialContainers[taskDefARN])
+		task.Status = status
+		stopped[idx] = task
+	}
+	return stopped, nil
+}
+
+// essentialContainerNames returns the names of the containers in the task definition that are essential,
+// which is the default unless a container is explicitly marked as non-essential.
+func essentialContainerNames(taskDef *ecs.TaskDefinition) map[string]bool {
+	names := make(map[string]bool)
+	for _, container := range taskDef.ContainerDefinitions {
+		if container.Essential == nil || aws.BoolValue(container.Essential) {
+			names[aws.StringValue(container.Name)] = true
+		}
+	}
+	return names
+}
+
+// essentialContainerExitCode returns the exit code of the task's essential container.
+// If there are multiple essential containers, a non-zero exit code takes precedence as it's the one that stopped the task.
+func essentialContainerExitCode(ecsTask *ecs.Task, essential map[string]bool) *int {
+	var exitCode *int
+	for _, container := range ecsTask.Containers {
+		if !essential[aws.StringValue(container.Name)] || container.ExitCode == nil {
+			continue
+		}
+		code := int(aws.Int64Value(container.ExitCode))
+		if exitCode == nil || *exitCode == 0 {
+			exitCode = &code
+		}
+	}
+	return exitCode
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitUntilStopped(t *testing.T) {
+	const (
+		taskARN    = "arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"
+		clusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/my-cluster"
+		taskDefARN = "arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-my-task:1"
+	)
+	inTasks := []*Task{
+		{
+			TaskARN:    taskARN,
+			ClusterARN: clusterARN,
+		},
+	}
+	stoppedTask := func(containers ...*awsecs.Container) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:           aws.String(taskARN),
+			ClusterArn:        aws.String(clusterARN),
+			TaskDefinitionArn: aws.String(taskDefARN),
+			LastStatus:        aws.String("STOPPED"),
+			StoppedReason:     aws.String("Essential container in task exited"),
+			Containers:        containers,
+		}
+	}
+	taskDef := &ecs.TaskDefinition{
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			{
+				Name: aws.String("my-task"),
+			},
+			{
+				Name:      aws.String("sidecar"),
+				Essential: aws.Bool(false),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		mockWaiter func(m *mocks.MockStoppedTasksWaiter)
+
+		wantedExitCode      *int
+		wantedStoppedReason string
+		wantedError         error
+	}{
+		"error waiting for the tasks to stop": {
+			mockWaiter: func(m *mocks.MockStoppedTasksWaiter) {
+				m.EXPECT().WaitUntilTasksStopped(clusterARN, []string{taskARN}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error getting the task definition": {
+			mockWaiter: func(m *mocks.MockStoppedTasksWaiter) {
+				m.EXPECT().WaitUntilTasksStopped(clusterARN, []string{taskARN}).Return([]*ecs.Task{stoppedTask()}, nil)
+				m.EXPECT().TaskDefinition(taskDefARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get task definition arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-my-task:1: some error"),
+		},
+		"returns the exit code of the essential container": {
+			mockWaiter: func(m *mocks.MockStoppedTasksWaiter) {
+				m.EXPECT().WaitUntilTasksStopped(clusterARN, []string{taskARN}).Return([]*ecs.Task{
+					stoppedTask(
+						&awsecs.Container{
+							Name:     aws.String("sidecar"),
+							ExitCode: aws.Int64(137),
+						},
+						&awsecs.Container{
+							Name:     aws.String("my-task"),
+							ExitCode: aws.Int64(2),
+						},
+					),
+				}, nil)
+				m.EXPECT().TaskDefinition(taskDefARN).Return(taskDef, nil)
+			},
+			wantedExitCode:      aws.Int(2),
+			wantedStoppedReason: "Essential container in task exited",
+		},
+		"no exit code if the essential container never exited": {
+			mockWaiter: func(m *mocks.MockStoppedTasksWaiter) {
+				m.EXPECT().WaitUntilTasksStopped(clusterARN, []string{taskARN}).Return([]*ecs.Task{
+					stoppedTask(&awsecs.Container{
+						Name: aws.String("my-task"),
+					}),
+				}, nil)
+				m.EXPECT().TaskDefinition(taskDefARN).Return(taskDef, nil)
+			},
+			wantedStoppedReason: "Essential container in task exited",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockStoppedTasksWaiter(ctrl)
+			tc.mockWaiter(m)
+
+			tasks, err := WaitUntilStopped(m, inTasks)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, tasks, 1)
+			require.Equal(t, taskARN, tasks[0].TaskARN)
+			require.Equal(t, tc.wantedExitCode, tasks[0].ExitCode)
+			require.Equal(t, tc.wantedStoppedReason, tasks[0].StoppedReason)
+			require.Equal(t, "STOPPED", tasks[0].Status.LastStatus)
+		})
+	}
+}
@@ -24,6 +24,8 @@ var (
 		"environment-manager-role",
 		"lambdas",
 		"vpc-resources",
+		"vpc-ipv6-resources",
+		"access-logs",
 	}
 )
 
@@ -35,8 +37,14 @@ type EnvOpts struct {
 	ACMValidationLambda       string
 	EnableLongARNFormatLambda string
 
-	ImportVPC *config.ImportVPC
-	VPCConfig *config.AdjustVPC
+	ImportVPC  *config.ImportVPC
+	VPCConfig  *config.AdjustVPC
+	EnableIPv6 bool               // Provisions IPv6 CIDR blocks for the VPC and subnets and a dualstack load balancer.
+	AccessLogs *config.AccessLogs // Stores the access logs of the load balancer in S3, in a new bucket if no bucket name is set.
+
+	ImportCertARNs []string // Creates an HTTPS listener with existing ACM certificates, the first one is the default certificate.
+
+	PermissionsBoundary string // ARN of the IAM policy set as the permissions boundary of the environment's roles, if not empty.
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
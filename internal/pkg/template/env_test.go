@@ -34,6 +34,8 @@ func TestTemplate_ParseEnv(t *testing.T) {
   environment-manager-role
   lambdas
   vpc-resources
+  vpc-ipv6-resources
+  access-logs
 `,
 		},
 		"renders v1.0.0 template": {
@@ -58,6 +60,8 @@ func TestTemplate_ParseEnv(t *testing.T) {
 			tpl.box.AddString("environment/partials/environment-manager-role.yml", "environment-manager-role")
 			tpl.box.AddString("environment/partials/lambdas.yml", "lambdas")
 			tpl.box.AddString("environment/partials/vpc-resources.yml", "vpc-resources")
+			tpl.box.AddString("environment/partials/vpc-ipv6-resources.yml", "vpc-ipv6-resources")
+			tpl.box.AddString("environment/partials/access-logs.yml", "access-logs")
 
 			// WHEN
 			c, err := tpl.ParseEnv(&EnvOpts{
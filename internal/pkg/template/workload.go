@@ -36,12 +36,16 @@ var (
 		"servicediscovery",
 		"addons",
 		"sidecars",
+		"container-resources",
 		"logconfig",
 		"autoscaling",
+		"alarms",
 		"eventrule",
 		"state-machine",
 		"state-machine-definition.json",
 		"env-controller",
+		"messaging",
+		"messaging-outputs",
 	}
 )
 
@@ -56,18 +60,50 @@ const (
 type WorkloadNestedStackOpts struct {
 	StackName string
 
-	VariableOutputs []string
-	SecretOutputs   []string
-	PolicyOutputs   []string
+	VariableOutputs      []string
+	SecretOutputs        []string
+	PolicyOutputs        []string
+	SecurityGroupOutputs []string // Security groups attached to the service's tasks in addition to the environment's.
 }
 
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
-	Name       *string
-	Image      *string
-	Port       *string
-	Protocol   *string
-	CredsParam *string
+	Name         *string
+	Image        *string
+	Port         *string
+	Protocol     *string
+	CredsParam   *string
+	Essential    *bool // ECS defaults to true if nil.
+	Resources    *ContainerResourcesOpts
+	DockerLabels map[string]string
+	DependsOn    []*ContainerDependencyOpts
+}
+
+// ContainerDependencyOpts holds a container of the task and the condition it must reach before the dependent container starts.
+type ContainerDependencyOpts struct {
+	Name      string
+	Condition string // START, COMPLETE, SUCCESS or HEALTHY.
+}
+
+// ContainerResourcesOpts holds the container-level resource configuration of a container in the task.
+type ContainerResourcesOpts struct {
+	CPU               *int
+	Memory            *int
+	MemoryReservation *int
+	Ulimits           []*UlimitOpts
+}
+
+// RuntimePlatformOpts holds the operating system family and CPU architecture that the tasks run on.
+type RuntimePlatformOpts struct {
+	OS   string
+	Arch string
+}
+
+// UlimitOpts holds the soft and hard limits of a ulimit, like "nofile", set on a container.
+type UlimitOpts struct {
+	Name      string
+	SoftLimit int
+	HardLimit int
 }
 
 // LogConfigOpts holds configuration that's needed if the service is configured with Firelens to route
@@ -87,6 +123,25 @@ type HTTPHealthCheckOpts struct {
 	UnhealthyThreshold *int64
 	Interval           *int64
 	Timeout            *int64
+	GracePeriod        *int64
+	SuccessCodes       *string // HTTP codes, or gRPC codes if the protocol version is GRPC, of a healthy target.
+}
+
+// AdditionalRoutingRuleOpts holds configuration for a listener rule and target group that route requests
+// to a port of the main container. The path of the i-th rule is the i-th element of the "AdditionalRulePaths" parameter.
+type AdditionalRoutingRuleOpts struct {
+	TargetPort      uint16
+	HTTPHealthCheck HTTPHealthCheckOpts
+}
+
+// AliasesOpts holds configuration for the custom domain names that route requests to a load balanced web service.
+// A certificate is requested for the names, and their records are added to the hosted zone of the environment
+// if they're under the environment's domain, or otherwise to the hosted zone of the application.
+// If the environment imported its certificates, only the names are set and their records are managed outside of Copilot.
+type AliasesOpts struct {
+	Names                []string
+	AppDNSName           string // Domain name that the application was created with, such as "example.com".
+	AppDNSDelegationRole string // ARN of the role to assume to update the records of the application's hosted zone.
 }
 
 // AutoscalingOpts holds configuration that's needed for Auto Scaling.
@@ -99,33 +154,151 @@ type AutoscalingOpts struct {
 	ResponseTime *float64
 }
 
+// CapacityProviderStrategyOpts holds the configuration of a capacity provider that the tasks of a service are placed on.
+type CapacityProviderStrategyOpts struct {
+	CapacityProvider string
+	Base             *int
+	Weight           *int
+}
+
+// AlarmsOpts holds the thresholds of the CloudWatch alarms created with a service. An alarm isn't created if its threshold is nil.
+type AlarmsOpts struct {
+	CPU        *int     // Average CPU utilization in percent.
+	Memory     *int     // Average memory utilization in percent.
+	HTTP5xx    *int     // Percentage of the requests to the target group answered with a 5xx code.
+	P99Latency *float64 // 99th percentile of the response time of the targets, in seconds.
+}
+
+// DeploymentConfigurationOpts holds configuration for the rolling deployments of a service.
+type DeploymentConfigurationOpts struct {
+	MinHealthyPercent int
+	MaxPercent        int
+	RollbackAlarms    []string // Names of CloudWatch alarms that roll back the deployment when in ALARM.
+}
+
+// ServiceConnectOpts holds configuration to register a service in the environment's ECS Service Connect namespace.
+type ServiceConnectOpts struct {
+	NamespaceExport string // Export name of the ARN of the environment's Cloud Map namespace.
+	PortName        string // Name of the port mapping of the main container that clients connect to.
+}
+
+// MessagingOpts holds the SNS topics that a workload publishes to and the SQS queues that it receives messages from.
+type MessagingOpts struct {
+	Topics []*TopicOpts // SNS topics created with the workload.
+	Queues []*QueueOpts // SQS queues created with the workload.
+}
+
+// TopicOpts holds an SNS topic created with the workload.
+type TopicOpts struct {
+	Name       string // Name of the topic in the manifest, unique within the application.
+	ExportName string // Name of the CloudFormation export of the topic's ARN, imported by the subscribers in other workloads.
+}
+
+// QueueOpts holds an SQS queue created with the workload and the SNS topics that it's subscribed to.
+type QueueOpts struct {
+	Name   string
+	Topics []*SubscribedTopicOpts
+}
+
+// SubscribedTopicOpts holds an SNS topic that a queue is subscribed to.
+type SubscribedTopicOpts struct {
+	Name       string // Name of the topic in the manifest of its publisher.
+	ImportName string // Name of the export of the topic's ARN if it's published by another workload, empty if it's published by the workload itself.
+}
+
+// ObservabilityOpts holds the observability features of a service.
+type ObservabilityOpts struct {
+	Tracing string // Vendor that traces the requests, "AWSXRAY" for the X-Ray daemon sidecar.
+}
+
 // StateMachineOpts holds configuration neeed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
 	Retries *int
 }
 
+// Variable holds the value of an environment variable of the main container.
+type Variable struct {
+	Value      string // Literal value of the variable.
+	ImportName string // Name of the CloudFormation export that the variable is set to instead of Value, if not empty.
+}
+
 // WorkloadOpts holds optional data that can be provided to enable features in a workload stack template.
 type WorkloadOpts struct {
 	// Additional options that are common between **all** workload templates.
-	Variables   map[string]string
-	Secrets     map[string]string
-	NestedStack *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
-	Sidecars    []*SidecarOpts
-	LogConfig   *LogConfigOpts
-	Autoscaling *AutoscalingOpts
+	Variables    map[string]Variable
+	Secrets      map[string]string
+	NestedStack  *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
+	Sidecars     []*SidecarOpts
+	LogConfig    *LogConfigOpts
+	LogGroupName *string // Overrides the default name of the workload's log group.
+	Autoscaling  *AutoscalingOpts
+
+	// Security groups attached to the tasks in addition to the environment's security group.
+	SecurityGroups []string
+
+	// ARN of the ECR repository that the execution role pulls the images from if it's in another account than the environment.
+	CrossAccountRepoARN string
+
+	// ARN of the IAM policy set as the permissions boundary of every role of the workload. No boundary is set if empty.
+	PermissionsBoundary string
+
+	// Capacity providers that the tasks are placed on. The tasks are launched on Fargate if empty.
+	CapacityProviders []*CapacityProviderStrategyOpts
+
+	// Container-level resources of the main container.
+	ContainerResources *ContainerResourcesOpts
+
+	// Docker labels of the main container.
+	DockerLabels map[string]string
+
+	// Containers that must reach a condition before the main container starts.
+	DependsOn []*ContainerDependencyOpts
+
+	// Tags of the ECS service of a service, propagated to its tasks.
+	ServiceTags map[string]string
+
+	// Operating system family and CPU architecture of the tasks. Fargate defaults to Linux on X86_64 if empty.
+	RuntimePlatform *RuntimePlatformOpts
+
+	// Rolling deployment limits and rollback alarms of a service. Enables the deployment circuit breaker if set.
+	DeploymentConfig *DeploymentConfigurationOpts
+
+	// CloudWatch alarms created from the thresholds of a service's manifest. Nil if the service has none.
+	Alarms *AlarmsOpts
+
+	// ECS Service Connect configuration of a service. Service Connect is disabled if nil.
+	ServiceConnect *ServiceConnectOpts
+
+	// SNS topics and SQS queues of the workload, and the task role statements to use them. Nil if the workload has none.
+	Messaging *MessagingOpts
+
+	// Tracing configuration of a service, the X-Ray daemon itself is one of the Sidecars. Nil if tracing is disabled.
+	Observability *ObservabilityOpts
 
 	// Additional options for service templates.
 	HealthCheck         *ecs.HealthCheck
 	HTTPHealthCheck     HTTPHealthCheckOpts
+	HTTPVersion         string // Protocol version of the target groups: GRPC, HTTP2 or HTTP1. ELB defaults to HTTP1 if empty.
+	EnableIPv6          bool   // Registers targets with their IPv6 addresses if the environment supports IPv6.
+	EnableExec          bool   // Lets commands run in the service's containers with ECS Exec.
 	AllowedSourceIps    []string
+	DeregistrationDelay *int64
+	AdditionalPorts     []uint16 // Ports exposed by the main container in addition to the service's port.
+	AdditionalRules     []AdditionalRoutingRuleOpts
+	Aliases             *AliasesOpts // Custom domain names of the service, only set if the environment has an HTTPS listener.
+	HTTPSImportedCerts  bool         // True if the HTTPS listener of the environment uses imported certificates instead of the application's domain.
 	RulePriorityLambda  string
 	DesiredCountLambda  string
 	EnvControllerLambda string
+	ACMValidationLambda string
+	CustomDomainLambda  string
 
 	// Additional options for job templates.
 	ScheduleExpression string
+	EventPattern       string // EventBridge event pattern in JSON that triggers the job instead of the schedule, if not empty.
 	StateMachine       *StateMachineOpts
+	ScheduleDisabled   bool // True if the rule that triggers the job is created disabled.
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
@@ -179,11 +352,12 @@ func (t *Template) parseWkld(name, wkldDirName string, data interface{}, options
 func withSvcParsingFuncs() ParseOption {
 	return func(t *template.Template) *template.Template {
 		return t.Funcs(map[string]interface{}{
-			"toSnakeCase": ToSnakeCaseFunc,
-			"hasSecrets":  hasSecrets,
-			"fmtSlice":    FmtSliceFunc,
-			"quoteSlice":  QuotePSliceFunc,
-			"randomUUID":  randomUUIDFunc,
+			"toSnakeCase":   ToSnakeCaseFunc,
+			"hasSecrets":    hasSecrets,
+			"fmtSlice":      FmtSliceFunc,
+			"quoteSlice":    QuotePSliceFunc,
+			"randomUUID":    randomUUIDFunc,
+			"logicalIDSafe": ReplaceDashesFunc,
 		})
 	}
 }
@@ -38,12 +38,16 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("workloads/common/cf/servicediscovery.yml", "servicediscovery")
 				mockBox.AddString("workloads/common/cf/addons.yml", "addons")
 				mockBox.AddString("workloads/common/cf/sidecars.yml", "sidecars")
+				mockBox.AddString("workloads/common/cf/container-resources.yml", "container-resources")
 				mockBox.AddString("workloads/common/cf/logconfig.yml", "logconfig")
 				mockBox.AddString("workloads/common/cf/autoscaling.yml", "autoscaling")
+				mockBox.AddString("workloads/common/cf/alarms.yml", "alarms")
 				mockBox.AddString("workloads/common/cf/state-machine-definition.json.yml", "state-machine-definition")
 				mockBox.AddString("workloads/common/cf/eventrule.yml", "eventrule")
 				mockBox.AddString("workloads/common/cf/state-machine.yml", "state-machine")
 				mockBox.AddString("workloads/common/cf/env-controller.yml", "env-controller")
+				mockBox.AddString("workloads/common/cf/messaging.yml", "messaging")
+				mockBox.AddString("workloads/common/cf/messaging-outputs.yml", "messaging-outputs")
 
 				t.box = mockBox
 			},
@@ -57,12 +61,16 @@ func TestTemplate_ParseSvc(t *testing.T) {
   servicediscovery
   addons
   sidecars
+  container-resources
   logconfig
   autoscaling
+  alarms
   eventrule
   state-machine
   state-machine-definition
   env-controller
+  messaging
+  messaging-outputs
 `,
 		},
 	}
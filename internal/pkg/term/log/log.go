@@ -6,6 +6,8 @@ package log
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 
 	"github.com/fatih/color"
 )
@@ -29,22 +31,44 @@ const (
 	warningPrefix = "Note:"
 )
 
+// quiet is true if every message but errors is suppressed.
+var quiet bool
+
+// SetQuiet suppresses the success, warning, info and debug messages if quiet is true so that only errors are written.
+// Data written directly to OutputWriter, such as JSON documents, is unaffected.
+func SetQuiet(q bool) {
+	quiet = q
+}
+
+// IsQuiet returns true if every message but errors is suppressed.
+func IsQuiet() bool {
+	return quiet
+}
+
+// messageWriter returns the writer for messages that aren't errors.
+func messageWriter() io.Writer {
+	if quiet {
+		return ioutil.Discard
+	}
+	return DiagnosticWriter
+}
+
 // Success prefixes the message with a green "✔ Success!", and writes to standard error.
 func Success(args ...interface{}) {
 	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), fmt.Sprint(args...))
-	fmt.Fprint(DiagnosticWriter, msg)
+	fmt.Fprint(messageWriter(), msg)
 }
 
 // Successln prefixes the message with a green "✔ Success!", and writes to standard error with a new line.
 func Successln(args ...interface{}) {
 	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), fmt.Sprint(args...))
-	fmt.Fprintln(DiagnosticWriter, msg)
+	fmt.Fprintln(messageWriter(), msg)
 }
 
 // Successf formats according to the specifier, prefixes the message with a green "✔ Success!", and writes to standard error.
 func Successf(format string, args ...interface{}) {
 	wrappedFormat := fmt.Sprintf("%s %s", successSprintf(successPrefix), format)
-	fmt.Fprintf(DiagnosticWriter, wrappedFormat, args...)
+	fmt.Fprintf(messageWriter(), wrappedFormat, args...)
 }
 
 // Ssuccess prefixes the message with a green "✔ Success!", and returns it.
@@ -102,47 +126,47 @@ func Serrorf(format string, args ...interface{}) string {
 // Warning prefixes the message with a "Note:", colors the *entire* message in yellow, writes to standard error.
 func Warning(args ...interface{}) {
 	msg := fmt.Sprint(args...)
-	fmt.Fprint(DiagnosticWriter, warningSprintf(fmt.Sprintf("%s %s", warningPrefix, msg)))
+	fmt.Fprint(messageWriter(), warningSprintf(fmt.Sprintf("%s %s", warningPrefix, msg)))
 }
 
 // Warningln prefixes the message with a "Note:", colors the *entire* message in yellow, writes to standard error with a new line.
 func Warningln(args ...interface{}) {
 	msg := fmt.Sprint(args...)
-	fmt.Fprintln(DiagnosticWriter, warningSprintf(fmt.Sprintf("%s %s", warningPrefix, msg)))
+	fmt.Fprintln(messageWriter(), warningSprintf(fmt.Sprintf("%s %s", warningPrefix, msg)))
 }
 
 // Warningf formats according to the specifier, prefixes the message with a "Note:", colors the *entire* message in yellow, and writes to standard error.
 func Warningf(format string, args ...interface{}) {
 	wrappedFormat := fmt.Sprintf("%s %s", warningPrefix, format)
-	fmt.Fprint(DiagnosticWriter, warningSprintf(wrappedFormat, args...))
+	fmt.Fprint(messageWriter(), warningSprintf(wrappedFormat, args...))
 }
 
 // Info writes the message to standard error with the default color.
 func Info(args ...interface{}) {
-	fmt.Fprint(DiagnosticWriter, args...)
+	fmt.Fprint(messageWriter(), args...)
 }
 
 // Infoln writes the message to standard error with the default color and new line.
 func Infoln(args ...interface{}) {
-	fmt.Fprintln(DiagnosticWriter, args...)
+	fmt.Fprintln(messageWriter(), args...)
 }
 
 // Infof formats according to the specifier, and writes to standard error with the default color.
 func Infof(format string, args ...interface{}) {
-	fmt.Fprintf(DiagnosticWriter, format, args...)
+	fmt.Fprintf(messageWriter(), format, args...)
 }
 
 // Debug writes the message to standard error in grey.
 func Debug(args ...interface{}) {
-	fmt.Fprint(DiagnosticWriter, debugSprintf(fmt.Sprint(args...)))
+	fmt.Fprint(messageWriter(), debugSprintf(fmt.Sprint(args...)))
 }
 
 // Debugln writes the message to standard error in grey and with a new line.
 func Debugln(args ...interface{}) {
-	fmt.Fprintln(DiagnosticWriter, debugSprintf(fmt.Sprint(args...)))
+	fmt.Fprintln(messageWriter(), debugSprintf(fmt.Sprint(args...)))
 }
 
 // Debugf formats according to the specifier, colors the message in grey, and writes to standard error.
 func Debugf(format string, args ...interface{}) {
-	fmt.Fprint(DiagnosticWriter, debugSprintf(format, args...))
+	fmt.Fprint(messageWriter(), debugSprintf(format, args...))
 }
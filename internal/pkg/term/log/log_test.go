@@ -230,3 +230,21 @@ func TestDebugf(t *testing.T) {
 	// THEN
 	require.Contains(t, b.String(), "hello world\n")
 }
+
+func TestSetQuiet(t *testing.T) {
+	// GIVEN
+	b := &strings.Builder{}
+	DiagnosticWriter = b
+	SetQuiet(true)
+	defer SetQuiet(false)
+
+	// WHEN
+	Successln("hello")
+	Warningln("hello")
+	Infoln("hello")
+	Debugln("hello")
+	Errorln("hello")
+
+	// THEN
+	require.Equal(t, fmt.Sprintf("%s hello\n", errorPrefix), b.String())
+}
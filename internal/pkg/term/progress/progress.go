@@ -4,6 +4,12 @@
 // Package progress provides data and functionality to display updates to the terminal.
 package progress
 
+import (
+	"io/ioutil"
+	"sync"
+	"text/tabwriter"
+)
+
 // Text is a description of the progress update.
 type Text string
 
@@ -17,3 +23,59 @@ const (
 	StatusComplete   Status = "Complete"
 	StatusSkipped    Status = "Skipped"
 )
+
+// activeSpinners holds the spinners that are started but not stopped yet.
+var activeSpinners = struct {
+	sync.Mutex
+	spinners map[*Spinner]struct{}
+}{
+	spinners: make(map[*Spinner]struct{}),
+}
+
+// StopSpinners stops every spinner that is still running and replaces it with the label.
+// It's meant to be called when the process is interrupted, so that the cursor is visible again
+// and the progress of the interrupted operation isn't left half-drawn on the terminal.
+func StopSpinners(label string) {
+	activeSpinners.Lock()
+	var running []*Spinner
+	for s := range activeSpinners.spinners {
+		running = append(running, s)
+	}
+	activeSpinners.Unlock()
+
+	for _, s := range running {
+		s.Stop(label)
+	}
+}
+
+func trackSpinner(s *Spinner) {
+	activeSpinners.Lock()
+	defer activeSpinners.Unlock()
+	activeSpinners.spinners[s] = struct{}{}
+}
+
+func untrackSpinner(s *Spinner) {
+	activeSpinners.Lock()
+	defer activeSpinners.Unlock()
+	delete(activeSpinners.spinners, s)
+}
+
+// newQuietSpinner returns a spinner that doesn't write anything, used when messages are suppressed with log.SetQuiet.
+func newQuietSpinner() *Spinner {
+	return &Spinner{
+		spin:         nopStartStopper{},
+		cur:          nopMover{},
+		eventsWriter: tabwriter.NewWriter(ioutil.Discard, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting),
+	}
+}
+
+type nopStartStopper struct{}
+
+func (nopStartStopper) Start() {}
+func (nopStartStopper) Stop()  {}
+
+type nopMover struct{}
+
+func (nopMover) Up(n int)   {}
+func (nopMover) Down(n int) {}
+func (nopMover) EraseLine() {}
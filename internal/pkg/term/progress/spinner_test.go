@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress/mocks"
 	spin "github.com/briandowns/spinner"
 	"github.com/golang/mock/gomock"
@@ -56,6 +57,23 @@ func TestNew(t *testing.T) {
 		require.Equal(t, os.Stderr, v.Writer)
 		require.Equal(t, 125*time.Millisecond, v.Delay)
 	})
+	t.Run("it should not write anything if messages are suppressed", func(t *testing.T) {
+		b := &bytes.Buffer{}
+		defaultWriter := log.DiagnosticWriter
+		log.DiagnosticWriter = b
+		log.SetQuiet(true)
+		defer func() {
+			log.DiagnosticWriter = defaultWriter
+			log.SetQuiet(false)
+		}()
+
+		got := NewSpinner()
+		got.Start("start")
+		got.Events([]TabRow{"event"})
+		got.Stop("stop")
+
+		require.Empty(t, b.String())
+	})
 }
 
 func TestSpinner_Start(t *testing.T) {
@@ -113,6 +131,37 @@ func TestSpinner_Stop(t *testing.T) {
 	}
 }
 
+func TestStopSpinners(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	activeSpinners.spinners = make(map[*Spinner]struct{})
+	mockRunning := mocks.NewMockstartStopper(ctrl)
+	mockStopped := mocks.NewMockstartStopper(ctrl)
+	running := &Spinner{
+		spin:         mockRunning,
+		eventsWriter: &mockWriteFlusher{buf: &bytes.Buffer{}},
+	}
+	stopped := &Spinner{
+		spin:         mockStopped,
+		eventsWriter: &mockWriteFlusher{buf: &bytes.Buffer{}},
+	}
+	mockRunning.EXPECT().Start()
+	mockStopped.EXPECT().Start()
+	mockStopped.EXPECT().Stop()
+	running.Start("running")
+	stopped.Start("stopped")
+	stopped.Stop("done")
+
+	mockRunning.EXPECT().Stop()
+
+	// WHEN
+	StopSpinners("interrupted")
+
+	// THEN
+	require.Empty(t, activeSpinners.spinners)
+}
+
 func TestSpinner_Events(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -57,7 +57,11 @@ type Spinner struct {
 }
 
 // NewSpinner returns a spinner that outputs to stderr.
+// If messages are suppressed with log.SetQuiet, the spinner doesn't output anything.
 func NewSpinner() *Spinner {
+	if log.IsQuiet() {
+		return newQuietSpinner()
+	}
 	s := spinner.New(charset, 500*time.Millisecond, spinner.WithHiddenCursor(true))
 	s.Writer = log.DiagnosticWriter
 	return &Spinner{
@@ -71,12 +75,14 @@ func NewSpinner() *Spinner {
 func (s *Spinner) Start(label string) {
 	s.suffix(fmt.Sprintf(" %s", label))
 	s.spin.Start()
+	trackSpinner(s)
 }
 
 // Stop stops the spinner and replaces it with a label.
 func (s *Spinner) Stop(label string) {
 	s.finalMSG(fmt.Sprintln(label))
 	s.spin.Stop()
+	untrackSpinner(s)
 	// Reset event entries once the spinner stops.
 	s.pastEvents = nil
 }
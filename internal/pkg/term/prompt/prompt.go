@@ -7,7 +7,9 @@ package prompt
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"os/exec"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -96,6 +98,37 @@ func init() {
   {{- end}}
 {{- end}}`
 
+	survey.EditorQuestionTemplate = `{{if not .ShowAnswer}}
+{{end}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if eq $i 0}}  {{ $line }}
+{{ else }}  {{ $line }}
+{{ end }}{{- end }}{{color "reset"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{if not .ShowAnswer}}  {{ .Config.Icons.Question.Text }}{{else}}{{ .Config.Icons.Question.Text }}{{end}}{{color "reset"}}
+{{- color "default"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "default"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ .Config.HelpInput }} for help]{{color "reset"}} {{end}}
+  {{- color "white"}}[Enter to launch editor] {{color "reset"}}
+{{- end}}`
+
+	survey.MultilineQuestionTemplate = `{{if not .ShowAnswer}}
+{{end}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if eq $i 0}}  {{ $line }}
+{{ else }}  {{ $line }}
+{{ end }}{{- end }}{{color "reset"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{if not .ShowAnswer}}  {{ .Config.Icons.Question.Text }}{{else}}{{ .Config.Icons.Question.Text }}{{end}}{{color "reset"}}
+{{- color "default"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- "\n"}}{{color "default"}}{{.Answer}}{{color "reset"}}
+  {{- if .Answer }}{{ "\n" }}{{ end }}
+{{- else }}
+  {{- if .Default}}{{color "default"}}({{.Default}}) {{color "reset"}}{{end}}
+  {{- color "white"}}[Enter 2 empty lines to finish]{{color "reset"}}
+{{- end}}`
+
 	split := func(s string, sep string) []string {
 		return strings.Split(s, sep)
 	}
@@ -117,6 +150,15 @@ func New() Prompt {
 	return survey.AskOne
 }
 
+// IsInteractive returns true if the standard input is attached to a terminal that can answer prompts.
+func IsInteractive() bool {
+	fi, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
 type prompter interface {
 	Prompt(config *survey.PromptConfig) (interface{}, error)
 	Cleanup(*survey.PromptConfig, interface{}) error
@@ -126,7 +168,8 @@ type prompter interface {
 
 type prompt struct {
 	prompter
-	FinalMessage string // Text to display after the user selects an answer.
+	FinalMessage string   // Text to display after the user selects an answer.
+	optionValues []string // Values that the options of a select prompt stand for, at the same index as the options.
 }
 
 // Cleanup does a final render with the user's chosen value.
@@ -142,6 +185,10 @@ func (p *prompt) Cleanup(config *survey.PromptConfig, val interface{}) error {
 		typedPrompt.Message = p.FinalMessage
 	case *survey.Input:
 		typedPrompt.Message = p.FinalMessage
+	case *survey.Editor:
+		typedPrompt.Message = p.FinalMessage
+	case *survey.Multiline:
+		typedPrompt.Message = p.FinalMessage
 	case *passwordPrompt:
 		typedPrompt.Message = p.FinalMessage
 	case *survey.Confirm:
@@ -170,14 +217,37 @@ func (p Prompt) Get(message, help string, validator ValidatorFunc, promptOpts ..
 
 	var result string
 	var err error
-	if validator == nil {
+	switch {
+	case validator == nil:
 		err = p(prompt, &result, stdio(), icons())
-	} else {
+	case prompt.isEditor():
+		err = p(prompt, &result, stdio(), editorValidators(validator), icons())
+	default:
 		err = p(prompt, &result, stdio(), validators(validator), icons())
 	}
+	if prompt.isEditor() {
+		result = trimEditorInput(result)
+	}
 	return result, err
 }
 
+// isEditor returns true if the prompt accepts multi-line text.
+func (p *prompt) isEditor() bool {
+	switch p.prompter.(type) {
+	case *survey.Editor, *survey.Multiline:
+		return true
+	}
+	return false
+}
+
+// trimEditorInput removes a single trailing newline, which editors usually add, from multi-line text.
+func trimEditorInput(s string) string {
+	if strings.HasSuffix(s, "\r\n") {
+		return strings.TrimSuffix(s, "\r\n")
+	}
+	return strings.TrimSuffix(s, "\n")
+}
+
 type passwordPrompt struct {
 	*survey.Password
 }
@@ -252,6 +322,77 @@ func (p Prompt) SelectOne(message, help string, options []string, promptOpts ...
 	return result, err
 }
 
+// SelectOption is an option of a select prompt that is displayed to the user with additional information.
+type SelectOption struct {
+	Value string // Value returned when the user selects the option.
+	Label string // Optional name to display instead of the value.
+	Hint  string // Optional information displayed faintly next to the label, such as the region of an environment.
+}
+
+func (o SelectOption) label() string {
+	if o.Label == "" {
+		return o.Value
+	}
+	return o.Label
+}
+
+// SelectOption prompts the user with a list of options, displayed with their hints, to choose from with the arrow keys.
+// It returns the value of the selected option. WithDefaultSelection pre-selects an option by its value.
+func (p Prompt) SelectOption(message, help string, options []SelectOption, promptOpts ...Option) (string, error) {
+	if len(options) <= 0 {
+		return "", ErrEmptyOptions
+	}
+
+	displayed, values := fmtSelectOptions(options)
+	sel := &survey.Select{
+		Message: message,
+		Options: displayed,
+		Default: displayed[0],
+	}
+	if help != "" {
+		sel.Help = color.Help(help)
+	}
+
+	prompt := &prompt{
+		prompter:     sel,
+		optionValues: values,
+	}
+	for _, opt := range promptOpts {
+		opt(prompt)
+	}
+
+	var result string
+	if err := p(prompt, &result, stdio(), icons()); err != nil {
+		return "", err
+	}
+	for i, option := range displayed {
+		if option == result {
+			return values[i], nil
+		}
+	}
+	return "", fmt.Errorf("selected option %s is not one of the options", result)
+}
+
+// fmtSelectOptions returns how the options are displayed to the user, with the hints aligned in a column,
+// and the values of the options at the same index.
+func fmtSelectOptions(options []SelectOption) (displayed, values []string) {
+	var width int
+	for _, opt := range options {
+		if l := len(opt.label()); l > width {
+			width = l
+		}
+	}
+	for _, opt := range options {
+		s := opt.label()
+		if opt.Hint != "" {
+			s = fmt.Sprintf("%-*s  %s", width, s, color.Faint.Sprintf("(%s)", opt.Hint))
+		}
+		displayed = append(displayed, s)
+		values = append(values, opt.Value)
+	}
+	return displayed, values
+}
+
 // MultiSelect prompts the user with a list of options to choose from with the arrow keys and enter key.
 func (p Prompt) MultiSelect(message, help string, options []string, promptOpts ...Option) ([]string, error) {
 	var result []string
@@ -306,12 +447,84 @@ type Option func(*prompt)
 // WithDefaultInput sets a default message for an input prompt.
 func WithDefaultInput(s string) Option {
 	return func(p *prompt) {
-		if get, ok := p.prompter.(*survey.Input); ok {
+		switch get := p.prompter.(type) {
+		case *survey.Input:
+			get.Default = s
+		case *survey.Editor:
+			get.Default = s
+		case *survey.Multiline:
 			get.Default = s
 		}
 	}
 }
 
+// WithEditor lets the user enter multi-line text for an input prompt in the editor set by $VISUAL or $EDITOR.
+// If neither is set or the editor can't be found, the user types the text in the terminal instead.
+func WithEditor() Option {
+	return func(p *prompt) {
+		get, ok := p.prompter.(*survey.Input)
+		if !ok {
+			return
+		}
+		editor, ok := lookupEditor()
+		if !ok {
+			p.prompter = &survey.Multiline{
+				Message: get.Message,
+				Default: get.Default,
+				Help:    get.Help,
+			}
+			return
+		}
+		p.prompter = &survey.Editor{
+			Message:       get.Message,
+			Default:       get.Default,
+			Help:          get.Help,
+			Editor:        editor,
+			HideDefault:   true,
+			AppendDefault: true,
+		}
+	}
+}
+
+// WithDefaultSelection pre-selects an option of a select prompt. It's ignored if s isn't one of the options.
+// Options that carry a value, like the ones of SelectOption, are matched by their value.
+func WithDefaultSelection(s string) Option {
+	return func(p *prompt) {
+		sel, ok := p.prompter.(*survey.Select)
+		if !ok {
+			return
+		}
+		for i, option := range sel.Options {
+			if option == s || (i < len(p.optionValues) && p.optionValues[i] == s) {
+				sel.Default = option
+				return
+			}
+		}
+	}
+}
+
+// WithDefaultSelections pre-selects options of a multiselect prompt. Selections that aren't options are ignored.
+func WithDefaultSelections(selections ...string) Option {
+	return func(p *prompt) {
+		sel, ok := p.prompter.(*survey.MultiSelect)
+		if !ok {
+			return
+		}
+		var defaults []string
+		for _, s := range selections {
+			for _, option := range sel.Options {
+				if option == s {
+					defaults = append(defaults, s)
+					break
+				}
+			}
+		}
+		if len(defaults) > 0 {
+			sel.Default = defaults
+		}
+	}
+}
+
 // WithFinalMessage sets a final message that replaces the question prompt once the user enters an answer.
 func WithFinalMessage(msg string) Option {
 	return func(p *prompt) {
@@ -328,6 +541,21 @@ func WithTrueDefault() Option {
 	}
 }
 
+// lookupEditor returns the editor command set by $VISUAL or $EDITOR if the executable exists.
+var lookupEditor = func() (string, bool) {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		editor := strings.TrimSpace(os.Getenv(env))
+		if editor == "" {
+			continue
+		}
+		if _, err := exec.LookPath(strings.Fields(editor)[0]); err != nil {
+			return "", false
+		}
+		return editor, true
+	}
+	return "", false
+}
+
 func stdio() survey.AskOpt {
 	return survey.WithStdio(os.Stdin, os.Stderr, os.Stderr)
 }
@@ -353,3 +581,14 @@ func RequireNonEmpty(v interface{}) error {
 func validators(validatorFunc ValidatorFunc) survey.AskOpt {
 	return survey.WithValidator(survey.ComposeValidators(survey.Required, survey.Validator(validatorFunc)))
 }
+
+// editorValidators validates multi-line text the same way as it's returned to the caller of the prompt.
+func editorValidators(validatorFunc ValidatorFunc) survey.AskOpt {
+	validate := survey.ComposeValidators(survey.Required, survey.Validator(validatorFunc))
+	return survey.WithValidator(func(v interface{}) error {
+		if s, ok := v.(string); ok {
+			return validate(trimEditorInput(s))
+		}
+		return validate(v)
+	})
+}
@@ -72,6 +72,103 @@ func TestPrompt_Get(t *testing.T) {
 	}
 }
 
+func TestPrompt_GetWithEditor(t *testing.T) {
+	mockMessage := "mockMessage"
+	mockHelpMessage := "mockHelpMessage"
+	mockDefaultInput := "python migrate.py"
+
+	testCases := map[string]struct {
+		inEditor    string
+		inValidator ValidatorFunc
+		inPrompt    func(t *testing.T) Prompt
+
+		wantValue string
+		wantError error
+	}{
+		"should open the editor and trim a single trailing newline": {
+			inEditor: "vim",
+			inPrompt: func(t *testing.T) Prompt {
+				return func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+					internalPrompt, ok := p.(*prompt)
+					require.True(t, ok, "input prompt should be type *prompt")
+					editor, ok := internalPrompt.prompter.(*survey.Editor)
+					require.True(t, ok, "internal prompt should be type *survey.Editor")
+					require.Equal(t, mockMessage, editor.Message)
+					require.Equal(t, mockHelpMessage, editor.Help)
+					require.Equal(t, mockDefaultInput, editor.Default)
+					require.Equal(t, "vim", editor.Editor)
+					require.True(t, editor.AppendDefault)
+
+					result := out.(*string)
+					*result = "python migrate.py \\\n  --dry-run\n\n"
+					require.Equal(t, 2, len(opts))
+					return nil
+				}
+			},
+			wantValue: "python migrate.py \\\n  --dry-run\n",
+		},
+		"should fall back to a multiline input if there is no editor": {
+			inPrompt: func(t *testing.T) Prompt {
+				return func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+					internalPrompt, ok := p.(*prompt)
+					require.True(t, ok, "input prompt should be type *prompt")
+					multiline, ok := internalPrompt.prompter.(*survey.Multiline)
+					require.True(t, ok, "internal prompt should be type *survey.Multiline")
+					require.Equal(t, mockMessage, multiline.Message)
+					require.Equal(t, mockDefaultInput, multiline.Default)
+
+					result := out.(*string)
+					*result = "python migrate.py"
+					return nil
+				}
+			},
+			wantValue: "python migrate.py",
+		},
+		"should validate the trimmed text": {
+			inEditor: "vim",
+			inValidator: func(ans interface{}) error {
+				if ans.(string) != "python migrate.py" {
+					return fmt.Errorf("unexpected text %q", ans)
+				}
+				return nil
+			},
+			inPrompt: func(t *testing.T) Prompt {
+				return func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+					require.Equal(t, 3, len(opts))
+					askOpts := &survey.AskOptions{}
+					for _, opt := range opts {
+						require.NoError(t, opt(askOpts))
+					}
+					for _, validate := range askOpts.Validators {
+						require.NoError(t, validate("python migrate.py\n"))
+						require.Error(t, validate("\n"))
+					}
+
+					result := out.(*string)
+					*result = "python migrate.py\n"
+					return nil
+				}
+			},
+			wantValue: "python migrate.py",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func(lookup func() (string, bool)) { lookupEditor = lookup }(lookupEditor)
+			lookupEditor = func() (string, bool) {
+				return tc.inEditor, tc.inEditor != ""
+			}
+
+			gotValue, gotError := tc.inPrompt(t).Get(mockMessage, mockHelpMessage, tc.inValidator,
+				WithDefaultInput(mockDefaultInput), WithEditor())
+
+			require.Equal(t, tc.wantValue, gotValue)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
 func TestPrompt_GetSecret(t *testing.T) {
 	mockError := fmt.Errorf("error")
 	mockMessage := "What's your super secret password?"
@@ -130,12 +227,39 @@ func TestPrompt_SelectOne(t *testing.T) {
 	mockMessage := "Which droid is best droid?"
 
 	testCases := map[string]struct {
-		inPrompt Prompt
-		inOpts   []string
+		inPrompt     Prompt
+		inOpts       []string
+		inPromptOpts []Option
 
 		wantValue string
 		wantError error
 	}{
+		"should pre-select the default selection": {
+			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				sel := p.(*prompt).prompter.(*survey.Select)
+				require.Equal(t, "c3po", sel.Default)
+
+				result := out.(*string)
+				*result = sel.Default.(string)
+				return nil
+			},
+			inOpts:       []string{"r2d2", "c3po", "bb8"},
+			inPromptOpts: []Option{WithDefaultSelection("c3po")},
+			wantValue:    "c3po",
+		},
+		"should ignore a default selection that is not an option": {
+			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				sel := p.(*prompt).prompter.(*survey.Select)
+				require.Equal(t, "r2d2", sel.Default)
+
+				result := out.(*string)
+				*result = sel.Default.(string)
+				return nil
+			},
+			inOpts:       []string{"r2d2", "c3po", "bb8"},
+			inPromptOpts: []Option{WithDefaultSelection("k2so")},
+			wantValue:    "r2d2",
+		},
 		"should return users input": {
 			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
 				internalPrompt, ok := p.(*prompt)
@@ -177,7 +301,75 @@ func TestPrompt_SelectOne(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			gotValue, gotError := tc.inPrompt.SelectOne(mockMessage, "", tc.inOpts)
+			gotValue, gotError := tc.inPrompt.SelectOne(mockMessage, "", tc.inOpts, tc.inPromptOpts...)
+
+			require.Equal(t, tc.wantValue, gotValue)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
+func TestPrompt_SelectOption(t *testing.T) {
+	mockMessage := "Which environment?"
+	mockOpts := []SelectOption{
+		{Value: "test", Hint: "us-west-2, 111111111111"},
+		{Value: "prod", Label: "prod-iad", Hint: "us-east-1, 222222222222"},
+		{Value: "dev"},
+	}
+
+	testCases := map[string]struct {
+		inPrompt     Prompt
+		inOpts       []SelectOption
+		inPromptOpts []Option
+
+		wantValue string
+		wantError error
+	}{
+		"should display the labels with aligned hints and return the value of the selection": {
+			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				sel := p.(*prompt).prompter.(*survey.Select)
+				require.Equal(t, []string{
+					"test      (us-west-2, 111111111111)",
+					"prod-iad  (us-east-1, 222222222222)",
+					"dev",
+				}, sel.Options)
+				require.Equal(t, "test      (us-west-2, 111111111111)", sel.Default)
+
+				result := out.(*string)
+				*result = sel.Options[1]
+				return nil
+			},
+			inOpts:    mockOpts,
+			wantValue: "prod",
+		},
+		"should pre-select the default selection by value": {
+			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				sel := p.(*prompt).prompter.(*survey.Select)
+				require.Equal(t, "prod-iad  (us-east-1, 222222222222)", sel.Default)
+
+				result := out.(*string)
+				*result = sel.Default.(string)
+				return nil
+			},
+			inOpts:       mockOpts,
+			inPromptOpts: []Option{WithDefaultSelection("prod")},
+			wantValue:    "prod",
+		},
+		"should echo error": {
+			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				return fmt.Errorf("error")
+			},
+			inOpts:    mockOpts,
+			wantError: fmt.Errorf("error"),
+		},
+		"should return error if input options list is empty": {
+			wantError: ErrEmptyOptions,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotError := tc.inPrompt.SelectOption(mockMessage, "", tc.inOpts, tc.inPromptOpts...)
 
 			require.Equal(t, tc.wantValue, gotValue)
 			require.Equal(t, tc.wantError, gotError)
@@ -191,8 +383,9 @@ func TestPrompt_MultiSelect(t *testing.T) {
 	mockFinalMessage := "Best dogs:"
 
 	testCases := map[string]struct {
-		inPrompt Prompt
-		inOpts   []string
+		inPrompt     Prompt
+		inOpts       []string
+		inPromptOpts []Option
 
 		wantValue []string
 		wantError error
@@ -223,6 +416,19 @@ func TestPrompt_MultiSelect(t *testing.T) {
 			wantValue: []string{"bowie", "clyde", "keno", "cava", "meow"},
 			wantError: nil,
 		},
+		"should pre-select the default selections that are options": {
+			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				sel := p.(*prompt).prompter.(*survey.MultiSelect)
+				require.Equal(t, []string{"bowie", "keno"}, sel.Default)
+
+				result := out.(*[]string)
+				*result = sel.Default.([]string)
+				return nil
+			},
+			inOpts:       []string{"bowie", "clyde", "keno"},
+			inPromptOpts: []Option{WithDefaultSelections("bowie", "meow", "keno")},
+			wantValue:    []string{"bowie", "keno"},
+		},
 		"should echo error": {
 			inPrompt: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
 				return mockError
@@ -238,7 +444,7 @@ func TestPrompt_MultiSelect(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			gotValue, gotError := tc.inPrompt.MultiSelect(mockMessage, "", tc.inOpts, WithFinalMessage(mockFinalMessage))
+			gotValue, gotError := tc.inPrompt.MultiSelect(mockMessage, "", tc.inOpts, append(tc.inPromptOpts, WithFinalMessage(mockFinalMessage))...)
 
 			require.Equal(t, tc.wantValue, gotValue)
 			require.Equal(t, tc.wantError, gotError)
@@ -16,12 +16,15 @@ var (
 	ErrVPCNotFound = errors.New("no existing VPCs found")
 	// ErrSubnetsNotFound is returned when no existing subnets are found.
 	ErrSubnetsNotFound = errors.New("no existing subnets found")
+	// ErrSecurityGroupsNotFound is returned when no existing security groups are found.
+	ErrSecurityGroupsNotFound = errors.New("no existing security groups found")
 )
 
-// VPCSubnetLister list VPCs and subnets.
+// VPCSubnetLister list VPCs, subnets and security groups.
 type VPCSubnetLister interface {
 	ListVPCs() ([]ec2.VPC, error)
 	ListVPCSubnets(vpcID string, opts ...ec2.ListVPCSubnetsOpts) ([]string, error)
+	SecurityGroups(filters ...ec2.Filter) ([]string, error)
 }
 
 // EC2Select is a selector for Ec2 resources.
@@ -75,6 +78,21 @@ func (s *EC2Select) PrivateSubnets(prompt, help, vpcID string) ([]string, error)
 	return s.subnet(prompt, help, vpcID, ec2.FilterForPrivateSubnets())
 }
 
+// SecurityGroups has the user multiselect security groups given the VPC ID.
+func (s *EC2Select) SecurityGroups(prompt, help, vpcID string) ([]string, error) {
+	securityGroups, err := s.ec2Svc.SecurityGroups(ec2.Filter{
+		Name:   "vpc-id",
+		Values: []string{vpcID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list security groups for VPC %s: %w", vpcID, err)
+	}
+	if len(securityGroups) == 0 {
+		return nil, ErrSecurityGroupsNotFound
+	}
+	return s.prompt.MultiSelect(prompt, help, securityGroups)
+}
+
 func (s *EC2Select) subnet(prompt, help string, vpcID string, filter ec2.ListVPCSubnetsOpts) ([]string, error) {
 	subnets, err := s.ec2Svc.ListVPCSubnets(vpcID, filter)
 	if err != nil {
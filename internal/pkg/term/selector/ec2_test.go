@@ -173,3 +173,65 @@ func TestEc2Select_subnets(t *testing.T) {
 		})
 	}
 }
+
+func TestEc2Select_SecurityGroups(t *testing.T) {
+	mockErr := errors.New("some error")
+	mockVPC := "mockVPC"
+	testCases := map[string]struct {
+		setupMocks func(mocks ec2SelectMocks)
+
+		wantErr            error
+		wantSecurityGroups []string
+	}{
+		"return error if fail to list security groups": {
+			setupMocks: func(m ec2SelectMocks) {
+				m.ec2Svc.EXPECT().SecurityGroups(ec2.Filter{
+					Name:   "vpc-id",
+					Values: []string{mockVPC},
+				}).Return(nil, mockErr)
+			},
+			wantErr: fmt.Errorf("list security groups for VPC mockVPC: some error"),
+		},
+		"return error if no security groups found": {
+			setupMocks: func(m ec2SelectMocks) {
+				m.ec2Svc.EXPECT().SecurityGroups(gomock.Any()).Return([]string{}, nil)
+			},
+			wantErr: ErrSecurityGroupsNotFound,
+		},
+		"success": {
+			setupMocks: func(m ec2SelectMocks) {
+				m.ec2Svc.EXPECT().SecurityGroups(gomock.Any()).Return([]string{"sg-1", "sg-2"}, nil)
+				m.prompt.EXPECT().MultiSelect("Select security groups", "Help text", []string{"sg-1", "sg-2"}).
+					Return([]string{"sg-2"}, nil)
+			},
+			wantSecurityGroups: []string{"sg-2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockec2Svc := mocks.NewMockVPCSubnetLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := ec2SelectMocks{
+				ec2Svc: mockec2Svc,
+				prompt: mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := EC2Select{
+				prompt: mockprompt,
+				ec2Svc: mockec2Svc,
+			}
+			securityGroups, err := sel.SecurityGroups("Select security groups", "Help text", mockVPC)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantSecurityGroups, securityGroups)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	// imagePromptUseCustom is the option for typing the location of an image instead of choosing one from ECR.
+	imagePromptUseCustom = "Enter a custom image location"
+	fmtImageTagPrompt    = "Which image of %s would you like to use?"
+	imageTagHelp         = "The most recently pushed tags of the repository, the image is referenced by its tag."
+
+	// maxImageTags is the number of most recently pushed tags listed for a repository.
+	maxImageTags = 20
+)
+
+// humanizeTime is overridden in tests so that its output is constant as time passes.
+var humanizeTime = humanize.Time
+
+// ImageLister lists the repositories of a registry and the tagged images in a repository.
+type ImageLister interface {
+	ListRepositories() ([]ecr.Repository, error)
+	ListTaggedImages(repoName string) ([]ecr.TaggedImage, error)
+}
+
+// WithImageLister lets the user choose an image from the ECR repositories listed by images
+// when they're asked for the location of an image.
+func WithImageLister(images ImageLister) SelectOption {
+	return func(s *Select) {
+		s.images = images
+	}
+}
+
+// Image asks the user for the location of an image. If an ImageLister is set up, the user can choose a repository
+// and one of its most recently pushed tags instead of typing a location, and the URI of the image is returned.
+func (s *WorkspaceSelect) Image(selPrompt, customPrompt, selHelp, customHelp string, validator prompt.ValidatorFunc) (string, error) {
+	repos, err := s.listRepositories()
+	if err != nil {
+		return "", err
+	}
+	if len(repos) == 0 {
+		return s.customImage(customPrompt, customHelp, validator)
+	}
+	options := []string{imagePromptUseCustom}
+	for _, repo := range repos {
+		options = append(options, repo.Name)
+	}
+	sel, err := s.prompt.SelectOne(selPrompt, selHelp, options, prompt.WithFinalMessage("Repository:"))
+	if err != nil {
+		return "", fmt.Errorf("select repository: %w", err)
+	}
+	if sel == imagePromptUseCustom {
+		return s.customImage(customPrompt, customHelp, validator)
+	}
+	repo := repos[indexOf(options, sel)-1]
+	tag, err := s.imageTag(repo.Name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s", repo.URI, tag), nil
+}
+
+// listRepositories returns the ECR repositories to choose an image from.
+// Failing to list them falls back to typing the location of the image.
+func (s *WorkspaceSelect) listRepositories() ([]ecr.Repository, error) {
+	if s.images == nil {
+		return nil, nil
+	}
+	repos, err := s.images.ListRepositories()
+	if err != nil {
+		log.Warningf("Couldn't list the ECR repositories, enter the location of the image instead: %v\n", err)
+		return nil, nil
+	}
+	return repos, nil
+}
+
+func (s *WorkspaceSelect) customImage(customPrompt, customHelp string, validator prompt.ValidatorFunc) (string, error) {
+	image, err := s.prompt.Get(customPrompt, customHelp, validator, prompt.WithFinalMessage("Image:"))
+	if err != nil {
+		return "", fmt.Errorf("get image location: %w", err)
+	}
+	return image, nil
+}
+
+// imageTag asks the user to select one of the most recently pushed tags of the repository.
+func (s *WorkspaceSelect) imageTag(repoName string) (string, error) {
+	images, err := s.images.ListTaggedImages(repoName)
+	if err != nil {
+		return "", fmt.Errorf("list images of repository %s: %w", repoName, err)
+	}
+	if len(images) == 0 {
+		return "", fmt.Errorf("no tagged images found in repository %s", repoName)
+	}
+	sort.SliceStable(images, func(i, j int) bool {
+		return images[i].PushedAt.After(images[j].PushedAt)
+	})
+	if len(images) > maxImageTags {
+		images = images[:maxImageTags]
+	}
+	var options []prompt.SelectOption
+	for _, image := range images {
+		options = append(options, prompt.SelectOption{
+			Value: image.Tag,
+			Hint:  fmt.Sprintf("pushed %s", humanizeTime(image.PushedAt)),
+		})
+	}
+	tag, err := s.prompt.SelectOption(
+		fmt.Sprintf(fmtImageTagPrompt, color.HighlightUserInput(repoName)),
+		imageTagHelp,
+		options,
+		prompt.WithFinalMessage("Tag:"))
+	if err != nil {
+		return "", fmt.Errorf("select image tag: %w", err)
+	}
+	return tag, nil
+}
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type imageSelectMocks struct {
+	prompt *mocks.MockPrompter
+	images *mocks.MockImageLister
+}
+
+func TestWorkspaceSelect_Image(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2022-03-01T12:00:00Z")
+		return fmt.Sprintf("%d hours ago", int(now.Sub(then).Hours()))
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	pushedAt, _ := time.Parse(time.RFC3339, "2022-03-01T12:00:00Z")
+	repos := []ecr.Repository{
+		{Name: "phonetool/frontend", URI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"},
+		{Name: "nginx", URI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/nginx"},
+	}
+	repoOptions := []string{"Enter a custom image location", "phonetool/frontend", "nginx"}
+	var manyImages []ecr.TaggedImage
+	var manyOptions []prompt.SelectOption
+	for i := 0; i < 25; i++ {
+		manyImages = append(manyImages, ecr.TaggedImage{
+			Tag:      fmt.Sprintf("v%d", i),
+			PushedAt: pushedAt.Add(time.Duration(i-25) * time.Hour),
+		})
+	}
+	for i := 24; i > 4; i-- {
+		manyOptions = append(manyOptions, prompt.SelectOption{
+			Value: fmt.Sprintf("v%d", i),
+			Hint:  fmt.Sprintf("pushed %d hours ago", 25-i),
+		})
+	}
+	testCases := map[string]struct {
+		noLister   bool
+		setupMocks func(m imageSelectMocks)
+
+		wantedImage string
+		wantedErr   error
+	}{
+		"asks for the location without an image lister": {
+			noLister: true,
+			setupMocks: func(m imageSelectMocks) {
+				m.prompt.EXPECT().Get("custom prompt", "custom help", gomock.Any(), gomock.Any()).Return("nginx:latest", nil)
+			},
+			wantedImage: "nginx:latest",
+		},
+		"asks for the location if the repositories can't be listed": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(nil, errors.New("some error"))
+				m.prompt.EXPECT().Get("custom prompt", "custom help", gomock.Any(), gomock.Any()).Return("nginx:latest", nil)
+			},
+			wantedImage: "nginx:latest",
+		},
+		"asks for the location if there are no repositories": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(nil, nil)
+				m.prompt.EXPECT().Get("custom prompt", "custom help", gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get image location: some error"),
+		},
+		"asks for the location if the user chooses to enter it": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(repos, nil)
+				m.prompt.EXPECT().SelectOne("select prompt", "select help", repoOptions, gomock.Any()).Return("Enter a custom image location", nil)
+				m.prompt.EXPECT().Get("custom prompt", "custom help", gomock.Any(), gomock.Any()).Return("nginx:latest", nil)
+			},
+			wantedImage: "nginx:latest",
+		},
+		"returns an error if the repository can't be selected": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(repos, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select repository: some error"),
+		},
+		"returns an error if the images can't be listed": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(repos, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("nginx", nil)
+				m.images.EXPECT().ListTaggedImages("nginx").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list images of repository nginx: some error"),
+		},
+		"returns an error if the repository has no tagged images": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(repos, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("nginx", nil)
+				m.images.EXPECT().ListTaggedImages("nginx").Return(nil, nil)
+			},
+			wantedErr: errors.New("no tagged images found in repository nginx"),
+		},
+		"returns the URI of the selected image among the most recent ones": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(repos, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("phonetool/frontend", nil)
+				m.images.EXPECT().ListTaggedImages("phonetool/frontend").Return(manyImages, nil)
+				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), manyOptions, gomock.Any()).Return("v23", nil)
+			},
+			wantedImage: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v23",
+		},
+		"returns an error if the tag can't be selected": {
+			setupMocks: func(m imageSelectMocks) {
+				m.images.EXPECT().ListRepositories().Return(repos, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("nginx", nil)
+				m.images.EXPECT().ListTaggedImages("nginx").Return([]ecr.TaggedImage{{Tag: "latest", PushedAt: pushedAt}}, nil)
+				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), []prompt.SelectOption{
+					{Value: "latest", Hint: "pushed 0 hours ago"},
+				}, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select image tag: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := imageSelectMocks{
+				prompt: mocks.NewMockPrompter(ctrl),
+				images: mocks.NewMockImageLister(ctrl),
+			}
+			tc.setupMocks(m)
+
+			sel := WorkspaceSelect{
+				Select: &Select{
+					prompt: m.prompt,
+				},
+			}
+			if !tc.noLister {
+				sel.images = m.images
+			}
+
+			// WHEN
+			image, err := sel.Image("select prompt", "custom prompt", "select help", "custom help", nil)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedImage, image)
+		})
+	}
+}
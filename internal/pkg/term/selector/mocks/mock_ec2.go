@@ -67,3 +67,22 @@ func (mr *MockVPCSubnetListerMockRecorder) ListVPCSubnets(vpcID interface{}, opt
 	varargs := append([]interface{}{vpcID}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnets", reflect.TypeOf((*MockVPCSubnetLister)(nil).ListVPCSubnets), varargs...)
 }
+
+// SecurityGroups mocks base method
+func (m *MockVPCSubnetLister) SecurityGroups(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroups", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups
+func (mr *MockVPCSubnetListerMockRecorder) SecurityGroups(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MockVPCSubnetLister)(nil).SecurityGroups), filters...)
+}
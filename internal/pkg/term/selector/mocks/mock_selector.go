@@ -115,6 +115,26 @@ func (mr *MockPrompterMockRecorder) Confirm(message, help interface{}, promptOpt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockPrompter)(nil).Confirm), varargs...)
 }
 
+// SelectOption mocks base method
+func (m *MockPrompter) SelectOption(message, help string, options []prompt.SelectOption, promptOpts ...prompt.Option) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{message, help, options}
+	for _, a := range promptOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectOption", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOption indicates an expected call of SelectOption
+func (mr *MockPrompterMockRecorder) SelectOption(message, help, options interface{}, promptOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{message, help, options}, promptOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOption", reflect.TypeOf((*MockPrompter)(nil).SelectOption), varargs...)
+}
+
 // MockAppEnvLister is a mock of AppEnvLister interface
 type MockAppEnvLister struct {
 	ctrl     *gomock.Controller
@@ -552,3 +572,33 @@ func (mr *MockDeployStoreClientMockRecorder) IsServiceDeployed(appName, envName,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceDeployed", reflect.TypeOf((*MockDeployStoreClient)(nil).IsServiceDeployed), appName, envName, svcName)
 }
+
+// IsJobDeployed mocks base method
+func (m *MockDeployStoreClient) IsJobDeployed(appName, envName, jobName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsJobDeployed", appName, envName, jobName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsJobDeployed indicates an expected call of IsJobDeployed
+func (mr *MockDeployStoreClientMockRecorder) IsJobDeployed(appName, envName, jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsJobDeployed", reflect.TypeOf((*MockDeployStoreClient)(nil).IsJobDeployed), appName, envName, jobName)
+}
+
+// ListDeployedJobs mocks base method
+func (m *MockDeployStoreClient) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs
+func (mr *MockDeployStoreClientMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockDeployStoreClient)(nil).ListDeployedJobs), appName, envName)
+}
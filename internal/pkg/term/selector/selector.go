@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 
 	"github.com/lnquy/cron"
 )
@@ -22,6 +23,7 @@ const (
 	every         = "@every %s"
 	rate          = "Rate"
 	fixedSchedule = "Fixed Schedule"
+	eventPattern  = "Event Pattern"
 
 	custom  = "Custom"
 	hourly  = "Hourly"
@@ -36,6 +38,8 @@ const (
 	dockerfilePromptUseCustom = "Enter custom path for your Dockerfile"
 	// DockerfilePromptUseImage is the option for using existing image instead of Dockerfile.
 	DockerfilePromptUseImage = "Use an existing image instead"
+	// dockerfileDirPrompt asks for the top-level directory of the Dockerfile when there are too many to list at once.
+	dockerfileDirPrompt = "Which directory is your Dockerfile in?"
 
 	ratePrompt = "How long would you like to wait between executions?"
 	rateHelp   = `You can specify the time as a duration string. (For example, 2m, 1h30m, 24h)`
@@ -48,6 +52,9 @@ For example, "Daily" runs at midnight. "Weekly" runs at midnight on Mondays.`
 Minute | Hour | Day of Month | Month | Day of Week
 For example: 0 17 ? * MON-FRI (5 pm on weekdays)
              0 0 1 */3 * (on the first of the month, quarterly)`
+	eventPatternPrompt = "What EventBridge event pattern should trigger the job?"
+	eventPatternHelp   = `The event pattern can be written in JSON or YAML. Events that match the pattern trigger the job.
+For example: {"source": ["aws.s3"], "detail-type": ["Object Created"]}`
 	humanReadableCronConfirmPrompt = "Would you like to use this schedule?"
 	humanReadableCronConfirmHelp   = `Confirm whether the schedule looks right to you.
 (Y)es will continue execution. (N)o will allow you to input a different schedule.`
@@ -56,6 +63,7 @@ For example: 0 17 ? * MON-FRI (5 pm on weekdays)
 var scheduleTypes = []string{
 	rate,
 	fixedSchedule,
+	eventPattern,
 }
 
 var presetSchedules = []string{
@@ -71,6 +79,7 @@ var presetSchedules = []string{
 type Prompter interface {
 	Get(message, help string, validator prompt.ValidatorFunc, promptOpts ...prompt.Option) (string, error)
 	SelectOne(message, help string, options []string, promptOpts ...prompt.Option) (string, error)
+	SelectOption(message, help string, options []prompt.SelectOption, promptOpts ...prompt.Option) (string, error)
 	MultiSelect(message, help string, options []string, promptOpts ...prompt.Option) ([]string, error)
 	Confirm(message, help string, promptOpts ...prompt.Option) (bool, error)
 }
@@ -112,12 +121,44 @@ type WorkspaceRetriever interface {
 type DeployStoreClient interface {
 	ListDeployedServices(appName string, envName string) ([]string, error)
 	IsServiceDeployed(appName string, envName string, svcName string) (bool, error)
+	ListDeployedJobs(appName string, envName string) ([]string, error)
+	IsJobDeployed(appName string, envName string, jobName string) (bool, error)
 }
 
 // Select prompts users to select the name of an application or environment.
 type Select struct {
-	prompt Prompter
-	config ConfigLister
+	prompt           Prompter
+	config           ConfigLister
+	defaultEnv       string
+	app              string // Application of the command, checked against the workspace's application by workspace selectors.
+	allowAppOverride bool   // true means workspace selectors use app even if the workspace is registered with another application.
+	images           ImageLister
+}
+
+// SelectOption sets up optional parameters of a selector.
+type SelectOption func(*Select)
+
+// WithDefaultEnv pre-selects the environment when the user is prompted for one.
+func WithDefaultEnv(env string) SelectOption {
+	return func(s *Select) {
+		s.defaultEnv = env
+	}
+}
+
+// WithApp sets the application of the command. Workspace selectors return a *workspace.ErrAppMismatch
+// if the workspace is registered with a different application.
+func WithApp(app string) SelectOption {
+	return func(s *Select) {
+		s.app = app
+	}
+}
+
+// WithAppOverride lets workspace selectors choose the workloads of the application set with WithApp
+// even if the workspace is registered with a different application.
+func WithAppOverride() SelectOption {
+	return func(s *Select) {
+		s.allowAppOverride = true
+	}
 }
 
 // ConfigSelect is an application and environment selector, but can also choose a service from the config store.
@@ -133,43 +174,48 @@ type WorkspaceSelect struct {
 	appName string
 }
 
-// DeploySelect is a service and environment selector from the deploy store.
+// DeploySelect is a service, job and environment selector from the deploy store.
 type DeploySelect struct {
 	*Select
 	deployStoreSvc DeployStoreClient
 	svc            string
+	job            string
 	env            string
 }
 
 // NewSelect returns a selector that chooses applications or environments.
-func NewSelect(prompt Prompter, store ConfigLister) *Select {
-	return &Select{
+func NewSelect(prompt Prompter, store ConfigLister, opts ...SelectOption) *Select {
+	s := &Select{
 		prompt: prompt,
 		config: store,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // NewConfigSelect returns a new selector that chooses applications, environments, or services from the config store.
-func NewConfigSelect(prompt Prompter, store ConfigLister) *ConfigSelect {
+func NewConfigSelect(prompt Prompter, store ConfigLister, opts ...SelectOption) *ConfigSelect {
 	return &ConfigSelect{
-		Select:    NewSelect(prompt, store),
+		Select:    NewSelect(prompt, store, opts...),
 		svcLister: store,
 	}
 }
 
 // NewWorkspaceSelect returns a new selector that chooses applications and environments from the config store, but
 // services from the local workspace.
-func NewWorkspaceSelect(prompt Prompter, store ConfigLister, ws WorkspaceRetriever) *WorkspaceSelect {
+func NewWorkspaceSelect(prompt Prompter, store ConfigLister, ws WorkspaceRetriever, opts ...SelectOption) *WorkspaceSelect {
 	return &WorkspaceSelect{
-		Select: NewSelect(prompt, store),
+		Select: NewSelect(prompt, store, opts...),
 		ws:     ws,
 	}
 }
 
-// NewDeploySelect returns a new selector that chooses services and environments from the deploy store.
-func NewDeploySelect(prompt Prompter, configStore ConfigLister, deployStore DeployStoreClient) *DeploySelect {
+// NewDeploySelect returns a new selector that chooses services, jobs and environments from the deploy store.
+func NewDeploySelect(prompt Prompter, configStore ConfigLister, deployStore DeployStoreClient, opts ...SelectOption) *DeploySelect {
 	return &DeploySelect{
-		Select:         NewSelect(prompt, configStore),
+		Select:         NewSelect(prompt, configStore, opts...),
 		deployStoreSvc: deployStore,
 	}
 }
@@ -184,6 +230,13 @@ func WithSvc(svc string) GetDeployedServiceOpts {
 	}
 }
 
+// WithJob sets up the job name for DeploySelect.
+func WithJob(job string) GetDeployedServiceOpts {
+	return func(in *DeploySelect) {
+		in.job = job
+	}
+}
+
 // WithEnv sets up the env name for DeploySelect.
 func WithEnv(env string) GetDeployedServiceOpts {
 	return func(in *DeploySelect) {
@@ -198,7 +251,35 @@ type DeployedService struct {
 }
 
 func (s *DeployedService) String() string {
-	return fmt.Sprintf("%s (%s)", s.Svc, s.Env)
+	return FmtWorkloadEnv(s.Svc, s.Env)
+}
+
+// FmtWorkloadEnv returns how a workload deployed in an environment is displayed to users, for example "frontend (test)".
+func FmtWorkloadEnv(workload, env string) string {
+	return fmt.Sprintf("%s (%s)", workload, env)
+}
+
+// DeployedJob contains the job name and environment name of the deployed job.
+type DeployedJob struct {
+	Job string
+	Env string
+}
+
+func (j *DeployedJob) String() string {
+	return FmtWorkloadEnv(j.Job, j.Env)
+}
+
+// deployedWorkload is a workload deployed in an environment.
+type deployedWorkload struct {
+	name string
+	env  string
+}
+
+// workloadDeployLister checks and lists the workloads of one type that are deployed in an environment.
+type workloadDeployLister struct {
+	typ          string // Type of the workload displayed to users, such as "service".
+	isDeployed   func(appName, envName, name string) (bool, error)
+	listDeployed func(appName, envName string) ([]string, error)
 }
 
 // DeployedService has the user select a deployed service. Callers can provide either a particular environment,
@@ -207,6 +288,41 @@ func (s *DeploySelect) DeployedService(prompt, help string, app string, opts ...
 	for _, opt := range opts {
 		opt(s)
 	}
+	wkld, err := s.deployedWorkload(prompt, help, app, s.svc, workloadDeployLister{
+		typ:          "service",
+		isDeployed:   s.deployStoreSvc.IsServiceDeployed,
+		listDeployed: s.deployStoreSvc.ListDeployedServices,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DeployedService{
+		Svc: wkld.name,
+		Env: wkld.env,
+	}, nil
+}
+
+// DeployedJob has the user select a deployed job. Callers can provide either a particular environment,
+// a particular job to filter on, or both.
+func (s *DeploySelect) DeployedJob(prompt, help string, app string, opts ...GetDeployedServiceOpts) (*DeployedJob, error) {
+	for _, opt := range opts {
+		opt(s)
+	}
+	wkld, err := s.deployedWorkload(prompt, help, app, s.job, workloadDeployLister{
+		typ:          "job",
+		isDeployed:   s.deployStoreSvc.IsJobDeployed,
+		listDeployed: s.deployStoreSvc.ListDeployedJobs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DeployedJob{
+		Job: wkld.name,
+		Env: wkld.env,
+	}, nil
+}
+
+func (s *DeploySelect) deployedWorkload(msg, help, app, name string, lister workloadDeployLister) (*deployedWorkload, error) {
 	var envNames []string
 	var err error
 	if s.env != "" {
@@ -217,74 +333,88 @@ func (s *DeploySelect) DeployedService(prompt, help string, app string, opts ...
 			return nil, fmt.Errorf("list environments: %w", err)
 		}
 	}
-	svcEnvs := make(map[string]DeployedService)
-	var svcEnvNames []string
+	// The options displayed to the user and the deployed workloads they stand for share the same index,
+	// so that the selected workload is never parsed back out of its display name.
+	var wkldEnvOpts []prompt.SelectOption
+	var wkldEnvs []deployedWorkload
 	for _, envName := range envNames {
-		var svcNames []string
-		if s.svc != "" {
-			deployed, err := s.deployStoreSvc.IsServiceDeployed(app, envName, s.svc)
+		var wkldNames []string
+		if name != "" {
+			deployed, err := lister.isDeployed(app, envName, name)
 			if err != nil {
-				return nil, fmt.Errorf("check if service %s is deployed in environment %s: %w", s.svc, envName, err)
+				return nil, fmt.Errorf("check if %s %s is deployed in environment %s: %w", lister.typ, name, envName, err)
 			}
 			if !deployed {
 				continue
 			}
-			svcNames = append(svcNames, s.svc)
+			wkldNames = append(wkldNames, name)
 		} else {
-			svcNames, err = s.deployStoreSvc.ListDeployedServices(app, envName)
+			wkldNames, err = lister.listDeployed(app, envName)
 			if err != nil {
-				return nil, fmt.Errorf("list deployed service for environment %s: %w", envName, err)
+				return nil, fmt.Errorf("list deployed %s for environment %s: %w", lister.typ, envName, err)
 			}
 		}
-		for _, svcName := range svcNames {
-			svcEnv := DeployedService{
-				Svc: svcName,
-				Env: envName,
-			}
-			svcEnvName := svcEnv.String()
-			svcEnvs[svcEnvName] = svcEnv
-			svcEnvNames = append(svcEnvNames, svcEnvName)
+		for _, wkldName := range wkldNames {
+			wkldEnvs = append(wkldEnvs, deployedWorkload{
+				name: wkldName,
+				env:  envName,
+			})
+			wkldEnvOpts = append(wkldEnvOpts, prompt.SelectOption{
+				Value: FmtWorkloadEnv(wkldName, envName),
+				Label: wkldName,
+				Hint:  envName,
+			})
 		}
 	}
-	if len(svcEnvNames) == 0 {
-		return nil, fmt.Errorf("no deployed services found in application %s", color.HighlightUserInput(app))
+	if len(wkldEnvOpts) == 0 {
+		return nil, fmt.Errorf("no deployed %ss found in application %s", lister.typ, color.HighlightUserInput(app))
 	}
-	// return if only one deployed service found
-	var deployedSvc DeployedService
-	if len(svcEnvNames) == 1 {
-		deployedSvc = svcEnvs[svcEnvNames[0]]
-		if s.svc == "" && s.env == "" {
-			log.Infof("Found only one deployed service %s in environment %s\n", color.HighlightUserInput(deployedSvc.Svc), color.HighlightUserInput(deployedSvc.Env))
+	// return if only one deployed workload found
+	if len(wkldEnvOpts) == 1 {
+		wkld := wkldEnvs[0]
+		if name == "" && s.env == "" {
+			log.Infof("Found only one deployed %s %s in environment %s\n", lister.typ, color.HighlightUserInput(wkld.name), color.HighlightUserInput(wkld.env))
 		}
-		if (s.svc != "") != (s.env != "") {
-			log.Infof("Service %s found in environment %s\n", color.HighlightUserInput(deployedSvc.Svc), color.HighlightUserInput(deployedSvc.Env))
+		if (name != "") != (s.env != "") {
+			log.Infof("%s %s found in environment %s\n", strings.Title(lister.typ), color.HighlightUserInput(wkld.name), color.HighlightUserInput(wkld.env))
 		}
-		return &deployedSvc, nil
+		return &wkld, nil
 	}
-	svcEnvName, err := s.prompt.SelectOne(
-		prompt,
+	var defaultWkldEnvName string
+	for i, wkldEnv := range wkldEnvs {
+		if wkldEnv.env == s.defaultEnv {
+			defaultWkldEnvName = wkldEnvOpts[i].Value
+			break
+		}
+	}
+	wkldEnvName, err := s.prompt.SelectOption(
+		msg,
 		help,
-		svcEnvNames,
+		wkldEnvOpts,
+		defaultSelection(defaultWkldEnvName, optionValues(wkldEnvOpts))...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("select deployed services for application %s: %w", app, err)
+		return nil, fmt.Errorf("select deployed %ss for application %s: %w", lister.typ, app, err)
 	}
-	deployedSvc = svcEnvs[svcEnvName]
-
-	return &deployedSvc, nil
+	for i, option := range wkldEnvOpts {
+		if option.Value == wkldEnvName {
+			return &wkldEnvs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("selected deployed %s %s is not one of the options", lister.typ, wkldEnvName)
 }
 
 // Service fetches all services in the workspace and then prompts the user to select one.
 func (s *WorkspaceSelect) Service(msg, help string) (string, error) {
-	summary, err := s.ws.Summary()
+	appName, err := s.workspaceApp()
 	if err != nil {
-		return "", fmt.Errorf("read workspace summary: %w", err)
+		return "", err
 	}
 	wsServiceNames, err := s.retrieveWorkspaceServices()
 	if err != nil {
 		return "", fmt.Errorf("retrieve services from workspace: %w", err)
 	}
-	storeServiceNames, err := s.Select.config.ListServices(summary.Application)
+	storeServiceNames, err := s.Select.config.ListServices(appName)
 	if err != nil {
 		return "", fmt.Errorf("retrieve services from store: %w", err)
 	}
@@ -306,15 +436,15 @@ func (s *WorkspaceSelect) Service(msg, help string) (string, error) {
 
 // Job fetches all jobs in the workspace and then prompts the user to select one.
 func (s *WorkspaceSelect) Job(msg, help string) (string, error) {
-	summary, err := s.ws.Summary()
+	appName, err := s.workspaceApp()
 	if err != nil {
-		return "", fmt.Errorf("read workspace summary: %w", err)
+		return "", err
 	}
 	wsJobNames, err := s.retrieveWorkspaceJobs()
 	if err != nil {
 		return "", fmt.Errorf("retrieve jobs from workspace: %w", err)
 	}
-	storeJobNames, err := s.Select.config.ListJobs(summary.Application)
+	storeJobNames, err := s.Select.config.ListJobs(appName)
 	if err != nil {
 		return "", fmt.Errorf("retrieve jobs from store: %w", err)
 	}
@@ -336,15 +466,15 @@ func (s *WorkspaceSelect) Job(msg, help string) (string, error) {
 
 // Workload fetches all jobs and services in an app and prompts the user to select one.
 func (s *WorkspaceSelect) Workload(msg, help string) (wl string, err error) {
-	summary, err := s.ws.Summary()
+	appName, err := s.workspaceApp()
 	if err != nil {
-		return "", fmt.Errorf("read workspace summary: %w", err)
+		return "", err
 	}
 	wsWlNames, err := s.retrieveWorkspaceWorkloads()
 	if err != nil {
 		return "", fmt.Errorf("retrieve jobs and services from workspace: %w", err)
 	}
-	storeWls, err := s.Select.config.ListWorkloads(summary.Application)
+	storeWls, err := s.Select.config.ListWorkloads(appName)
 	if err != nil {
 		return "", fmt.Errorf("retrieve jobs and services from store: %w", err)
 	}
@@ -379,11 +509,12 @@ func filterWlsByName(wls []*config.Workload, wantedNames []string) []string {
 }
 
 // Service fetches all services in an app and prompts the user to select one.
-func (s *ConfigSelect) Service(prompt, help, app string) (string, error) {
-	services, err := s.retrieveServices(app)
+func (s *ConfigSelect) Service(msg, help, app string) (string, error) {
+	serviceOpts, err := s.retrieveServiceOptions(app)
 	if err != nil {
 		return "", fmt.Errorf("get services for app %s: %w", app, err)
 	}
+	services := optionValues(serviceOpts)
 	if len(services) == 0 {
 		log.Infof("Couldn't find any services associated with app %s, try initializing one: %s\n",
 			color.HighlightUserInput(app),
@@ -394,21 +525,47 @@ func (s *ConfigSelect) Service(prompt, help, app string) (string, error) {
 		log.Infof("Only found one service, defaulting to: %s\n", color.HighlightUserInput(services[0]))
 		return services[0], nil
 	}
-	selectedAppName, err := s.prompt.SelectOne(prompt, help, services)
+	selectedSvcName, err := s.prompt.SelectOption(msg, help, serviceOpts)
 	if err != nil {
 		return "", fmt.Errorf("select service: %w", err)
 	}
-	return selectedAppName, nil
+	return selectedSvcName, nil
+}
+
+// Job fetches all jobs in an app and prompts the user to select one.
+func (s *ConfigSelect) Job(prompt, help, app string) (string, error) {
+	jobs, err := s.retrieveJobs(app)
+	if err != nil {
+		return "", fmt.Errorf("get jobs for app %s: %w", app, err)
+	}
+	if len(jobs) == 0 {
+		log.Infof("Couldn't find any jobs associated with app %s, try initializing one: %s\n",
+			color.HighlightUserInput(app),
+			color.HighlightCode("copilot job init"))
+		return "", fmt.Errorf("no jobs found in app %s", app)
+	}
+	if len(jobs) == 1 {
+		log.Infof("Only found one job, defaulting to: %s\n", color.HighlightUserInput(jobs[0]))
+		return jobs[0], nil
+	}
+	selectedJobName, err := s.prompt.SelectOne(prompt, help, jobs)
+	if err != nil {
+		return "", fmt.Errorf("select job: %w", err)
+	}
+	return selectedJobName, nil
 }
 
 // Environment fetches all the environments in an app and prompts the user to select one.
-func (s *Select) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
-	envs, err := s.retrieveEnvironments(app)
+func (s *Select) Environment(msg, help, app string, additionalOpts ...string) (string, error) {
+	envOpts, err := s.retrieveEnvironmentOptions(app)
 	if err != nil {
 		return "", fmt.Errorf("get environments for app %s from metadata store: %w", app, err)
 	}
+	for _, opt := range additionalOpts {
+		envOpts = append(envOpts, prompt.SelectOption{Value: opt})
+	}
 
-	envs = append(envs, additionalOpts...)
+	envs := optionValues(envOpts)
 	if len(envs) == 0 {
 		log.Infof("Couldn't find any environments associated with app %s, try initializing one: %s\n",
 			color.HighlightUserInput(app),
@@ -420,13 +577,68 @@ func (s *Select) Environment(prompt, help, app string, additionalOpts ...string)
 		return envs[0], nil
 	}
 
-	selectedEnvName, err := s.prompt.SelectOne(prompt, help, envs)
+	selectedEnvName, err := s.prompt.SelectOption(msg, help, envOpts, defaultSelection(s.defaultEnv, envs)...)
 	if err != nil {
 		return "", fmt.Errorf("select environment: %w", err)
 	}
 	return selectedEnvName, nil
 }
 
+// defaultSelection returns the prompt option to pre-select def if it's one of the options.
+func defaultSelection(def string, options []string) []prompt.Option {
+	for _, opt := range options {
+		if def != "" && opt == def {
+			return []prompt.Option{prompt.WithDefaultSelection(def)}
+		}
+	}
+	return nil
+}
+
+// optionValues returns the values of the select options.
+func optionValues(opts []prompt.SelectOption) []string {
+	values := make([]string, len(opts))
+	for i, opt := range opts {
+		values[i] = opt.Value
+	}
+	return values
+}
+
+// fmtHint joins the non-empty parts of a select option's hint, for example "us-west-2, 123456789012".
+func fmtHint(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
+
+// Environments fetches all the environments in an app and prompts the user to select one or more.
+// The environments are returned in the order they're listed in the app.
+func (s *Select) Environments(prompt, help, app string) ([]string, error) {
+	envs, err := s.retrieveEnvironments(app)
+	if err != nil {
+		return nil, fmt.Errorf("get environments for app %s from metadata store: %w", app, err)
+	}
+	if len(envs) == 0 {
+		log.Infof("Couldn't find any environments associated with app %s, try initializing one: %s\n",
+			color.HighlightUserInput(app),
+			color.HighlightCode("copilot env init"))
+		return nil, fmt.Errorf("no environments found in app %s", app)
+	}
+	if len(envs) == 1 {
+		log.Infof("Only found one environment, defaulting to: %s\n", color.HighlightUserInput(envs[0]))
+		return envs, nil
+	}
+
+	selectedEnvNames, err := s.prompt.MultiSelect(prompt, help, envs)
+	if err != nil {
+		return nil, fmt.Errorf("select environments: %w", err)
+	}
+	return selectedEnvNames, nil
+}
+
 // Application fetches all the apps in an account/region and prompts the user to select one.
 func (s *Select) Application(prompt, help string, additionalOpts ...string) (string, error) {
 	appNames, err := s.retrieveApps()
@@ -477,16 +689,66 @@ func (s *Select) retrieveEnvironments(app string) ([]string, error) {
 	return envsNames, nil
 }
 
-func (s *ConfigSelect) retrieveServices(app string) ([]string, error) {
+// retrieveEnvironmentOptions returns the environments of the app hinted with their region and account.
+func (s *Select) retrieveEnvironmentOptions(app string) ([]prompt.SelectOption, error) {
+	envs, err := s.config.ListEnvironments(app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments: %w", err)
+	}
+	opts := make([]prompt.SelectOption, len(envs))
+	for ind, env := range envs {
+		opts[ind] = prompt.SelectOption{
+			Value: env.Name,
+			Hint:  fmtHint(env.Region, env.AccountID),
+		}
+	}
+	return opts, nil
+}
+
+// retrieveServiceOptions returns the services of the app hinted with their type.
+func (s *ConfigSelect) retrieveServiceOptions(app string) ([]prompt.SelectOption, error) {
 	services, err := s.svcLister.ListServices(app)
 	if err != nil {
 		return nil, fmt.Errorf("list services: %w", err)
 	}
-	serviceNames := make([]string, len(services))
+	opts := make([]prompt.SelectOption, len(services))
 	for ind, service := range services {
-		serviceNames[ind] = service.Name
+		opts[ind] = prompt.SelectOption{
+			Value: service.Name,
+			Hint:  service.Type,
+		}
+	}
+	return opts, nil
+}
+
+func (s *ConfigSelect) retrieveJobs(app string) ([]string, error) {
+	jobs, err := s.svcLister.ListJobs(app)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	jobNames := make([]string, len(jobs))
+	for ind, job := range jobs {
+		jobNames[ind] = job.Name
+	}
+	return jobNames, nil
+}
+
+// workspaceApp returns the application whose workloads are selected from the workspace.
+func (s *WorkspaceSelect) workspaceApp() (string, error) {
+	summary, err := s.ws.Summary()
+	if err != nil {
+		return "", fmt.Errorf("read workspace summary: %w", err)
+	}
+	if s.app == "" || s.app == summary.Application {
+		return summary.Application, nil
+	}
+	if s.allowAppOverride {
+		return s.app, nil
+	}
+	return "", &workspace.ErrAppMismatch{
+		WorkspaceApp: summary.Application,
+		App:          s.app,
 	}
-	return serviceNames, nil
 }
 
 func (s *WorkspaceSelect) retrieveWorkspaceServices() ([]string, error) {
@@ -520,16 +782,9 @@ func (s *WorkspaceSelect) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoun
 	if err != nil {
 		return "", fmt.Errorf("list Dockerfiles: %w", err)
 	}
-	var sel string
-	dockerfiles = append(dockerfiles, []string{dockerfilePromptUseCustom, DockerfilePromptUseImage}...)
-	sel, err = s.prompt.SelectOne(
-		selPrompt,
-		selHelp,
-		dockerfiles,
-		prompt.WithFinalMessage("Dockerfile:"),
-	)
+	sel, err := s.selectDockerfile(selPrompt, selHelp, dockerfiles)
 	if err != nil {
-		return "", fmt.Errorf("select Dockerfile: %w", err)
+		return "", err
 	}
 	if sel != dockerfilePromptUseCustom {
 		return sel, nil
@@ -545,8 +800,86 @@ func (s *WorkspaceSelect) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoun
 	return sel, nil
 }
 
-// Schedule asks the user to select either a rate, preset cron, or custom cron.
-func (s *WorkspaceSelect) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+// selectDockerfile asks the user to select one of the Dockerfiles or one of the other options.
+// If there are many Dockerfiles spread across directories, the user first selects the top-level directory.
+func (s *WorkspaceSelect) selectDockerfile(selPrompt, selHelp string, dockerfiles []string) (string, error) {
+	otherOptions := []string{dockerfilePromptUseCustom, DockerfilePromptUseImage}
+	if groups := groupDockerfiles(dockerfiles); len(dockerfiles) > dockerfileGroupThreshold && len(groups) > 1 {
+		var options []string
+		for _, group := range groups {
+			options = append(options, group.String())
+		}
+		sel, err := s.prompt.SelectOne(
+			dockerfileDirPrompt,
+			selHelp,
+			append(options, otherOptions...),
+			prompt.WithFinalMessage("Directory:"),
+		)
+		if err != nil {
+			return "", fmt.Errorf("select directory of the Dockerfile: %w", err)
+		}
+		i := indexOf(options, sel)
+		if i == -1 {
+			return sel, nil
+		}
+		dockerfiles = groups[i].dockerfiles
+	}
+	sel, err := s.prompt.SelectOne(
+		selPrompt,
+		selHelp,
+		append(append([]string{}, dockerfiles...), otherOptions...),
+		prompt.WithFinalMessage("Dockerfile:"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("select Dockerfile: %w", err)
+	}
+	return sel, nil
+}
+
+// dockerfileGroupThreshold is the number of Dockerfiles above which they are grouped by top-level directory.
+const dockerfileGroupThreshold = 10
+
+// dockerfileGroup holds the Dockerfiles under a top-level directory of the workspace.
+type dockerfileGroup struct {
+	dir         string
+	dockerfiles []string
+}
+
+func (g dockerfileGroup) String() string {
+	return fmt.Sprintf("%s/ (%d %s)", g.dir, len(g.dockerfiles), english.PluralWord(len(g.dockerfiles), "Dockerfile", ""))
+}
+
+// groupDockerfiles groups the sorted Dockerfile paths by top-level directory, such as "." for "./Dockerfile"
+// and "services" for "services/api/Dockerfile".
+func groupDockerfiles(dockerfiles []string) []dockerfileGroup {
+	var groups []dockerfileGroup
+	for _, dockerfile := range dockerfiles {
+		dir := strings.SplitN(dockerfile, "/", 2)[0]
+		if len(groups) == 0 || groups[len(groups)-1].dir != dir {
+			groups = append(groups, dockerfileGroup{dir: dir})
+		}
+		groups[len(groups)-1].dockerfiles = append(groups[len(groups)-1].dockerfiles, dockerfile)
+	}
+	return groups
+}
+
+func indexOf(elems []string, s string) int {
+	for i, elem := range elems {
+		if elem == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// JobTrigger holds what triggers a job. Only one of Schedule and EventPattern is set.
+type JobTrigger struct {
+	Schedule     string
+	EventPattern string // EventBridge event pattern in JSON or YAML.
+}
+
+// Schedule asks the user to select either a rate, preset cron, custom cron, or an event pattern.
+func (s *WorkspaceSelect) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator, eventPatternValidator prompt.ValidatorFunc) (*JobTrigger, error) {
 	scheduleType, err := s.prompt.SelectOne(
 		scheduleTypePrompt,
 		scheduleTypeHelp,
@@ -554,16 +887,41 @@ func (s *WorkspaceSelect) Schedule(scheduleTypePrompt, scheduleTypeHelp string,
 		prompt.WithFinalMessage("Schedule type:"),
 	)
 	if err != nil {
-		return "", fmt.Errorf("get schedule type: %w", err)
+		return nil, fmt.Errorf("get schedule type: %w", err)
 	}
+	var schedule string
 	switch scheduleType {
 	case rate:
-		return s.askRate(rateValidator)
+		schedule, err = s.askRate(rateValidator)
 	case fixedSchedule:
-		return s.askCron(scheduleValidator)
+		schedule, err = s.askCron(scheduleValidator)
+	case eventPattern:
+		pattern, err := s.askEventPattern(eventPatternValidator)
+		if err != nil {
+			return nil, err
+		}
+		return &JobTrigger{EventPattern: pattern}, nil
 	default:
-		return "", fmt.Errorf("unrecognized schedule type %s", scheduleType)
+		return nil, fmt.Errorf("unrecognized schedule type %s", scheduleType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &JobTrigger{Schedule: schedule}, nil
+}
+
+func (s *WorkspaceSelect) askEventPattern(eventPatternValidator prompt.ValidatorFunc) (string, error) {
+	pattern, err := s.prompt.Get(
+		eventPatternPrompt,
+		eventPatternHelp,
+		eventPatternValidator,
+		prompt.WithEditor(),
+		prompt.WithFinalMessage("Event Pattern:"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("get event pattern: %w", err)
 	}
+	return pattern, nil
 }
 
 func (s *WorkspaceSelect) askRate(rateValidator prompt.ValidatorFunc) (string, error) {
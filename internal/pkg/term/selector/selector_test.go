@@ -28,6 +28,7 @@ func TestDeploySelect_Service(t *testing.T) {
 		setupMocks func(mocks deploySelectMocks)
 		svc        string
 		env        string
+		defaultEnv string
 
 		wantErr error
 		wantEnv string
@@ -97,7 +98,7 @@ func TestDeploySelect_Service(t *testing.T) {
 
 				m.prompt.
 					EXPECT().
-					SelectOne("Select a deployed service", "Help text", []string{"mockSvc1 (test)", "mockSvc2 (test)"}).
+					SelectOption("Select a deployed service", "Help text", []prompt.SelectOption{{Value: "mockSvc1 (test)", Label: "mockSvc1", Hint: "test"}, {Value: "mockSvc2 (test)", Label: "mockSvc2", Hint: "test"}}).
 					Return("", errors.New("some error"))
 			},
 			wantErr: fmt.Errorf("select deployed services for application %s: some error", testApp),
@@ -120,12 +121,75 @@ func TestDeploySelect_Service(t *testing.T) {
 
 				m.prompt.
 					EXPECT().
-					SelectOne("Select a deployed service", "Help text", []string{"mockSvc1 (test)", "mockSvc2 (test)"}).
+					SelectOption("Select a deployed service", "Help text", []prompt.SelectOption{{Value: "mockSvc1 (test)", Label: "mockSvc1", Hint: "test"}, {Value: "mockSvc2 (test)", Label: "mockSvc2", Hint: "test"}}).
 					Return("mockSvc1 (test)", nil)
 			},
 			wantEnv: "test",
 			wantSvc: "mockSvc1",
 		},
+		"success with a service name containing parentheses": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+						{
+							Name: "prod",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedServices(testApp, "test").
+					Return([]string{"api (v2)", "api"}, nil)
+				m.deploySvc.
+					EXPECT().
+					ListDeployedServices(testApp, "prod").
+					Return([]string{"api (v2)"}, nil)
+
+				m.prompt.
+					EXPECT().
+					SelectOption("Select a deployed service", "Help text", []prompt.SelectOption{{Value: "api (v2) (test)", Label: "api (v2)", Hint: "test"}, {Value: "api (test)", Label: "api", Hint: "test"}, {Value: "api (v2) (prod)", Label: "api (v2)", Hint: "prod"}}).
+					Return("api (v2) (prod)", nil)
+			},
+			wantEnv: "prod",
+			wantSvc: "api (v2)",
+		},
+		"pre-selects a service in the default environment": {
+			defaultEnv: "prod",
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+						{
+							Name: "prod",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedServices(testApp, "test").
+					Return([]string{"mockSvc"}, nil)
+				m.deploySvc.
+					EXPECT().
+					ListDeployedServices(testApp, "prod").
+					Return([]string{"mockSvc"}, nil)
+
+				m.prompt.
+					EXPECT().
+					SelectOption("Select a deployed service", "Help text", []prompt.SelectOption{{Value: "mockSvc (test)", Label: "mockSvc", Hint: "test"}, {Value: "mockSvc (prod)", Label: "mockSvc", Hint: "prod"}}, gomock.Any()).
+					Return("mockSvc (prod)", nil)
+			},
+			wantEnv: "prod",
+			wantSvc: "mockSvc",
+		},
 		"skip with only one deployed service": {
 			setupMocks: func(m deploySelectMocks) {
 				m.configSvc.
@@ -187,8 +251,9 @@ func TestDeploySelect_Service(t *testing.T) {
 
 			sel := DeploySelect{
 				Select: &Select{
-					config: mockconfigSvc,
-					prompt: mockprompt,
+					config:     mockconfigSvc,
+					prompt:     mockprompt,
+					defaultEnv: tc.defaultEnv,
 				},
 				deployStoreSvc: mockdeploySvc,
 			}
@@ -203,6 +268,204 @@ func TestDeploySelect_Service(t *testing.T) {
 	}
 }
 
+func TestDeploySelect_Job(t *testing.T) {
+	const testApp = "mockApp"
+	testCases := map[string]struct {
+		setupMocks func(mocks deploySelectMocks)
+		job        string
+		env        string
+
+		wantErr error
+		wantEnv string
+		wantJob string
+	}{
+		"return error if fail to list deployed jobs": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "test").
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list deployed job for environment test: some error"),
+		},
+		"return error if no deployed jobs found": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "test").
+					Return([]string{}, nil)
+			},
+			wantErr: fmt.Errorf("no deployed jobs found in application %s", testApp),
+		},
+		"return error if fail to select": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+						{
+							Name: "prod",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "test").
+					Return([]string{"mockJob"}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "prod").
+					Return([]string{"mockJob"}, nil)
+
+				m.prompt.
+					EXPECT().
+					SelectOption("Select a deployed job", "Help text", []prompt.SelectOption{{Value: "mockJob (test)", Label: "mockJob", Hint: "test"}, {Value: "mockJob (prod)", Label: "mockJob", Hint: "prod"}}).
+					Return("", errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("select deployed jobs for application %s: some error", testApp),
+		},
+		"success": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+						{
+							Name: "prod",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "test").
+					Return([]string{"mockJob"}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "prod").
+					Return([]string{"mockJob"}, nil)
+
+				m.prompt.
+					EXPECT().
+					SelectOption("Select a deployed job", "Help text", []prompt.SelectOption{{Value: "mockJob (test)", Label: "mockJob", Hint: "test"}, {Value: "mockJob (prod)", Label: "mockJob", Hint: "prod"}}).
+					Return("mockJob (prod)", nil)
+			},
+			wantEnv: "prod",
+			wantJob: "mockJob",
+		},
+		"skip with only one deployed job": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.
+					EXPECT().
+					ListEnvironments(testApp).
+					Return([]*config.Environment{
+						{
+							Name: "test",
+						},
+					}, nil)
+
+				m.deploySvc.
+					EXPECT().
+					ListDeployedJobs(testApp, "test").
+					Return([]string{"mockJob"}, nil)
+			},
+			wantEnv: "test",
+			wantJob: "mockJob",
+		},
+		"return error if fail to check if job passed in by flag is deployed or not": {
+			env: "test",
+			job: "mockJob",
+			setupMocks: func(m deploySelectMocks) {
+				m.deploySvc.
+					EXPECT().
+					IsJobDeployed(testApp, "test", "mockJob").
+					Return(false, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("check if job mockJob is deployed in environment test: some error"),
+		},
+		"return error if job passed in by flag is not deployed": {
+			env: "test",
+			job: "mockJob",
+			setupMocks: func(m deploySelectMocks) {
+				m.deploySvc.
+					EXPECT().
+					IsJobDeployed(testApp, "test", "mockJob").
+					Return(false, nil)
+			},
+			wantErr: fmt.Errorf("no deployed jobs found in application %s", testApp),
+		},
+		"success with flags": {
+			env: "test",
+			job: "mockJob",
+			setupMocks: func(m deploySelectMocks) {
+				m.deploySvc.
+					EXPECT().
+					IsJobDeployed(testApp, "test", "mockJob").
+					Return(true, nil)
+			},
+			wantEnv: "test",
+			wantJob: "mockJob",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockdeploySvc := mocks.NewMockDeployStoreClient(ctrl)
+			mockconfigSvc := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			tc.setupMocks(deploySelectMocks{
+				deploySvc: mockdeploySvc,
+				configSvc: mockconfigSvc,
+				prompt:    mockprompt,
+			})
+
+			sel := DeploySelect{
+				Select: &Select{
+					config: mockconfigSvc,
+					prompt: mockprompt,
+				},
+				deployStoreSvc: mockdeploySvc,
+			}
+			gotDeployed, err := sel.DeployedJob("Select a deployed job", "Help text", testApp, WithEnv(tc.env), WithJob(tc.job))
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantJob, gotDeployed.Job)
+			require.Equal(t, tc.wantEnv, gotDeployed.Env)
+		})
+	}
+}
+
 type workspaceSelectMocks struct {
 	workloadLister *mocks.MockWorkspaceRetriever
 	prompt         *mocks.MockPrompter
@@ -211,6 +474,9 @@ type workspaceSelectMocks struct {
 
 func TestWorkspaceSelect_Service(t *testing.T) {
 	testCases := map[string]struct {
+		inApp              string
+		inAllowAppOverride bool
+
 		setupMocks func(mocks workspaceSelectMocks)
 		wantErr    error
 		want       string
@@ -455,6 +721,43 @@ func TestWorkspaceSelect_Service(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("select service: error selecting"),
 		},
+		"with the application of the command different from the workspace's": {
+			inApp: "other-app",
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(
+					&workspace.Summary{
+						Application: "app-name",
+					}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Times(0)
+				m.configLister.EXPECT().ListServices(gomock.Any()).Times(0)
+			},
+			wantErr: &workspace.ErrAppMismatch{
+				WorkspaceApp: "app-name",
+				App:          "other-app",
+			},
+		},
+		"with the application of the command overriding the workspace's": {
+			inApp:              "other-app",
+			inAllowAppOverride: true,
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(
+					&workspace.Summary{
+						Application: "app-name",
+					}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return(
+					[]string{
+						"service1",
+					}, nil)
+				m.configLister.EXPECT().ListServices("other-app").Return(
+					[]*config.Workload{
+						{
+							App:  "other-app",
+							Name: "service1",
+						},
+					}, nil)
+			},
+			want: "service1",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -474,8 +777,10 @@ func TestWorkspaceSelect_Service(t *testing.T) {
 
 			sel := WorkspaceSelect{
 				Select: &Select{
-					prompt: mockprompt,
-					config: mockconfigLister,
+					prompt:           mockprompt,
+					config:           mockconfigLister,
+					app:              tc.inApp,
+					allowAppOverride: tc.inAllowAppOverride,
 				},
 				ws: mockwsRetriever,
 			}
@@ -491,6 +796,9 @@ func TestWorkspaceSelect_Service(t *testing.T) {
 
 func TestWorkspaceSelect_Job(t *testing.T) {
 	testCases := map[string]struct {
+		inApp              string
+		inAllowAppOverride bool
+
 		setupMocks func(mocks workspaceSelectMocks)
 		wantErr    error
 		want       string
@@ -750,6 +1058,43 @@ func TestWorkspaceSelect_Job(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("select job: error selecting"),
 		},
+		"with the application of the command different from the workspace's": {
+			inApp: "other-app",
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(
+					&workspace.Summary{
+						Application: "app-name",
+					}, nil)
+				m.workloadLister.EXPECT().JobNames().Times(0)
+				m.configLister.EXPECT().ListJobs(gomock.Any()).Times(0)
+			},
+			wantErr: &workspace.ErrAppMismatch{
+				WorkspaceApp: "app-name",
+				App:          "other-app",
+			},
+		},
+		"with the application of the command overriding the workspace's": {
+			inApp:              "other-app",
+			inAllowAppOverride: true,
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(
+					&workspace.Summary{
+						Application: "app-name",
+					}, nil)
+				m.workloadLister.EXPECT().JobNames().Return(
+					[]string{
+						"job1",
+					}, nil)
+				m.configLister.EXPECT().ListJobs("other-app").Return(
+					[]*config.Workload{
+						{
+							App:  "other-app",
+							Name: "job1",
+						},
+					}, nil)
+			},
+			want: "job1",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -769,8 +1114,10 @@ func TestWorkspaceSelect_Job(t *testing.T) {
 
 			sel := WorkspaceSelect{
 				Select: &Select{
-					prompt: mockprompt,
-					config: mockconfigLister,
+					prompt:           mockprompt,
+					config:           mockconfigLister,
+					app:              tc.inApp,
+					allowAppOverride: tc.inAllowAppOverride,
 				},
 				ws: mockwsRetriever,
 			}
@@ -805,7 +1152,7 @@ func TestConfigSelect_Service(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
 			},
@@ -826,7 +1173,7 @@ func TestConfigSelect_Service(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
 			},
@@ -852,10 +1199,10 @@ func TestConfigSelect_Service(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(
+					SelectOption(
 						gomock.Eq("Select a service"),
 						gomock.Eq("Help text"),
-						gomock.Eq([]string{"service1", "service2"})).
+						gomock.Eq([]prompt.SelectOption{{Value: "service1", Hint: "load balanced web service"}, {Value: "service2", Hint: "backend service"}})).
 					Return("service2", nil).
 					Times(1)
 			},
@@ -881,7 +1228,7 @@ func TestConfigSelect_Service(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"service1", "service2"})).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Eq([]prompt.SelectOption{{Value: "service1", Hint: "load balanced web service"}, {Value: "service2", Hint: "backend service"}})).
 					Return("", fmt.Errorf("error selecting")).
 					Times(1)
 			},
@@ -919,6 +1266,118 @@ func TestConfigSelect_Service(t *testing.T) {
 	}
 }
 
+func TestConfigSelect_Job(t *testing.T) {
+	appName := "myapp"
+	testCases := map[string]struct {
+		setupMocks func(m configSelectMocks)
+		wantErr    error
+		want       string
+	}{
+		"with no jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			wantErr: fmt.Errorf("no jobs found in app myapp"),
+		},
+		"with only one job (skips prompting)": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{
+						{
+							App:  appName,
+							Name: "job1",
+							Type: "Scheduled Job",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			want: "job1",
+		},
+		"with multiple jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{
+						{
+							App:  appName,
+							Name: "job1",
+							Type: "Scheduled Job",
+						},
+						{
+							App:  appName,
+							Name: "job2",
+							Type: "Scheduled Job",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(
+						gomock.Eq("Select a job"),
+						gomock.Eq("Help text"),
+						gomock.Eq([]string{"job1", "job2"})).
+					Return("job2", nil).
+					Times(1)
+			},
+			want: "job2",
+		},
+		"with error listing jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return(nil, fmt.Errorf("some error")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("get jobs for app myapp: list jobs: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockconfigLister := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := configSelectMocks{
+				serviceLister: mockconfigLister,
+				prompt:        mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := ConfigSelect{
+				Select: &Select{
+					prompt: mockprompt,
+				},
+				svcLister: mockconfigLister,
+			}
+
+			got, err := sel.Job("Select a job", "Help text", appName)
+			if tc.wantErr != nil {
+				require.EqualError(t, tc.wantErr, err.Error())
+			} else {
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 type environmentMocks struct {
 	envLister *mocks.MockConfigLister
 	prompt    *mocks.MockPrompter
@@ -930,6 +1389,7 @@ func TestSelect_Environment(t *testing.T) {
 
 	testCases := map[string]struct {
 		inAdditionalOpts []string
+		inDefaultEnv     string
 
 		setupMocks func(m environmentMocks)
 		wantErr    error
@@ -944,7 +1404,7 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
 			},
@@ -964,7 +1424,7 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
 			},
@@ -977,21 +1437,24 @@ func TestSelect_Environment(t *testing.T) {
 					ListEnvironments(gomock.Eq(appName)).
 					Return([]*config.Environment{
 						{
-							App:  appName,
-							Name: "env1",
+							App:       appName,
+							Name:      "env1",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
 						},
 						{
-							App:  appName,
-							Name: "env2",
+							App:    appName,
+							Name:   "env2",
+							Region: "us-east-1",
 						},
 					}, nil).
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(
+					SelectOption(
 						gomock.Eq("Select an environment"),
 						gomock.Eq("Help text"),
-						gomock.Eq([]string{"env1", "env2"})).
+						gomock.Eq([]prompt.SelectOption{{Value: "env1", Hint: "us-west-2, 123456789012"}, {Value: "env2", Hint: "us-east-1"}})).
 					Return("env2", nil).
 					Times(1)
 			},
@@ -1015,12 +1478,62 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"env1", "env2"})).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Eq([]prompt.SelectOption{{Value: "env1"}, {Value: "env2"}})).
 					Return("", fmt.Errorf("error selecting")).
 					Times(1)
 			},
 			wantErr: fmt.Errorf("select environment: error selecting"),
 		},
+		"pre-selects the default environment": {
+			inDefaultEnv: "env2",
+			setupMocks: func(m environmentMocks) {
+				m.envLister.
+					EXPECT().
+					ListEnvironments(gomock.Eq(appName)).
+					Return([]*config.Environment{
+						{
+							App:  appName,
+							Name: "env1",
+						},
+						{
+							App:  appName,
+							Name: "env2",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Eq([]prompt.SelectOption{{Value: "env1"}, {Value: "env2"}}), gomock.Any()).
+					Return("env2", nil).
+					Times(1)
+			},
+			want: "env2",
+		},
+		"ignores a default environment that is not listed": {
+			inDefaultEnv: "test",
+			setupMocks: func(m environmentMocks) {
+				m.envLister.
+					EXPECT().
+					ListEnvironments(gomock.Eq(appName)).
+					Return([]*config.Environment{
+						{
+							App:  appName,
+							Name: "env1",
+						},
+						{
+							App:  appName,
+							Name: "env2",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Eq([]prompt.SelectOption{{Value: "env1"}, {Value: "env2"}})).
+					Return("env1", nil).
+					Times(1)
+			},
+			want: "env1",
+		},
 		"no environment but with one additional option": {
 			inAdditionalOpts: []string{additionalOpt1},
 			setupMocks: func(m environmentMocks) {
@@ -1031,7 +1544,7 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 
@@ -1047,7 +1560,7 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), []string{additionalOpt1, additionalOpt2}).
+					SelectOption(gomock.Any(), gomock.Any(), []prompt.SelectOption{{Value: additionalOpt1}, {Value: additionalOpt2}}).
 					Times(1).
 					Return(additionalOpt2, nil)
 			},
@@ -1070,8 +1583,9 @@ func TestSelect_Environment(t *testing.T) {
 			tc.setupMocks(mocks)
 
 			sel := Select{
-				prompt: mockprompt,
-				config: mockenvLister,
+				prompt:     mockprompt,
+				config:     mockenvLister,
+				defaultEnv: tc.inDefaultEnv,
 			}
 
 			got, err := sel.Environment("Select an environment", "Help text", appName, tc.inAdditionalOpts...)
@@ -1089,6 +1603,96 @@ type applicationMocks struct {
 	prompt    *mocks.MockPrompter
 }
 
+func TestSelect_Environments(t *testing.T) {
+	appName := "myapp"
+
+	testCases := map[string]struct {
+		setupMocks func(m environmentMocks)
+		wantErr    error
+		want       []string
+	}{
+		"with no environments": {
+			setupMocks: func(m environmentMocks) {
+				m.envLister.EXPECT().ListEnvironments(appName).Return([]*config.Environment{}, nil)
+				m.prompt.EXPECT().MultiSelect(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: fmt.Errorf("no environments found in app myapp"),
+		},
+		"with only one environment (skips prompting)": {
+			setupMocks: func(m environmentMocks) {
+				m.envLister.EXPECT().ListEnvironments(appName).Return([]*config.Environment{
+					{
+						App:  appName,
+						Name: "env1",
+					},
+				}, nil)
+				m.prompt.EXPECT().MultiSelect(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			want: []string{"env1"},
+		},
+		"with multiple environments": {
+			setupMocks: func(m environmentMocks) {
+				m.envLister.EXPECT().ListEnvironments(appName).Return([]*config.Environment{
+					{
+						App:  appName,
+						Name: "env1",
+					},
+					{
+						App:  appName,
+						Name: "env2",
+					},
+				}, nil)
+				m.prompt.EXPECT().MultiSelect("Select environments", "Help text", []string{"env1", "env2"}).
+					Return([]string{"env1", "env2"}, nil)
+			},
+			want: []string{"env1", "env2"},
+		},
+		"with error selecting environments": {
+			setupMocks: func(m environmentMocks) {
+				m.envLister.EXPECT().ListEnvironments(appName).Return([]*config.Environment{
+					{
+						App:  appName,
+						Name: "env1",
+					},
+					{
+						App:  appName,
+						Name: "env2",
+					},
+				}, nil)
+				m.prompt.EXPECT().MultiSelect(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("error selecting"))
+			},
+			wantErr: fmt.Errorf("select environments: error selecting"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := environmentMocks{
+				envLister: mocks.NewMockConfigLister(ctrl),
+				prompt:    mocks.NewMockPrompter(ctrl),
+			}
+			tc.setupMocks(mocks)
+
+			sel := Select{
+				prompt: mocks.prompt,
+				config: mocks.envLister,
+			}
+
+			got, err := sel.Environments("Select environments", "Help text", appName)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 func TestSelect_Application(t *testing.T) {
 	testCases := map[string]struct {
 		setupMocks func(m applicationMocks)
@@ -1212,6 +1816,22 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 		"backend/Dockerfile",
 		"frontend/Dockerfile",
 	}
+	manyDockerfiles := []string{
+		"./Dockerfile",
+		"services/api/Dockerfile",
+		"services/api/Dockerfile.dev",
+		"services/billing/Dockerfile",
+		"services/cart/Dockerfile",
+		"services/catalog/Dockerfile",
+		"services/frontend/Dockerfile",
+		"services/orders/Dockerfile",
+		"services/payments/Dockerfile",
+		"services/search/Dockerfile",
+		"services/shipping/Dockerfile",
+		"services/users/Dockerfile",
+		"tools/Dockerfile",
+		"tools/Dockerfile.ci",
+	}
 	dockerfileOptions := []string{
 		"./Dockerfile",
 		"backend/Dockerfile",
@@ -1303,6 +1923,70 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("get custom Dockerfile path: some error"),
 		},
+		"selects the directory first if there are many Dockerfiles": {
+			mockWs: func(m *mocks.MockWorkspaceRetriever) {
+				m.EXPECT().ListDockerfiles().Return(manyDockerfiles, nil)
+			},
+			mockPrompt: func(m *mocks.MockPrompter) {
+				gomock.InOrder(
+					m.EXPECT().SelectOne(
+						"Which directory is your Dockerfile in?", gomock.Any(),
+						gomock.Eq([]string{
+							"./ (1 Dockerfile)",
+							"services/ (11 Dockerfiles)",
+							"tools/ (2 Dockerfiles)",
+							"Enter custom path for your Dockerfile",
+							"Use an existing image instead",
+						}),
+						gomock.Any(),
+					).Return("tools/ (2 Dockerfiles)", nil),
+					m.EXPECT().SelectOne(
+						gomock.Any(), gomock.Any(),
+						gomock.Eq([]string{
+							"tools/Dockerfile",
+							"tools/Dockerfile.ci",
+							"Enter custom path for your Dockerfile",
+							"Use an existing image instead",
+						}),
+						gomock.Any(),
+					).Return("tools/Dockerfile.ci", nil),
+				)
+			},
+			wantedDockerfile: "tools/Dockerfile.ci",
+		},
+		"uses an existing image from the directory selection": {
+			mockWs: func(m *mocks.MockWorkspaceRetriever) {
+				m.EXPECT().ListDockerfiles().Return(manyDockerfiles, nil)
+			},
+			mockPrompt: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne(
+					"Which directory is your Dockerfile in?", gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return("Use an existing image instead", nil)
+			},
+			wantedDockerfile: "Use an existing image instead",
+		},
+		"returns an error if fail to select the directory": {
+			mockWs: func(m *mocks.MockWorkspaceRetriever) {
+				m.EXPECT().ListDockerfiles().Return(manyDockerfiles, nil)
+			},
+			mockPrompt: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select directory of the Dockerfile: some error"),
+		},
+		"lists many Dockerfiles in a single directory without grouping": {
+			mockWs: func(m *mocks.MockWorkspaceRetriever) {
+				m.EXPECT().ListDockerfiles().Return(manyDockerfiles[1:12], nil)
+			},
+			mockPrompt: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne(
+					"prompt", gomock.Any(),
+					gomock.Eq(append(append([]string{}, manyDockerfiles[1:12]...), "Enter custom path for your Dockerfile", "Use an existing image instead")),
+					gomock.Any(),
+				).Return("services/api/Dockerfile", nil)
+			},
+			wantedDockerfile: "services/api/Dockerfile",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1351,10 +2035,10 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 	scheduleTypeHelp := "NO"
 
 	testCases := map[string]struct {
-		mockWs         func(retriever *mocks.MockWorkspaceRetriever)
-		mockPrompt     func(*mocks.MockPrompter)
-		wantedSchedule string
-		wantedErr      error
+		mockWs        func(retriever *mocks.MockWorkspaceRetriever)
+		mockPrompt    func(*mocks.MockPrompter)
+		wantedTrigger *JobTrigger
+		wantedErr     error
 	}{
 		"error asking schedule type": {
 			mockPrompt: func(m *mocks.MockPrompter) {
@@ -1371,7 +2055,7 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 					m.EXPECT().Get(ratePrompt, rateHelp, gomock.Any(), gomock.Any()).Return("1h30m", nil),
 				)
 			},
-			wantedSchedule: "@every 1h30m",
+			wantedTrigger: &JobTrigger{Schedule: "@every 1h30m"},
 		},
 		"error getting rate": {
 			mockPrompt: func(m *mocks.MockPrompter) {
@@ -1382,6 +2066,24 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 			},
 			wantedErr: errors.New("get schedule rate: some error"),
 		},
+		"ask for event pattern": {
+			mockPrompt: func(m *mocks.MockPrompter) {
+				gomock.InOrder(
+					m.EXPECT().SelectOne(scheduleTypePrompt, scheduleTypeHelp, scheduleTypes, gomock.Any()).Return(eventPattern, nil),
+					m.EXPECT().Get(eventPatternPrompt, eventPatternHelp, gomock.Any(), gomock.Any(), gomock.Any()).Return(`{"source": ["aws.s3"]}`, nil),
+				)
+			},
+			wantedTrigger: &JobTrigger{EventPattern: `{"source": ["aws.s3"]}`},
+		},
+		"error getting event pattern": {
+			mockPrompt: func(m *mocks.MockPrompter) {
+				gomock.InOrder(
+					m.EXPECT().SelectOne(scheduleTypePrompt, scheduleTypeHelp, scheduleTypes, gomock.Any()).Return(eventPattern, nil),
+					m.EXPECT().Get(eventPatternPrompt, eventPatternHelp, gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error")),
+				)
+			},
+			wantedErr: errors.New("get event pattern: some error"),
+		},
 		"ask for cron": {
 			mockPrompt: func(m *mocks.MockPrompter) {
 				gomock.InOrder(
@@ -1389,7 +2091,7 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 					m.EXPECT().SelectOne(schedulePrompt, scheduleHelp, presetSchedules, gomock.Any()).Return("Daily", nil),
 				)
 			},
-			wantedSchedule: "@daily",
+			wantedTrigger: &JobTrigger{Schedule: "@daily"},
 		},
 		"error getting cron": {
 			mockPrompt: func(m *mocks.MockPrompter) {
@@ -1409,7 +2111,7 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 					m.EXPECT().Confirm(humanReadableCronConfirmPrompt, humanReadableCronConfirmHelp).Return(true, nil),
 				)
 			},
-			wantedSchedule: "0 * * * *",
+			wantedTrigger: &JobTrigger{Schedule: "0 * * * *"},
 		},
 		"error getting custom schedule": {
 			mockPrompt: func(m *mocks.MockPrompter) {
@@ -1440,7 +2142,7 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 					m.EXPECT().Get(customSchedulePrompt, customScheduleHelp, gomock.Any(), gomock.Any()).Return("@hourly", nil),
 				)
 			},
-			wantedSchedule: "@hourly",
+			wantedTrigger: &JobTrigger{Schedule: "@hourly"},
 		},
 	}
 	for name, tc := range testCases {
@@ -1464,13 +2166,13 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 			var mockValidator prompt.ValidatorFunc = func(interface{}) error { return nil }
 
 			// WHEN
-			schedule, err := sel.Schedule(scheduleTypePrompt, scheduleTypeHelp, mockValidator, mockValidator)
+			trigger, err := sel.Schedule(scheduleTypePrompt, scheduleTypeHelp, mockValidator, mockValidator, mockValidator)
 
 			// THEN
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			} else {
-				require.Equal(t, tc.wantedSchedule, schedule)
+				require.Equal(t, tc.wantedTrigger, trigger)
 			}
 		})
 	}
@@ -49,3 +49,14 @@ type errHasExistingApplication struct {
 func (e *errHasExistingApplication) Error() string {
 	return fmt.Sprintf("this workspace is already registered with application %s", e.existingAppName)
 }
+
+// ErrAppMismatch means a command targets a different application than the one the workspace is registered with.
+type ErrAppMismatch struct {
+	WorkspaceApp string
+	App          string
+}
+
+func (e *ErrAppMismatch) Error() string {
+	return fmt.Sprintf(`workspace is registered with application %s but the command targets application %s: run the command outside of this workspace or drop the --app flag to use application %s, or pass --allow-app-override to use application %s with this workspace`,
+		e.WorkspaceApp, e.App, e.WorkspaceApp, e.App)
+}
@@ -8,7 +8,9 @@
 //  ├── copilot                        (application directory)
 //  │   ├── .workspace                 (workspace summary)
 //  │   └── my-service
-//  │   │   └── manifest.yml           (service manifest)
+//  │   │   ├── manifest.yml           (service manifest)
+//  │   │   └── overrides
+//  │   │       └── cfn.patches.yml    (optional patches to the service's CloudFormation template)
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
 //  │   └── pipeline.yml               (pipeline manifest)
 //  └── my-service-src                 (customer service code)
@@ -19,8 +21,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/spf13/afero"
@@ -34,19 +38,41 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	overridesDirName          = "overrides"
+	cfnPatchesFileName        = "cfn.patches.yml"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	gitIgnoreFileName         = ".gitignore"
 
 	ymlFileExtension = ".yml"
 
-	dockerfileName = "Dockerfile"
+	dockerfileName        = "Dockerfile"
+	copilotIgnoreFileName = ".copilotignore"
 )
 
+// dockerfileSkippedDirs are the directories that are never searched for Dockerfiles,
+// since they hold dependencies or version control data rather than the workspace's own code.
+var dockerfileSkippedDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// gitIgnorePatterns are the files under the copilot directory that shouldn't be committed to the git repository.
+var gitIgnorePatterns = []string{
+	CopilotDirName + "/**/addons/*.bak", // Backups of addons templates.
+	CopilotDirName + "/.deployments/",   // Local records of deployments.
+}
+
 // Summary is a description of what's associated with this workspace.
 type Summary struct {
-	Application string `yaml:"application"` // Name of the application.
+	Application        string `yaml:"application"`                   // Name of the application.
+	NotifyTopicARN     string `yaml:"notify_topic_arn,omitempty"`    // SNS topic that deployment events are published to by default.
+	DefaultEnvironment string `yaml:"default_environment,omitempty"` // Environment pre-selected when commands prompt for one.
+	BuildTool          string `yaml:"build_tool,omitempty"`          // Tool that builds the images of workloads by default.
+	DeploymentHistory  int    `yaml:"deployment_history,omitempty"`  // Number of deployment records kept for each workload and environment.
 }
 
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
@@ -95,7 +121,9 @@ func (ws *Workspace) Create(appName string) error {
 	// If there isn't an existing workspace summary, create it.
 	var notFound *errNoAssociatedApplication
 	if errors.As(err, &notFound) {
-		return ws.writeSummary(appName)
+		return ws.writeSummary(&Summary{
+			Application: appName,
+		})
 	}
 
 	return err
@@ -119,6 +147,16 @@ func (ws *Workspace) Summary() (*Summary, error) {
 	return nil, &errNoAssociatedApplication{}
 }
 
+// SetDefaultEnvironment saves the environment that commands pre-select when they prompt for one in the workspace summary.
+func (ws *Workspace) SetDefaultEnvironment(envName string) error {
+	summary, err := ws.Summary()
+	if err != nil {
+		return err
+	}
+	summary.DefaultEnvironment = envName
+	return ws.writeSummary(summary)
+}
+
 // ServiceNames returns the names of the services in the workspace.
 func (ws *Workspace) ServiceNames() ([]string, error) {
 	return ws.workloadNames(func(wlType string) bool {
@@ -301,6 +339,64 @@ func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name stri
 	return ws.write(data, svc, addonsDirName, fname)
 }
 
+// ReadCFNPatches returns the contents of the "overrides/cfn.patches.yml" file of a workload.
+// Patches are optional, so if the file or the workspace doesn't exist it returns nil and no error.
+func (ws *Workspace) ReadCFNPatches(wlName string) ([]byte, error) {
+	dat, err := ws.read(wlName, overridesDirName, cfnPatchesFileName)
+	if err != nil {
+		var errNoWorkspace *errWorkspaceNotFound
+		if os.IsNotExist(err) || errors.As(err, &errNoWorkspace) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return dat, nil
+}
+
+// AddGitIgnorePatterns appends the patterns of files that shouldn't be committed to the .gitignore file
+// next to the copilot directory, and creates the file if it doesn't exist.
+// Patterns that are already in the file aren't added again. It returns the patterns that were added.
+func (ws *Workspace) AddGitIgnorePatterns() ([]string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	fname := filepath.Join(filepath.Dir(copilotPath), gitIgnoreFileName)
+	exist, err := ws.fsUtils.Exists(fname)
+	if err != nil {
+		return nil, fmt.Errorf("check if %s exists: %w", fname, err)
+	}
+	var content []byte
+	if exist {
+		content, err = ws.fsUtils.ReadFile(fname)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", fname, err)
+		}
+	}
+	existingPatterns := make(map[string]bool)
+	for _, line := range strings.Split(string(content), "\n") {
+		existingPatterns[strings.TrimSpace(line)] = true
+	}
+	var added []string
+	for _, pattern := range gitIgnorePatterns {
+		if !existingPatterns[pattern] {
+			added = append(added, pattern)
+		}
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+	updated := string(content)
+	if updated != "" && !strings.HasSuffix(updated, "\n") {
+		updated += "\n"
+	}
+	updated += strings.Join(added, "\n") + "\n"
+	if err := ws.fsUtils.WriteFile(fname, []byte(updated), 0644 /* -rw-r--r-- */); err != nil {
+		return nil, fmt.Errorf("write %s: %w", fname, err)
+	}
+	return added, nil
+}
+
 // FileStat wraps the os.Stat function.
 type FileStat interface {
 	Stat(name string) (os.FileInfo, error)
@@ -312,16 +408,12 @@ func IsInGitRepository(fs FileStat) bool {
 	return !os.IsNotExist(err)
 }
 
-func (ws *Workspace) writeSummary(appName string) error {
+func (ws *Workspace) writeSummary(workspaceSummary *Summary) error {
 	summaryPath, err := ws.summaryPath()
 	if err != nil {
 		return err
 	}
 
-	workspaceSummary := Summary{
-		Application: appName,
-	}
-
 	serializedWorkspaceSummary, err := yaml.Marshal(workspaceSummary)
 
 	if err != nil {
@@ -434,47 +526,110 @@ func (ws *Workspace) read(elem ...string) ([]byte, error) {
 	return ws.fsUtils.ReadFile(filepath.Join(pathElems...))
 }
 
-// ListDockerfiles returns the list of Dockerfiles within the current
-// working directory and a sub-directory level below. If an error occurs while
-// reading directories, or no Dockerfiles found returns the error.
+// ListDockerfiles returns the Dockerfiles under the current working directory, sorted by path.
+// Files named "Dockerfile" or "Dockerfile.<suffix>", such as "Dockerfile.prod", are Dockerfiles.
+// Dependency and version control directories are skipped, as well as the files and directories
+// matching a pattern of the ".copilotignore" file in the working directory.
 func (ws *Workspace) ListDockerfiles() ([]string, error) {
-	wdFiles, err := ws.fsUtils.ReadDir(ws.workingDir)
+	ignored, err := ws.copilotIgnorePatterns()
 	if err != nil {
-		return nil, fmt.Errorf("read directory: %w", err)
+		return nil, err
 	}
-	var directories []string
-	for _, wdFile := range wdFiles {
-		// Add current directory if a Dockerfile exists, otherwise continue.
-		if !wdFile.IsDir() {
-			if wdFile.Name() == dockerfileName {
-				directories = append(directories, filepath.Dir(wdFile.Name()))
-			}
-			continue
+	dockerfiles := []string{}
+	err = ws.fsUtils.Walk(ws.workingDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
 		}
-
-		// Add sub-directories containing a Dockerfile one level below current directory.
-		subFiles, err := ws.fsUtils.ReadDir(wdFile.Name())
+		relPath, err := filepath.Rel(ws.workingDir, fullPath)
 		if err != nil {
-			return nil, fmt.Errorf("read directory: %w", err)
+			return err
 		}
-		for _, f := range subFiles {
-			// NOTE: ignore directories in sub-directories.
-			if f.IsDir() {
-				continue
+		if relPath == "." {
+			return nil
+		}
+		if info.IsDir() {
+			if dockerfileSkippedDirs[info.Name()] || ignored.match(relPath, true) {
+				return filepath.SkipDir
 			}
+			return nil
+		}
+		if !isDockerfile(info.Name()) || ignored.match(relPath, false) {
+			return nil
+		}
+		if filepath.Dir(relPath) == "." {
+			// Keep the "./" prefix for Dockerfiles in the working directory so that they read as paths.
+			relPath = "./" + relPath
+		}
+		dockerfiles = append(dockerfiles, filepath.ToSlash(relPath))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read directory: %w", err)
+	}
+	sort.Strings(dockerfiles)
+	return dockerfiles, nil
+}
 
-			if f.Name() == dockerfileName {
-				directories = append(directories, wdFile.Name())
-			}
+// isDockerfile returns true if the file is named "Dockerfile" or "Dockerfile.<suffix>".
+// The Dockerfile-specific ignore files of BuildKit, such as "Dockerfile.dockerignore", aren't Dockerfiles.
+func isDockerfile(name string) bool {
+	if name == dockerfileName {
+		return true
+	}
+	return strings.HasPrefix(name, dockerfileName+".") && len(name) > len(dockerfileName)+1 &&
+		!strings.HasSuffix(name, ".dockerignore")
+}
+
+// ignorePatterns are the patterns of a ".copilotignore" file.
+type ignorePatterns []string
+
+// copilotIgnorePatterns returns the patterns of the ".copilotignore" file in the working directory, if any.
+// Blank lines and lines starting with "#" are skipped.
+func (ws *Workspace) copilotIgnorePatterns() (ignorePatterns, error) {
+	ignoreFile := filepath.Join(ws.workingDir, copilotIgnoreFileName)
+	exists, err := ws.fsUtils.Exists(ignoreFile)
+	if err != nil {
+		return nil, fmt.Errorf("check if %s exists: %w", copilotIgnoreFileName, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	content, err := ws.fsUtils.ReadFile(ignoreFile)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", copilotIgnoreFileName, err)
+	}
+	var patterns ignorePatterns
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
 		}
+		patterns = append(patterns, line)
 	}
-	sort.Strings(directories)
-	dockerfiles := make([]string, 0, len(directories))
-	for _, dir := range directories {
-		file := dir + "/" + dockerfileName
-		dockerfiles = append(dockerfiles, file)
+	return patterns, nil
+}
+
+// match returns true if the path, relative to the working directory, matches one of the patterns.
+// Like in a ".gitignore" file, a pattern with a trailing "/" only matches directories, a pattern with
+// another "/" is matched against the whole path, and other patterns are matched against the name of the file.
+func (patterns ignorePatterns) match(relPath string, isDir bool) bool {
+	relPath = filepath.ToSlash(relPath)
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "/") {
+			if !isDir {
+				continue
+			}
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		target := path.Base(relPath)
+		if strings.Contains(pattern, "/") {
+			pattern, target = strings.TrimPrefix(pattern, "/"), relPath
+		}
+		if matched, _ := path.Match(pattern, target); matched {
+			return true
+		}
 	}
-	return dockerfiles, nil
+	return false
 }
 
 // RelPath returns the path relative to the current working directory.
@@ -160,6 +160,60 @@ func TestWorkspace_Summary(t *testing.T) {
 	}
 }
 
+func TestWorkspace_SetDefaultEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		mockFileSystem func(fs afero.Fs)
+
+		wantedSummary string
+		wantedErr     error
+	}{
+		"keeps the rest of the summary": {
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/copilot/.workspace", []byte("application: phonetool\nnotify_topic_arn: arn:aws:sns:us-west-2:123456789012:deployments\n"), 0644)
+			},
+			wantedSummary: "application: phonetool\nnotify_topic_arn: arn:aws:sns:us-west-2:123456789012:deployments\ndefault_environment: test\n",
+		},
+		"replaces the previous default environment": {
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/copilot/.workspace", []byte("application: phonetool\ndefault_environment: prod\n"), 0644)
+			},
+			wantedSummary: "application: phonetool\ndefault_environment: test\n",
+		},
+		"returns error if there is no workspace summary": {
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+			},
+			wantedErr: fmt.Errorf("couldn't find an application associated with this workspace"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.mockFileSystem(fs)
+			ws := Workspace{
+				workingDir: "test/",
+				fsUtils:    &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			err := ws.SetDefaultEnvironment("test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			out, err := afero.ReadFile(fs, "test/copilot/.workspace")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSummary, string(out))
+		})
+	}
+}
+
 func TestWorkspace_Create(t *testing.T) {
 	testCases := map[string]struct {
 		appName        string
@@ -241,6 +295,72 @@ func TestWorkspace_Create(t *testing.T) {
 	}
 }
 
+func TestWorkspace_AddGitIgnorePatterns(t *testing.T) {
+	testCases := map[string]struct {
+		workingDir     string
+		mockFileSystem func(fs afero.Fs)
+
+		wantedAdded     []string
+		wantedGitIgnore string
+		wantedErr       error
+	}{
+		"creates the .gitignore file if it doesn't exist": {
+			workingDir: "test/",
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+			},
+			wantedAdded:     []string{"copilot/**/addons/*.bak", "copilot/.deployments/"},
+			wantedGitIgnore: "copilot/**/addons/*.bak\ncopilot/.deployments/\n",
+		},
+		"appends only the missing patterns next to the copilot directory": {
+			workingDir: "test/copilot",
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/.gitignore", []byte("node_modules/\ncopilot/**/addons/*.bak"), 0644)
+			},
+			wantedAdded:     []string{"copilot/.deployments/"},
+			wantedGitIgnore: "node_modules/\ncopilot/**/addons/*.bak\ncopilot/.deployments/\n",
+		},
+		"does not modify the file if all the patterns exist": {
+			workingDir: "test/",
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/.gitignore", []byte("copilot/.deployments/\ncopilot/**/addons/*.bak\n"), 0644)
+			},
+			wantedGitIgnore: "copilot/.deployments/\ncopilot/**/addons/*.bak\n",
+		},
+		"returns an error if there is no copilot directory": {
+			workingDir:     "test/",
+			mockFileSystem: func(fs afero.Fs) {},
+			wantedErr:      fmt.Errorf("couldn't find a directory called copilot up to 5 levels up from test/"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.mockFileSystem(fs)
+			ws := Workspace{
+				workingDir: tc.workingDir,
+				fsUtils:    &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			added, err := ws.AddGitIgnorePatterns()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAdded, added)
+			content, err := afero.ReadFile(fs, "test/.gitignore")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGitIgnore, string(content))
+		})
+	}
+}
 func TestWorkspace_ServiceNames(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string
@@ -662,6 +782,59 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadCFNPatches(t *testing.T) {
+	testCases := map[string]struct {
+		copilotDir string
+		fs         func() afero.Fs
+
+		wantedData []byte
+	}{
+		"no workspace": {
+			fs: func() afero.Fs {
+				return afero.NewMemMapFs()
+			},
+		},
+		"no patches file": {
+			copilotDir: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				return fs
+			},
+		},
+		"reads the patches file": {
+			copilotDir: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook/overrides", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/overrides/cfn.patches.yml", []byte("- op: remove"), 0644)
+				return fs
+			},
+			wantedData: []byte("- op: remove"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: tc.copilotDir,
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			data, err := ws.ReadCFNPatches("webhook")
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedData, data)
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -829,12 +1002,12 @@ func TestWorkspace_ListDockerfiles(t *testing.T) {
 	}{
 		"find Dockerfiles": {
 			mockFileSystem: func(mockFS afero.Fs) {
-				mockFS.MkdirAll("frontend", 0755)
-				mockFS.MkdirAll("backend", 0755)
+				mockFS.MkdirAll("/frontend", 0755)
+				mockFS.MkdirAll("/backend", 0755)
 
-				afero.WriteFile(mockFS, "Dockerfile", []byte("FROM nginx"), 0644)
-				afero.WriteFile(mockFS, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
-				afero.WriteFile(mockFS, "backend/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/backend/Dockerfile", []byte("FROM nginx"), 0644)
 			},
 			err:         nil,
 			dockerfiles: wantedDockerfiles,
@@ -843,6 +1016,58 @@ func TestWorkspace_ListDockerfiles(t *testing.T) {
 			mockFileSystem: func(mockFS afero.Fs) {},
 			dockerfiles:    []string{},
 		},
+		"find Dockerfiles with a suffix and in nested directories": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("/services/api", 0755)
+
+				afero.WriteFile(mockFS, "/Dockerfile.prod", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/Dockerfile.dockerignore", []byte("*.md"), 0644)
+				afero.WriteFile(mockFS, "/Dockerfile.", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/prod.Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/services/api/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/services/api/Dockerfile.dev", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"./Dockerfile.prod", "services/api/Dockerfile", "services/api/Dockerfile.dev"},
+		},
+		"skip dependency and version control directories": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("/frontend/node_modules/some-pkg", 0755)
+				mockFS.MkdirAll("/vendor/github.com/some/pkg", 0755)
+				mockFS.MkdirAll("/.git", 0755)
+
+				afero.WriteFile(mockFS, "/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/frontend/node_modules/some-pkg/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/vendor/github.com/some/pkg/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/.git/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"frontend/Dockerfile"},
+		},
+		"skip the files and directories in the .copilotignore file": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("/frontend/test", 0755)
+				mockFS.MkdirAll("/backend/test", 0755)
+				mockFS.MkdirAll("/third_party/lib", 0755)
+				mockFS.MkdirAll("/tools", 0755)
+
+				afero.WriteFile(mockFS, "/.copilotignore", []byte(`# Vendored code.
+/third_party/
+
+test/
+*.local
+tools/Dockerfile
+`), 0644)
+				afero.WriteFile(mockFS, "/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/Dockerfile.local", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/frontend/test/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/backend/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/backend/test/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/third_party/lib/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/tools/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "/tools/Dockerfile.ci", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"./Dockerfile", "backend/Dockerfile", "frontend/Dockerfile", "tools/Dockerfile.ci"},
+		},
 	}
 
 	for name, tc := range testCases {
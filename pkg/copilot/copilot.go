@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package copilot exposes the deploy and describe operations of the Copilot CLI as a Go API,
+so that other tools can deploy and inspect Copilot workloads without running the copilot binary.
+
+The functions never prompt for input and never exit the process. They use the credentials of the AWS session
+in their input, which must be configured in the account and region of the application, and they assume the
+manager role of an environment to act on its resources. Progress messages are written to the input writer, if any.
+
+Stability
+
+This package follows semantic versioning: within a major version, exported identifiers are not removed or renamed,
+function signatures don't change, and input and output structs only gain new optional fields.
+Use field names in struct literals so that new fields don't break your build.
+Packages under the module's "internal" directory are not covered by this contract and may change at any time.
+*/
+package copilot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	errNoApp     = errors.New("application name is required")
+	errNoEnv     = errors.New("environment name is required")
+	errNoSvc     = errors.New("service name is required")
+	errNoSession = errors.New("session is required")
+)
+
+// logf writes a progress message to w if it isn't nil.
+func logf(w io.Writer, format string, args ...interface{}) {
+	if w == nil {
+		return
+	}
+	fmt.Fprintf(w, format, args...)
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package copilot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+)
+
+// DescribeEnvInput holds the fields to describe an environment.
+type DescribeEnvInput struct {
+	App string // Required. Name of the application.
+	Env string // Required. Name of the environment.
+
+	Session *session.Session // Required. Session in the account and region of the application.
+}
+
+func (in DescribeEnvInput) validate() error {
+	if in.App == "" {
+		return errNoApp
+	}
+	if in.Env == "" {
+		return errNoEnv
+	}
+	if in.Session == nil {
+		return errNoSession
+	}
+	return nil
+}
+
+// EnvDescription holds the information about an environment.
+type EnvDescription struct {
+	Name      string
+	App       string
+	Region    string
+	AccountID string
+	Prod      bool
+	Services  []string          // Names of the services deployed to the environment.
+	Tags      map[string]string // Tags of the environment stack.
+}
+
+// DescribeEnv returns the configuration of an environment and the services deployed to it.
+func DescribeEnv(ctx context.Context, in DescribeEnvInput) (*EnvDescription, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	store := config.NewStoreFromSession(in.Session)
+	d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+		App:         in.App,
+		Env:         in.Env,
+		ConfigStore: store,
+		DeployStore: deploy.NewStoreFromSession(store, in.Session),
+		Session:     in.Session,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create describer for environment %s: %w", in.Env, err)
+	}
+	descr, err := d.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe environment %s: %w", in.Env, err)
+	}
+	return newEnvDescription(descr), nil
+}
+
+func newEnvDescription(descr *describe.EnvDescription) *EnvDescription {
+	svcs := make([]string, len(descr.Services))
+	for i, svc := range descr.Services {
+		svcs[i] = svc.Name
+	}
+	return &EnvDescription{
+		Name:      descr.Environment.Name,
+		App:       descr.Environment.App,
+		Region:    descr.Environment.Region,
+		AccountID: descr.Environment.AccountID,
+		Prod:      descr.Environment.Prod,
+		Services:  svcs,
+		Tags:      descr.Tags,
+	}
+}
+
+// ListDeployedServicesInput holds the fields to list the services deployed to an environment.
+type ListDeployedServicesInput struct {
+	App string // Required. Name of the application.
+	Env string // Required. Name of the environment.
+
+	Session *session.Session // Required. Session in the account and region of the application.
+}
+
+func (in ListDeployedServicesInput) validate() error {
+	if in.App == "" {
+		return errNoApp
+	}
+	if in.Env == "" {
+		return errNoEnv
+	}
+	if in.Session == nil {
+		return errNoSession
+	}
+	return nil
+}
+
+// ListDeployedServices returns the names of the services deployed to an environment.
+func ListDeployedServices(ctx context.Context, in ListDeployedServicesInput) ([]string, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	store := config.NewStoreFromSession(in.Session)
+	svcs, err := deploy.NewStoreFromSession(store, in.Session).ListDeployedServices(in.App, in.Env)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed to environment %s: %w", in.Env, err)
+	}
+	return svcs, nil
+}
@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package copilot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnvDescription(t *testing.T) {
+	descr := &describe.EnvDescription{
+		Environment: &config.Environment{
+			App:            "phonetool",
+			Name:           "test",
+			Region:         "us-west-2",
+			AccountID:      "123456789012",
+			Prod:           true,
+			ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+		},
+		Services: []*config.Workload{
+			{
+				Name: "frontend",
+				Type: "Load Balanced Web Service",
+			},
+			{
+				Name: "backend",
+				Type: "Backend Service",
+			},
+		},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+		},
+	}
+
+	got := newEnvDescription(descr)
+
+	require.Equal(t, &EnvDescription{
+		Name:      "test",
+		App:       "phonetool",
+		Region:    "us-west-2",
+		AccountID: "123456789012",
+		Prod:      true,
+		Services:  []string{"frontend", "backend"},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+		},
+	}, got)
+}
+
+func TestDescribeEnv_validate(t *testing.T) {
+	_, err := DescribeEnv(context.Background(), DescribeEnvInput{App: "phonetool"})
+
+	require.EqualError(t, err, errNoEnv.Error())
+}
+
+func TestListDeployedServices_validate(t *testing.T) {
+	_, err := ListDeployedServices(context.Background(), ListDeployedServicesInput{App: "phonetool", Env: "test"})
+
+	require.EqualError(t, err, errNoSession.Error())
+}
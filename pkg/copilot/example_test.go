@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package copilot_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/pkg/copilot"
+)
+
+func ExampleDeployService() {
+	sess := session.Must(session.NewSession(aws.NewConfig().WithRegion("us-west-2")))
+	mft, err := ioutil.ReadFile("copilot/frontend/manifest.yml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err := copilot.DeployService(context.Background(), copilot.DeployServiceInput{
+		App:      "phonetool",
+		Env:      "test",
+		Name:     "frontend",
+		Manifest: mft,
+		ImageTag: "v1.0",
+		Session:  sess,
+		Progress: os.Stderr,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !out.Deployed {
+		fmt.Printf("Stack %s is already up to date.\n", out.StackName)
+	}
+}
+
+func ExampleDescribeEnv() {
+	sess := session.Must(session.NewSession(aws.NewConfig().WithRegion("us-west-2")))
+	env, err := copilot.DescribeEnv(context.Background(), copilot.DescribeEnvInput{
+		App:     "phonetool",
+		Env:     "test",
+		Session: sess,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Environment %s in account %s and region %s runs %d services.\n", env.Name, env.AccountID, env.Region, len(env.Services))
+}
+
+func ExampleListDeployedServices() {
+	sess := session.Must(session.NewSession(aws.NewConfig().WithRegion("us-west-2")))
+	svcs, err := copilot.ListDeployedServices(context.Background(), copilot.ListDeployedServicesInput{
+		App:     "phonetool",
+		Env:     "test",
+		Session: sess,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, svc := range svcs {
+		fmt.Println(svc)
+	}
+}
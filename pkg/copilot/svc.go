@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package copilot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// DeployServiceInput holds the fields to deploy a service to an environment.
+type DeployServiceInput struct {
+	App      string // Required. Name of the application.
+	Env      string // Required. Name of the environment to deploy to.
+	Name     string // Required. Name of the service.
+	Manifest []byte // Required. Contents of the service's manifest file.
+
+	ImageTag          string            // Optional. Tag of the image pushed to the service's ECR repository, required if the manifest builds the image from a Dockerfile.
+	SidecarImageTags  map[string]string // Optional. Tags of the sidecar images pushed to the service's ECR repository, keyed by sidecar name.
+	AddonsTemplateURL string            // Optional. S3 object URL of the service's addons template.
+	ResourceTags      map[string]string // Optional. Tags applied to the service's resources in addition to the application's tags.
+	Force             bool              // Optional. True deploys the service even if its stack wouldn't change.
+	NoWait            bool              // Optional. True returns once the stack create or update has started.
+
+	Session  *session.Session // Required. Session in the account and region of the application.
+	Progress io.Writer        // Optional. Writer that progress messages are written to.
+}
+
+func (in DeployServiceInput) validate() error {
+	if in.App == "" {
+		return errNoApp
+	}
+	if in.Env == "" {
+		return errNoEnv
+	}
+	if in.Name == "" {
+		return errNoSvc
+	}
+	if len(in.Manifest) == 0 {
+		return errors.New("manifest is required")
+	}
+	if in.Session == nil {
+		return errNoSession
+	}
+	return nil
+}
+
+// DeployServiceOutput holds the result of a service deployment.
+type DeployServiceOutput struct {
+	StackName    string // Name of the service's CloudFormation stack in the environment.
+	Deployed     bool   // False if the deployment was skipped because the stack wouldn't change.
+	TemplateHash string // SHA-256 hash of the deployed stack template, empty if the deployment was skipped.
+}
+
+type appResourcesGetter interface {
+	GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error)
+}
+
+type serviceDeployer interface {
+	DeployService(conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
+	DeployServiceNoWait(conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
+	IsServiceUpToDate(conf cloudformation.StackConfiguration) (bool, error)
+}
+
+type stackDescriber interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+}
+
+type certValidator interface {
+	ValidateCertAliases(aliases []string, certARNs []string) error
+}
+
+// DeployService creates or updates the CloudFormation stack of a service in an environment.
+// The images and the addons template of the service must already be uploaded, and the environment must be
+// on the latest version. Unless Force is set, the stack isn't updated if it wouldn't change.
+//
+// The context is checked between steps. If it's canceled while the stack's change set is created, the change set
+// is deleted; once the change set is executed, DeployService stops waiting but CloudFormation keeps applying it.
+func DeployService(ctx context.Context, in DeployServiceInput) (*DeployServiceOutput, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+	store := config.NewStoreFromSession(in.Session)
+	app, err := store.GetApplication(in.App)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", in.App, err)
+	}
+	env, err := store.GetEnvironment(in.App, in.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", in.Env, err)
+	}
+	envSess, err := sessions.AssumeRole(in.Session, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume environment manager role: %w", err)
+	}
+	d := &svcDeployer{
+		in:        in,
+		app:       app,
+		env:       env,
+		unmarshal: manifest.UnmarshalWorkload,
+		appCFN:    cloudformation.New(in.Session),
+		svcCFN:    cloudformation.New(envSess).WithContext(ctx),
+		envStack:  awscloudformation.New(envSess),
+		certs:     acm.New(envSess),
+	}
+	return d.deploy(ctx)
+}
+
+// svcDeployer deploys a service to the environment once the configuration of the application
+// and of the environment are retrieved.
+type svcDeployer struct {
+	in  DeployServiceInput
+	app *config.Application
+	env *config.Environment
+
+	unmarshal func([]byte) (interface{}, error)
+	appCFN    appResourcesGetter
+	svcCFN    serviceDeployer
+	envStack  stackDescriber
+	certs     certValidator
+}
+
+func (d *svcDeployer) deploy(ctx context.Context) (*DeployServiceOutput, error) {
+	out := &DeployServiceOutput{
+		StackName: stack.NameForService(d.app.Name, d.env.Name, d.in.Name),
+	}
+	conf, err := d.stackConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	upToDate, err := d.isUpToDate(conf)
+	if err != nil {
+		return nil, err
+	}
+	if upToDate {
+		logf(d.in.Progress, "No changes detected for %s in %s.\n", d.in.Name, d.env.Name)
+		return out, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	hash, err := cloudformation.TemplateHash(conf)
+	if err != nil {
+		return nil, fmt.Errorf("hash the template of service %s: %w", d.in.Name, err)
+	}
+	deployFn := d.svcCFN.DeployService
+	if d.in.NoWait {
+		deployFn = d.svcCFN.DeployServiceNoWait
+	}
+	logf(d.in.Progress, "Deploying %s to %s, the stack is %s.\n", d.in.Name, d.env.Name, out.StackName)
+	if err := deployFn(conf, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN)); err != nil {
+		return nil, fmt.Errorf("deploy service: %w", err)
+	}
+	out.Deployed = true
+	out.TemplateHash = hash
+	return out, nil
+}
+
+// isUpToDate returns true if the deployment isn't forced and the deployed service stack
+// already has the same template, parameters and tags as the configuration.
+func (d *svcDeployer) isUpToDate(conf cloudformation.StackConfiguration) (bool, error) {
+	if d.in.Force {
+		return false, nil
+	}
+	upToDate, err := d.svcCFN.IsServiceUpToDate(conf)
+	if err != nil {
+		return false, fmt.Errorf("compare service %s with its deployed stack: %w", d.in.Name, err)
+	}
+	return upToDate, nil
+}
+
+func (d *svcDeployer) stackConfiguration() (cloudformation.StackConfiguration, error) {
+	mft, err := d.unmarshal(d.in.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal service %s manifest: %w", d.in.Name, err)
+	}
+	rc, err := d.runtimeConfig()
+	if err != nil {
+		return nil, err
+	}
+	outputs, exports, err := d.envOutputs()
+	if err != nil {
+		return nil, err
+	}
+	rc.SecurityGroups = importedSecurityGroups(outputs)
+	rc.EnvOutputExports = exports
+	var conf cloudformation.StackConfiguration
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		rc.EnableIPv6 = isIPv6Enabled(outputs)
+		rc.ImportedCertARNs = importedCertARNs(outputs)
+		switch {
+		case d.app.RequiresDNSDelegation():
+			conf, err = stack.NewHTTPSLoadBalancedWebService(t, d.env.Name, deploy.AppInformation{
+				Name:      d.env.App,
+				AccountID: d.app.AccountID,
+				DNSName:   d.app.Domain,
+			}, *rc)
+		case len(rc.ImportedCertARNs) != 0:
+			if err := d.validateCertAliases(t, rc.ImportedCertARNs); err != nil {
+				return nil, err
+			}
+			conf, err = stack.NewHTTPSLoadBalancedWebService(t, d.env.Name, deploy.AppInformation{
+				Name:      d.env.App,
+				AccountID: d.app.AccountID,
+			}, *rc)
+		default:
+			conf, err = stack.NewLoadBalancedWebService(t, d.env.Name, d.env.App, *rc)
+		}
+	case *manifest.BackendService:
+		conf, err = stack.NewBackendService(t, d.env.Name, d.env.App, *rc)
+	default:
+		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create stack configuration: %w", err)
+	}
+	return conf, nil
+}
+
+// validateCertAliases returns an error if the certificates imported by the environment don't cover
+// the aliases of the service in the environment.
+func (d *svcDeployer) validateCertAliases(mft *manifest.LoadBalancedWebService, certARNs []string) error {
+	envMft, err := mft.ApplyEnv(d.env.Name)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", d.env.Name, err)
+	}
+	if err := d.certs.ValidateCertAliases(envMft.Alias, certARNs); err != nil {
+		return fmt.Errorf("validate aliases of service %s: %w", d.in.Name, err)
+	}
+	return nil
+}
+
+func (d *svcDeployer) runtimeConfig() (*stack.RuntimeConfig, error) {
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL: d.in.AddonsTemplateURL,
+		AdditionalTags:    tags.Merge(d.app.Tags, d.in.ResourceTags),
+		AccountID:         d.env.AccountID,
+	}
+	if d.in.ImageTag == "" && len(d.in.SidecarImageTags) == 0 {
+		return rc, nil
+	}
+	resources, err := d.appCFN.GetAppResourcesByRegion(d.app, d.env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", d.app.Name, d.env.Region, err)
+	}
+	repoURL, ok := resources.RepositoryURLs[d.in.Name]
+	if !ok {
+		return nil, fmt.Errorf("ECR repository not found for service %s in region %s and account %s", d.in.Name, d.env.Region, d.app.AccountID)
+	}
+	rc.SidecarImages = stack.SidecarImageLocations(repoURL, d.in.SidecarImageTags)
+	if d.in.ImageTag != "" {
+		rc.Image = &stack.ECRImage{
+			RepoURL:  repoURL,
+			ImageTag: d.in.ImageTag,
+		}
+	}
+	return rc, nil
+}
+
+// envOutputs returns the values of the environment stack outputs keyed by output name,
+// and the export names of the exported outputs keyed by output name.
+func (d *svcDeployer) envOutputs() (outputs map[string]string, exports map[string]string, err error) {
+	descr, err := d.envStack.Describe(stack.NameForEnv(d.app.Name, d.env.Name))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get outputs of environment %s: %w", d.env.Name, err)
+	}
+	outputs = make(map[string]string, len(descr.Outputs))
+	exports = make(map[string]string)
+	for _, output := range descr.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+		if output.ExportName != nil {
+			exports[aws.StringValue(output.OutputKey)] = aws.StringValue(output.ExportName)
+		}
+	}
+	return outputs, exports, nil
+}
+
+// isIPv6Enabled returns true if the environment's VPC and load balancer support IPv6.
+func isIPv6Enabled(envOutputs map[string]string) bool {
+	// Environments created without IPv6 support don't have the output.
+	return envOutputs[stack.EnvOutputIPv6Enabled] == "true"
+}
+
+// importedSecurityGroups returns the security groups imported with the VPC of the environment, if any.
+func importedSecurityGroups(envOutputs map[string]string) []string {
+	// Environments created without importing security groups don't have the output.
+	sgs := envOutputs[stack.EnvOutputImportedSecurityGroups]
+	if sgs == "" {
+		return nil
+	}
+	return strings.Split(sgs, ",")
+}
+
+// importedCertARNs returns the ACM certificates imported by the environment for its HTTPS listener, if any.
+func importedCertARNs(envOutputs map[string]string) []string {
+	// Environments created without importing certificates don't have the output.
+	arns := envOutputs[stack.EnvOutputImportedCertARNs]
+	if arns == "" {
+		return nil
+	}
+	return strings.Split(arns, ",")
+}
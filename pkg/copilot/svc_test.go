@@ -0,0 +1,336 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package copilot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/pkg/copilot/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcDeployerMocks struct {
+	appCFN   *mocks.MockappResourcesGetter
+	svcCFN   *mocks.MockserviceDeployer
+	envStack *mocks.MockstackDescriber
+	certs    *mocks.MockcertValidator
+}
+
+func TestSvcDeployer_deploy(t *testing.T) {
+	envStack := &awscloudformation.StackDescription{
+		Outputs: []*sdkcloudformation.Output{
+			{
+				OutputKey:   aws.String(stack.EnvOutputImportedSecurityGroups),
+				OutputValue: aws.String("sg-1234"),
+			},
+		},
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	testCases := map[string]struct {
+		ctx            context.Context
+		inImageTag     string
+		inSidecarTags  map[string]string
+		inForce        bool
+		inNoWait       bool
+		inManifest     interface{} // Defaults to a backend service.
+		setupMocks     func(m svcDeployerMocks)
+		wantedDeployed bool
+		wantedProgress string
+		wantedErr      error
+	}{
+		"wraps error if fail to get the environment outputs": {
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of environment test: some error"),
+		},
+		"errors if the service has no ECR repository in the region": {
+			inImageTag: "v1.0",
+			setupMocks: func(m svcDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{}, nil)
+			},
+			wantedErr: errors.New("ECR repository not found for service frontend in region us-west-2 and account 123456789012"),
+		},
+		"wraps error if the imported certificates don't cover the aliases": {
+			inManifest: &manifest.LoadBalancedWebService{
+				Workload: manifest.Workload{
+					Name: aws.String("frontend"),
+				},
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: manifest.Alias{"api.example.com"},
+					},
+				},
+			},
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(&awscloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{
+							OutputKey:   aws.String(stack.EnvOutputImportedCertARNs),
+							OutputValue: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/cert"),
+						},
+					},
+				}, nil)
+				m.certs.EXPECT().ValidateCertAliases([]string{"api.example.com"}, []string{"arn:aws:acm:us-west-2:123456789012:certificate/cert"}).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("validate aliases of service frontend: some error"),
+		},
+		"returns the context error before comparing the stack": {
+			ctx: canceled,
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.svcCFN.EXPECT().IsServiceUpToDate(gomock.Any()).Times(0)
+			},
+			wantedErr: context.Canceled,
+		},
+		"wraps error if fail to compare the stack": {
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.svcCFN.EXPECT().IsServiceUpToDate(gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedErr: errors.New("compare service frontend with its deployed stack: some error"),
+		},
+		"skips the deployment if the stack wouldn't change": {
+			inImageTag:    "v1.0",
+			inSidecarTags: map[string]string{"nginx": "v1.0-nginx"},
+			setupMocks: func(m svcDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					RepositoryURLs: map[string]string{
+						"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+					},
+				}, nil)
+				m.envStack.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.svcCFN.EXPECT().IsServiceUpToDate(gomock.Any()).Return(true, nil)
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDeployed: false,
+			wantedProgress: "No changes detected for frontend in test.\n",
+		},
+		"deploys without comparing the stack if the deployment is forced": {
+			inForce: true,
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.svcCFN.EXPECT().IsServiceUpToDate(gomock.Any()).Times(0)
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantedDeployed: true,
+			wantedProgress: "Deploying frontend to test, the stack is phonetool-test-frontend.\n",
+		},
+		"returns once the deployment starts without waiting": {
+			inNoWait: true,
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.svcCFN.EXPECT().IsServiceUpToDate(gomock.Any()).Return(false, nil)
+				m.svcCFN.EXPECT().DeployServiceNoWait(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantedDeployed: true,
+			wantedProgress: "Deploying frontend to test, the stack is phonetool-test-frontend.\n",
+		},
+		"wraps error if fail to deploy the stack": {
+			setupMocks: func(m svcDeployerMocks) {
+				m.envStack.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.svcCFN.EXPECT().IsServiceUpToDate(gomock.Any()).Return(false, nil)
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("deploy service: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcDeployerMocks{
+				appCFN:   mocks.NewMockappResourcesGetter(ctrl),
+				svcCFN:   mocks.NewMockserviceDeployer(ctrl),
+				envStack: mocks.NewMockstackDescriber(ctrl),
+				certs:    mocks.NewMockcertValidator(ctrl),
+			}
+			tc.setupMocks(m)
+			var mft interface{} = &manifest.BackendService{
+				Workload: manifest.Workload{
+					Name: aws.String("frontend"),
+				},
+			}
+			if tc.inManifest != nil {
+				mft = tc.inManifest
+			}
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			progress := &bytes.Buffer{}
+			d := &svcDeployer{
+				in: DeployServiceInput{
+					App:              "phonetool",
+					Env:              "test",
+					Name:             "frontend",
+					ImageTag:         tc.inImageTag,
+					SidecarImageTags: tc.inSidecarTags,
+					Force:            tc.inForce,
+					NoWait:           tc.inNoWait,
+					Progress:         progress,
+				},
+				app: &config.Application{
+					Name:      "phonetool",
+					AccountID: "123456789012",
+				},
+				env: &config.Environment{
+					App:    "phonetool",
+					Name:   "test",
+					Region: "us-west-2",
+				},
+				unmarshal: func([]byte) (interface{}, error) {
+					return mft, nil
+				},
+				appCFN:   m.appCFN,
+				svcCFN:   m.svcCFN,
+				envStack: m.envStack,
+				certs:    m.certs,
+			}
+
+			// WHEN
+			out, err := d.deploy(ctx)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "phonetool-test-frontend", out.StackName)
+			require.Equal(t, tc.wantedDeployed, out.Deployed)
+			require.Equal(t, tc.wantedDeployed, out.TemplateHash != "", "the template is hashed only if it's deployed")
+			require.Equal(t, tc.wantedProgress, progress.String())
+		})
+	}
+}
+
+func TestIsIPv6Enabled(t *testing.T) {
+	testCases := map[string]struct {
+		outputs map[string]string
+
+		wanted bool
+	}{
+		"returns true if the environment supports IPv6": {
+			outputs: map[string]string{
+				"VpcId":       "vpc-1234",
+				"IPv6Enabled": "true",
+			},
+			wanted: true,
+		},
+		"returns false if the environment was created without IPv6": {
+			outputs: map[string]string{
+				"VpcId": "vpc-1234",
+			},
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, isIPv6Enabled(tc.outputs))
+		})
+	}
+}
+
+func TestImportedSecurityGroups(t *testing.T) {
+	testCases := map[string]struct {
+		outputs map[string]string
+
+		wanted []string
+	}{
+		"returns the security groups imported with the VPC": {
+			outputs: map[string]string{
+				"VpcId":                  "vpc-1234",
+				"ImportedSecurityGroups": "sg-1234,sg-5678",
+			},
+			wanted: []string{"sg-1234", "sg-5678"},
+		},
+		"returns nil if the environment has no imported security groups": {
+			outputs: map[string]string{
+				"VpcId": "vpc-1234",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, importedSecurityGroups(tc.outputs))
+		})
+	}
+}
+
+func TestImportedCertARNs(t *testing.T) {
+	testCases := map[string]struct {
+		outputs map[string]string
+
+		wanted []string
+	}{
+		"returns the certificates imported by the environment": {
+			outputs: map[string]string{
+				"HTTPSListenerArn":        "listener",
+				"ImportedCertificateARNs": "arn:aws:acm:us-west-2:123456789012:certificate/cert-1,arn:aws:acm:us-west-2:123456789012:certificate/cert-2",
+			},
+			wanted: []string{"arn:aws:acm:us-west-2:123456789012:certificate/cert-1", "arn:aws:acm:us-west-2:123456789012:certificate/cert-2"},
+		},
+		"returns nil if the environment has no imported certificates": {
+			outputs: map[string]string{
+				"HTTPSListenerArn": "listener",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, importedCertARNs(tc.outputs))
+		})
+	}
+}
+
+func TestDeployService_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in DeployServiceInput
+
+		wantedErr error
+	}{
+		"errors without an application": {
+			in:        DeployServiceInput{Env: "test", Name: "frontend"},
+			wantedErr: errNoApp,
+		},
+		"errors without a manifest": {
+			in:        DeployServiceInput{App: "phonetool", Env: "test", Name: "frontend"},
+			wantedErr: errors.New("manifest is required"),
+		},
+		"errors without a session": {
+			in: DeployServiceInput{
+				App:      "phonetool",
+				Env:      "test",
+				Name:     "frontend",
+				Manifest: []byte("name: frontend"),
+			},
+			wantedErr: errNoSession,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := DeployService(context.Background(), tc.in)
+
+			require.EqualError(t, err, tc.wantedErr.Error())
+		})
+	}
+}